@@ -0,0 +1,180 @@
+// Package client is the RPC client of the node in the stable API. It connects to the StoreService,
+// P2PService, HealthService, ConfigService, FeeService and AdminService of a node.
+package client
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"net"
+	"net/http"
+	"time"
+
+	"github.com/evstack/ev-node/pkg/rpc/client"
+	nodetypes "github.com/evstack/ev-node/types"
+)
+
+// Client is the RPC client of a node.
+type Client = client.Client
+
+// Option configures a Client.
+type Option = client.Option
+
+// RetryPolicy configures the retries of the requests failing with a transient error.
+type RetryPolicy = client.RetryPolicy
+
+// DefaultRetryPolicy retries requests up to 3 times on the errors of a node which is unavailable
+// or overloaded.
+var DefaultRetryPolicy = client.DefaultRetryPolicy
+
+// TypedClient calls the RPCs of a Client and converts their responses into the types of the node,
+// see Client.Typed.
+type TypedClient = client.TypedClient
+
+// TypedBlock is a block returned by a TypedClient, with the DA heights of its header and data.
+type TypedBlock = client.Block
+
+// VerifyingClient returns the headers and blocks of a TypedClient only once verified against the
+// genesis of the chain, see Client.Verifying.
+type VerifyingClient = client.VerifyingClient
+
+// VerifyOption configures a VerifyingClient.
+type VerifyOption = client.VerifyOption
+
+// ErrUnverified is returned by a VerifyingClient for a header or block which fails verification.
+var ErrUnverified = client.ErrUnverified
+
+// WithSignaturePayloadProvider verifies the signatures of the headers over the payload returned
+// by provider, for chains whose sequencer signs a custom payload.
+func WithSignaturePayloadProvider(provider SignaturePayloadProvider) VerifyOption {
+	return client.WithSignaturePayloadProvider(provider)
+}
+
+// SignaturePayloadProvider returns the bytes of a header signed by the sequencer.
+type SignaturePayloadProvider = nodetypes.SignaturePayloadProvider
+
+// HealthChange is a change of the reachability of a node observed by a HealthMonitor.
+type HealthChange = client.HealthChange
+
+// HealthMonitor pings a node in the background, see Client.MonitorHealth.
+type HealthMonitor = client.HealthMonitor
+
+// BlockIterator iterates over the blocks of a range, see Client.BlockIterator.
+type BlockIterator = client.BlockIterator
+
+// Metrics is the telemetry of the requests of RPC clients, a prometheus.Collector, see
+// WithMetrics.
+type Metrics = client.Metrics
+
+// NewMetrics creates the metrics of RPC clients, named after namespace.
+func NewMetrics(namespace string) *Metrics {
+	return client.NewMetrics(namespace)
+}
+
+// PageFunc fetches a page of a list RPC, returning its items and the page response.
+type PageFunc[T any] = client.PageFunc[T]
+
+// AllPages fetches the pages of a list RPC and returns the items of all the pages, see
+// PageFunc.
+func AllPages[T any](ctx context.Context, limit uint32, fetch PageFunc[T]) ([]T, error) {
+	return client.AllPages(ctx, limit, fetch)
+}
+
+// NewClient creates a client of the node serving RPCs at baseURL.
+func NewClient(baseURL string, opts ...Option) *Client {
+	return client.NewClient(baseURL, opts...)
+}
+
+// WithBearerToken authenticates the requests of the client with a bearer token, i.e. the
+// static token or a JWT accepted by the RPCs protected by the node.
+func WithBearerToken(token string) Option {
+	return client.WithBearerToken(token)
+}
+
+// WithTokenSource authenticates every request of the client with the bearer token returned by
+// source for the context of the request, e.g. a JWT signed for each request.
+func WithTokenSource(source func(ctx context.Context) (string, error)) Option {
+	return client.WithTokenSource(source)
+}
+
+// WithTLSConfig sets the TLS configuration of the connections to https URLs.
+func WithTLSConfig(config *tls.Config) Option {
+	return client.WithTLSConfig(config)
+}
+
+// WithRootCAs sets the certificate authorities trusted to verify the certificate of the node,
+// instead of the ones of the system.
+func WithRootCAs(pool *x509.CertPool) Option {
+	return client.WithRootCAs(pool)
+}
+
+// WithDialTimeout bounds the time to connect to the node, 30 seconds by default.
+func WithDialTimeout(timeout time.Duration) Option {
+	return client.WithDialTimeout(timeout)
+}
+
+// WithReadTimeout bounds the time to get the response of a unary request, for requests whose
+// context has no earlier deadline.
+func WithReadTimeout(timeout time.Duration) Option {
+	return client.WithReadTimeout(timeout)
+}
+
+// WithKeepalive makes the client ping the node over HTTP/2 connections idle for interval, and
+// close the connections whose ping is not answered within timeout.
+func WithKeepalive(interval, timeout time.Duration) Option {
+	return client.WithKeepalive(interval, timeout)
+}
+
+// WithMaxConcurrentStreams bounds the number of requests and streams of the client in flight at
+// once.
+func WithMaxConcurrentStreams(n int) Option {
+	return client.WithMaxConcurrentStreams(n)
+}
+
+// WithCache caches up to entries responses of the node which no longer change, i.e. the blocks
+// by hash and the headers by height once included on DA.
+func WithCache(entries int) Option {
+	return client.WithCache(entries)
+}
+
+// WithMetrics records the requests of the client and their latency, by procedure and status
+// code, in metrics.
+func WithMetrics(metrics *Metrics) Option {
+	return client.WithMetrics(metrics)
+}
+
+// WithUnixSocket connects the client to the unix socket of the node at path, see the
+// rpc.unix_socket configuration. The host of the base URL is then ignored, e.g. http://localhost.
+func WithUnixSocket(path string) Option {
+	return client.WithUnixSocket(path)
+}
+
+// WithDialer connects the client to the node through dial, e.g. the DialContext of an SSH client
+// or of a SOCKS proxy dialer. The TLS and keepalive options still apply.
+func WithDialer(dial func(ctx context.Context, network, addr string) (net.Conn, error)) Option {
+	return client.WithDialer(dial)
+}
+
+// WithTransport sends the requests of the client through transport, used as is: the dialer, unix
+// socket, TLS and keepalive options are then ignored.
+func WithTransport(transport http.RoundTripper) Option {
+	return client.WithTransport(transport)
+}
+
+// WithRetryPolicy retries the requests of the client without side effects failing with one of the
+// codes of the policy, with exponential backoff.
+func WithRetryPolicy(policy RetryPolicy) Option {
+	return client.WithRetryPolicy(policy)
+}
+
+// WithFailoverURLs adds endpoints serving the same RPCs, which the client fails over to when the
+// endpoint it uses is down.
+func WithFailoverURLs(urls ...string) Option {
+	return client.WithFailoverURLs(urls...)
+}
+
+// WithLoadBalancedReads spreads the requests without side effects over all the endpoints of the
+// client in turn.
+func WithLoadBalancedReads() Option {
+	return client.WithLoadBalancedReads()
+}
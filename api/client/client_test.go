@@ -0,0 +1,57 @@
+package client_test
+
+import (
+	"context"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/evstack/ev-node/api/client"
+	apierrors "github.com/evstack/ev-node/api/errors"
+	"github.com/evstack/ev-node/pkg/config"
+	"github.com/evstack/ev-node/pkg/p2p"
+	"github.com/evstack/ev-node/pkg/rpc/server"
+	"github.com/evstack/ev-node/test/mocks"
+	"github.com/evstack/ev-node/types"
+)
+
+func TestClient(t *testing.T) {
+	mockStore := mocks.NewMockStore(t)
+	mockStore.On("GetState", mock.Anything).Return(types.State{ChainID: "test-chain"}, nil)
+	mockStore.On("Height", mock.Anything).Return(uint64(0), nil)
+	mockP2P := &mocks.MockP2PRPC{}
+	mockP2P.On("GetNetworkInfo").Return(p2p.NetworkInfo{ID: "nid"}, nil)
+
+	cfg := config.DefaultConfig
+	cfg.RPC.AuthToken = "secret-token"
+	cfg.RPC.AuthServices = "evnode.v1.P2PService"
+	handler, err := server.NewServiceHandler(mockStore, mockP2P, zerolog.Nop(), cfg, server.ServiceOptions{})
+	require.NoError(t, err)
+	srv := httptest.NewServer(handler)
+	defer srv.Close()
+
+	ctx := context.Background()
+	c := client.NewClient(srv.URL)
+	state, err := c.GetState(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, "test-chain", state.GetChainId())
+
+	_, err = c.GetBlockByHeight(ctx, 0)
+	assert.True(t, apierrors.IsNotFound(err))
+	assert.Equal(t, apierrors.ReasonBlockNotFound, apierrors.ReasonOf(err))
+
+	_, err = c.GetNetInfo(ctx)
+	assert.True(t, apierrors.IsUnauthenticated(err))
+	netInfo, err := client.NewClient(srv.URL, client.WithBearerToken("secret-token")).GetNetInfo(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, "nid", netInfo.GetId())
+
+	srv.Close()
+	_, err = c.GetState(ctx)
+	assert.True(t, apierrors.IsUnavailable(err))
+	assert.Equal(t, apierrors.ReasonUnspecified, apierrors.ReasonOf(err))
+}
@@ -0,0 +1,64 @@
+package client
+
+import (
+	"context"
+	"time"
+
+	"github.com/evstack/ev-node/api/types"
+	"github.com/evstack/ev-node/pkg/genesis"
+)
+
+// The methods of Client which are part of the stable API. An incompatible change of the
+// underlying client fails to compile here; methods can be added, but not removed or changed.
+var (
+	_ func(*Client, context.Context, uint64) (*types.GetBlockResponse, error)                       = (*Client).GetBlockByHeight
+	_ func(*Client, context.Context, []byte) (*types.GetBlockResponse, error)                       = (*Client).GetBlockByHash
+	_ func(*Client, context.Context) (*types.State, error)                                          = (*Client).GetState
+	_ func(*Client, context.Context, string) ([]byte, error)                                        = (*Client).GetMetadata
+	_ func(*Client, context.Context, []string) ([]*types.MetadataEntry, error)                      = (*Client).GetMetadataBatch
+	_ func(*Client, context.Context, uint64) (*types.StateDiff, error)                              = (*Client).GetStateDiff
+	_ func(*Client, context.Context, uint64) (*types.SequencerFees, error)                          = (*Client).GetSequencerFees
+	_ func(*Client, context.Context, uint64) (*types.GetHeaderResponse, error)                      = (*Client).GetHeader
+	_ func(*Client, context.Context, uint64, uint64) ([]*types.SignedHeader, error)                 = (*Client).GetHeaderRange
+	_ func(*Client, context.Context, []byte) (*types.GetTxStatusResponse, error)                    = (*Client).GetTxStatus
+	_ func(*Client, context.Context, []byte, time.Duration) (*types.GetTxStatusResponse, error)     = (*Client).WaitForTxInclusion
+	_ func(*Client, context.Context, uint64) (uint64, error)                                        = (*Client).WaitForHeight
+	_ func(*Client, context.Context, uint64) (uint64, error)                                        = (*Client).WaitForDAInclusion
+	_ func(*Client, context.Context, time.Time, time.Time, ...string) ([]*types.Event, error)       = (*Client).GetEvents
+	_ func(*Client, context.Context) (*types.GetSyncStatusResponse, error)                          = (*Client).GetSyncStatus
+	_ func(*Client, context.Context, uint64) (*types.GetDAInclusionProofResponse, error)            = (*Client).GetDAInclusionProof
+	_ func(*Client, context.Context) (*types.GetDAInfoResponse, error)                              = (*Client).GetDAInfo
+	_ func(*Client, context.Context, uint32) (*types.GetExecutionConsistencyResponse, error)        = (*Client).GetExecutionConsistency
+	_ func(*Client, context.Context) ([]*types.PeerInfo, error)                                     = (*Client).GetPeerInfo
+	_ func(*Client, context.Context, *types.GetPeerInfoRequest) (*types.GetPeerInfoResponse, error) = (*Client).GetPeerInfoPage
+	_ func(*Client, context.Context) (*types.NetInfo, error)                                        = (*Client).GetNetInfo
+	_ func(*Client, context.Context) (types.HealthStatus, error)                                    = (*Client).GetHealth
+	_ func(*Client, context.Context) (*types.ReadyzResponse, error)                                 = (*Client).GetReadiness
+	_ func(*Client, context.Context) ([]*types.Alert, error)                                        = (*Client).GetAlerts
+	_ func(*Client, context.Context) ([]*types.SubsystemError, error)                               = (*Client).GetErrors
+	_ func(*Client, context.Context) (*types.GetNamespaceResponse, error)                           = (*Client).GetNamespace
+	_ func(*Client, context.Context, []byte) (*types.ValidateConfigResponse, error)                 = (*Client).ValidateConfig
+	_ func(*Client, context.Context) (*types.GetNodeInfoResponse, error)                            = (*Client).GetNodeInfo
+	_ func(*Client, context.Context, string) error                                                  = (*Client).VerifyBuild
+	_ func(*Client, context.Context) (*types.GetGenesisResponse, error)                             = (*Client).GetGenesis
+	_ func(*Client, context.Context, []byte) (*types.EstimateTxFeeResponse, error)                  = (*Client).EstimateTxFee
+	_ func(*Client, context.Context) error                                                          = (*Client).Shutdown
+	_ func(*Client, context.Context, string) (string, error)                                        = (*Client).SetLogLevel
+	_ func(*Client, context.Context) (*types.TriggerDASubmissionResponse, error)                    = (*Client).TriggerDASubmission
+	_ func(*Client, context.Context, string, bool) error                                            = (*Client).DisconnectPeer
+	_ func(*Client, context.Context) error                                                          = (*Client).CompactStore
+	_ func(*Client, context.Context, time.Duration) error                                           = (*Client).Drain
+	_ func(*Client, context.Context, string, string, []byte) error                                  = (*Client).SetMetadata
+
+	_ func(*Client, context.Context, uint64, uint64, func(*types.GetBlockStreamResponse) error) error = (*Client).GetBlockStream
+	_ func(*Client, context.Context, *types.SearchBlocksRequest) (*types.SearchBlocksResponse, error) = (*Client).SearchBlocks
+	_ func(*Client, context.Context, uint64) (<-chan *types.Block, error)                             = (*Client).SubscribeBlocks
+	_ func(*Client, context.Context) <-chan *types.Block                                              = (*Client).SubscribePreviewBlocks
+
+	_ func(*Client, context.Context) error                                             = (*Client).Ping
+	_ func(*Client, context.Context, time.Duration, func(HealthChange)) *HealthMonitor = (*Client).MonitorHealth
+	_ func(*Client) *TypedClient                                                       = (*Client).Typed
+	_ func(*Client, genesis.Genesis, ...VerifyOption) *VerifyingClient                 = (*Client).Verifying
+	_ func(*Client, context.Context, uint64, uint64) *BlockIterator                    = (*Client).BlockIterator
+	_ func(*BlockIterator) *types.GetBlockResponse                                     = (*BlockIterator).Block
+)
@@ -0,0 +1,14 @@
+// Package api is the stable Go API of the node for the applications embedding its RPC client.
+//
+// Its packages are the only ones covered by compatibility guarantees: within a major version,
+// exported identifiers are neither removed nor changed in an incompatible way, while the
+// packages they are built on, such as pkg/rpc/client and types, may be refactored freely.
+//
+//   - api/client is the RPC client of the node
+//   - api/types are the types returned by the client
+//   - api/errors classifies the errors returned by the client
+//
+// The packages built on internal packages pin the API they re-export in a compat.go file, so
+// that an incompatible change of the internal packages fails to compile, for everyone building
+// the module, instead of breaking downstream applications.
+package api
@@ -0,0 +1,120 @@
+// Package errors classifies the errors returned by the RPC client of api/client. The errors of
+// failed RPCs carry a code, independent of the transport, e.g. to tell a missing block from an
+// unreachable node.
+package errors
+
+import (
+	"errors"
+
+	"connectrpc.com/connect"
+
+	pb "github.com/evstack/ev-node/types/pb/evnode/v1"
+)
+
+// Code is the code of the error of a failed RPC.
+type Code = connect.Code
+
+// Codes of the errors of failed RPCs.
+const (
+	CodeCanceled           = connect.CodeCanceled
+	CodeUnknown            = connect.CodeUnknown
+	CodeInvalidArgument    = connect.CodeInvalidArgument
+	CodeDeadlineExceeded   = connect.CodeDeadlineExceeded
+	CodeNotFound           = connect.CodeNotFound
+	CodeAlreadyExists      = connect.CodeAlreadyExists
+	CodePermissionDenied   = connect.CodePermissionDenied
+	CodeResourceExhausted  = connect.CodeResourceExhausted
+	CodeFailedPrecondition = connect.CodeFailedPrecondition
+	CodeAborted            = connect.CodeAborted
+	CodeOutOfRange         = connect.CodeOutOfRange
+	CodeUnimplemented      = connect.CodeUnimplemented
+	CodeInternal           = connect.CodeInternal
+	CodeUnavailable        = connect.CodeUnavailable
+	CodeDataLoss           = connect.CodeDataLoss
+	CodeUnauthenticated    = connect.CodeUnauthenticated
+)
+
+// Reason is the machine-readable reason of the error of a failed RPC. It refines the code of the
+// error.
+type Reason = pb.ErrorReason
+
+// Reasons of the errors of failed RPCs.
+const (
+	ReasonUnspecified    = pb.ErrorReason_ERROR_REASON_UNSPECIFIED
+	ReasonBlockNotFound  = pb.ErrorReason_ERROR_REASON_BLOCK_NOT_FOUND
+	ReasonPruned         = pb.ErrorReason_ERROR_REASON_PRUNED
+	ReasonStoreCorrupted = pb.ErrorReason_ERROR_REASON_STORE_CORRUPTED
+	ReasonDAUnavailable  = pb.ErrorReason_ERROR_REASON_DA_UNAVAILABLE
+	ReasonSyncing        = pb.ErrorReason_ERROR_REASON_SYNCING
+	ReasonP2PUnavailable = pb.ErrorReason_ERROR_REASON_P2P_UNAVAILABLE
+)
+
+// CodeOf returns the code of the error of a failed RPC, CodeUnknown if err is not the error of
+// an RPC.
+func CodeOf(err error) Code {
+	return connect.CodeOf(err)
+}
+
+// IsNotFound reports whether the RPC failed because the requested item, e.g. a block, is not
+// in the store of the node.
+func IsNotFound(err error) bool {
+	return CodeOf(err) == CodeNotFound
+}
+
+// IsUnauthenticated reports whether the RPC failed because the node requires a bearer token
+// which the client did not provide or which is invalid.
+func IsUnauthenticated(err error) bool {
+	return CodeOf(err) == CodeUnauthenticated
+}
+
+// IsUnavailable reports whether the RPC failed because the node could not be reached, in which
+// case it may succeed when retried.
+func IsUnavailable(err error) bool {
+	return CodeOf(err) == CodeUnavailable
+}
+
+// ReasonOf returns the reason of the error of a failed RPC, ReasonUnspecified if the node did not
+// report one.
+func ReasonOf(err error) Reason {
+	if detail := detailOf(err); detail != nil {
+		return detail.GetReason()
+	}
+	return ReasonUnspecified
+}
+
+// HeightOf returns the height of the block the error of a failed RPC relates to, 0 if none.
+func HeightOf(err error) uint64 {
+	if detail := detailOf(err); detail != nil {
+		return detail.GetHeight()
+	}
+	return 0
+}
+
+// IsPruned reports whether the RPC failed because the data of the requested block was pruned
+// from the store of the node.
+func IsPruned(err error) bool {
+	return ReasonOf(err) == ReasonPruned
+}
+
+// IsSyncing reports whether the RPC failed because the node has not synced the requested block
+// yet, in which case it may succeed when retried later.
+func IsSyncing(err error) bool {
+	return ReasonOf(err) == ReasonSyncing
+}
+
+func detailOf(err error) *pb.ErrorDetail {
+	var connectErr *connect.Error
+	if !errors.As(err, &connectErr) {
+		return nil
+	}
+	for _, detail := range connectErr.Details() {
+		value, err := detail.Value()
+		if err != nil {
+			continue
+		}
+		if errDetail, ok := value.(*pb.ErrorDetail); ok {
+			return errDetail
+		}
+	}
+	return nil
+}
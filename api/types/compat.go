@@ -0,0 +1,158 @@
+package types
+
+import (
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// The fields of the types which are part of the stable API. The types are generated from the
+// protobuf definitions, so a field renamed or retyped there fails to compile here.
+var (
+	_ func(*GetBlockResponse) *Block = (*GetBlockResponse).GetBlock
+	_ func(*GetBlockResponse) uint64 = (*GetBlockResponse).GetHeaderDaHeight
+	_ func(*GetBlockResponse) uint64 = (*GetBlockResponse).GetDataDaHeight
+	_ func(*Block) *SignedHeader     = (*Block).GetHeader
+	_ func(*Block) *Data             = (*Block).GetData
+	_ func(*SignedHeader) *Header    = (*SignedHeader).GetHeader
+	_ func(*SignedHeader) []byte     = (*SignedHeader).GetSignature
+	_ func(*SignedHeader) *Signer    = (*SignedHeader).GetSigner
+	_ func(*Header) *Version         = (*Header).GetVersion
+	_ func(*Header) uint64           = (*Header).GetHeight
+	_ func(*Header) uint64           = (*Header).GetTime
+	_ func(*Header) []byte           = (*Header).GetLastHeaderHash
+	_ func(*Header) []byte           = (*Header).GetDataHash
+	_ func(*Header) []byte           = (*Header).GetAppHash
+	_ func(*Header) string           = (*Header).GetChainId
+	_ func(*Data) *Metadata          = (*Data).GetMetadata
+	_ func(*Data) [][]byte           = (*Data).GetTxs
+
+	_ func(*GetBlockStreamResponse) *SignedHeader = (*GetBlockStreamResponse).GetHeader
+	_ func(*GetBlockStreamResponse) *Metadata     = (*GetBlockStreamResponse).GetMetadata
+	_ func(*GetBlockStreamResponse) uint64        = (*GetBlockStreamResponse).GetHeaderDaHeight
+	_ func(*GetBlockStreamResponse) uint64        = (*GetBlockStreamResponse).GetDataDaHeight
+	_ func(*GetBlockStreamResponse) uint64        = (*GetBlockStreamResponse).GetTxCount
+	_ func(*GetBlockStreamResponse) [][]byte      = (*GetBlockStreamResponse).GetTxs
+
+	_ func(*SearchBlocksResponse) []*BlockSummary = (*SearchBlocksResponse).GetBlocks
+	_ func(*SearchBlocksResponse) *PageResponse   = (*SearchBlocksResponse).GetPage
+	_ func(*BlockSummary) uint64                  = (*BlockSummary).GetHeight
+	_ func(*BlockSummary) []byte                  = (*BlockSummary).GetHash
+	_ func(*BlockSummary) *timestamppb.Timestamp  = (*BlockSummary).GetTime
+	_ func(*BlockSummary) []byte                  = (*BlockSummary).GetProposerAddress
+	_ func(*BlockSummary) uint64                  = (*BlockSummary).GetTxCount
+
+	_ func(*State) string                 = (*State).GetChainId
+	_ func(*State) uint64                 = (*State).GetInitialHeight
+	_ func(*State) uint64                 = (*State).GetLastBlockHeight
+	_ func(*State) *timestamppb.Timestamp = (*State).GetLastBlockTime
+	_ func(*State) uint64                 = (*State).GetDaHeight
+	_ func(*State) []byte                 = (*State).GetAppHash
+
+	_ func(*GetTxStatusResponse) TxStatus = (*GetTxStatusResponse).GetStatus
+	_ func(*GetTxStatusResponse) uint64   = (*GetTxStatusResponse).GetHeight
+	_ func(*GetTxStatusResponse) uint32   = (*GetTxStatusResponse).GetIndex
+	_ func(*GetTxStatusResponse) bool     = (*GetTxStatusResponse).GetDaIncluded
+
+	_ func(*GetBlockByTxHashResponse) *Block  = (*GetBlockByTxHashResponse).GetBlock
+	_ func(*GetBlockByTxHashResponse) uint64  = (*GetBlockByTxHashResponse).GetHeaderDaHeight
+	_ func(*GetBlockByTxHashResponse) uint64  = (*GetBlockByTxHashResponse).GetDataDaHeight
+	_ func(*GetBlockByTxHashResponse) uint32  = (*GetBlockByTxHashResponse).GetIndex
+	_ func(*GetTxProofResponse) *SignedHeader = (*GetTxProofResponse).GetHeader
+	_ func(*GetTxProofResponse) [][]byte      = (*GetTxProofResponse).GetTxs
+	_ func(*GetTxProofResponse) uint32        = (*GetTxProofResponse).GetIndex
+
+	_ func(*GetDAInclusionProofResponse) uint64           = (*GetDAInclusionProofResponse).GetHeight
+	_ func(*GetDAInclusionProofResponse) *DABlobInclusion = (*GetDAInclusionProofResponse).GetHeader
+	_ func(*GetDAInclusionProofResponse) *DABlobInclusion = (*GetDAInclusionProofResponse).GetData
+	_ func(*DABlobInclusion) uint64                       = (*DABlobInclusion).GetDaHeight
+	_ func(*DABlobInclusion) string                       = (*DABlobInclusion).GetNamespace
+	_ func(*DABlobInclusion) []byte                       = (*DABlobInclusion).GetId
+	_ func(*DABlobInclusion) []byte                       = (*DABlobInclusion).GetCommitment
+	_ func(*DABlobInclusion) []byte                       = (*DABlobInclusion).GetProof
+
+	_ func(*GetDAInfoResponse) string = (*GetDAInfoResponse).GetBackend
+	_ func(*GetDAInfoResponse) string = (*GetDAInfoResponse).GetNetworkId
+	_ func(*GetDAInfoResponse) string = (*GetDAInfoResponse).GetHeaderNamespace
+	_ func(*GetDAInfoResponse) string = (*GetDAInfoResponse).GetDataNamespace
+	_ func(*GetDAInfoResponse) uint64 = (*GetDAInfoResponse).GetMaxBlobSize
+	_ func(*GetDAInfoResponse) uint64 = (*GetDAInfoResponse).GetDaHeight
+	_ func(*GetDAInfoResponse) uint64 = (*GetDAInfoResponse).GetDaIncludedHeight
+
+	_ func(*MetadataEntry) string = (*MetadataEntry).GetKey
+	_ func(*MetadataEntry) []byte = (*MetadataEntry).GetValue
+	_ func(*MetadataEntry) bool   = (*MetadataEntry).GetFound
+
+	_ func(*GetSyncStatusResponse) uint64            = (*GetSyncStatusResponse).GetHeight
+	_ func(*GetSyncStatusResponse) uint64            = (*GetSyncStatusResponse).GetNetworkHeight
+	_ func(*GetSyncStatusResponse) uint64            = (*GetSyncStatusResponse).GetDaHeight
+	_ func(*GetSyncStatusResponse) uint64            = (*GetSyncStatusResponse).GetDaIncludedHeight
+	_ func(*GetSyncStatusResponse) bool              = (*GetSyncStatusResponse).GetSyncing
+	_ func(*GetSyncStatusResponse) map[string]uint64 = (*GetSyncStatusResponse).GetHeadersBySource
+	_ func(*GetSyncStatusResponse) map[string]uint64 = (*GetSyncStatusResponse).GetDataBySource
+	_ func(*GetSyncStatusResponse) uint64            = (*GetSyncStatusResponse).GetPrunedBaseHeight
+
+	_ func(*SequencerFees) uint64 = (*SequencerFees).GetHeight
+	_ func(*SequencerFees) []byte = (*SequencerFees).GetRecipient
+	_ func(*SequencerFees) string = (*SequencerFees).GetCollected
+	_ func(*SequencerFees) string = (*SequencerFees).GetTotalCollected
+	_ func(*SequencerFees) string = (*SequencerFees).GetRecipientBalance
+	_ func(*SequencerFees) bool   = (*SequencerFees).GetReconciled
+	_ func(*SequencerFees) string = (*SequencerFees).GetDiscrepancy
+
+	_ func(*GetExecutionConsistencyResponse) uint64                   = (*GetExecutionConsistencyResponse).GetHeight
+	_ func(*GetExecutionConsistencyResponse) uint64                   = (*GetExecutionConsistencyResponse).GetExecutionHeight
+	_ func(*GetExecutionConsistencyResponse) int64                    = (*GetExecutionConsistencyResponse).GetDrift
+	_ func(*GetExecutionConsistencyResponse) bool                     = (*GetExecutionConsistencyResponse).GetConsistent
+	_ func(*GetExecutionConsistencyResponse) []*ExecutionBlockMapping = (*GetExecutionConsistencyResponse).GetBlocks
+	_ func(*ExecutionBlockMapping) uint64                             = (*ExecutionBlockMapping).GetHeight
+	_ func(*ExecutionBlockMapping) uint64                             = (*ExecutionBlockMapping).GetExecutionNumber
+	_ func(*ExecutionBlockMapping) []byte                             = (*ExecutionBlockMapping).GetExecutionHash
+	_ func(*ExecutionBlockMapping) []byte                             = (*ExecutionBlockMapping).GetExecutionStateRoot
+	_ func(*ExecutionBlockMapping) []byte                             = (*ExecutionBlockMapping).GetExpectedStateRoot
+	_ func(*ExecutionBlockMapping) bool                               = (*ExecutionBlockMapping).GetConsistent
+	_ func(*ExecutionBlockMapping) string                             = (*ExecutionBlockMapping).GetError
+
+	_ func(*GetNodeInfoResponse) string                 = (*GetNodeInfoResponse).GetVersion
+	_ func(*GetNodeInfoResponse) string                 = (*GetNodeInfoResponse).GetGitCommit
+	_ func(*GetNodeInfoResponse) string                 = (*GetNodeInfoResponse).GetChainId
+	_ func(*GetNodeInfoResponse) string                 = (*GetNodeInfoResponse).GetMode
+	_ func(*GetNodeInfoResponse) string                 = (*GetNodeInfoResponse).GetExecutionClient
+	_ func(*GetNodeInfoResponse) string                 = (*GetNodeInfoResponse).GetDaBackend
+	_ func(*GetNodeInfoResponse) *timestamppb.Timestamp = (*GetNodeInfoResponse).GetStartTime
+	_ func(*GetNodeInfoResponse) *BuildInfo             = (*GetNodeInfoResponse).GetBuildInfo
+
+	_ func(*BuildInfo) string            = (*BuildInfo).GetGoVersion
+	_ func(*BuildInfo) *ModuleVersion    = (*BuildInfo).GetMain
+	_ func(*BuildInfo) string            = (*BuildInfo).GetVcsRevision
+	_ func(*BuildInfo) string            = (*BuildInfo).GetVcsTime
+	_ func(*BuildInfo) bool              = (*BuildInfo).GetVcsModified
+	_ func(*BuildInfo) string            = (*BuildInfo).GetBuilder
+	_ func(*BuildInfo) map[string]string = (*BuildInfo).GetSettings
+	_ func(*BuildInfo) []*ModuleVersion  = (*BuildInfo).GetDeps
+	_ func(*BuildInfo) string            = (*BuildInfo).GetDigest
+	_ func(*ModuleVersion) string        = (*ModuleVersion).GetPath
+	_ func(*ModuleVersion) string        = (*ModuleVersion).GetVersion
+	_ func(*ModuleVersion) string        = (*ModuleVersion).GetSum
+
+	_ func(*GetGenesisResponse) []byte = (*GetGenesisResponse).GetGenesis
+	_ func(*GetGenesisResponse) string = (*GetGenesisResponse).GetChainId
+	_ func(*GetGenesisResponse) []byte = (*GetGenesisResponse).GetHash
+
+	_ func(*Event) uint64                 = (*Event).GetSequence
+	_ func(*Event) *timestamppb.Timestamp = (*Event).GetTime
+	_ func(*Event) string                 = (*Event).GetType
+	_ func(*Event) string                 = (*Event).GetMessage
+	_ func(*Event) map[string]string      = (*Event).GetAttributes
+
+	_ func(*PeerInfo) string                   = (*PeerInfo).GetId
+	_ func(*PeerInfo) string                   = (*PeerInfo).GetAddress
+	_ func(*PeerInfo) PeerDirection            = (*PeerInfo).GetDirection
+	_ func(*PeerInfo) *durationpb.Duration     = (*PeerInfo).GetConnectionAge
+	_ func(*PeerInfo) *timestamppb.Timestamp   = (*PeerInfo).GetLastSeen
+	_ func(*PeerInfo) string                   = (*PeerInfo).GetProtocolVersion
+	_ func(*GetPeerInfoResponse) []*PeerInfo   = (*GetPeerInfoResponse).GetPeers
+	_ func(*GetPeerInfoResponse) *PageResponse = (*GetPeerInfoResponse).GetPage
+	_ func(*NetInfo) string                    = (*NetInfo).GetId
+	_ func(*NetInfo) []string                  = (*NetInfo).GetListenAddresses
+	_ func(*NetInfo) []string                  = (*NetInfo).GetConnectedPeers
+)
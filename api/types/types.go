@@ -0,0 +1,142 @@
+// Package types are the types of the stable API returned by the RPC client of api/client.
+package types
+
+import (
+	pb "github.com/evstack/ev-node/types/pb/evnode/v1"
+)
+
+// Blocks.
+type (
+	// Block is a block with its signed header and data.
+	Block = pb.Block
+	// Header is the header of a block.
+	Header = pb.Header
+	// SignedHeader is a header signed by the proposer.
+	SignedHeader = pb.SignedHeader
+	// Data are the transactions and metadata of a block.
+	Data = pb.Data
+	// Metadata is the metadata of the data of a block.
+	Metadata = pb.Metadata
+	// Version is the protocol version of a block.
+	Version = pb.Version
+	// Signer is the proposer of a block.
+	Signer = pb.Signer
+	// GetBlockResponse is a block with its DA heights.
+	GetBlockResponse = pb.GetBlockResponse
+	// GetBlockStreamResponse is a chunk of a block retrieved in chunks.
+	GetBlockStreamResponse = pb.GetBlockStreamResponse
+	// SearchBlocksRequest selects blocks by the metadata of their header and data.
+	SearchBlocksRequest = pb.SearchBlocksRequest
+	// SearchBlocksResponse is a page of the blocks matching a search.
+	SearchBlocksResponse = pb.SearchBlocksResponse
+	// PageRequest selects a page of the items of a list RPC.
+	PageRequest = pb.PageRequest
+	// PageResponse describes the page returned by a list RPC.
+	PageResponse = pb.PageResponse
+	// BlockSummary is the metadata of a block.
+	BlockSummary = pb.BlockSummary
+	// GetHeaderResponse is a header with its DA height.
+	GetHeaderResponse = pb.GetHeaderResponse
+	// GetDAInclusionProofResponse locates the header and data of a block on DA and proves their inclusion.
+	GetDAInclusionProofResponse = pb.GetDAInclusionProofResponse
+	// DABlobInclusion locates a blob on DA and proves its inclusion.
+	DABlobInclusion = pb.DABlobInclusion
+	// GetDAInfoResponse describes the DA layer of the node.
+	GetDAInfoResponse = pb.GetDAInfoResponse
+)
+
+// State.
+type (
+	// State is the state of the chain after the last block.
+	State = pb.State
+	// StateDiff are the changes of the state made by a block.
+	StateDiff = pb.StateDiff
+	// GetExecutionConsistencyResponse maps the latest heights to the blocks of the execution layer.
+	GetExecutionConsistencyResponse = pb.GetExecutionConsistencyResponse
+	// ExecutionBlockMapping maps a height to the block of the execution layer built for it.
+	ExecutionBlockMapping = pb.ExecutionBlockMapping
+	// StateChange is a change of the state.
+	StateChange = pb.StateChange
+	// MetadataEntry is the value of a metadata key of the store.
+	MetadataEntry = pb.MetadataEntry
+	// SequencerFees are the sequencing fees collected by a block.
+	SequencerFees = pb.SequencerFees
+)
+
+// Transactions.
+type (
+	// GetTxStatusResponse is the status of a transaction.
+	GetTxStatusResponse = pb.GetTxStatusResponse
+	// TxStatus is whether a transaction is pending or included in a block.
+	TxStatus = pb.TxStatus
+	// GetBlockByTxHashResponse is the latest block including a transaction.
+	GetBlockByTxHashResponse = pb.GetBlockByTxHashResponse
+	// GetTxProofResponse is the proof that a transaction is included in a block.
+	GetTxProofResponse = pb.GetTxProofResponse
+	// EstimateTxFeeResponse is the fee estimate of a transaction.
+	EstimateTxFeeResponse = pb.EstimateTxFeeResponse
+)
+
+// Statuses of transactions.
+const (
+	TxStatusUnknown  = pb.TxStatus_TX_STATUS_UNKNOWN
+	TxStatusPending  = pb.TxStatus_TX_STATUS_PENDING
+	TxStatusIncluded = pb.TxStatus_TX_STATUS_INCLUDED
+)
+
+// Node.
+type (
+	// Event is an event recorded in the journal of the node.
+	Event = pb.Event
+	// GetSyncStatusResponse is the sync progress of the node.
+	GetSyncStatusResponse = pb.GetSyncStatusResponse
+	// PeerInfo describes a connected peer.
+	PeerInfo = pb.PeerInfo
+	// PeerDirection is the direction of the connection to a peer.
+	PeerDirection = pb.PeerDirection
+	// GetPeerInfoRequest selects a page of the peers of the node.
+	GetPeerInfoRequest = pb.GetPeerInfoRequest
+	// GetPeerInfoResponse is a page of the peers of the node.
+	GetPeerInfoResponse = pb.GetPeerInfoResponse
+	// NetInfo describes the P2P network of the node.
+	NetInfo = pb.NetInfo
+	// HealthStatus is the health of the node.
+	HealthStatus = pb.HealthStatus
+	// ReadyzResponse are the results of the readiness checks of the node.
+	ReadyzResponse = pb.ReadyzResponse
+	// ReadinessCheck is the result of a readiness check.
+	ReadinessCheck = pb.ReadinessCheck
+	// Alert is the state of an alert rule of the node.
+	Alert = pb.Alert
+	// SubsystemError is the most recent error of a subsystem of the node.
+	SubsystemError = pb.SubsystemError
+	// GetNamespaceResponse are the DA namespaces of the node.
+	GetNamespaceResponse = pb.GetNamespaceResponse
+	// ValidateConfigResponse is the result of the validation of a configuration.
+	ValidateConfigResponse = pb.ValidateConfigResponse
+	// GetNodeInfoResponse describes the software, chain and components of the node.
+	GetNodeInfoResponse = pb.GetNodeInfoResponse
+	// BuildInfo is the provenance of the node binary.
+	BuildInfo = pb.BuildInfo
+	// ModuleVersion is a Go module linked into the node binary.
+	ModuleVersion = pb.ModuleVersion
+	// GetGenesisResponse is the genesis document of the network of the node.
+	GetGenesisResponse = pb.GetGenesisResponse
+	// TriggerDASubmissionResponse is the number of headers and data pending DA submission.
+	TriggerDASubmissionResponse = pb.TriggerDASubmissionResponse
+)
+
+// Peer connection directions.
+const (
+	PeerDirectionUnspecified = pb.PeerDirection_PEER_DIRECTION_UNSPECIFIED
+	PeerDirectionInbound     = pb.PeerDirection_PEER_DIRECTION_INBOUND
+	PeerDirectionOutbound    = pb.PeerDirection_PEER_DIRECTION_OUTBOUND
+)
+
+// Health statuses.
+const (
+	HealthStatusUnknown = pb.HealthStatus_UNKNOWN
+	HealthStatusPass    = pb.HealthStatus_PASS
+	HealthStatusWarn    = pb.HealthStatus_WARN
+	HealthStatusFail    = pb.HealthStatus_FAIL
+)
@@ -0,0 +1,100 @@
+package cmd
+
+import (
+	"fmt"
+	"math/big"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/rs/zerolog"
+	"github.com/spf13/cobra"
+
+	"github.com/evstack/ev-node/execution/evm"
+	"github.com/evstack/ev-node/pkg/relayer"
+	rpcclient "github.com/evstack/ev-node/pkg/rpc/client"
+)
+
+const (
+	flagRelayNodeRPC       = "relay.node-rpc"
+	flagRelayEthURL        = "relay.eth-url"
+	flagRelayContract      = "relay.contract"
+	flagRelayKeyFile       = "relay.key-file"
+	flagRelayBatchSize     = "relay.batch-size"
+	flagRelayPollInterval  = "relay.poll-interval"
+	flagRelayDAIncluded    = "relay.da-included-only"
+	flagRelayGasMultiplier = "relay.gas-multiplier"
+	flagRelayMaxFeePerGas  = "relay.max-fee-per-gas"
+	flagRelayResubmit      = "relay.resubmit-interval"
+)
+
+// RelayHeadersCmd relays the headers of a node to a light client contract on an EVM chain.
+var RelayHeadersCmd = &cobra.Command{
+	Use:   "relay-headers",
+	Short: "Relay block headers to a light client contract on an EVM chain",
+	Long: `Relay the signed headers of the chain, read from the RPC of a node, to a light client
+contract on an EVM settlement chain, in batches, with fees following the base fee of the
+settlement chain. The contract must implement submitHeaders(bytes[]) and latestHeight().`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		flags := cmd.Flags()
+		nodeRPC, _ := flags.GetString(flagRelayNodeRPC)
+		ethURL, _ := flags.GetString(flagRelayEthURL)
+		contract, _ := flags.GetString(flagRelayContract)
+		keyFile, _ := flags.GetString(flagRelayKeyFile)
+		batchSize, _ := flags.GetInt(flagRelayBatchSize)
+		pollInterval, _ := flags.GetDuration(flagRelayPollInterval)
+		daIncludedOnly, _ := flags.GetBool(flagRelayDAIncluded)
+		gasMultiplier, _ := flags.GetFloat64(flagRelayGasMultiplier)
+		maxFeePerGas, _ := flags.GetUint64(flagRelayMaxFeePerGas)
+		resubmitInterval, _ := flags.GetDuration(flagRelayResubmit)
+
+		if !common.IsHexAddress(contract) {
+			return fmt.Errorf("invalid contract address %q", contract)
+		}
+		key, err := crypto.LoadECDSA(keyFile)
+		if err != nil {
+			return fmt.Errorf("failed to load key from %s: %w", keyFile, err)
+		}
+
+		ctx, cancel := signal.NotifyContext(cmd.Context(), os.Interrupt, syscall.SIGTERM)
+		defer cancel()
+
+		config := evm.HeaderRelayConfig{
+			Contract:         common.HexToAddress(contract),
+			GasMultiplier:    gasMultiplier,
+			ResubmitInterval: resubmitInterval,
+		}
+		if maxFeePerGas > 0 {
+			config.MaxFeePerGas = new(big.Int).SetUint64(maxFeePerGas)
+		}
+		submitter, err := evm.NewHeaderRelaySubmitter(ctx, ethURL, key, config)
+		if err != nil {
+			return fmt.Errorf("failed to connect to %s: %w", ethURL, err)
+		}
+
+		logger := zerolog.New(zerolog.ConsoleWriter{Out: os.Stderr}).With().Timestamp().Str("module", "relayer").Logger()
+		r := relayer.NewRelayer(rpcclient.NewClient(nodeRPC), submitter, relayer.Config{
+			BatchSize:      batchSize,
+			PollInterval:   pollInterval,
+			DAIncludedOnly: daIncludedOnly,
+		}, logger)
+		return r.Run(ctx)
+	},
+}
+
+func init() {
+	flags := RelayHeadersCmd.Flags()
+	flags.String(flagRelayNodeRPC, "http://127.0.0.1:7331", "URL of the RPC of the node to read headers from")
+	flags.String(flagRelayEthURL, "http://localhost:8545", "URL of the Ethereum JSON-RPC endpoint of the settlement chain")
+	flags.String(flagRelayContract, "", "Address of the light client contract")
+	flags.String(flagRelayKeyFile, "", "File holding the hex encoded private key of the account submitting headers")
+	flags.Int(flagRelayBatchSize, relayer.DefaultBatchSize, "Maximum number of headers submitted in a transaction")
+	flags.Duration(flagRelayPollInterval, relayer.DefaultPollInterval, "Interval at which the node is checked for new headers")
+	flags.Bool(flagRelayDAIncluded, false, "Only relay headers of blocks included on DA")
+	flags.Float64(flagRelayGasMultiplier, 1.2, "Multiplier applied to the estimated gas of a submission")
+	flags.Uint64(flagRelayMaxFeePerGas, 0, "Maximum fee per gas of a submission, in wei (0 for no cap)")
+	flags.Duration(flagRelayResubmit, time.Minute, "Time after which a pending submission is replaced with higher fees")
+}
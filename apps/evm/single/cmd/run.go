@@ -5,8 +5,10 @@ import (
 	"encoding/hex"
 	"fmt"
 	"path/filepath"
+	"strings"
 
 	"github.com/evstack/ev-node/core/da"
+	"github.com/evstack/ev-node/core/execution"
 	"github.com/evstack/ev-node/da/jsonrpc"
 	"github.com/evstack/ev-node/node"
 	"github.com/evstack/ev-node/sequencers/single"
@@ -16,7 +18,6 @@ import (
 
 	"github.com/evstack/ev-node/execution/evm"
 
-	"github.com/evstack/ev-node/core/execution"
 	rollcmd "github.com/evstack/ev-node/pkg/cmd"
 	"github.com/evstack/ev-node/pkg/config"
 	genesispkg "github.com/evstack/ev-node/pkg/genesis"
@@ -30,10 +31,16 @@ var RunCmd = &cobra.Command{
 	Aliases: []string{"node", "run"},
 	Short:   "Run the evolve node with EVM execution client",
 	RunE: func(cmd *cobra.Command, args []string) error {
-		executor, err := createExecutionClient(cmd)
+		executor, err := createExecutionClient(cmd, evm.FlagEvmEthURL, evm.FlagEvmEngineURL)
 		if err != nil {
 			return err
 		}
+		var upgradeExecutor *evm.EngineClient
+		if upgradeEngineURL, _ := cmd.Flags().GetString(evm.FlagEvmUpgradeEngineURL); upgradeEngineURL != "" {
+			if upgradeExecutor, err = createExecutionClient(cmd, evm.FlagEvmUpgradeEthURL, evm.FlagEvmUpgradeEngineURL); err != nil {
+				return err
+			}
+		}
 
 		nodeConfig, err := rollcmd.ParseConfig(cmd)
 		if err != nil {
@@ -52,7 +59,7 @@ var RunCmd = &cobra.Command{
 			return err
 		}
 
-		datastore, err := store.NewDefaultKVStore(nodeConfig.RootDir, nodeConfig.DBPath, "evm-single")
+		datastore, err := store.NewKVStore(nodeConfig.DBBackend, nodeConfig.RootDir, nodeConfig.DBPath, "evm-single")
 		if err != nil {
 			return err
 		}
@@ -62,6 +69,16 @@ var RunCmd = &cobra.Command{
 		if err != nil {
 			return fmt.Errorf("failed to load genesis: %w", err)
 		}
+		if err := configureExecutionClient(executor, genesis); err != nil {
+			return err
+		}
+		nodeOptions := node.NodeOptions{}
+		if upgradeExecutor != nil {
+			if err := configureExecutionClient(upgradeExecutor, genesis); err != nil {
+				return err
+			}
+			nodeOptions.UpgradeExecutor = withStateDiffs(cmd, upgradeExecutor)
+		}
 
 		singleMetrics, err := single.DefaultMetricsProvider(nodeConfig.Instrumentation.IsPrometheusEnabled())(genesis.ChainID)
 		if err != nil {
@@ -81,6 +98,16 @@ var RunCmd = &cobra.Command{
 		if err != nil {
 			return err
 		}
+		if err := sequencer.AddHTTPOrderflowSources(nodeConfig.Node.OrderflowSources); err != nil {
+			return err
+		}
+		txPolicy, err := parseTxPolicy(cmd)
+		if err != nil {
+			return err
+		}
+		if !txPolicy.IsZero() {
+			sequencer.SetTxFilter(evm.TxDecoder{}, txPolicy)
+		}
 
 		nodeKey, err := key.LoadNodeKey(filepath.Dir(nodeConfig.ConfigPath()))
 		if err != nil {
@@ -92,7 +119,7 @@ var RunCmd = &cobra.Command{
 			return err
 		}
 
-		return rollcmd.StartNode(logger, cmd, executor, sequencer, &daJrpc.DA, p2pClient, datastore, nodeConfig, genesis, node.NodeOptions{})
+		return rollcmd.StartNode(logger, cmd, withStateDiffs(cmd, executor), sequencer, &daJrpc.DA, p2pClient, datastore, nodeConfig, genesis, nodeOptions)
 	},
 }
 
@@ -101,15 +128,17 @@ func init() {
 	addFlags(RunCmd)
 }
 
-func createExecutionClient(cmd *cobra.Command) (execution.Executor, error) {
+// createExecutionClient creates the client of the execution client serving the Ethereum JSON-RPC
+// and Engine API at the URLs of the flags.
+func createExecutionClient(cmd *cobra.Command, ethURLFlag, engineURLFlag string) (*evm.EngineClient, error) {
 	// Read execution client parameters from flags
-	ethURL, err := cmd.Flags().GetString(evm.FlagEvmEthURL)
+	ethURL, err := cmd.Flags().GetString(ethURLFlag)
 	if err != nil {
-		return nil, fmt.Errorf("failed to get '%s' flag: %w", evm.FlagEvmEthURL, err)
+		return nil, fmt.Errorf("failed to get '%s' flag: %w", ethURLFlag, err)
 	}
-	engineURL, err := cmd.Flags().GetString(evm.FlagEvmEngineURL)
+	engineURL, err := cmd.Flags().GetString(engineURLFlag)
 	if err != nil {
-		return nil, fmt.Errorf("failed to get '%s' flag: %w", evm.FlagEvmEngineURL, err)
+		return nil, fmt.Errorf("failed to get '%s' flag: %w", engineURLFlag, err)
 	}
 	jwtSecret, err := cmd.Flags().GetString(evm.FlagEvmJWTSecret)
 	if err != nil {
@@ -131,6 +160,32 @@ func createExecutionClient(cmd *cobra.Command) (execution.Executor, error) {
 	return evm.NewEngineExecutionClient(ethURL, engineURL, jwtSecret, genesisHash, feeRecipient)
 }
 
+// configureExecutionClient applies the execution parameters of the genesis to the client.
+func configureExecutionClient(executor *evm.EngineClient, genesis genesispkg.Genesis) error {
+	if genesis.FeeMarket != nil {
+		executor.SetFeeMarketParams(evm.FeeMarketParams{
+			BaseFeeFloor: genesis.FeeMarket.BaseFeeFloor,
+			TargetGas:    genesis.FeeMarket.TargetGas,
+		})
+	}
+	if genesis.SystemCalls != nil && genesis.SystemCalls.Contract != "" {
+		if !common.IsHexAddress(genesis.SystemCalls.Contract) {
+			return fmt.Errorf("invalid system calls contract address %q in genesis", genesis.SystemCalls.Contract)
+		}
+		executor.SetSystemContract(common.HexToAddress(genesis.SystemCalls.Contract))
+	}
+	return nil
+}
+
+// withStateDiffs returns the executor of the client, reporting the state diffs of its blocks if
+// enabled by the flags.
+func withStateDiffs(cmd *cobra.Command, executor *evm.EngineClient) execution.Executor {
+	if stateDiffs, _ := cmd.Flags().GetBool(evm.FlagEvmStateDiffs); stateDiffs {
+		return &evm.StateDiffClient{EngineClient: executor}
+	}
+	return executor
+}
+
 // addFlags adds flags related to the EVM execution client
 func addFlags(cmd *cobra.Command) {
 	cmd.Flags().String(evm.FlagEvmEthURL, "http://localhost:8545", "URL of the Ethereum JSON-RPC endpoint")
@@ -138,4 +193,42 @@ func addFlags(cmd *cobra.Command) {
 	cmd.Flags().String(evm.FlagEvmJWTSecret, "", "The JWT secret for authentication with the execution client")
 	cmd.Flags().String(evm.FlagEvmGenesisHash, "", "Hash of the genesis block")
 	cmd.Flags().String(evm.FlagEvmFeeRecipient, "", "Address that will receive transaction fees")
+	cmd.Flags().String(evm.FlagEvmUpgradeEthURL, "http://localhost:8645", "URL of the Ethereum JSON-RPC endpoint of the execution client to upgrade to")
+	cmd.Flags().String(evm.FlagEvmUpgradeEngineURL, "", "URL of the Engine API endpoint of an execution client started with an empty state, into which the chain is replayed before the node switches to it (default none)")
+	cmd.Flags().StringSlice(evm.FlagEvmAllowedMethods, nil, "Hex encoded 4-byte method selectors which are the only ones the sequencer batches calls to (default all)")
+	cmd.Flags().StringSlice(evm.FlagEvmDeniedMethods, nil, "Hex encoded 4-byte method selectors the sequencer does not batch calls to")
+	cmd.Flags().Bool(evm.FlagEvmDenyContractCreation, false, "Do not batch transactions creating contracts")
+	cmd.Flags().Bool(evm.FlagEvmStateDiffs, false, "Store the state diff of every block, traced by the execution client, which must serve the debug namespace")
+}
+
+// parseTxPolicy returns the transaction policy of the sequencer set by the flags.
+func parseTxPolicy(cmd *cobra.Command) (single.TxPolicy, error) {
+	var policy single.TxPolicy
+	var err error
+	if policy.AllowedMethods, err = parseMethodSelectors(cmd, evm.FlagEvmAllowedMethods); err != nil {
+		return policy, err
+	}
+	if policy.DeniedMethods, err = parseMethodSelectors(cmd, evm.FlagEvmDeniedMethods); err != nil {
+		return policy, err
+	}
+	if policy.DenyContractCreation, err = cmd.Flags().GetBool(evm.FlagEvmDenyContractCreation); err != nil {
+		return policy, fmt.Errorf("failed to get '%s' flag: %w", evm.FlagEvmDenyContractCreation, err)
+	}
+	return policy, nil
+}
+
+func parseMethodSelectors(cmd *cobra.Command, flag string) ([][]byte, error) {
+	values, err := cmd.Flags().GetStringSlice(flag)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get '%s' flag: %w", flag, err)
+	}
+	selectors := make([][]byte, 0, len(values))
+	for _, value := range values {
+		selector, err := hex.DecodeString(strings.TrimPrefix(value, "0x"))
+		if err != nil || len(selector) != 4 {
+			return nil, fmt.Errorf("invalid method selector %q in '%s' flag: expected 4 hex encoded bytes", value, flag)
+		}
+		selectors = append(selectors, selector)
+	}
+	return selectors, nil
 }
@@ -31,6 +31,17 @@ func main() {
 		rollcmd.NetInfoCmd,
 		rollcmd.StoreUnsafeCleanCmd,
 		rollcmd.KeysCmd(),
+		rollcmd.ConfigCmd(),
+		rollcmd.DAMappingCmd(),
+		rollcmd.SyncStatusCmd(),
+		rollcmd.QueryCmd(),
+		rollcmd.FetchGenesisCmd(),
+		rollcmd.PruneHeightsCmd("evm-single"),
+		rollcmd.RestoreHeightsCmd("evm-single", rollcmd.NewDA),
+		rollcmd.SnapshotCmd("evm-single"),
+		rollcmd.MigrateStoreCmd("evm-single"),
+		rollcmd.ScaffoldCmd(),
+		cmd.RelayHeadersCmd,
 	)
 
 	if err := rootCmd.Execute(); err != nil {
@@ -59,7 +59,7 @@ The execution client must implement the Evolve execution gRPC interface.`,
 		}
 
 		// Create datastore
-		datastore, err := store.NewDefaultKVStore(nodeConfig.RootDir, nodeConfig.DBPath, "grpc-single")
+		datastore, err := store.NewKVStore(nodeConfig.DBBackend, nodeConfig.RootDir, nodeConfig.DBPath, "grpc-single")
 		if err != nil {
 			return err
 		}
@@ -90,6 +90,9 @@ The execution client must implement the Evolve execution gRPC interface.`,
 		if err != nil {
 			return err
 		}
+		if err := sequencer.AddHTTPOrderflowSources(nodeConfig.Node.OrderflowSources); err != nil {
+			return err
+		}
 
 		// Load node key
 		nodeKey, err := key.LoadNodeKey(filepath.Dir(nodeConfig.ConfigPath()))
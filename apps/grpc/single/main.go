@@ -31,6 +31,16 @@ the Evolve execution gRPC interface.`,
 		evcmd.NetInfoCmd,
 		evcmd.StoreUnsafeCleanCmd,
 		evcmd.KeysCmd(),
+		evcmd.ConfigCmd(),
+		evcmd.DAMappingCmd(),
+		evcmd.SyncStatusCmd(),
+		evcmd.QueryCmd(),
+		evcmd.FetchGenesisCmd(),
+		evcmd.PruneHeightsCmd("grpc-single"),
+		evcmd.RestoreHeightsCmd("grpc-single", evcmd.NewDA),
+		evcmd.SnapshotCmd("grpc-single"),
+		evcmd.MigrateStoreCmd("grpc-single"),
+		evcmd.ScaffoldCmd(),
 	)
 
 	if err := rootCmd.Execute(); err != nil {
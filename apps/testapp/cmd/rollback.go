@@ -7,6 +7,7 @@ import (
 
 	kvexecutor "github.com/evstack/ev-node/apps/testapp/kv"
 	rollcmd "github.com/evstack/ev-node/pkg/cmd"
+	"github.com/evstack/ev-node/pkg/journal"
 	"github.com/evstack/ev-node/pkg/store"
 	"github.com/spf13/cobra"
 )
@@ -24,7 +25,7 @@ var RollbackCmd = &cobra.Command{
 		ctx, cancel := context.WithCancel(context.Background())
 		defer cancel()
 
-		datastore, err := store.NewDefaultKVStore(nodeConfig.RootDir, nodeConfig.DBPath, "testapp")
+		datastore, err := store.NewKVStore(nodeConfig.DBBackend, nodeConfig.RootDir, nodeConfig.DBPath, "testapp")
 		if err != nil {
 			return err
 		}
@@ -59,6 +60,13 @@ var RollbackCmd = &cobra.Command{
 			return fmt.Errorf("rollback failed: %w", err)
 		}
 
+		if err := journal.New(storeWrapper).Record(ctx, journal.EventRollback, "node rolled back", map[string]string{
+			"from": strconv.FormatUint(currentHeight, 10),
+			"to":   strconv.FormatUint(targetHeight, 10),
+		}); err != nil {
+			cmd.PrintErrf("failed to record rollback in event journal: %v\n", err)
+		}
+
 		cmd.Println("Rollback completed successfully")
 		return nil
 	},
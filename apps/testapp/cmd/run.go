@@ -13,9 +13,11 @@ import (
 	"github.com/evstack/ev-node/da/jsonrpc"
 	"github.com/evstack/ev-node/node"
 	rollcmd "github.com/evstack/ev-node/pkg/cmd"
+	rollconf "github.com/evstack/ev-node/pkg/config"
 	genesispkg "github.com/evstack/ev-node/pkg/genesis"
 	"github.com/evstack/ev-node/pkg/p2p"
 	"github.com/evstack/ev-node/pkg/p2p/key"
+	rpcclient "github.com/evstack/ev-node/pkg/rpc/client"
 	"github.com/evstack/ev-node/pkg/store"
 	"github.com/evstack/ev-node/sequencers/single"
 )
@@ -62,7 +64,7 @@ var RunCmd = &cobra.Command{
 			return err
 		}
 
-		datastore, err := store.NewDefaultKVStore(nodeConfig.RootDir, nodeConfig.DBPath, "testapp")
+		datastore, err := store.NewKVStore(nodeConfig.DBBackend, nodeConfig.RootDir, nodeConfig.DBPath, "testapp")
 		if err != nil {
 			return err
 		}
@@ -75,6 +77,11 @@ var RunCmd = &cobra.Command{
 		// Start the KV executor HTTP server
 		if kvEndpoint != "" { // Only start if endpoint is provided
 			httpServer := kvexecutor.NewHTTPServer(executor, kvEndpoint)
+			var clientOpts []rpcclient.Option
+			if nodeConfig.RPC.AuthToken != "" {
+				clientOpts = append(clientOpts, rpcclient.WithBearerToken(nodeConfig.RPC.AuthToken))
+			}
+			httpServer.SetTxInclusionWaiter(rpcclient.NewClient(rollconf.RPCClientURL(nodeConfig.RPC.Address), clientOpts...))
 			err = httpServer.Start(ctx) // Use the main context for lifecycle management
 			if err != nil {
 				return fmt.Errorf("failed to start KV executor HTTP server: %w", err)
@@ -102,6 +109,9 @@ var RunCmd = &cobra.Command{
 		if err != nil {
 			return err
 		}
+		if err := sequencer.AddHTTPOrderflowSources(nodeConfig.Node.OrderflowSources); err != nil {
+			return err
+		}
 
 		p2pClient, err := p2p.NewClient(nodeConfig.P2P, nodeKey.PrivKey, datastore, genesis.ChainID, logger, p2p.NopMetrics())
 		if err != nil {
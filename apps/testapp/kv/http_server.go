@@ -2,6 +2,7 @@ package executor
 
 import (
 	"context"
+	"crypto/sha256"
 	"encoding/json"
 	"errors"
 	"fmt"
@@ -11,12 +12,21 @@ import (
 
 	ds "github.com/ipfs/go-datastore"
 	"github.com/ipfs/go-datastore/query"
+
+	pb "github.com/evstack/ev-node/types/pb/evnode/v1"
 )
 
+// TxInclusionWaiter waits for a transaction to be included in a block, such as the RPC client
+// of the node.
+type TxInclusionWaiter interface {
+	WaitForTxInclusion(ctx context.Context, txHash []byte, timeout time.Duration) (*pb.GetTxStatusResponse, error)
+}
+
 // HTTPServer wraps a KVExecutor and provides an HTTP interface for it
 type HTTPServer struct {
 	executor *KVExecutor
 	server   *http.Server
+	waiter   TxInclusionWaiter
 }
 
 // NewHTTPServer creates a new HTTP server for the KVExecutor
@@ -39,6 +49,11 @@ func NewHTTPServer(executor *KVExecutor, listenAddr string) *HTTPServer {
 	return hs
 }
 
+// SetTxInclusionWaiter enables the wait parameter of transaction submissions.
+func (hs *HTTPServer) SetTxInclusionWaiter(waiter TxInclusionWaiter) {
+	hs.waiter = waiter
+}
+
 // Start begins listening for HTTP requests
 func (hs *HTTPServer) Start(ctx context.Context) error {
 	// Start the server in a goroutine
@@ -83,12 +98,27 @@ func (hs *HTTPServer) Stop() error {
 // It is recommended to use transactions in the format "key=value" to be consistent
 // with the KVExecutor implementation that parses transactions in this format.
 // Example: "mykey=myvalue"
+// POST /tx?wait=10s responds once the transaction is included in a block, with JSON
+// {"height": 12, "index": 0}, or with 504 if it is not included in time.
 func (hs *HTTPServer) handleTx(w http.ResponseWriter, r *http.Request) {
 	if r.Method != http.MethodPost {
 		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
 		return
 	}
 
+	var wait time.Duration
+	if param := r.URL.Query().Get("wait"); param != "" {
+		if hs.waiter == nil {
+			http.Error(w, "Waiting for inclusion is not supported", http.StatusNotImplemented)
+			return
+		}
+		var err error
+		if wait, err = time.ParseDuration(param); err != nil || wait <= 0 {
+			http.Error(w, "Invalid wait parameter", http.StatusBadRequest)
+			return
+		}
+	}
+
 	body, err := io.ReadAll(r.Body)
 	if err != nil {
 		http.Error(w, "Failed to read request body", http.StatusBadRequest)
@@ -106,6 +136,10 @@ func (hs *HTTPServer) handleTx(w http.ResponseWriter, r *http.Request) {
 	}
 
 	hs.executor.InjectTx(body)
+	if wait > 0 {
+		hs.waitForInclusion(w, r, body, wait)
+		return
+	}
 	w.WriteHeader(http.StatusAccepted)
 	_, err = w.Write([]byte("Transaction accepted"))
 	if err != nil {
@@ -113,6 +147,29 @@ func (hs *HTTPServer) handleTx(w http.ResponseWriter, r *http.Request) {
 	}
 }
 
+// waitForInclusion responds with the height and index of tx once it is included in a block.
+func (hs *HTTPServer) waitForInclusion(w http.ResponseWriter, r *http.Request, tx []byte, wait time.Duration) {
+	txHash := sha256.Sum256(tx)
+	status, err := hs.waiter.WaitForTxInclusion(r.Context(), txHash[:], wait)
+	if err != nil {
+		http.Error(w, "Failed to get transaction status", http.StatusBadGateway)
+		fmt.Printf("Error waiting for transaction inclusion: %v\n", err)
+		return
+	}
+	if status.Status != pb.TxStatus_TX_STATUS_INCLUDED {
+		http.Error(w, "Transaction not included in time", http.StatusGatewayTimeout)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(w).Encode(struct {
+		Height uint64 `json:"height"`
+		Index  uint32 `json:"index"`
+	}{status.Height, status.Index}); err != nil {
+		fmt.Printf("Error encoding JSON response: %v\n", err)
+	}
+}
+
 // handleKV handles direct key-value operations (GET/POST) against the database
 // GET /kv?key=somekey - retrieve a value
 // POST /kv with JSON {"key": "somekey", "value": "somevalue"} - set a value
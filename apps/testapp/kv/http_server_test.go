@@ -2,6 +2,7 @@ package executor
 
 import (
 	"context"
+	"crypto/sha256"
 	"errors"
 	"fmt"
 	"net"
@@ -10,6 +11,8 @@ import (
 	"strings"
 	"testing"
 	"time"
+
+	pb "github.com/evstack/ev-node/types/pb/evnode/v1"
 )
 
 func TestHandleTx(t *testing.T) {
@@ -93,6 +96,55 @@ func TestHandleTx(t *testing.T) {
 	}
 }
 
+// includedTxs is a TxInclusionWaiter including the txs at the height of the given map.
+type includedTxs map[[32]byte]uint64
+
+func (i includedTxs) WaitForTxInclusion(_ context.Context, txHash []byte, _ time.Duration) (*pb.GetTxStatusResponse, error) {
+	height, ok := i[[32]byte(txHash)]
+	if !ok {
+		return &pb.GetTxStatusResponse{Status: pb.TxStatus_TX_STATUS_PENDING}, nil
+	}
+	return &pb.GetTxStatusResponse{Status: pb.TxStatus_TX_STATUS_INCLUDED, Height: height, Index: 3}, nil
+}
+
+func TestHandleTxWait(t *testing.T) {
+	exec, err := NewKVExecutor(t.TempDir(), "testdb")
+	if err != nil {
+		t.Fatalf("Failed to create KVExecutor: %v", err)
+	}
+	server := NewHTTPServer(exec, ":0")
+
+	post := func(target, body string) *httptest.ResponseRecorder {
+		rr := httptest.NewRecorder()
+		server.handleTx(rr, httptest.NewRequest(http.MethodPost, target, strings.NewReader(body)))
+		return rr
+	}
+
+	if rr := post("/tx?wait=1s", "k=v"); rr.Code != http.StatusNotImplemented {
+		t.Errorf("expected status %d without waiter, got %d", http.StatusNotImplemented, rr.Code)
+	}
+
+	server.SetTxInclusionWaiter(includedTxs{sha256.Sum256([]byte("included=1")): 12})
+
+	rr := post("/tx?wait=1s", "included=1")
+	if rr.Code != http.StatusOK {
+		t.Fatalf("expected status %d, got %d", http.StatusOK, rr.Code)
+	}
+	if got, want := rr.Body.String(), `{"height":12,"index":3}`+"\n"; got != want {
+		t.Errorf("expected body %q, got %q", want, got)
+	}
+
+	if rr := post("/tx?wait=1s", "pending=1"); rr.Code != http.StatusGatewayTimeout {
+		t.Errorf("expected status %d, got %d", http.StatusGatewayTimeout, rr.Code)
+	}
+	if rr := post("/tx?wait=soon", "k=v"); rr.Code != http.StatusBadRequest {
+		t.Errorf("expected status %d, got %d", http.StatusBadRequest, rr.Code)
+	}
+	if rr := post("/tx", "k=v"); rr.Code != http.StatusAccepted {
+		t.Errorf("expected status %d, got %d", http.StatusAccepted, rr.Code)
+	}
+}
+
 func TestHandleKV_Get(t *testing.T) {
 	tests := []struct {
 		name           string
@@ -8,6 +8,7 @@ import (
 	"strings"
 	"time"
 
+	"github.com/evstack/ev-node/core/execution"
 	"github.com/evstack/ev-node/pkg/store"
 	ds "github.com/ipfs/go-datastore"
 	"github.com/ipfs/go-datastore/query"
@@ -49,6 +50,11 @@ func NewKVExecutor(rootdir, dbpath string) (*KVExecutor, error) {
 	}, nil
 }
 
+// ComponentName returns the name of the executor reported by the node.
+func (k *KVExecutor) ComponentName() string {
+	return "kv"
+}
+
 // GetStoreValue is a helper for the HTTP interface to retrieve the value for a key from the database.
 // It searches across all block heights to find the latest value for the given key.
 func (k *KVExecutor) GetStoreValue(ctx context.Context, key string) (string, bool) {
@@ -293,6 +299,31 @@ func (k *KVExecutor) SetFinal(ctx context.Context, blockHeight uint64) error {
 	return k.db.Put(ctx, finalizedHeightKey, []byte(fmt.Sprintf("%d", blockHeight)))
 }
 
+// GetStateDiff returns the keys written by the block at the given height with their new values, sorted by key.
+func (k *KVExecutor) GetStateDiff(ctx context.Context, blockHeight uint64) ([]execution.StateChange, error) {
+	prefix := heightKeyPrefix.ChildString(fmt.Sprintf("%d", blockHeight)).String() + "/"
+	results, err := k.db.Query(ctx, query.Query{Prefix: prefix})
+	if err != nil {
+		return nil, fmt.Errorf("failed to query keys for state diff: %w", err)
+	}
+	defer results.Close()
+
+	changes := make([]execution.StateChange, 0)
+	for result := range results.Next() {
+		if result.Error != nil {
+			return nil, fmt.Errorf("error iterating query results: %w", result.Error)
+		}
+		changes = append(changes, execution.StateChange{
+			Key:   []byte(strings.TrimPrefix(result.Key, prefix)),
+			Value: result.Value,
+		})
+	}
+	sort.Slice(changes, func(i, j int) bool {
+		return string(changes[i].Key) < string(changes[j].Key)
+	})
+	return changes, nil
+}
+
 // InjectTx adds a transaction to the mempool channel.
 // Uses a non-blocking send to avoid blocking the caller if the channel is full.
 func (k *KVExecutor) InjectTx(tx []byte) {
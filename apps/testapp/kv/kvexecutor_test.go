@@ -7,6 +7,8 @@ import (
 	"strings"
 	"testing"
 	"time"
+
+	"github.com/evstack/ev-node/core/execution"
 )
 
 func TestInitChain_Idempotency(t *testing.T) {
@@ -135,6 +137,41 @@ func TestExecuteTxs_Valid(t *testing.T) {
 	}
 }
 
+func TestGetStateDiff(t *testing.T) {
+	exec, err := NewKVExecutor(t.TempDir(), "testdb")
+	if err != nil {
+		t.Fatalf("Failed to create KVExecutor: %v", err)
+	}
+	ctx := context.Background()
+
+	if _, _, err := exec.ExecuteTxs(ctx, [][]byte{[]byte("b=1"), []byte("a=2")}, 1, time.Now(), []byte("")); err != nil {
+		t.Fatalf("ExecuteTxs failed: %v", err)
+	}
+	if _, _, err := exec.ExecuteTxs(ctx, [][]byte{[]byte("a=3")}, 11, time.Now(), []byte("")); err != nil {
+		t.Fatalf("ExecuteTxs failed: %v", err)
+	}
+
+	changes, err := exec.GetStateDiff(ctx, 1)
+	if err != nil {
+		t.Fatalf("GetStateDiff failed: %v", err)
+	}
+	expected := []execution.StateChange{
+		{Key: []byte("a"), Value: []byte("2")},
+		{Key: []byte("b"), Value: []byte("1")},
+	}
+	if !reflect.DeepEqual(changes, expected) {
+		t.Errorf("Expected state diff %v, got %v", expected, changes)
+	}
+
+	changes, err = exec.GetStateDiff(ctx, 2)
+	if err != nil {
+		t.Fatalf("GetStateDiff failed: %v", err)
+	}
+	if len(changes) != 0 {
+		t.Errorf("Expected empty state diff for height without txs, got %v", changes)
+	}
+}
+
 func TestExecuteTxs_Invalid(t *testing.T) {
 	exec, err := NewKVExecutor(t.TempDir(), "testdb")
 	if err != nil {
@@ -20,6 +20,16 @@ func main() {
 		rollcmd.NetInfoCmd,
 		rollcmd.StoreUnsafeCleanCmd,
 		rollcmd.KeysCmd(),
+		rollcmd.ConfigCmd(),
+		rollcmd.DAMappingCmd(),
+		rollcmd.SyncStatusCmd(),
+		rollcmd.QueryCmd(),
+		rollcmd.FetchGenesisCmd(),
+		rollcmd.PruneHeightsCmd("testapp"),
+		rollcmd.RestoreHeightsCmd("testapp", rollcmd.NewDA),
+		rollcmd.SnapshotCmd("testapp"),
+		rollcmd.MigrateStoreCmd("testapp"),
+		rollcmd.ScaffoldCmd(),
 		cmds.RollbackCmd,
 		initCmd,
 	)
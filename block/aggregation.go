@@ -17,10 +17,10 @@ func (m *Manager) AggregationLoop(ctx context.Context, errCh chan<- error) {
 	var delay time.Duration
 
 	if height < initialHeight {
-		delay = time.Until(m.genesis.GenesisDAStartTime.Add(m.config.Node.BlockTime.Duration))
+		delay = time.Until(m.genesis.GenesisDAStartTime.Add(m.blockTime()))
 	} else {
 		lastBlockTime := m.getLastBlockTime()
-		delay = time.Until(lastBlockTime.Add(m.config.Node.BlockTime.Duration))
+		delay = time.Until(lastBlockTime.Add(m.blockTime()))
 	}
 
 	if delay > 0 {
@@ -74,7 +74,7 @@ func (m *Manager) lazyAggregationLoop(ctx context.Context, blockTimer *time.Time
 				m.txsAvailable = false
 			} else {
 				// Ensure we keep ticking even when there are no txs
-				blockTimer.Reset(m.config.Node.BlockTime.Duration)
+				blockTimer.Reset(m.blockTime())
 			}
 		case <-m.txNotifyCh:
 			m.txsAvailable = true
@@ -95,7 +95,7 @@ func (m *Manager) produceBlock(ctx context.Context, mode string, lazyTimer, bloc
 
 	// Reset both timers for the next aggregation window
 	lazyTimer.Reset(getRemainingSleep(start, m.config.Node.LazyBlockInterval.Duration))
-	blockTimer.Reset(getRemainingSleep(start, m.config.Node.BlockTime.Duration))
+	blockTimer.Reset(getRemainingSleep(start, m.blockTime()))
 
 	return nil
 }
@@ -115,7 +115,7 @@ func (m *Manager) normalAggregationLoop(ctx context.Context, blockTimer *time.Ti
 
 			// Reset the blockTimer to signal the next block production
 			// period based on the block time.
-			blockTimer.Reset(getRemainingSleep(start, m.config.Node.BlockTime.Duration))
+			blockTimer.Reset(getRemainingSleep(start, m.blockTime()))
 
 		case <-m.txNotifyCh:
 			// Transaction notifications are intentionally ignored in normal mode
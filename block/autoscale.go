@@ -0,0 +1,122 @@
+package block
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/evstack/ev-node/pkg/genesis"
+	storepkg "github.com/evstack/ev-node/pkg/store"
+)
+
+const (
+	// autoscaleWindow is the number of consecutive blocks with transactions, or without, after
+	// which an autoscaling aggregator shrinks, or relaxes, its block time.
+	autoscaleWindow = 5
+	// autoscaleShrink and autoscaleRelax are the factors applied to the block time, in percent.
+	autoscaleShrink = 80
+	autoscaleRelax  = 125
+)
+
+// blockTimeAutoscaler adjusts the block time of an aggregator to its load. While blocks keep
+// carrying transactions, the block time shrinks toward a minimum, lowering the latency of the
+// transactions; while blocks are empty, it relaxes back toward the base block time, lowering the
+// number of blocks, and so the DA cost, of an idle chain. The block time stays within the bounds of
+// the genesis.
+type blockTimeAutoscaler struct {
+	bounds genesis.BlockTimeBounds
+	// floor is the block time shrunk toward, 0 for the lower bound
+	floor time.Duration
+
+	mu sync.Mutex
+	// current is the block time, 0 until the first block
+	current time.Duration
+	// busy and idle are the numbers of consecutive blocks with and without transactions
+	busy, idle int
+}
+
+// newBlockTimeAutoscaler returns the autoscaler of an aggregator configured to autoscale its block
+// time, which requires block time bounds in the genesis.
+func newBlockTimeAutoscaler(gen genesis.Genesis, floor time.Duration) (*blockTimeAutoscaler, error) {
+	if gen.BlockTimeBounds == nil {
+		return nil, fmt.Errorf("autoscaling the block time requires block_time_bounds in the genesis")
+	}
+	return &blockTimeAutoscaler{bounds: *gen.BlockTimeBounds, floor: floor}, nil
+}
+
+// limits returns the lowest and highest block times for the base block time.
+func (a *blockTimeAutoscaler) limits(base time.Duration) (time.Duration, time.Duration) {
+	upper := min(max(base, a.bounds.Min.Duration), a.bounds.Max.Duration)
+	lower := a.bounds.Min.Duration
+	if a.floor > lower {
+		lower = min(a.floor, upper)
+	}
+	return lower, upper
+}
+
+// blockTime returns the block time for the base block time, i.e. the configured one or the one
+// set by a system call.
+func (a *blockTimeAutoscaler) blockTime(base time.Duration) time.Duration {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	lower, upper := a.limits(base)
+	if a.current == 0 {
+		return upper
+	}
+	return min(max(a.current, lower), upper)
+}
+
+// observe accounts a block with txs transactions, and returns the block time for the next blocks.
+func (a *blockTimeAutoscaler) observe(txs int, base time.Duration) time.Duration {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	lower, upper := a.limits(base)
+	if a.current == 0 {
+		a.current = upper
+	}
+	if txs > 0 {
+		a.busy, a.idle = a.busy+1, 0
+	} else {
+		a.busy, a.idle = 0, a.idle+1
+	}
+	switch {
+	case a.busy >= autoscaleWindow:
+		a.current, a.busy = a.current*autoscaleShrink/100, 0
+	case a.idle >= autoscaleWindow:
+		a.current, a.idle = a.current*autoscaleRelax/100, 0
+	}
+	a.current = min(max(a.current, lower), upper)
+	return a.current
+}
+
+// autoscaleBlockTime records the block time the block at height was produced with, and adjusts the
+// block time to the number of transactions of the block. The block is already committed, and the
+// recorded block time is informative, so failures are logged.
+func (m *Manager) autoscaleBlockTime(ctx context.Context, height uint64, txs int) {
+	if m.autoscaler == nil {
+		return
+	}
+
+	blockTime := m.blockTime()
+	if err := m.store.SetMetadata(ctx, fmt.Sprintf("%s/%d", storepkg.BlockTimeKey, height), []byte(blockTime.String())); err != nil {
+		m.logger.Error().Err(err).Uint64("height", height).Msg("failed to save block time")
+	}
+
+	next := m.autoscaler.observe(txs, m.baseBlockTime())
+	if next != blockTime {
+		m.logger.Info().Dur("from", blockTime).Dur("to", next).Uint64("height", height).Msg("autoscaled block time")
+	}
+}
+
+// earliestBlockTime returns the earliest timestamp of the block at height after a block
+// timestamped lastBlockTime: the lower block time bound of the genesis bounds the interval between
+// blocks. The first block is not bounded.
+func earliestBlockTime(g genesis.Genesis, height uint64, lastBlockTime time.Time) time.Time {
+	if g.BlockTimeBounds == nil || height <= g.InitialHeight {
+		return time.Time{}
+	}
+	return lastBlockTime.Add(g.BlockTimeBounds.Min.Duration)
+}
@@ -0,0 +1,102 @@
+package block
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/evstack/ev-node/pkg/config"
+	"github.com/evstack/ev-node/pkg/genesis"
+	storepkg "github.com/evstack/ev-node/pkg/store"
+)
+
+func testBounds(lower, upper time.Duration) genesis.Genesis {
+	return genesis.Genesis{BlockTimeBounds: &genesis.BlockTimeBounds{
+		Min: genesis.Duration{Duration: lower},
+		Max: genesis.Duration{Duration: upper},
+	}}
+}
+
+func TestNewBlockTimeAutoscaler_RequiresBounds(t *testing.T) {
+	_, err := newBlockTimeAutoscaler(genesis.Genesis{}, 0)
+	require.ErrorContains(t, err, "block_time_bounds")
+}
+
+// TestBlockTimeAutoscaler verifies that the block time shrinks toward the floor under sustained
+// load, relaxes toward the base block time when idle, and stays within the genesis bounds.
+func TestBlockTimeAutoscaler(t *testing.T) {
+	a, err := newBlockTimeAutoscaler(testBounds(100*time.Millisecond, 2*time.Second), 200*time.Millisecond)
+	require.NoError(t, err)
+	base := time.Second
+	assert.Equal(t, time.Second, a.blockTime(base))
+
+	// a few busy blocks are not sustained load
+	for range autoscaleWindow - 1 {
+		assert.Equal(t, time.Second, a.observe(1, base))
+	}
+	assert.Equal(t, 800*time.Millisecond, a.observe(1, base))
+
+	// shrinks down to the floor
+	for range 20 * autoscaleWindow {
+		a.observe(10, base)
+	}
+	assert.Equal(t, 200*time.Millisecond, a.blockTime(base))
+
+	// relaxes back to the base block time
+	for range 20 * autoscaleWindow {
+		a.observe(0, base)
+	}
+	assert.Equal(t, time.Second, a.blockTime(base))
+
+	// a base block time out of the bounds is clamped
+	assert.Equal(t, 100*time.Millisecond, a.blockTime(time.Millisecond))
+	fresh, err := newBlockTimeAutoscaler(testBounds(100*time.Millisecond, 2*time.Second), 0)
+	require.NoError(t, err)
+	assert.Equal(t, 2*time.Second, fresh.blockTime(time.Minute))
+}
+
+func TestEarliestBlockTime(t *testing.T) {
+	last := time.Unix(1000, 0)
+	gen := testBounds(100*time.Millisecond, time.Second)
+	gen.InitialHeight = 1
+	assert.Equal(t, last.Add(100*time.Millisecond), earliestBlockTime(gen, 2, last))
+	// the first block and chains without bounds are not bounded
+	assert.True(t, earliestBlockTime(gen, 1, last).IsZero())
+	assert.True(t, earliestBlockTime(genesis.Genesis{InitialHeight: 1}, 2, last).IsZero())
+
+	// aggregators which do not autoscale their block time keep it within the bounds
+	cfg := config.DefaultConfig
+	cfg.Node.BlockTime.Duration = 10 * time.Millisecond
+	m := &Manager{config: cfg, genesis: gen}
+	assert.Equal(t, 100*time.Millisecond, m.blockTime())
+}
+
+func TestManager_AutoscaleBlockTime(t *testing.T) {
+	ctx := context.Background()
+	kv, err := storepkg.NewDefaultInMemoryKVStore()
+	require.NoError(t, err)
+	store := storepkg.New(kv)
+
+	cfg := config.DefaultConfig
+	cfg.Node.BlockTime.Duration = time.Second
+	autoscaler, err := newBlockTimeAutoscaler(testBounds(100*time.Millisecond, time.Second), 0)
+	require.NoError(t, err)
+	m := &Manager{store: store, config: cfg, autoscaler: autoscaler, logger: zerolog.Nop(), lastStateMtx: &sync.RWMutex{}}
+
+	for height := uint64(1); height <= autoscaleWindow+1; height++ {
+		m.autoscaleBlockTime(ctx, height, 1)
+	}
+	value, err := store.GetMetadata(ctx, fmt.Sprintf("%s/%d", storepkg.BlockTimeKey, 1))
+	require.NoError(t, err)
+	assert.Equal(t, "1s", string(value))
+	value, err = store.GetMetadata(ctx, fmt.Sprintf("%s/%d", storepkg.BlockTimeKey, autoscaleWindow+1))
+	require.NoError(t, err)
+	assert.Equal(t, "800ms", string(value))
+	assert.Equal(t, 800*time.Millisecond, m.blockTime())
+}
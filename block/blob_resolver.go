@@ -0,0 +1,119 @@
+package block
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"time"
+
+	"github.com/evstack/ev-node/pkg/genesis"
+	"github.com/evstack/ev-node/types"
+)
+
+// DefaultBlobResolveTimeout is the timeout of the requests of NewHTTPBlobResolver without a
+// client.
+const DefaultBlobResolveTimeout = 30 * time.Second
+
+// BlobResolver fetches the payloads of the blob pointers of blocks, available outside of the DA
+// layer of the chain. The payloads are verified against the commitments of the pointers by the
+// manager, so resolvers may fetch them from untrusted sources.
+type BlobResolver interface {
+	ResolveBlob(ctx context.Context, pointer types.BlobPointer) ([]byte, error)
+}
+
+// BlobResolverFunc is a function implementing BlobResolver.
+type BlobResolverFunc func(ctx context.Context, pointer types.BlobPointer) ([]byte, error)
+
+// ResolveBlob calls f.
+func (f BlobResolverFunc) ResolveBlob(ctx context.Context, pointer types.BlobPointer) ([]byte, error) {
+	return f(ctx, pointer)
+}
+
+// SchemeBlobResolver resolves the blob pointers with the resolver of the scheme of their URI,
+// e.g. "https" or "ipfs".
+type SchemeBlobResolver map[string]BlobResolver
+
+// ResolveBlob resolves the pointer with the resolver of the scheme of its URI.
+func (r SchemeBlobResolver) ResolveBlob(ctx context.Context, pointer types.BlobPointer) ([]byte, error) {
+	u, err := url.Parse(pointer.URI)
+	if err != nil {
+		return nil, fmt.Errorf("invalid blob URI %q: %w", pointer.URI, err)
+	}
+	resolver, ok := r[u.Scheme]
+	if !ok {
+		return nil, fmt.Errorf("no blob resolver for scheme %q", u.Scheme)
+	}
+	return resolver.ResolveBlob(ctx, pointer)
+}
+
+// NewHTTPBlobResolver returns a resolver fetching the payloads of the blob pointers with an HTTP
+// GET of their URI, e.g. from an object store or an IPFS gateway. A nil client is a client with
+// DefaultBlobResolveTimeout. At most types.MaxBlobPayloadSize bytes are read.
+func NewHTTPBlobResolver(client *http.Client) BlobResolver {
+	if client == nil {
+		client = &http.Client{Timeout: DefaultBlobResolveTimeout}
+	}
+	return BlobResolverFunc(func(ctx context.Context, pointer types.BlobPointer) ([]byte, error) {
+		req, err := http.NewRequestWithContext(ctx, http.MethodGet, pointer.URI, nil)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := client.Do(req)
+		if err != nil {
+			return nil, err
+		}
+		defer resp.Body.Close()
+		if resp.StatusCode != http.StatusOK {
+			return nil, fmt.Errorf("unexpected status %s", resp.Status)
+		}
+		// read one byte more than the size, for Verify to reject larger payloads
+		return io.ReadAll(io.LimitReader(resp.Body, int64(min(pointer.Size, types.MaxBlobPayloadSize))+1))
+	})
+}
+
+// resolveTxs returns the transactions of a block to execute. If blob pointers are enabled by the
+// genesis, they are replaced by the transactions of their payloads, fetched with resolver and
+// verified against their commitments. Payloads cannot contain blob pointers themselves.
+func resolveTxs(ctx context.Context, g genesis.Genesis, resolver BlobResolver, txs types.Txs) ([][]byte, error) {
+	resolved := make([][]byte, 0, len(txs))
+	if !g.BlobPointers {
+		for _, tx := range txs {
+			resolved = append(resolved, tx)
+		}
+		return resolved, nil
+	}
+	for i, tx := range txs {
+		pointer, err := types.ParseBlobPointer(tx)
+		if errors.Is(err, types.ErrNotBlobPointer) {
+			resolved = append(resolved, tx)
+			continue
+		}
+		if err != nil {
+			return nil, fmt.Errorf("invalid blob pointer at transaction %d: %w", i, err)
+		}
+		if resolver == nil {
+			return nil, fmt.Errorf("transaction %d points to blob %s, but no blob resolver is configured", i, pointer.URI)
+		}
+		payload, err := resolver.ResolveBlob(ctx, pointer)
+		if err != nil {
+			return nil, fmt.Errorf("failed to resolve blob %s: %w", pointer.URI, err)
+		}
+		if err := pointer.Verify(payload); err != nil {
+			return nil, err
+		}
+		payloadTxs, err := types.UnmarshalBlobPayload(payload)
+		if err != nil {
+			return nil, fmt.Errorf("invalid payload of blob %s: %w", pointer.URI, err)
+		}
+		for _, payloadTx := range payloadTxs {
+			if _, err := types.ParseBlobPointer(payloadTx); !errors.Is(err, types.ErrNotBlobPointer) {
+				return nil, fmt.Errorf("payload of blob %s contains a blob pointer", pointer.URI)
+			}
+			resolved = append(resolved, payloadTx)
+		}
+	}
+	return resolved, nil
+}
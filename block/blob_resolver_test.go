@@ -0,0 +1,82 @@
+package block
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/evstack/ev-node/pkg/genesis"
+	"github.com/evstack/ev-node/types"
+)
+
+func TestResolveTxs(t *testing.T) {
+	ctx := context.Background()
+	payload := types.MarshalBlobPayload(types.Txs{types.Tx("tx2"), types.Tx("tx3")})
+	pointer := types.NewBlobPointer("mem://batch", payload)
+	blobs := map[string][]byte{pointer.URI: payload}
+	resolver := BlobResolverFunc(func(_ context.Context, p types.BlobPointer) ([]byte, error) {
+		payload, ok := blobs[p.URI]
+		if !ok {
+			return nil, errors.New("not found")
+		}
+		return payload, nil
+	})
+
+	gen := genesis.Genesis{ChainID: "test", BlobPointers: true}
+
+	// blob pointers are executed as they are unless enabled by the genesis
+	txs, err := resolveTxs(ctx, genesis.Genesis{ChainID: "test"}, nil, types.Txs{pointer.Tx()})
+	require.NoError(t, err)
+	require.Equal(t, [][]byte{pointer.Tx()}, txs)
+
+	txs, err = resolveTxs(ctx, gen, resolver, types.Txs{types.Tx("tx1"), pointer.Tx(), types.Tx("tx4")})
+	require.NoError(t, err)
+	require.Equal(t, [][]byte{[]byte("tx1"), []byte("tx2"), []byte("tx3"), []byte("tx4")}, txs)
+
+	// blocks without blob pointers do not need a resolver
+	txs, err = resolveTxs(ctx, gen, nil, types.Txs{types.Tx("tx1")})
+	require.NoError(t, err)
+	require.Equal(t, [][]byte{[]byte("tx1")}, txs)
+	_, err = resolveTxs(ctx, gen, nil, types.Txs{pointer.Tx()})
+	require.ErrorContains(t, err, "no blob resolver")
+
+	// payloads are verified against their commitments
+	blobs[pointer.URI] = types.MarshalBlobPayload(types.Txs{types.Tx("tx2"), types.Tx("tx5")})
+	_, err = resolveTxs(ctx, gen, resolver, types.Txs{pointer.Tx()})
+	require.ErrorContains(t, err, "does not match its commitment")
+
+	// payloads cannot point to other payloads
+	nested := types.MarshalBlobPayload(types.Txs{pointer.Tx()})
+	nestedPointer := types.NewBlobPointer("mem://nested", nested)
+	blobs[nestedPointer.URI] = nested
+	_, err = resolveTxs(ctx, gen, resolver, types.Txs{nestedPointer.Tx()})
+	require.ErrorContains(t, err, "contains a blob pointer")
+}
+
+func TestHTTPBlobResolver(t *testing.T) {
+	ctx := context.Background()
+	payload := types.MarshalBlobPayload(types.Txs{types.Tx("tx1")})
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/batch" {
+			http.NotFound(w, r)
+			return
+		}
+		_, _ = w.Write(payload)
+	}))
+	defer server.Close()
+
+	resolver := SchemeBlobResolver{"http": NewHTTPBlobResolver(server.Client())}
+	pointer := types.NewBlobPointer(server.URL+"/batch", payload)
+	resolved, err := resolver.ResolveBlob(ctx, pointer)
+	require.NoError(t, err)
+	require.Equal(t, payload, resolved)
+
+	_, err = resolver.ResolveBlob(ctx, types.NewBlobPointer(server.URL+"/missing", payload))
+	require.ErrorContains(t, err, "404")
+	_, err = resolver.ResolveBlob(ctx, types.NewBlobPointer("ipfs://bafybeigdyrzt", payload))
+	require.ErrorContains(t, err, `no blob resolver for scheme "ipfs"`)
+}
@@ -0,0 +1,77 @@
+package block
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"sync"
+)
+
+// blockMetadataTracker collects the metadata written while a block is produced or applied, such as
+// its state diff, which is saved in the batch committing the block rather than straight to the
+// store, so that no metadata of a height is persisted if its block is not.
+// The zero value is ready to use.
+type blockMetadataTracker struct {
+	mu      sync.Mutex
+	heights map[uint64]map[string][]byte
+}
+
+// set records a metadata value to save with the block at the given height.
+func (t *blockMetadataTracker) set(height uint64, key string, value []byte) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if t.heights == nil {
+		t.heights = make(map[uint64]map[string][]byte)
+	}
+	if t.heights[height] == nil {
+		t.heights[height] = make(map[string][]byte)
+	}
+	t.heights[height][key] = value
+}
+
+// get returns a metadata value recorded for the block at the given height.
+func (t *blockMetadataTracker) get(height uint64, key string) ([]byte, bool) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	value, ok := t.heights[height][key]
+	return value, ok
+}
+
+// pop returns the metadata recorded for the block at the given height and stops tracking it.
+func (t *blockMetadataTracker) pop(height uint64) map[string][]byte {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	values := t.heights[height]
+	delete(t.heights, height)
+	return values
+}
+
+// blockMetadataWriter records the metadata written to it for the block at the given height.
+type blockMetadataWriter struct {
+	tracker *blockMetadataTracker
+	height  uint64
+}
+
+// SetMetadata implements metadataWriter.
+func (w blockMetadataWriter) SetMetadata(_ context.Context, key string, value []byte) error {
+	w.tracker.set(w.height, key, value)
+	return nil
+}
+
+// saveBlockMetadata writes the metadata recorded for the block at the given height, in the batch
+// committing the block. The metadata is dropped even if the commit fails, as it is recorded again
+// when the block is applied again.
+func (m *Manager) saveBlockMetadata(ctx context.Context, w metadataWriter, height uint64) error {
+	values := m.blockMetadata.pop(height)
+	keys := make([]string, 0, len(values))
+	for key := range values {
+		keys = append(keys, key)
+	}
+	slices.Sort(keys)
+	for _, key := range keys {
+		if err := w.SetMetadata(ctx, key, values[key]); err != nil {
+			return fmt.Errorf("failed to save metadata %s: %w", key, err)
+		}
+	}
+	return nil
+}
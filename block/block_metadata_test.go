@@ -0,0 +1,89 @@
+package block
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"testing"
+
+	ds "github.com/ipfs/go-datastore"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/require"
+
+	coreexecutor "github.com/evstack/ev-node/core/execution"
+	coresequencer "github.com/evstack/ev-node/core/sequencer"
+	storepkg "github.com/evstack/ev-node/pkg/store"
+	"github.com/evstack/ev-node/types"
+)
+
+// failingCommitStore is a store whose batches fail to commit while failCommit is set.
+type failingCommitStore struct {
+	storepkg.Store
+	failCommit bool
+}
+
+func (s *failingCommitStore) Batch(ctx context.Context) (storepkg.Batch, error) {
+	batch, err := s.Store.Batch(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &failingCommitBatch{Batch: batch, fail: s.failCommit}, nil
+}
+
+type failingCommitBatch struct {
+	storepkg.Batch
+	fail bool
+}
+
+func (b *failingCommitBatch) Commit(ctx context.Context) error {
+	if b.fail {
+		return errors.New("commit failed")
+	}
+	return b.Batch.Commit(ctx)
+}
+
+func TestBlockMetadata_SavedWithBlockCommit(t *testing.T) {
+	ctx := context.Background()
+	kv, err := storepkg.NewDefaultInMemoryKVStore()
+	require.NoError(t, err)
+	store := &failingCommitStore{Store: storepkg.New(kv), failCommit: true}
+	m := &Manager{
+		store:        store,
+		exec:         &stateDiffExecutor{changes: []coreexecutor.StateChange{{Key: []byte("a"), Value: []byte("1")}}},
+		logger:       zerolog.Nop(),
+		metrics:      NopMetrics(),
+		lastStateMtx: new(sync.RWMutex),
+	}
+
+	header, data := types.GetRandomBlock(1, 2, "testchain")
+	state := types.State{ChainID: "testchain", LastBlockHeight: 1}
+	keys := []string{
+		fmt.Sprintf("%s/%d", storepkg.StateDiffKey, 1),
+		fmt.Sprintf("%s/%d", storepkg.OrderflowKey, 1),
+	}
+	record := func() {
+		m.saveStateDiff(ctx, 1)
+		m.saveOrderflow(1, []coresequencer.BundleInclusion{{Source: "private", BundleID: "p1", Count: 1}})
+	}
+
+	record()
+	require.Error(t, m.saveSyncedBlock(ctx, header, data, state))
+	for _, key := range keys {
+		_, err := store.GetMetadata(ctx, key)
+		require.ErrorIs(t, err, ds.ErrNotFound, key)
+	}
+	height, err := store.Height(ctx)
+	require.NoError(t, err)
+	require.Zero(t, height)
+	require.Empty(t, m.blockMetadata.pop(1))
+
+	// the metadata recorded when the block is applied again is saved with it
+	store.failCommit = false
+	record()
+	require.NoError(t, m.saveSyncedBlock(ctx, header, data, state))
+	for _, key := range keys {
+		_, err := store.GetMetadata(ctx, key)
+		require.NoError(t, err, key)
+	}
+}
@@ -0,0 +1,82 @@
+package block
+
+import (
+	"context"
+	"time"
+
+	ds "github.com/ipfs/go-datastore"
+)
+
+// CompactionLoop compacts the store every Node.CompactionInterval until ctx is done. Failures are
+// logged and retried at the next interval.
+func (m *Manager) CompactionLoop(ctx context.Context) {
+	interval := m.config.Node.CompactionInterval.Duration
+	if interval <= 0 {
+		return
+	}
+	if _, ok := m.store.(ds.GCFeature); !ok {
+		m.logger.Warn().Msg("store does not support compaction, scheduled compactions disabled")
+		return
+	}
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+		if err := m.CompactStore(ctx); err != nil && ctx.Err() == nil {
+			m.logger.Warn().Err(err).Msg("failed to compact store")
+		}
+	}
+}
+
+// CompactStore compacts the store, reclaiming the disk space of the data deleted from it, and
+// records the duration of the compaction and the disk space reclaimed in the metrics. Concurrent
+// compactions, e.g. scheduled and requested through the admin RPC, run one after the other. It is
+// a no-op for stores which do not support compaction.
+func (m *Manager) CompactStore(ctx context.Context) error {
+	gc, ok := m.store.(ds.GCFeature)
+	if !ok {
+		return nil
+	}
+	m.compactionMu.Lock()
+	defer m.compactionMu.Unlock()
+
+	before, err := m.storeDiskUsage(ctx)
+	if err != nil {
+		return err
+	}
+	start := time.Now()
+	err = gc.CollectGarbage(ctx)
+	elapsed := time.Since(start)
+	m.metrics.StoreCompactionDuration.Observe(elapsed.Seconds())
+	if err != nil {
+		m.metrics.StoreCompactionFailures.Add(1)
+		return err
+	}
+	m.metrics.StoreCompactions.Add(1)
+
+	after, err := m.storeDiskUsage(ctx)
+	if err != nil {
+		return err
+	}
+	m.metrics.StoreDiskUsage.Set(float64(after))
+	var reclaimed uint64
+	if after < before {
+		reclaimed = before - after
+	}
+	m.metrics.StoreCompactionReclaimedBytes.Add(float64(reclaimed))
+	m.logger.Info().Dur("duration", elapsed).Uint64("disk_usage", after).Uint64("reclaimed", reclaimed).Msg("compacted store")
+	return nil
+}
+
+// storeDiskUsage returns the disk usage of the store, 0 if it does not report it.
+func (m *Manager) storeDiskUsage(ctx context.Context) (uint64, error) {
+	if persistent, ok := m.store.(ds.PersistentFeature); ok {
+		return persistent.DiskUsage(ctx)
+	}
+	return 0, nil
+}
@@ -0,0 +1,82 @@
+package block
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/go-kit/kit/metrics/generic"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/evstack/ev-node/pkg/config"
+	"github.com/evstack/ev-node/test/mocks"
+)
+
+// failingGCStore is a store whose garbage collection fails.
+type failingGCStore struct {
+	quotaStore
+}
+
+func (s *failingGCStore) CollectGarbage(context.Context) error {
+	return errors.New("compaction failed")
+}
+
+func TestCompactStore(t *testing.T) {
+	ctx := context.Background()
+	s := &quotaStore{Store: mocks.NewMockStore(t), usage: 1000, reclaimed: 300}
+	metrics := NopMetrics()
+	compactions, reclaimed, diskUsage := generic.NewCounter("compactions"), generic.NewCounter("reclaimed"), generic.NewGauge("disk_usage")
+	failures, duration := generic.NewCounter("failures"), generic.NewHistogram("duration", 10)
+	metrics.StoreCompactions, metrics.StoreCompactionReclaimedBytes, metrics.StoreDiskUsage = compactions, reclaimed, diskUsage
+	metrics.StoreCompactionFailures, metrics.StoreCompactionDuration = failures, duration
+	m := &Manager{store: s, logger: zerolog.Nop(), metrics: metrics}
+
+	require.NoError(t, m.CompactStore(ctx))
+	require.NoError(t, m.CompactStore(ctx))
+	assert.Equal(t, 2, s.gcCalls)
+	assert.Equal(t, float64(2), compactions.Value())
+	assert.Equal(t, float64(600), reclaimed.Value())
+	assert.Equal(t, float64(400), diskUsage.Value())
+	assert.Zero(t, failures.Value())
+
+	m.store = &failingGCStore{quotaStore: quotaStore{Store: s.Store, usage: 400}}
+	require.Error(t, m.CompactStore(ctx))
+	assert.Equal(t, float64(2), compactions.Value())
+	assert.Equal(t, float64(1), failures.Value())
+
+	// stores which do not support compaction are left as is
+	m.store = s.Store
+	require.NoError(t, m.CompactStore(ctx))
+	assert.Equal(t, float64(2), compactions.Value())
+}
+
+func TestCompactionLoop(t *testing.T) {
+	s := &quotaStore{Store: mocks.NewMockStore(t), usage: 1000, reclaimed: 100}
+	m := &Manager{
+		store:   s,
+		logger:  zerolog.Nop(),
+		metrics: NopMetrics(),
+		config:  config.Config{Node: config.NodeConfig{CompactionInterval: config.DurationWrapper{Duration: 10 * time.Millisecond}}},
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		m.CompactionLoop(ctx)
+	}()
+	require.Eventually(t, func() bool {
+		m.compactionMu.Lock()
+		defer m.compactionMu.Unlock()
+		return s.gcCalls >= 2
+	}, time.Second, 5*time.Millisecond)
+	cancel()
+	<-done
+
+	// scheduled compactions are disabled without an interval
+	m.config.Node.CompactionInterval.Duration = 0
+	m.CompactionLoop(context.Background())
+}
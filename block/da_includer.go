@@ -55,7 +55,9 @@ func (m *Manager) incrementDAIncludedHeight(ctx context.Context) error {
 	currentHeight := m.GetDAIncludedHeight()
 	newHeight := currentHeight + 1
 	m.logger.Debug().Uint64("height", newHeight).Msg("setting final height")
+	m.execMu.RLock()
 	err := m.exec.SetFinal(ctx, newHeight)
+	m.execMu.RUnlock()
 	if err != nil {
 		m.logger.Error().Uint64("height", newHeight).Err(err).Msg("failed to set final height")
 		return err
@@ -65,6 +65,7 @@ func TestDAIncluderLoop_AdvancesHeightWhenBothDAIncluded(t *testing.T) {
 	dataHeightBytes := make([]byte, 8)
 	binary.LittleEndian.PutUint64(dataHeightBytes, uint64(1))
 	store.On("SetMetadata", mock.Anything, fmt.Sprintf("%s/%d/d", storepkg.HeightToDAHeightKey, uint64(5)), dataHeightBytes).Return(nil).Once()
+	store.On("SetMetadata", mock.Anything, fmt.Sprintf("%s/%d", storepkg.DAIncludedTimeKey, uint64(5)), mock.Anything).Return(nil).Once()
 	// Mock expectations for incrementDAIncludedHeight method
 	heightBytes := make([]byte, 8)
 	binary.LittleEndian.PutUint64(heightBytes, expectedDAIncludedHeight)
@@ -279,6 +280,7 @@ func TestDAIncluderLoop_MultipleConsecutiveHeightsDAIncluded(t *testing.T) {
 		dataHeightBytes := make([]byte, 8)
 		binary.LittleEndian.PutUint64(dataHeightBytes, uint64(i+1))
 		store.On("SetMetadata", mock.Anything, fmt.Sprintf("%s/%d/d", storepkg.HeightToDAHeightKey, height), dataHeightBytes).Return(nil).Once()
+		store.On("SetMetadata", mock.Anything, fmt.Sprintf("%s/%d", storepkg.DAIncludedTimeKey, height), mock.Anything).Return(nil).Once()
 	}
 	store.On("SetMetadata", mock.Anything, storepkg.DAIncludedHeightKey, mock.Anything).Return(nil).Times(numConsecutive)
 	exec.On("SetFinal", mock.Anything, mock.Anything).Return(nil).Times(numConsecutive)
@@ -332,6 +334,7 @@ func TestDAIncluderLoop_AdvancesHeightWhenDataHashIsEmptyAndHeaderDAIncluded(t *
 	dataHeightBytes := make([]byte, 8)
 	binary.LittleEndian.PutUint64(dataHeightBytes, uint64(1))
 	store.On("SetMetadata", mock.Anything, fmt.Sprintf("%s/%d/d", storepkg.HeightToDAHeightKey, uint64(5)), dataHeightBytes).Return(nil).Once()
+	store.On("SetMetadata", mock.Anything, fmt.Sprintf("%s/%d", storepkg.DAIncludedTimeKey, uint64(5)), mock.Anything).Return(nil).Once()
 	// Mock expectations for incrementDAIncludedHeight method
 	heightBytes := make([]byte, 8)
 	binary.LittleEndian.PutUint64(heightBytes, expectedDAIncludedHeight)
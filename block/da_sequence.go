@@ -0,0 +1,117 @@
+package block
+
+import (
+	"context"
+	"regexp"
+	"strconv"
+	"sync"
+
+	coreda "github.com/evstack/ev-node/core/da"
+	"github.com/evstack/ev-node/types"
+)
+
+// sequenceMismatchRe matches the expected sequence in the account sequence mismatch errors
+// returned by Cosmos SDK based DA layers and by coreda.CheckSequence, e.g. "account sequence
+// mismatch, expected 42, got 41".
+var sequenceMismatchRe = regexp.MustCompile(`expected (\d+), got (\d+)`)
+
+// daAccountSequence tracks the sequence of the DA submission account locally, so that every
+// submission is made with the next sequence without querying the DA layer, and submissions
+// made concurrently by the header and data submission loops get distinct sequences.
+//
+// The sequence is unknown until the DA layer reports it, either through the optional
+// coreda.AccountSequencer interface or in an account sequence mismatch error. While it is
+// unknown, submissions leave the sequence to the DA layer.
+type daAccountSequence struct {
+	// submitMu serializes the submissions made with a sequence, so that they reach the DA layer
+	// in the order of their sequences
+	submitMu sync.Mutex
+
+	mu    sync.Mutex
+	next  uint64
+	known bool
+	// initialized is true once the DA layer was queried for the sequence
+	initialized bool
+}
+
+// reserve reserves the sequence of the next submission and returns it with the submission
+// options holding it. It returns false if the sequence is unknown.
+func (s *daAccountSequence) reserve(ctx context.Context, da coreda.DA) (uint64, []byte, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if !s.initialized {
+		s.initialized = true
+		if sequencer, ok := da.(coreda.AccountSequencer); ok {
+			if next, err := sequencer.AccountSequence(ctx); err == nil {
+				s.next, s.known = next, true
+			}
+		}
+	}
+	if !s.known {
+		return 0, nil, false
+	}
+	seq := s.next
+	s.next++
+	return seq, coreda.EncodeSequenceOptions(seq), true
+}
+
+// release gives back a sequence reserved for a submission that was not included, if no
+// sequence was reserved after it.
+func (s *daAccountSequence) release(seq uint64) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.known && s.next == seq+1 {
+		s.next = seq
+	}
+}
+
+// recover resets the sequence to the one expected by the DA layer, as reported in an account
+// sequence mismatch error. It returns the gap between the expected and the local sequence, and
+// false if the error does not report the expected sequence, in which case the sequence is
+// queried again from the DA layer for the next submission.
+func (s *daAccountSequence) recover(message string) (int64, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	match := sequenceMismatchRe.FindStringSubmatch(message)
+	if match == nil {
+		s.known, s.initialized = false, false
+		return 0, false
+	}
+	expected, err := strconv.ParseUint(match[1], 10, 64)
+	if err != nil {
+		s.known, s.initialized = false, false
+		return 0, false
+	}
+
+	gap := int64(expected) - int64(s.next) //nolint:gosec // sequences are far below 2^63
+	s.next, s.known = expected, true
+	return gap, true
+}
+
+// submitWithSequence submits the blobs to DA with the next sequence of the submission account
+// and keeps the local sequence in step with the result.
+func (m *Manager) submitWithSequence(ctx context.Context, blobs [][]byte, gasPrice float64, namespace []byte) coreda.ResultSubmit {
+	m.daSequence.submitMu.Lock()
+	defer m.daSequence.submitMu.Unlock()
+
+	seq, options, reserved := m.daSequence.reserve(ctx, m.da)
+	res := types.SubmitWithHelpers(ctx, m.da, m.logger, blobs, gasPrice, namespace, options)
+
+	switch res.Code {
+	case coreda.StatusSuccess:
+	case coreda.StatusIncorrectAccountSequence:
+		if gap, ok := m.daSequence.recover(res.Message); ok {
+			m.logger.Warn().Int64("gap", gap).Uint64("sequence", seq).Msg("DA account sequence out of step, resynchronized from DA layer")
+		} else {
+			m.logger.Warn().Uint64("sequence", seq).Msg("DA account sequence out of step, querying it again from DA layer")
+		}
+	default:
+		if reserved {
+			m.daSequence.release(seq)
+		}
+	}
+	return res
+}
@@ -0,0 +1,161 @@
+package block
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	coreda "github.com/evstack/ev-node/core/da"
+	"github.com/evstack/ev-node/test/mocks"
+)
+
+// sequencedDA is a DA layer reporting the sequence of its submission account.
+type sequencedDA struct {
+	*mocks.MockDA
+	sequence uint64
+}
+
+func (d *sequencedDA) AccountSequence(context.Context) (uint64, error) {
+	return d.sequence, nil
+}
+
+func TestDAAccountSequence(t *testing.T) {
+	ctx := context.Background()
+	var s daAccountSequence
+
+	// unknown until reported by the DA layer
+	_, options, ok := s.reserve(ctx, &mocks.MockDA{})
+	require.False(t, ok)
+	require.Nil(t, options)
+
+	_, ok = s.recover("failed to submit blobs: incorrect account sequence")
+	require.False(t, ok)
+
+	gap, ok := s.recover("failed to submit blobs: account sequence mismatch, expected 5, got 3: incorrect account sequence")
+	require.True(t, ok)
+	assert.Equal(t, int64(5), gap)
+
+	seq, options, ok := s.reserve(ctx, &mocks.MockDA{})
+	require.True(t, ok)
+	assert.Equal(t, uint64(5), seq)
+	assert.JSONEq(t, `{"sequence":5}`, string(options))
+	seq, _, _ = s.reserve(ctx, &mocks.MockDA{})
+	assert.Equal(t, uint64(6), seq)
+
+	// a sequence is only given back if none was reserved after it
+	s.release(5)
+	assert.Equal(t, uint64(7), s.next)
+	s.release(6)
+	assert.Equal(t, uint64(6), s.next)
+
+	// a gap is detected when submissions were made outside the node
+	gap, ok = s.recover("account sequence mismatch, expected 9, got 6")
+	require.True(t, ok)
+	assert.Equal(t, int64(3), gap)
+
+	// the sequence is queried once from DA layers reporting it
+	var queried daAccountSequence
+	seq, _, ok = queried.reserve(ctx, &sequencedDA{MockDA: &mocks.MockDA{}, sequence: 42})
+	require.True(t, ok)
+	assert.Equal(t, uint64(42), seq)
+	seq, _, _ = queried.reserve(ctx, &sequencedDA{MockDA: &mocks.MockDA{}, sequence: 0})
+	assert.Equal(t, uint64(43), seq)
+
+	// and queried again when a mismatch does not report the expected sequence
+	_, ok = queried.recover("incorrect account sequence")
+	require.False(t, ok)
+	seq, _, ok = queried.reserve(ctx, &sequencedDA{MockDA: &mocks.MockDA{}, sequence: 50})
+	require.True(t, ok)
+	assert.Equal(t, uint64(50), seq)
+}
+
+func TestSubmitDataToDA_SequenceMismatchRecovery(t *testing.T) {
+	da := &mocks.MockDA{}
+	m := newTestManagerWithDA(t, da)
+	ctx := t.Context()
+
+	fillPendingData(ctx, t, m.pendingData, "Test Sequence Mismatch", numItemsToSubmit)
+	items, err := m.createSignedDataToSubmit(ctx)
+	require.NoError(t, err)
+
+	var options [][]byte
+	recordOptions := func(args mock.Arguments) { options = append(options, args.Get(4).([]byte)) }
+	da.On("SubmitWithOptions", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+		Run(recordOptions).
+		Return(nil, fmt.Errorf("account sequence mismatch, expected 7, got 6: %w", coreda.ErrTxIncorrectAccountSequence)).Once()
+	ids := make([]coreda.ID, len(items))
+	for i := range ids {
+		ids[i] = getDummyID(1, []byte{byte(i)})
+	}
+	da.On("SubmitWithOptions", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+		Run(recordOptions).
+		Return(ids, nil).Once()
+
+	require.NoError(t, m.submitDataToDA(ctx, items))
+
+	require.Len(t, options, 2)
+	assert.Nil(t, options[0])
+	assert.JSONEq(t, `{"sequence":7}`, string(options[1]))
+	assert.Equal(t, uint64(8), m.daSequence.next)
+	da.AssertExpectations(t)
+}
+
+func TestSubmitWithSequence_Concurrent(t *testing.T) {
+	dummyDA := coreda.NewDummyDA(1024*1024, 1.0, 1.0, time.Second)
+	m := newTestManagerWithDA(t, nil)
+	m.da = dummyDA
+	ctx := t.Context()
+
+	const submissions = 20
+	var wg sync.WaitGroup
+	results := make([]coreda.ResultSubmit, submissions)
+	for i := range submissions {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			results[i] = m.submitWithSequence(ctx, [][]byte{fmt.Appendf(nil, "blob-%d", i)}, 1.0, []byte("ns"))
+		}()
+	}
+	wg.Wait()
+
+	for i, res := range results {
+		require.Equal(t, coreda.StatusSuccess, res.Code, "submission %d: %s", i, res.Message)
+	}
+	sequence, err := dummyDA.AccountSequence(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(submissions), sequence)
+	assert.Equal(t, uint64(submissions), m.daSequence.next)
+}
+
+func TestSubmitDataToDA_RecoversFromForcedSequenceMismatch(t *testing.T) {
+	dummyDA := coreda.NewDummyDA(1024*1024, 1.0, 1.0, time.Second)
+	m := newTestManagerWithDA(t, nil)
+	m.da = dummyDA
+	ctx := t.Context()
+
+	res := m.submitWithSequence(ctx, [][]byte{[]byte("first")}, 1.0, []byte("ns"))
+	require.Equal(t, coreda.StatusSuccess, res.Code, res.Message)
+
+	// submissions made with the same account outside the node move the sequence of the DA layer
+	for i := range 3 {
+		_, err := dummyDA.Submit(ctx, []coreda.Blob{fmt.Appendf(nil, "outside-%d", i)}, 1.0, []byte("ns"))
+		require.NoError(t, err)
+	}
+
+	fillPendingData(ctx, t, m.pendingData, "Test Forced Sequence Mismatch", numItemsToSubmit)
+	items, err := m.createSignedDataToSubmit(ctx)
+	require.NoError(t, err)
+
+	require.NoError(t, m.submitDataToDA(ctx, items))
+
+	sequence, err := dummyDA.AccountSequence(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(5), sequence)
+	assert.Equal(t, uint64(5), m.daSequence.next)
+}
@@ -0,0 +1,121 @@
+package block
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	coreda "github.com/evstack/ev-node/core/da"
+)
+
+// daSubscriptionPollFactor is the number of DA block times between the polls of the SyncLoop
+// while the DA layer pushes the inclusion of blobs. Polling then only recovers from failed
+// retrievals and advances the DA height over blocks without blobs of the node.
+const daSubscriptionPollFactor = 10
+
+var errDASubscriptionInterrupted = errors.New("DA subscription interrupted")
+
+// subscribeDA subscribes to the header and data namespaces if the DA layer supports subscriptions,
+// and signals the RetrieveLoop as soon as blobs are included in them. The SyncLoop polls the DA
+// layer every DA block time until the subscriptions are established, and again if they are
+// interrupted while they are re-established.
+func (m *Manager) subscribeDA(ctx context.Context) {
+	subscriber, ok := m.da.(coreda.Subscriber)
+	if !ok {
+		return
+	}
+
+	err := m.runDASubscriptions(ctx, subscriber)
+	if ctx.Err() != nil {
+		return
+	}
+	if !errors.Is(err, errDASubscriptionInterrupted) {
+		m.logger.Info().Err(err).Msg("DA layer does not support subscriptions, polling it every DA block time")
+		return
+	}
+
+	// back off up to the polling interval of the SyncLoop while subscribed
+	maxRetryInterval := daSubscriptionPollFactor * m.config.DA.BlockTime.Duration
+	retryInterval := m.config.DA.BlockTime.Duration
+	for {
+		m.logger.Warn().Err(err).Dur("retryInterval", retryInterval).Msg("DA subscription failed, polling DA layer until it is restored")
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(retryInterval):
+		}
+		if err = m.runDASubscriptions(ctx, subscriber); ctx.Err() != nil {
+			return
+		}
+		if errors.Is(err, errDASubscriptionInterrupted) {
+			retryInterval = m.config.DA.BlockTime.Duration
+		} else {
+			retryInterval = min(2*retryInterval, maxRetryInterval)
+		}
+	}
+}
+
+// runDASubscriptions subscribes to the namespaces of the node and signals the RetrieveLoop of every
+// notified height, until ctx is done or a subscription is interrupted.
+func (m *Manager) runDASubscriptions(ctx context.Context, subscriber coreda.Subscriber) error {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	heights := make(chan uint64)
+	interrupted := make(chan struct{}, 1)
+	for _, ns := range m.retrievedNamespaces() {
+		ch, err := subscriber.Subscribe(ctx, ns)
+		if err != nil {
+			return err
+		}
+		go func() {
+			for height := range ch {
+				select {
+				case heights <- height:
+				case <-ctx.Done():
+					return
+				}
+			}
+			select {
+			case interrupted <- struct{}{}:
+			default:
+			}
+		}()
+	}
+
+	m.daSubscribed.Store(true)
+	defer m.daSubscribed.Store(false)
+	m.logger.Info().Msg("subscribed to DA layer, retrieving blobs as soon as they are included")
+	// catch up with the blobs included while not subscribed
+	m.sendNonBlockingSignalToRetrieveCh()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-interrupted:
+			return errDASubscriptionInterrupted
+		case height := <-heights:
+			m.logger.Debug().Uint64("daHeight", height).Msg("DA layer notified new blobs")
+			m.sendNonBlockingSignalToRetrieveCh()
+		}
+	}
+}
+
+// retrievedNamespaces returns the distinct namespaces the RetrieveLoop fetches blobs from.
+func (m *Manager) retrievedNamespaces() [][]byte {
+	candidates := []string{m.config.DA.GetHeaderNamespace(), m.config.DA.GetDataNamespace()}
+	if !m.namespaceMigrationCompleted.Load() && m.config.DA.Namespace != "" {
+		candidates = append(candidates, m.config.DA.Namespace)
+	}
+
+	var namespaces [][]byte
+	seen := make(map[string]bool, len(candidates))
+	for _, ns := range candidates {
+		if !seen[ns] {
+			seen[ns] = true
+			namespaces = append(namespaces, []byte(ns))
+		}
+	}
+	return namespaces
+}
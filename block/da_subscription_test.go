@@ -0,0 +1,94 @@
+package block
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	coreda "github.com/evstack/ev-node/core/da"
+	"github.com/evstack/ev-node/pkg/config"
+)
+
+// subscriberDA is a DA layer whose subscriptions are controlled by the test.
+type subscriberDA struct {
+	coreda.DA
+
+	mu            sync.Mutex
+	err           error
+	subscriptions map[string]chan uint64
+}
+
+func (d *subscriberDA) Subscribe(ctx context.Context, namespace []byte) (<-chan uint64, error) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	if d.err != nil {
+		return nil, d.err
+	}
+	ch := make(chan uint64)
+	d.subscriptions[string(namespace)] = ch
+	return ch, nil
+}
+
+func (d *subscriberDA) subscription(namespace string) chan uint64 {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	return d.subscriptions[namespace]
+}
+
+func TestSubscribeDA(t *testing.T) {
+	m, mockDA, _, _, _, _ := setupManagerForRetrieverTest(t, 0)
+	m.config.DA = config.DAConfig{
+		BlockTime:       config.DurationWrapper{Duration: 10 * time.Millisecond},
+		HeaderNamespace: "headers",
+		DataNamespace:   "data",
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	// DA layers which do not support subscriptions are polled
+	done := make(chan struct{})
+	go func() {
+		m.subscribeDA(ctx)
+		close(done)
+	}()
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		t.Fatal("subscribeDA did not return")
+	}
+
+	da := &subscriberDA{DA: mockDA, err: errors.New("not supported"), subscriptions: map[string]chan uint64{}}
+	m.da = da
+	m.subscribeDA(ctx)
+	assert.False(t, m.daSubscribed.Load())
+
+	da.err = nil
+	go m.subscribeDA(ctx)
+	require.Eventually(t, m.daSubscribed.Load, time.Second, time.Millisecond)
+	assert.NotNil(t, da.subscription("headers"))
+	assert.NotNil(t, da.subscription("data"))
+
+	// the RetrieveLoop catches up once subscribed, then is signaled of every notified height
+	<-m.retrieveCh
+	da.subscription("data") <- 5
+	select {
+	case <-m.retrieveCh:
+	case <-time.After(time.Second):
+		t.Fatal("RetrieveLoop not signaled")
+	}
+
+	// interrupted subscriptions are restored
+	headers := da.subscription("headers")
+	close(headers)
+	require.Eventually(t, func() bool {
+		return m.daSubscribed.Load() && da.subscription("headers") != headers
+	}, time.Second, time.Millisecond)
+
+	cancel()
+	require.Eventually(t, func() bool { return !m.daSubscribed.Load() }, time.Second, time.Millisecond)
+}
@@ -0,0 +1,128 @@
+package block
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/evstack/ev-node/types"
+)
+
+// dryRunTotals are the totals of the DA submissions prepared by a dry-run aggregator.
+type dryRunTotals struct {
+	bytes uint64
+	cost  float64
+}
+
+// DryRunSubmissionLoop replaces the DA submission loops of a dry-run aggregator. Every DA block
+// time, it prepares the headers and data pending DA submission like the submission loops, and has
+// the DA layer compute the commitments of their blobs to check that it accepts them, but does not
+// submit them. The size of the blobs and their estimated cost at the current gas price, assuming
+// a gas per byte, are logged instead, and the headers and data are then considered submitted.
+func (m *Manager) DryRunSubmissionLoop(ctx context.Context) {
+	timer := time.NewTicker(m.config.DA.BlockTime.Duration)
+	defer timer.Stop()
+	var totals dryRunTotals
+	for {
+		select {
+		case <-ctx.Done():
+			m.logger.Info().Uint64("total_bytes", totals.bytes).Float64("total_estimated_cost", totals.cost).Msg("dry run submission loop stopped")
+			return
+		case <-timer.C:
+		case <-m.headerSubmissionCh:
+		case <-m.dataSubmissionCh:
+		}
+		if err := m.dryRunSubmission(ctx, &totals); err != nil {
+			m.logger.Error().Err(err).Msg("dry run: failed to prepare DA submission")
+		}
+	}
+}
+
+// dryRunSubmission prepares the pending headers and data for DA submission without submitting
+// them, and adds their size and estimated cost to the totals.
+func (m *Manager) dryRunSubmission(ctx context.Context, totals *dryRunTotals) error {
+	var (
+		headers  []*types.SignedHeader
+		dataList []*types.Data
+		err      error
+	)
+	if !m.pendingHeaders.isEmpty() {
+		if headers, err = m.pendingHeaders.getPendingHeaders(ctx); err != nil {
+			return fmt.Errorf("failed to get pending headers: %w", err)
+		}
+	}
+	if !m.pendingData.isEmpty() {
+		if dataList, err = m.pendingData.getPendingData(ctx); err != nil {
+			return fmt.Errorf("failed to get pending data: %w", err)
+		}
+	}
+	if len(headers) == 0 && len(dataList) == 0 {
+		return nil
+	}
+
+	signedData, err := m.signData(dataList)
+	if err != nil {
+		return err
+	}
+	headerBlobs, err := marshalItems(headers, marshalHeader, "header")
+	if err != nil {
+		return err
+	}
+	dataBlobs, err := marshalItems(signedData, m.marshalSignedData, "data")
+	if err != nil {
+		return err
+	}
+	if err := m.dryRunCommit(ctx, headerBlobs, m.config.DA.GetHeaderNamespace()); err != nil {
+		return err
+	}
+	if err := m.dryRunCommit(ctx, dataBlobs, m.config.DA.GetDataNamespace()); err != nil {
+		return err
+	}
+
+	gasPrice, err := m.da.GasPrice(ctx)
+	if err != nil {
+		m.logger.Warn().Err(err).Msg("failed to get gas price from DA layer, using default")
+		gasPrice = defaultGasPrice
+	}
+	var size uint64
+	for _, blobs := range [][][]byte{headerBlobs, dataBlobs} {
+		for _, blob := range blobs {
+			size += uint64(len(blob))
+		}
+	}
+	cost := gasPrice * float64(size)
+	totals.bytes += size
+	totals.cost += cost
+
+	if len(headers) > 0 {
+		m.pendingHeaders.setLastSubmittedHeaderHeight(ctx, headers[len(headers)-1].Height())
+	}
+	if len(dataList) > 0 {
+		m.pendingData.setLastSubmittedDataHeight(ctx, dataList[len(dataList)-1].Height())
+	}
+
+	m.logger.Info().
+		Int("headers", len(headers)).
+		Int("data", len(signedData)).
+		Uint64("bytes", size).
+		Float64("gas_price", gasPrice).
+		Float64("estimated_cost", cost).
+		Uint64("total_bytes", totals.bytes).
+		Float64("total_estimated_cost", totals.cost).
+		Msg("dry run: DA submission prepared but not submitted")
+	return nil
+}
+
+// dryRunCommit has the DA layer compute the commitments of the blobs, which it does without
+// publishing them.
+func (m *Manager) dryRunCommit(ctx context.Context, blobs [][]byte, namespace string) error {
+	if len(blobs) == 0 {
+		return nil
+	}
+	ctx, cancel := context.WithTimeout(ctx, submissionTimeout)
+	defer cancel()
+	if _, err := m.da.Commit(ctx, blobs, []byte(namespace)); err != nil {
+		return fmt.Errorf("DA layer failed to compute the commitments of %d blobs: %w", len(blobs), err)
+	}
+	return nil
+}
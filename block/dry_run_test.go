@@ -0,0 +1,41 @@
+package block
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/evstack/ev-node/test/mocks"
+)
+
+func TestDryRunSubmission(t *testing.T) {
+	da := &mocks.MockDA{}
+	m := newTestManagerWithDA(t, da)
+	ctx := t.Context()
+	fillPendingHeaders(ctx, t, m.pendingHeaders, "Test Dry Run", 3)
+	fillPendingData(ctx, t, m.pendingData, "Test Dry Run", 3)
+
+	// nothing is published if the DA layer cannot compute the commitments
+	da.On("Commit", mock.Anything, mock.Anything, mock.Anything).Return(nil, errors.New("unsupported namespace")).Once()
+	var totals dryRunTotals
+	require.ErrorContains(t, m.dryRunSubmission(ctx, &totals), "unsupported namespace")
+	require.Equal(t, uint64(3), m.pendingHeaders.numPendingHeaders())
+	require.Zero(t, totals.bytes)
+
+	da.On("Commit", mock.Anything, mock.Anything, mock.Anything).Return(nil, nil)
+	require.NoError(t, m.dryRunSubmission(ctx, &totals))
+	require.Positive(t, totals.bytes)
+	// the mock DA layer has a gas price of 1
+	require.Equal(t, float64(totals.bytes), totals.cost)
+	require.True(t, m.pendingHeaders.isEmpty())
+	require.True(t, m.pendingData.isEmpty())
+	da.AssertNotCalled(t, "Submit", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	da.AssertNotCalled(t, "SubmitWithOptions", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+
+	// no submission is prepared while nothing is pending
+	bytes := totals.bytes
+	require.NoError(t, m.dryRunSubmission(ctx, &totals))
+	require.Equal(t, bytes, totals.bytes)
+}
@@ -17,4 +17,7 @@ var (
 
 	// ErrHeightFromFutureStr is the error message for height from future returned by da
 	ErrHeightFromFutureStr = errors.New("given height is from the future")
+
+	// ErrHaltHeight is returned when the node reaches the activation height of a halt system call
+	ErrHaltHeight = errors.New("halt height reached")
 )
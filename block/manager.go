@@ -25,10 +25,12 @@ import (
 	"github.com/evstack/ev-node/pkg/cache"
 	"github.com/evstack/ev-node/pkg/config"
 	"github.com/evstack/ev-node/pkg/genesis"
+	"github.com/evstack/ev-node/pkg/journal"
 	"github.com/evstack/ev-node/pkg/rpc/server"
 	"github.com/evstack/ev-node/pkg/signer"
 	storepkg "github.com/evstack/ev-node/pkg/store"
 	"github.com/evstack/ev-node/types"
+	pb "github.com/evstack/ev-node/types/pb/evnode/v1"
 )
 
 const (
@@ -71,12 +73,16 @@ type MetricsRecorder interface {
 type NewHeaderEvent struct {
 	Header   *types.SignedHeader
 	DAHeight uint64
+	// Source is where the header was obtained from
+	Source SyncSource
 }
 
 // NewDataEvent is used to pass header and DA height to headerInCh
 type NewDataEvent struct {
 	Data     *types.Data
 	DAHeight uint64
+	// Source is where the data was obtained from
+	Source SyncSource
 }
 
 // BatchData is used to pass batch, time and data (da.IDs) to BatchQueue
@@ -84,6 +90,8 @@ type BatchData struct {
 	*coresequencer.Batch
 	time.Time
 	Data [][]byte
+	// Orderflow attributes the transactions pulled from orderflow sources by the sequencer
+	Orderflow []coresequencer.BundleInclusion
 }
 
 type broadcaster[T any] interface {
@@ -128,6 +136,14 @@ type Manager struct {
 	// daIncluderCh is used to notify sync goroutine (DAIncluderLoop) that it needs to set DA included height
 	daIncluderCh chan struct{}
 
+	// headerSubmissionCh and dataSubmissionCh are used to notify the submission loops to submit
+	// the pending headers and data without waiting for the next DA block time
+	headerSubmissionCh chan struct{}
+	dataSubmissionCh   chan struct{}
+
+	// sequencerFeesCh is used to notify SequencerFeesLoop that new blocks were committed
+	sequencerFeesCh chan struct{}
+
 	logger zerolog.Logger
 
 	// For usage by Lazy Aggregator mode
@@ -140,6 +156,9 @@ type Manager struct {
 	metrics *Metrics
 
 	exec coreexecutor.Executor
+	// execMu is held for reading while a block is produced, applied or finalized, and for
+	// writing while exec is switched, see UpgradeExecutor. Every read of exec holds it.
+	execMu sync.RWMutex
 
 	// daIncludedHeight is evolve height at which all blocks have been included
 	// in the DA
@@ -167,6 +186,56 @@ type Manager struct {
 	// namespaceMigrationCompleted tracks whether we have completed the migration
 	// from legacy namespace to separate header/data namespaces
 	namespaceMigrationCompleted *atomic.Bool
+
+	// syncSources tracks which source supplied the header and data of heights waiting to be applied
+	syncSources syncSourceTracker
+
+	// blockMetadata collects the metadata of the blocks being produced or applied, saved when they are committed
+	blockMetadata blockMetadataTracker
+
+	// missingDataHeight is the height whose data was found missing at the last DA tick of the SyncLoop
+	missingDataHeight uint64
+
+	// dataRecoveryInFlight ensures that only one recovery of missing data from DA runs at a time
+	dataRecoveryInFlight atomic.Bool
+
+	// daSubscribed is true while the DA layer pushes the inclusion of blobs, see subscribeDA
+	daSubscribed atomic.Bool
+
+	// daSequence tracks the sequence of the DA submission account
+	daSequence daAccountSequence
+
+	// journal records significant events such as DA submission failures, if set
+	journal *journal.Journal
+
+	// stall tracks the progress of the sync to attribute its stalls
+	stall stallTracker
+
+	// syncPeers reports the contributions of peers to the sync, if set
+	syncPeers SyncPeers
+
+	// previews gossips the preview blocks, nil unless node.preview_blocks is set
+	previews Previews
+
+	// pruning prunes the store when its disk usage approaches Node.MaxDiskUsage, if set
+	pruning *storepkg.PruningManager
+
+	// diskQuota tracks the disk usage of the store against its quota
+	diskQuota diskQuota
+	// compactionMu serializes the compactions of the store
+	compactionMu sync.Mutex
+
+	// blockTimeOverride is the block time set by a system call in nanoseconds, 0 if none
+	blockTimeOverride atomic.Int64
+	// autoscaler adjusts the block time to the load, nil unless node.block_time_autoscale is set
+	autoscaler *blockTimeAutoscaler
+
+	// divergence is the report of the block whose execution diverged from the sequencer, set by
+	// shadow replicas only, see node.shadow_replica
+	divergence atomic.Pointer[pb.ExecutionDivergence]
+
+	// blobResolver fetches the payloads of the blob pointers of blocks, see ManagerOptions
+	blobResolver BlobResolver
 }
 
 // getInitialState tries to load lastState from Store, and if it's not available it reads genesis.
@@ -265,6 +334,9 @@ func getInitialState(ctx context.Context, genesis genesis.Genesis, signer signer
 type ManagerOptions struct {
 	SignaturePayloadProvider types.SignaturePayloadProvider
 	ValidatorHasherProvider  types.ValidatorHasherProvider
+	// BlobResolver, if set, fetches the payloads of the blob pointers of blocks before they are
+	// executed. Blocks with blob pointers cannot be executed without it.
+	BlobResolver BlobResolver
 }
 
 func (opts *ManagerOptions) Validate() error {
@@ -384,6 +456,9 @@ func NewManager(
 		dataCache:                   cache.NewCache[types.Data](),
 		retrieveCh:                  make(chan struct{}, 1),
 		daIncluderCh:                make(chan struct{}, 1),
+		headerSubmissionCh:          make(chan struct{}, 1),
+		dataSubmissionCh:            make(chan struct{}, 1),
+		sequencerFeesCh:             make(chan struct{}, 1),
 		logger:                      logger,
 		txsAvailable:                false,
 		pendingHeaders:              pendingHeaders,
@@ -395,6 +470,7 @@ func NewManager(
 		txNotifyCh:                  make(chan struct{}, 1), // Non-blocking channel
 		signaturePayloadProvider:    managerOpts.SignaturePayloadProvider,
 		validatorHasherProvider:     managerOpts.ValidatorHasherProvider,
+		blobResolver:                managerOpts.BlobResolver,
 		namespaceMigrationCompleted: &atomic.Bool{},
 	}
 
@@ -408,9 +484,32 @@ func NewManager(
 		m.namespaceMigrationCompleted.Store(migrationData[0] == 1)
 	}
 
+	// initialize the parameters set by system calls
+	if err := m.loadSystemParams(ctx); err != nil {
+		return nil, err
+	}
+
+	if config.Node.Aggregator && config.Node.BlockTimeAutoscale {
+		if m.autoscaler, err = newBlockTimeAutoscaler(genesis, config.Node.MinBlockTime.Duration); err != nil {
+			return nil, err
+		}
+	}
+
 	// Set the default publishBlock implementation
 	m.publishBlock = m.publishBlockInternal
 
+	// bound the memory of the sync caches, spilling the overflow to disk
+	if limit := config.Node.MaxSyncCacheBytes; limit > 0 {
+		spillDir := filepath.Join(config.RootDir, "data", cacheDir, "spill")
+		if err := m.headerCache.EnableSpill(limit, filepath.Join(spillDir, "header")); err != nil {
+			return nil, fmt.Errorf("failed to bound header cache: %w", err)
+		}
+		if err := m.dataCache.EnableSpill(limit, filepath.Join(spillDir, "data")); err != nil {
+			return nil, fmt.Errorf("failed to bound data cache: %w", err)
+		}
+		logger.Info().Uint64("maxBytes", limit).Str("spillDir", spillDir).Msg("sync cache memory limit enabled")
+	}
+
 	// fetch caches from disks
 	if err := m.LoadCache(); err != nil {
 		return nil, fmt.Errorf("failed to load cache: %w", err)
@@ -452,6 +551,11 @@ func (m *Manager) PendingHeaders() *PendingHeaders {
 	return m.pendingHeaders
 }
 
+// NumPendingDA returns the number of headers and data waiting for DA submission.
+func (m *Manager) NumPendingDA() (headers, data uint64) {
+	return m.pendingHeaders.numPendingHeaders(), m.pendingData.numPendingData()
+}
+
 // SeqClient returns the grpc sequencing client.
 func (m *Manager) SeqClient() coresequencer.Sequencer {
 	return m.sequencer
@@ -495,6 +599,18 @@ func (m *Manager) SetLastState(state types.State) {
 	m.lastState = state
 }
 
+// SetJournal sets the journal the Manager records significant events to.
+func (m *Manager) SetJournal(j *journal.Journal) {
+	m.journal = j
+}
+
+// recordEvent records an event to the journal, logging failures.
+func (m *Manager) recordEvent(ctx context.Context, eventType, message string, attrs map[string]string) {
+	if err := m.journal.Record(ctx, eventType, message, attrs); err != nil {
+		m.logger.Warn().Err(err).Str("type", eventType).Msg("failed to record event in journal")
+	}
+}
+
 // GetStoreHeight returns the manager's store height
 func (m *Manager) GetStoreHeight(ctx context.Context) (uint64, error) {
 	return m.store.Height(ctx)
@@ -560,15 +676,17 @@ func (m *Manager) SetSequencerHeightToDAHeight(ctx context.Context, height uint6
 	if err := m.store.SetMetadata(ctx, fmt.Sprintf("%s/%d/d", storepkg.HeightToDAHeightKey, height), dataHeightBytes); err != nil {
 		return err
 	}
-	return nil
+	timeBytes := make([]byte, 8)
+	binary.LittleEndian.PutUint64(timeBytes, uint64(time.Now().UnixNano()))
+	return m.store.SetMetadata(ctx, fmt.Sprintf("%s/%d", storepkg.DAIncludedTimeKey, height), timeBytes)
 }
 
-// GetExecutor returns the executor used by the manager.
-//
-// Note: this is a temporary method to allow testing the manager.
-// It will be removed once the manager is fully integrated with the execution client.
-// TODO(tac0turtle): remove
+// GetExecutor returns the executor used by the manager. It may be switched while the node runs, see
+// UpgradeExecutor, so the components of the node using the executor get it from the manager
+// rather than keeping it. Within the manager, m.exec is read with execMu held instead.
 func (m *Manager) GetExecutor() coreexecutor.Executor {
+	m.execMu.RLock()
+	defer m.execMu.RUnlock()
 	return m.exec
 }
 
@@ -600,7 +718,7 @@ func (m *Manager) retrieveBatch(ctx context.Context) (*BatchData, error) {
 			m.logger.Error().Err(err).Msg("error while setting last batch hash")
 		}
 		m.lastBatchData = res.BatchData
-		return &BatchData{Batch: res.Batch, Time: res.Timestamp, Data: res.BatchData}, errRetrieveBatch
+		return &BatchData{Batch: res.Batch, Time: res.Timestamp, Data: res.BatchData, Orderflow: res.Orderflow}, errRetrieveBatch
 	}
 	return nil, ErrNoBatch
 }
@@ -613,6 +731,9 @@ func (m *Manager) isUsingExpectedSingleSequencer(header *types.SignedHeader) boo
 // It's assigned to the publishBlock field by default.
 // Any error will be returned, unless the error is due to a publishing error.
 func (m *Manager) publishBlockInternal(ctx context.Context) error {
+	m.execMu.RLock()
+	defer m.execMu.RUnlock()
+
 	// Start timing block production
 	timer := NewMetricsTimer("block_production", m.metrics)
 	defer timer.Stop()
@@ -628,6 +749,11 @@ func (m *Manager) publishBlockInternal(ctx context.Context) error {
 		return nil
 	}
 
+	if m.diskQuota.readOnly.Load() {
+		m.logger.Warn().Uint64("usage", m.diskQuota.usage.Load()).Uint64("quota", m.config.Node.MaxDiskUsage).Msg("refusing to create block: store reached its disk quota")
+		return nil
+	}
+
 	var (
 		lastSignature  *types.Signature
 		lastHeaderHash types.Hash
@@ -692,6 +818,11 @@ func (m *Manager) publishBlockInternal(ctx context.Context) error {
 			}
 			m.logger.Info().Uint64("height", newHeight).Int("num_tx", len(batchData.Transactions)).Msg("creating and publishing block")
 		}
+		// the timers of the aggregator jitter, while full nodes reject blocks timestamped sooner
+		// than the lower block time bound after the previous one
+		if earliest := earliestBlockTime(m.genesis, newHeight, m.getLastBlockTime()); batchData.Time.Before(earliest) {
+			batchData.Time = earliest
+		}
 
 		header, data, err = m.createBlock(ctx, newHeight, lastSignature, lastHeaderHash, batchData)
 		if err != nil {
@@ -701,6 +832,7 @@ func (m *Manager) publishBlockInternal(ctx context.Context) error {
 		if err = m.store.SaveBlockData(ctx, header, data, &signature); err != nil { // saved early for crash recovery, will be overwritten later with the final signature
 			return fmt.Errorf("failed to save block: %w", err)
 		}
+		m.saveOrderflow(newHeight, batchData.Orderflow)
 	}
 
 	newState, err := m.applyBlock(ctx, header.Header, data)
@@ -716,6 +848,8 @@ func (m *Manager) publishBlockInternal(ctx context.Context) error {
 		LastDataHash: lastDataHash,
 	}
 
+	m.publishPreview(ctx, header, data)
+
 	// we sign the header after executing the block, as a signature payload provider could depend on the block's data
 	signature, err = m.getHeaderSignature(header.Header)
 	if err != nil {
@@ -736,26 +870,18 @@ func (m *Manager) publishBlockInternal(ctx context.Context) error {
 	headerHash := header.Hash().String()
 	m.headerCache.SetSeen(headerHash)
 
-	// SaveBlock commits the DB tx
-	err = m.store.SaveBlockData(ctx, header, data, &signature)
-	if err != nil {
-		return fmt.Errorf("failed to save block: %w", err)
-	}
-
-	// Update the store height before submitting to the DA layer but after committing to the DB
-	headerHeight := header.Height()
-	if err = m.store.SetHeight(ctx, headerHeight); err != nil {
-		return err
-	}
-
 	newState.DAHeight = m.daHeight.Load()
-	// After this call m.lastState is the NEW state returned from ApplyBlock
-	// updateState also commits the DB tx
-	if err = m.updateState(ctx, newState); err != nil {
-		return fmt.Errorf("failed to update state: %w", err)
+	// Save the block, update the store height before submitting to the DA layer and update the
+	// state in a single commit. After this call m.lastState is the NEW state returned from ApplyBlock
+	if err = m.commitBlock(ctx, header, data, &signature, newState, func(batch storepkg.Batch) error {
+		return m.saveBlockMetadata(ctx, batch, header.Height())
+	}); err != nil {
+		return err
 	}
+	headerHeight := header.Height()
 
 	m.recordMetrics(data)
+	m.autoscaleBlockTime(ctx, headerHeight, len(data.Txs))
 
 	// Record extended block production metrics
 	productionTime := time.Since(timer.start)
@@ -833,6 +959,10 @@ func (m *Manager) execValidate(lastState types.State, header *types.SignedHeader
 		return fmt.Errorf("block time must be strictly increasing: got %v, last block time was %v",
 			headerTime, lastState.LastBlockTime)
 	}
+	if earliest := earliestBlockTime(m.genesis, header.Height(), lastState.LastBlockTime); headerTime.Before(earliest) {
+		return fmt.Errorf("block time %v is less than the minimum block time %s of the genesis after the last block time %v",
+			headerTime, m.genesis.BlockTimeBounds.Min, lastState.LastBlockTime)
+	}
 
 	// AppHash should match the last state's AppHash
 	if !bytes.Equal(header.AppHash, lastState.AppHash) {
@@ -918,9 +1048,13 @@ func (m *Manager) execCreateBlock(_ context.Context, height uint64, lastSignatur
 }
 
 func (m *Manager) execApplyBlock(ctx context.Context, lastState types.State, header types.Header, data *types.Data) (types.State, error) {
-	rawTxs := make([][]byte, len(data.Txs))
-	for i := range data.Txs {
-		rawTxs[i] = data.Txs[i]
+	rawTxs, err := resolveTxs(ctx, m.genesis, m.blobResolver, data.Txs)
+	if err != nil {
+		return types.State{}, err
+	}
+
+	if err := m.applySystemCalls(ctx, header.Height()); err != nil {
+		return types.State{}, err
 	}
 
 	ctx = context.WithValue(ctx, types.HeaderContextKey, header)
@@ -929,6 +1063,12 @@ func (m *Manager) execApplyBlock(ctx context.Context, lastState types.State, hea
 		return types.State{}, fmt.Errorf("failed to execute transactions: %w", err)
 	}
 
+	m.saveStateDiff(ctx, header.Height())
+	m.saveTxResults(ctx, header.Height(), len(rawTxs))
+	if err := m.scheduleSystemCalls(ctx, header.Height()); err != nil {
+		return types.State{}, err
+	}
+
 	s, err := lastState.NextState(header, newStateRoot)
 	if err != nil {
 		return types.State{}, err
@@ -590,6 +590,27 @@ func TestManager_execValidate(t *testing.T) {
 		require.ErrorContains(err, "block time must be strictly increasing")
 	})
 
+	t.Run("block time below the lower bound of the genesis", func(t *testing.T) {
+		state, header, data, privKey := makeValid()
+		state.LastBlockTime = header.Time().Add(-time.Second)
+		state.LastBlockHeight = m.genesis.InitialHeight
+		header.BaseHeader.Height = state.LastBlockHeight + 1
+		data.Metadata.Height = state.LastBlockHeight + 1
+		signer, err := noopsigner.NewNoopSigner(privKey)
+		require.NoError(err)
+		header.Signature, err = types.GetSignature(header.Header, signer)
+		require.NoError(err)
+		m.genesis.BlockTimeBounds = &genesispkg.BlockTimeBounds{
+			Min: genesispkg.Duration{Duration: 2 * time.Second},
+			Max: genesispkg.Duration{Duration: time.Minute},
+		}
+		defer func() { m.genesis.BlockTimeBounds = nil }()
+		err = m.execValidate(state, header, data)
+		require.ErrorContains(err, "less than the minimum block time")
+		state.LastBlockTime = header.Time().Add(-2 * time.Second)
+		require.NoError(m.execValidate(state, header, data))
+	})
+
 	t.Run("app hash mismatch", func(t *testing.T) {
 		state, header, data, _ := makeValid()
 		state.AppHash = []byte("different")
@@ -1041,6 +1062,7 @@ func TestSetRollkitHeightToDAHeight(t *testing.T) {
 		dataKey := fmt.Sprintf("%s/%d/d", storepkg.HeightToDAHeightKey, height)
 		mockStore.On("SetMetadata", mock.Anything, headerKey, mock.Anything).Return(nil)
 		mockStore.On("SetMetadata", mock.Anything, dataKey, mock.Anything).Return(nil)
+		mockStore.On("SetMetadata", mock.Anything, fmt.Sprintf("%s/%d", storepkg.DAIncludedTimeKey, height), mock.Anything).Return(nil)
 
 		// Call the method
 		err := m.SetSequencerHeightToDAHeight(ctx, height)
@@ -1071,6 +1093,7 @@ func TestSetRollkitHeightToDAHeight(t *testing.T) {
 		dataKey := fmt.Sprintf("%s/%d/d", storepkg.HeightToDAHeightKey, height)
 		mockStore.On("SetMetadata", mock.Anything, headerKey, mock.Anything).Return(nil)
 		mockStore.On("SetMetadata", mock.Anything, dataKey, mock.Anything).Return(nil)
+		mockStore.On("SetMetadata", mock.Anything, fmt.Sprintf("%s/%d", storepkg.DAIncludedTimeKey, height), mock.Anything).Return(nil)
 
 		// Call the method
 		err := m.SetSequencerHeightToDAHeight(ctx, height)
@@ -50,6 +50,9 @@ type Metrics struct {
 	SyncLag             metrics.Gauge
 	HeadersSynced       metrics.Counter
 	DataSynced          metrics.Counter
+	DataRecovered       metrics.Counter
+	DataQuarantined     metrics.Counter
+	SyncStalls          map[string]metrics.Counter
 	BlocksApplied       metrics.Counter
 	InvalidHeadersCount metrics.Counter
 
@@ -60,6 +63,13 @@ type Metrics struct {
 	NormalBlocksProduced metrics.Counter
 	TxsPerBlock          metrics.Histogram
 
+	// Store metrics
+	StoreCompactions              metrics.Counter
+	StoreCompactionFailures       metrics.Counter
+	StoreCompactionDuration       metrics.Histogram
+	StoreCompactionReclaimedBytes metrics.Counter
+	StoreDiskUsage                metrics.Gauge
+
 	// State transition metrics
 	StateTransitions   map[string]metrics.Counter
 	InvalidTransitions metrics.Counter
@@ -76,6 +86,7 @@ func PrometheusMetrics(namespace string, labelsAndValues ...string) *Metrics {
 		ChannelBufferUsage: make(map[string]metrics.Gauge),
 		ErrorsByType:       make(map[string]metrics.Counter),
 		OperationDuration:  make(map[string]metrics.Histogram),
+		SyncStalls:         make(map[string]metrics.Counter),
 		StateTransitions:   make(map[string]metrics.Counter),
 	}
 
@@ -275,6 +286,32 @@ func PrometheusMetrics(namespace string, labelsAndValues ...string) *Metrics {
 		Help:      "Total number of data blocks synced",
 	}, labels).With(labelsAndValues...)
 
+	m.DataRecovered = prometheus.NewCounterFrom(stdprometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: MetricsSubsystem,
+		Name:      "data_recovered_total",
+		Help:      "Total number of data blocks missing for synced headers that were recovered from DA",
+	}, labels).With(labelsAndValues...)
+
+	m.DataQuarantined = prometheus.NewCounterFrom(stdprometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: MetricsSubsystem,
+		Name:      "data_quarantined_total",
+		Help:      "Total number of data blocks rejected because they do not match the data commitment of their header",
+	}, labels).With(labelsAndValues...)
+
+	for _, cause := range stallCauses {
+		m.SyncStalls[cause] = prometheus.NewCounterFrom(stdprometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: MetricsSubsystem,
+			Name:      "sync_stalls_total",
+			Help:      "Total number of sync stalls by bottleneck",
+			ConstLabels: map[string]string{
+				"cause": cause,
+			},
+		}, labels).With(labelsAndValues...)
+	}
+
 	m.BlocksApplied = prometheus.NewCounterFrom(stdprometheus.CounterOpts{
 		Namespace: namespace,
 		Subsystem: MetricsSubsystem,
@@ -327,6 +364,43 @@ func PrometheusMetrics(namespace string, labelsAndValues ...string) *Metrics {
 		Buckets:   []float64{0, 1, 5, 10, 25, 50, 100, 250, 500, 1000, 2500, 5000},
 	}, labels).With(labelsAndValues...)
 
+	// Store metrics
+	m.StoreCompactions = prometheus.NewCounterFrom(stdprometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: MetricsSubsystem,
+		Name:      "store_compactions_total",
+		Help:      "Total number of store compactions",
+	}, labels).With(labelsAndValues...)
+
+	m.StoreCompactionFailures = prometheus.NewCounterFrom(stdprometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: MetricsSubsystem,
+		Name:      "store_compaction_failures_total",
+		Help:      "Total number of failed store compactions",
+	}, labels).With(labelsAndValues...)
+
+	m.StoreCompactionDuration = prometheus.NewHistogramFrom(stdprometheus.HistogramOpts{
+		Namespace: namespace,
+		Subsystem: MetricsSubsystem,
+		Name:      "store_compaction_duration_seconds",
+		Help:      "Time taken to compact the store",
+		Buckets:   []float64{.1, .5, 1, 5, 10, 30, 60, 300, 900},
+	}, labels).With(labelsAndValues...)
+
+	m.StoreCompactionReclaimedBytes = prometheus.NewCounterFrom(stdprometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: MetricsSubsystem,
+		Name:      "store_compaction_reclaimed_bytes_total",
+		Help:      "Total disk space in bytes reclaimed by store compactions",
+	}, labels).With(labelsAndValues...)
+
+	m.StoreDiskUsage = prometheus.NewGaugeFrom(stdprometheus.GaugeOpts{
+		Namespace: namespace,
+		Subsystem: MetricsSubsystem,
+		Name:      "store_disk_usage_bytes",
+		Help:      "Disk usage of the store in bytes",
+	}, labels).With(labelsAndValues...)
+
 	// State transition metrics
 	m.InvalidTransitions = prometheus.NewCounterFrom(stdprometheus.CounterOpts{
 		Namespace: namespace,
@@ -366,6 +440,7 @@ func NopMetrics() *Metrics {
 		ChannelBufferUsage:    make(map[string]metrics.Gauge),
 		ErrorsByType:          make(map[string]metrics.Counter),
 		OperationDuration:     make(map[string]metrics.Histogram),
+		SyncStalls:            make(map[string]metrics.Counter),
 		StateTransitions:      make(map[string]metrics.Counter),
 		DroppedSignals:        discard.NewCounter(),
 		RecoverableErrors:     discard.NewCounter(),
@@ -383,6 +458,8 @@ func NopMetrics() *Metrics {
 		SyncLag:               discard.NewGauge(),
 		HeadersSynced:         discard.NewCounter(),
 		DataSynced:            discard.NewCounter(),
+		DataRecovered:         discard.NewCounter(),
+		DataQuarantined:       discard.NewCounter(),
 		BlocksApplied:         discard.NewCounter(),
 		InvalidHeadersCount:   discard.NewCounter(),
 		BlockProductionTime:   discard.NewHistogram(),
@@ -391,6 +468,13 @@ func NopMetrics() *Metrics {
 		NormalBlocksProduced:  discard.NewCounter(),
 		TxsPerBlock:           discard.NewHistogram(),
 		InvalidTransitions:    discard.NewCounter(),
+
+		// Store metrics
+		StoreCompactions:              discard.NewCounter(),
+		StoreCompactionFailures:       discard.NewCounter(),
+		StoreCompactionDuration:       discard.NewHistogram(),
+		StoreCompactionReclaimedBytes: discard.NewCounter(),
+		StoreDiskUsage:                discard.NewGauge(),
 	}
 
 	// Initialize maps with no-op metrics
@@ -409,6 +493,10 @@ func NopMetrics() *Metrics {
 		m.OperationDuration[op] = discard.NewHistogram()
 	}
 
+	for _, cause := range stallCauses {
+		m.SyncStalls[cause] = discard.NewCounter()
+	}
+
 	transitions := []string{"pending_to_submitted", "submitted_to_included", "included_to_finalized"}
 	for _, transition := range transitions {
 		m.StateTransitions[transition] = discard.NewCounter()
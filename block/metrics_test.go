@@ -59,6 +59,13 @@ func TestMetrics(t *testing.T) {
 		assert.NotNil(t, em.NormalBlocksProduced)
 		assert.NotNil(t, em.TxsPerBlock)
 
+		// Test store metrics initialization
+		assert.NotNil(t, em.StoreCompactions)
+		assert.NotNil(t, em.StoreCompactionFailures)
+		assert.NotNil(t, em.StoreCompactionDuration)
+		assert.NotNil(t, em.StoreCompactionReclaimedBytes)
+		assert.NotNil(t, em.StoreDiskUsage)
+
 		// Test state transition metrics initialization
 		assert.Len(t, em.StateTransitions, 3)
 		assert.NotNil(t, em.StateTransitions["pending_to_submitted"])
@@ -0,0 +1,37 @@
+package block
+
+import (
+	"fmt"
+
+	"google.golang.org/protobuf/proto"
+
+	coresequencer "github.com/evstack/ev-node/core/sequencer"
+	storepkg "github.com/evstack/ev-node/pkg/store"
+	pb "github.com/evstack/ev-node/types/pb/evnode/v1"
+)
+
+// saveOrderflow records the attribution of the transactions of a produced block to the orderflow
+// sources of the sequencer, if any were included, saved when the block is committed. A block
+// resumed after a crash before its commit loses its attribution. The attribution is an optional
+// artifact, so failures are logged and do not stop block production.
+func (m *Manager) saveOrderflow(height uint64, inclusions []coresequencer.BundleInclusion) {
+	if len(inclusions) == 0 {
+		return
+	}
+
+	record := &pb.OrderflowAttribution{Height: height}
+	for _, inclusion := range inclusions {
+		record.Inclusions = append(record.Inclusions, &pb.OrderflowInclusion{
+			Source:   inclusion.Source,
+			BundleId: inclusion.BundleID,
+			Start:    uint64(inclusion.Start),
+			Count:    uint64(inclusion.Count),
+		})
+	}
+	bz, err := proto.Marshal(record)
+	if err != nil {
+		m.logger.Error().Err(err).Uint64("height", height).Msg("failed to marshal orderflow attribution")
+		return
+	}
+	m.blockMetadata.set(height, fmt.Sprintf("%s/%d", storepkg.OrderflowKey, height), bz)
+}
@@ -0,0 +1,47 @@
+package block
+
+import (
+	"context"
+	"testing"
+
+	ds "github.com/ipfs/go-datastore"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+
+	coresequencer "github.com/evstack/ev-node/core/sequencer"
+	storepkg "github.com/evstack/ev-node/pkg/store"
+	pb "github.com/evstack/ev-node/types/pb/evnode/v1"
+)
+
+func TestSaveOrderflow(t *testing.T) {
+	ctx := context.Background()
+	kv, err := storepkg.NewDefaultInMemoryKVStore()
+	require.NoError(t, err)
+	m := &Manager{store: storepkg.New(kv), logger: zerolog.Nop()}
+
+	m.saveOrderflow(5, []coresequencer.BundleInclusion{
+		{Source: "private", BundleID: "p1", Start: 0, Count: 2},
+		{Source: "builder", BundleID: "b1", Start: 2, Count: 1},
+	})
+	// saved when the block is committed
+	_, err = m.store.GetMetadata(ctx, "rof/5")
+	require.ErrorIs(t, err, ds.ErrNotFound)
+	require.NoError(t, m.saveBlockMetadata(ctx, m.store, 5))
+	bz, err := m.store.GetMetadata(ctx, "rof/5")
+	require.NoError(t, err)
+	var record pb.OrderflowAttribution
+	require.NoError(t, proto.Unmarshal(bz, &record))
+	require.Equal(t, uint64(5), record.Height)
+	require.Len(t, record.Inclusions, 2)
+	require.Equal(t, "builder", record.Inclusions[1].Source)
+	require.Equal(t, "b1", record.Inclusions[1].BundleId)
+	require.Equal(t, uint64(2), record.Inclusions[1].Start)
+	require.Equal(t, uint64(1), record.Inclusions[1].Count)
+
+	// nothing is recorded for the blocks without orderflow
+	m.saveOrderflow(6, nil)
+	require.NoError(t, m.saveBlockMetadata(ctx, m.store, 6))
+	_, err = m.store.GetMetadata(ctx, "rof/6")
+	require.ErrorIs(t, err, ds.ErrNotFound)
+}
@@ -0,0 +1,41 @@
+package block
+
+import (
+	"context"
+
+	"github.com/evstack/ev-node/types"
+	pb "github.com/evstack/ev-node/types/pb/evnode/v1"
+)
+
+// Previews gossips the unsigned preview blocks of the chain, see node.preview_blocks.
+type Previews interface {
+	Publish(ctx context.Context, header *types.Header, data *types.Data) error
+	Subscribe() (<-chan *pb.Block, func())
+}
+
+// SetPreviews sets the gossip of the preview blocks. An aggregator publishes the preview of every
+// block it produces once executed, before signing its header.
+func (m *Manager) SetPreviews(previews Previews) {
+	m.previews = previews
+}
+
+// SubscribePreviews returns the preview blocks published or received from now on and a function
+// to stop receiving them, or false if the node does not gossip previews.
+func (m *Manager) SubscribePreviews() (<-chan *pb.Block, func(), bool) {
+	if m.previews == nil {
+		return nil, nil, false
+	}
+	previews, cancel := m.previews.Subscribe()
+	return previews, cancel, true
+}
+
+// publishPreview publishes the preview of an executed block. Failures are only logged, as the
+// previews are a best effort ahead of the signed header.
+func (m *Manager) publishPreview(ctx context.Context, header *types.SignedHeader, data *types.Data) {
+	if m.previews == nil {
+		return
+	}
+	if err := m.previews.Publish(ctx, &header.Header, data); err != nil {
+		m.logger.Warn().Err(err).Uint64("height", header.Height()).Msg("failed to publish preview block")
+	}
+}
@@ -214,8 +214,7 @@ func Test_publishBlock_NoBatch(t *testing.T) {
 	mockStore.AssertNotCalled(t, "SaveBlockData", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
 	mockExec.AssertNotCalled(t, "ExecuteTxs", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything)
 	mockExec.AssertNotCalled(t, "SetFinal", mock.Anything, mock.Anything)
-	mockStore.AssertNotCalled(t, "SetHeight", mock.Anything, mock.Anything)
-	mockStore.AssertNotCalled(t, "UpdateState", mock.Anything, mock.Anything)
+	mockStore.AssertNotCalled(t, "Batch", mock.Anything)
 
 	mockSeq.AssertExpectations(t)
 	mockStore.AssertExpectations(t)
@@ -311,14 +310,13 @@ func Test_publishBlock_EmptyBatch(t *testing.T) {
 	newAppHash := []byte("newAppHash")
 	mockExec.On("ExecuteTxs", mock.Anything, mock.Anything, currentHeight+1, mock.AnythingOfType("time.Time"), m.lastState.AppHash).Return(newAppHash, uint64(100), nil).Once()
 
-	// SetHeight should be called
-	mockStore.On("SetHeight", ctx, currentHeight+1).Return(nil).Once()
-
-	// UpdateState should be called
-	mockStore.On("UpdateState", ctx, mock.AnythingOfType("types.State")).Return(nil).Once()
-
-	// SaveBlockData should be called again after validation
-	mockStore.On("SaveBlockData", ctx, mock.AnythingOfType("*types.SignedHeader"), mock.AnythingOfType("*types.Data"), mock.AnythingOfType("*types.Signature")).Return(nil).Once()
+	// The block, height and state should be committed in a batch after validation
+	mockBatch := mocks.NewMockBatch(t)
+	mockStore.On("Batch", ctx).Return(mockBatch, nil).Once()
+	mockBatch.On("SaveBlockData", ctx, mock.AnythingOfType("*types.SignedHeader"), mock.AnythingOfType("*types.Data"), mock.AnythingOfType("*types.Signature")).Return(nil).Once()
+	mockBatch.On("SetHeight", ctx, currentHeight+1).Return(nil).Once()
+	mockBatch.On("UpdateState", ctx, mock.AnythingOfType("types.State")).Return(nil).Once()
+	mockBatch.On("Commit", ctx).Return(nil).Once()
 
 	// Call publishBlock
 	err = m.publishBlock(ctx)
@@ -351,9 +349,12 @@ func Test_publishBlock_Success(t *testing.T) {
 	mockStore.On("GetBlockData", t.Context(), initialHeight).Return(lastHeader, lastData, nil).Once()
 	mockStore.On("GetBlockData", t.Context(), newHeight).Return(nil, nil, errors.New("not found")).Once()
 	mockStore.On("SaveBlockData", t.Context(), mock.AnythingOfType("*types.SignedHeader"), mock.AnythingOfType("*types.Data"), mock.AnythingOfType("*types.Signature")).Return(nil).Once()
-	mockStore.On("SaveBlockData", t.Context(), mock.AnythingOfType("*types.SignedHeader"), mock.AnythingOfType("*types.Data"), mock.AnythingOfType("*types.Signature")).Return(nil).Once()
-	mockStore.On("SetHeight", t.Context(), newHeight).Return(nil).Once()
-	mockStore.On("UpdateState", t.Context(), mock.AnythingOfType("types.State")).Return(nil).Once()
+	mockBatch := mocks.NewMockBatch(t)
+	mockStore.On("Batch", t.Context()).Return(mockBatch, nil).Once()
+	mockBatch.On("SaveBlockData", t.Context(), mock.AnythingOfType("*types.SignedHeader"), mock.AnythingOfType("*types.Data"), mock.AnythingOfType("*types.Signature")).Return(nil).Once()
+	mockBatch.On("SetHeight", t.Context(), newHeight).Return(nil).Once()
+	mockBatch.On("UpdateState", t.Context(), mock.AnythingOfType("types.State")).Return(nil).Once()
+	mockBatch.On("Commit", t.Context()).Return(nil).Once()
 	mockStore.On("SetMetadata", t.Context(), storepkg.LastBatchDataKey, mock.AnythingOfType("[]uint8")).Return(nil).Once()
 
 	headerCh := make(chan *types.SignedHeader, 1)
@@ -0,0 +1,112 @@
+package block
+
+import (
+	"context"
+	"strconv"
+	"sync/atomic"
+	"time"
+
+	ds "github.com/ipfs/go-datastore"
+
+	"github.com/evstack/ev-node/pkg/journal"
+	storepkg "github.com/evstack/ev-node/pkg/store"
+)
+
+// diskQuotaCheckInterval is the interval at which the disk usage of the store is checked.
+const diskQuotaCheckInterval = 10 * time.Second
+
+// diskQuotaHighWatermark is the fraction of the disk quota above which the garbage of the store
+// is collected, to reclaim space before the quota is reached.
+const diskQuotaHighWatermark = 0.9
+
+// diskQuota tracks the disk usage of the store against Node.MaxDiskUsage. The zero value is ready
+// to use.
+type diskQuota struct {
+	// usage is the disk usage of the store at the last check
+	usage atomic.Uint64
+	// readOnly is set while the disk usage of the store is at or above the quota
+	readOnly atomic.Bool
+}
+
+// SetPruningManager sets the pruning manager of the store, run in an emergency when the disk usage
+// of the store approaches Node.MaxDiskUsage.
+func (m *Manager) SetPruningManager(pruning *storepkg.PruningManager) {
+	m.pruning = pruning
+}
+
+// DiskQuotaStatus returns whether the node stopped writing blocks because its store reached
+// Node.MaxDiskUsage, and the disk usage of the store at the last check.
+func (m *Manager) DiskQuotaStatus() (readOnly bool, usage uint64) {
+	return m.diskQuota.readOnly.Load(), m.diskQuota.usage.Load()
+}
+
+// DiskQuotaLoop checks the disk usage of the store against Node.MaxDiskUsage until ctx is done.
+// Above the high watermark the whole pruning backlog is pruned at once, according to the pruning
+// strategy, and the garbage of the store is collected; once the quota is reached, the
+// node stops producing and syncing blocks until the usage drops below it, instead of failing on
+// a full disk in the middle of a write.
+func (m *Manager) DiskQuotaLoop(ctx context.Context) {
+	if m.config.Node.MaxDiskUsage == 0 {
+		return
+	}
+	if _, ok := m.store.(ds.PersistentFeature); !ok {
+		m.logger.Warn().Msg("store does not report its disk usage, disk quota not enforced")
+		return
+	}
+
+	ticker := time.NewTicker(diskQuotaCheckInterval)
+	defer ticker.Stop()
+	for {
+		m.checkDiskQuota(ctx)
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// checkDiskQuota measures the disk usage of the store, pruning it and collecting its garbage above
+// the high watermark, and switches the node to or from read-only.
+func (m *Manager) checkDiskQuota(ctx context.Context) {
+	quota := m.config.Node.MaxDiskUsage
+	usage, err := m.store.(ds.PersistentFeature).DiskUsage(ctx)
+	if err != nil {
+		m.logger.Warn().Err(err).Msg("failed to get the disk usage of the store")
+		return
+	}
+
+	if float64(usage) >= diskQuotaHighWatermark*float64(quota) {
+		if m.pruning != nil {
+			m.logger.Warn().Uint64("usage", usage).Uint64("quota", quota).Msg("store approaching its disk quota, pruning")
+			if _, err := m.pruning.PruneBacklog(ctx); err != nil {
+				m.logger.Warn().Err(err).Msg("failed to prune the store")
+			}
+		}
+		if _, ok := m.store.(ds.GCFeature); ok {
+			m.logger.Warn().Uint64("usage", usage).Uint64("quota", quota).Msg("store approaching its disk quota, collecting garbage")
+			if err := m.CompactStore(ctx); err != nil {
+				m.logger.Warn().Err(err).Msg("failed to collect the garbage of the store")
+			} else if usage, err = m.store.(ds.PersistentFeature).DiskUsage(ctx); err != nil {
+				m.logger.Warn().Err(err).Msg("failed to get the disk usage of the store")
+				return
+			}
+		}
+	}
+	m.diskQuota.usage.Store(usage)
+	m.metrics.StoreDiskUsage.Set(float64(usage))
+
+	exceeded := usage >= quota
+	if m.diskQuota.readOnly.Swap(exceeded) == exceeded {
+		return
+	}
+	if exceeded {
+		m.logger.Error().Uint64("usage", usage).Uint64("quota", quota).Msg("store reached its disk quota, no blocks are written until disk space is freed")
+		m.recordEvent(ctx, journal.EventDiskQuotaExceeded, "store reached its disk quota", map[string]string{
+			"usage": strconv.FormatUint(usage, 10),
+			"quota": strconv.FormatUint(quota, 10),
+		})
+	} else {
+		m.logger.Info().Uint64("usage", usage).Uint64("quota", quota).Msg("store back below its disk quota, resuming block writes")
+	}
+}
@@ -0,0 +1,121 @@
+package block
+
+import (
+	"context"
+	"encoding/binary"
+	"fmt"
+	"testing"
+
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/evstack/ev-node/pkg/config"
+	storepkg "github.com/evstack/ev-node/pkg/store"
+	"github.com/evstack/ev-node/test/mocks"
+	"github.com/evstack/ev-node/types"
+)
+
+// quotaStore is a store reporting a fixed disk usage, from which garbage collection reclaims
+// a fixed amount.
+type quotaStore struct {
+	storepkg.Store
+	usage     uint64
+	reclaimed uint64
+	gcCalls   int
+}
+
+func (s *quotaStore) DiskUsage(context.Context) (uint64, error) { return s.usage, nil }
+
+func (s *quotaStore) CollectGarbage(context.Context) error {
+	s.gcCalls++
+	s.usage -= min(s.reclaimed, s.usage)
+	return nil
+}
+
+func TestCheckDiskQuota(t *testing.T) {
+	ctx := context.Background()
+	mockStore := mocks.NewMockStore(t)
+	s := &quotaStore{Store: mockStore}
+	m := &Manager{
+		store:   s,
+		logger:  zerolog.Nop(),
+		metrics: NopMetrics(),
+		config:  config.Config{Node: config.NodeConfig{MaxDiskUsage: 1000}},
+	}
+
+	// below the high watermark, the garbage is not collected
+	s.usage = 800
+	m.checkDiskQuota(ctx)
+	readOnly, usage := m.DiskQuotaStatus()
+	assert.False(t, readOnly)
+	assert.Equal(t, uint64(800), usage)
+	assert.Zero(t, s.gcCalls)
+
+	// above it, garbage collection keeps the store below the quota
+	s.usage, s.reclaimed = 950, 100
+	m.checkDiskQuota(ctx)
+	readOnly, usage = m.DiskQuotaStatus()
+	assert.False(t, readOnly)
+	assert.Equal(t, uint64(850), usage)
+	assert.Equal(t, 1, s.gcCalls)
+
+	// the node is read-only while garbage collection does not free enough space
+	s.usage, s.reclaimed = 1100, 50
+	m.checkDiskQuota(ctx)
+	readOnly, usage = m.DiskQuotaStatus()
+	assert.True(t, readOnly)
+	assert.Equal(t, uint64(1050), usage)
+
+	// no blocks are produced nor synced
+	require.NoError(t, m.publishBlockInternal(ctx))
+	require.NoError(t, m.trySyncNextBlock(ctx, 0))
+	mockStore.AssertNotCalled(t, "Height")
+
+	s.usage = 500
+	m.checkDiskQuota(ctx)
+	readOnly, _ = m.DiskQuotaStatus()
+	assert.False(t, readOnly)
+}
+
+func TestCheckDiskQuotaPrunes(t *testing.T) {
+	ctx := context.Background()
+	kv, err := storepkg.NewDefaultInMemoryKVStore()
+	require.NoError(t, err)
+	store := storepkg.New(kv)
+	for h := uint64(1); h <= 3; h++ {
+		header, data := types.GetRandomBlock(h, 2, "test-quota")
+		require.NoError(t, store.SaveBlockData(ctx, header, data, &header.Signature))
+		require.NoError(t, store.SetHeight(ctx, h))
+		require.NoError(t, store.SetMetadata(ctx, fmt.Sprintf("%s/%d/d", storepkg.HeightToDAHeightKey, h), binary.LittleEndian.AppendUint64(nil, 100+h)))
+	}
+	require.NoError(t, store.SetMetadata(ctx, storepkg.DAIncludedHeightKey, binary.LittleEndian.AppendUint64(nil, 3)))
+	pruning, err := storepkg.NewPruningManager(store, storepkg.PruningDefault, 1, zerolog.Nop())
+	require.NoError(t, err)
+
+	s := &quotaStore{Store: store}
+	m := &Manager{
+		store:   s,
+		logger:  zerolog.Nop(),
+		metrics: NopMetrics(),
+		config:  config.Config{Node: config.NodeConfig{MaxDiskUsage: 1000}},
+	}
+	m.SetPruningManager(pruning)
+
+	// below the high watermark, nothing is pruned
+	s.usage = 800
+	m.checkDiskQuota(ctx)
+	base, err := storepkg.PrunedBaseHeight(ctx, store)
+	require.NoError(t, err)
+	assert.Zero(t, base)
+
+	// above it, the store is pruned according to the pruning strategy before collecting garbage
+	s.usage, s.reclaimed = 950, 100
+	m.checkDiskQuota(ctx)
+	base, err = storepkg.PrunedBaseHeight(ctx, store)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(2), base)
+	assert.Equal(t, 1, s.gcCalls)
+	readOnly, _ := m.DiskQuotaStatus()
+	assert.False(t, readOnly)
+}
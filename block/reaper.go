@@ -3,7 +3,9 @@ package block
 import (
 	"context"
 	"crypto/sha256"
+	"encoding/binary"
 	"encoding/hex"
+	"errors"
 	"time"
 
 	ds "github.com/ipfs/go-datastore"
@@ -11,10 +13,18 @@ import (
 
 	coreexecutor "github.com/evstack/ev-node/core/execution"
 	coresequencer "github.com/evstack/ev-node/core/sequencer"
+	"github.com/evstack/ev-node/types"
 )
 
 const DefaultInterval = 1 * time.Second
 
+// maxBackoffIntervals bounds the backoff applied while the sequencer is full, in reaping intervals.
+const maxBackoffIntervals = 30
+
+// pendingTxTTL is the time a submitted transaction is reported as submitted for. Transactions not
+// included by then were likely dropped by the sequencer.
+const pendingTxTTL = 10 * time.Minute
+
 // Reaper is responsible for periodically retrieving transactions from the executor,
 // filtering out already seen transactions, and submitting new transactions to the sequencer.
 type Reaper struct {
@@ -26,6 +36,20 @@ type Reaper struct {
 	ctx       context.Context
 	seenStore ds.Batching
 	manager   *Manager
+
+	// maxBytes and maxGas bound the transactions pulled at once from executors implementing
+	// coreexecutor.LimitedTxGetter. 0 means no limit.
+	maxBytes uint64
+	maxGas   uint64
+
+	// dropBlobPointers drops the transactions with the blob pointer prefix, which only the
+	// sequencer may emit.
+	dropBlobPointers bool
+
+	// backoff is the current pause between pulls while the sequencer reports being full,
+	// and resumeAt the time of the next pull.
+	backoff  time.Duration
+	resumeAt time.Time
 }
 
 // NewReaper creates a new Reaper instance with persistent seenTx storage.
@@ -49,6 +73,19 @@ func (r *Reaper) SetManager(manager *Manager) {
 	r.manager = manager
 }
 
+// SetLimits sets the maximum total size and gas of the transactions pulled at once from the
+// executor, if it implements coreexecutor.LimitedTxGetter. 0 means no limit.
+func (r *Reaper) SetLimits(maxBytes, maxGas uint64) {
+	r.maxBytes = maxBytes
+	r.maxGas = maxGas
+}
+
+// SetDropBlobPointers sets whether transactions starting with the blob pointer prefix are dropped
+// instead of submitted to the sequencer, as required when the chain enables blob pointers.
+func (r *Reaper) SetDropBlobPointers(drop bool) {
+	r.dropBlobPointers = drop
+}
+
 // Start begins the reaping process at the specified interval.
 func (r *Reaper) Start(ctx context.Context) {
 	r.ctx = ctx
@@ -69,15 +106,31 @@ func (r *Reaper) Start(ctx context.Context) {
 }
 
 // SubmitTxs retrieves transactions from the executor and submits them to the sequencer.
+//
+// When the sequencer reports being full, the transactions are not marked as seen, so that they are
+// pulled again, and the reaper pauses pulling with an exponential backoff until the sequencer
+// accepts transactions again.
 func (r *Reaper) SubmitTxs() {
-	txs, err := r.exec.GetTxs(r.ctx)
+	if time.Now().Before(r.resumeAt) {
+		return
+	}
+
+	txs, err := r.getTxs()
 	if err != nil {
 		r.logger.Error().Err(err).Msg("Reaper failed to get txs from executor")
 		return
 	}
+	if len(txs) == 0 {
+		r.logger.Debug().Msg("Reaper found no txs in the executor mempool")
+		return
+	}
 
 	var newTxs [][]byte
 	for _, tx := range txs {
+		if r.dropBlobPointers && types.IsBlobPointer(tx) {
+			r.logger.Warn().Str("txHash", hashTx(tx)).Msg("Reaper dropped a transaction with the blob pointer prefix")
+			continue
+		}
 		txHash := hashTx(tx)
 		key := ds.NewKey(txHash)
 		has, err := r.seenStore.Has(r.ctx, key)
@@ -101,15 +154,23 @@ func (r *Reaper) SubmitTxs() {
 		Id:    []byte(r.chainID),
 		Batch: &coresequencer.Batch{Transactions: newTxs},
 	})
+	if errors.Is(err, coresequencer.ErrQueueFull) {
+		r.backoff = min(max(2*r.backoff, r.interval), maxBackoffIntervals*r.interval)
+		r.resumeAt = time.Now().Add(r.backoff)
+		r.logger.Warn().Dur("backoff", r.backoff).Int("txCount", len(newTxs)).Msg("Sequencer is full, pausing reaping")
+		return
+	}
 	if err != nil {
 		r.logger.Error().Err(err).Msg("Reaper failed to submit txs to sequencer")
 		return
 	}
+	r.backoff = 0
 
+	submittedAt := binary.LittleEndian.AppendUint64(nil, uint64(time.Now().UnixNano()))
 	for _, tx := range newTxs {
 		txHash := hashTx(tx)
 		key := ds.NewKey(txHash)
-		if err := r.seenStore.Put(r.ctx, key, []byte{1}); err != nil {
+		if err := r.seenStore.Put(r.ctx, key, submittedAt); err != nil {
 			r.logger.Error().Err(err).Str("txHash", txHash).Msg("Failed to persist seen tx")
 		}
 	}
@@ -123,6 +184,38 @@ func (r *Reaper) SubmitTxs() {
 	r.logger.Debug().Msg("Reaper successfully submitted txs")
 }
 
+// IsTxSubmitted returns whether the transaction with the given hex encoded SHA-256 hash was
+// submitted to the sequencer by the reaper within pendingTxTTL. Transactions submitted before are
+// still never submitted again.
+func (r *Reaper) IsTxSubmitted(ctx context.Context, txHash string) (bool, error) {
+	submittedAt, err := r.seenStore.Get(ctx, ds.NewKey(txHash))
+	if errors.Is(err, ds.ErrNotFound) {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+	// transactions seen before their submission time was recorded are expired
+	if len(submittedAt) != 8 {
+		return false, nil
+	}
+	return time.Since(time.Unix(0, int64(binary.LittleEndian.Uint64(submittedAt)))) < pendingTxTTL, nil
+}
+
+// getTxs pulls the candidate transactions from the executor, within the limits if it supports them.
+// getTxs pulls the transactions of the executor of the manager, if set, which may be switched
+// while the node runs, see Manager.UpgradeExecutor.
+func (r *Reaper) getTxs() ([][]byte, error) {
+	exec := r.exec
+	if r.manager != nil {
+		exec = r.manager.GetExecutor()
+	}
+	if getter, ok := exec.(coreexecutor.LimitedTxGetter); ok {
+		return getter.GetTxsWithLimits(r.ctx, r.maxBytes, r.maxGas)
+	}
+	return exec.GetTxs(r.ctx)
+}
+
 func hashTx(tx []byte) string {
 	hash := sha256.Sum256(tx)
 	return hex.EncodeToString(hash[:])
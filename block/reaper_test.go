@@ -1,8 +1,11 @@
 package block
 
 import (
+	"context"
 	"crypto/sha256"
+	"encoding/binary"
 	"encoding/hex"
+	"fmt"
 	"testing"
 	"time"
 
@@ -14,6 +17,7 @@ import (
 
 	coresequencer "github.com/evstack/ev-node/core/sequencer"
 	testmocks "github.com/evstack/ev-node/test/mocks"
+	"github.com/evstack/ev-node/types"
 )
 
 // TestReaper_SubmitTxs_Success verifies that the Reaper successfully submits new transactions to the sequencer.
@@ -42,6 +46,19 @@ func TestReaper_SubmitTxs_Success(t *testing.T) {
 	// Run once and ensure transaction is submitted
 	reaper.SubmitTxs()
 	mockSeq.AssertCalled(t, "SubmitBatchTxs", mock.Anything, submitReqMatcher)
+	submitted, err := reaper.IsTxSubmitted(t.Context(), hashTx(tx))
+	require.NoError(t, err)
+	require.True(t, submitted)
+
+	// submitted transactions expire
+	expired := binary.LittleEndian.AppendUint64(nil, uint64(time.Now().Add(-pendingTxTTL).UnixNano()))
+	require.NoError(t, store.Put(t.Context(), ds.NewKey(hashTx(tx)), expired))
+	submitted, err = reaper.IsTxSubmitted(t.Context(), hashTx(tx))
+	require.NoError(t, err)
+	require.False(t, submitted)
+	submitted, err = reaper.IsTxSubmitted(t.Context(), hashTx([]byte("unknown")))
+	require.NoError(t, err)
+	require.False(t, submitted)
 
 	mockExec.On("GetTxs", mock.Anything).Return([][]byte{tx}, nil).Once()
 
@@ -130,3 +147,117 @@ func TestReaper_TxPersistence_AcrossRestarts(t *testing.T) {
 	mockExec2.AssertExpectations(t)
 	mockSeq2.AssertNotCalled(t, "SubmitBatchTxs", mock.Anything, mock.Anything)
 }
+
+// limitedExecutor is an executor bounding the transactions it returns from its mempool.
+type limitedExecutor struct {
+	*testmocks.MockExecutor
+	txs              [][]byte
+	maxBytes, maxGas uint64
+}
+
+func (e *limitedExecutor) GetTxsWithLimits(_ context.Context, maxBytes, maxGas uint64) ([][]byte, error) {
+	e.maxBytes, e.maxGas = maxBytes, maxGas
+	return e.txs, nil
+}
+
+// TestReaper_SubmitTxs_WithLimits verifies that the Reaper pulls transactions within its limits from executors supporting them.
+func TestReaper_SubmitTxs_WithLimits(t *testing.T) {
+	t.Parallel()
+
+	exec := &limitedExecutor{MockExecutor: testmocks.NewMockExecutor(t), txs: [][]byte{[]byte("tx1")}}
+	mockSeq := testmocks.NewMockSequencer(t)
+	store := dsync.MutexWrap(ds.NewMapDatastore())
+
+	reaper := NewReaper(t.Context(), exec, mockSeq, "test-chain", 100*time.Millisecond, zerolog.Nop(), store)
+	reaper.SetLimits(1024, 30_000_000)
+
+	mockSeq.On("SubmitBatchTxs", mock.Anything, mock.Anything).Return(&coresequencer.SubmitBatchTxsResponse{}, nil).Once()
+	reaper.SubmitTxs()
+
+	require.Equal(t, uint64(1024), exec.maxBytes)
+	require.Equal(t, uint64(30_000_000), exec.maxGas)
+	mockSeq.AssertExpectations(t)
+	// GetTxs is not used when limits are supported
+	exec.AssertNotCalled(t, "GetTxs", mock.Anything)
+}
+
+// TestReaper_SubmitTxs_DropBlobPointers verifies that the Reaper drops mempool transactions with the blob pointer prefix when blob pointers are enabled.
+func TestReaper_SubmitTxs_DropBlobPointers(t *testing.T) {
+	t.Parallel()
+
+	mockExec := testmocks.NewMockExecutor(t)
+	mockSeq := testmocks.NewMockSequencer(t)
+	store := dsync.MutexWrap(ds.NewMapDatastore())
+
+	reaper := NewReaper(t.Context(), mockExec, mockSeq, "test-chain", 100*time.Millisecond, zerolog.Nop(), store)
+	reaper.SetDropBlobPointers(true)
+
+	pointer := types.NewBlobPointer("https://example.com/batch", []byte("payload")).Tx()
+	mockExec.On("GetTxs", mock.Anything).Return([][]byte{pointer, []byte("tx1")}, nil).Once()
+	submitReqMatcher := mock.MatchedBy(func(req coresequencer.SubmitBatchTxsRequest) bool {
+		return len(req.Batch.Transactions) == 1 && string(req.Batch.Transactions[0]) == "tx1"
+	})
+	mockSeq.On("SubmitBatchTxs", mock.Anything, submitReqMatcher).Return(&coresequencer.SubmitBatchTxsResponse{}, nil).Once()
+
+	reaper.SubmitTxs()
+	mockSeq.AssertExpectations(t)
+}
+
+// TestReaper_SubmitTxs_SwitchedExecutor verifies that the Reaper pulls transactions from the current executor of the manager.
+func TestReaper_SubmitTxs_SwitchedExecutor(t *testing.T) {
+	t.Parallel()
+
+	oldExec := testmocks.NewMockExecutor(t)
+	newExec := testmocks.NewMockExecutor(t)
+	mockSeq := testmocks.NewMockSequencer(t)
+	store := dsync.MutexWrap(ds.NewMapDatastore())
+
+	reaper := NewReaper(t.Context(), oldExec, mockSeq, "test-chain", 100*time.Millisecond, zerolog.Nop(), store)
+	reaper.SetManager(&Manager{exec: newExec})
+
+	newExec.On("GetTxs", mock.Anything).Return([][]byte{[]byte("tx1")}, nil).Once()
+	mockSeq.On("SubmitBatchTxs", mock.Anything, mock.Anything).Return(&coresequencer.SubmitBatchTxsResponse{}, nil).Once()
+	reaper.SubmitTxs()
+
+	newExec.AssertExpectations(t)
+	oldExec.AssertNotCalled(t, "GetTxs", mock.Anything)
+}
+
+// TestReaper_SubmitTxs_Backpressure verifies that the Reaper backs off and keeps the transactions while the sequencer is full.
+func TestReaper_SubmitTxs_Backpressure(t *testing.T) {
+	t.Parallel()
+
+	mockExec := testmocks.NewMockExecutor(t)
+	mockSeq := testmocks.NewMockSequencer(t)
+	store := dsync.MutexWrap(ds.NewMapDatastore())
+	interval := 10 * time.Millisecond
+
+	reaper := NewReaper(t.Context(), mockExec, mockSeq, "test-chain", interval, zerolog.Nop(), store)
+
+	tx := []byte("tx1")
+	mockExec.On("GetTxs", mock.Anything).Return([][]byte{tx}, nil)
+	mockSeq.On("SubmitBatchTxs", mock.Anything, mock.Anything).
+		Return(nil, fmt.Errorf("batch queue is full: %w", coresequencer.ErrQueueFull)).Twice()
+
+	reaper.SubmitTxs()
+	require.Equal(t, interval, reaper.backoff)
+
+	// no pull until the backoff elapsed
+	reaper.SubmitTxs()
+	mockExec.AssertNumberOfCalls(t, "GetTxs", 1)
+
+	// the backoff doubles while the sequencer stays full
+	time.Sleep(interval)
+	reaper.SubmitTxs()
+	require.Equal(t, 2*interval, reaper.backoff)
+
+	// the rejected transaction was not marked as seen and is submitted once the sequencer accepts it
+	mockSeq.On("SubmitBatchTxs", mock.Anything, mock.Anything).Return(&coresequencer.SubmitBatchTxsResponse{}, nil).Once()
+	time.Sleep(2 * interval)
+	reaper.SubmitTxs()
+	require.Zero(t, reaper.backoff)
+	mockSeq.AssertNumberOfCalls(t, "SubmitBatchTxs", 3)
+	has, err := store.Has(t.Context(), ds.NewKey(hashTx(tx)))
+	require.NoError(t, err)
+	require.True(t, has)
+}
@@ -0,0 +1,268 @@
+package block
+
+import (
+	"bytes"
+	"context"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"sync"
+
+	ds "github.com/ipfs/go-datastore"
+
+	coreda "github.com/evstack/ev-node/core/da"
+	storepkg "github.com/evstack/ev-node/pkg/store"
+	"github.com/evstack/ev-node/types"
+)
+
+// SyncSource identifies where the header or data of a synced block was obtained from.
+type SyncSource string
+
+const (
+	// SyncSourceP2P is used for headers and data received from P2P sync.
+	SyncSourceP2P SyncSource = "p2p"
+	// SyncSourceDA is used for headers and data found by the regular DA retrieval.
+	SyncSourceDA SyncSource = "da"
+	// SyncSourceDARecovery is used for data fetched from DA because it was missing for a synced header.
+	SyncSourceDARecovery SyncSource = "da-recovery"
+	// SyncSourceEmpty is used for data of blocks without transactions, which is derived from the header.
+	SyncSourceEmpty SyncSource = "empty"
+)
+
+// dataRecoveryWindow is the number of DA heights searched for the data of a single block.
+const dataRecoveryWindow = 16
+
+// heightSyncSources holds the sources of the header and data of a single height.
+type heightSyncSources struct {
+	header SyncSource
+	data   SyncSource
+}
+
+// syncSourceTracker tracks the sources of heights waiting to be applied.
+// The zero value is ready to use.
+type syncSourceTracker struct {
+	mu      sync.Mutex
+	sources map[uint64]heightSyncSources
+
+	// applied counts the headers and data of the heights popped, by source
+	appliedHeaders map[SyncSource]uint64
+	appliedData    map[SyncSource]uint64
+}
+
+func (t *syncSourceTracker) setHeader(height uint64, source SyncSource) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if t.sources == nil {
+		t.sources = make(map[uint64]heightSyncSources)
+	}
+	s := t.sources[height]
+	s.header = source
+	t.sources[height] = s
+}
+
+func (t *syncSourceTracker) setData(height uint64, source SyncSource) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if t.sources == nil {
+		t.sources = make(map[uint64]heightSyncSources)
+	}
+	s := t.sources[height]
+	s.data = source
+	t.sources[height] = s
+}
+
+// pop returns the sources of the given height and stops tracking it.
+func (t *syncSourceTracker) pop(height uint64) heightSyncSources {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	s, ok := t.sources[height]
+	if !ok {
+		return s
+	}
+	delete(t.sources, height)
+	if t.appliedHeaders == nil {
+		t.appliedHeaders = make(map[SyncSource]uint64)
+		t.appliedData = make(map[SyncSource]uint64)
+	}
+	if s.header != "" {
+		t.appliedHeaders[s.header]++
+	}
+	if s.data != "" {
+		t.appliedData[s.data]++
+	}
+	return s
+}
+
+// applied returns the number of headers and data popped, by source.
+func (t *syncSourceTracker) applied() (headers, data map[string]uint64) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	headers = make(map[string]uint64, len(t.appliedHeaders))
+	for source, n := range t.appliedHeaders {
+		headers[string(source)] = n
+	}
+	data = make(map[string]uint64, len(t.appliedData))
+	for source, n := range t.appliedData {
+		data[string(source)] = n
+	}
+	return headers, data
+}
+
+// GetSyncSource returns the sources the header and data of a synced height were obtained from.
+// Sources are empty for heights produced locally or synced before sources were recorded.
+func GetSyncSource(ctx context.Context, store storepkg.Store, height uint64) (header SyncSource, data SyncSource, err error) {
+	header, err = getSyncSource(ctx, store, height, "h")
+	if err != nil {
+		return "", "", err
+	}
+	data, err = getSyncSource(ctx, store, height, "d")
+	if err != nil {
+		return "", "", err
+	}
+	return header, data, nil
+}
+
+func getSyncSource(ctx context.Context, store storepkg.Store, height uint64, suffix string) (SyncSource, error) {
+	bz, err := store.GetMetadata(ctx, fmt.Sprintf("%s/%d/%s", storepkg.HeightToSyncSourceKey, height, suffix))
+	if errors.Is(err, ds.ErrNotFound) {
+		return "", nil
+	}
+	if err != nil {
+		return "", err
+	}
+	return SyncSource(bz), nil
+}
+
+// metadataWriter is the store or a store batch sync sources are saved to.
+type metadataWriter interface {
+	SetMetadata(ctx context.Context, key string, value []byte) error
+}
+
+// saveSyncSources persists the sources of an applied height for diagnostics.
+func (m *Manager) saveSyncSources(ctx context.Context, w metadataWriter, height uint64) error {
+	sources := m.syncSources.pop(height)
+	if sources.header != "" {
+		if err := w.SetMetadata(ctx, fmt.Sprintf("%s/%d/h", storepkg.HeightToSyncSourceKey, height), []byte(sources.header)); err != nil {
+			return err
+		}
+	}
+	if sources.data != "" {
+		if err := w.SetMetadata(ctx, fmt.Sprintf("%s/%d/d", storepkg.HeightToSyncSourceKey, height), []byte(sources.data)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// tryRecoverMissingData starts fetching the data of the next height from DA when its header has been
+// waiting for the data for a full DA block time. This happens when header sync outruns data sync,
+// e.g. because P2P peers already pruned the data, and would otherwise stall syncing.
+func (m *Manager) tryRecoverMissingData(ctx context.Context) {
+	height, err := m.store.Height(ctx)
+	if err != nil {
+		m.logger.Error().Err(err).Msg("error while getting store height")
+		return
+	}
+	next := height + 1
+	header := m.headerCache.GetItem(next)
+	if header == nil || m.dataCache.GetItem(next) != nil {
+		m.missingDataHeight = 0
+		return
+	}
+	if m.missingDataHeight != next {
+		// give P2P and the regular DA retrieval one DA block time to deliver the data first
+		m.missingDataHeight = next
+		return
+	}
+	if !m.dataRecoveryInFlight.CompareAndSwap(false, true) {
+		return
+	}
+	go func() {
+		defer m.dataRecoveryInFlight.Store(false)
+		m.recoverDataFromDA(ctx, header)
+	}()
+}
+
+// recoverDataFromDA searches DA for the data committed to by the header and sends it to the SyncLoop.
+// It returns true if the data was found.
+func (m *Manager) recoverDataFromDA(ctx context.Context, header *types.SignedHeader) bool {
+	height := header.Height()
+	namespaces := [][]byte{[]byte(m.config.DA.GetDataNamespace())}
+	if !m.namespaceMigrationCompleted.Load() && m.config.DA.Namespace != "" {
+		namespaces = append(namespaces, []byte(m.config.DA.Namespace))
+	}
+
+	start := m.dataRecoveryStartHeight(ctx, header)
+	m.logger.Info().Uint64("height", height).Uint64("fromDAHeight", start).Msg("data missing for synced header, recovering from DA")
+
+	for daHeight := start; daHeight < start+dataRecoveryWindow; daHeight++ {
+		for _, namespace := range namespaces {
+			select {
+			case <-ctx.Done():
+				return false
+			default:
+			}
+
+			res := types.RetrieveWithHelpers(ctx, m.da, m.logger, daHeight, namespace)
+			if res.Code == coreda.StatusHeightFromFuture {
+				m.logger.Debug().Uint64("height", height).Uint64("daHeight", daHeight).Msg("reached DA head while recovering data")
+				return false
+			}
+			if res.Code != coreda.StatusSuccess {
+				continue
+			}
+
+			for _, bz := range res.Data {
+				var signedData types.SignedData
+				if err := signedData.UnmarshalBinary(bz); err != nil {
+					continue
+				}
+				if signedData.Metadata == nil || signedData.Height() != height ||
+					!bytes.Equal(signedData.DACommitment(), header.DataHash) {
+					continue
+				}
+				if !m.isValidSignedData(&signedData) {
+					m.logger.Debug().Uint64("height", height).Uint64("daHeight", daHeight).Msg("invalid data signature")
+					continue
+				}
+
+				m.dataCache.SetDAIncluded(header.DataHash.String(), daHeight)
+				m.sendNonBlockingSignalToDAIncluderCh()
+				m.metrics.DataRecovered.Add(1)
+				m.logger.Info().Uint64("height", height).Uint64("daHeight", daHeight).Msg("recovered missing data from DA")
+
+				select {
+				case <-ctx.Done():
+					return false
+				case m.dataInCh <- NewDataEvent{Data: &signedData.Data, DAHeight: daHeight, Source: SyncSourceDARecovery}:
+				}
+				return true
+			}
+		}
+	}
+
+	m.logger.Warn().Uint64("height", height).Uint64("fromDAHeight", start).Uint64("toDAHeight", start+dataRecoveryWindow-1).Msg("missing data not found on DA")
+	return false
+}
+
+// dataRecoveryStartHeight returns the first DA height to search for the data of the header.
+// Data is submitted in order, so the data of a block cannot be included before the data of the
+// previous block. If that is unknown, the search is centered on where the header was included.
+func (m *Manager) dataRecoveryStartHeight(ctx context.Context, header *types.SignedHeader) uint64 {
+	height := header.Height()
+	if height > 1 {
+		bz, err := m.store.GetMetadata(ctx, fmt.Sprintf("%s/%d/d", storepkg.HeightToDAHeightKey, height-1))
+		if err == nil && len(bz) == 8 {
+			return binary.LittleEndian.Uint64(bz)
+		}
+	}
+
+	daHeight, ok := m.headerCache.GetDAIncludedHeight(header.Hash().String())
+	if !ok {
+		daHeight = m.daHeight.Load()
+	}
+	if daHeight < dataRecoveryWindow/2 {
+		return 0
+	}
+	return daHeight - dataRecoveryWindow/2
+}
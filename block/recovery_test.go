@@ -0,0 +1,169 @@
+package block
+
+import (
+	"context"
+	"encoding/binary"
+	"fmt"
+	"testing"
+	"time"
+
+	ds "github.com/ipfs/go-datastore"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	coreda "github.com/evstack/ev-node/core/da"
+	storepkg "github.com/evstack/ev-node/pkg/store"
+	"github.com/evstack/ev-node/types"
+)
+
+// buildSignedDataBlob creates a header and the signed data blob it commits to for the given height.
+func buildSignedDataBlob(t *testing.T, m *Manager, height uint64) (*types.SignedHeader, *types.Data, []byte) {
+	t.Helper()
+	header, data, _ := types.GenerateRandomBlockCustom(&types.BlockConfig{Height: height, NTxs: 2, ProposerAddr: m.genesis.ProposerAddress}, m.genesis.ChainID)
+	header.DataHash = data.DACommitment()
+
+	signature, err := m.getDataSignature(data)
+	require.NoError(t, err)
+	pubKey, err := m.signer.GetPublic()
+	require.NoError(t, err)
+	signedData := &types.SignedData{
+		Data:      *data,
+		Signature: signature,
+		Signer: types.Signer{
+			Address: m.genesis.ProposerAddress,
+			PubKey:  pubKey,
+		},
+	}
+	bz, err := signedData.MarshalBinary()
+	require.NoError(t, err)
+	return header, data, bz
+}
+
+// TestRecoverDataFromDA verifies that missing data is searched on DA from the previous block's data DA height,
+// that blobs for other blocks are skipped and that the matching data is sent to the SyncLoop.
+func TestRecoverDataFromDA(t *testing.T) {
+	m, mockDA, mockStore, _, dataCache, cancel := setupManagerForRetrieverTest(t, 0)
+	defer cancel()
+	m.namespaceMigrationCompleted.Store(true)
+
+	height := uint64(10)
+	header, data, blob := buildSignedDataBlob(t, m, height)
+	_, _, otherBlob := buildSignedDataBlob(t, m, height+1)
+
+	prevDAHeight := make([]byte, 8)
+	binary.LittleEndian.PutUint64(prevDAHeight, 20)
+	mockStore.On("GetMetadata", mock.Anything, fmt.Sprintf("%s/%d/d", storepkg.HeightToDAHeightKey, height-1)).Return(prevDAHeight, nil).Once()
+
+	mockDA.On("GetIDs", mock.Anything, uint64(20), mock.Anything).Return(nil, coreda.ErrBlobNotFound).Once()
+	mockDA.On("GetIDs", mock.Anything, uint64(21), mock.Anything).Return(&coreda.GetIDsResult{
+		IDs:       []coreda.ID{[]byte("id")},
+		Timestamp: time.Now(),
+	}, nil).Once()
+	mockDA.On("Get", mock.Anything, []coreda.ID{[]byte("id")}, mock.Anything).Return([]coreda.Blob{otherBlob, blob}, nil).Once()
+
+	require.True(t, m.recoverDataFromDA(context.Background(), header))
+
+	select {
+	case event := <-m.dataInCh:
+		assert.Equal(t, SyncSourceDARecovery, event.Source)
+		assert.Equal(t, uint64(21), event.DAHeight)
+		assert.Equal(t, height, event.Data.Height())
+		assert.Equal(t, data.Txs, event.Data.Txs)
+	default:
+		t.Fatal("expected recovered data event")
+	}
+	daHeight, ok := dataCache.GetDAIncludedHeight(data.DACommitment().String())
+	require.True(t, ok)
+	assert.Equal(t, uint64(21), daHeight)
+
+	mockDA.AssertExpectations(t)
+}
+
+// TestRecoverDataFromDA_NotFound verifies that recovery stops at the DA head without sending any data.
+func TestRecoverDataFromDA_NotFound(t *testing.T) {
+	m, mockDA, mockStore, _, _, cancel := setupManagerForRetrieverTest(t, 30)
+	defer cancel()
+	m.namespaceMigrationCompleted.Store(true)
+
+	height := uint64(10)
+	header, _, _ := buildSignedDataBlob(t, m, height)
+	m.headerCache.SetDAIncluded(header.Hash().String(), 40)
+
+	mockStore.On("GetMetadata", mock.Anything, fmt.Sprintf("%s/%d/d", storepkg.HeightToDAHeightKey, height-1)).Return(nil, ds.ErrNotFound).Once()
+
+	// the search is centered on the DA height of the header
+	start := uint64(40 - dataRecoveryWindow/2)
+	mockDA.On("GetIDs", mock.Anything, start, mock.Anything).Return(nil, coreda.ErrBlobNotFound).Once()
+	mockDA.On("GetIDs", mock.Anything, start+1, mock.Anything).Return(nil, coreda.ErrHeightFromFuture).Once()
+
+	require.False(t, m.recoverDataFromDA(context.Background(), header))
+	require.Empty(t, m.dataInCh)
+	mockDA.AssertExpectations(t)
+}
+
+// TestTryRecoverMissingData verifies that recovery only starts once data has been missing for a full DA tick.
+func TestTryRecoverMissingData(t *testing.T) {
+	m, mockDA, mockStore, _, _, cancel := setupManagerForRetrieverTest(t, 0)
+	defer cancel()
+	m.namespaceMigrationCompleted.Store(true)
+
+	height := uint64(5)
+	header, _, blob := buildSignedDataBlob(t, m, height)
+	m.headerCache.SetItem(height, header)
+
+	mockStore.On("Height", mock.Anything).Return(height-1, nil)
+	mockStore.On("GetMetadata", mock.Anything, fmt.Sprintf("%s/%d/d", storepkg.HeightToDAHeightKey, height-1)).Return(nil, ds.ErrNotFound).Maybe()
+
+	ctx := context.Background()
+	m.tryRecoverMissingData(ctx)
+	require.Equal(t, height, m.missingDataHeight)
+	require.False(t, m.dataRecoveryInFlight.Load(), "recovery must not start on the first tick")
+
+	mockDA.On("GetIDs", mock.Anything, uint64(0), mock.Anything).Return(&coreda.GetIDsResult{
+		IDs:       []coreda.ID{[]byte("id")},
+		Timestamp: time.Now(),
+	}, nil).Once()
+	mockDA.On("Get", mock.Anything, []coreda.ID{[]byte("id")}, mock.Anything).Return([]coreda.Blob{blob}, nil).Once()
+
+	m.tryRecoverMissingData(ctx)
+
+	select {
+	case event := <-m.dataInCh:
+		assert.Equal(t, SyncSourceDARecovery, event.Source)
+		assert.Equal(t, height, event.Data.Height())
+	case <-time.After(time.Second):
+		t.Fatal("expected recovered data event")
+	}
+
+	// once the data is cached, nothing is missing anymore
+	m.dataCache.SetItem(height, &types.Data{})
+	m.tryRecoverMissingData(ctx)
+	require.Zero(t, m.missingDataHeight)
+}
+
+// TestSyncSources verifies that the sources of applied heights are persisted and can be read back.
+func TestSyncSources(t *testing.T) {
+	ctx := context.Background()
+	kv, err := storepkg.NewDefaultInMemoryKVStore()
+	require.NoError(t, err)
+	m := &Manager{store: storepkg.New(kv)}
+
+	m.syncSources.setHeader(1, SyncSourceP2P)
+	m.syncSources.setData(1, SyncSourceDARecovery)
+	require.NoError(t, m.saveSyncSources(ctx, m.store, 1))
+
+	header, data, err := GetSyncSource(ctx, m.store, 1)
+	require.NoError(t, err)
+	assert.Equal(t, SyncSourceP2P, header)
+	assert.Equal(t, SyncSourceDARecovery, data)
+
+	// heights without recorded sources return empty sources
+	header, data, err = GetSyncSource(ctx, m.store, 2)
+	require.NoError(t, err)
+	assert.Empty(t, header)
+	assert.Empty(t, data)
+
+	// sources are no longer tracked once persisted
+	assert.Empty(t, m.syncSources.pop(1))
+}
@@ -0,0 +1,203 @@
+package block
+
+import (
+	"bytes"
+	"context"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
+
+	ds "github.com/ipfs/go-datastore"
+	"github.com/rs/zerolog"
+
+	coreda "github.com/evstack/ev-node/core/da"
+	coreexecutor "github.com/evstack/ev-node/core/execution"
+	"github.com/evstack/ev-node/pkg/config"
+	"github.com/evstack/ev-node/pkg/genesis"
+	"github.com/evstack/ev-node/pkg/journal"
+	storepkg "github.com/evstack/ev-node/pkg/store"
+	"github.com/evstack/ev-node/types"
+)
+
+const (
+	// upgradeSwitchLag is the number of blocks an executor being upgraded to may lag behind the
+	// node when the node pauses to switch to it. The blocks are replayed while the node is paused.
+	upgradeSwitchLag = 10
+	// upgradeCatchUpInterval is the wait between two catch-up rounds of an executor being upgraded
+	// to which caught up with the node.
+	upgradeCatchUpInterval = time.Second
+)
+
+// ExecutionReplayer replays the blocks of the chain into an executor started with an empty state,
+// e.g. a new major version of the execution client, checking that it computes the state roots
+// committed to by the headers of the chain. The data of the blocks is retrieved from DA, at the DA
+// height recorded when it was included, or read from the store if it is not on DA.
+type ExecutionReplayer struct {
+	store   storepkg.Store
+	da      coreda.DA
+	config  config.DAConfig
+	genesis genesis.Genesis
+	exec    coreexecutor.Executor
+	logger  zerolog.Logger
+	// blobResolver fetches the payloads of the blob pointers of the blocks
+	blobResolver BlobResolver
+
+	// height is the last height replayed, and stateRoot the state root of exec after it. The
+	// chain of exec is not initialized while stateRoot is nil.
+	height    uint64
+	stateRoot []byte
+}
+
+// NewExecutionReplayer creates an ExecutionReplayer replaying the blocks of store into exec.
+func NewExecutionReplayer(store storepkg.Store, da coreda.DA, config config.DAConfig, genesis genesis.Genesis, exec coreexecutor.Executor, logger zerolog.Logger) *ExecutionReplayer {
+	return &ExecutionReplayer{
+		store:   store,
+		da:      da,
+		config:  config,
+		genesis: genesis,
+		exec:    exec,
+		logger:  logger,
+	}
+}
+
+// Height returns the last height replayed.
+func (r *ExecutionReplayer) Height() uint64 {
+	return r.height
+}
+
+// StateRoot returns the state root of the executor after the last height replayed.
+func (r *ExecutionReplayer) StateRoot() []byte {
+	return r.stateRoot
+}
+
+// ReplayTo replays the blocks up to height. The chain of the executor is initialized by the first
+// call. Before executing a block, the state root of the executor is checked against the one
+// committed to by its header, so that a diverging executor fails the replay at the first block
+// whose state differs.
+func (r *ExecutionReplayer) ReplayTo(ctx context.Context, height uint64) error {
+	if r.stateRoot == nil {
+		stateRoot, _, err := r.exec.InitChain(ctx, r.genesis.GenesisDAStartTime, r.genesis.InitialHeight, r.genesis.ChainID)
+		if err != nil {
+			return fmt.Errorf("failed to initialize chain: %w", err)
+		}
+		r.height, r.stateRoot = r.genesis.InitialHeight-1, stateRoot
+	}
+
+	for r.height < height {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		next := r.height + 1
+		header, err := r.store.GetHeader(ctx, next)
+		if err != nil {
+			return fmt.Errorf("failed to get header at height %d: %w", next, err)
+		}
+		if !bytes.Equal(header.AppHash, r.stateRoot) {
+			return fmt.Errorf("state root %x after height %d differs from the app hash %x of the next header", r.stateRoot, r.height, header.AppHash)
+		}
+		data, err := r.blockData(ctx, header)
+		if err != nil {
+			return err
+		}
+
+		txs, err := resolveTxs(ctx, r.genesis, r.blobResolver, data.Txs)
+		if err != nil {
+			return fmt.Errorf("failed to resolve transactions of height %d: %w", next, err)
+		}
+		execCtx := context.WithValue(ctx, types.HeaderContextKey, header.Header)
+		stateRoot, _, err := r.exec.ExecuteTxs(execCtx, txs, next, header.Time(), r.stateRoot)
+		if err != nil {
+			return fmt.Errorf("failed to execute transactions of height %d: %w", next, err)
+		}
+		r.height, r.stateRoot = next, stateRoot
+		if next%1000 == 0 {
+			r.logger.Info().Uint64("height", next).Msg("replayed blocks into the new executor")
+		}
+	}
+	return nil
+}
+
+// blockData returns the data of the block of the header, from DA if its DA height is recorded.
+func (r *ExecutionReplayer) blockData(ctx context.Context, header *types.SignedHeader) (*types.Data, error) {
+	height := header.Height()
+	if bytes.Equal(header.DataHash, dataHashForEmptyTxs) {
+		// the data of empty blocks is not submitted to DA
+		return &types.Data{}, nil
+	}
+
+	bz, err := r.store.GetMetadata(ctx, fmt.Sprintf("%s/%d/d", storepkg.HeightToDAHeightKey, height))
+	switch {
+	case err == nil && len(bz) == 8:
+		data, err := retrieveDataFromDA(ctx, r.da, r.config, r.logger, header, binary.LittleEndian.Uint64(bz))
+		if err == nil {
+			return data, nil
+		}
+		r.logger.Warn().Err(err).Uint64("height", height).Msg("failed to retrieve data from DA, reading it from the store")
+	case err != nil && !errors.Is(err, ds.ErrNotFound):
+		return nil, fmt.Errorf("failed to get DA height of the data at height %d: %w", height, err)
+	}
+
+	_, data, err := r.store.GetBlockData(ctx, height)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get data at height %d: %w", height, err)
+	}
+	return data, nil
+}
+
+// UpgradeExecutor switches the node to exec, e.g. a new major version of the execution client
+// started with an empty state, with minimal downtime. The blocks of the chain are replayed into
+// exec in the background of the node, until exec lags behind the node by a few blocks. Block
+// production and sync are then paused while the last blocks are replayed, the state root of exec
+// is checked against the state of the node, and the node switches to exec atomically.
+//
+// The node is left on its executor if the replay fails, e.g. because exec computes a different
+// state root. Once switched, the node must be configured with exec before it restarts.
+func (m *Manager) UpgradeExecutor(ctx context.Context, exec coreexecutor.Executor) error {
+	replayer := NewExecutionReplayer(m.store, m.da, m.config.DA, m.genesis, exec, m.logger)
+	replayer.blobResolver = m.blobResolver
+	m.logger.Info().Msg("replaying the chain into the new executor")
+	for {
+		target := m.GetLastState().LastBlockHeight
+		if err := replayer.ReplayTo(ctx, target); err != nil {
+			return fmt.Errorf("failed to replay the chain into the new executor: %w", err)
+		}
+		if m.GetLastState().LastBlockHeight <= replayer.Height()+upgradeSwitchLag {
+			break
+		}
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(upgradeCatchUpInterval):
+		}
+	}
+	return m.switchExecutor(ctx, replayer)
+}
+
+// switchExecutor pauses block production and sync, replays the last blocks into the executor of
+// the replayer, and switches the node to it if its state root is the one of the node.
+func (m *Manager) switchExecutor(ctx context.Context, replayer *ExecutionReplayer) error {
+	m.execMu.Lock()
+	defer m.execMu.Unlock()
+
+	state := m.GetLastState()
+	if err := replayer.ReplayTo(ctx, state.LastBlockHeight); err != nil {
+		return fmt.Errorf("failed to replay the chain into the new executor: %w", err)
+	}
+	if !bytes.Equal(replayer.StateRoot(), state.AppHash) {
+		return fmt.Errorf("state root %x of the new executor at height %d differs from the app hash %x of the node", replayer.StateRoot(), state.LastBlockHeight, state.AppHash)
+	}
+	if final := m.GetDAIncludedHeight(); final > 0 {
+		if err := replayer.exec.SetFinal(ctx, final); err != nil {
+			return fmt.Errorf("failed to set final height of the new executor: %w", err)
+		}
+	}
+
+	m.exec = replayer.exec
+	m.logger.Info().Uint64("height", state.LastBlockHeight).Msg("switched to the new executor")
+	m.recordEvent(ctx, journal.EventExecutorSwitched, fmt.Sprintf("executor switched at height %d", state.LastBlockHeight), map[string]string{
+		"height": strconv.FormatUint(state.LastBlockHeight, 10),
+	})
+	return nil
+}
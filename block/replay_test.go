@@ -0,0 +1,118 @@
+package block
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	coreexecutor "github.com/evstack/ev-node/core/execution"
+	"github.com/evstack/ev-node/pkg/config"
+	"github.com/evstack/ev-node/pkg/genesis"
+	storepkg "github.com/evstack/ev-node/pkg/store"
+	"github.com/evstack/ev-node/types"
+)
+
+// buildReplayChain stores n blocks executed by the dummy executor, every other block being
+// empty, and returns the store and the state root after the last block.
+func buildReplayChain(t *testing.T, gen genesis.Genesis, n uint64) (storepkg.Store, []byte) {
+	t.Helper()
+	ctx := context.Background()
+	kv, err := storepkg.NewDefaultInMemoryKVStore()
+	require.NoError(t, err)
+	store := storepkg.New(kv)
+
+	exec := coreexecutor.NewDummyExecutor()
+	stateRoot, _, err := exec.InitChain(ctx, gen.GenesisDAStartTime, gen.InitialHeight, gen.ChainID)
+	require.NoError(t, err)
+	for h := gen.InitialHeight; h < gen.InitialHeight+n; h++ {
+		header, data := types.GetRandomBlock(h, int(h%2), gen.ChainID)
+		header.AppHash = stateRoot
+		if len(data.Txs) == 0 {
+			header.DataHash = dataHashForEmptyTxs
+		}
+		require.NoError(t, store.SaveBlockData(ctx, header, data, &header.Signature))
+		require.NoError(t, store.SetHeight(ctx, h))
+
+		txs := make([][]byte, len(data.Txs))
+		for i, tx := range data.Txs {
+			txs[i] = tx
+		}
+		stateRoot, _, err = exec.ExecuteTxs(ctx, txs, h, header.Time(), stateRoot)
+		require.NoError(t, err)
+	}
+	return store, stateRoot
+}
+
+// TestExecutionReplayer_ReplayTo verifies that blocks are replayed incrementally into a fresh
+// executor, which computes the state roots of the chain.
+func TestExecutionReplayer_ReplayTo(t *testing.T) {
+	ctx := context.Background()
+	gen := genesis.NewGenesis("replay-chain", 1, time.Now(), nil)
+	store, stateRoot := buildReplayChain(t, gen, 6)
+
+	replayer := NewExecutionReplayer(store, nil, config.DefaultConfig.DA, gen, coreexecutor.NewDummyExecutor(), zerolog.Nop())
+	require.NoError(t, replayer.ReplayTo(ctx, 4))
+	assert.Equal(t, uint64(4), replayer.Height())
+	require.NoError(t, replayer.ReplayTo(ctx, 6))
+	assert.Equal(t, uint64(6), replayer.Height())
+	assert.Equal(t, stateRoot, replayer.StateRoot())
+}
+
+// divergingExecutor is the dummy executor computing a different state root from height on.
+type divergingExecutor struct {
+	*coreexecutor.DummyExecutor
+	height uint64
+}
+
+func (e *divergingExecutor) ExecuteTxs(ctx context.Context, txs [][]byte, blockHeight uint64, timestamp time.Time, prevStateRoot []byte) ([]byte, uint64, error) {
+	if blockHeight >= e.height {
+		txs = append(txs, []byte("diverged"))
+	}
+	return e.DummyExecutor.ExecuteTxs(ctx, txs, blockHeight, timestamp, prevStateRoot)
+}
+
+// TestExecutionReplayer_Divergence verifies that the replay fails at the first block whose state
+// root differs from the one committed to by the chain.
+func TestExecutionReplayer_Divergence(t *testing.T) {
+	ctx := context.Background()
+	gen := genesis.NewGenesis("replay-chain", 1, time.Now(), nil)
+	store, _ := buildReplayChain(t, gen, 6)
+
+	exec := &divergingExecutor{DummyExecutor: coreexecutor.NewDummyExecutor(), height: 3}
+	replayer := NewExecutionReplayer(store, nil, config.DefaultConfig.DA, gen, exec, zerolog.Nop())
+	err := replayer.ReplayTo(ctx, 6)
+	require.ErrorContains(t, err, "after height 3 differs")
+	assert.Equal(t, uint64(3), replayer.Height())
+}
+
+// TestManager_UpgradeExecutor verifies that the manager switches to an executor computing the
+// state of the chain, and stays on its executor otherwise.
+func TestManager_UpgradeExecutor(t *testing.T) {
+	ctx := context.Background()
+	gen := genesis.NewGenesis("replay-chain", 1, time.Now(), nil)
+	store, stateRoot := buildReplayChain(t, gen, 6)
+
+	current := coreexecutor.NewDummyExecutor()
+	m := &Manager{
+		store:        store,
+		genesis:      gen,
+		config:       config.DefaultConfig,
+		exec:         current,
+		logger:       zerolog.Nop(),
+		lastStateMtx: &sync.RWMutex{},
+	}
+	m.SetLastState(types.State{LastBlockHeight: 6, AppHash: stateRoot})
+
+	diverging := &divergingExecutor{DummyExecutor: coreexecutor.NewDummyExecutor(), height: 5}
+	require.Error(t, m.UpgradeExecutor(ctx, diverging))
+	assert.Same(t, current, m.GetExecutor())
+
+	upgraded := coreexecutor.NewDummyExecutor()
+	require.NoError(t, m.UpgradeExecutor(ctx, upgraded))
+	assert.Same(t, upgraded, m.GetExecutor())
+}
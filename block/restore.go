@@ -0,0 +1,98 @@
+package block
+
+import (
+	"bytes"
+	"context"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"slices"
+
+	"github.com/rs/zerolog"
+
+	coreda "github.com/evstack/ev-node/core/da"
+	"github.com/evstack/ev-node/pkg/config"
+	storepkg "github.com/evstack/ev-node/pkg/store"
+	"github.com/evstack/ev-node/types"
+)
+
+// RestorePrunedData fetches the data of a pruned height from DA, at the DA height recorded when it
+// was DA included, checks it against the header of the height and saves it in the store again.
+// It returns the DA height the data was found at.
+func RestorePrunedData(ctx context.Context, store storepkg.Store, da coreda.DA, cfg config.DAConfig, logger zerolog.Logger, height uint64) (uint64, error) {
+	pruner, ok := store.(storepkg.Pruner)
+	if !ok {
+		return 0, errors.New("store does not support pruning")
+	}
+	if pruned, err := pruner.IsPruned(ctx, height); err != nil || !pruned {
+		if err == nil {
+			err = fmt.Errorf("data of height %d is not pruned", height)
+		}
+		return 0, err
+	}
+	header, err := store.GetHeader(ctx, height)
+	if err != nil {
+		return 0, fmt.Errorf("failed to get header at height %d: %w", height, err)
+	}
+	bz, err := store.GetMetadata(ctx, fmt.Sprintf("%s/%d/d", storepkg.HeightToDAHeightKey, height))
+	if err != nil {
+		return 0, fmt.Errorf("failed to get DA height of the data at height %d: %w", height, err)
+	}
+	if len(bz) != 8 {
+		return 0, fmt.Errorf("invalid DA height of the data at height %d", height)
+	}
+	daHeight := binary.LittleEndian.Uint64(bz)
+
+	data, err := retrieveDataFromDA(ctx, da, cfg, logger, header, daHeight)
+	if err != nil {
+		return 0, err
+	}
+	if err := pruner.RestoreBlockData(ctx, height, data); err != nil {
+		return 0, err
+	}
+	return daHeight, nil
+}
+
+// retrieveDataFromDA fetches the data of the header from DA at the DA height it was included at,
+// and checks it against the header.
+func retrieveDataFromDA(ctx context.Context, da coreda.DA, cfg config.DAConfig, logger zerolog.Logger, header *types.SignedHeader, daHeight uint64) (*types.Data, error) {
+	height := header.Height()
+
+	// the data may have been submitted alone, or with the header in a single blob
+	var namespaces [][]byte
+	for _, ns := range []string{cfg.GetDataNamespace(), cfg.GetHeaderNamespace(), cfg.Namespace} {
+		if ns != "" && !slices.ContainsFunc(namespaces, func(n []byte) bool { return string(n) == ns }) {
+			namespaces = append(namespaces, []byte(ns))
+		}
+	}
+	for _, namespace := range namespaces {
+		res := types.RetrieveWithHelpers(ctx, da, logger, daHeight, namespace)
+		switch res.Code {
+		case coreda.StatusSuccess:
+		case coreda.StatusNotFound:
+			continue
+		default:
+			return nil, fmt.Errorf("failed to retrieve blobs at DA height %d: %s", daHeight, res.Message)
+		}
+
+		for _, blob := range res.Data {
+			parts := [][]byte{blob}
+			if envelope, err := types.UnmarshalBlobEnvelope(blob); err == nil {
+				parts = envelope
+			}
+			for _, part := range parts {
+				var signedData types.SignedData
+				if err := signedData.UnmarshalBinary(part); err != nil {
+					continue
+				}
+				if signedData.Metadata == nil || signedData.Height() != height ||
+					!bytes.Equal(signedData.DACommitment(), header.DataHash) {
+					continue
+				}
+				return &signedData.Data, nil
+			}
+		}
+	}
+
+	return nil, fmt.Errorf("data of height %d not found at DA height %d", height, daHeight)
+}
@@ -0,0 +1,91 @@
+package block
+
+import (
+	"context"
+	"encoding/binary"
+	"fmt"
+	"testing"
+
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	coreda "github.com/evstack/ev-node/core/da"
+	"github.com/evstack/ev-node/pkg/config"
+	storepkg "github.com/evstack/ev-node/pkg/store"
+	"github.com/evstack/ev-node/test/mocks"
+	"github.com/evstack/ev-node/types"
+)
+
+// TestRestorePrunedData verifies that pruned data is restored from the blob at its recorded DA
+// height, whether submitted alone or with its header.
+func TestRestorePrunedData(t *testing.T) {
+	ctx := context.Background()
+	kv, err := storepkg.NewDefaultInMemoryKVStore()
+	require.NoError(t, err)
+	store := storepkg.New(kv)
+	pruner := store.(storepkg.Pruner)
+
+	cfg := config.DefaultConfig.DA
+	cfg.HeaderNamespace = "ns-header"
+	cfg.DataNamespace = "ns-data"
+	daBlobs := map[string]map[uint64][][]byte{"ns-header": {}, "ns-data": {}}
+
+	var blocks []*types.Data
+	for h := uint64(1); h <= 3; h++ {
+		header, data := types.GetRandomBlock(h, 2, "test-chain")
+		require.NoError(t, store.SaveBlockData(ctx, header, data, &header.Signature))
+		require.NoError(t, store.SetHeight(ctx, h))
+		require.NoError(t, store.SetMetadata(ctx, fmt.Sprintf("%s/%d/d", storepkg.HeightToDAHeightKey, h), binary.LittleEndian.AppendUint64(nil, 10+h)))
+		blocks = append(blocks, data)
+
+		dataBz, err := (&types.SignedData{Data: *data, Signature: header.Signature, Signer: header.Signer}).MarshalBinary()
+		require.NoError(t, err)
+		if h == 2 {
+			headerBz, err := header.MarshalBinary()
+			require.NoError(t, err)
+			envelope, err := types.MarshalBlobEnvelope(headerBz, dataBz)
+			require.NoError(t, err)
+			daBlobs["ns-header"][10+h] = [][]byte{envelope}
+		} else {
+			daBlobs["ns-data"][10+h] = [][]byte{[]byte("junk"), dataBz}
+		}
+	}
+	require.NoError(t, store.SetMetadata(ctx, storepkg.DAIncludedHeightKey, binary.LittleEndian.AppendUint64(nil, 3)))
+	require.NoError(t, pruner.PruneBlockData(ctx, 1))
+	require.NoError(t, pruner.PruneBlockData(ctx, 2))
+
+	mockDA := mocks.NewMockDA(t)
+	mockDA.On("GetIDs", mock.Anything, mock.Anything, mock.Anything).Return(
+		func(_ context.Context, daHeight uint64, ns []byte) (*coreda.GetIDsResult, error) {
+			result := &coreda.GetIDsResult{}
+			for i := range daBlobs[string(ns)][daHeight] {
+				result.IDs = append(result.IDs, binary.LittleEndian.AppendUint64([]byte{byte(i)}, daHeight))
+			}
+			return result, nil
+		})
+	mockDA.On("Get", mock.Anything, mock.Anything, mock.Anything).Return(
+		func(_ context.Context, ids []coreda.ID, ns []byte) ([]coreda.Blob, error) {
+			return daBlobs[string(ns)][binary.LittleEndian.Uint64(ids[0][1:])], nil
+		})
+
+	for h := uint64(1); h <= 2; h++ {
+		daHeight, err := RestorePrunedData(ctx, store, mockDA, cfg, zerolog.Nop(), h)
+		require.NoError(t, err)
+		assert.Equal(t, 10+h, daHeight)
+		_, data, err := store.GetBlockData(ctx, h)
+		require.NoError(t, err)
+		assert.Equal(t, blocks[h-1].Txs, data.Txs)
+	}
+
+	// only pruned heights are restored
+	_, err = RestorePrunedData(ctx, store, mockDA, cfg, zerolog.Nop(), 3)
+	assert.ErrorContains(t, err, "not pruned")
+
+	// the data is not on DA at the recorded height
+	require.NoError(t, pruner.PruneBlockData(ctx, 1))
+	daBlobs["ns-data"][11] = nil
+	_, err = RestorePrunedData(ctx, store, mockDA, cfg, zerolog.Nop(), 1)
+	assert.ErrorContains(t, err, "not found at DA height 11")
+}
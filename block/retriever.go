@@ -26,6 +26,7 @@ func (m *Manager) RetrieveLoop(ctx context.Context) {
 	// This enables syncing faster than the DA block time.
 	blobsFoundCh := make(chan struct{}, 1)
 	defer close(blobsFoundCh)
+	go m.subscribeDA(ctx)
 	for {
 		select {
 		case <-ctx.Done():
@@ -39,9 +40,13 @@ func (m *Manager) RetrieveLoop(ctx context.Context) {
 			// if the requested da height is not yet available, wait silently, otherwise log the error and wait
 			if !m.areAllErrorsHeightFromFuture(err) {
 				m.logger.Error().Uint64("daHeight", daHeight).Str("errors", err.Error()).Msg("failed to retrieve data from DALC")
+				m.stall.daRetrieved(err, time.Now())
+			} else {
+				m.stall.daRetrieved(nil, time.Now())
 			}
 			continue
 		}
+		m.stall.daRetrieved(nil, time.Now())
 		// Signal the blobsFoundCh to try and retrieve the next set of blobs
 		select {
 		case blobsFoundCh <- struct{}{}:
@@ -85,6 +90,10 @@ func (m *Manager) processNextDAHeaderAndData(ctx context.Context) error {
 					m.logger.Debug().Uint64("daHeight", daHeight).Msg("ignoring nil or empty blob")
 					continue
 				}
+				if parts, err := types.UnmarshalBlobEnvelope(bz); !errors.Is(err, types.ErrNotBlobEnvelope) {
+					m.handleBlobEnvelope(ctx, parts, err, daHeight)
+					continue
+				}
 				if m.handlePotentialHeader(ctx, bz, daHeight) {
 					continue
 				}
@@ -108,6 +117,23 @@ func (m *Manager) processNextDAHeaderAndData(ctx context.Context) error {
 	return err
 }
 
+// handleBlobEnvelope processes the header and data of a height submitted in a single blob.
+func (m *Manager) handleBlobEnvelope(ctx context.Context, parts [][]byte, err error, daHeight uint64) {
+	if err == nil && len(parts) != 2 {
+		err = fmt.Errorf("expected 2 parts, got %d", len(parts))
+	}
+	if err != nil {
+		m.logger.Debug().Uint64("daHeight", daHeight).Err(err).Msg("ignoring invalid blob envelope")
+		return
+	}
+	if len(parts[0]) > 0 && !m.handlePotentialHeader(ctx, parts[0], daHeight) {
+		m.logger.Debug().Uint64("daHeight", daHeight).Msg("blob envelope does not contain a valid header")
+	}
+	if len(parts[1]) > 0 {
+		m.handlePotentialData(ctx, parts[1], daHeight)
+	}
+}
+
 // handlePotentialHeader tries to decode and process a header. Returns true if successful or skipped, false if not a header.
 func (m *Manager) handlePotentialHeader(ctx context.Context, bz []byte, daHeight uint64) bool {
 	header := new(types.SignedHeader)
@@ -152,7 +178,7 @@ func (m *Manager) handlePotentialHeader(ctx context.Context, bz []byte, daHeight
 		default:
 			m.logger.Warn().Uint64("daHeight", daHeight).Msg("headerInCh backlog full, dropping header")
 		}
-		m.headerInCh <- NewHeaderEvent{header, daHeight}
+		m.headerInCh <- NewHeaderEvent{Header: header, DAHeight: daHeight, Source: SyncSourceDA}
 	}
 	return true
 }
@@ -187,7 +213,7 @@ func (m *Manager) handlePotentialData(ctx context.Context, bz []byte, daHeight u
 		default:
 			m.logger.Warn().Uint64("daHeight", daHeight).Msg("dataInCh backlog full, dropping signed data")
 		}
-		m.dataInCh <- NewDataEvent{&signedData.Data, daHeight}
+		m.dataInCh <- NewDataEvent{Data: &signedData.Data, DAHeight: daHeight, Source: SyncSourceDA}
 	}
 }
 
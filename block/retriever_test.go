@@ -801,3 +801,62 @@ func TestRetrieveLoop_DAHeightIncrementsOnlyOnSuccess(t *testing.T) {
 
 	mockDAClient.AssertExpectations(t)
 }
+
+// TestProcessNextDAHeader_BlobEnvelope verifies that the header and data of a height combined in a single blob are both processed.
+func TestProcessNextDAHeader_BlobEnvelope(t *testing.T) {
+	t.Parallel()
+	daHeight := uint64(20)
+	blockHeight := uint64(100)
+	manager, mockDAClient, _, headerCache, dataCache, cancel := setupManagerForRetrieverTest(t, daHeight)
+	defer cancel()
+
+	proposerAddr := manager.genesis.ProposerAddress
+	header, err := types.GetRandomSignedHeaderCustom(&types.HeaderConfig{Height: blockHeight, Signer: manager.signer}, manager.genesis.ChainID)
+	require.NoError(t, err)
+	header.ProposerAddress = proposerAddr
+	headerBytes, err := header.MarshalBinary()
+	require.NoError(t, err)
+
+	_, blockData, _ := types.GenerateRandomBlockCustom(&types.BlockConfig{Height: blockHeight, NTxs: 2, ProposerAddr: proposerAddr}, manager.genesis.ChainID)
+	pubKey, err := manager.signer.GetPublic()
+	require.NoError(t, err)
+	signature, err := manager.getDataSignature(blockData)
+	require.NoError(t, err)
+	signedData := &types.SignedData{
+		Data:      *blockData,
+		Signature: signature,
+		Signer:    types.Signer{Address: proposerAddr, PubKey: pubKey},
+	}
+	dataBytes, err := signedData.MarshalBinary()
+	require.NoError(t, err)
+
+	envelope, err := types.MarshalBlobEnvelope(headerBytes, dataBytes)
+	require.NoError(t, err)
+
+	headerNamespace := []byte(manager.config.DA.GetHeaderNamespace())
+	mockDAClient.On("GetIDs", mock.Anything, daHeight, headerNamespace).Return(&coreda.GetIDsResult{
+		IDs:       []coreda.ID{[]byte("envelope-id")},
+		Timestamp: time.Now(),
+	}, nil).Once()
+	mockDAClient.On("GetIDs", mock.Anything, daHeight, []byte(manager.config.DA.GetDataNamespace())).Return(&coreda.GetIDsResult{
+		Timestamp: time.Now(),
+	}, nil).Once()
+	mockDAClient.On("Get", mock.Anything, []coreda.ID{[]byte("envelope-id")}, headerNamespace).Return([]coreda.Blob{envelope}, nil).Once()
+
+	require.NoError(t, manager.processNextDAHeaderAndData(context.Background()))
+
+	select {
+	case event := <-manager.headerInCh:
+		assert.Equal(t, blockHeight, event.Header.Height())
+	case <-time.After(100 * time.Millisecond):
+		t.Fatal("Expected header event not received")
+	}
+	select {
+	case event := <-manager.dataInCh:
+		assert.Equal(t, blockData.Txs, event.Data.Txs)
+	case <-time.After(100 * time.Millisecond):
+		t.Fatal("Expected block data event not received")
+	}
+	assert.True(t, headerCache.IsDAIncluded(header.Hash().String()))
+	assert.True(t, dataCache.IsDAIncluded(blockData.DACommitment().String()))
+}
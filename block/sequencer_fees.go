@@ -0,0 +1,160 @@
+package block
+
+import (
+	"bytes"
+	"context"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"math/big"
+
+	ds "github.com/ipfs/go-datastore"
+	"google.golang.org/protobuf/proto"
+
+	coreexecutor "github.com/evstack/ev-node/core/execution"
+	storepkg "github.com/evstack/ev-node/pkg/store"
+	pb "github.com/evstack/ev-node/types/pb/evnode/v1"
+)
+
+// SequencerFeesLoop accounts the sequencing fees of the committed blocks, in order, if the
+// executor reports them. Fees are queried from the execution layer after the blocks are committed,
+// off the block production and sync paths, and the height up to which they were accounted is
+// persisted so that the loop resumes where it stopped. Blocks committed before the first start of
+// the loop are not accounted.
+func (m *Manager) SequencerFeesLoop(ctx context.Context) {
+	next, err := m.nextSequencerFeesHeight(ctx)
+	if err != nil {
+		m.logger.Error().Err(err).Msg("failed to get the height of the last accounted sequencer fees")
+		return
+	}
+	for {
+		next = m.accountSequencerFees(ctx, next)
+		select {
+		case <-ctx.Done():
+			return
+		case <-m.sequencerFeesCh:
+		}
+	}
+}
+
+// nextSequencerFeesHeight returns the height of the next block whose fees are to be accounted.
+func (m *Manager) nextSequencerFeesHeight(ctx context.Context) (uint64, error) {
+	bz, err := m.store.GetMetadata(ctx, storepkg.SequencerFeesHeightKey)
+	if errors.Is(err, ds.ErrNotFound) {
+		height, err := m.store.Height(ctx)
+		if err != nil {
+			return 0, err
+		}
+		return height + 1, nil
+	}
+	if err != nil {
+		return 0, err
+	}
+	if len(bz) != 8 {
+		return 0, fmt.Errorf("invalid sequencer fees height length: %d", len(bz))
+	}
+	return binary.LittleEndian.Uint64(bz) + 1, nil
+}
+
+// accountSequencerFees accounts the fees of the committed blocks from height next, and returns the
+// height of the next block to account. The fees of a block are saved in a batch along with the
+// height up to which fees were accounted, so that a block is neither skipped nor accounted twice.
+func (m *Manager) accountSequencerFees(ctx context.Context, next uint64) uint64 {
+	height, err := m.store.Height(ctx)
+	if err != nil {
+		m.logger.Error().Err(err).Msg("failed to get store height")
+		return next
+	}
+	for ; next <= height && ctx.Err() == nil; next++ {
+		reporter, ok := m.GetExecutor().(coreexecutor.FeeReporter)
+		if !ok {
+			continue
+		}
+		batch, err := m.store.Batch(ctx)
+		if err != nil {
+			m.logger.Error().Err(err).Uint64("height", next).Msg("failed to create sequencer fees batch")
+			return next
+		}
+		m.saveSequencerFees(ctx, batch, reporter, next)
+		bz := make([]byte, 8)
+		binary.LittleEndian.PutUint64(bz, next)
+		if err := batch.SetMetadata(ctx, storepkg.SequencerFeesHeightKey, bz); err != nil {
+			m.logger.Error().Err(err).Uint64("height", next).Msg("failed to save sequencer fees height")
+			return next
+		}
+		if err := batch.Commit(ctx); err != nil {
+			m.logger.Error().Err(err).Uint64("height", next).Msg("failed to commit sequencer fees")
+			return next
+		}
+	}
+	return next
+}
+
+// saveSequencerFees accounts the sequencing fees collected by an executed block, and reconciles
+// them against the balance change of the fee recipient since the previous block. The accounting is
+// an optional artifact, so failures are logged and the block is not accounted.
+func (m *Manager) saveSequencerFees(ctx context.Context, w metadataWriter, reporter coreexecutor.FeeReporter, height uint64) {
+	fees, err := reporter.GetBlockFees(ctx, height)
+	if err != nil {
+		m.logger.Error().Err(err).Uint64("height", height).Msg("failed to get block fees from executor")
+		return
+	}
+	collected := orZero(fees.Collected)
+	balance := orZero(fees.RecipientBalance)
+
+	record := &pb.SequencerFees{
+		Height:           height,
+		Recipient:        fees.Recipient,
+		Collected:        collected.String(),
+		TotalCollected:   collected.String(),
+		RecipientBalance: balance.String(),
+	}
+	if prev, err := m.getSequencerFees(ctx, height-1); err == nil {
+		if total, ok := new(big.Int).SetString(prev.TotalCollected, 10); ok {
+			record.TotalCollected = total.Add(total, collected).String()
+		}
+		prevBalance, ok := new(big.Int).SetString(prev.RecipientBalance, 10)
+		if ok && bytes.Equal(prev.Recipient, fees.Recipient) {
+			discrepancy := new(big.Int).Sub(balance, prevBalance)
+			discrepancy.Sub(discrepancy, collected)
+			record.Reconciled = true
+			record.Discrepancy = discrepancy.String()
+			if discrepancy.Sign() != 0 {
+				m.logger.Warn().
+					Uint64("height", height).
+					Str("collected", record.Collected).
+					Str("discrepancy", record.Discrepancy).
+					Msg("balance change of fee recipient does not match collected fees")
+			}
+		}
+	}
+
+	bz, err := proto.Marshal(record)
+	if err != nil {
+		m.logger.Error().Err(err).Uint64("height", height).Msg("failed to marshal sequencer fees")
+		return
+	}
+	if err := w.SetMetadata(ctx, fmt.Sprintf("%s/%d", storepkg.SequencerFeesKey, height), bz); err != nil {
+		m.logger.Error().Err(err).Uint64("height", height).Msg("failed to save sequencer fees")
+	}
+}
+
+// getSequencerFees returns the sequencing fees accounted for the block at the given height.
+func (m *Manager) getSequencerFees(ctx context.Context, height uint64) (*pb.SequencerFees, error) {
+	bz, err := m.store.GetMetadata(ctx, fmt.Sprintf("%s/%d", storepkg.SequencerFeesKey, height))
+	if err != nil {
+		return nil, err
+	}
+	var fees pb.SequencerFees
+	if err := proto.Unmarshal(bz, &fees); err != nil {
+		return nil, err
+	}
+	return &fees, nil
+}
+
+func orZero(x *big.Int) *big.Int {
+	if x == nil {
+		return new(big.Int)
+	}
+	return x
+}
@@ -0,0 +1,128 @@
+package block
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/require"
+
+	coreexecutor "github.com/evstack/ev-node/core/execution"
+	storepkg "github.com/evstack/ev-node/pkg/store"
+	"github.com/evstack/ev-node/test/mocks"
+)
+
+// feeReporterExecutor is an executor reporting the fees of its blocks by height.
+type feeReporterExecutor struct {
+	*mocks.MockExecutor
+	fees map[uint64]coreexecutor.BlockFees
+	err  error
+}
+
+func (e *feeReporterExecutor) GetBlockFees(ctx context.Context, blockHeight uint64) (coreexecutor.BlockFees, error) {
+	return e.fees[blockHeight], e.err
+}
+
+func TestSaveSequencerFees(t *testing.T) {
+	ctx := context.Background()
+	newManager := func(exec coreexecutor.Executor) *Manager {
+		kv, err := storepkg.NewDefaultInMemoryKVStore()
+		require.NoError(t, err)
+		return &Manager{store: storepkg.New(kv), exec: exec, logger: zerolog.Nop()}
+	}
+	coinbase := []byte("coinbase")
+
+	t.Run("fees are accounted and reconciled", func(t *testing.T) {
+		exec := &feeReporterExecutor{fees: map[uint64]coreexecutor.BlockFees{
+			1: {Recipient: coinbase, Collected: big.NewInt(100), RecipientBalance: big.NewInt(1000)},
+			2: {Recipient: coinbase, Collected: big.NewInt(50), RecipientBalance: big.NewInt(1050)},
+			// a transfer of 20 out of the recipient
+			3: {Recipient: coinbase, Collected: big.NewInt(30), RecipientBalance: big.NewInt(1060)},
+			4: {Recipient: []byte("other"), Collected: big.NewInt(10), RecipientBalance: big.NewInt(10)},
+		}}
+		m := newManager(exec)
+		for height := uint64(1); height <= 4; height++ {
+			m.saveSequencerFees(ctx, m.store, exec, height)
+		}
+
+		fees, err := m.getSequencerFees(ctx, 1)
+		require.NoError(t, err)
+		require.Equal(t, coinbase, fees.Recipient)
+		require.Equal(t, "100", fees.Collected)
+		require.Equal(t, "100", fees.TotalCollected)
+		require.Equal(t, "1000", fees.RecipientBalance)
+		require.False(t, fees.Reconciled)
+
+		fees, err = m.getSequencerFees(ctx, 2)
+		require.NoError(t, err)
+		require.Equal(t, "150", fees.TotalCollected)
+		require.True(t, fees.Reconciled)
+		require.Equal(t, "0", fees.Discrepancy)
+
+		fees, err = m.getSequencerFees(ctx, 3)
+		require.NoError(t, err)
+		require.Equal(t, "180", fees.TotalCollected)
+		require.True(t, fees.Reconciled)
+		require.Equal(t, "-20", fees.Discrepancy)
+
+		// a new recipient is not reconciled against the balance of the previous one
+		fees, err = m.getSequencerFees(ctx, 4)
+		require.NoError(t, err)
+		require.Equal(t, "190", fees.TotalCollected)
+		require.False(t, fees.Reconciled)
+	})
+
+	t.Run("executor error does not account fees", func(t *testing.T) {
+		exec := &feeReporterExecutor{err: errors.New("boom")}
+		m := newManager(exec)
+		m.saveSequencerFees(ctx, m.store, exec, 1)
+
+		_, err := m.getSequencerFees(ctx, 1)
+		require.Error(t, err)
+	})
+
+}
+
+func TestAccountSequencerFees(t *testing.T) {
+	ctx := context.Background()
+	coinbase := []byte("coinbase")
+	exec := &feeReporterExecutor{fees: map[uint64]coreexecutor.BlockFees{
+		1: {Recipient: coinbase, Collected: big.NewInt(100), RecipientBalance: big.NewInt(100)},
+		2: {Recipient: coinbase, Collected: big.NewInt(50), RecipientBalance: big.NewInt(150)},
+		3: {Recipient: coinbase, Collected: big.NewInt(10), RecipientBalance: big.NewInt(160)},
+	}}
+	kv, err := storepkg.NewDefaultInMemoryKVStore()
+	require.NoError(t, err)
+	store := storepkg.New(kv)
+	m := &Manager{store: store, exec: exec, logger: zerolog.Nop()}
+
+	// without progress, accounting starts after the blocks committed before the loop started
+	require.NoError(t, store.SetHeight(ctx, 1))
+	next, err := m.nextSequencerFeesHeight(ctx)
+	require.NoError(t, err)
+	require.Equal(t, uint64(2), next)
+
+	// the blocks committed since are accounted in order
+	require.NoError(t, store.SetHeight(ctx, 3))
+	require.Equal(t, uint64(4), m.accountSequencerFees(ctx, next))
+	_, err = m.getSequencerFees(ctx, 1)
+	require.Error(t, err)
+	fees, err := m.getSequencerFees(ctx, 3)
+	require.NoError(t, err)
+	require.Equal(t, "60", fees.TotalCollected)
+	require.True(t, fees.Reconciled)
+
+	// the progress is persisted, so that accounting resumes after a restart
+	next, err = m.nextSequencerFeesHeight(ctx)
+	require.NoError(t, err)
+	require.Equal(t, uint64(4), next)
+
+	// blocks are skipped by executors without fee reports
+	m.exec = mocks.NewMockExecutor(t)
+	require.NoError(t, store.SetHeight(ctx, 4))
+	require.Equal(t, uint64(5), m.accountSequencerFees(ctx, next))
+	_, err = m.getSequencerFees(ctx, 4)
+	require.Error(t, err)
+}
@@ -0,0 +1,169 @@
+package block
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"strconv"
+
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	coreexecutor "github.com/evstack/ev-node/core/execution"
+	"github.com/evstack/ev-node/pkg/journal"
+	storepkg "github.com/evstack/ev-node/pkg/store"
+	"github.com/evstack/ev-node/types"
+	pb "github.com/evstack/ev-node/types/pb/evnode/v1"
+)
+
+// shadowTrials is the number of times a shadow replica re-executes the transactions of a diverging
+// block, or of a prefix of them, to tell whether their execution is reproducible.
+const shadowTrials = 3
+
+// ExecutionDivergence returns the report of the block whose execution diverged from the sequencer,
+// nil if none was detected. Only shadow replicas cross-check the state roots of the sequencer.
+func (m *Manager) ExecutionDivergence() *pb.ExecutionDivergence {
+	return m.divergence.Load()
+}
+
+// checkDivergence cross-checks the state root committed by the sequencer in the header of the next
+// block against the one computed by the node after the previous block, and reports a divergence.
+// It returns true if the node diverged and must stop syncing. execMu must be held for reading.
+func (m *Manager) checkDivergence(ctx context.Context, header *types.SignedHeader) bool {
+	lastState := m.GetLastState()
+	if bytes.Equal(header.AppHash, lastState.AppHash) {
+		return false
+	}
+	m.reportDivergence(ctx, header.Height()-1, header.AppHash, lastState.AppHash)
+	return true
+}
+
+// reportDivergence builds the report of a block whose state root diverged from the one committed
+// by the sequencer, then persists, records and logs it. The report is an artifact for operators, so
+// failures are logged and do not prevent it from being recorded.
+func (m *Manager) reportDivergence(ctx context.Context, height uint64, expected, stateRoot []byte) {
+	report := &pb.ExecutionDivergence{
+		Height:            height,
+		ExpectedStateRoot: expected,
+		StateRoot:         stateRoot,
+		DivergingTxIndex:  -1,
+		DetectedAt:        timestamppb.Now(),
+	}
+
+	// the state root of the genesis comes from InitChain, there is no block to re-execute
+	if height >= m.genesis.InitialHeight {
+		if err := m.reexecuteDivergence(ctx, report); err != nil {
+			m.logger.Warn().Err(err).Uint64("height", height).Msg("failed to re-execute diverging block")
+		}
+	}
+
+	if bz, err := proto.Marshal(report); err != nil {
+		m.logger.Error().Err(err).Uint64("height", height).Msg("failed to marshal execution divergence")
+	} else if err := m.store.SetMetadata(ctx, fmt.Sprintf("%s/%d", storepkg.ExecutionDivergenceKey, height), bz); err != nil {
+		m.logger.Error().Err(err).Uint64("height", height).Msg("failed to save execution divergence")
+	}
+	m.divergence.Store(report)
+
+	attrs := map[string]string{
+		"height":              strconv.FormatUint(height, 10),
+		"expected_state_root": hex.EncodeToString(expected),
+		"state_root":          hex.EncodeToString(stateRoot),
+		"reproducible":        strconv.FormatBool(report.Reproducible),
+	}
+	if report.DivergingTxIndex >= 0 {
+		attrs["diverging_tx_index"] = strconv.FormatInt(report.DivergingTxIndex, 10)
+		attrs["diverging_tx_hash"] = report.DivergingTxHash
+	}
+	m.recordEvent(ctx, journal.EventExecutionDivergence, fmt.Sprintf("execution diverged from the sequencer at height %d", height), attrs)
+
+	m.logger.Error().
+		Uint64("height", height).
+		Hex("expected_state_root", expected).
+		Hex("state_root", stateRoot).
+		Uint64("tx_count", report.TxCount).
+		Bool("reexecuted", report.Reexecuted).
+		Bool("reproducible", report.Reproducible).
+		Int64("diverging_tx_index", report.DivergingTxIndex).
+		Str("diverging_tx_hash", report.DivergingTxHash).
+		Msg("execution nondeterminism detected: state root diverged from the sequencer, sync stopped")
+}
+
+// reexecuteDivergence re-executes the diverging block of a report, if the executor can simulate
+// transactions, to pinpoint the diverging transaction.
+//
+// Only the state root after the whole block is committed by the sequencer, so the transactions
+// cannot be checked one by one against it. Instead, the block is executed several times: if the
+// replica reproduces its own state root, its execution is deterministic and the divergence comes
+// from the sequencer or from a state diverged earlier. Otherwise, the prefixes of the block are
+// bisected to find the shortest one whose execution is not reproducible, ending with the diverging
+// transaction. Nondeterminism may not show in every execution, so a reproducible prefix is only
+// likely to be deterministic. Blob pointers are resolved first, so the diverging transaction is an
+// index in the executed transactions. execMu must be held for reading.
+func (m *Manager) reexecuteDivergence(ctx context.Context, report *pb.ExecutionDivergence) error {
+	simulator, ok := m.exec.(coreexecutor.Simulator)
+	if !ok {
+		return nil
+	}
+	header, data, err := m.store.GetBlockData(ctx, report.Height)
+	if err != nil {
+		return fmt.Errorf("failed to load block: %w", err)
+	}
+	txs, err := resolveTxs(ctx, m.genesis, m.blobResolver, data.Txs)
+	if err != nil {
+		return err
+	}
+	report.TxCount = uint64(len(txs))
+
+	// reproducible executes the first n transactions of the block shadowTrials times and returns
+	// the state root if all the executions agree on it
+	reproducible := func(n int) ([]byte, bool, error) {
+		var root []byte
+		for i := range shadowTrials {
+			r, err := simulator.SimulateTxs(ctx, txs[:n], header.Height(), header.Time(), header.AppHash)
+			if err != nil {
+				return nil, false, fmt.Errorf("failed to simulate %d transactions: %w", n, err)
+			}
+			if i > 0 && !bytes.Equal(r, root) {
+				return nil, false, nil
+			}
+			root = r
+		}
+		return root, true, nil
+	}
+
+	root, ok, err := reproducible(len(txs))
+	if err != nil {
+		return err
+	}
+	report.Reexecuted = true
+	report.Reproducible = ok && bytes.Equal(root, report.StateRoot)
+	// the nondeterminism of a block whose re-executions agree with each other cannot be pinpointed
+	if ok {
+		return nil
+	}
+	if _, ok, err := reproducible(0); err != nil || !ok {
+		// the nondeterminism is not caused by the transactions
+		return err
+	}
+
+	// the execution of the first lo transactions is reproducible, the one of the first hi is not
+	lo, hi := 0, len(txs)
+	for hi-lo > 1 {
+		mid := lo + (hi-lo)/2
+		_, ok, err := reproducible(mid)
+		if err != nil {
+			return err
+		}
+		if ok {
+			lo = mid
+		} else {
+			hi = mid
+		}
+	}
+	hash := sha256.Sum256(txs[hi-1])
+	report.DivergingTxIndex = int64(hi - 1)
+	report.DivergingTxHash = hex.EncodeToString(hash[:])
+	return nil
+}
@@ -0,0 +1,121 @@
+package block
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+
+	coreexecutor "github.com/evstack/ev-node/core/execution"
+	"github.com/evstack/ev-node/pkg/config"
+	"github.com/evstack/ev-node/pkg/genesis"
+	storepkg "github.com/evstack/ev-node/pkg/store"
+	"github.com/evstack/ev-node/types"
+	pb "github.com/evstack/ev-node/types/pb/evnode/v1"
+)
+
+// flakySimulator is a dummy executor whose execution is not reproducible once a given transaction
+// is executed.
+type flakySimulator struct {
+	*coreexecutor.DummyExecutor
+	flakyTx []byte
+
+	mu    sync.Mutex
+	calls int
+}
+
+func (s *flakySimulator) SimulateTxs(ctx context.Context, txs [][]byte, blockHeight uint64, timestamp time.Time, prevStateRoot []byte) ([]byte, error) {
+	root, err := s.DummyExecutor.SimulateTxs(ctx, txs, blockHeight, timestamp, prevStateRoot)
+	if err != nil {
+		return nil, err
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.calls++
+	for _, tx := range txs {
+		if string(tx) == string(s.flakyTx) {
+			return append(root, byte(s.calls)), nil
+		}
+	}
+	return root, nil
+}
+
+func TestShadowReplicaDivergence(t *testing.T) {
+	ctx := context.Background()
+	const chainID = "test-chain"
+	header, data, _ := types.GenerateRandomBlockCustom(&types.BlockConfig{Height: 1, NTxs: 8}, chainID)
+	txs := make([][]byte, len(data.Txs))
+	for i, tx := range data.Txs {
+		txs[i] = tx
+	}
+	deterministicRoot, err := coreexecutor.NewDummyExecutor().SimulateTxs(ctx, txs, 1, header.Time(), header.AppHash)
+	require.NoError(t, err)
+
+	newManager := func(t *testing.T, exec coreexecutor.Executor, stateRoot []byte) *Manager {
+		kv, err := storepkg.NewDefaultInMemoryKVStore()
+		require.NoError(t, err)
+		s := storepkg.New(kv)
+		require.NoError(t, s.SaveBlockData(ctx, header, data, &header.Signature))
+		cfg := config.DefaultConfig
+		cfg.Node.ShadowReplica = true
+		return &Manager{
+			store:        s,
+			exec:         exec,
+			config:       cfg,
+			genesis:      genesis.Genesis{ChainID: chainID, InitialHeight: 1},
+			logger:       zerolog.Nop(),
+			lastStateMtx: new(sync.RWMutex),
+			lastState:    types.State{ChainID: chainID, LastBlockHeight: 1, AppHash: stateRoot},
+		}
+	}
+	next, _, _ := types.GenerateRandomBlockCustomWithAppHash(&types.BlockConfig{Height: 2}, chainID, deterministicRoot)
+
+	t.Run("agreeing state root", func(t *testing.T) {
+		m := newManager(t, coreexecutor.NewDummyExecutor(), deterministicRoot)
+		require.False(t, m.checkDivergence(ctx, next))
+		require.Nil(t, m.ExecutionDivergence())
+	})
+
+	t.Run("nondeterministic transaction", func(t *testing.T) {
+		m := newManager(t, &flakySimulator{DummyExecutor: coreexecutor.NewDummyExecutor(), flakyTx: txs[5]}, []byte("replica root"))
+		require.True(t, m.checkDivergence(ctx, next))
+
+		report := m.ExecutionDivergence()
+		require.NotNil(t, report)
+		require.Equal(t, uint64(1), report.Height)
+		require.Equal(t, deterministicRoot, report.ExpectedStateRoot)
+		require.Equal(t, []byte("replica root"), report.StateRoot)
+		require.Equal(t, uint64(8), report.TxCount)
+		require.True(t, report.Reexecuted)
+		require.False(t, report.Reproducible)
+		require.Equal(t, int64(5), report.DivergingTxIndex)
+		hash := sha256.Sum256(txs[5])
+		require.Equal(t, hex.EncodeToString(hash[:]), report.DivergingTxHash)
+
+		bz, err := m.store.GetMetadata(ctx, fmt.Sprintf("%s/%d", storepkg.ExecutionDivergenceKey, 1))
+		require.NoError(t, err)
+		var stored pb.ExecutionDivergence
+		require.NoError(t, proto.Unmarshal(bz, &stored))
+		require.Equal(t, report.DivergingTxHash, stored.DivergingTxHash)
+	})
+
+	t.Run("reproducible execution", func(t *testing.T) {
+		// the replica reproduces its own state root: the sequencer diverged
+		other, _, _ := types.GenerateRandomBlockCustomWithAppHash(&types.BlockConfig{Height: 2}, chainID, []byte("sequencer root"))
+		m := newManager(t, coreexecutor.NewDummyExecutor(), deterministicRoot)
+		require.True(t, m.checkDivergence(ctx, other))
+
+		report := m.ExecutionDivergence()
+		require.True(t, report.Reexecuted)
+		require.True(t, report.Reproducible)
+		require.Equal(t, int64(-1), report.DivergingTxIndex)
+		require.Empty(t, report.DivergingTxHash)
+	})
+}
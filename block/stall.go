@@ -0,0 +1,242 @@
+package block
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/evstack/ev-node/pkg/journal"
+)
+
+// Bottlenecks to which sync stalls are attributed.
+const (
+	StallCausePeers     = "peers"
+	StallCauseDA        = "da"
+	StallCauseExecution = "execution"
+	StallCauseLocalIO   = "local_io"
+)
+
+// stallCauses lists the causes of sync stalls, e.g. to initialize metrics.
+var stallCauses = []string{StallCausePeers, StallCauseDA, StallCauseExecution, StallCauseLocalIO}
+
+// syncStallBlockTimes is the number of block times without a synced block after which sync is
+// considered stalled.
+const syncStallBlockTimes = 10
+
+// SyncPeerStats are the totals of the requests of the sync services to peers.
+type SyncPeerStats struct {
+	// Peers is the number of connected peers.
+	Peers int
+	// Served is the number of headers and data served by peers.
+	Served uint64
+	// Requests is the number of requests made to peers.
+	Requests uint64
+	// FailedRequests is the number of requests to peers which failed.
+	FailedRequests uint64
+}
+
+// SyncPeers reports the contributions of peers to the sync of the node.
+type SyncPeers interface {
+	SyncPeerStats() SyncPeerStats
+}
+
+// SyncStall is the diagnostic of a sync stall.
+type SyncStall struct {
+	// Cause is the bottleneck of the sync, one of the StallCause constants.
+	Cause string
+	// Reason describes what was observed.
+	Reason string
+	// Height is the height of the last synced block.
+	Height uint64
+	// Duration is the time since the last block was synced.
+	Duration time.Duration
+}
+
+// stallTracker records the progress of the sync and of the operations it depends on, to find
+// the bottleneck once it stalls. The zero value is ready to use.
+type stallTracker struct {
+	mu sync.Mutex
+	stallState
+}
+
+// stallState is the state of a stallTracker.
+type stallState struct {
+	// lastProgress is the time the last block was synced, or the sync started
+	lastProgress time.Time
+	// peersAtProgress are the peer stats when the last block was synced
+	peersAtProgress SyncPeerStats
+	// execStarted and ioStarted are the start times of the block execution and store writes
+	// in progress, zero if there are none
+	execStarted time.Time
+	ioStarted   time.Time
+	// daErr is the last DA retrieval error since the last successful retrieval
+	daErr   error
+	daErrAt time.Time
+	// reported is the cause of the current stall already reported, empty if none
+	reported string
+}
+
+// state returns a copy of the state of the tracker.
+func (t *stallTracker) state() stallState {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	return t.stallState
+}
+
+func (t *stallTracker) progress(now time.Time, peers SyncPeerStats) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.lastProgress = now
+	t.peersAtProgress = peers
+	t.reported = ""
+}
+
+func (t *stallTracker) startExec(now time.Time) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.execStarted = now
+}
+
+func (t *stallTracker) endExec() {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.execStarted = time.Time{}
+}
+
+func (t *stallTracker) startIO(now time.Time) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.ioStarted = now
+}
+
+func (t *stallTracker) endIO() {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.ioStarted = time.Time{}
+}
+
+func (t *stallTracker) daRetrieved(err error, now time.Time) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.daErr = err
+	t.daErrAt = now
+}
+
+// SetSyncPeers sets the provider of the peer stats used to attribute sync stalls.
+func (m *Manager) SetSyncPeers(peers SyncPeers) {
+	m.syncPeers = peers
+}
+
+func (m *Manager) syncPeerStats() SyncPeerStats {
+	if m.syncPeers == nil {
+		return SyncPeerStats{}
+	}
+	return m.syncPeers.SyncPeerStats()
+}
+
+// syncStallTimeout returns the time without a synced block after which sync is considered stalled.
+func (m *Manager) syncStallTimeout() time.Duration {
+	blockTime := m.blockTime()
+	if m.config.Node.LazyMode {
+		blockTime = m.config.Node.LazyBlockInterval.Duration
+	}
+	return max(syncStallBlockTimes*blockTime, 2*m.config.DA.BlockTime.Duration)
+}
+
+// monitorSyncStalls reports sync stalls with their cause until ctx is done. A stall is reported
+// once per cause until a block is synced again.
+func (m *Manager) monitorSyncStalls(ctx context.Context) {
+	m.stall.progress(time.Now(), m.syncPeerStats())
+	ticker := time.NewTicker(m.config.Node.BlockTime.Duration)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case now := <-ticker.C:
+			stall := m.diagnoseSyncStall(ctx, now)
+			if stall == nil {
+				continue
+			}
+			m.stall.mu.Lock()
+			reported := m.stall.reported == stall.Cause
+			m.stall.reported = stall.Cause
+			m.stall.mu.Unlock()
+			if !reported {
+				m.reportSyncStall(ctx, stall)
+			}
+		}
+	}
+}
+
+// diagnoseSyncStall returns the diagnostic of the sync stall at now, nil if sync is not stalled,
+// e.g. because it caught up with a network which produces no blocks.
+func (m *Manager) diagnoseSyncStall(ctx context.Context, now time.Time) *SyncStall {
+	t := m.stall.state()
+	stalledFor := now.Sub(t.lastProgress)
+	if stalledFor < m.syncStallTimeout() {
+		return nil
+	}
+
+	height, err := m.store.Height(ctx)
+	if err != nil {
+		return &SyncStall{Cause: StallCauseLocalIO, Reason: fmt.Sprintf("store not readable: %v", err), Duration: stalledFor}
+	}
+	stall := &SyncStall{Height: height, Duration: stalledFor}
+
+	peers := m.syncPeerStats()
+	requests := peers.Requests - t.peersAtProgress.Requests
+	failed := peers.FailedRequests - t.peersAtProgress.FailedRequests
+
+	switch {
+	case m.diskQuota.readOnly.Load():
+		stall.Cause = StallCauseLocalIO
+		stall.Reason = fmt.Sprintf("store reached its disk quota of %d bytes", m.config.Node.MaxDiskUsage)
+	case !t.execStarted.IsZero():
+		stall.Cause = StallCauseExecution
+		stall.Reason = fmt.Sprintf("execution of block %d running for %s", height+1, now.Sub(t.execStarted).Round(time.Millisecond))
+	case !t.ioStarted.IsZero():
+		stall.Cause = StallCauseLocalIO
+		stall.Reason = fmt.Sprintf("store writes of block %d running for %s", height+1, now.Sub(t.ioStarted).Round(time.Millisecond))
+	case t.daErr != nil && t.daErrAt.After(t.lastProgress):
+		stall.Cause = StallCauseDA
+		stall.Reason = fmt.Sprintf("DA retrieval at DA height %d failing: %v", m.daHeight.Load(), t.daErr)
+	case peers.Peers == 0:
+		stall.Cause = StallCausePeers
+		stall.Reason = "no connected peers"
+	case requests > 0 && failed == requests:
+		stall.Cause = StallCausePeers
+		stall.Reason = fmt.Sprintf("all %d requests to peers failed", requests)
+	default:
+		// the peers respond, so sync only stalls if they do not serve the next block
+		networkHeight := m.headerStore.Height()
+		switch {
+		case networkHeight <= height:
+			return nil
+		case m.headerCache.GetItem(height+1) != nil:
+			stall.Cause = StallCausePeers
+			stall.Reason = fmt.Sprintf("data of block %d not served by peers nor found on DA", height+1)
+		default:
+			stall.Cause = StallCausePeers
+			stall.Reason = fmt.Sprintf("headers up to %d known but header %d not received", networkHeight, height+1)
+		}
+	}
+	return stall
+}
+
+func (m *Manager) reportSyncStall(ctx context.Context, stall *SyncStall) {
+	m.metrics.SyncStalls[stall.Cause].Add(1)
+	m.logger.Warn().
+		Str("cause", stall.Cause).
+		Str("reason", stall.Reason).
+		Uint64("height", stall.Height).
+		Dur("stalled_for", stall.Duration).
+		Msg("sync stalled")
+	m.recordEvent(ctx, journal.EventSyncStalled, stall.Reason, map[string]string{
+		"cause":       stall.Cause,
+		"height":      strconv.FormatUint(stall.Height, 10),
+		"stalled_for": stall.Duration.Round(time.Second).String(),
+	})
+}
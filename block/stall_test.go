@@ -0,0 +1,147 @@
+package block
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/evstack/ev-node/pkg/cache"
+	"github.com/evstack/ev-node/pkg/config"
+	"github.com/evstack/ev-node/test/mocks"
+	"github.com/evstack/ev-node/types"
+)
+
+type syncPeersStub struct {
+	stats SyncPeerStats
+}
+
+func (s *syncPeersStub) SyncPeerStats() SyncPeerStats {
+	return s.stats
+}
+
+func TestDiagnoseSyncStall(t *testing.T) {
+	cfg := config.DefaultConfig
+	cfg.Node.BlockTime.Duration = time.Second
+	cfg.DA.BlockTime.Duration = 6 * time.Second
+	start := time.Unix(1_700_000_000, 0)
+	stalled := start.Add(20 * time.Second)
+	progressPeers := SyncPeerStats{Peers: 3, Served: 10, Requests: 10, FailedRequests: 1}
+
+	for _, tc := range []struct {
+		name      string
+		now       time.Time
+		storeErr  error
+		peers     SyncPeerStats
+		setup     func(*stallTracker)
+		wantCause string
+		wantInfo  string
+	}{
+		{
+			name:  "not stalled before timeout",
+			now:   start.Add(11 * time.Second),
+			peers: SyncPeerStats{},
+		},
+		{
+			name:      "execution",
+			now:       stalled,
+			peers:     progressPeers,
+			setup:     func(t *stallTracker) { t.startExec(start.Add(time.Second)) },
+			wantCause: StallCauseExecution,
+			wantInfo:  "execution of block 11 running for 19s",
+		},
+		{
+			name:      "store writes",
+			now:       stalled,
+			peers:     progressPeers,
+			setup:     func(t *stallTracker) { t.startIO(start.Add(time.Second)) },
+			wantCause: StallCauseLocalIO,
+			wantInfo:  "store writes of block 11",
+		},
+		{
+			name:      "store unreadable",
+			now:       stalled,
+			storeErr:  errors.New("disk failure"),
+			wantCause: StallCauseLocalIO,
+			wantInfo:  "store not readable: disk failure",
+		},
+		{
+			name:      "da retrieval failing",
+			now:       stalled,
+			peers:     progressPeers,
+			setup:     func(t *stallTracker) { t.daRetrieved(errors.New("connection refused"), start.Add(time.Second)) },
+			wantCause: StallCauseDA,
+			wantInfo:  "DA retrieval at DA height 7 failing: connection refused",
+		},
+		{
+			name:      "da failure before progress is ignored",
+			now:       stalled,
+			peers:     SyncPeerStats{},
+			setup:     func(t *stallTracker) { t.daRetrieved(errors.New("connection refused"), start.Add(-time.Second)) },
+			wantCause: StallCausePeers,
+			wantInfo:  "no connected peers",
+		},
+		{
+			name:      "all requests failed",
+			now:       stalled,
+			peers:     SyncPeerStats{Peers: 3, Served: 10, Requests: 14, FailedRequests: 5},
+			wantCause: StallCausePeers,
+			wantInfo:  "all 4 requests to peers failed",
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			mockStore := mocks.NewMockStore(t)
+			mockStore.On("Height", mock.Anything).Return(uint64(10), tc.storeErr).Maybe()
+			peers := &syncPeersStub{stats: progressPeers}
+			m := &Manager{
+				store:        mockStore,
+				config:       cfg,
+				logger:       zerolog.Nop(),
+				headerCache:  cache.NewCache[types.SignedHeader](),
+				dataCache:    cache.NewCache[types.Data](),
+				daHeight:     &atomic.Uint64{},
+				lastStateMtx: &sync.RWMutex{},
+				metrics:      NopMetrics(),
+			}
+			m.daHeight.Store(7)
+			m.SetSyncPeers(peers)
+
+			m.stall.progress(start, m.syncPeerStats())
+			peers.stats = tc.peers
+			if tc.setup != nil {
+				tc.setup(&m.stall)
+			}
+
+			stall := m.diagnoseSyncStall(context.Background(), tc.now)
+			if tc.wantCause == "" {
+				assert.Nil(t, stall)
+				return
+			}
+			require.NotNil(t, stall)
+			assert.Equal(t, tc.wantCause, stall.Cause)
+			assert.Contains(t, stall.Reason, tc.wantInfo)
+			assert.Equal(t, tc.now.Sub(start), stall.Duration)
+		})
+	}
+}
+
+func TestSyncStallTimeout(t *testing.T) {
+	m := &Manager{config: config.DefaultConfig}
+	m.config.Node.BlockTime.Duration = time.Second
+	m.config.DA.BlockTime.Duration = 6 * time.Second
+	assert.Equal(t, 12*time.Second, m.syncStallTimeout())
+
+	m.config.Node.BlockTime.Duration = 2 * time.Second
+	assert.Equal(t, 20*time.Second, m.syncStallTimeout())
+
+	m.config.Node.LazyMode = true
+	m.config.Node.LazyBlockInterval.Duration = time.Minute
+	assert.Equal(t, 10*time.Minute, m.syncStallTimeout())
+}
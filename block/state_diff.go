@@ -0,0 +1,43 @@
+package block
+
+import (
+	"context"
+	"fmt"
+
+	"google.golang.org/protobuf/proto"
+
+	coreexecutor "github.com/evstack/ev-node/core/execution"
+	storepkg "github.com/evstack/ev-node/pkg/store"
+	pb "github.com/evstack/ev-node/types/pb/evnode/v1"
+)
+
+// saveStateDiff records the state diff of an executed block if the executor provides one, saved
+// when the block is committed. The diff is an optional artifact, so failures are logged and do not
+// stop block processing. execMu must be held for reading.
+func (m *Manager) saveStateDiff(ctx context.Context, height uint64) {
+	provider, ok := m.exec.(coreexecutor.StateDiffProvider)
+	if !ok {
+		return
+	}
+
+	changes, err := provider.GetStateDiff(ctx, height)
+	if err != nil {
+		m.logger.Error().Err(err).Uint64("height", height).Msg("failed to get state diff from executor")
+		return
+	}
+
+	diff := &pb.StateDiff{
+		Height:  height,
+		Changes: make([]*pb.StateChange, len(changes)),
+	}
+	for i, c := range changes {
+		diff.Changes[i] = &pb.StateChange{Key: c.Key, Value: c.Value, Deleted: c.Deleted}
+	}
+	bz, err := proto.Marshal(diff)
+	if err != nil {
+		m.logger.Error().Err(err).Uint64("height", height).Msg("failed to marshal state diff")
+		return
+	}
+
+	m.blockMetadata.set(height, fmt.Sprintf("%s/%d", storepkg.StateDiffKey, height), bz)
+}
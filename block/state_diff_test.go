@@ -0,0 +1,75 @@
+package block
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+
+	coreexecutor "github.com/evstack/ev-node/core/execution"
+	storepkg "github.com/evstack/ev-node/pkg/store"
+	"github.com/evstack/ev-node/test/mocks"
+	pb "github.com/evstack/ev-node/types/pb/evnode/v1"
+)
+
+// stateDiffExecutor is an executor that provides a fixed state diff.
+type stateDiffExecutor struct {
+	*mocks.MockExecutor
+	changes []coreexecutor.StateChange
+	err     error
+}
+
+func (e *stateDiffExecutor) GetStateDiff(ctx context.Context, blockHeight uint64) ([]coreexecutor.StateChange, error) {
+	return e.changes, e.err
+}
+
+func TestSaveStateDiff(t *testing.T) {
+	ctx := context.Background()
+	newManager := func(exec coreexecutor.Executor) *Manager {
+		kv, err := storepkg.NewDefaultInMemoryKVStore()
+		require.NoError(t, err)
+		return &Manager{store: storepkg.New(kv), exec: exec, logger: zerolog.Nop()}
+	}
+	diffKey := fmt.Sprintf("%s/%d", storepkg.StateDiffKey, 7)
+
+	t.Run("diff is stored", func(t *testing.T) {
+		m := newManager(&stateDiffExecutor{changes: []coreexecutor.StateChange{
+			{Key: []byte("a"), Value: []byte("1")},
+			{Key: []byte("b"), Deleted: true},
+		}})
+		m.saveStateDiff(ctx, 7)
+		require.NoError(t, m.saveBlockMetadata(ctx, m.store, 7))
+
+		bz, err := m.store.GetMetadata(ctx, diffKey)
+		require.NoError(t, err)
+		var diff pb.StateDiff
+		require.NoError(t, proto.Unmarshal(bz, &diff))
+		require.Equal(t, uint64(7), diff.Height)
+		require.Len(t, diff.Changes, 2)
+		require.Equal(t, []byte("a"), diff.Changes[0].Key)
+		require.Equal(t, []byte("1"), diff.Changes[0].Value)
+		require.True(t, diff.Changes[1].Deleted)
+	})
+
+	t.Run("executor error does not store a diff", func(t *testing.T) {
+		m := newManager(&stateDiffExecutor{err: errors.New("boom")})
+		m.saveStateDiff(ctx, 7)
+		require.NoError(t, m.saveBlockMetadata(ctx, m.store, 7))
+
+		_, err := m.store.GetMetadata(ctx, diffKey)
+		require.Error(t, err)
+	})
+
+	t.Run("executor without state diffs", func(t *testing.T) {
+		m := newManager(mocks.NewMockExecutor(t))
+		m.saveStateDiff(ctx, 7)
+		require.NoError(t, m.saveBlockMetadata(ctx, m.store, 7))
+
+		_, err := m.store.GetMetadata(ctx, diffKey)
+		require.Error(t, err)
+	})
+}
@@ -51,7 +51,7 @@ func (m *Manager) HeaderStoreRetrieveLoop(ctx context.Context) {
 					continue
 				}
 				m.logger.Debug().Uint64("headerHeight", header.Height()).Uint64("daHeight", daHeight).Msg("header retrieved from p2p header sync")
-				m.headerInCh <- NewHeaderEvent{header, daHeight}
+				m.headerInCh <- NewHeaderEvent{Header: header, DAHeight: daHeight, Source: SyncSourceP2P}
 			}
 		}
 		lastHeaderStoreHeight = headerStoreHeight
@@ -95,7 +95,7 @@ func (m *Manager) DataStoreRetrieveLoop(ctx context.Context) {
 				}
 				// TODO: remove junk if possible
 				m.logger.Debug().Uint64("dataHeight", d.Metadata.Height).Uint64("daHeight", daHeight).Msg("data retrieved from p2p data sync")
-				m.dataInCh <- NewDataEvent{d, daHeight}
+				m.dataInCh <- NewDataEvent{Data: d, DAHeight: daHeight, Source: SyncSourceP2P}
 			}
 		}
 		lastDataStoreHeight = dataStoreHeight
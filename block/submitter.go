@@ -6,6 +6,7 @@ import (
 	"time"
 
 	coreda "github.com/evstack/ev-node/core/da"
+	"github.com/evstack/ev-node/pkg/journal"
 	"github.com/evstack/ev-node/pkg/rpc/server"
 	"github.com/evstack/ev-node/types"
 	"google.golang.org/protobuf/proto"
@@ -113,6 +114,7 @@ func (m *Manager) HeaderSubmissionLoop(ctx context.Context) {
 			m.logger.Info().Msg("header submission loop stopped")
 			return
 		case <-timer.C:
+		case <-m.headerSubmissionCh:
 		}
 		if m.pendingHeaders.isEmpty() {
 			continue
@@ -128,20 +130,31 @@ func (m *Manager) HeaderSubmissionLoop(ctx context.Context) {
 		err = m.submitHeadersToDA(ctx, headersToSubmit)
 		if err != nil {
 			m.logger.Error().Err(err).Msg("error while submitting header to DA")
+			m.recordEvent(ctx, journal.EventDASubmissionFailed, "failed to submit headers to DA", map[string]string{
+				"kind":  "header",
+				"error": err.Error(),
+			})
 		}
 	}
 }
 
+// TriggerDASubmission makes the submission loops submit the pending headers and data without
+// waiting for the next DA block time, and returns the number of pending headers and data.
+func (m *Manager) TriggerDASubmission() (headers, data uint64) {
+	headers, data = m.pendingHeaders.numPendingHeaders(), m.pendingData.numPendingData()
+	for _, ch := range []chan struct{}{m.headerSubmissionCh, m.dataSubmissionCh} {
+		select {
+		case ch <- struct{}{}:
+		default: // a submission is already triggered
+		}
+	}
+	return headers, data
+}
+
 // submitHeadersToDA submits a list of headers to the DA layer using the generic submitToDA helper.
 func (m *Manager) submitHeadersToDA(ctx context.Context, headersToSubmit []*types.SignedHeader) error {
 	return submitToDA(m, ctx, headersToSubmit,
-		func(header *types.SignedHeader) ([]byte, error) {
-			headerPb, err := header.ToProto()
-			if err != nil {
-				return nil, fmt.Errorf("failed to transform header to proto: %w", err)
-			}
-			return proto.Marshal(headerPb)
-		},
+		marshalHeader,
 		func(submitted []*types.SignedHeader, res *coreda.ResultSubmit, gasPrice float64) {
 			for _, header := range submitted {
 				m.headerCache.SetDAIncluded(header.Hash().String(), res.Height)
@@ -162,6 +175,15 @@ func (m *Manager) submitHeadersToDA(ctx context.Context, headersToSubmit []*type
 	)
 }
 
+// marshalHeader encodes a signed header for DA.
+func marshalHeader(header *types.SignedHeader) ([]byte, error) {
+	headerPb, err := header.ToProto()
+	if err != nil {
+		return nil, fmt.Errorf("failed to transform header to proto: %w", err)
+	}
+	return proto.Marshal(headerPb)
+}
+
 // DataSubmissionLoop is responsible for submitting data to the DA layer.
 func (m *Manager) DataSubmissionLoop(ctx context.Context) {
 	timer := time.NewTicker(m.config.DA.BlockTime.Duration)
@@ -172,6 +194,7 @@ func (m *Manager) DataSubmissionLoop(ctx context.Context) {
 			m.logger.Info().Msg("data submission loop stopped")
 			return
 		case <-timer.C:
+		case <-m.dataSubmissionCh:
 		}
 		if m.pendingData.isEmpty() {
 			continue
@@ -189,6 +212,10 @@ func (m *Manager) DataSubmissionLoop(ctx context.Context) {
 		err = m.submitDataToDA(ctx, signedDataToSubmit)
 		if err != nil {
 			m.logger.Error().Err(err).Msg("failed to submit data to DA")
+			m.recordEvent(ctx, journal.EventDASubmissionFailed, "failed to submit data to DA", map[string]string{
+				"kind":  "data",
+				"error": err.Error(),
+			})
 		}
 	}
 }
@@ -196,9 +223,7 @@ func (m *Manager) DataSubmissionLoop(ctx context.Context) {
 // submitDataToDA submits a list of signed data to the DA layer using the generic submitToDA helper.
 func (m *Manager) submitDataToDA(ctx context.Context, signedDataToSubmit []*types.SignedData) error {
 	return submitToDA(m, ctx, signedDataToSubmit,
-		func(signedData *types.SignedData) ([]byte, error) {
-			return signedData.MarshalBinary()
-		},
+		m.marshalSignedData,
 		func(submitted []*types.SignedData, res *coreda.ResultSubmit, gasPrice float64) {
 			for _, signedData := range submitted {
 				m.dataCache.SetDAIncluded(signedData.Data.DACommitment().String(), res.Height)
@@ -219,6 +244,138 @@ func (m *Manager) submitDataToDA(ctx context.Context, signedDataToSubmit []*type
 	)
 }
 
+// marshalSignedData encodes signed data for DA, laid out in segments if configured.
+func (m *Manager) marshalSignedData(signedData *types.SignedData) ([]byte, error) {
+	if size := m.config.DA.DataSegmentSize; size > 0 {
+		return signedData.MarshalSegmented(size)
+	}
+	return signedData.MarshalBinary()
+}
+
+// heightBlobs are the header and signed data of a height, submitted to the DA layer in a single
+// blob. The header is nil if it was already submitted, and the data if it was already submitted
+// or the block has no transactions.
+type heightBlobs struct {
+	height uint64
+	header *types.SignedHeader
+	data   *types.SignedData
+}
+
+// CombinedSubmissionLoop is responsible for submitting headers and data to the DA layer when
+// they are combined in a single blob per height. It replaces HeaderSubmissionLoop and
+// DataSubmissionLoop.
+func (m *Manager) CombinedSubmissionLoop(ctx context.Context) {
+	timer := time.NewTicker(m.config.DA.BlockTime.Duration)
+	defer timer.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			m.logger.Info().Msg("combined submission loop stopped")
+			return
+		case <-timer.C:
+		case <-m.headerSubmissionCh:
+		case <-m.dataSubmissionCh:
+		}
+		if m.pendingHeaders.isEmpty() && m.pendingData.isEmpty() {
+			continue
+		}
+		toSubmit, err := m.createHeightBlobsToSubmit(ctx)
+		if err != nil {
+			m.logger.Error().Err(err).Msg("failed to create headers and data to submit")
+			continue
+		}
+		if len(toSubmit) == 0 {
+			continue
+		}
+		err = m.submitHeightBlobsToDA(ctx, toSubmit)
+		if err != nil {
+			m.logger.Error().Err(err).Msg("failed to submit headers and data to DA")
+			m.recordEvent(ctx, journal.EventDASubmissionFailed, "failed to submit headers and data to DA", map[string]string{
+				"kind":  "combined",
+				"error": err.Error(),
+			})
+		}
+	}
+}
+
+// createHeightBlobsToSubmit pairs the pending headers and data by height, in increasing order.
+func (m *Manager) createHeightBlobsToSubmit(ctx context.Context) ([]*heightBlobs, error) {
+	headers, err := m.pendingHeaders.getPendingHeaders(ctx)
+	if err != nil {
+		return nil, err
+	}
+	signedData, err := m.createSignedDataToSubmit(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	toSubmit := make([]*heightBlobs, 0, max(len(headers), len(signedData)))
+	for len(headers) > 0 || len(signedData) > 0 {
+		var blobs heightBlobs
+		switch {
+		case len(signedData) == 0 || (len(headers) > 0 && headers[0].Height() < signedData[0].Height()):
+			blobs.height, blobs.header, headers = headers[0].Height(), headers[0], headers[1:]
+		case len(headers) == 0 || signedData[0].Height() < headers[0].Height():
+			blobs.height, blobs.data, signedData = signedData[0].Height(), signedData[0], signedData[1:]
+		default:
+			blobs.height, blobs.header, blobs.data = headers[0].Height(), headers[0], signedData[0]
+			headers, signedData = headers[1:], signedData[1:]
+		}
+		toSubmit = append(toSubmit, &blobs)
+	}
+	return toSubmit, nil
+}
+
+// submitHeightBlobsToDA submits the headers and data of heights to the DA layer, combined in a
+// single blob per height, using the generic submitToDA helper.
+func (m *Manager) submitHeightBlobsToDA(ctx context.Context, toSubmit []*heightBlobs) error {
+	return submitToDA(m, ctx, toSubmit,
+		func(blobs *heightBlobs) ([]byte, error) {
+			var headerBz, dataBz []byte
+			var err error
+			if blobs.header != nil {
+				if headerBz, err = blobs.header.MarshalBinary(); err != nil {
+					return nil, fmt.Errorf("failed to marshal header: %w", err)
+				}
+			}
+			if blobs.data != nil {
+				if dataBz, err = m.marshalSignedData(blobs.data); err != nil {
+					return nil, fmt.Errorf("failed to marshal data: %w", err)
+				}
+			}
+			return types.MarshalBlobEnvelope(headerBz, dataBz)
+		},
+		func(submitted []*heightBlobs, res *coreda.ResultSubmit, gasPrice float64) {
+			if len(submitted) == 0 {
+				return
+			}
+			for _, blobs := range submitted {
+				if blobs.header != nil {
+					m.headerCache.SetDAIncluded(blobs.header.Hash().String(), res.Height)
+				}
+				if blobs.data != nil {
+					m.dataCache.SetDAIncluded(blobs.data.Data.DACommitment().String(), res.Height)
+				}
+			}
+			// every pending header and non-empty data up to the last submitted height was submitted
+			lastSubmittedHeight := submitted[len(submitted)-1].height
+			if lastSubmittedHeight > m.pendingHeaders.getLastSubmittedHeaderHeight() {
+				m.pendingHeaders.setLastSubmittedHeaderHeight(ctx, lastSubmittedHeight)
+			}
+			if lastSubmittedHeight > m.pendingData.getLastSubmittedDataHeight() {
+				m.pendingData.setLastSubmittedDataHeight(ctx, lastSubmittedHeight)
+			}
+			// Update sequencer metrics if the sequencer supports it
+			if seq, ok := m.sequencer.(MetricsRecorder); ok {
+				seq.RecordMetrics(gasPrice, res.BlobSize, res.Code, m.pendingHeaders.numPendingHeaders(), lastSubmittedHeight)
+			}
+			m.sendNonBlockingSignalToDAIncluderCh()
+		},
+		"combined",
+		[]byte(m.config.DA.GetHeaderNamespace()),
+	)
+}
+
 // submitToDA is a generic helper for submitting items to the DA layer with retry, backoff, and gas price logic.
 func submitToDA[T any](
 	m *Manager,
@@ -255,7 +412,7 @@ func submitToDA[T any](
 		submitCtx, cancel := context.WithTimeout(ctx, submissionTimeout)
 		m.recordDAMetrics("submission", DAModeRetry)
 
-		res := types.SubmitWithHelpers(submitCtx, m.da, m.logger, marshaled, retryStrategy.gasPrice, namespace, nil)
+		res := m.submitWithSequence(submitCtx, marshaled, retryStrategy.gasPrice, namespace)
 		cancel()
 
 		outcome := handleSubmissionResult(ctx, m, res, remaining, marshaled, retryStrategy, postSubmit, itemType, namespace)
@@ -334,6 +491,9 @@ func handleSubmissionResult[T any](
 	case coreda.StatusTooBig:
 		return handleTooBigError(m, ctx, remaining, marshaled, retryStrategy, postSubmit, itemType, retryStrategy.attempt, namespace)
 
+	case coreda.StatusIncorrectAccountSequence:
+		return handleSequenceMismatch(m, &res, retryStrategy, retryStrategy.attempt, remaining, marshaled)
+
 	default:
 		return handleGenericFailure(m, &res, retryStrategy, retryStrategy.attempt, remaining, marshaled)
 	}
@@ -479,6 +639,37 @@ func handleTooBigError[T any](
 	}
 }
 
+// handleSequenceMismatch retries a submission rejected on the account sequence right away, as
+// the sequence was resynchronized from the error. If the error did not report the expected
+// sequence, the submission is retried with backoff like any other failure.
+func handleSequenceMismatch[T any](
+	m *Manager,
+	res *coreda.ResultSubmit,
+	retryStrategy *retryStrategy,
+	attempt int,
+	remaining []T,
+	marshaled [][]byte,
+) submissionOutcome[T] {
+	if !sequenceMismatchRe.MatchString(res.Message) {
+		return handleGenericFailure(m, res, retryStrategy, attempt, remaining, marshaled)
+	}
+
+	m.logger.Info().Str("error", res.Message).Int("attempt", attempt).Msg("DA layer submission failed on account sequence, retrying with the expected sequence")
+
+	m.recordDAMetrics("submission", DAModeFail)
+
+	// Record failed submission in DA visualization server
+	if daVisualizationServer := server.GetDAVisualizationServer(); daVisualizationServer != nil {
+		daVisualizationServer.RecordSubmission(res, retryStrategy.gasPrice, uint64(len(remaining)))
+	}
+
+	return submissionOutcome[T]{
+		RemainingItems:   remaining,
+		RemainingMarshal: marshaled,
+		AllSubmitted:     false,
+	}
+}
+
 func handleGenericFailure[T any](
 	m *Manager,
 	res *coreda.ResultSubmit,
@@ -511,7 +702,11 @@ func (m *Manager) createSignedDataToSubmit(ctx context.Context) ([]*types.Signed
 	if err != nil {
 		return nil, err
 	}
+	return m.signData(dataList)
+}
 
+// signData signs the data with transactions of the list, and skips the others.
+func (m *Manager) signData(dataList []*types.Data) ([]*types.SignedData, error) {
 	if m.signer == nil {
 		return nil, fmt.Errorf("signer is nil; cannot sign data")
 	}
@@ -688,7 +883,7 @@ func processBatch[T any](
 	batchCtx, batchCtxCancel := context.WithTimeout(ctx, submissionTimeout)
 	defer batchCtxCancel()
 
-	batchRes := types.SubmitWithHelpers(batchCtx, m.da, m.logger, batch.Marshaled, gasPrice, namespace, nil)
+	batchRes := m.submitWithSequence(batchCtx, batch.Marshaled, gasPrice, namespace)
 
 	if batchRes.Code == coreda.StatusSuccess {
 		// Successfully submitted this batch
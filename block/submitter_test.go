@@ -1098,3 +1098,106 @@ func TestSubmitHalfBatch(t *testing.T) {
 		})
 	}
 }
+
+func TestTriggerDASubmission(t *testing.T) {
+	da := &mocks.MockDA{}
+	m := newTestManagerWithDA(t, da)
+	m.config.DA.BlockTime.Duration = time.Hour
+	m.headerSubmissionCh = make(chan struct{}, 1)
+	m.dataSubmissionCh = make(chan struct{}, 1)
+
+	ctx := t.Context()
+	fillPendingHeaders(ctx, t, m.pendingHeaders, "Test Trigger Submission", numItemsToSubmit)
+	submitted := make(chan struct{}, numItemsToSubmit)
+	da.EXPECT().SubmitWithOptions(mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+		RunAndReturn(func(_ context.Context, blobs []coreda.Blob, _ float64, _ []byte, _ []byte) ([]coreda.ID, error) {
+			ids := make([]coreda.ID, len(blobs))
+			for i := range blobs {
+				ids[i] = getDummyID(1, []byte("commitment"))
+				submitted <- struct{}{}
+			}
+			return ids, nil
+		})
+
+	go m.HeaderSubmissionLoop(ctx)
+
+	headers, data := m.TriggerDASubmission()
+	assert.Equal(t, uint64(numItemsToSubmit), headers)
+	assert.Zero(t, data)
+
+	// the headers are submitted without waiting for the DA block time
+	for range numItemsToSubmit {
+		select {
+		case <-submitted:
+		case <-time.After(5 * time.Second):
+			t.Fatal("headers were not submitted")
+		}
+	}
+	require.Eventually(t, m.pendingHeaders.isEmpty, time.Second, 10*time.Millisecond)
+}
+
+func TestSubmitHeightBlobsToDA(t *testing.T) {
+	da := &mocks.MockDA{}
+	m := newTestManagerWithDA(t, da)
+	kv, err := store.NewDefaultInMemoryKVStore()
+	require.NoError(t, err)
+	s := store.New(kv)
+	m.pendingHeaders, err = NewPendingHeaders(s, zerolog.Nop())
+	require.NoError(t, err)
+	m.pendingData, err = NewPendingData(s, zerolog.Nop())
+	require.NoError(t, err)
+
+	// the block at height 2 has no transactions
+	ctx := t.Context()
+	for height := uint64(1); height <= numItemsToSubmit; height++ {
+		nTxs := 2
+		if height == 2 {
+			nTxs = 0
+		}
+		header, data := types.GetRandomBlock(height, nTxs, "Test Combined Submission")
+		require.NoError(t, s.SaveBlockData(ctx, header, data, &header.Signature))
+		require.NoError(t, s.SetHeight(ctx, height))
+	}
+
+	toSubmit, err := m.createHeightBlobsToSubmit(ctx)
+	require.NoError(t, err)
+	require.Len(t, toSubmit, numItemsToSubmit)
+	for i, blobs := range toSubmit {
+		assert.Equal(t, uint64(i+1), blobs.height)
+		assert.NotNil(t, blobs.header)
+		assert.Equal(t, blobs.height != 2, blobs.data != nil)
+	}
+
+	var submitted []coreda.Blob
+	da.EXPECT().SubmitWithOptions(mock.Anything, mock.Anything, mock.Anything, []byte(m.config.DA.GetHeaderNamespace()), mock.Anything).
+		RunAndReturn(func(_ context.Context, blobs []coreda.Blob, _ float64, _ []byte, _ []byte) ([]coreda.ID, error) {
+			submitted = append(submitted, blobs...)
+			ids := make([]coreda.ID, len(blobs))
+			for i := range blobs {
+				ids[i] = getDummyID(1, []byte("commitment"))
+			}
+			return ids, nil
+		}).Once()
+	require.NoError(t, m.submitHeightBlobsToDA(ctx, toSubmit))
+
+	// a single blob per height, combining its header and data
+	require.Len(t, submitted, numItemsToSubmit)
+	for i, blob := range submitted {
+		parts, err := types.UnmarshalBlobEnvelope(blob)
+		require.NoError(t, err)
+		require.Len(t, parts, 2)
+		var header types.SignedHeader
+		require.NoError(t, header.UnmarshalBinary(parts[0]))
+		assert.Equal(t, toSubmit[i].header.Hash(), header.Hash())
+		assert.True(t, m.headerCache.IsDAIncluded(header.Hash().String()))
+		if toSubmit[i].data == nil {
+			assert.Empty(t, parts[1])
+			continue
+		}
+		var data types.SignedData
+		require.NoError(t, data.UnmarshalBinary(parts[1]))
+		assert.True(t, m.dataCache.IsDAIncluded(data.Data.DACommitment().String()))
+	}
+	assert.True(t, m.pendingHeaders.isEmpty())
+	assert.True(t, m.pendingData.isEmpty())
+}
@@ -4,8 +4,11 @@ import (
 	"bytes"
 	"context"
 	"fmt"
+	"strconv"
 	"time"
 
+	"github.com/evstack/ev-node/pkg/journal"
+	storepkg "github.com/evstack/ev-node/pkg/store"
 	"github.com/evstack/ev-node/types"
 )
 
@@ -22,10 +25,19 @@ func (m *Manager) SyncLoop(ctx context.Context, errCh chan<- error) {
 	metricsTicker := time.NewTicker(30 * time.Second)
 	defer metricsTicker.Stop()
 
+	// the monitor runs apart from the loop, which is blocked while a block is synced
+	go m.monitorSyncStalls(ctx)
+
+	var daTicks uint64
 	for {
 		select {
 		case <-daTicker.C:
-			m.sendNonBlockingSignalToRetrieveCh()
+			// while the DA layer pushes new blobs, polling is only a fallback
+			daTicks++
+			if !m.daSubscribed.Load() || daTicks%daSubscriptionPollFactor == 0 {
+				m.sendNonBlockingSignalToRetrieveCh()
+			}
+			m.tryRecoverMissingData(ctx)
 		case <-blockTicker.C:
 			m.sendNonBlockingSignalToHeaderStoreCh()
 			m.sendNonBlockingSignalToDataStoreCh()
@@ -50,6 +62,7 @@ func (m *Manager) SyncLoop(ctx context.Context, errCh chan<- error) {
 				continue
 			}
 			m.headerCache.SetItem(headerHeight, header)
+			m.syncSources.setHeader(headerHeight, headerEvent.Source)
 
 			// Record header synced metric
 			m.recordSyncMetrics("header_synced")
@@ -81,7 +94,9 @@ func (m *Manager) SyncLoop(ctx context.Context, errCh chan<- error) {
 				Int("txs", len(data.Txs)).
 				Msg("data retrieved")
 
-			if m.dataCache.IsSeen(dataHash) {
+			// recovered data is only fetched for a header still waiting for it, even if a copy was
+			// seen before and dropped, e.g. because conflicting data was received for the same height
+			if dataEvent.Source != SyncSourceDARecovery && m.dataCache.IsSeen(dataHash) {
 				m.logger.Debug().Str("data_hash", dataHash).Msg("data already seen")
 				continue
 			}
@@ -94,7 +109,15 @@ func (m *Manager) SyncLoop(ctx context.Context, errCh chan<- error) {
 				m.logger.Debug().Uint64("height", dataHeight).Str("data_hash", dataHash).Msg("data already seen")
 				continue
 			}
+			// data not committed to by the header of its height never replaces the cached data
+			if header := m.headerCache.GetItem(dataHeight); header != nil {
+				if err := types.Validate(header, data); err != nil {
+					m.quarantineData(ctx, header, data, daHeight, err)
+					continue
+				}
+			}
 			m.dataCache.SetItem(dataHeight, data)
+			m.syncSources.setData(dataHeight, dataEvent.Source)
 
 			// Record data synced metric
 			m.recordSyncMetrics("data_synced")
@@ -122,12 +145,23 @@ func (m *Manager) SyncLoop(ctx context.Context, errCh chan<- error) {
 // For every block, to be able to apply block at height h, we need to have its Commit. It is contained in block at height h+1.
 // If commit for block h+1 is available, we proceed with sync process, and remove synced block from sync cache.
 func (m *Manager) trySyncNextBlock(ctx context.Context, daHeight uint64) error {
+	m.execMu.RLock()
+	defer m.execMu.RUnlock()
+
 	for {
 		select {
 		case <-ctx.Done():
 			return ctx.Err()
 		default:
 		}
+		// blocks stay in the caches until the store is back below its disk quota
+		if m.diskQuota.readOnly.Load() {
+			return nil
+		}
+		// a shadow replica stops at the last block it agrees on with the sequencer
+		if m.divergence.Load() != nil {
+			return nil
+		}
 		currentHeight, err := m.store.Height(ctx)
 		if err != nil {
 			return err
@@ -143,13 +177,29 @@ func (m *Manager) trySyncNextBlock(ctx context.Context, daHeight uint64) error {
 			return nil
 		}
 
+		// data received before its header is only checked against the header commitment now;
+		// the header then waits for the correct data, recovered from DA if needed
+		if err := types.Validate(h, d); err != nil {
+			m.quarantineData(ctx, h, d, 0, err)
+			m.dataCache.DeleteItem(currentHeight + 1)
+			return nil
+		}
+
 		hHeight := h.Height()
 		m.logger.Info().Uint64("height", hHeight).Msg("syncing header and data")
 
 		// set the custom verifier to ensure proper signature validation
 		h.SetCustomVerifier(m.signaturePayloadProvider)
 
+		// the header commits to the state root after the previous block, which a shadow replica
+		// cross-checks before applying the block, to report the divergence rather than fail
+		if m.config.Node.ShadowReplica && m.checkDivergence(ctx, h) {
+			return nil
+		}
+
+		m.stall.startExec(time.Now())
 		newState, err := m.applyBlock(ctx, h.Header, d)
+		m.stall.endExec()
 		if err != nil {
 			return fmt.Errorf("failed to apply block: %w", err)
 		}
@@ -160,18 +210,11 @@ func (m *Manager) trySyncNextBlock(ctx context.Context, daHeight uint64) error {
 			return fmt.Errorf("failed to validate block: %w", err)
 		}
 
-		if err = m.updateState(ctx, newState); err != nil {
-			return fmt.Errorf("failed to save updated state: %w", err)
-		}
-
-		if err = m.store.SaveBlockData(ctx, h, d, &h.Signature); err != nil {
-			return fmt.Errorf("failed to save block: %w", err)
-		}
-
-		// Height gets updated
-		if err = m.store.SetHeight(ctx, hHeight); err != nil {
+		m.stall.startIO(time.Now())
+		if err = m.saveSyncedBlock(ctx, h, d, newState); err != nil {
 			return err
 		}
+		m.stall.progress(time.Now(), m.syncPeerStats())
 
 		// Record sync metrics
 		m.recordSyncMetrics("block_applied")
@@ -189,6 +232,18 @@ func (m *Manager) trySyncNextBlock(ctx context.Context, daHeight uint64) error {
 	}
 }
 
+// saveSyncedBlock persists a synced block and the state after it.
+func (m *Manager) saveSyncedBlock(ctx context.Context, h *types.SignedHeader, d *types.Data, newState types.State) error {
+	defer m.stall.endIO()
+
+	return m.commitBlock(ctx, h, d, &h.Signature, newState, func(batch storepkg.Batch) error {
+		if err := m.saveSyncSources(ctx, batch, h.Height()); err != nil {
+			return fmt.Errorf("failed to save sync sources: %w", err)
+		}
+		return m.saveBlockMetadata(ctx, batch, h.Height())
+	})
+}
+
 func (m *Manager) handleEmptyDataHash(ctx context.Context, header *types.Header) {
 	headerHeight := header.Height()
 	if bytes.Equal(header.DataHash, dataHashForEmptyTxs) {
@@ -212,7 +267,34 @@ func (m *Manager) handleEmptyDataHash(ctx context.Context, header *types.Header)
 			Metadata: metadata,
 		}
 		m.dataCache.SetItem(headerHeight, d)
+		m.syncSources.setData(headerHeight, SyncSourceEmpty)
+	}
+}
+
+// quarantineData rejects data that does not match the header of its height, as published by a faulty
+// or byzantine proposer. The data is marked as seen so that it is not processed again, without
+// blocking the processing of the correct data for the same height. daHeight is 0 if unknown.
+func (m *Manager) quarantineData(ctx context.Context, header *types.SignedHeader, data *types.Data, daHeight uint64, reason error) {
+	dataHash := data.DACommitment().String()
+	m.dataCache.SetSeen(dataHash)
+	m.metrics.DataQuarantined.Add(1)
+	m.logger.Warn().
+		Uint64("height", header.Height()).
+		Uint64("daHeight", daHeight).
+		Str("dataHash", dataHash).
+		Str("expectedDataHash", header.DataHash.String()).
+		Err(reason).
+		Msg("quarantined data not matching header commitment")
+
+	attrs := map[string]string{
+		"height":             strconv.FormatUint(header.Height(), 10),
+		"data_hash":          dataHash,
+		"expected_data_hash": header.DataHash.String(),
+	}
+	if daHeight > 0 {
+		attrs["da_height"] = strconv.FormatUint(daHeight, 10)
 	}
+	m.recordEvent(ctx, journal.EventDataQuarantined, reason.Error(), attrs)
 }
 
 func (m *Manager) sendNonBlockingSignalToHeaderStoreCh() {
@@ -231,16 +313,41 @@ func (m *Manager) sendNonBlockingSignalToDAIncluderCh() {
 	m.sendNonBlockingSignalWithMetrics(m.daIncluderCh, "da_includer")
 }
 
-// Updates the state stored in manager's store along the manager's lastState
-func (m *Manager) updateState(ctx context.Context, s types.State) error {
+func (m *Manager) sendNonBlockingSignalToSequencerFeesCh() {
+	m.sendNonBlockingSignalWithMetrics(m.sequencerFeesCh, "sequencer_fees")
+}
+
+// commitBlock saves the block, the store height and the new state, along with the writes of extra
+// if not nil, in a single store batch so that a crash cannot leave the block partially written.
+// After this call m.lastState is the new state.
+func (m *Manager) commitBlock(ctx context.Context, header *types.SignedHeader, data *types.Data, signature *types.Signature, s types.State, extra func(storepkg.Batch) error) error {
 	m.logger.Debug().Interface("newState", s).Msg("updating state")
-	m.lastStateMtx.Lock()
-	defer m.lastStateMtx.Unlock()
-	err := m.store.UpdateState(ctx, s)
+	batch, err := m.store.Batch(ctx)
 	if err != nil {
 		return err
 	}
+	if err := batch.SaveBlockData(ctx, header, data, signature); err != nil {
+		return fmt.Errorf("failed to save block: %w", err)
+	}
+	if err := batch.SetHeight(ctx, header.Height()); err != nil {
+		return err
+	}
+	if err := batch.UpdateState(ctx, s); err != nil {
+		return fmt.Errorf("failed to update state: %w", err)
+	}
+	if extra != nil {
+		if err := extra(batch); err != nil {
+			return err
+		}
+	}
+
+	m.lastStateMtx.Lock()
+	defer m.lastStateMtx.Unlock()
+	if err := batch.Commit(ctx); err != nil {
+		return fmt.Errorf("failed to commit block: %w", err)
+	}
 	m.lastState = s
 	m.metrics.Height.Set(float64(s.LastBlockHeight))
+	m.sendNonBlockingSignalToSequencerFeesCh()
 	return nil
 }
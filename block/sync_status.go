@@ -0,0 +1,18 @@
+package block
+
+import (
+	"github.com/evstack/ev-node/pkg/rpc/server"
+)
+
+// SyncStatus returns the sync progress of the node, served by the GetSyncStatus RPC.
+func (m *Manager) SyncStatus() server.SyncStatus {
+	status := server.SyncStatus{
+		DAHeight:         m.daHeight.Load(),
+		DAIncludedHeight: m.GetDAIncludedHeight(),
+	}
+	if m.headerStore != nil {
+		status.NetworkHeight = m.headerStore.Height()
+	}
+	status.HeadersBySource, status.DataBySource = m.syncSources.applied()
+	return status
+}
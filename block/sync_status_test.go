@@ -0,0 +1,40 @@
+package block
+
+import (
+	"context"
+	"sync/atomic"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	storepkg "github.com/evstack/ev-node/pkg/store"
+)
+
+// TestSyncStatus verifies that the sync status reports the DA heights and counts the applied
+// heights by source.
+func TestSyncStatus(t *testing.T) {
+	ctx := context.Background()
+	kv, err := storepkg.NewDefaultInMemoryKVStore()
+	require.NoError(t, err)
+	daHeight := &atomic.Uint64{}
+	daHeight.Store(42)
+	m := &Manager{store: storepkg.New(kv), daHeight: daHeight}
+	m.daIncludedHeight.Store(3)
+
+	m.syncSources.setHeader(1, SyncSourceP2P)
+	m.syncSources.setData(1, SyncSourceEmpty)
+	m.syncSources.setHeader(2, SyncSourceP2P)
+	m.syncSources.setData(2, SyncSourceDA)
+	require.NoError(t, m.saveSyncSources(ctx, m.store, 1))
+	require.NoError(t, m.saveSyncSources(ctx, m.store, 2))
+	// heights without recorded sources are not counted
+	require.NoError(t, m.saveSyncSources(ctx, m.store, 3))
+
+	status := m.SyncStatus()
+	assert.Equal(t, uint64(42), status.DAHeight)
+	assert.Equal(t, uint64(3), status.DAIncludedHeight)
+	assert.Zero(t, status.NetworkHeight)
+	assert.Equal(t, map[string]uint64{"p2p": 2}, status.HeadersBySource)
+	assert.Equal(t, map[string]uint64{"empty": 1, "da": 1}, status.DataBySource)
+}
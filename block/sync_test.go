@@ -88,6 +88,15 @@ func setupManagerForSyncLoopTest(t *testing.T, initialState types.State) (
 	return m, mockStore, mockExec, ctx, cancel, headerInCh, dataInCh, heightPtr
 }
 
+// expectStoreBatch makes mockStore return a mock batch, which expects to be committed, for the
+// blocks to be saved.
+func expectStoreBatch(t *testing.T, mockStore *mocks.MockStore) *mocks.MockBatch {
+	mockBatch := mocks.NewMockBatch(t)
+	mockStore.On("Batch", mock.Anything).Return(mockBatch, nil)
+	mockBatch.On("Commit", mock.Anything).Return(nil)
+	return mockBatch
+}
+
 // TestSyncLoop_ProcessSingleBlock_HeaderFirst verifies that the sync loop processes a single block when the header arrives before the data.
 // 1. Header for H+1 arrives.
 // 2. Data for H+1 arrives.
@@ -128,11 +137,12 @@ func TestSyncLoop_ProcessSingleBlock_HeaderFirst(t *testing.T) {
 	}
 	mockExec.On("ExecuteTxs", mock.Anything, txs, newHeight, header.Time(), initialState.AppHash).
 		Return(expectedNewAppHash, uint64(100), nil).Once()
-	mockStore.On("SaveBlockData", mock.Anything, header, data, &header.Signature).Return(nil).Once()
+	mockBatch := expectStoreBatch(t, mockStore)
+	mockBatch.On("SaveBlockData", mock.Anything, header, data, &header.Signature).Return(nil).Once()
 
-	mockStore.On("UpdateState", mock.Anything, expectedNewState).Return(nil).Run(func(args mock.Arguments) { close(syncChan) }).Once()
+	mockBatch.On("UpdateState", mock.Anything, expectedNewState).Return(nil).Run(func(args mock.Arguments) { close(syncChan) }).Once()
 
-	mockStore.On("SetHeight", mock.Anything, newHeight).Return(nil).Once()
+	mockBatch.On("SetHeight", mock.Anything, newHeight).Return(nil).Once()
 
 	ctx, loopCancel := context.WithTimeout(context.Background(), 1*time.Second)
 	defer loopCancel()
@@ -215,9 +225,10 @@ func TestSyncLoop_ProcessSingleBlock_DataFirst(t *testing.T) {
 
 	mockExec.On("ExecuteTxs", mock.Anything, txs, newHeight, header.Time(), initialState.AppHash).
 		Return(expectedNewAppHash, uint64(100), nil).Once()
-	mockStore.On("SaveBlockData", mock.Anything, header, data, &header.Signature).Return(nil).Once()
-	mockStore.On("UpdateState", mock.Anything, expectedNewState).Return(nil).Run(func(args mock.Arguments) { close(syncChan) }).Once()
-	mockStore.On("SetHeight", mock.Anything, newHeight).Return(nil).Once()
+	mockBatch := expectStoreBatch(t, mockStore)
+	mockBatch.On("SaveBlockData", mock.Anything, header, data, &header.Signature).Return(nil).Once()
+	mockBatch.On("UpdateState", mock.Anything, expectedNewState).Return(nil).Run(func(args mock.Arguments) { close(syncChan) }).Once()
+	mockBatch.On("SetHeight", mock.Anything, newHeight).Return(nil).Once()
 
 	ctx, loopCancel := context.WithTimeout(context.Background(), 1*time.Second)
 	defer loopCancel()
@@ -320,9 +331,10 @@ func TestSyncLoop_ProcessMultipleBlocks_Sequentially(t *testing.T) {
 
 	mockExec.On("ExecuteTxs", mock.Anything, txsH1, heightH1, headerH1.Time(), initialState.AppHash).
 		Return(expectedNewAppHashH1, uint64(100), nil).Once()
-	mockStore.On("SaveBlockData", mock.Anything, headerH1, dataH1, &headerH1.Signature).Return(nil).Once()
-	mockStore.On("UpdateState", mock.Anything, expectedNewStateH1).Return(nil).Run(func(args mock.Arguments) { close(syncChanH1) }).Once()
-	mockStore.On("SetHeight", mock.Anything, heightH1).Return(nil).
+	mockBatch := expectStoreBatch(t, mockStore)
+	mockBatch.On("SaveBlockData", mock.Anything, headerH1, dataH1, &headerH1.Signature).Return(nil).Once()
+	mockBatch.On("UpdateState", mock.Anything, expectedNewStateH1).Return(nil).Run(func(args mock.Arguments) { close(syncChanH1) }).Once()
+	mockBatch.On("SetHeight", mock.Anything, heightH1).Return(nil).
 		Run(func(args mock.Arguments) {
 			newHeight := args.Get(1).(uint64)
 			*heightPtr = newHeight // Update the mocked height
@@ -333,9 +345,9 @@ func TestSyncLoop_ProcessMultipleBlocks_Sequentially(t *testing.T) {
 	// --- Mock Expectations for H+2 ---
 	mockExec.On("ExecuteTxs", mock.Anything, txsH2, heightH2, headerH2.Time(), expectedNewAppHashH1).
 		Return(expectedNewAppHashH2, uint64(100), nil).Once()
-	mockStore.On("SaveBlockData", mock.Anything, headerH2, dataH2, &headerH2.Signature).Return(nil).Once()
-	mockStore.On("UpdateState", mock.Anything, expectedNewStateH2).Return(nil).Run(func(args mock.Arguments) { close(syncChanH2) }).Once()
-	mockStore.On("SetHeight", mock.Anything, heightH2).Return(nil).
+	mockBatch.On("SaveBlockData", mock.Anything, headerH2, dataH2, &headerH2.Signature).Return(nil).Once()
+	mockBatch.On("UpdateState", mock.Anything, expectedNewStateH2).Return(nil).Run(func(args mock.Arguments) { close(syncChanH2) }).Once()
+	mockBatch.On("SetHeight", mock.Anything, heightH2).Return(nil).
 		Run(func(args mock.Arguments) {
 			newHeight := args.Get(1).(uint64)
 			*heightPtr = newHeight // Update the mocked height
@@ -459,11 +471,12 @@ func TestSyncLoop_ProcessBlocks_OutOfOrderArrival(t *testing.T) {
 	mockExec.On("Validate", mock.Anything, &headerH1.Header, dataH1).Return(nil).Maybe()
 	mockExec.On("ExecuteTxs", mock.Anything, txsH1, heightH1, headerH1.Time(), initialState.AppHash).
 		Return(appHashH1, uint64(100), nil).Once()
-	mockStore.On("SaveBlockData", mock.Anything, headerH1, dataH1, &headerH1.Signature).Return(nil).Once()
-	mockStore.On("UpdateState", mock.Anything, expectedNewStateH1).Return(nil).
+	mockBatch := expectStoreBatch(t, mockStore)
+	mockBatch.On("SaveBlockData", mock.Anything, headerH1, dataH1, &headerH1.Signature).Return(nil).Once()
+	mockBatch.On("UpdateState", mock.Anything, expectedNewStateH1).Return(nil).
 		Run(func(args mock.Arguments) { close(syncChanH1) }).
 		Once()
-	mockStore.On("SetHeight", mock.Anything, heightH1).Return(nil).
+	mockBatch.On("SetHeight", mock.Anything, heightH1).Return(nil).
 		Run(func(args mock.Arguments) {
 			newHeight := args.Get(1).(uint64)
 			*heightPtr = newHeight // Update the mocked height
@@ -476,15 +489,15 @@ func TestSyncLoop_ProcessBlocks_OutOfOrderArrival(t *testing.T) {
 	mockExec.On("Validate", mock.Anything, &headerH2.Header, dataH2).Return(nil).Maybe()
 	mockExec.On("ExecuteTxs", mock.Anything, txsH2, heightH2, headerH2.Time(), expectedNewStateH1.AppHash).
 		Return(appHashH2, uint64(1), nil).Once()
-	mockStore.On("SaveBlockData", mock.Anything, headerH2, dataH2, &headerH2.Signature).Return(nil).Once()
-	mockStore.On("SetHeight", mock.Anything, heightH2).Return(nil).
+	mockBatch.On("SaveBlockData", mock.Anything, headerH2, dataH2, &headerH2.Signature).Return(nil).Once()
+	mockBatch.On("SetHeight", mock.Anything, heightH2).Return(nil).
 		Run(func(args mock.Arguments) {
 			newHeight := args.Get(1).(uint64)
 			*heightPtr = newHeight // Update the mocked height
 			t.Logf("Mock SetHeight called for H+2, updated mock height to %d", newHeight)
 		}).
 		Once()
-	mockStore.On("UpdateState", mock.Anything, expectedStateH2).Return(nil).
+	mockBatch.On("UpdateState", mock.Anything, expectedStateH2).Return(nil).
 		Run(func(args mock.Arguments) { close(syncChanH2) }).
 		Once()
 
@@ -591,9 +604,10 @@ func TestSyncLoop_IgnoreDuplicateEvents(t *testing.T) {
 	// --- Mock Expectations (Expect processing exactly ONCE) ---
 	mockExec.On("ExecuteTxs", mock.Anything, txsH1, heightH1, headerH1.Time(), initialState.AppHash).
 		Return(appHashH1, uint64(1), nil).Once()
-	mockStore.On("SaveBlockData", mock.Anything, headerH1, dataH1, &headerH1.Signature).Return(nil).Once()
-	mockStore.On("SetHeight", mock.Anything, heightH1).Return(nil).Once()
-	mockStore.On("UpdateState", mock.Anything, expectedStateH1).Return(nil).
+	mockBatch := expectStoreBatch(t, mockStore)
+	mockBatch.On("SaveBlockData", mock.Anything, headerH1, dataH1, &headerH1.Signature).Return(nil).Once()
+	mockBatch.On("SetHeight", mock.Anything, heightH1).Return(nil).Once()
+	mockBatch.On("UpdateState", mock.Anything, expectedStateH1).Return(nil).
 		Run(func(args mock.Arguments) { close(syncChanH1) }).
 		Once()
 
@@ -963,3 +977,77 @@ func TestSyncLoop_MultipleHeadersArriveFirst_ThenData(t *testing.T) {
 		assert.Nil(m.dataCache.GetItem(blockHeights[i]), "Data cache should be cleared for H+%d", i+1)
 	}
 }
+
+// TestSyncLoop_QuarantinesDataNotMatchingHeader verifies that data not matching the data commitment of its header is
+// quarantined without blocking the block once the correct data arrives.
+// 1. Byzantine data for H+1 arrives before the header and is cached.
+// 2. Header for H+1 arrives: the cached data is quarantined instead of being applied.
+// 3. Other byzantine data for H+1 arrives and is quarantined right away.
+// 4. Correct data for H+1 arrives and block H+1 is applied.
+func TestSyncLoop_QuarantinesDataNotMatchingHeader(t *testing.T) {
+	require := require.New(t)
+
+	initialHeight := uint64(10)
+	initialState := types.State{
+		LastBlockHeight: initialHeight,
+		AppHash:         []byte("initial_app_hash"),
+		ChainID:         "syncLoopTest",
+		DAHeight:        5,
+	}
+	newHeight := initialHeight + 1
+	daHeight := initialState.DAHeight
+
+	m, mockStore, mockExec, _, cancel, headerInCh, dataInCh, _ := setupManagerForSyncLoopTest(t, initialState)
+	defer cancel()
+
+	header, data, _ := types.GenerateRandomBlockCustomWithAppHash(&types.BlockConfig{Height: newHeight, NTxs: 2}, initialState.ChainID, initialState.AppHash)
+	byzantine := func(tx string) *types.Data {
+		return &types.Data{Metadata: data.Metadata, Txs: types.Txs{types.Tx(tx)}}
+	}
+	firstByzantine, secondByzantine := byzantine("forged1"), byzantine("forged2")
+
+	expectedNewAppHash := []byte("new_app_hash")
+	expectedNewState, err := initialState.NextState(header.Header, expectedNewAppHash)
+	require.NoError(err)
+
+	syncChan := make(chan struct{})
+	var txs [][]byte
+	for _, tx := range data.Txs {
+		txs = append(txs, tx)
+	}
+	mockExec.On("ExecuteTxs", mock.Anything, txs, newHeight, header.Time(), initialState.AppHash).
+		Return(expectedNewAppHash, uint64(100), nil).Once()
+	mockBatch := expectStoreBatch(t, mockStore)
+	mockBatch.On("SaveBlockData", mock.Anything, header, data, &header.Signature).Return(nil).Once()
+	mockBatch.On("UpdateState", mock.Anything, expectedNewState).Return(nil).Run(func(args mock.Arguments) { close(syncChan) }).Once()
+	mockBatch.On("SetHeight", mock.Anything, newHeight).Return(nil).Once()
+
+	ctx, loopCancel := context.WithTimeout(context.Background(), 1*time.Second)
+	defer loopCancel()
+
+	var wg sync.WaitGroup
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		m.SyncLoop(ctx, make(chan<- error))
+	}()
+
+	dataInCh <- NewDataEvent{Data: firstByzantine, DAHeight: daHeight}
+	headerInCh <- NewHeaderEvent{Header: header, DAHeight: daHeight}
+	dataInCh <- NewDataEvent{Data: secondByzantine, DAHeight: daHeight}
+	dataInCh <- NewDataEvent{Data: data, DAHeight: daHeight}
+
+	select {
+	case <-syncChan:
+	case <-time.After(2 * time.Second):
+		t.Fatal("Timeout waiting for sync to complete")
+	}
+	loopCancel()
+	wg.Wait()
+
+	mockStore.AssertExpectations(t)
+	mockExec.AssertExpectations(t)
+	require.Equal(newHeight, m.GetLastState().LastBlockHeight)
+	require.True(m.dataCache.IsSeen(firstByzantine.DACommitment().String()))
+	require.True(m.dataCache.IsSeen(secondByzantine.DACommitment().String()))
+}
@@ -0,0 +1,239 @@
+package block
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
+
+	ds "github.com/ipfs/go-datastore"
+	"google.golang.org/protobuf/proto"
+
+	coreexecutor "github.com/evstack/ev-node/core/execution"
+	"github.com/evstack/ev-node/pkg/journal"
+	storepkg "github.com/evstack/ev-node/pkg/store"
+	pb "github.com/evstack/ev-node/types/pb/evnode/v1"
+)
+
+// scheduleSystemCalls schedules the system calls requested by an executed block, if the executor
+// reports them, saved when the block is committed. Unlike the other artifacts of a block, system
+// calls change the behavior of the node, so failing to get them fails the block. Invalid calls are
+// skipped, every node skipping the same ones. execMu must be held for reading.
+func (m *Manager) scheduleSystemCalls(ctx context.Context, height uint64) error {
+	provider, ok := m.exec.(coreexecutor.SystemCallProvider)
+	if !ok {
+		return nil
+	}
+
+	calls, err := provider.GetSystemCalls(ctx, height)
+	if err != nil {
+		return fmt.Errorf("failed to get system calls of block %d: %w", height, err)
+	}
+	for _, call := range calls {
+		if err := m.validateSystemCall(height, call); err != nil {
+			m.logger.Warn().Err(err).Uint64("height", height).Str("type", call.Type).Msg("ignoring invalid system call")
+			continue
+		}
+
+		// calls of the block scheduled at the same height are not committed yet
+		scheduled, err := m.getSystemCalls(ctx, call.ActivationHeight)
+		if bz, ok := m.blockMetadata.get(height, systemCallsKey(call.ActivationHeight)); ok {
+			scheduled, err = decodeSystemCalls(call.ActivationHeight, bz)
+		}
+		if err != nil {
+			return err
+		}
+		record := &pb.SystemCall{
+			Type:             call.Type,
+			ActivationHeight: call.ActivationHeight,
+			Value:            call.Value,
+			RequestedHeight:  height,
+		}
+		// a block applied again, e.g. after a crash, requests the same calls
+		if containsSystemCall(scheduled.Calls, record) {
+			continue
+		}
+		scheduled.Calls = append(scheduled.Calls, record)
+		if err := m.setSystemCalls(ctx, blockMetadataWriter{&m.blockMetadata, height}, call.ActivationHeight, scheduled); err != nil {
+			return err
+		}
+
+		m.logger.Info().
+			Uint64("height", height).
+			Str("type", call.Type).
+			Str("value", call.Value).
+			Uint64("activation_height", call.ActivationHeight).
+			Msg("scheduled system call")
+		m.recordEvent(ctx, journal.EventSystemCallScheduled, fmt.Sprintf("%s scheduled at height %d", call.Type, call.ActivationHeight), map[string]string{
+			"type":              call.Type,
+			"value":             call.Value,
+			"height":            strconv.FormatUint(height, 10),
+			"activation_height": strconv.FormatUint(call.ActivationHeight, 10),
+		})
+	}
+	return nil
+}
+
+// validateSystemCall checks a system call requested by the block at the given height.
+func (m *Manager) validateSystemCall(height uint64, call coreexecutor.SystemCall) error {
+	minDelay := uint64(1)
+	if m.genesis.SystemCalls != nil && m.genesis.SystemCalls.MinDelay > minDelay {
+		minDelay = m.genesis.SystemCalls.MinDelay
+	}
+	if call.ActivationHeight < height+minDelay {
+		return fmt.Errorf("activation height %d is less than %d blocks after height %d", call.ActivationHeight, minDelay, height)
+	}
+
+	switch call.Type {
+	case coreexecutor.SystemCallHalt:
+		return nil
+	case coreexecutor.SystemCallSetBlockTime:
+		blockTime, err := time.ParseDuration(call.Value)
+		if err != nil {
+			return fmt.Errorf("invalid block time: %w", err)
+		}
+		if blockTime <= 0 {
+			return fmt.Errorf("block time must be positive, got %s", blockTime)
+		}
+		return nil
+	default:
+		return fmt.Errorf("unknown system call type %q", call.Type)
+	}
+}
+
+// applySystemCalls applies the system calls scheduled at the given height, before the block at
+// this height is executed. It returns ErrHaltHeight if a halt is scheduled at the height; the
+// halt is then removed, so that the node proceeds when restarted. execMu must be held for reading.
+func (m *Manager) applySystemCalls(ctx context.Context, height uint64) error {
+	// system calls are only scheduled by executors reporting them
+	if _, ok := m.exec.(coreexecutor.SystemCallProvider); !ok {
+		return nil
+	}
+
+	scheduled, err := m.getSystemCalls(ctx, height)
+	if err != nil {
+		return err
+	}
+
+	var halt *pb.SystemCall
+	remaining := make([]*pb.SystemCall, 0, len(scheduled.Calls))
+	for _, call := range scheduled.Calls {
+		switch call.Type {
+		case coreexecutor.SystemCallHalt:
+			halt = call
+			continue
+		case coreexecutor.SystemCallSetBlockTime:
+			// validated when scheduled
+			blockTime, _ := time.ParseDuration(call.Value)
+			m.blockMetadata.set(height, storepkg.SystemBlockTimeKey, []byte(call.Value))
+			m.blockTimeOverride.Store(int64(blockTime))
+		}
+		remaining = append(remaining, call)
+		m.logger.Info().Uint64("height", height).Str("type", call.Type).Str("value", call.Value).Msg("applied system call")
+		m.recordSystemCallApplied(ctx, height, call)
+	}
+	if halt == nil {
+		return nil
+	}
+
+	// the block is not committed, so the halt is removed from the store right away
+	if err := m.setSystemCalls(ctx, m.store, height, &pb.SystemCalls{Calls: remaining}); err != nil {
+		return err
+	}
+	m.logger.Warn().Uint64("height", height).Str("upgrade", halt.Value).Msg("halting at the activation height of a halt system call")
+	m.recordSystemCallApplied(ctx, height, halt)
+	return fmt.Errorf("%w: %d (requested at height %d)", ErrHaltHeight, height, halt.RequestedHeight)
+}
+
+func (m *Manager) recordSystemCallApplied(ctx context.Context, height uint64, call *pb.SystemCall) {
+	m.recordEvent(ctx, journal.EventSystemCallApplied, fmt.Sprintf("%s applied at height %d", call.Type, height), map[string]string{
+		"type":             call.Type,
+		"value":            call.Value,
+		"height":           strconv.FormatUint(height, 10),
+		"requested_height": strconv.FormatUint(call.RequestedHeight, 10),
+	})
+}
+
+// loadSystemParams loads the parameters set by the system calls applied before the node started.
+func (m *Manager) loadSystemParams(ctx context.Context) error {
+	value, err := m.store.GetMetadata(ctx, storepkg.SystemBlockTimeKey)
+	if errors.Is(err, ds.ErrNotFound) {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("failed to load block time: %w", err)
+	}
+	blockTime, err := time.ParseDuration(string(value))
+	if err != nil {
+		return fmt.Errorf("failed to parse block time: %w", err)
+	}
+	m.blockTimeOverride.Store(int64(blockTime))
+	return nil
+}
+
+// blockTime returns the block time of the aggregator: the base block time, autoscaled to the load
+// if enabled.
+func (m *Manager) blockTime() time.Duration {
+	if m.autoscaler != nil {
+		return m.autoscaler.blockTime(m.baseBlockTime())
+	}
+	if b := m.genesis.BlockTimeBounds; b != nil {
+		return min(max(m.baseBlockTime(), b.Min.Duration), b.Max.Duration)
+	}
+	return m.baseBlockTime()
+}
+
+// baseBlockTime returns the block time set by the last applied system call, or the configured one.
+func (m *Manager) baseBlockTime() time.Duration {
+	if blockTime := m.blockTimeOverride.Load(); blockTime > 0 {
+		return time.Duration(blockTime)
+	}
+	return m.config.Node.BlockTime.Duration
+}
+
+// getSystemCalls returns the system calls scheduled at the given height.
+func (m *Manager) getSystemCalls(ctx context.Context, height uint64) (*pb.SystemCalls, error) {
+	bz, err := m.store.GetMetadata(ctx, systemCallsKey(height))
+	if errors.Is(err, ds.ErrNotFound) {
+		return &pb.SystemCalls{}, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get system calls at height %d: %w", height, err)
+	}
+	return decodeSystemCalls(height, bz)
+}
+
+func decodeSystemCalls(height uint64, bz []byte) (*pb.SystemCalls, error) {
+	var calls pb.SystemCalls
+	if err := proto.Unmarshal(bz, &calls); err != nil {
+		return nil, fmt.Errorf("failed to decode system calls at height %d: %w", height, err)
+	}
+	return &calls, nil
+}
+
+// setSystemCalls saves the system calls scheduled at the given height to the store, or to the
+// metadata of a block.
+func (m *Manager) setSystemCalls(ctx context.Context, w metadataWriter, height uint64, calls *pb.SystemCalls) error {
+	bz, err := proto.Marshal(calls)
+	if err != nil {
+		return fmt.Errorf("failed to marshal system calls: %w", err)
+	}
+	if err := w.SetMetadata(ctx, systemCallsKey(height), bz); err != nil {
+		return fmt.Errorf("failed to save system calls at height %d: %w", height, err)
+	}
+	return nil
+}
+
+func systemCallsKey(height uint64) string {
+	return fmt.Sprintf("%s/%d", storepkg.SystemCallsKey, height)
+}
+
+func containsSystemCall(calls []*pb.SystemCall, call *pb.SystemCall) bool {
+	for _, c := range calls {
+		if proto.Equal(c, call) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,104 @@
+package block
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/require"
+
+	coreexecutor "github.com/evstack/ev-node/core/execution"
+	"github.com/evstack/ev-node/pkg/config"
+	"github.com/evstack/ev-node/pkg/genesis"
+	storepkg "github.com/evstack/ev-node/pkg/store"
+	"github.com/evstack/ev-node/test/mocks"
+)
+
+// systemCallExecutor is an executor requesting system calls by height.
+type systemCallExecutor struct {
+	*mocks.MockExecutor
+	calls map[uint64][]coreexecutor.SystemCall
+	err   error
+}
+
+func (e *systemCallExecutor) GetSystemCalls(ctx context.Context, blockHeight uint64) ([]coreexecutor.SystemCall, error) {
+	return e.calls[blockHeight], e.err
+}
+
+func TestSystemCalls(t *testing.T) {
+	ctx := context.Background()
+	newManager := func(exec coreexecutor.Executor, store storepkg.Store) *Manager {
+		cfg := config.DefaultConfig
+		cfg.Node.BlockTime.Duration = time.Second
+		return &Manager{
+			store:   store,
+			exec:    exec,
+			config:  cfg,
+			genesis: genesis.Genesis{SystemCalls: &genesis.SystemCalls{MinDelay: 2}},
+			logger:  zerolog.Nop(),
+		}
+	}
+	newStore := func() storepkg.Store {
+		kv, err := storepkg.NewDefaultInMemoryKVStore()
+		require.NoError(t, err)
+		return storepkg.New(kv)
+	}
+
+	t.Run("calls are applied at their activation height", func(t *testing.T) {
+		store := newStore()
+		exec := &systemCallExecutor{calls: map[uint64][]coreexecutor.SystemCall{
+			1: {
+				{Type: coreexecutor.SystemCallSetBlockTime, ActivationHeight: 3, Value: "250ms"},
+				{Type: coreexecutor.SystemCallHalt, ActivationHeight: 4, Value: "v2"},
+				// below the minimum delay
+				{Type: coreexecutor.SystemCallHalt, ActivationHeight: 2},
+				{Type: "unknown", ActivationHeight: 5},
+				{Type: coreexecutor.SystemCallSetBlockTime, ActivationHeight: 5, Value: "-1s"},
+			},
+		}}
+		m := newManager(exec, store)
+		require.NoError(t, m.scheduleSystemCalls(ctx, 1))
+		// scheduling the calls of a block applied again is a no-op
+		require.NoError(t, m.scheduleSystemCalls(ctx, 1))
+		require.NoError(t, m.saveBlockMetadata(ctx, m.store, 1))
+
+		for _, height := range []uint64{2, 5} {
+			scheduled, err := m.getSystemCalls(ctx, height)
+			require.NoError(t, err)
+			require.Empty(t, scheduled.Calls)
+		}
+		scheduled, err := m.getSystemCalls(ctx, 3)
+		require.NoError(t, err)
+		require.Len(t, scheduled.Calls, 1)
+		require.Equal(t, uint64(1), scheduled.Calls[0].RequestedHeight)
+
+		require.NoError(t, m.applySystemCalls(ctx, 2))
+		require.Equal(t, time.Second, m.blockTime())
+		require.NoError(t, m.applySystemCalls(ctx, 3))
+		require.Equal(t, 250*time.Millisecond, m.blockTime())
+		require.NoError(t, m.saveBlockMetadata(ctx, m.store, 3))
+
+		require.ErrorIs(t, m.applySystemCalls(ctx, 4), ErrHaltHeight)
+		// the node proceeds when restarted
+		require.NoError(t, m.applySystemCalls(ctx, 4))
+
+		// the block time is kept across restarts
+		restarted := newManager(exec, store)
+		require.NoError(t, restarted.loadSystemParams(ctx))
+		require.Equal(t, 250*time.Millisecond, restarted.blockTime())
+	})
+
+	t.Run("executor error fails the block", func(t *testing.T) {
+		m := newManager(&systemCallExecutor{err: errors.New("boom")}, newStore())
+		require.Error(t, m.scheduleSystemCalls(ctx, 1))
+	})
+
+	t.Run("executor without system calls", func(t *testing.T) {
+		m := newManager(&mocks.MockExecutor{}, newStore())
+		require.NoError(t, m.scheduleSystemCalls(ctx, 1))
+		require.NoError(t, m.applySystemCalls(ctx, 2))
+		require.Equal(t, time.Second, m.blockTime())
+	})
+}
@@ -0,0 +1,48 @@
+package block
+
+import (
+	"context"
+	"fmt"
+
+	"google.golang.org/protobuf/proto"
+
+	coreexecutor "github.com/evstack/ev-node/core/execution"
+	storepkg "github.com/evstack/ev-node/pkg/store"
+	pb "github.com/evstack/ev-node/types/pb/evnode/v1"
+)
+
+// saveTxResults records the execution results of the transactions of an executed block if the
+// executor provides them, saved when the block is committed. The results are an optional
+// artifact, so failures are logged and do not stop block processing. execMu must be held for
+// reading.
+func (m *Manager) saveTxResults(ctx context.Context, height uint64, txCount int) {
+	provider, ok := m.exec.(coreexecutor.TxResultProvider)
+	if !ok {
+		return
+	}
+
+	results, err := provider.GetTxResults(ctx, height)
+	if err != nil {
+		m.logger.Error().Err(err).Uint64("height", height).Msg("failed to get transaction results from executor")
+		return
+	}
+	if len(results) != txCount {
+		m.logger.Error().Uint64("height", height).Int("results", len(results)).Int("txs", txCount).Msg("executor returned a transaction result count not matching the block")
+		return
+	}
+
+	msg := &pb.TxResults{
+		Height:  height,
+		Results: make([]*pb.TxResult, len(results)),
+	}
+	for i, r := range results {
+		msg.Results[i] = &pb.TxResult{Success: r.Success, Logs: r.Logs}
+	}
+	bz, err := proto.Marshal(msg)
+	if err != nil {
+		m.logger.Error().Err(err).Uint64("height", height).Msg("failed to marshal transaction results")
+		return
+	}
+
+	m.blockMetadata.set(height, fmt.Sprintf("%s/%d", storepkg.TxResultsKey, height), bz)
+}
@@ -0,0 +1,84 @@
+package block
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+
+	coreexecutor "github.com/evstack/ev-node/core/execution"
+	storepkg "github.com/evstack/ev-node/pkg/store"
+	"github.com/evstack/ev-node/test/mocks"
+	pb "github.com/evstack/ev-node/types/pb/evnode/v1"
+)
+
+// txResultExecutor is an executor that provides fixed transaction results.
+type txResultExecutor struct {
+	*mocks.MockExecutor
+	results []coreexecutor.TxResult
+	err     error
+}
+
+func (e *txResultExecutor) GetTxResults(ctx context.Context, blockHeight uint64) ([]coreexecutor.TxResult, error) {
+	return e.results, e.err
+}
+
+func TestSaveTxResults(t *testing.T) {
+	ctx := context.Background()
+	newManager := func(exec coreexecutor.Executor) *Manager {
+		kv, err := storepkg.NewDefaultInMemoryKVStore()
+		require.NoError(t, err)
+		return &Manager{store: storepkg.New(kv), exec: exec, logger: zerolog.Nop()}
+	}
+	resultsKey := fmt.Sprintf("%s/%d", storepkg.TxResultsKey, 7)
+
+	t.Run("results are stored", func(t *testing.T) {
+		m := newManager(&txResultExecutor{results: []coreexecutor.TxResult{
+			{Success: true, Logs: []string{"log"}},
+			{Success: false},
+		}})
+		m.saveTxResults(ctx, 7, 2)
+		require.NoError(t, m.saveBlockMetadata(ctx, m.store, 7))
+
+		bz, err := m.store.GetMetadata(ctx, resultsKey)
+		require.NoError(t, err)
+		var results pb.TxResults
+		require.NoError(t, proto.Unmarshal(bz, &results))
+		require.Equal(t, uint64(7), results.Height)
+		require.Len(t, results.Results, 2)
+		require.True(t, results.Results[0].Success)
+		require.Equal(t, []string{"log"}, results.Results[0].Logs)
+		require.False(t, results.Results[1].Success)
+	})
+
+	t.Run("result count not matching the block is not stored", func(t *testing.T) {
+		m := newManager(&txResultExecutor{results: []coreexecutor.TxResult{{Success: true}}})
+		m.saveTxResults(ctx, 7, 2)
+		require.NoError(t, m.saveBlockMetadata(ctx, m.store, 7))
+
+		_, err := m.store.GetMetadata(ctx, resultsKey)
+		require.Error(t, err)
+	})
+
+	t.Run("executor error does not store results", func(t *testing.T) {
+		m := newManager(&txResultExecutor{err: errors.New("boom")})
+		m.saveTxResults(ctx, 7, 0)
+		require.NoError(t, m.saveBlockMetadata(ctx, m.store, 7))
+
+		_, err := m.store.GetMetadata(ctx, resultsKey)
+		require.Error(t, err)
+	})
+
+	t.Run("executor without transaction results", func(t *testing.T) {
+		m := newManager(mocks.NewMockExecutor(t))
+		m.saveTxResults(ctx, 7, 0)
+		require.NoError(t, m.saveBlockMetadata(ctx, m.store, 7))
+
+		_, err := m.store.GetMetadata(ctx, resultsKey)
+		require.Error(t, err)
+	})
+}
@@ -74,6 +74,31 @@ type ResultRetrieve struct {
 	Data [][]byte
 }
 
+// Subscriber is an optional interface implemented by DA layers which push the inclusion of new
+// blobs, e.g. with the blob subscriptions of celestia-node. The node then retrieves blobs as soon
+// as they are included, instead of polling the DA layer every DA block time.
+type Subscriber interface {
+	// Subscribe returns a channel receiving the height of every DA block including blobs in the
+	// namespace, from the next DA block on. The channel is closed when ctx is done or the
+	// subscription is interrupted. Heights may be skipped if the receiver falls behind.
+	Subscribe(ctx context.Context, namespace []byte) (<-chan uint64, error)
+}
+
+// NetworkInfo identifies the network of a DA layer and its limits.
+type NetworkInfo struct {
+	// NetworkID is the ID of the DA network, e.g. the chain ID of a Celestia network.
+	NetworkID string
+	// MaxBlobSize is the maximum size of a blob in bytes, 0 if unknown.
+	MaxBlobSize uint64
+}
+
+// NetworkInfoProvider is an optional interface implemented by DA layers which report the network
+// they are connected to, so that the node can expose the DA coordinates it uses to verifiers.
+type NetworkInfoProvider interface {
+	// NetworkInfo returns the network of the DA layer and its limits.
+	NetworkInfo(ctx context.Context) (NetworkInfo, error)
+}
+
 // StatusCode is a type for DA layer return status.
 // TODO: define an enum of different non-happy-path cases
 // that might need to be handled by Evolve independent of
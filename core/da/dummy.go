@@ -10,7 +10,11 @@ import (
 	"time"
 )
 
-var _ DA = (*DummyDA)(nil)
+var (
+	_ DA               = (*DummyDA)(nil)
+	_ Subscriber       = (*DummyDA)(nil)
+	_ AccountSequencer = (*DummyDA)(nil)
+)
 
 // DummyDA is a simple in-memory implementation of the DA interface for testing purposes.
 type DummyDA struct {
@@ -32,6 +36,17 @@ type DummyDA struct {
 
 	// Simulated failure support
 	submitShouldFail bool
+
+	// sequence is the sequence of the next submission, see AccountSequencer
+	sequence uint64
+
+	subscriptions map[*dummySubscription]struct{}
+}
+
+// dummySubscription is a subscription to the blobs of a namespace.
+type dummySubscription struct {
+	namespace []byte
+	ch        chan uint64
 }
 
 var ErrHeightFromFutureStr = fmt.Errorf("given height is from the future")
@@ -51,6 +66,7 @@ func NewDummyDA(maxBlobSize uint64, gasPrice float64, gasMultiplier float64, blo
 		blockTime:          blockTime,
 		stopCh:             make(chan struct{}),
 		currentHeight:      0,
+		subscriptions:      make(map[*dummySubscription]struct{}),
 	}
 }
 
@@ -64,6 +80,7 @@ func (d *DummyDA) StartHeightTicker() {
 			case <-ticker.C:
 				d.mu.Lock()
 				d.currentHeight++
+				d.notifySubscriptions(d.currentHeight)
 				d.mu.Unlock()
 			case <-d.stopCh:
 				return
@@ -77,6 +94,41 @@ func (d *DummyDA) StopHeightTicker() {
 	close(d.stopCh)
 }
 
+// Subscribe notifies the heights including blobs in the namespace once they are reached by the
+// height ticker.
+func (d *DummyDA) Subscribe(ctx context.Context, namespace []byte) (<-chan uint64, error) {
+	sub := &dummySubscription{namespace: namespace, ch: make(chan uint64, 16)}
+	d.mu.Lock()
+	d.subscriptions[sub] = struct{}{}
+	d.mu.Unlock()
+
+	go func() {
+		<-ctx.Done()
+		d.mu.Lock()
+		delete(d.subscriptions, sub)
+		close(sub.ch)
+		d.mu.Unlock()
+	}()
+	return sub.ch, nil
+}
+
+// notifySubscriptions notifies the subscriptions to the namespaces of the blobs at the given
+// height. It must be called with the lock held.
+func (d *DummyDA) notifySubscriptions(height uint64) {
+	for sub := range d.subscriptions {
+		for _, id := range d.blobsByHeight[height] {
+			if !bytes.Equal(d.namespaceByID[string(id)], sub.namespace) {
+				continue
+			}
+			select {
+			case sub.ch <- height:
+			default:
+			}
+			break
+		}
+	}
+}
+
 // GasPrice returns the gas price for the DA layer.
 func (d *DummyDA) GasPrice(ctx context.Context) (float64, error) {
 	return d.gasPrice, nil
@@ -87,6 +139,27 @@ func (d *DummyDA) GasMultiplier(ctx context.Context) (float64, error) {
 	return d.gasMultiplier, nil
 }
 
+// dummyNetworkID is the network ID reported by the dummy DA layer.
+const dummyNetworkID = "dummy"
+
+// NetworkInfo reports the dummy network and the maximum blob size of the DA layer.
+func (d *DummyDA) NetworkInfo(ctx context.Context) (NetworkInfo, error) {
+	return NetworkInfo{NetworkID: dummyNetworkID, MaxBlobSize: d.maxBlobSize}, nil
+}
+
+// AccountSequence returns the sequence of the next submission, which is incremented by every
+// successful submission.
+func (d *DummyDA) AccountSequence(ctx context.Context) (uint64, error) {
+	d.mu.RLock()
+	defer d.mu.RUnlock()
+	return d.sequence, nil
+}
+
+// ComponentName returns the name of the dummy DA layer reported by the node.
+func (d *DummyDA) ComponentName() string {
+	return "dummy"
+}
+
 // Get returns blobs for the given IDs.
 func (d *DummyDA) Get(ctx context.Context, ids []ID, namespace []byte) ([]Blob, error) {
 	d.mu.RLock()
@@ -186,6 +259,9 @@ func (d *DummyDA) SubmitWithOptions(ctx context.Context, blobs []Blob, gasPrice
 	if d.submitShouldFail {
 		return nil, errors.New("simulated DA layer failure")
 	}
+	if err := CheckSequence(options, d.sequence); err != nil {
+		return nil, err
+	}
 
 	height := d.currentHeight + 1
 	ids := make([]ID, 0, len(blobs))
@@ -234,6 +310,7 @@ func (d *DummyDA) SubmitWithOptions(ctx context.Context, blobs []Blob, gasPrice
 		d.blobsByHeight[height] = ids
 	}
 	d.timestampsByHeight[height] = time.Now()
+	d.sequence++
 
 	return ids, nil
 }
@@ -2,7 +2,9 @@ package da
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"strings"
 	"testing"
 	"time"
 )
@@ -135,3 +137,76 @@ func waitForAtLeastDAHeight(ctx context.Context, da *DummyDA, targetHeight uint6
 		}
 	}
 }
+
+func TestDummyDASubscribe(t *testing.T) {
+	dummyDA := NewDummyDA(1024, 0, 0, 50*time.Millisecond)
+	dummyDA.StartHeightTicker()
+	defer dummyDA.StopHeightTicker()
+	ctx, cancel := context.WithCancel(context.Background())
+
+	heights, err := dummyDA.Subscribe(ctx, []byte("ns1"))
+	if err != nil {
+		t.Fatalf("Subscribe failed: %v", err)
+	}
+	if _, err := dummyDA.Submit(ctx, []Blob{[]byte("other")}, 0, []byte("ns2")); err != nil {
+		t.Fatalf("Submit failed: %v", err)
+	}
+	ids, err := dummyDA.Submit(ctx, []Blob{[]byte("blob")}, 0, []byte("ns1"))
+	if err != nil {
+		t.Fatalf("Submit failed: %v", err)
+	}
+	expectedHeight, _, err := SplitID(ids[0])
+	if err != nil {
+		t.Fatalf("SplitID failed: %v", err)
+	}
+
+	select {
+	case height := <-heights:
+		if height != expectedHeight {
+			t.Errorf("Expected height %d, got %d", expectedHeight, height)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("timed out waiting for subscription")
+	}
+
+	cancel()
+	select {
+	case _, ok := <-heights:
+		if ok {
+			t.Error("Expected closed subscription")
+		}
+	case <-time.After(time.Second):
+		t.Fatal("timed out waiting for subscription to close")
+	}
+}
+
+func TestDummyDAAccountSequence(t *testing.T) {
+	dummyDA := NewDummyDA(1024, 0, 0, 50*time.Millisecond)
+	ctx := context.Background()
+
+	if _, err := dummyDA.Submit(ctx, []Blob{[]byte("unsequenced")}, 0, []byte("ns")); err != nil {
+		t.Fatalf("Submit failed: %v", err)
+	}
+	sequence, err := dummyDA.AccountSequence(ctx)
+	if err != nil {
+		t.Fatalf("AccountSequence failed: %v", err)
+	}
+	if sequence != 1 {
+		t.Fatalf("Expected sequence 1, got %d", sequence)
+	}
+
+	_, err = dummyDA.SubmitWithOptions(ctx, []Blob{[]byte("stale")}, 0, []byte("ns"), EncodeSequenceOptions(0))
+	if !errors.Is(err, ErrTxIncorrectAccountSequence) {
+		t.Fatalf("Expected ErrTxIncorrectAccountSequence, got %v", err)
+	}
+	if !strings.Contains(err.Error(), "expected 1, got 0") {
+		t.Errorf("Expected the expected sequence to be reported, got %v", err)
+	}
+
+	if _, err := dummyDA.SubmitWithOptions(ctx, []Blob{[]byte("next")}, 0, []byte("ns"), EncodeSequenceOptions(1)); err != nil {
+		t.Fatalf("SubmitWithOptions failed: %v", err)
+	}
+	if sequence, _ := dummyDA.AccountSequence(ctx); sequence != 2 {
+		t.Errorf("Expected sequence 2, got %d", sequence)
+	}
+}
@@ -13,4 +13,7 @@ var (
 	ErrContextDeadline            = errors.New("context deadline")
 	ErrHeightFromFuture           = errors.New("given height is from the future")
 	ErrContextCanceled            = errors.New("context canceled")
+	ErrSubscriptionNotSupported   = errors.New("subscriptions not supported")
+	ErrNetworkInfoNotSupported    = errors.New("network info not supported")
+	ErrSequenceNotSupported       = errors.New("account sequence not supported")
 )
@@ -0,0 +1,41 @@
+package da
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+)
+
+// AccountSequencer is an optional interface implemented by DA layers whose submissions are
+// transactions of an account ordered by a sequence number (nonce). The node queries it once,
+// then tracks the sequence locally and passes it in the submission options, see SequenceOptions.
+type AccountSequencer interface {
+	// AccountSequence returns the sequence of the next transaction of the submission account.
+	AccountSequence(ctx context.Context) (uint64, error)
+}
+
+// SequenceOptions are the JSON encoded submission options carrying the account sequence of a
+// submission, which DA layers implementing AccountSequencer check with CheckSequence.
+type SequenceOptions struct {
+	Sequence *uint64 `json:"sequence,omitempty"`
+}
+
+// EncodeSequenceOptions returns the submission options carrying the given sequence.
+func EncodeSequenceOptions(sequence uint64) []byte {
+	options, _ := json.Marshal(SequenceOptions{Sequence: &sequence})
+	return options
+}
+
+// CheckSequence checks the sequence carried by submission options, if any, against the next
+// sequence of the submission account. It returns an error wrapping ErrTxIncorrectAccountSequence
+// and reporting the expected sequence on mismatch. Options not carrying a sequence are accepted.
+func CheckSequence(options []byte, next uint64) error {
+	var opts SequenceOptions
+	if len(options) == 0 || json.Unmarshal(options, &opts) != nil || opts.Sequence == nil {
+		return nil
+	}
+	if *opts.Sequence != next {
+		return fmt.Errorf("account sequence mismatch, expected %d, got %d: %w", next, *opts.Sequence, ErrTxIncorrectAccountSequence)
+	}
+	return nil
+}
@@ -32,6 +32,11 @@ func NewDummyExecutor() *DummyExecutor {
 	}
 }
 
+// ComponentName returns the name of the dummy executor reported by the node.
+func (e *DummyExecutor) ComponentName() string {
+	return "dummy"
+}
+
 // InitChain initializes the chain state with the given genesis time, initial height, and chain ID.
 // It returns the state root hash, the maximum byte size, and an error if the initialization fails.
 func (e *DummyExecutor) InitChain(ctx context.Context, genesisTime time.Time, initialHeight uint64, chainID string) ([]byte, uint64, error) {
@@ -67,15 +72,25 @@ func (e *DummyExecutor) ExecuteTxs(ctx context.Context, txs [][]byte, blockHeigh
 	e.mu.Lock()
 	defer e.mu.Unlock()
 
+	pending := dummyStateRoot(txs, prevStateRoot)
+	e.pendingRoots[blockHeight] = pending
+	e.removeExecutedTxs(txs)
+	return pending, e.maxBytes, nil
+}
+
+// SimulateTxs returns the state root ExecuteTxs would return, without executing the transactions.
+func (e *DummyExecutor) SimulateTxs(ctx context.Context, txs [][]byte, blockHeight uint64, timestamp time.Time, prevStateRoot []byte) ([]byte, error) {
+	return dummyStateRoot(txs, prevStateRoot), nil
+}
+
+// dummyStateRoot returns the state root after executing txs on top of prevStateRoot.
+func dummyStateRoot(txs [][]byte, prevStateRoot []byte) []byte {
 	hash := sha512.New()
 	hash.Write(prevStateRoot)
 	for _, tx := range txs {
 		hash.Write(tx)
 	}
-	pending := hash.Sum(nil)
-	e.pendingRoots[blockHeight] = pending
-	e.removeExecutedTxs(txs)
-	return pending, e.maxBytes, nil
+	return hash.Sum(nil)
 }
 
 // SetFinal marks block at given height as finalized.
@@ -169,6 +169,38 @@ func TestExecuteTxs(t *testing.T) {
 	}
 }
 
+func TestSimulateTxs(t *testing.T) {
+	executor := NewDummyExecutor()
+	ctx := context.Background()
+
+	tx := []byte("tx1")
+	executor.InjectTx(tx)
+	prevStateRoot := executor.GetStateRoot()
+	timestamp := time.Now()
+
+	simulatedRoot, err := executor.SimulateTxs(ctx, [][]byte{tx}, 1, timestamp, prevStateRoot)
+	if err != nil {
+		t.Fatalf("SimulateTxs returned error: %v", err)
+	}
+
+	// Verify that the simulation left the mempool and the pending roots untouched
+	remainingTxs, _ := executor.GetTxs(ctx)
+	if len(remainingTxs) != 1 {
+		t.Fatalf("Expected 1 remaining transaction, got %d", len(remainingTxs))
+	}
+	if _, exists := executor.pendingRoots[1]; exists {
+		t.Error("Expected no pending root to be stored by SimulateTxs")
+	}
+
+	newStateRoot, _, err := executor.ExecuteTxs(ctx, [][]byte{tx}, 1, timestamp, prevStateRoot)
+	if err != nil {
+		t.Fatalf("ExecuteTxs returned error: %v", err)
+	}
+	if !bytes.Equal(simulatedRoot, newStateRoot) {
+		t.Errorf("Expected simulated state root %x to match executed state root %x", simulatedRoot, newStateRoot)
+	}
+}
+
 func TestSetFinal(t *testing.T) {
 	executor := NewDummyExecutor()
 	ctx := context.Background()
@@ -2,9 +2,14 @@ package execution
 
 import (
 	"context"
+	"errors"
+	"math/big"
 	"time"
 )
 
+// ErrTxNotFound is returned by TxResolver when the execution layer does not know a transaction.
+var ErrTxNotFound = errors.New("transaction not found")
+
 // Executor defines the interface that execution clients must implement to be compatible with Evolve.
 // This interface enables the separation between consensus and execution layers, allowing for modular
 // and pluggable execution environments.
@@ -85,3 +90,298 @@ type Executor interface {
 	// - error: Any errors during finalization
 	SetFinal(ctx context.Context, blockHeight uint64) error
 }
+
+// GasEstimator is an optional interface that an Executor may implement to report
+// the execution cost of a transaction without including it in a block.
+// It is used by the fee estimation RPC; executors that do not implement it only
+// get the DA component of the estimate.
+type GasEstimator interface {
+	// EstimateGas returns the gas the transaction is expected to consume and the
+	// gas price currently charged by the execution layer.
+	// Requirements:
+	// - Must not mutate state or the mempool
+	// - Must return error if the transaction cannot be decoded or would fail
+	//
+	// Parameters:
+	// - ctx: Context for timeout/cancellation control
+	// - tx: Raw transaction bytes
+	//
+	// Returns:
+	// - gas: Estimated gas units
+	// - gasPrice: Price per gas unit
+	// - err: Any estimation errors
+	EstimateGas(ctx context.Context, tx []byte) (gas uint64, gasPrice float64, err error)
+}
+
+// LimitedTxGetter is an optional interface that an Executor may implement to bound the
+// transactions it returns from its mempool.
+// When implemented, the reaper pulls candidate transactions with it instead of GetTxs, so that
+// a single pull never exceeds what the sequencer can batch, independently of how the execution
+// layer builds its block payloads.
+type LimitedTxGetter interface {
+	// GetTxsWithLimits fetches available transactions from the execution layer's mempool,
+	// up to the given limits.
+	// Requirements:
+	// - Must follow the requirements of GetTxs
+	// - Must return transactions in the order they should be included (e.g. by priority)
+	// - Must stop before the total size of the returned transactions exceeds maxBytes
+	// - Must stop before the total gas limit of the returned transactions exceeds maxGas
+	// - A limit of 0 means no limit
+	// - Must return an empty slice and no error when no transaction is available
+	//
+	// Parameters:
+	// - ctx: Context for timeout/cancellation control
+	// - maxBytes: Maximum total size of the returned transactions
+	// - maxGas: Maximum total gas limit of the returned transactions
+	//
+	// Returns:
+	// - []types.Tx: Slice of valid transactions
+	// - error: Any errors during transaction retrieval
+	GetTxsWithLimits(ctx context.Context, maxBytes, maxGas uint64) ([][]byte, error)
+}
+
+// DecodedTx is the chain-agnostic view of a transaction returned by a TxDecoder.
+type DecodedTx struct {
+	// Type is the execution layer specific type of the transaction (e.g. the EIP-2718 type).
+	Type uint8
+	// To is the address the transaction is sent to, nil for contract creations.
+	To []byte
+	// Method identifies the called method (e.g. the 4-byte selector of the calldata), nil if none.
+	Method []byte
+	// Gas is the gas limit of the transaction.
+	Gas uint64
+}
+
+// IsContractCreation returns whether the transaction creates a contract.
+func (tx DecodedTx) IsContractCreation() bool {
+	return tx.To == nil
+}
+
+// TxDecoder decodes the raw transactions of an execution layer, so that chain-specific policies
+// can be applied to them (e.g. by the sequencer) before they are batched.
+type TxDecoder interface {
+	// DecodeTx decodes a raw transaction.
+	// Requirements:
+	// - Must not depend on the state of the execution layer
+	// - Must return error if the transaction cannot be decoded or its type is not supported
+	DecodeTx(tx []byte) (DecodedTx, error)
+}
+
+// TxResolver is an optional interface that an Executor may implement to resolve transactions by
+// the hash the execution layer identifies them with (e.g. the keccak-256 hash of EVM transactions),
+// which differs from the SHA-256 hash of the raw transaction used by the node.
+// When implemented, the transaction status RPC also accepts the hashes of the execution layer.
+type TxResolver interface {
+	// ResolveTx returns the raw transaction with the given execution layer hash, as returned by
+	// GetTxs.
+	// Requirements:
+	// - Must return ErrTxNotFound if the execution layer does not know the transaction
+	//
+	// Parameters:
+	// - ctx: Context for timeout/cancellation control
+	// - hash: Execution layer hash of the transaction
+	//
+	// Returns:
+	// - tx: Raw transaction bytes
+	// - err: Any retrieval errors
+	ResolveTx(ctx context.Context, hash []byte) (tx []byte, err error)
+}
+
+// BlockInfo identifies the block of the execution layer built for a height.
+type BlockInfo struct {
+	// Number is the number of the block in the execution layer.
+	Number uint64
+	// Hash is the hash of the block in the execution layer.
+	Hash []byte
+	// StateRoot is the state root after the block, as returned by ExecuteTxs.
+	StateRoot []byte
+}
+
+// BlockInfoProvider is an optional interface that an Executor may implement to expose the blocks
+// of the execution layer.
+// When implemented, the node reports over RPC the mapping of its latest heights to the execution
+// blocks and whether they diverge from its store.
+type BlockInfoProvider interface {
+	// GetBlockInfo returns the execution block built for the given height.
+	// Requirements:
+	// - Must return an error if no block was built for the height
+	//
+	// Parameters:
+	// - ctx: Context for timeout/cancellation control
+	// - blockHeight: Height of the block
+	//
+	// Returns:
+	// - info: The execution block
+	// - err: Any retrieval errors
+	GetBlockInfo(ctx context.Context, blockHeight uint64) (info BlockInfo, err error)
+
+	// GetLatestBlockInfo returns the latest block of the execution layer.
+	GetLatestBlockInfo(ctx context.Context) (info BlockInfo, err error)
+}
+
+// StateChange is the new value of a single key of the execution state.
+type StateChange struct {
+	// Key identifies the touched state entry (e.g. a storage slot or account), in an encoding defined by the executor.
+	Key []byte
+	// Value is the new value of the entry. It is empty if the entry was deleted.
+	Value []byte
+	// Deleted is true if the entry was removed from the state.
+	Deleted bool
+}
+
+// StateDiffProvider is an optional interface that an Executor may implement to report
+// the state changes made by the transactions of a block.
+// When implemented, the diff of every executed block is stored by the node and served
+// over RPC, so that provers and indexers do not need to reconstruct it from the state.
+type StateDiffProvider interface {
+	// GetStateDiff returns the state changes made by the block at the given height.
+	// Requirements:
+	// - Must be called after ExecuteTxs for the same height
+	// - Must return every touched key exactly once with its value after the block
+	// - Must return changes in a deterministic order
+	//
+	// Parameters:
+	// - ctx: Context for timeout/cancellation control
+	// - blockHeight: Height of the executed block
+	//
+	// Returns:
+	// - changes: State changes made by the block
+	// - err: Any retrieval errors
+	GetStateDiff(ctx context.Context, blockHeight uint64) (changes []StateChange, err error)
+}
+
+// TxResult is the result of the execution of a single transaction.
+type TxResult struct {
+	// Success is false if the execution of the transaction failed, e.g. reverted, or if the
+	// execution layer did not include it in the block.
+	Success bool
+	// Logs are the logs emitted by the transaction, in the order they were emitted, encoded as
+	// defined by the execution layer (e.g. JSON for EVM logs).
+	Logs []string
+}
+
+// TxResultProvider is an optional interface that an Executor may implement to report the
+// execution results of the transactions of a block.
+// When implemented, the results of every executed block are stored by the node and included in
+// the webhook notifications of finalized blocks, so that consumers do not need to query the
+// execution layer for them.
+type TxResultProvider interface {
+	// GetTxResults returns the execution results of the transactions of the block at the given
+	// height.
+	// Requirements:
+	// - Must be called after ExecuteTxs for the same height
+	// - Must return one result per transaction passed to ExecuteTxs, in the same order
+	// - Must be deterministic, i.e. only depend on the executed block
+	//
+	// Parameters:
+	// - ctx: Context for timeout/cancellation control
+	// - blockHeight: Height of the executed block
+	//
+	// Returns:
+	// - results: Execution results of the transactions of the block
+	// - err: Any retrieval errors
+	GetTxResults(ctx context.Context, blockHeight uint64) (results []TxResult, err error)
+}
+
+// BlockFees are the sequencing fees collected by the transactions of a block.
+type BlockFees struct {
+	// Recipient is the account credited with the fees (e.g. the coinbase of an EVM block).
+	Recipient []byte
+	// Collected is the total of the fees credited to Recipient by the transactions of the block,
+	// in the smallest unit of the execution layer (e.g. wei).
+	Collected *big.Int
+	// RecipientBalance is the balance of Recipient after the block, in the same unit.
+	RecipientBalance *big.Int
+}
+
+// FeeReporter is an optional interface that an Executor may implement to report the sequencing
+// fees collected by its blocks.
+// When implemented, the node accounts the fees of every executed block, reconciles them against
+// the balance of the fee recipient and serves them over RPC, so that revenue can be monitored
+// without querying the execution layer.
+type FeeReporter interface {
+	// GetBlockFees returns the fees collected by the block at the given height.
+	// Requirements:
+	// - Must be called after ExecuteTxs for the same height
+	// - Must only count the fees credited to Recipient, e.g. not the burnt base fee of EIP-1559
+	//
+	// Parameters:
+	// - ctx: Context for timeout/cancellation control
+	// - blockHeight: Height of the executed block
+	//
+	// Returns:
+	// - fees: Fees collected by the block
+	// - err: Any retrieval errors
+	GetBlockFees(ctx context.Context, blockHeight uint64) (fees BlockFees, err error)
+}
+
+// Types of the system calls supported by the node.
+const (
+	// SystemCallHalt stops the node before it executes the block at the activation height, e.g. for
+	// a coordinated software upgrade. Value optionally names the upgrade. The halt happens once: the
+	// node proceeds when restarted.
+	SystemCallHalt = "halt"
+	// SystemCallSetBlockTime sets the block time of the aggregator from the activation height on.
+	// Value is a duration, e.g. "500ms".
+	SystemCallSetBlockTime = "set_block_time"
+)
+
+// SystemCall is a protocol action requested by the execution layer, e.g. by a governance contract
+// emitting an event, to be applied by the node at a future height.
+type SystemCall struct {
+	// Type is the action, one of the SystemCall* constants.
+	Type string
+	// ActivationHeight is the height of the first block the action applies to. It must be above
+	// the height of the block requesting it.
+	ActivationHeight uint64
+	// Value is the parameter of the action, as defined by its type.
+	Value string
+}
+
+// SystemCallProvider is an optional interface that an Executor may implement to let the execution
+// layer request protocol actions, enabling on-chain governance of the parameters of the sequencer.
+// When implemented, the node schedules the system calls requested by every executed block and
+// applies them at their activation height. As every node executes the same blocks, all nodes of
+// the network apply the same actions at the same height.
+type SystemCallProvider interface {
+	// GetSystemCalls returns the system calls requested by the block at the given height.
+	// Requirements:
+	// - Must be called after ExecuteTxs for the same height
+	// - Must be deterministic, i.e. only depend on the executed block
+	//
+	// Parameters:
+	// - ctx: Context for timeout/cancellation control
+	// - blockHeight: Height of the executed block
+	//
+	// Returns:
+	// - calls: System calls requested by the block, in the order they were requested
+	// - err: Any retrieval errors
+	GetSystemCalls(ctx context.Context, blockHeight uint64) (calls []SystemCall, err error)
+}
+
+// Simulator is an optional interface that an Executor may implement to execute transactions
+// without committing their result. When implemented, a shadow replica which detects a divergence
+// of its state root from the sequencer re-executes the prefixes of the diverging block to
+// pinpoint the first transaction whose execution is not reproducible. The EVM execution client
+// implements it by building the block without importing it, and the gRPC execution client by
+// forwarding the call to the remote executor.
+type Simulator interface {
+	// SimulateTxs executes the transactions as ExecuteTxs would at blockHeight, on top of the
+	// state with root prevStateRoot, and discards the result.
+	// Requirements:
+	// - Must not mutate the state of the execution layer or its mempool
+	// - Must return the state root ExecuteTxs returns for the same arguments
+	// - Must support the prevStateRoot of blocks already executed, at least the latest ones
+	//
+	// Parameters:
+	// - ctx: Context for timeout/cancellation control
+	// - txs: Ordered list of transactions to execute
+	// - blockHeight: Height of the block the transactions are executed in
+	// - timestamp: Time of the block
+	// - prevStateRoot: State root the transactions are executed on top of
+	//
+	// Returns:
+	// - stateRoot: State root after executing the transactions
+	// - err: Any execution errors
+	SimulateTxs(ctx context.Context, txs [][]byte, blockHeight uint64, timestamp time.Time, prevStateRoot []byte) (stateRoot []byte, err error)
+}
@@ -0,0 +1,39 @@
+package sequencer
+
+import "context"
+
+// Bundle is a group of transactions from an orderflow source, included together and in order,
+// or not at all.
+type Bundle struct {
+	// ID identifies the bundle in its source
+	ID           string
+	Transactions [][]byte
+}
+
+// OrderflowQuota bounds the transactions a sequencer includes from an orderflow source in a
+// batch. Zero values mean no limit.
+type OrderflowQuota struct {
+	MaxTxs   int
+	MaxBytes uint64
+}
+
+// OrderflowSource is an external source of transactions, such as a private orderflow endpoint or
+// a block builder, which a sequencer pulls bundles from alongside its public queue.
+type OrderflowSource interface {
+	// Name identifies the source in the inclusion attribution of the batches.
+	Name() string
+	// Bundles returns the bundles to include in the next batch, in order. The quota of the source
+	// is a hint: the bundles which do not fit in it are dropped by the sequencer.
+	Bundles(ctx context.Context, quota OrderflowQuota) ([]Bundle, error)
+}
+
+// BundleInclusion attributes the transactions of a batch to the bundle of the orderflow source
+// they were pulled from.
+type BundleInclusion struct {
+	Source   string
+	BundleID string
+	// Start is the index of the first transaction of the bundle in the batch
+	Start int
+	// Count is the number of transactions of the bundle
+	Count int
+}
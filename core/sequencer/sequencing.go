@@ -4,9 +4,14 @@ import (
 	"context"
 	"crypto/sha256"
 	"encoding/binary"
+	"errors"
 	"time"
 )
 
+// ErrQueueFull is returned, possibly wrapped, by SubmitBatchTxs when the sequencer cannot accept
+// more transactions for now. The transactions are not queued and should be submitted again later.
+var ErrQueueFull = errors.New("sequencer queue is full")
+
 // Sequencer is a generic interface for a sequencer
 type Sequencer interface {
 	// SubmitBatchTxs submits a batch of transactions from  to sequencer
@@ -86,6 +91,8 @@ type GetNextBatchResponse struct {
 	Batch     *Batch
 	Timestamp time.Time
 	BatchData [][]byte
+	// Orderflow attributes the transactions of the batch pulled from orderflow sources, if any
+	Orderflow []BundleInclusion
 }
 
 // VerifyBatchRequest is a request to verify a batch of transactions received from the sequencer
@@ -16,6 +16,9 @@ import (
 	"github.com/rs/zerolog"
 )
 
+// localNetworkID is the network ID reported by the local DA.
+const localNetworkID = "local-da"
+
 // DefaultMaxBlobSize is the default max blob size
 const DefaultMaxBlobSize uint64 = 64 * 64 * 481 // 1970176
 
@@ -31,6 +34,12 @@ type LocalDA struct {
 	height      uint64
 	privKey     ed25519.PrivateKey
 	pubKey      ed25519.PublicKey
+	// sim injects latency and failures in the calls, if set
+	sim *simulator
+	// subscriptions are notified of the heights of the submitted blobs
+	subscriptions map[*subscription]struct{}
+	// sequence is the sequence of the next submission, see coreda.AccountSequencer
+	sequence uint64
 
 	logger zerolog.Logger
 }
@@ -39,14 +48,21 @@ type kvp struct {
 	key, value []byte
 }
 
+// subscription is a subscription to the blobs of a namespace.
+type subscription struct {
+	ns []byte
+	ch chan uint64
+}
+
 // NewLocalDA create new instance of DummyDA
 func NewLocalDA(logger zerolog.Logger, opts ...func(*LocalDA) *LocalDA) *LocalDA {
 	da := &LocalDA{
-		mu:          new(sync.Mutex),
-		data:        make(map[uint64][]kvp),
-		timestamps:  make(map[uint64]time.Time),
-		maxBlobSize: DefaultMaxBlobSize,
-		logger:      logger,
+		mu:            new(sync.Mutex),
+		data:          make(map[uint64][]kvp),
+		timestamps:    make(map[uint64]time.Time),
+		maxBlobSize:   DefaultMaxBlobSize,
+		subscriptions: make(map[*subscription]struct{}),
+		logger:        logger,
 	}
 	for _, f := range opts {
 		da = f(da)
@@ -56,7 +72,12 @@ func NewLocalDA(logger zerolog.Logger, opts ...func(*LocalDA) *LocalDA) *LocalDA
 	return da
 }
 
-var _ coreda.DA = &LocalDA{}
+var (
+	_ coreda.DA                  = &LocalDA{}
+	_ coreda.Subscriber          = &LocalDA{}
+	_ coreda.NetworkInfoProvider = &LocalDA{}
+	_ coreda.AccountSequencer    = &LocalDA{}
+)
 
 // validateNamespace checks that namespace is exactly 29 bytes
 func validateNamespace(ns []byte) error {
@@ -72,6 +93,19 @@ func (d *LocalDA) MaxBlobSize(ctx context.Context) (uint64, error) {
 	return d.maxBlobSize, nil
 }
 
+// NetworkInfo reports the local network and the max blob size.
+func (d *LocalDA) NetworkInfo(ctx context.Context) (coreda.NetworkInfo, error) {
+	return coreda.NetworkInfo{NetworkID: localNetworkID, MaxBlobSize: d.maxBlobSize}, nil
+}
+
+// AccountSequence returns the sequence of the next submission, which is incremented by every
+// successful submission.
+func (d *LocalDA) AccountSequence(ctx context.Context) (uint64, error) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	return d.sequence, nil
+}
+
 // GasMultiplier returns the gas multiplier.
 func (d *LocalDA) GasMultiplier(ctx context.Context) (float64, error) {
 	d.logger.Debug().Msg("GasMultiplier called")
@@ -90,6 +124,9 @@ func (d *LocalDA) Get(ctx context.Context, ids []coreda.ID, ns []byte) ([]coreda
 		d.logger.Error().Err(err).Msg("Get: invalid namespace")
 		return nil, err
 	}
+	if err := d.simulate(ctx, "Get", false); err != nil {
+		return nil, err
+	}
 	d.logger.Debug().Interface("ids", ids).Msg("Get called")
 	d.mu.Lock()
 	defer d.mu.Unlock()
@@ -122,6 +159,9 @@ func (d *LocalDA) GetIDs(ctx context.Context, height uint64, ns []byte) (*coreda
 		d.logger.Error().Err(err).Msg("GetIDs: invalid namespace")
 		return nil, err
 	}
+	if err := d.simulate(ctx, "GetIDs", false); err != nil {
+		return nil, err
+	}
 	d.logger.Debug().Uint64("height", height).Msg("GetIDs called")
 	d.mu.Lock()
 	defer d.mu.Unlock()
@@ -184,7 +224,7 @@ func (d *LocalDA) Commit(ctx context.Context, blobs []coreda.Blob, ns []byte) ([
 }
 
 // SubmitWithOptions stores blobs in DA layer (options are ignored).
-func (d *LocalDA) SubmitWithOptions(ctx context.Context, blobs []coreda.Blob, gasPrice float64, ns []byte, _ []byte) ([]coreda.ID, error) {
+func (d *LocalDA) SubmitWithOptions(ctx context.Context, blobs []coreda.Blob, gasPrice float64, ns []byte, options []byte) ([]coreda.ID, error) {
 	if err := validateNamespace(ns); err != nil {
 		d.logger.Error().Err(err).Msg("SubmitWithOptions: invalid namespace")
 		return nil, err
@@ -198,9 +238,17 @@ func (d *LocalDA) SubmitWithOptions(ctx context.Context, blobs []coreda.Blob, ga
 			return nil, coreda.ErrBlobSizeOverLimit
 		}
 	}
+	if err := d.simulate(ctx, "SubmitWithOptions", true); err != nil {
+		return nil, err
+	}
 
 	d.mu.Lock()
 	defer d.mu.Unlock()
+	if err := coreda.CheckSequence(options, d.sequence); err != nil {
+		d.logger.Error().Err(err).Msg("SubmitWithOptions: account sequence mismatch")
+		return nil, err
+	}
+	d.sequence++
 	ids := make([]coreda.ID, len(blobs))
 	d.height += 1
 	d.timestamps[d.height] = time.Now()
@@ -209,6 +257,7 @@ func (d *LocalDA) SubmitWithOptions(ctx context.Context, blobs []coreda.Blob, ga
 
 		d.data[d.height] = append(d.data[d.height], kvp{ids[i], blob})
 	}
+	d.notifySubscriptions(ns)
 	d.logger.Info().Uint64("newHeight", d.height).Int("count", len(ids)).Msg("SubmitWithOptions successful")
 	return ids, nil
 }
@@ -228,9 +277,13 @@ func (d *LocalDA) Submit(ctx context.Context, blobs []coreda.Blob, gasPrice floa
 			return nil, coreda.ErrBlobSizeOverLimit
 		}
 	}
+	if err := d.simulate(ctx, "Submit", true); err != nil {
+		return nil, err
+	}
 
 	d.mu.Lock()
 	defer d.mu.Unlock()
+	d.sequence++
 	ids := make([]coreda.ID, len(blobs))
 	d.height += 1
 	d.timestamps[d.height] = time.Now()
@@ -239,10 +292,47 @@ func (d *LocalDA) Submit(ctx context.Context, blobs []coreda.Blob, gasPrice floa
 
 		d.data[d.height] = append(d.data[d.height], kvp{ids[i], blob})
 	}
+	d.notifySubscriptions(ns)
 	d.logger.Info().Uint64("newHeight", d.height).Int("count", len(ids)).Msg("Submit successful")
 	return ids, nil
 }
 
+// Subscribe notifies the heights of the blobs submitted to the namespace.
+func (d *LocalDA) Subscribe(ctx context.Context, ns []byte) (<-chan uint64, error) {
+	if err := validateNamespace(ns); err != nil {
+		return nil, err
+	}
+	sub := &subscription{ns: ns, ch: make(chan uint64, 16)}
+	d.mu.Lock()
+	d.subscriptions[sub] = struct{}{}
+	d.mu.Unlock()
+	d.logger.Debug().Str("namespace", string(ns)).Msg("Subscribe successful")
+
+	go func() {
+		<-ctx.Done()
+		d.mu.Lock()
+		delete(d.subscriptions, sub)
+		close(sub.ch)
+		d.mu.Unlock()
+	}()
+	return sub.ch, nil
+}
+
+// notifySubscriptions notifies the subscriptions to the namespace of the current height.
+// It must be called with the lock held.
+func (d *LocalDA) notifySubscriptions(ns []byte) {
+	for sub := range d.subscriptions {
+		if !bytes.Equal(sub.ns, ns) {
+			continue
+		}
+		select {
+		case sub.ch <- d.height:
+		default:
+			d.logger.Warn().Uint64("height", d.height).Msg("subscription backlog full, dropping notification")
+		}
+	}
+}
+
 // Validate checks the Proofs for given IDs.
 func (d *LocalDA) Validate(ctx context.Context, ids []coreda.ID, proofs []coreda.Proof, ns []byte) ([]bool, error) {
 	if err := validateNamespace(ns); err != nil {
@@ -0,0 +1,42 @@
+package main
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	coreda "github.com/evstack/ev-node/core/da"
+)
+
+func TestLocalDASubscribe(t *testing.T) {
+	ns := make([]byte, 29)
+	otherNs := append(make([]byte, 28), 1)
+	da := NewLocalDA(zerolog.Nop())
+	ctx, cancel := context.WithCancel(context.Background())
+
+	_, err := da.Subscribe(ctx, []byte("short"))
+	require.Error(t, err)
+	heights, err := da.Subscribe(ctx, ns)
+	require.NoError(t, err)
+
+	_, err = da.Submit(ctx, []coreda.Blob{[]byte("other")}, 0, otherNs)
+	require.NoError(t, err)
+	_, err = da.Submit(ctx, []coreda.Blob{[]byte("blob")}, 0, ns)
+	require.NoError(t, err)
+	select {
+	case height := <-heights:
+		assert.Equal(t, uint64(2), height)
+	case <-time.After(time.Second):
+		t.Fatal("timed out waiting for subscription")
+	}
+
+	cancel()
+	require.Eventually(t, func() bool {
+		_, ok := <-heights
+		return !ok
+	}, time.Second, 10*time.Millisecond)
+}
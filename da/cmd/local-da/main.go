@@ -4,8 +4,10 @@ import (
 	"context"
 	"flag"
 	"fmt"
+	"math/rand/v2"
 	"os"
 	"os/signal"
+	"strconv"
 	"syscall"
 
 	"github.com/rs/zerolog"
@@ -16,6 +18,11 @@ import (
 const (
 	defaultHost = "localhost"
 	defaultPort = "7980"
+
+	// simProfileEnv and simSeedEnv set the default simulation profile and seed, so that test
+	// harnesses can enable the simulation without changing how they start local-da.
+	simProfileEnv = "LOCAL_DA_SIM_PROFILE"
+	simSeedEnv    = "LOCAL_DA_SIM_SEED"
 )
 
 func main() {
@@ -24,13 +31,33 @@ func main() {
 		port        string
 		listenAll   bool
 		maxBlobSize uint64
+		simProfile  string
+		simSeed     uint64
 	)
 	flag.StringVar(&port, "port", defaultPort, "listening port")
 	flag.StringVar(&host, "host", defaultHost, "listening address")
 	flag.BoolVar(&listenAll, "listen-all", false, "listen on all network interfaces (0.0.0.0) instead of just localhost")
 	flag.Uint64Var(&maxBlobSize, "max-blob-size", DefaultMaxBlobSize, "maximum blob size in bytes")
+	flag.StringVar(&simProfile, "sim-profile", envOr(simProfileEnv, "none"), "latency and failure simulation profile, one of: "+SimProfileNames())
+	flag.Uint64Var(&simSeed, "sim-seed", 0, "seed of the simulation, taken from $"+simSeedEnv+" or drawn at random if not set")
 	flag.Parse()
 
+	if simSeed == 0 {
+		if env := os.Getenv(simSeedEnv); env != "" {
+			seed, err := strconv.ParseUint(env, 10, 64)
+			if err != nil {
+				fmt.Fprintf(os.Stderr, "invalid %s: %v\n", simSeedEnv, err)
+				os.Exit(1)
+			}
+			simSeed = seed
+		}
+	}
+	profile, ok := SimProfiles[simProfile]
+	if !ok {
+		fmt.Fprintf(os.Stderr, "unknown simulation profile %q, must be one of: %s\n", simProfile, SimProfileNames())
+		os.Exit(1)
+	}
+
 	if listenAll {
 		host = "0.0.0.0"
 	}
@@ -44,6 +71,15 @@ func main() {
 	if maxBlobSize != DefaultMaxBlobSize {
 		opts = append(opts, WithMaxBlobSize(maxBlobSize))
 	}
+	if simProfile != "none" {
+		if simSeed == 0 {
+			simSeed = rand.Uint64()
+		}
+		// the seed is all that is needed to replay the same latencies and failures
+		logger.Info().Str("profile", simProfile).Uint64("seed", simSeed).
+			Msgf("simulating DA latency and failures, replay with -sim-profile=%s -sim-seed=%d", simProfile, simSeed)
+		opts = append(opts, WithSimulation(simSeed, profile))
+	}
 	da := NewLocalDA(logger, opts...)
 
 	srv := proxy.NewServer(logger, host, port, da)
@@ -58,3 +94,11 @@ func main() {
 	fmt.Println("\nCtrl+C pressed. Exiting...")
 	os.Exit(0)
 }
+
+// envOr returns the value of the environment variable key, or fallback if it is not set.
+func envOr(key, fallback string) string {
+	if v := os.Getenv(key); v != "" {
+		return v
+	}
+	return fallback
+}
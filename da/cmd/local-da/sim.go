@@ -0,0 +1,129 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"math/rand/v2"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	coreda "github.com/evstack/ev-node/core/da"
+)
+
+// SimProfile describes the latency and failures injected in the calls to LocalDA, to reproduce
+// the timing of a real DA layer.
+type SimProfile struct {
+	// MinLatency and MaxLatency bound the latency added to every call, drawn uniformly.
+	MinLatency time.Duration
+	MaxLatency time.Duration
+	// SubmitFailureRate is the probability that a submission fails.
+	SubmitFailureRate float64
+	// RetrieveFailureRate is the probability that a retrieval fails.
+	RetrieveFailureRate float64
+}
+
+// SimProfiles are the simulation profiles selectable by name.
+var SimProfiles = map[string]SimProfile{
+	"none": {},
+	"slow": {
+		MinLatency: 200 * time.Millisecond,
+		MaxLatency: 2 * time.Second,
+	},
+	"flaky": {
+		MinLatency:          10 * time.Millisecond,
+		MaxLatency:          300 * time.Millisecond,
+		SubmitFailureRate:   0.2,
+		RetrieveFailureRate: 0.05,
+	},
+	"congested": {
+		MinLatency:          time.Second,
+		MaxLatency:          6 * time.Second,
+		SubmitFailureRate:   0.3,
+		RetrieveFailureRate: 0.01,
+	},
+}
+
+// SimProfileNames returns the names of the simulation profiles, sorted.
+func SimProfileNames() string {
+	names := make([]string, 0, len(SimProfiles))
+	for name := range SimProfiles {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return strings.Join(names, ", ")
+}
+
+var (
+	// submitFailures are the errors a simulated submission failure returns, as a DA node would.
+	submitFailures = []error{coreda.ErrTxTimedOut, coreda.ErrTxAlreadyInMempool, coreda.ErrTxIncorrectAccountSequence}
+	// retrieveFailures are the errors a simulated retrieval failure returns.
+	retrieveFailures = []error{coreda.ErrContextDeadline}
+)
+
+// simulator draws the latency and failure of every call from a seeded random source, so that
+// the same seed reproduces the same sequence of outcomes for the same sequence of calls.
+type simulator struct {
+	mu      sync.Mutex
+	rng     *rand.Rand
+	profile SimProfile
+}
+
+func newSimulator(seed uint64, profile SimProfile) *simulator {
+	return &simulator{
+		rng:     rand.New(rand.NewPCG(seed, seed)), //nolint:gosec // reproducibility is the point
+		profile: profile,
+	}
+}
+
+// next draws the outcome of the next call. Every call draws the same number of values
+// whatever the outcome, so that an outcome only depends on the seed and the call index.
+func (s *simulator) next(submit bool) (time.Duration, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	latency := s.profile.MinLatency
+	if spread := s.profile.MaxLatency - s.profile.MinLatency; spread > 0 {
+		latency += time.Duration(s.rng.Int64N(int64(spread)))
+	} else {
+		s.rng.Int64()
+	}
+	failureRate, failures := s.profile.RetrieveFailureRate, retrieveFailures
+	if submit {
+		failureRate, failures = s.profile.SubmitFailureRate, submitFailures
+	}
+	fail := s.rng.Float64() < failureRate
+	failure := failures[s.rng.IntN(len(failures))]
+	if !fail {
+		return latency, nil
+	}
+	return latency, failure
+}
+
+// simulate applies the simulated latency and failure of a call, if a simulation is configured.
+func (d *LocalDA) simulate(ctx context.Context, method string, submit bool) error {
+	if d.sim == nil {
+		return nil
+	}
+	latency, err := d.sim.next(submit)
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-time.After(latency):
+	}
+	if err != nil {
+		d.logger.Warn().Err(err).Str("method", method).Dur("latency", latency).Msg("simulated failure")
+		return fmt.Errorf("simulated failure: %w", err)
+	}
+	return nil
+}
+
+// WithSimulation returns a function that makes LocalDA add the latency and failures of the
+// profile to its calls, drawn from a random source seeded with seed.
+func WithSimulation(seed uint64, profile SimProfile) func(*LocalDA) *LocalDA {
+	return func(da *LocalDA) *LocalDA {
+		da.sim = newSimulator(seed, profile)
+		return da
+	}
+}
@@ -0,0 +1,90 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	coreda "github.com/evstack/ev-node/core/da"
+)
+
+func TestSimulatorIsDeterministic(t *testing.T) {
+	profile := SimProfile{
+		MinLatency:          time.Millisecond,
+		MaxLatency:          time.Second,
+		SubmitFailureRate:   0.5,
+		RetrieveFailureRate: 0.5,
+	}
+	draw := func(seed uint64) ([]time.Duration, []error) {
+		sim := newSimulator(seed, profile)
+		var latencies []time.Duration
+		var errs []error
+		for i := 0; i < 100; i++ {
+			latency, err := sim.next(i%2 == 0)
+			latencies = append(latencies, latency)
+			errs = append(errs, err)
+		}
+		return latencies, errs
+	}
+
+	latencies, errs := draw(42)
+	replayedLatencies, replayedErrs := draw(42)
+	assert.Equal(t, latencies, replayedLatencies)
+	assert.Equal(t, errs, replayedErrs)
+
+	otherLatencies, _ := draw(43)
+	assert.NotEqual(t, latencies, otherLatencies)
+
+	failures := 0
+	for i, latency := range latencies {
+		assert.GreaterOrEqual(t, latency, profile.MinLatency)
+		assert.Less(t, latency, profile.MaxLatency)
+		if errs[i] != nil {
+			failures++
+		}
+	}
+	assert.Greater(t, failures, 0)
+	assert.Less(t, failures, len(errs))
+}
+
+func TestLocalDASimulation(t *testing.T) {
+	ctx := context.Background()
+	ns := make([]byte, 29)
+	profile := SimProfile{SubmitFailureRate: 0.5}
+
+	submitOutcomes := func(seed uint64) []bool {
+		da := NewLocalDA(zerolog.Nop(), WithSimulation(seed, profile))
+		var outcomes []bool
+		for i := 0; i < 20; i++ {
+			_, err := da.Submit(ctx, []coreda.Blob{[]byte("blob")}, 0, ns)
+			if err != nil {
+				require.True(t, errors.Is(err, coreda.ErrTxTimedOut) ||
+					errors.Is(err, coreda.ErrTxAlreadyInMempool) ||
+					errors.Is(err, coreda.ErrTxIncorrectAccountSequence), err)
+			}
+			outcomes = append(outcomes, err == nil)
+		}
+		return outcomes
+	}
+	outcomes := submitOutcomes(7)
+	assert.Equal(t, outcomes, submitOutcomes(7))
+	assert.Contains(t, outcomes, true)
+	assert.Contains(t, outcomes, false)
+
+	// retrievals never fail with this profile
+	da := NewLocalDA(zerolog.Nop(), WithSimulation(7, profile))
+	_, err := da.GetIDs(ctx, 0, ns)
+	require.NoError(t, err)
+
+	// the simulated latency is interrupted by the context
+	slow := NewLocalDA(zerolog.Nop(), WithSimulation(7, SimProfile{MinLatency: time.Hour, MaxLatency: time.Hour}))
+	cancelled, cancel := context.WithCancel(ctx)
+	cancel()
+	_, err = slow.Submit(cancelled, []coreda.Blob{[]byte("blob")}, 0, ns)
+	require.ErrorIs(t, err, context.Canceled)
+}
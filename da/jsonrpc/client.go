@@ -3,6 +3,7 @@ package jsonrpc
 import (
 	"context"
 	"encoding/hex"
+	"errors"
 	"fmt"
 	"net/http"
 	"strings"
@@ -35,7 +36,26 @@ type API struct {
 		SubmitWithOptions func(context.Context, []da.Blob, float64, []byte, []byte) ([]da.ID, error)     `perm:"write"`
 		GasMultiplier     func(context.Context) (float64, error)                                         `perm:"read"`
 		GasPrice          func(context.Context) (float64, error)                                         `perm:"read"`
+		Subscribe         func(ctx context.Context, ns []byte) (<-chan uint64, error)                    `perm:"read"`
+		NetworkInfo       func(ctx context.Context) (da.NetworkInfo, error)                              `perm:"read"`
+		AccountSequence   func(ctx context.Context) (uint64, error)                                      `perm:"read"`
 	}
+	// Header is the header module of celestia-node, which reports its network in the headers
+	Header struct {
+		NetworkHead func(ctx context.Context) (*celestiaHeader, error) `perm:"read"`
+	}
+}
+
+// celestiaHeader is the part of the extended headers of celestia-node identifying its network.
+type celestiaHeader struct {
+	RawHeader struct {
+		ChainID string `json:"chain_id"`
+	} `json:"header"`
+}
+
+// ComponentName returns the name of the DA client reported by the node.
+func (api *API) ComponentName() string {
+	return "jsonrpc"
 }
 
 // Get returns Blob for each given ID, or an error.
@@ -181,6 +201,10 @@ func (api *API) SubmitWithOptions(ctx context.Context, inputBlobs []da.Blob, gas
 			return res, context.Canceled
 		}
 		api.Logger.Error().Err(err).Str("method", "SubmitWithOptions").Msg("RPC call failed")
+		// the error type is lost over RPC, restore it so that the node resynchronizes its sequence
+		if strings.Contains(err.Error(), da.ErrTxIncorrectAccountSequence.Error()) && !errors.Is(err, da.ErrTxIncorrectAccountSequence) {
+			return res, fmt.Errorf("%s: %w", strings.TrimSuffix(err.Error(), ": "+da.ErrTxIncorrectAccountSequence.Error()), da.ErrTxIncorrectAccountSequence)
+		}
 	} else {
 		api.Logger.Debug().Str("method", "SubmitWithOptions").Int("num_ids_returned", len(res)).Msg("RPC call successful")
 	}
@@ -200,6 +224,61 @@ func (api *API) GasPrice(ctx context.Context) (float64, error) {
 	return api.gasPrice, nil
 }
 
+// Subscribe returns a channel receiving the height of every DA block including blobs in the
+// namespace. Subscriptions require a websocket connection, i.e. a ws:// or wss:// address, and a
+// server whose DA implementation supports them.
+func (api *API) Subscribe(ctx context.Context, ns []byte) (<-chan uint64, error) {
+	preparedNs := da.PrepareNamespace(ns)
+	api.Logger.Debug().Str("method", "Subscribe").Str("namespace", hex.EncodeToString(preparedNs)).Msg("Making RPC call")
+	res, err := api.Internal.Subscribe(ctx, preparedNs)
+	if err != nil {
+		return nil, fmt.Errorf("failed to subscribe: %w", err)
+	}
+	return res, nil
+}
+
+// NetworkInfo returns the network reported by the server and the max blob size of the client, or
+// of the server if lower. celestia-node does not implement the NetworkInfo method, so its network
+// is the chain ID of its network head. For other servers not reporting their network, only the max
+// blob size of the client is returned.
+func (api *API) NetworkInfo(ctx context.Context) (da.NetworkInfo, error) {
+	api.Logger.Debug().Str("method", "NetworkInfo").Msg("Making RPC call")
+	info, err := api.Internal.NetworkInfo(ctx)
+	if err != nil {
+		api.Logger.Debug().Err(err).Str("method", "NetworkInfo").Msg("RPC call failed, getting the network head")
+		info = da.NetworkInfo{NetworkID: api.networkHeadChainID(ctx)}
+	}
+	if info.MaxBlobSize == 0 || info.MaxBlobSize > api.MaxBlobSize {
+		info.MaxBlobSize = api.MaxBlobSize
+	}
+	return info, nil
+}
+
+// AccountSequence returns the sequence of the next submission of the account of the server, for
+// servers whose DA implementation reports it.
+func (api *API) AccountSequence(ctx context.Context) (uint64, error) {
+	api.Logger.Debug().Str("method", "AccountSequence").Msg("Making RPC call")
+	seq, err := api.Internal.AccountSequence(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("failed to get account sequence: %w", err)
+	}
+	return seq, nil
+}
+
+// networkHeadChainID returns the chain ID of the network head of celestia-node, or an empty
+// string if the server does not report it.
+func (api *API) networkHeadChainID(ctx context.Context) string {
+	if api.Header.NetworkHead == nil {
+		return ""
+	}
+	head, err := api.Header.NetworkHead(ctx)
+	if err != nil || head == nil {
+		api.Logger.Debug().Err(err).Str("method", "NetworkHead").Msg("RPC call failed, the server does not report its network")
+		return ""
+	}
+	return head.RawHeader.ChainID
+}
+
 // Client is the jsonrpc client
 type Client struct {
 	DA     API
@@ -262,6 +341,7 @@ func newClient(ctx context.Context, logger zerolog.Logger, addr string, authHead
 func moduleMap(client *Client) map[string]interface{} {
 	// TODO: this duplication of strings many times across the codebase can be avoided with issue #1176
 	return map[string]interface{}{
-		"da": &client.DA.Internal,
+		"da":     &client.DA.Internal,
+		"header": &client.DA.Header,
 	}
 }
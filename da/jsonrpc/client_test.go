@@ -2,6 +2,7 @@ package jsonrpc
 
 import (
 	"context"
+	"errors"
 	"testing"
 
 	"github.com/rs/zerolog"
@@ -123,3 +124,25 @@ func TestSubmitWithOptions_SizeValidation(t *testing.T) {
 		})
 	}
 }
+
+// TestNetworkInfo_CelestiaNode tests that the network of servers not implementing the NetworkInfo
+// method, e.g. celestia-node, is the chain ID of their network head.
+func TestNetworkInfo_CelestiaNode(t *testing.T) {
+	api := &API{Logger: zerolog.Nop(), MaxBlobSize: 1024}
+	api.Internal.NetworkInfo = func(ctx context.Context) (da.NetworkInfo, error) {
+		return da.NetworkInfo{}, errors.New("method 'da.NetworkInfo' not found")
+	}
+
+	info, err := api.NetworkInfo(context.Background())
+	assert.NoError(t, err)
+	assert.Equal(t, da.NetworkInfo{MaxBlobSize: 1024}, info)
+
+	api.Header.NetworkHead = func(ctx context.Context) (*celestiaHeader, error) {
+		head := &celestiaHeader{}
+		head.RawHeader.ChainID = "mocha-4"
+		return head, nil
+	}
+	info, err = api.NetworkInfo(context.Background())
+	assert.NoError(t, err)
+	assert.Equal(t, da.NetworkInfo{NetworkID: "mocha-4", MaxBlobSize: 1024}, info)
+}
@@ -76,6 +76,99 @@ func TestProxy(t *testing.T) {
 	dummy.StopHeightTicker()
 }
 
+func TestProxySubscribe(t *testing.T) {
+	dummy := coreda.NewDummyDA(100_000, 0, 0, getTestDABlockTime())
+	dummy.StartHeightTicker()
+	defer dummy.StopHeightTicker()
+	logger := zerolog.Nop()
+	server := proxy.NewServer(logger, ServerHost, ServerPort, dummy)
+	require.NoError(t, server.Start(context.Background()))
+	defer func() {
+		require.NoError(t, server.Stop(context.Background()))
+	}()
+
+	// channels are only supported over websockets
+	httpClient, err := proxy.NewClient(t.Context(), logger, ClientURL, "", 0, 1)
+	require.NoError(t, err)
+	defer httpClient.Close()
+	_, err = httpClient.DA.Subscribe(t.Context(), testNamespace)
+	require.Error(t, err)
+
+	wsClient, err := proxy.NewClient(t.Context(), logger, strings.Replace(ClientURL, "http", "ws", 1), "", 0, 1)
+	require.NoError(t, err)
+	defer wsClient.Close()
+	var _ coreda.Subscriber = &wsClient.DA
+
+	ctx, cancel := context.WithCancel(t.Context())
+	defer cancel()
+	heights, err := wsClient.DA.Subscribe(ctx, testNamespace)
+	require.NoError(t, err)
+
+	ids, err := wsClient.DA.Submit(ctx, []coreda.Blob{[]byte("blob")}, 0, testNamespace)
+	require.NoError(t, err)
+	expectedHeight, _, err := coreda.SplitID(ids[0])
+	require.NoError(t, err)
+	select {
+	case height := <-heights:
+		assert.Equal(t, expectedHeight, height)
+	case <-time.After(5 * time.Second):
+		t.Fatal("timed out waiting for subscription")
+	}
+}
+
+func TestProxyNetworkInfo(t *testing.T) {
+	dummy := coreda.NewDummyDA(1024, 0, 0, getTestDABlockTime())
+	logger := zerolog.Nop()
+	server := proxy.NewServer(logger, ServerHost, ServerPort, dummy)
+	require.NoError(t, server.Start(context.Background()))
+	defer func() {
+		require.NoError(t, server.Stop(context.Background()))
+	}()
+
+	client, err := proxy.NewClient(t.Context(), logger, ClientURL, "", 0, 1)
+	require.NoError(t, err)
+	defer client.Close()
+	var _ coreda.NetworkInfoProvider = &client.DA
+
+	// the lower of the max blob sizes of the client and the server
+	info, err := client.DA.NetworkInfo(t.Context())
+	require.NoError(t, err)
+	assert.Equal(t, coreda.NetworkInfo{NetworkID: "dummy", MaxBlobSize: 1024}, info)
+	client.DA.MaxBlobSize = 512
+	info, err = client.DA.NetworkInfo(t.Context())
+	require.NoError(t, err)
+	assert.Equal(t, uint64(512), info.MaxBlobSize)
+}
+
+func TestProxyAccountSequence(t *testing.T) {
+	dummy := coreda.NewDummyDA(1024, 0, 0, getTestDABlockTime())
+	logger := zerolog.Nop()
+	// a separate port, so that no idle connection to the server of a previous test is reused
+	server := proxy.NewServer(logger, ServerHost, "3451", dummy)
+	require.NoError(t, server.Start(context.Background()))
+	defer func() {
+		require.NoError(t, server.Stop(context.Background()))
+	}()
+
+	client, err := proxy.NewClient(t.Context(), logger, "http://localhost:3451", "", 0, 1)
+	require.NoError(t, err)
+	defer client.Close()
+	var _ coreda.AccountSequencer = &client.DA
+
+	sequence, err := client.DA.AccountSequence(t.Context())
+	require.NoError(t, err)
+	assert.Equal(t, uint64(0), sequence)
+
+	_, err = client.DA.SubmitWithOptions(t.Context(), []coreda.Blob{[]byte("blob")}, 0, testNamespace, coreda.EncodeSequenceOptions(0))
+	require.NoError(t, err)
+	_, err = client.DA.SubmitWithOptions(t.Context(), []coreda.Blob{[]byte("stale")}, 0, testNamespace, coreda.EncodeSequenceOptions(0))
+	require.ErrorIs(t, err, coreda.ErrTxIncorrectAccountSequence)
+
+	sequence, err = client.DA.AccountSequence(t.Context())
+	require.NoError(t, err)
+	assert.Equal(t, uint64(1), sequence)
+}
+
 // BasicDATest tests round trip of messages to DA and back.
 func BasicDATest(t *testing.T, d coreda.DA) {
 	msg1 := []byte("message 1")
@@ -84,6 +84,36 @@ func (s *serverInternalAPI) GasMultiplier(ctx context.Context) (float64, error)
 	return s.daImpl.GasMultiplier(ctx)
 }
 
+// Subscribe implements the RPC method, if the DA implementation supports subscriptions.
+func (s *serverInternalAPI) Subscribe(ctx context.Context, ns []byte) (<-chan uint64, error) {
+	s.logger.Debug().Str("namespace", string(ns)).Msg("RPC server: Subscribe called")
+	subscriber, ok := s.daImpl.(da.Subscriber)
+	if !ok {
+		return nil, da.ErrSubscriptionNotSupported
+	}
+	return subscriber.Subscribe(ctx, ns)
+}
+
+// NetworkInfo implements the RPC method, if the DA implementation reports its network.
+func (s *serverInternalAPI) NetworkInfo(ctx context.Context) (da.NetworkInfo, error) {
+	s.logger.Debug().Msg("RPC server: NetworkInfo called")
+	provider, ok := s.daImpl.(da.NetworkInfoProvider)
+	if !ok {
+		return da.NetworkInfo{}, da.ErrNetworkInfoNotSupported
+	}
+	return provider.NetworkInfo(ctx)
+}
+
+// AccountSequence implements the RPC method, if the DA implementation reports its account sequence.
+func (s *serverInternalAPI) AccountSequence(ctx context.Context) (uint64, error) {
+	s.logger.Debug().Msg("RPC server: AccountSequence called")
+	sequencer, ok := s.daImpl.(da.AccountSequencer)
+	if !ok {
+		return 0, da.ErrSequenceNotSupported
+	}
+	return sequencer.AccountSequence(ctx)
+}
+
 // NewServer accepts the host address port and the DA implementation to serve as a jsonrpc service
 func NewServer(logger zerolog.Logger, address, port string, daImplementation da.DA) *Server {
 	rpc := jsonrpc.NewServer(jsonrpc.WithServerErrors(getKnownErrorsMapping()))
@@ -209,6 +209,7 @@ function sidebarHome() {
             { text: "Block Validity", link: "/learn/specs/block-validity" },
             { text: "Data Availability", link: "/learn/specs/da" },
             { text: "Full Node", link: "/learn/specs/full_node" },
+            { text: "Header Encoding", link: "/learn/specs/header-encoding" },
             { text: "Header Sync", link: "/learn/specs/header-sync" },
             { text: "P2P", link: "/learn/specs/p2p" },
             { text: "Store", link: "/learn/specs/store" },
@@ -31,20 +31,27 @@ var (
 // Ensure EngineAPIExecutionClient implements the execution.Execute interface
 var _ execution.Executor = (*EngineClient)(nil)
 
+var _ execution.BlockInfoProvider = (*EngineClient)(nil)
+
+var _ execution.Simulator = (*EngineClient)(nil)
+
 // EngineClient represents a client that interacts with an Ethereum execution engine
 // through the Engine API. It manages connections to both the engine and standard Ethereum
 // APIs, and maintains state related to block processing.
 type EngineClient struct {
-	engineClient  *rpc.Client       // Client for Engine API calls
-	ethClient     *ethclient.Client // Client for standard Ethereum API calls
-	genesisHash   common.Hash       // Hash of the genesis block
-	initialHeight uint64
-	feeRecipient  common.Address // Address to receive transaction fees
+	engineClient   *rpc.Client       // Client for Engine API calls
+	ethClient      *ethclient.Client // Client for standard Ethereum API calls
+	genesisHash    common.Hash       // Hash of the genesis block
+	initialHeight  uint64
+	feeRecipient   common.Address // Address to receive transaction fees
+	feeMarket      FeeMarketParams
+	systemContract common.Address // Contract emitting the system calls of the node, zero if none
 
 	mu                        sync.Mutex  // Mutex to protect concurrent access to block hashes
 	currentHeadBlockHash      common.Hash // Store last non-finalized HeadBlockHash
 	currentSafeBlockHash      common.Hash // Store last non-finalized SafeBlockHash
 	currentFinalizedBlockHash common.Hash // Store last finalized block hash
+	executed                  executedTxs // Transactions of the last executed block
 }
 
 // NewEngineExecutionClient creates a new instance of EngineAPIExecutionClient
@@ -92,6 +99,39 @@ func NewEngineExecutionClient(
 	}, nil
 }
 
+// ComponentName returns the name of the execution client reported by the node.
+func (c *EngineClient) ComponentName() string {
+	return "evm"
+}
+
+// SetFeeMarketParams sets the fee market parameters enforced on every block, typically taken from
+// the fee_market section of the genesis. Transactions whose fee cap is below the base fee floor are
+// not proposed, the parameters are passed to the execution client in the payload attributes, and
+// blocks that do not respect them are rejected by ExecuteTxs with ErrFeeMarketViolation. The
+// execution client must be an ev-reth build applying the non-standard payload attributes, or every
+// block is rejected. It must be called before the client is used.
+func (c *EngineClient) SetFeeMarketParams(params FeeMarketParams) {
+	c.feeMarket = params
+}
+
+// Ping checks that the Engine API is reachable and accepts the JWT secret, and that the execution
+// client was initialized with the configured genesis block.
+func (c *EngineClient) Ping(ctx context.Context) error {
+	var capabilities []string
+	if err := c.engineClient.CallContext(ctx, &capabilities, "engine_exchangeCapabilities", []string{}); err != nil {
+		return fmt.Errorf("engine API unreachable or JWT secret rejected: %w", err)
+	}
+
+	genesis, err := c.ethClient.HeaderByNumber(ctx, big.NewInt(0))
+	if err != nil {
+		return fmt.Errorf("eth API unreachable: %w", err)
+	}
+	if genesis.Hash() != c.genesisHash {
+		return fmt.Errorf("execution client genesis hash %s does not match the configured genesis hash %s", genesis.Hash().Hex(), c.genesisHash.Hex())
+	}
+	return nil
+}
+
 // InitChain initializes the blockchain with the given genesis parameters
 func (c *EngineClient) InitChain(ctx context.Context, genesisTime time.Time, initialHeight uint64, chainID string) ([]byte, uint64, error) {
 	if initialHeight != 1 {
@@ -124,6 +164,13 @@ func (c *EngineClient) InitChain(ctx context.Context, genesisTime time.Time, ini
 
 // GetTxs retrieves transactions from the current execution payload
 func (c *EngineClient) GetTxs(ctx context.Context) ([][]byte, error) {
+	return c.GetTxsWithLimits(ctx, 0, 0)
+}
+
+// GetTxsWithLimits retrieves the pending transactions from the txpool, in the order returned by
+// the execution client, until the next one would exceed the total size or gas limit.
+// A limit of 0 means no limit.
+func (c *EngineClient) GetTxsWithLimits(ctx context.Context, maxBytes, maxGas uint64) ([][]byte, error) {
 	var result []string
 	err := c.ethClient.Client().CallContext(ctx, &result, "txpoolExt_getTxs")
 	if err != nil {
@@ -131,6 +178,7 @@ func (c *EngineClient) GetTxs(ctx context.Context) ([][]byte, error) {
 	}
 
 	txs := make([][]byte, 0, len(result))
+	var totalBytes, totalGas uint64
 	for _, rlpHex := range result {
 		if !strings.HasPrefix(rlpHex, "0x") || len(rlpHex) < 3 {
 			return nil, fmt.Errorf("invalid hex format for transaction: %s", rlpHex)
@@ -139,6 +187,14 @@ func (c *EngineClient) GetTxs(ctx context.Context) ([][]byte, error) {
 		if len(txBytes) == 0 && len(rlpHex) > 2 {
 			return nil, fmt.Errorf("failed to decode hex transaction: %s", rlpHex)
 		}
+		if c.feeMarket.belowFloor(txBytes) {
+			continue
+		}
+		size, gas := totalBytes+uint64(len(txBytes)), totalGas+txGas(txBytes)
+		if (maxBytes > 0 && size > maxBytes) || (maxGas > 0 && gas > maxGas) {
+			break
+		}
+		totalBytes, totalGas = size, gas
 		txs = append(txs, txBytes)
 	}
 
@@ -147,6 +203,76 @@ func (c *EngineClient) GetTxs(ctx context.Context) ([][]byte, error) {
 
 // ExecuteTxs executes the given transactions at the specified block height and timestamp
 func (c *EngineClient) ExecuteTxs(ctx context.Context, txs [][]byte, blockHeight uint64, timestamp time.Time, prevStateRoot []byte) (updatedStateRoot []byte, maxBytes uint64, err error) {
+	payload, _, err := c.buildPayload(ctx, txs, blockHeight, timestamp)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	// submit payload
+	var newPayloadResult engine.PayloadStatusV1
+	err = c.engineClient.CallContext(ctx, &newPayloadResult, "engine_newPayloadV4",
+		payload,
+		[]string{},          // No blob hashes
+		common.Hash{}.Hex(), // Use zero hash for parentBeaconBlockRoot (same as in payload attributes)
+		[][]byte{},          // No execution requests
+	)
+	if err != nil {
+		return nil, 0, fmt.Errorf("new payload submission failed: %w", err)
+	}
+
+	if newPayloadResult.Status != engine.VALID {
+		return nil, 0, ErrInvalidPayloadStatus
+	}
+
+	// forkchoice update
+	err = c.setFinal(ctx, payload.BlockHash, false)
+	if err != nil {
+		return nil, 0, err
+	}
+	c.setExecutedTxs(blockHeight, txs)
+
+	return payload.StateRoot.Bytes(), payload.GasUsed, nil
+}
+
+// SimulateTxs builds the block the given transactions produce at the specified block height and
+// timestamp, and returns its state root without importing the block: the payload is neither
+// submitted with engine_newPayload nor made the head of the chain. As in ExecuteTxs, the block is
+// built on top of the block at blockHeight-1 of the execution client and prevStateRoot is ignored.
+//
+// Building the payload points the forkchoice of the execution client at the parent of the block,
+// so the forkchoice is restored afterwards if the parent is not the head. Execution clients that
+// do not build payloads on top of a block below their head, as geth, only simulate the next block
+// and fail with ErrNilPayloadStatus for the others.
+func (c *EngineClient) SimulateTxs(ctx context.Context, txs [][]byte, blockHeight uint64, timestamp time.Time, prevStateRoot []byte) ([]byte, error) {
+	head, err := c.ethClient.HeaderByNumber(ctx, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get latest block: %w", err)
+	}
+
+	payload, prevHeader, err := c.buildPayload(ctx, txs, blockHeight, timestamp)
+	if prevHeader != nil && prevHeader.Hash() != head.Hash() {
+		c.mu.Lock()
+		args := engine.ForkchoiceStateV1{
+			HeadBlockHash:      head.Hash(),
+			SafeBlockHash:      head.Hash(),
+			FinalizedBlockHash: c.currentFinalizedBlockHash,
+		}
+		c.mu.Unlock()
+		if restoreErr := c.forkchoiceUpdated(ctx, args); restoreErr != nil {
+			return nil, errors.Join(err, fmt.Errorf("failed to restore forkchoice: %w", restoreErr))
+		}
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return payload.StateRoot.Bytes(), nil
+}
+
+// buildPayload has the execution client build the block of the given transactions on top of the
+// block at blockHeight-1, and returns its payload with the header of its parent. The parent header
+// is returned as soon as it is known, even on error.
+func (c *EngineClient) buildPayload(ctx context.Context, txs [][]byte, blockHeight uint64, timestamp time.Time) (*engine.ExecutableData, *types.Header, error) {
 	// convert evolve tx to hex strings for ev-reth
 	txsPayload := make([]string, len(txs))
 	for i, tx := range txs {
@@ -154,10 +280,11 @@ func (c *EngineClient) ExecuteTxs(ctx context.Context, txs [][]byte, blockHeight
 		txsPayload[i] = "0x" + hex.EncodeToString(tx)
 	}
 
-	prevBlockHash, _, prevGasLimit, _, err := c.getBlockInfo(ctx, blockHeight-1)
+	prevHeader, err := c.getHeader(ctx, blockHeight-1)
 	if err != nil {
-		return nil, 0, fmt.Errorf("failed to get block info: %w", err)
+		return nil, nil, fmt.Errorf("failed to get block info: %w", err)
 	}
+	prevBlockHash, prevGasLimit := prevHeader.Hash(), prevHeader.GasLimit
 
 	args := engine.ForkchoiceStateV1{
 		HeadBlockHash:      prevBlockHash,
@@ -181,50 +308,35 @@ func (c *EngineClient) ExecuteTxs(ctx context.Context, txs [][]byte, blockHeight
 		"transactions": txsPayload,
 		"gasLimit":     prevGasLimit, // Use camelCase to match JSON conventions
 	}
+	if !c.feeMarket.IsZero() {
+		evPayloadAttrs["baseFeeFloor"] = c.feeMarket.BaseFeeFloor
+		evPayloadAttrs["targetGas"] = c.feeMarket.TargetGas
+	}
 
 	err = c.engineClient.CallContext(ctx, &forkchoiceResult, "engine_forkchoiceUpdatedV3",
 		args,
 		evPayloadAttrs,
 	)
 	if err != nil {
-		return nil, 0, fmt.Errorf("forkchoice update failed: %w", err)
+		return nil, prevHeader, fmt.Errorf("forkchoice update failed: %w", err)
 	}
 
 	if forkchoiceResult.PayloadID == nil {
-		return nil, 0, ErrNilPayloadStatus
+		return nil, prevHeader, ErrNilPayloadStatus
 	}
 
 	// get payload
 	var payloadResult engine.ExecutionPayloadEnvelope
 	err = c.engineClient.CallContext(ctx, &payloadResult, "engine_getPayloadV4", *forkchoiceResult.PayloadID)
 	if err != nil {
-		return nil, 0, fmt.Errorf("get payload failed: %w", err)
+		return nil, prevHeader, fmt.Errorf("get payload failed: %w", err)
 	}
 
-	// submit payload
-	var newPayloadResult engine.PayloadStatusV1
-	err = c.engineClient.CallContext(ctx, &newPayloadResult, "engine_newPayloadV4",
-		payloadResult.ExecutionPayload,
-		[]string{},          // No blob hashes
-		common.Hash{}.Hex(), // Use zero hash for parentBeaconBlockRoot (same as in payload attributes)
-		[][]byte{},          // No execution requests
-	)
-	if err != nil {
-		return nil, 0, fmt.Errorf("new payload submission failed: %w", err)
-	}
-
-	if newPayloadResult.Status != engine.VALID {
-		return nil, 0, ErrInvalidPayloadStatus
+	if err := c.feeMarket.validatePayload(prevHeader, payloadResult.ExecutionPayload); err != nil {
+		return nil, prevHeader, err
 	}
 
-	// forkchoice update
-	blockHash := payloadResult.ExecutionPayload.BlockHash
-	err = c.setFinal(ctx, blockHash, false)
-	if err != nil {
-		return nil, 0, err
-	}
-
-	return payloadResult.ExecutionPayload.StateRoot.Bytes(), payloadResult.ExecutionPayload.GasUsed, nil
+	return payloadResult.ExecutionPayload, prevHeader, nil
 }
 
 func (c *EngineClient) setFinal(ctx context.Context, blockHash common.Hash, isFinal bool) error {
@@ -245,6 +357,11 @@ func (c *EngineClient) setFinal(ctx context.Context, blockHash common.Hash, isFi
 	}
 	c.mu.Unlock()
 
+	return c.forkchoiceUpdated(ctx, args)
+}
+
+// forkchoiceUpdated updates the forkchoice of the execution client, without building a payload.
+func (c *EngineClient) forkchoiceUpdated(ctx context.Context, args engine.ForkchoiceStateV1) error {
 	var forkchoiceResult engine.ForkChoiceResponse
 	err := c.engineClient.CallContext(ctx, &forkchoiceResult, "engine_forkchoiceUpdatedV3",
 		args,
@@ -270,20 +387,54 @@ func (c *EngineClient) SetFinal(ctx context.Context, blockHeight uint64) error {
 	return c.setFinal(ctx, blockHash, true)
 }
 
+// GetBlockInfo implements execution.BlockInfoProvider. The execution block of a height is the
+// block with the same number.
+func (c *EngineClient) GetBlockInfo(ctx context.Context, blockHeight uint64) (execution.BlockInfo, error) {
+	header, err := c.getHeader(ctx, blockHeight)
+	if err != nil {
+		return execution.BlockInfo{}, err
+	}
+	return blockInfo(header), nil
+}
+
+// GetLatestBlockInfo implements execution.BlockInfoProvider.
+func (c *EngineClient) GetLatestBlockInfo(ctx context.Context) (execution.BlockInfo, error) {
+	header, err := c.ethClient.HeaderByNumber(ctx, nil)
+	if err != nil {
+		return execution.BlockInfo{}, fmt.Errorf("failed to get latest block: %w", err)
+	}
+	return blockInfo(header), nil
+}
+
+func blockInfo(header *types.Header) execution.BlockInfo {
+	return execution.BlockInfo{
+		Number:    header.Number.Uint64(),
+		Hash:      header.Hash().Bytes(),
+		StateRoot: header.Root.Bytes(),
+	}
+}
+
 func (c *EngineClient) derivePrevRandao(blockHeight uint64) common.Hash {
 	return common.BigToHash(new(big.Int).SetUint64(blockHeight))
 }
 
 func (c *EngineClient) getBlockInfo(ctx context.Context, height uint64) (common.Hash, common.Hash, uint64, uint64, error) {
-	header, err := c.ethClient.HeaderByNumber(ctx, new(big.Int).SetUint64(height))
-
+	header, err := c.getHeader(ctx, height)
 	if err != nil {
-		return common.Hash{}, common.Hash{}, 0, 0, fmt.Errorf("failed to get block at height %d: %w", height, err)
+		return common.Hash{}, common.Hash{}, 0, 0, err
 	}
 
 	return header.Hash(), header.Root, header.GasLimit, header.Time, nil
 }
 
+func (c *EngineClient) getHeader(ctx context.Context, height uint64) (*types.Header, error) {
+	header, err := c.ethClient.HeaderByNumber(ctx, new(big.Int).SetUint64(height))
+	if err != nil {
+		return nil, fmt.Errorf("failed to get block at height %d: %w", height, err)
+	}
+	return header, nil
+}
+
 // decodeSecret decodes a hex-encoded JWT secret string into a byte slice.
 func decodeSecret(jwtSecret string) ([]byte, error) {
 	secret, err := hex.DecodeString(strings.TrimPrefix(jwtSecret, "0x"))
@@ -307,3 +458,13 @@ func getAuthToken(jwtSecret []byte) (string, error) {
 	}
 	return authToken, nil
 }
+
+// txGas returns the gas limit of a raw transaction, or 0 if it cannot be decoded, in which case it
+// is left to the execution client to reject it.
+func txGas(rawTx []byte) uint64 {
+	var tx types.Transaction
+	if err := tx.UnmarshalBinary(rawTx); err != nil {
+		return 0
+	}
+	return tx.Gas()
+}
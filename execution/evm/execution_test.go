@@ -75,6 +75,7 @@ func TestEngineExecution(t *testing.T) {
 
 		ctx, cancel := context.WithTimeout(context.Background(), 300*time.Second)
 		defer cancel()
+		require.NoError(tt, executionClient.Ping(ctx))
 		stateRoot, gasLimit, err := executionClient.InitChain(ctx, genesisTime, initialHeight, CHAIN_ID)
 		require.NoError(t, err)
 		require.Equal(t, GenesisStateRoot, stateRoot)
@@ -116,12 +117,20 @@ func TestEngineExecution(t *testing.T) {
 			blockTimestamp := baseTimestamp.Add(time.Duration(blockHeight-initialHeight) * time.Second)
 			allTimestamps = append(allTimestamps, blockTimestamp)
 
+			// Simulating the block returns its state root without importing it
+			simulatedStateRoot, err := executionClient.SimulateTxs(ctx, payload, blockHeight, blockTimestamp, prevStateRoot)
+			require.NoError(tt, err)
+			afterHeight, afterHash, _ := checkLatestBlock(tt, ctx)
+			require.Equal(tt, lastHeight, afterHeight, "Simulation should not import the block")
+			require.Equal(tt, lastHash.Hex(), afterHash.Hex(), "Simulation should not change the head")
+
 			// Execute transactions and get the new state root
 			newStateRoot, maxBytes, err := executionClient.ExecuteTxs(ctx, payload, blockHeight, blockTimestamp, prevStateRoot)
 			require.NoError(tt, err)
 			if nTxs > 0 {
 				require.NotZero(tt, maxBytes)
 			}
+			require.Equal(tt, simulatedStateRoot, newStateRoot, "Simulation should return the state root of the execution")
 
 			err = executionClient.SetFinal(ctx, blockHeight)
 			require.NoError(tt, err)
@@ -0,0 +1,122 @@
+package evm
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/beacon/engine"
+	"github.com/ethereum/go-ethereum/core/types"
+)
+
+// ErrFeeMarketViolation indicates that a block does not respect the fee market parameters of the chain
+var ErrFeeMarketViolation = errors.New("block violates fee market parameters")
+
+const (
+	// baseFeeChangeDenominator bounds the base fee change between blocks, as in EIP-1559
+	baseFeeChangeDenominator = 8
+	// elasticityMultiplier is the ratio of the maximum gas used per block to the target, as in EIP-1559
+	elasticityMultiplier = 2
+	// baseFeeToleranceDenominator bounds the deviation of the base fee of a block from the expected
+	// one, as a fraction of the expected base fee, so that rounding differences between the
+	// computation of the execution client and ExpectedBaseFee do not halt the chain
+	baseFeeToleranceDenominator = 1000
+)
+
+// FeeMarketParams are EIP-1559-like parameters enforced on every block of the chain.
+// The zero value enforces nothing.
+type FeeMarketParams struct {
+	// BaseFeeFloor is the minimum base fee per gas, in wei.
+	BaseFeeFloor uint64
+	// TargetGas is the gas used per block at which the base fee stays constant.
+	// 0 leaves the target to the execution client and only enforces the floor.
+	TargetGas uint64
+}
+
+// IsZero returns whether no parameter is set.
+func (p FeeMarketParams) IsZero() bool {
+	return p.BaseFeeFloor == 0 && p.TargetGas == 0
+}
+
+// ExpectedBaseFee returns the base fee of a block following a parent with the given base fee and
+// gas used: the EIP-1559 adjustment towards TargetGas, but never less than BaseFeeFloor.
+// It must only be called if TargetGas is set.
+func (p FeeMarketParams) ExpectedBaseFee(parentBaseFee *big.Int, parentGasUsed uint64) *big.Int {
+	floor := new(big.Int).SetUint64(p.BaseFeeFloor)
+	if parentBaseFee == nil {
+		return floor
+	}
+
+	baseFee := new(big.Int).Set(parentBaseFee)
+	target := new(big.Int).SetUint64(p.TargetGas)
+	switch {
+	case parentGasUsed > p.TargetGas:
+		delta := new(big.Int).SetUint64(parentGasUsed - p.TargetGas)
+		delta.Mul(delta, parentBaseFee)
+		delta.Div(delta, target)
+		delta.Div(delta, big.NewInt(baseFeeChangeDenominator))
+		if delta.Sign() == 0 {
+			delta.SetUint64(1)
+		}
+		baseFee.Add(baseFee, delta)
+	case parentGasUsed < p.TargetGas:
+		delta := new(big.Int).SetUint64(p.TargetGas - parentGasUsed)
+		delta.Mul(delta, parentBaseFee)
+		delta.Div(delta, target)
+		delta.Div(delta, big.NewInt(baseFeeChangeDenominator))
+		baseFee.Sub(baseFee, delta)
+	}
+
+	if baseFee.Cmp(floor) < 0 {
+		return floor
+	}
+	return baseFee
+}
+
+// validatePayload checks that a payload built on top of parent respects the parameters.
+func (p FeeMarketParams) validatePayload(parent *types.Header, payload *engine.ExecutableData) error {
+	if p.IsZero() {
+		return nil
+	}
+	if payload.BaseFeePerGas == nil {
+		return fmt.Errorf("%w: block %d has no base fee", ErrFeeMarketViolation, payload.Number)
+	}
+	if payload.BaseFeePerGas.Cmp(new(big.Int).SetUint64(p.BaseFeeFloor)) < 0 {
+		return fmt.Errorf("%w: block %d base fee %s is below the floor %d", ErrFeeMarketViolation, payload.Number, payload.BaseFeePerGas, p.BaseFeeFloor)
+	}
+	if p.TargetGas == 0 {
+		return nil
+	}
+
+	if maxGas := p.TargetGas * elasticityMultiplier; payload.GasUsed > maxGas {
+		return fmt.Errorf("%w: block %d used %d gas, more than %d times the target of %d", ErrFeeMarketViolation, payload.Number, payload.GasUsed, elasticityMultiplier, p.TargetGas)
+	}
+	if expected := p.ExpectedBaseFee(parent.BaseFee, parent.GasUsed); !baseFeeWithinTolerance(payload.BaseFeePerGas, expected) {
+		return fmt.Errorf("%w: block %d base fee %s, expected %s", ErrFeeMarketViolation, payload.Number, payload.BaseFeePerGas, expected)
+	}
+	return nil
+}
+
+// baseFeeWithinTolerance returns whether baseFee deviates from expected by at most
+// 1/baseFeeToleranceDenominator of expected, and at least 1 wei.
+func baseFeeWithinTolerance(baseFee, expected *big.Int) bool {
+	tolerance := new(big.Int).Div(expected, big.NewInt(baseFeeToleranceDenominator))
+	if tolerance.Sign() == 0 {
+		tolerance.SetUint64(1)
+	}
+	deviation := new(big.Int).Sub(baseFee, expected)
+	return deviation.Abs(deviation).Cmp(tolerance) <= 0
+}
+
+// belowFloor returns whether a raw transaction can never be included because its fee cap is
+// below the base fee floor. Transactions that cannot be decoded are left to the execution client.
+func (p FeeMarketParams) belowFloor(rawTx []byte) bool {
+	if p.BaseFeeFloor == 0 {
+		return false
+	}
+	var tx types.Transaction
+	if err := tx.UnmarshalBinary(rawTx); err != nil {
+		return false
+	}
+	return tx.GasFeeCap().Cmp(new(big.Int).SetUint64(p.BaseFeeFloor)) < 0
+}
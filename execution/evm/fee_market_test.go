@@ -0,0 +1,97 @@
+package evm
+
+import (
+	"crypto/ecdsa"
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/beacon/engine"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestExpectedBaseFee(t *testing.T) {
+	p := FeeMarketParams{BaseFeeFloor: 100, TargetGas: 1_000_000}
+	gwei := big.NewInt(1_000_000_000)
+
+	testCases := []struct {
+		name          string
+		parentBaseFee *big.Int
+		parentGasUsed uint64
+		expected      *big.Int
+	}{
+		{"at target", gwei, 1_000_000, gwei},
+		{"full block", gwei, 2_000_000, big.NewInt(1_125_000_000)},
+		{"empty block", gwei, 0, big.NewInt(875_000_000)},
+		{"minimal increase", big.NewInt(1_000), 1_000_001, big.NewInt(1_001)},
+		{"floor", big.NewInt(110), 0, big.NewInt(100)},
+		{"no parent base fee", nil, 0, big.NewInt(100)},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.expected.String(), p.ExpectedBaseFee(tc.parentBaseFee, tc.parentGasUsed).String())
+		})
+	}
+}
+
+func TestValidatePayload(t *testing.T) {
+	parent := &types.Header{BaseFee: big.NewInt(1_000_000_000), GasUsed: 1_000_000}
+	payload := func(baseFee int64, gasUsed uint64) *engine.ExecutableData {
+		return &engine.ExecutableData{Number: 2, BaseFeePerGas: big.NewInt(baseFee), GasUsed: gasUsed}
+	}
+
+	// nothing is enforced without parameters
+	require.NoError(t, FeeMarketParams{}.validatePayload(parent, payload(1, 100_000_000)))
+
+	floorOnly := FeeMarketParams{BaseFeeFloor: 1_000}
+	require.NoError(t, floorOnly.validatePayload(parent, payload(1_000, 100_000_000)))
+	require.True(t, errors.Is(floorOnly.validatePayload(parent, payload(999, 0)), ErrFeeMarketViolation))
+	require.True(t, errors.Is(floorOnly.validatePayload(parent, &engine.ExecutableData{}), ErrFeeMarketViolation))
+
+	p := FeeMarketParams{BaseFeeFloor: 1_000, TargetGas: 1_000_000}
+	require.NoError(t, p.validatePayload(parent, payload(1_000_000_000, 2_000_000)))
+	// the base fee may deviate from the expected one by 1/1000, for rounding differences
+	require.NoError(t, p.validatePayload(parent, payload(1_001_000_000, 2_000_000)))
+	require.NoError(t, p.validatePayload(parent, payload(999_000_000, 2_000_000)))
+	require.True(t, errors.Is(p.validatePayload(parent, payload(1_001_000_001, 2_000_000)), ErrFeeMarketViolation))
+	require.True(t, errors.Is(p.validatePayload(parent, payload(998_999_999, 0)), ErrFeeMarketViolation))
+	// and by 1 wei at least
+	atFloor := &types.Header{BaseFee: big.NewInt(1_000), GasUsed: 1_000_000}
+	require.NoError(t, p.validatePayload(atFloor, payload(1_001, 1_000_000)))
+	require.True(t, errors.Is(p.validatePayload(atFloor, payload(1_002, 1_000_000)), ErrFeeMarketViolation))
+	require.True(t, errors.Is(p.validatePayload(parent, payload(1_000_000_000, 2_000_001)), ErrFeeMarketViolation))
+}
+
+func TestBelowFloor(t *testing.T) {
+	key, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	signedTx := func(feeCap int64) []byte {
+		t.Helper()
+		return signTx(t, key, &types.DynamicFeeTx{
+			ChainID:   big.NewInt(1),
+			GasFeeCap: big.NewInt(feeCap),
+			GasTipCap: big.NewInt(1),
+			Gas:       21_000,
+		})
+	}
+
+	p := FeeMarketParams{BaseFeeFloor: 1_000}
+	assert.True(t, p.belowFloor(signedTx(999)))
+	assert.False(t, p.belowFloor(signedTx(1_000)))
+	// undecodable transactions are left to the execution client
+	assert.False(t, p.belowFloor([]byte("garbage")))
+	// nothing is filtered without a floor
+	assert.False(t, FeeMarketParams{}.belowFloor(signedTx(0)))
+}
+
+func signTx(t *testing.T, key *ecdsa.PrivateKey, txData types.TxData) []byte {
+	t.Helper()
+	tx, err := types.SignNewTx(key, types.LatestSignerForChainID(big.NewInt(1)), txData)
+	require.NoError(t, err)
+	bz, err := tx.MarshalBinary()
+	require.NoError(t, err)
+	return bz
+}
@@ -0,0 +1,54 @@
+package evm
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/rpc"
+
+	"github.com/evstack/ev-node/core/execution"
+)
+
+var _ execution.FeeReporter = (*EngineClient)(nil)
+
+// GetBlockFees implements execution.FeeReporter. The fees collected by a block are the priority
+// fees of its transactions, credited to its coinbase, i.e. the fee recipient of the node; the base
+// fee is burnt.
+func (c *EngineClient) GetBlockFees(ctx context.Context, blockHeight uint64) (execution.BlockFees, error) {
+	header, err := c.getHeader(ctx, blockHeight)
+	if err != nil {
+		return execution.BlockFees{}, err
+	}
+	receipts, err := c.ethClient.BlockReceipts(ctx, rpc.BlockNumberOrHashWithHash(header.Hash(), true))
+	if err != nil {
+		return execution.BlockFees{}, fmt.Errorf("failed to get receipts of block %d: %w", blockHeight, err)
+	}
+	balance, err := c.ethClient.BalanceAt(ctx, header.Coinbase, header.Number)
+	if err != nil {
+		return execution.BlockFees{}, fmt.Errorf("failed to get balance of fee recipient at block %d: %w", blockHeight, err)
+	}
+	return execution.BlockFees{
+		Recipient:        header.Coinbase.Bytes(),
+		Collected:        priorityFees(header.BaseFee, receipts),
+		RecipientBalance: balance,
+	}, nil
+}
+
+// priorityFees returns the fees credited to the coinbase by the transactions of a block with the
+// given base fee: the gas used by each transaction times its effective gas price above the base fee.
+func priorityFees(baseFee *big.Int, receipts []*types.Receipt) *big.Int {
+	total := new(big.Int)
+	for _, receipt := range receipts {
+		if receipt.EffectiveGasPrice == nil {
+			continue
+		}
+		tip := new(big.Int).Set(receipt.EffectiveGasPrice)
+		if baseFee != nil {
+			tip.Sub(tip, baseFee)
+		}
+		total.Add(total, tip.Mul(tip, new(big.Int).SetUint64(receipt.GasUsed)))
+	}
+	return total
+}
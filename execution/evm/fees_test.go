@@ -0,0 +1,24 @@
+package evm
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPriorityFees(t *testing.T) {
+	receipts := []*types.Receipt{
+		{GasUsed: 21000, EffectiveGasPrice: big.NewInt(12)},
+		{GasUsed: 50000, EffectiveGasPrice: big.NewInt(10)},
+		// receipts of pre-London blocks may not report an effective gas price
+		{GasUsed: 30000},
+	}
+
+	// the base fee is burnt
+	require.Equal(t, big.NewInt(2*21000), priorityFees(big.NewInt(10), receipts))
+	// blocks without base fee credit the whole gas price
+	require.Equal(t, big.NewInt(12*21000+10*50000), priorityFees(nil, receipts))
+	require.Zero(t, priorityFees(big.NewInt(10), nil).Sign())
+}
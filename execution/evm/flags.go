@@ -6,4 +6,13 @@ const (
 	FlagEvmJWTSecret    = "evm.jwt-secret"
 	FlagEvmGenesisHash  = "evm.genesis-hash"
 	FlagEvmFeeRecipient = "evm.fee-recipient"
+
+	FlagEvmUpgradeEthURL    = "evm.upgrade-eth-url"
+	FlagEvmUpgradeEngineURL = "evm.upgrade-engine-url"
+
+	FlagEvmAllowedMethods       = "evm.allowed-methods"
+	FlagEvmDeniedMethods        = "evm.denied-methods"
+	FlagEvmDenyContractCreation = "evm.deny-contract-creation"
+
+	FlagEvmStateDiffs = "evm.state-diffs"
 )
@@ -0,0 +1,67 @@
+package evm
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/core/types"
+
+	"github.com/evstack/ev-node/core/execution"
+)
+
+var _ execution.GasEstimator = (*EngineClient)(nil)
+
+// EstimateGas implements execution.GasEstimator. The transaction is simulated by the execution
+// client with eth_estimateGas against the latest block, from its recovered sender, and priced at
+// the gas price suggested by the execution client, in wei.
+func (c *EngineClient) EstimateGas(ctx context.Context, rawTx []byte) (uint64, float64, error) {
+	var tx types.Transaction
+	if err := tx.UnmarshalBinary(rawTx); err != nil {
+		return 0, 0, fmt.Errorf("failed to decode transaction: %w", err)
+	}
+	msg, err := callMsg(&tx)
+	if err != nil {
+		return 0, 0, err
+	}
+	gas, err := c.ethClient.EstimateGas(ctx, msg)
+	if err != nil {
+		return 0, 0, fmt.Errorf("failed to estimate gas: %w", err)
+	}
+	gasPrice, err := c.ethClient.SuggestGasPrice(ctx)
+	if err != nil {
+		return 0, 0, fmt.Errorf("failed to get gas price: %w", err)
+	}
+	price, _ := new(big.Float).SetInt(gasPrice).Float64()
+	return gas, price, nil
+}
+
+// callMsg returns the call simulating a signed transaction. The gas limit of the transaction is
+// kept, so that estimating a transaction that would run out of gas fails.
+func callMsg(tx *types.Transaction) (ethereum.CallMsg, error) {
+	from, err := types.Sender(types.LatestSignerForChainID(tx.ChainId()), tx)
+	if err != nil {
+		return ethereum.CallMsg{}, fmt.Errorf("failed to recover transaction sender: %w", err)
+	}
+	msg := ethereum.CallMsg{
+		From:       from,
+		To:         tx.To(),
+		Gas:        tx.Gas(),
+		Value:      tx.Value(),
+		Data:       tx.Data(),
+		AccessList: tx.AccessList(),
+
+		BlobGasFeeCap:     tx.BlobGasFeeCap(),
+		BlobHashes:        tx.BlobHashes(),
+		AuthorizationList: tx.SetCodeAuthorizations(),
+	}
+	// gas price and fee caps are mutually exclusive in calls
+	if tx.Type() == types.LegacyTxType || tx.Type() == types.AccessListTxType {
+		msg.GasPrice = tx.GasPrice()
+	} else {
+		msg.GasFeeCap = tx.GasFeeCap()
+		msg.GasTipCap = tx.GasTipCap()
+	}
+	return msg, nil
+}
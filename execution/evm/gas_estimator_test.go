@@ -0,0 +1,45 @@
+package evm
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCallMsg(t *testing.T) {
+	key, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	from := crypto.PubkeyToAddress(key.PublicKey)
+	signer := types.LatestSignerForChainID(big.NewInt(1))
+	to := common.HexToAddress("0x1000000000000000000000000000000000000001")
+
+	legacy := types.MustSignNewTx(key, signer, &types.LegacyTx{To: &to, Gas: 21_000, GasPrice: big.NewInt(7), Value: big.NewInt(3)})
+	msg, err := callMsg(legacy)
+	require.NoError(t, err)
+	assert.Equal(t, from, msg.From)
+	assert.Equal(t, &to, msg.To)
+	assert.Equal(t, uint64(21_000), msg.Gas)
+	assert.Equal(t, big.NewInt(7), msg.GasPrice)
+	assert.Nil(t, msg.GasFeeCap)
+	assert.Equal(t, big.NewInt(3), msg.Value)
+
+	// dynamic fee transactions are simulated with their fee caps only
+	dynamic := types.MustSignNewTx(key, signer, &types.DynamicFeeTx{ChainID: big.NewInt(1), Gas: 100_000, GasFeeCap: big.NewInt(9), GasTipCap: big.NewInt(2), Data: []byte{1}})
+	msg, err = callMsg(dynamic)
+	require.NoError(t, err)
+	assert.Equal(t, from, msg.From)
+	assert.Nil(t, msg.To)
+	assert.Nil(t, msg.GasPrice)
+	assert.Equal(t, big.NewInt(9), msg.GasFeeCap)
+	assert.Equal(t, big.NewInt(2), msg.GasTipCap)
+	assert.Equal(t, []byte{1}, msg.Data)
+
+	// unsigned transactions have no sender
+	_, err = callMsg(types.NewTx(&types.LegacyTx{To: &to, Gas: 21_000, GasPrice: big.NewInt(7)}))
+	assert.Error(t, err)
+}
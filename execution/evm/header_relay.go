@@ -0,0 +1,271 @@
+package evm
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"errors"
+	"fmt"
+	"math/big"
+	"strings"
+	"time"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/ethclient"
+)
+
+// LightClientABI is the ABI of the light client contract functions called by the header relay:
+// submitHeaders accepts protobuf encoded signed headers of consecutive heights, and latestHeight
+// returns the height of the last accepted header.
+const LightClientABI = `[
+	{"type":"function","name":"submitHeaders","stateMutability":"nonpayable","inputs":[{"name":"headers","type":"bytes[]"}],"outputs":[]},
+	{"type":"function","name":"latestHeight","stateMutability":"view","inputs":[],"outputs":[{"name":"","type":"uint64"}]}
+]`
+
+const (
+	// defaultGasMultiplier is the default multiplier applied to the estimated gas of a submission
+	defaultGasMultiplier = 1.2
+	// defaultResubmitInterval is the default time after which a pending submission is replaced
+	defaultResubmitInterval = time.Minute
+	// defaultReceiptPollInterval is the default interval at which the receipt of a submission is checked
+	defaultReceiptPollInterval = time.Second
+	// feeBumpPercent is the fee of a replacement transaction in percent of the replaced one,
+	// above the 110% required by geth
+	feeBumpPercent = 125
+)
+
+// ErrHeaderSubmissionReverted indicates that the light client contract rejected submitted headers
+var ErrHeaderSubmissionReverted = errors.New("header submission reverted")
+
+// relayClient is the subset of the Ethereum JSON-RPC API used by the header relay.
+type relayClient interface {
+	ChainID(ctx context.Context) (*big.Int, error)
+	HeaderByNumber(ctx context.Context, number *big.Int) (*types.Header, error)
+	SuggestGasTipCap(ctx context.Context) (*big.Int, error)
+	PendingNonceAt(ctx context.Context, account common.Address) (uint64, error)
+	EstimateGas(ctx context.Context, msg ethereum.CallMsg) (uint64, error)
+	CallContract(ctx context.Context, msg ethereum.CallMsg, blockNumber *big.Int) ([]byte, error)
+	SendTransaction(ctx context.Context, tx *types.Transaction) error
+	TransactionReceipt(ctx context.Context, txHash common.Hash) (*types.Receipt, error)
+}
+
+// HeaderRelayConfig is the configuration of a HeaderRelaySubmitter.
+type HeaderRelayConfig struct {
+	// Contract is the address of the light client contract
+	Contract common.Address
+	// GasMultiplier is applied to the estimated gas of a submission, 0 for the default
+	GasMultiplier float64
+	// MaxFeePerGas caps the fee per gas paid for a submission, nil for no cap
+	MaxFeePerGas *big.Int
+	// ResubmitInterval is the time after which a pending submission is replaced with higher fees,
+	// 0 for the default
+	ResubmitInterval time.Duration
+	// ReceiptPollInterval is the interval at which the receipt of a submission is checked,
+	// 0 for the default
+	ReceiptPollInterval time.Duration
+}
+
+// HeaderRelaySubmitter submits headers to a light client contract on an EVM chain,
+// implementing the relayer.Submitter interface of ev-node.
+//
+// Submissions are EIP-1559 transactions whose fees follow the base fee of the chain, capped by
+// MaxFeePerGas. A submission still pending after ResubmitInterval is replaced by a transaction
+// with the same nonce and fees increased by 25%, as long as the cap allows it.
+type HeaderRelaySubmitter struct {
+	client relayClient
+	key    *ecdsa.PrivateKey
+	from   common.Address
+	signer types.Signer
+	abi    abi.ABI
+	config HeaderRelayConfig
+}
+
+// NewHeaderRelaySubmitter creates a HeaderRelaySubmitter sending transactions signed with key
+// through the Ethereum JSON-RPC endpoint at ethURL.
+func NewHeaderRelaySubmitter(ctx context.Context, ethURL string, key *ecdsa.PrivateKey, config HeaderRelayConfig) (*HeaderRelaySubmitter, error) {
+	client, err := ethclient.DialContext(ctx, ethURL)
+	if err != nil {
+		return nil, err
+	}
+	return newHeaderRelaySubmitter(ctx, client, key, config)
+}
+
+func newHeaderRelaySubmitter(ctx context.Context, client relayClient, key *ecdsa.PrivateKey, config HeaderRelayConfig) (*HeaderRelaySubmitter, error) {
+	parsed, err := abi.JSON(strings.NewReader(LightClientABI))
+	if err != nil {
+		return nil, err
+	}
+	chainID, err := client.ChainID(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get chain ID: %w", err)
+	}
+	if config.GasMultiplier <= 0 {
+		config.GasMultiplier = defaultGasMultiplier
+	}
+	if config.ResubmitInterval <= 0 {
+		config.ResubmitInterval = defaultResubmitInterval
+	}
+	if config.ReceiptPollInterval <= 0 {
+		config.ReceiptPollInterval = defaultReceiptPollInterval
+	}
+	return &HeaderRelaySubmitter{
+		client: client,
+		key:    key,
+		from:   crypto.PubkeyToAddress(key.PublicKey),
+		signer: types.LatestSignerForChainID(chainID),
+		abi:    parsed,
+		config: config,
+	}, nil
+}
+
+// LatestHeight returns the height of the last header accepted by the light client contract.
+func (s *HeaderRelaySubmitter) LatestHeight(ctx context.Context) (uint64, error) {
+	data, err := s.abi.Pack("latestHeight")
+	if err != nil {
+		return 0, err
+	}
+	out, err := s.client.CallContract(ctx, ethereum.CallMsg{From: s.from, To: &s.config.Contract, Data: data}, nil)
+	if err != nil {
+		return 0, err
+	}
+	values, err := s.abi.Unpack("latestHeight", out)
+	if err != nil {
+		return 0, fmt.Errorf("failed to decode latest height: %w", err)
+	}
+	return values[0].(uint64), nil
+}
+
+// SubmitHeaders submits headers to the light client contract and waits until the submission
+// is included.
+func (s *HeaderRelaySubmitter) SubmitHeaders(ctx context.Context, headers [][]byte) error {
+	data, err := s.abi.Pack("submitHeaders", headers)
+	if err != nil {
+		return err
+	}
+	nonce, err := s.client.PendingNonceAt(ctx, s.from)
+	if err != nil {
+		return fmt.Errorf("failed to get nonce: %w", err)
+	}
+	gas, err := s.client.EstimateGas(ctx, ethereum.CallMsg{From: s.from, To: &s.config.Contract, Data: data})
+	if err != nil {
+		return fmt.Errorf("failed to estimate gas: %w", err)
+	}
+	gas = uint64(float64(gas) * s.config.GasMultiplier)
+	tipCap, feeCap, err := s.fees(ctx)
+	if err != nil {
+		return err
+	}
+
+	var sent []common.Hash
+	for send := true; ; {
+		if send {
+			hash, err := s.send(ctx, nonce, gas, tipCap, feeCap, data)
+			if err != nil {
+				return err
+			}
+			sent = append(sent, hash)
+		}
+
+		// any of the sent transactions may be included, as they share the nonce
+		receipt, err := s.waitReceipt(ctx, sent)
+		if err != nil {
+			return err
+		}
+		if receipt != nil {
+			if receipt.Status != types.ReceiptStatusSuccessful {
+				return fmt.Errorf("%w: transaction %s", ErrHeaderSubmissionReverted, receipt.TxHash)
+			}
+			return nil
+		}
+
+		// a replacement exceeding the fee cap is not sent, the pending transaction is waited for
+		bumpedTip, bumpedFee := bumpFee(tipCap), bumpFee(feeCap)
+		send = s.config.MaxFeePerGas == nil || bumpedFee.Cmp(s.config.MaxFeePerGas) <= 0
+		if send {
+			tipCap, feeCap = bumpedTip, bumpedFee
+		}
+	}
+}
+
+// send signs and sends a submission transaction, and returns its hash.
+func (s *HeaderRelaySubmitter) send(ctx context.Context, nonce, gas uint64, tipCap, feeCap *big.Int, data []byte) (common.Hash, error) {
+	tx, err := types.SignNewTx(s.key, s.signer, &types.DynamicFeeTx{
+		ChainID:   s.signer.ChainID(),
+		Nonce:     nonce,
+		GasTipCap: tipCap,
+		GasFeeCap: feeCap,
+		Gas:       gas,
+		To:        &s.config.Contract,
+		Data:      data,
+	})
+	if err != nil {
+		return common.Hash{}, err
+	}
+	if err := s.client.SendTransaction(ctx, tx); err != nil {
+		return common.Hash{}, fmt.Errorf("failed to send transaction: %w", err)
+	}
+	return tx.Hash(), nil
+}
+
+// fees returns the tip and fee caps of a new submission: the suggested tip on top of twice the
+// current base fee, so that the submission remains valid while the base fee increases.
+func (s *HeaderRelaySubmitter) fees(ctx context.Context) (*big.Int, *big.Int, error) {
+	tipCap, err := s.client.SuggestGasTipCap(ctx)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to suggest gas tip: %w", err)
+	}
+	head, err := s.client.HeaderByNumber(ctx, nil)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to get latest header: %w", err)
+	}
+	feeCap := new(big.Int).Set(tipCap)
+	if head.BaseFee != nil {
+		feeCap.Add(feeCap, new(big.Int).Mul(head.BaseFee, big.NewInt(2)))
+	}
+	if maxFee := s.config.MaxFeePerGas; maxFee != nil && feeCap.Cmp(maxFee) > 0 {
+		feeCap = new(big.Int).Set(maxFee)
+		if tipCap.Cmp(feeCap) > 0 {
+			tipCap = new(big.Int).Set(feeCap)
+		}
+	}
+	return tipCap, feeCap, nil
+}
+
+// waitReceipt waits for the receipt of any of the transactions for ResubmitInterval,
+// and returns nil if none is included by then.
+func (s *HeaderRelaySubmitter) waitReceipt(ctx context.Context, hashes []common.Hash) (*types.Receipt, error) {
+	ticker := time.NewTicker(s.config.ReceiptPollInterval)
+	defer ticker.Stop()
+	deadline := time.After(s.config.ResubmitInterval)
+	for {
+		for _, hash := range hashes {
+			receipt, err := s.client.TransactionReceipt(ctx, hash)
+			if err == nil {
+				return receipt, nil
+			}
+			if !errors.Is(err, ethereum.NotFound) {
+				return nil, fmt.Errorf("failed to get receipt of transaction %s: %w", hash, err)
+			}
+		}
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-deadline:
+			return nil, nil
+		case <-ticker.C:
+		}
+	}
+}
+
+// bumpFee returns the fee increased by feeBumpPercent.
+func bumpFee(fee *big.Int) *big.Int {
+	bumped := new(big.Int).Mul(fee, big.NewInt(feeBumpPercent))
+	bumped.Div(bumped, big.NewInt(100))
+	if bumped.Cmp(fee) <= 0 {
+		bumped.Add(fee, big.NewInt(1))
+	}
+	return bumped
+}
@@ -0,0 +1,127 @@
+package evm
+
+import (
+	"context"
+	"math/big"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// lightClientChain is an EVM chain including the n-th transaction sent to it.
+type lightClientChain struct {
+	mu        sync.Mutex
+	includeAt int
+	status    uint64
+	latest    uint64
+	sent      []*types.Transaction
+}
+
+func (c *lightClientChain) ChainID(context.Context) (*big.Int, error) {
+	return big.NewInt(1), nil
+}
+
+func (c *lightClientChain) HeaderByNumber(context.Context, *big.Int) (*types.Header, error) {
+	return &types.Header{BaseFee: big.NewInt(100)}, nil
+}
+
+func (c *lightClientChain) SuggestGasTipCap(context.Context) (*big.Int, error) {
+	return big.NewInt(10), nil
+}
+
+func (c *lightClientChain) PendingNonceAt(context.Context, common.Address) (uint64, error) {
+	return 7, nil
+}
+
+func (c *lightClientChain) EstimateGas(context.Context, ethereum.CallMsg) (uint64, error) {
+	return 100_000, nil
+}
+
+func (c *lightClientChain) CallContract(context.Context, ethereum.CallMsg, *big.Int) ([]byte, error) {
+	return common.LeftPadBytes(new(big.Int).SetUint64(c.latest).Bytes(), 32), nil
+}
+
+func (c *lightClientChain) SendTransaction(_ context.Context, tx *types.Transaction) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.sent = append(c.sent, tx)
+	return nil
+}
+
+func (c *lightClientChain) TransactionReceipt(_ context.Context, hash common.Hash) (*types.Receipt, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if len(c.sent) < c.includeAt || c.sent[c.includeAt-1].Hash() != hash {
+		return nil, ethereum.NotFound
+	}
+	return &types.Receipt{TxHash: hash, Status: c.status}, nil
+}
+
+func newTestHeaderRelaySubmitter(t *testing.T, chain *lightClientChain, maxFee *big.Int) *HeaderRelaySubmitter {
+	t.Helper()
+	key, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	s, err := newHeaderRelaySubmitter(context.Background(), chain, key, HeaderRelayConfig{
+		Contract:            common.HexToAddress("0x1234"),
+		MaxFeePerGas:        maxFee,
+		ResubmitInterval:    20 * time.Millisecond,
+		ReceiptPollInterval: time.Millisecond,
+	})
+	require.NoError(t, err)
+	return s
+}
+
+func TestHeaderRelaySubmitter_LatestHeight(t *testing.T) {
+	s := newTestHeaderRelaySubmitter(t, &lightClientChain{latest: 42}, nil)
+	height, err := s.LatestHeight(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, uint64(42), height)
+}
+
+func TestHeaderRelaySubmitter_SubmitHeaders(t *testing.T) {
+	chain := &lightClientChain{includeAt: 2, status: types.ReceiptStatusSuccessful}
+	s := newTestHeaderRelaySubmitter(t, chain, nil)
+	headers := [][]byte{[]byte("header 1"), []byte("header 2")}
+
+	require.NoError(t, s.SubmitHeaders(context.Background(), headers))
+
+	// the pending submission was replaced with higher fees and the same nonce
+	require.Len(t, chain.sent, 2)
+	first, replacement := chain.sent[0], chain.sent[1]
+	assert.Equal(t, uint64(7), first.Nonce())
+	assert.Equal(t, uint64(7), replacement.Nonce())
+	assert.Equal(t, uint64(120_000), first.Gas())
+	assert.Equal(t, big.NewInt(10), first.GasTipCap())
+	assert.Equal(t, big.NewInt(210), first.GasFeeCap())
+	assert.Equal(t, big.NewInt(12), replacement.GasTipCap())
+	assert.Equal(t, big.NewInt(262), replacement.GasFeeCap())
+
+	values, err := s.abi.Methods["submitHeaders"].Inputs.Unpack(first.Data()[4:])
+	require.NoError(t, err)
+	assert.Equal(t, headers, values[0])
+}
+
+func TestHeaderRelaySubmitter_FeeCap(t *testing.T) {
+	chain := &lightClientChain{includeAt: 2, status: types.ReceiptStatusSuccessful}
+	s := newTestHeaderRelaySubmitter(t, chain, big.NewInt(150))
+
+	// the fee cap is capped, and no replacement above the cap is sent
+	ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancel()
+	require.ErrorIs(t, s.SubmitHeaders(ctx, [][]byte{[]byte("header")}), context.DeadlineExceeded)
+	require.Len(t, chain.sent, 1)
+	assert.Equal(t, big.NewInt(150), chain.sent[0].GasFeeCap())
+}
+
+func TestHeaderRelaySubmitter_Reverted(t *testing.T) {
+	chain := &lightClientChain{includeAt: 1, status: types.ReceiptStatusFailed}
+	s := newTestHeaderRelaySubmitter(t, chain, nil)
+	require.ErrorIs(t, s.SubmitHeaders(context.Background(), [][]byte{[]byte("header")}), ErrHeaderSubmissionReverted)
+}
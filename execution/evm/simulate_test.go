@@ -0,0 +1,174 @@
+package evm
+
+import (
+	"context"
+	"encoding/json"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/beacon/engine"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/ethclient"
+	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeEngine serves the eth_getBlockByNumber method and the Engine API methods used to build and
+// import blocks, recording the forkchoice updates and the imported payloads.
+type fakeEngine struct {
+	headers   []*types.Header
+	noPayload bool
+	// services are additional RPC services served by name, e.g. debug
+	services map[string]any
+
+	forkchoices []engine.ForkchoiceStateV1
+	attributes  []bool
+	imported    int
+}
+
+func newFakeEngine(height uint64) *fakeEngine {
+	e := &fakeEngine{}
+	parent := common.Hash{}
+	for i := uint64(0); i <= height; i++ {
+		header := &types.Header{
+			ParentHash: parent,
+			Number:     new(big.Int).SetUint64(i),
+			Difficulty: big.NewInt(0),
+			GasLimit:   30_000_000,
+			Root:       common.BigToHash(new(big.Int).SetUint64(i + 1)),
+		}
+		e.headers = append(e.headers, header)
+		parent = header.Hash()
+	}
+	return e
+}
+
+type fakeEth struct{ *fakeEngine }
+
+func (e fakeEth) GetBlockByNumber(number rpc.BlockNumber, _ bool) (*types.Header, error) {
+	if number == rpc.LatestBlockNumber {
+		return e.headers[len(e.headers)-1], nil
+	}
+	if number < 0 || int(number) >= len(e.headers) {
+		return nil, nil
+	}
+	return e.headers[number], nil
+}
+
+type fakeEngineAPI struct{ *fakeEngine }
+
+func (e fakeEngineAPI) ForkchoiceUpdatedV3(args engine.ForkchoiceStateV1, attributes map[string]any) (engine.ForkChoiceResponse, error) {
+	e.forkchoices = append(e.forkchoices, args)
+	e.attributes = append(e.attributes, attributes != nil)
+	resp := engine.ForkChoiceResponse{PayloadStatus: engine.PayloadStatusV1{Status: engine.VALID}}
+	if attributes != nil && !e.noPayload {
+		resp.PayloadID = &engine.PayloadID{1}
+	}
+	return resp, nil
+}
+
+func (e fakeEngineAPI) GetPayloadV4(engine.PayloadID) (*engine.ExecutionPayloadEnvelope, error) {
+	return &engine.ExecutionPayloadEnvelope{
+		ExecutionPayload: &engine.ExecutableData{
+			StateRoot:     common.HexToHash("0x5157"),
+			BlockHash:     common.HexToHash("0xb10c"),
+			GasUsed:       21_000,
+			LogsBloom:     make([]byte, types.BloomByteLength),
+			ExtraData:     []byte{},
+			BaseFeePerGas: big.NewInt(1),
+			Transactions:  [][]byte{},
+		},
+		BlockValue: big.NewInt(0),
+	}, nil
+}
+
+func (e fakeEngineAPI) NewPayloadV4(json.RawMessage, []string, string, []json.RawMessage) (engine.PayloadStatusV1, error) {
+	e.imported++
+	return engine.PayloadStatusV1{Status: engine.VALID}, nil
+}
+
+func newFakeEngineClient(t *testing.T, e *fakeEngine) *EngineClient {
+	t.Helper()
+	server := rpc.NewServer()
+	require.NoError(t, server.RegisterName("eth", fakeEth{e}))
+	require.NoError(t, server.RegisterName("engine", fakeEngineAPI{e}))
+	for name, service := range e.services {
+		require.NoError(t, server.RegisterName(name, service))
+	}
+	t.Cleanup(server.Stop)
+	genesisHash := e.headers[0].Hash()
+	return &EngineClient{
+		engineClient:              rpc.DialInProc(server),
+		ethClient:                 ethclient.NewClient(rpc.DialInProc(server)),
+		genesisHash:               genesisHash,
+		currentHeadBlockHash:      genesisHash,
+		currentSafeBlockHash:      genesisHash,
+		currentFinalizedBlockHash: genesisHash,
+	}
+}
+
+func TestSimulateTxs(t *testing.T) {
+	ctx := context.Background()
+	timestamp := time.Unix(1_700_000_000, 0)
+
+	t.Run("next block", func(t *testing.T) {
+		e := newFakeEngine(2)
+		client := newFakeEngineClient(t, e)
+
+		root, err := client.SimulateTxs(ctx, nil, 3, timestamp, nil)
+		require.NoError(t, err)
+		assert.Equal(t, common.HexToHash("0x5157").Bytes(), root)
+
+		// the payload is built on top of the head, which is left untouched
+		require.Len(t, e.forkchoices, 1)
+		assert.True(t, e.attributes[0])
+		assert.Equal(t, e.headers[2].Hash(), e.forkchoices[0].HeadBlockHash)
+		assert.Zero(t, e.imported)
+	})
+
+	t.Run("block below the head", func(t *testing.T) {
+		e := newFakeEngine(2)
+		client := newFakeEngineClient(t, e)
+
+		root, err := client.SimulateTxs(ctx, nil, 2, timestamp, nil)
+		require.NoError(t, err)
+		assert.Equal(t, common.HexToHash("0x5157").Bytes(), root)
+
+		// the forkchoice is pointed back at the head once the payload is built
+		require.Len(t, e.forkchoices, 2)
+		assert.Equal(t, e.headers[1].Hash(), e.forkchoices[0].HeadBlockHash)
+		assert.False(t, e.attributes[1])
+		assert.Equal(t, e.headers[2].Hash(), e.forkchoices[1].HeadBlockHash)
+		assert.Equal(t, e.headers[2].Hash(), e.forkchoices[1].SafeBlockHash)
+		assert.Zero(t, e.imported)
+	})
+
+	t.Run("payload not built", func(t *testing.T) {
+		e := newFakeEngine(2)
+		e.noPayload = true
+		client := newFakeEngineClient(t, e)
+
+		_, err := client.SimulateTxs(ctx, nil, 2, timestamp, nil)
+		require.ErrorIs(t, err, ErrNilPayloadStatus)
+
+		// the forkchoice is restored even if the payload was not built
+		require.Len(t, e.forkchoices, 2)
+		assert.Equal(t, e.headers[2].Hash(), e.forkchoices[1].HeadBlockHash)
+	})
+
+	t.Run("execution imports the block", func(t *testing.T) {
+		e := newFakeEngine(2)
+		client := newFakeEngineClient(t, e)
+
+		root, gasUsed, err := client.ExecuteTxs(ctx, nil, 3, timestamp, nil)
+		require.NoError(t, err)
+		assert.Equal(t, common.HexToHash("0x5157").Bytes(), root)
+		assert.Equal(t, uint64(21_000), gasUsed)
+		assert.Equal(t, 1, e.imported)
+		require.Len(t, e.forkchoices, 2)
+		assert.Equal(t, common.HexToHash("0xb10c"), e.forkchoices[1].HeadBlockHash)
+	})
+}
@@ -0,0 +1,138 @@
+package evm
+
+import (
+	"bytes"
+	"context"
+	"encoding/binary"
+	"fmt"
+	"slices"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+
+	"github.com/evstack/ev-node/core/execution"
+)
+
+// Fields of an account in the keys of the state diffs, appended to the address of the account.
+const (
+	StateKeyBalance byte = 0x01
+	StateKeyNonce   byte = 0x02
+	StateKeyCode    byte = 0x03
+	// StateKeyStorage is followed by the 32 bytes storage slot.
+	StateKeyStorage byte = 0x04
+)
+
+var _ execution.StateDiffProvider = (*StateDiffClient)(nil)
+
+// StateDiffClient is an EngineClient which also reports the state diffs of its blocks, traced by
+// the execution client with the prestateTracer in diff mode. Tracing every block is costly and
+// requires the debug namespace of the Ethereum JSON-RPC endpoint, so state diffs are opt-in: wrap
+// the EngineClient in a StateDiffClient to enable them.
+//
+// The key of a change is the 20 bytes address of an account followed by the field changed,
+// StateKeyBalance, StateKeyNonce, StateKeyCode, or StateKeyStorage and the storage slot. Values are
+// the 32 bytes big-endian balance, the 8 bytes big-endian nonce, the code and the 32 bytes storage
+// value. Cleared storage slots and removed accounts are deleted. Only the changes made by the
+// transactions of a block are reported, not those of the system calls of the execution layer, e.g.
+// to the beacon root contract of EIP-4788.
+type StateDiffClient struct {
+	*EngineClient
+}
+
+// prestateAccount is an account in the result of the prestateTracer. In diff mode, the post state
+// only holds the fields changed by the transaction, and storage slots cleared by the transaction
+// are only in the pre state.
+type prestateAccount struct {
+	Balance *hexutil.Big                `json:"balance,omitempty"`
+	Nonce   *uint64                     `json:"nonce,omitempty"`
+	Code    *hexutil.Bytes              `json:"code,omitempty"`
+	Storage map[common.Hash]common.Hash `json:"storage,omitempty"`
+}
+
+// prestateTxResult is the result of the prestateTracer for a transaction of a block.
+type prestateTxResult struct {
+	TxHash common.Hash `json:"txHash"`
+	Result struct {
+		Pre  map[common.Address]prestateAccount `json:"pre"`
+		Post map[common.Address]prestateAccount `json:"post"`
+	} `json:"result"`
+	Error string `json:"error,omitempty"`
+}
+
+// GetStateDiff implements execution.StateDiffProvider.
+func (c *StateDiffClient) GetStateDiff(ctx context.Context, blockHeight uint64) ([]execution.StateChange, error) {
+	header, err := c.getHeader(ctx, blockHeight)
+	if err != nil {
+		return nil, err
+	}
+	var results []prestateTxResult
+	err = c.ethClient.Client().CallContext(ctx, &results, "debug_traceBlockByHash", header.Hash(), map[string]any{
+		"tracer":       "prestateTracer",
+		"tracerConfig": map[string]any{"diffMode": true},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to trace block %d: %w", blockHeight, err)
+	}
+	return stateDiff(results)
+}
+
+// stateDiff merges the state changes of the transactions of a block into the changes of the
+// block, sorted by key.
+func stateDiff(results []prestateTxResult) ([]execution.StateChange, error) {
+	changes := make(map[string]execution.StateChange)
+	set := func(key []byte, value []byte) {
+		changes[string(key)] = execution.StateChange{Key: key, Value: value, Deleted: len(value) == 0}
+	}
+	for _, result := range results {
+		if result.Error != "" {
+			return nil, fmt.Errorf("failed to trace transaction %s: %s", result.TxHash, result.Error)
+		}
+		for addr, pre := range result.Result.Pre {
+			post, ok := result.Result.Post[addr]
+			if !ok {
+				// the account was removed, e.g. self-destructed
+				set(stateKey(addr, StateKeyBalance), nil)
+				set(stateKey(addr, StateKeyNonce), nil)
+				set(stateKey(addr, StateKeyCode), nil)
+			}
+			for slot := range pre.Storage {
+				if _, ok := post.Storage[slot]; !ok {
+					set(storageKey(addr, slot), nil)
+				}
+			}
+		}
+		for addr, post := range result.Result.Post {
+			if post.Balance != nil {
+				set(stateKey(addr, StateKeyBalance), common.BigToHash(post.Balance.ToInt()).Bytes())
+			}
+			if post.Nonce != nil {
+				set(stateKey(addr, StateKeyNonce), binary.BigEndian.AppendUint64(nil, *post.Nonce))
+			}
+			if post.Code != nil {
+				set(stateKey(addr, StateKeyCode), *post.Code)
+			}
+			for slot, value := range post.Storage {
+				if value == (common.Hash{}) {
+					set(storageKey(addr, slot), nil)
+					continue
+				}
+				set(storageKey(addr, slot), value.Bytes())
+			}
+		}
+	}
+
+	diff := make([]execution.StateChange, 0, len(changes))
+	for _, change := range changes {
+		diff = append(diff, change)
+	}
+	slices.SortFunc(diff, func(a, b execution.StateChange) int { return bytes.Compare(a.Key, b.Key) })
+	return diff, nil
+}
+
+func stateKey(addr common.Address, field byte) []byte {
+	return append(addr.Bytes(), field)
+}
+
+func storageKey(addr common.Address, slot common.Hash) []byte {
+	return append(stateKey(addr, StateKeyStorage), slot.Bytes()...)
+}
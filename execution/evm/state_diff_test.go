@@ -0,0 +1,90 @@
+package evm
+
+import (
+	"context"
+	"encoding/binary"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/evstack/ev-node/core/execution"
+)
+
+type fakeDebug struct {
+	results []prestateTxResult
+	traced  []common.Hash
+}
+
+func (d *fakeDebug) TraceBlockByHash(hash common.Hash, config map[string]any) ([]prestateTxResult, error) {
+	d.traced = append(d.traced, hash)
+	return d.results, nil
+}
+
+// prestateResult returns the result of a traced transaction with the given pre and post states.
+func prestateResult(pre, post map[common.Address]prestateAccount) prestateTxResult {
+	var result prestateTxResult
+	result.Result.Pre, result.Result.Post = pre, post
+	return result
+}
+
+func TestStateDiff(t *testing.T) {
+	sender, contract, destroyed := common.HexToAddress("0x1"), common.HexToAddress("0x2"), common.HexToAddress("0x3")
+	slotA, slotB := common.HexToHash("0xa"), common.HexToHash("0xb")
+	nonce := uint64(2)
+	code := hexutil.Bytes{0x60, 0x00}
+
+	results := []prestateTxResult{
+		prestateResult(map[common.Address]prestateAccount{
+			sender:   {Balance: (*hexutil.Big)(big.NewInt(100))},
+			contract: {Storage: map[common.Hash]common.Hash{slotA: common.HexToHash("0x1"), slotB: common.HexToHash("0x2")}},
+		}, map[common.Address]prestateAccount{
+			sender:   {Balance: (*hexutil.Big)(big.NewInt(90)), Nonce: &nonce},
+			contract: {Code: &code, Storage: map[common.Hash]common.Hash{slotA: common.HexToHash("0x5")}},
+		}),
+		// a later transaction overrides the changes of the earlier ones
+		prestateResult(map[common.Address]prestateAccount{
+			sender:    {Balance: (*hexutil.Big)(big.NewInt(90))},
+			destroyed: {Balance: (*hexutil.Big)(big.NewInt(1))},
+		}, map[common.Address]prestateAccount{
+			sender: {Balance: (*hexutil.Big)(big.NewInt(80))},
+		}),
+	}
+
+	diff, err := stateDiff(results)
+	require.NoError(t, err)
+	assert.Equal(t, []execution.StateChange{
+		{Key: stateKey(sender, StateKeyBalance), Value: common.BigToHash(big.NewInt(80)).Bytes()},
+		{Key: stateKey(sender, StateKeyNonce), Value: binary.BigEndian.AppendUint64(nil, 2)},
+		{Key: stateKey(contract, StateKeyCode), Value: code},
+		{Key: storageKey(contract, slotA), Value: common.HexToHash("0x5").Bytes()},
+		// the slot cleared by the transaction is only in the pre state
+		{Key: storageKey(contract, slotB), Deleted: true},
+		{Key: stateKey(destroyed, StateKeyBalance), Deleted: true},
+		{Key: stateKey(destroyed, StateKeyNonce), Deleted: true},
+		{Key: stateKey(destroyed, StateKeyCode), Deleted: true},
+	}, diff)
+
+	failed := prestateTxResult{Error: "execution timeout"}
+	_, err = stateDiff([]prestateTxResult{failed})
+	require.Error(t, err)
+}
+
+func TestGetStateDiff(t *testing.T) {
+	e := newFakeEngine(2)
+	debug := &fakeDebug{results: []prestateTxResult{prestateResult(
+		map[common.Address]prestateAccount{},
+		map[common.Address]prestateAccount{common.HexToAddress("0x1"): {Balance: (*hexutil.Big)(big.NewInt(1))}},
+	)}}
+	e.services = map[string]any{"debug": debug}
+	client := newFakeEngineClient(t, e)
+
+	diff, err := (&StateDiffClient{client}).GetStateDiff(context.Background(), 2)
+	require.NoError(t, err)
+	require.Len(t, diff, 1)
+	assert.Equal(t, stateKey(common.HexToAddress("0x1"), StateKeyBalance), diff[0].Key)
+	assert.Equal(t, []common.Hash{e.headers[2].Hash()}, debug.traced)
+}
@@ -0,0 +1,92 @@
+package evm
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+
+	"github.com/evstack/ev-node/core/execution"
+)
+
+// SystemCallABI is the ABI of the event emitted by the system contract to request a system call of
+// the node, e.g. a halt for an upgrade decided by on-chain governance.
+const SystemCallABI = `[
+	{"type":"event","name":"SystemCall","anonymous":false,"inputs":[
+		{"name":"callType","type":"string","indexed":false},
+		{"name":"activationHeight","type":"uint64","indexed":false},
+		{"name":"value","type":"string","indexed":false}
+	]}
+]`
+
+var systemCallEvent = func() abi.Event {
+	parsed, err := abi.JSON(strings.NewReader(SystemCallABI))
+	if err != nil {
+		panic(err)
+	}
+	return parsed.Events["SystemCall"]
+}()
+
+var _ execution.SystemCallProvider = (*EngineClient)(nil)
+
+// SetSystemContract sets the contract whose SystemCall events request system calls of the node,
+// typically taken from the system_calls section of the genesis. Without it, no system call is
+// requested. It must be called before the client is used.
+func (c *EngineClient) SetSystemContract(contract common.Address) {
+	c.systemContract = contract
+}
+
+// GetSystemCalls implements execution.SystemCallProvider. The system calls requested by a block
+// are the SystemCall events emitted by the system contract in the block. Malformed events are
+// skipped.
+func (c *EngineClient) GetSystemCalls(ctx context.Context, blockHeight uint64) ([]execution.SystemCall, error) {
+	if c.systemContract == (common.Address{}) {
+		return nil, nil
+	}
+	header, err := c.getHeader(ctx, blockHeight)
+	if err != nil {
+		return nil, err
+	}
+	hash := header.Hash()
+	logs, err := c.ethClient.FilterLogs(ctx, ethereum.FilterQuery{
+		BlockHash: &hash,
+		Addresses: []common.Address{c.systemContract},
+		Topics:    [][]common.Hash{{systemCallEvent.ID}},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get system call events of block %d: %w", blockHeight, err)
+	}
+
+	var calls []execution.SystemCall
+	for _, log := range logs {
+		if log.Removed {
+			continue
+		}
+		call, err := decodeSystemCall(log)
+		if err != nil {
+			continue
+		}
+		calls = append(calls, call)
+	}
+	return calls, nil
+}
+
+// decodeSystemCall decodes a SystemCall event.
+func decodeSystemCall(log types.Log) (execution.SystemCall, error) {
+	values, err := systemCallEvent.Inputs.Unpack(log.Data)
+	if err != nil {
+		return execution.SystemCall{}, fmt.Errorf("failed to decode system call event: %w", err)
+	}
+	callType, _ := values[0].(string)
+	activationHeight, _ := values[1].(uint64)
+	value, _ := values[2].(string)
+	return execution.SystemCall{
+		Type:             callType,
+		ActivationHeight: activationHeight,
+		Value:            value,
+	}, nil
+}
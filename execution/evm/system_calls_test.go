@@ -0,0 +1,22 @@
+package evm
+
+import (
+	"testing"
+
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/evstack/ev-node/core/execution"
+)
+
+func TestDecodeSystemCall(t *testing.T) {
+	data, err := systemCallEvent.Inputs.Pack("halt", uint64(100), "v2")
+	require.NoError(t, err)
+
+	call, err := decodeSystemCall(types.Log{Data: data})
+	require.NoError(t, err)
+	require.Equal(t, execution.SystemCall{Type: execution.SystemCallHalt, ActivationHeight: 100, Value: "v2"}, call)
+
+	_, err = decodeSystemCall(types.Log{Data: []byte("malformed")})
+	require.Error(t, err)
+}
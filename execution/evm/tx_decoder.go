@@ -0,0 +1,37 @@
+package evm
+
+import (
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/core/types"
+
+	"github.com/evstack/ev-node/core/execution"
+)
+
+// methodSelectorLength is the length of the selector prefixing the calldata of a contract call.
+const methodSelectorLength = 4
+
+var _ execution.TxDecoder = TxDecoder{}
+
+// TxDecoder is the default execution.TxDecoder of EVM chains. It decodes the transaction types
+// supported by go-ethereum, and returns an error for the other types.
+type TxDecoder struct{}
+
+// DecodeTx implements execution.TxDecoder.
+func (TxDecoder) DecodeTx(rawTx []byte) (execution.DecodedTx, error) {
+	var tx types.Transaction
+	if err := tx.UnmarshalBinary(rawTx); err != nil {
+		return execution.DecodedTx{}, fmt.Errorf("failed to decode transaction: %w", err)
+	}
+	decoded := execution.DecodedTx{
+		Type: tx.Type(),
+		Gas:  tx.Gas(),
+	}
+	if to := tx.To(); to != nil {
+		decoded.To = to.Bytes()
+	}
+	if data := tx.Data(); len(data) >= methodSelectorLength && decoded.To != nil {
+		decoded.Method = data[:methodSelectorLength]
+	}
+	return decoded, nil
+}
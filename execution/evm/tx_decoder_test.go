@@ -0,0 +1,85 @@
+package evm
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/holiman/uint256"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTxDecoder(t *testing.T) {
+	key, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	signer := types.LatestSignerForChainID(big.NewInt(1))
+	to := common.HexToAddress("0x1000000000000000000000000000000000000001")
+	calldata := []byte{0xa9, 0x05, 0x9c, 0xbb, 0x01, 0x02}
+
+	testCases := []struct {
+		name         string
+		txData       types.TxData
+		expectedType uint8
+		expectedTo   []byte
+		method       []byte
+	}{
+		{
+			name:         "legacy call",
+			txData:       &types.LegacyTx{To: &to, Gas: 21_000, GasPrice: big.NewInt(1), Data: calldata},
+			expectedType: types.LegacyTxType,
+			expectedTo:   to.Bytes(),
+			method:       calldata[:4],
+		},
+		{
+			name:         "dynamic fee contract creation",
+			txData:       &types.DynamicFeeTx{Gas: 100_000, GasFeeCap: big.NewInt(1), GasTipCap: big.NewInt(1), Data: calldata},
+			expectedType: types.DynamicFeeTxType,
+		},
+		{
+			name:         "dynamic fee transfer",
+			txData:       &types.DynamicFeeTx{To: &to, Gas: 21_000, GasFeeCap: big.NewInt(1), GasTipCap: big.NewInt(1)},
+			expectedType: types.DynamicFeeTxType,
+			expectedTo:   to.Bytes(),
+		},
+		{
+			name: "blob call",
+			txData: &types.BlobTx{
+				ChainID: uint256.NewInt(1), To: to, Gas: 50_000, GasFeeCap: uint256.NewInt(1), GasTipCap: uint256.NewInt(1),
+				BlobFeeCap: uint256.NewInt(1), BlobHashes: []common.Hash{{0x01}}, Data: calldata,
+			},
+			expectedType: types.BlobTxType,
+			expectedTo:   to.Bytes(),
+			method:       calldata[:4],
+		},
+		{
+			name:         "access list call",
+			txData:       &types.AccessListTx{To: &to, Gas: 21_000, GasPrice: big.NewInt(1), Data: calldata},
+			expectedType: types.AccessListTxType,
+			expectedTo:   to.Bytes(),
+			method:       calldata[:4],
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			tx, err := types.SignNewTx(key, signer, tc.txData)
+			require.NoError(t, err)
+			rawTx, err := tx.MarshalBinary()
+			require.NoError(t, err)
+
+			decoded, err := TxDecoder{}.DecodeTx(rawTx)
+			require.NoError(t, err)
+			assert.Equal(t, tc.expectedType, decoded.Type)
+			assert.Equal(t, tc.expectedTo, decoded.To)
+			assert.Equal(t, tc.method, decoded.Method)
+			assert.Equal(t, tx.Gas(), decoded.Gas)
+			assert.Equal(t, tc.expectedTo == nil, decoded.IsContractCreation())
+		})
+	}
+
+	_, err = TxDecoder{}.DecodeTx([]byte{0xff, 0x00})
+	assert.Error(t, err)
+}
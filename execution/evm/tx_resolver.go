@@ -0,0 +1,30 @@
+package evm
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/evstack/ev-node/core/execution"
+)
+
+var _ execution.TxResolver = (*EngineClient)(nil)
+
+// ResolveTx implements execution.TxResolver. The transaction with the given keccak-256 hash is
+// looked up in the chain and the transaction pool of the execution client.
+func (c *EngineClient) ResolveTx(ctx context.Context, hash []byte) ([]byte, error) {
+	if len(hash) != common.HashLength {
+		return nil, execution.ErrTxNotFound
+	}
+	tx, _, err := c.ethClient.TransactionByHash(ctx, common.BytesToHash(hash))
+	if errors.Is(err, ethereum.NotFound) {
+		return nil, execution.ErrTxNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get transaction %x: %w", hash, err)
+	}
+	return tx.MarshalBinary()
+}
@@ -0,0 +1,88 @@
+package evm
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/rpc"
+
+	"github.com/evstack/ev-node/core/execution"
+)
+
+var _ execution.TxResultProvider = (*EngineClient)(nil)
+
+// executedTxs are the hashes of the transactions passed to ExecuteTxs for a block.
+type executedTxs struct {
+	height uint64
+	hashes []common.Hash
+}
+
+// setExecutedTxs records the transactions of the block executed at the given height.
+func (c *EngineClient) setExecutedTxs(height uint64, txs [][]byte) {
+	hashes := make([]common.Hash, len(txs))
+	for i, tx := range txs {
+		// the hash of a transaction is the keccak256 of its binary encoding
+		hashes[i] = crypto.Keccak256Hash(tx)
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.executed = executedTxs{height: height, hashes: hashes}
+}
+
+// GetTxResults implements execution.TxResultProvider. The results are read from the receipts of
+// the block, and the logs of a transaction are encoded as the JSON of eth_getTransactionReceipt.
+// The transactions passed to ExecuteTxs that the execution client left out of the block, e.g.
+// invalid ones, are reported as failed. Results are only available for the block last executed by
+// the client, as the node requests them right after executing it.
+func (c *EngineClient) GetTxResults(ctx context.Context, blockHeight uint64) ([]execution.TxResult, error) {
+	c.mu.Lock()
+	executed := c.executed
+	c.mu.Unlock()
+	if executed.height != blockHeight {
+		return nil, fmt.Errorf("block %d is not the last block executed by the client", blockHeight)
+	}
+	if len(executed.hashes) == 0 {
+		return []execution.TxResult{}, nil
+	}
+
+	header, err := c.getHeader(ctx, blockHeight)
+	if err != nil {
+		return nil, err
+	}
+	receipts, err := c.ethClient.BlockReceipts(ctx, rpc.BlockNumberOrHashWithHash(header.Hash(), true))
+	if err != nil {
+		return nil, fmt.Errorf("failed to get receipts of block %d: %w", blockHeight, err)
+	}
+	return txResults(executed.hashes, receipts)
+}
+
+// txResults returns the results of the transactions with the given hashes from the receipts of
+// the block including them.
+func txResults(hashes []common.Hash, receipts []*types.Receipt) ([]execution.TxResult, error) {
+	byHash := make(map[common.Hash]*types.Receipt, len(receipts))
+	for _, receipt := range receipts {
+		byHash[receipt.TxHash] = receipt
+	}
+
+	results := make([]execution.TxResult, len(hashes))
+	for i, hash := range hashes {
+		receipt, ok := byHash[hash]
+		if !ok {
+			continue
+		}
+		results[i].Success = receipt.Status == types.ReceiptStatusSuccessful
+		results[i].Logs = make([]string, len(receipt.Logs))
+		for j, log := range receipt.Logs {
+			bz, err := json.Marshal(log)
+			if err != nil {
+				return nil, fmt.Errorf("failed to encode log %d of transaction %s: %w", j, hash, err)
+			}
+			results[i].Logs[j] = string(bz)
+		}
+	}
+	return results, nil
+}
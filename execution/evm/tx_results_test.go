@@ -0,0 +1,60 @@
+package evm
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTxResults(t *testing.T) {
+	succeeded, reverted, dropped := common.HexToHash("0x01"), common.HexToHash("0x02"), common.HexToHash("0x03")
+	receipts := []*types.Receipt{
+		{TxHash: succeeded, Status: types.ReceiptStatusSuccessful, Logs: []*types.Log{
+			{Address: common.HexToAddress("0xa"), Topics: []common.Hash{common.HexToHash("0x7")}, Data: []byte{1}, TxHash: succeeded},
+		}},
+		{TxHash: reverted, Status: types.ReceiptStatusFailed},
+	}
+
+	results, err := txResults([]common.Hash{succeeded, reverted, dropped}, receipts)
+	require.NoError(t, err)
+	require.Len(t, results, 3)
+
+	assert.True(t, results[0].Success)
+	require.Len(t, results[0].Logs, 1)
+	var log types.Log
+	require.NoError(t, log.UnmarshalJSON([]byte(results[0].Logs[0])))
+	assert.Equal(t, common.HexToAddress("0xa"), log.Address)
+	assert.Equal(t, []byte{1}, log.Data)
+
+	assert.False(t, results[1].Success)
+	assert.Empty(t, results[1].Logs)
+	// transactions left out of the block are reported as failed
+	assert.False(t, results[2].Success)
+}
+
+func TestGetTxResults(t *testing.T) {
+	ctx := context.Background()
+	e := newFakeEngine(2)
+	client := newFakeEngineClient(t, e)
+
+	// results are only available for the last executed block
+	_, err := client.GetTxResults(ctx, 3)
+	require.Error(t, err)
+
+	_, _, err = client.ExecuteTxs(ctx, nil, 3, time.Unix(1_700_000_000, 0), nil)
+	require.NoError(t, err)
+	results, err := client.GetTxResults(ctx, 3)
+	require.NoError(t, err)
+	assert.Empty(t, results)
+
+	client.setExecutedTxs(4, [][]byte{{0x01}})
+	assert.Equal(t, []common.Hash{crypto.Keccak256Hash([]byte{0x01})}, client.executed.hashes)
+	_, err = client.GetTxResults(ctx, 3)
+	require.Error(t, err)
+}
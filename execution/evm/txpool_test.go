@@ -0,0 +1,71 @@
+package evm
+
+import (
+	"context"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/ethclient"
+	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// txpoolExt serves the txpoolExt_getTxs method of ev-reth.
+type txpoolExt struct {
+	txs []string
+}
+
+func (t *txpoolExt) GetTxs() []string {
+	return t.txs
+}
+
+func TestGetTxsWithLimits(t *testing.T) {
+	key, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	var raw [][]byte
+	pool := &txpoolExt{}
+	for i, gas := range []uint64{21_000, 50_000, 21_000} {
+		tx := signTx(t, key, &types.DynamicFeeTx{
+			ChainID:   big.NewInt(1),
+			Nonce:     uint64(i),
+			GasFeeCap: big.NewInt(1_000),
+			GasTipCap: big.NewInt(1),
+			Gas:       gas,
+		})
+		raw = append(raw, tx)
+		pool.txs = append(pool.txs, hexutil.Encode(tx))
+	}
+
+	server := rpc.NewServer()
+	require.NoError(t, server.RegisterName("txpoolExt", pool))
+	t.Cleanup(server.Stop)
+	client := &EngineClient{ethClient: ethclient.NewClient(rpc.DialInProc(server))}
+	ctx := context.Background()
+
+	txs, err := client.GetTxs(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, raw, txs)
+
+	// transactions are returned in order until the next one exceeds a limit
+	txs, err = client.GetTxsWithLimits(ctx, 0, 70_000)
+	require.NoError(t, err)
+	assert.Equal(t, raw[:1], txs)
+
+	txs, err = client.GetTxsWithLimits(ctx, uint64(len(raw[0])+len(raw[1])), 0)
+	require.NoError(t, err)
+	assert.Equal(t, raw[:2], txs)
+
+	txs, err = client.GetTxsWithLimits(ctx, 1, 0)
+	require.NoError(t, err)
+	assert.Empty(t, txs)
+
+	// an empty mempool is not an error
+	pool.txs = nil
+	txs, err = client.GetTxsWithLimits(ctx, 0, 0)
+	require.NoError(t, err)
+	assert.Empty(t, txs)
+}
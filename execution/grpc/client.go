@@ -17,6 +17,9 @@ import (
 // Ensure Client implements the execution.Executor interface
 var _ execution.Executor = (*Client)(nil)
 
+// Ensure Client implements the execution.Simulator interface
+var _ execution.Simulator = (*Client)(nil)
+
 // Client is a gRPC client that implements the execution.Executor interface.
 // It communicates with a remote execution service via gRPC using Connect-RPC.
 type Client struct {
@@ -41,6 +44,11 @@ func NewClient(url string, opts ...connect.ClientOption) *Client {
 	}
 }
 
+// ComponentName returns the name of the execution client reported by the node.
+func (c *Client) ComponentName() string {
+	return "grpc"
+}
+
 // InitChain initializes a new blockchain instance with genesis parameters.
 //
 // This method sends an InitChain request to the remote execution service and
@@ -112,3 +120,24 @@ func (c *Client) SetFinal(ctx context.Context, blockHeight uint64) error {
 
 	return nil
 }
+
+// SimulateTxs executes transactions without committing their result.
+//
+// This method sends transactions to the execution service for a simulated execution
+// and returns the resulting state root. It fails if the remote executor does not
+// simulate transactions.
+func (c *Client) SimulateTxs(ctx context.Context, txs [][]byte, blockHeight uint64, timestamp time.Time, prevStateRoot []byte) ([]byte, error) {
+	req := connect.NewRequest(&pb.SimulateTxsRequest{
+		Txs:           txs,
+		BlockHeight:   blockHeight,
+		Timestamp:     timestamppb.New(timestamp),
+		PrevStateRoot: prevStateRoot,
+	})
+
+	resp, err := c.client.SimulateTxs(ctx, req)
+	if err != nil {
+		return nil, fmt.Errorf("grpc client: failed to simulate txs: %w", err)
+	}
+
+	return resp.Msg.StateRoot, nil
+}
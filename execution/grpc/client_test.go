@@ -5,6 +5,8 @@ import (
 	"net/http/httptest"
 	"testing"
 	"time"
+
+	"connectrpc.com/connect"
 )
 
 // mockExecutor is a mock implementation of execution.Executor for testing
@@ -43,6 +45,19 @@ func (m *mockExecutor) SetFinal(ctx context.Context, blockHeight uint64) error {
 	return nil
 }
 
+// mockSimulator is a mock implementation of execution.Simulator for testing
+type mockSimulator struct {
+	mockExecutor
+	simulateTxsFunc func(ctx context.Context, txs [][]byte, blockHeight uint64, timestamp time.Time, prevStateRoot []byte) ([]byte, error)
+}
+
+func (m *mockSimulator) SimulateTxs(ctx context.Context, txs [][]byte, blockHeight uint64, timestamp time.Time, prevStateRoot []byte) ([]byte, error) {
+	if m.simulateTxsFunc != nil {
+		return m.simulateTxsFunc(ctx, txs, blockHeight, timestamp, prevStateRoot)
+	}
+	return []byte("simulated_state_root"), nil
+}
+
 func TestClient_InitChain(t *testing.T) {
 	ctx := context.Background()
 	expectedStateRoot := []byte("test_state_root")
@@ -199,3 +214,57 @@ func TestClient_SetFinal(t *testing.T) {
 		t.Fatalf("unexpected error: %v", err)
 	}
 }
+
+func TestClient_SimulateTxs(t *testing.T) {
+	ctx := context.Background()
+	txs := [][]byte{[]byte("tx1"), []byte("tx2")}
+	blockHeight := uint64(10)
+	timestamp := time.Now()
+	prevStateRoot := []byte("prev_state_root")
+	expectedStateRoot := []byte("simulated_state_root")
+
+	mockSim := &mockSimulator{
+		simulateTxsFunc: func(ctx context.Context, txsIn [][]byte, bh uint64, ts time.Time, psr []byte) ([]byte, error) {
+			if len(txsIn) != len(txs) {
+				t.Errorf("expected %d txs, got %d", len(txs), len(txsIn))
+			}
+			if bh != blockHeight {
+				t.Errorf("expected block height %d, got %d", blockHeight, bh)
+			}
+			if !ts.Equal(timestamp) {
+				t.Errorf("expected timestamp %v, got %v", timestamp, ts)
+			}
+			if string(psr) != string(prevStateRoot) {
+				t.Errorf("expected prev state root %s, got %s", prevStateRoot, psr)
+			}
+			return expectedStateRoot, nil
+		},
+	}
+
+	// Start test server
+	handler := NewExecutorServiceHandler(mockSim)
+	server := httptest.NewServer(handler)
+	defer server.Close()
+
+	// Create client
+	client := NewClient(server.URL)
+
+	// Test SimulateTxs
+	stateRoot, err := client.SimulateTxs(ctx, txs, blockHeight, timestamp, prevStateRoot)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if string(stateRoot) != string(expectedStateRoot) {
+		t.Errorf("expected state root %s, got %s", expectedStateRoot, stateRoot)
+	}
+
+	// Executors that do not simulate transactions fail the call
+	unsupported := httptest.NewServer(NewExecutorServiceHandler(&mockExecutor{}))
+	defer unsupported.Close()
+
+	_, err = NewClient(unsupported.URL).SimulateTxs(ctx, txs, blockHeight, timestamp, prevStateRoot)
+	if connect.CodeOf(err) != connect.CodeUnimplemented {
+		t.Errorf("expected unimplemented error, got %v", err)
+	}
+}
@@ -138,3 +138,44 @@ func (s *Server) SetFinal(
 
 	return connect.NewResponse(&pb.SetFinalResponse{}), nil
 }
+
+// SimulateTxs handles the SimulateTxs RPC request.
+//
+// It executes transactions without committing their result, if the underlying
+// executor implements execution.Simulator, and fails with CodeUnimplemented otherwise.
+func (s *Server) SimulateTxs(
+	ctx context.Context,
+	req *connect.Request[pb.SimulateTxsRequest],
+) (*connect.Response[pb.SimulateTxsResponse], error) {
+	simulator, ok := s.executor.(execution.Simulator)
+	if !ok {
+		return nil, connect.NewError(connect.CodeUnimplemented, errors.New("executor does not simulate transactions"))
+	}
+
+	if req.Msg.BlockHeight == 0 {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("block_height must be > 0"))
+	}
+
+	if req.Msg.Timestamp == nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("timestamp is required"))
+	}
+
+	if len(req.Msg.PrevStateRoot) == 0 {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("prev_state_root is required"))
+	}
+
+	stateRoot, err := simulator.SimulateTxs(
+		ctx,
+		req.Msg.Txs,
+		req.Msg.BlockHeight,
+		req.Msg.Timestamp.AsTime(),
+		req.Msg.PrevStateRoot,
+	)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to simulate txs: %w", err))
+	}
+
+	return connect.NewResponse(&pb.SimulateTxsResponse{
+		StateRoot: stateRoot,
+	}), nil
+}
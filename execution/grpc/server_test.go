@@ -373,3 +373,126 @@ func TestServer_SetFinal(t *testing.T) {
 		})
 	}
 }
+
+func TestServer_SimulateTxs(t *testing.T) {
+	ctx := context.Background()
+	txs := [][]byte{[]byte("tx1"), []byte("tx2")}
+	blockHeight := uint64(10)
+	timestamp := time.Now()
+	prevStateRoot := []byte("prev_state_root")
+	expectedStateRoot := []byte("simulated_state_root")
+
+	tests := []struct {
+		name        string
+		req         *pb.SimulateTxsRequest
+		mockFunc    func(ctx context.Context, txs [][]byte, blockHeight uint64, timestamp time.Time, prevStateRoot []byte) ([]byte, error)
+		noSimulator bool
+		wantErr     bool
+		wantCode    connect.Code
+	}{
+		{
+			name: "success",
+			req: &pb.SimulateTxsRequest{
+				Txs:           txs,
+				BlockHeight:   blockHeight,
+				Timestamp:     timestamppb.New(timestamp),
+				PrevStateRoot: prevStateRoot,
+			},
+			mockFunc: func(ctx context.Context, txs [][]byte, bh uint64, ts time.Time, psr []byte) ([]byte, error) {
+				return expectedStateRoot, nil
+			},
+			wantErr: false,
+		},
+		{
+			name: "executor does not simulate",
+			req: &pb.SimulateTxsRequest{
+				Txs:           txs,
+				BlockHeight:   blockHeight,
+				Timestamp:     timestamppb.New(timestamp),
+				PrevStateRoot: prevStateRoot,
+			},
+			noSimulator: true,
+			wantErr:     true,
+			wantCode:    connect.CodeUnimplemented,
+		},
+		{
+			name: "missing block height",
+			req: &pb.SimulateTxsRequest{
+				Txs:           txs,
+				Timestamp:     timestamppb.New(timestamp),
+				PrevStateRoot: prevStateRoot,
+			},
+			wantErr:  true,
+			wantCode: connect.CodeInvalidArgument,
+		},
+		{
+			name: "missing timestamp",
+			req: &pb.SimulateTxsRequest{
+				Txs:           txs,
+				BlockHeight:   blockHeight,
+				PrevStateRoot: prevStateRoot,
+			},
+			wantErr:  true,
+			wantCode: connect.CodeInvalidArgument,
+		},
+		{
+			name: "missing prev state root",
+			req: &pb.SimulateTxsRequest{
+				Txs:         txs,
+				BlockHeight: blockHeight,
+				Timestamp:   timestamppb.New(timestamp),
+			},
+			wantErr:  true,
+			wantCode: connect.CodeInvalidArgument,
+		},
+		{
+			name: "executor error",
+			req: &pb.SimulateTxsRequest{
+				Txs:           txs,
+				BlockHeight:   blockHeight,
+				Timestamp:     timestamppb.New(timestamp),
+				PrevStateRoot: prevStateRoot,
+			},
+			mockFunc: func(ctx context.Context, txs [][]byte, bh uint64, ts time.Time, psr []byte) ([]byte, error) {
+				return nil, errors.New("simulate txs failed")
+			},
+			wantErr:  true,
+			wantCode: connect.CodeInternal,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := NewServer(&mockSimulator{simulateTxsFunc: tt.mockFunc})
+			if tt.noSimulator {
+				server = NewServer(&mockExecutor{})
+			}
+
+			req := connect.NewRequest(tt.req)
+			resp, err := server.SimulateTxs(ctx, req)
+
+			if tt.wantErr {
+				if err == nil {
+					t.Fatalf("expected error but got none")
+				}
+				var connectErr *connect.Error
+				if errors.As(err, &connectErr) {
+					if connectErr.Code() != tt.wantCode {
+						t.Errorf("expected error code %v, got %v", tt.wantCode, connectErr.Code())
+					}
+				} else {
+					t.Errorf("expected connect error, got %v", err)
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			if string(resp.Msg.StateRoot) != string(expectedStateRoot) {
+				t.Errorf("expected state root %s, got %s", expectedStateRoot, resp.Msg.StateRoot)
+			}
+		})
+	}
+}
@@ -0,0 +1,43 @@
+package node
+
+import (
+	"context"
+	"crypto/rand"
+	"fmt"
+
+	ds "github.com/ipfs/go-datastore"
+	dssync "github.com/ipfs/go-datastore/sync"
+	"github.com/libp2p/go-libp2p/core/crypto"
+
+	genesispkg "github.com/evstack/ev-node/pkg/genesis"
+	"github.com/evstack/ev-node/pkg/signer"
+	"github.com/evstack/ev-node/pkg/signer/noop"
+)
+
+// dryRunSetup returns the signer, genesis and database of a dry-run aggregator: blocks are signed
+// with a throwaway key, which the genesis designates as proposer, and stored in memory, so that
+// they never reach the data directory.
+func dryRunSetup(genesis genesispkg.Genesis) (signer.Signer, genesispkg.Genesis, ds.Batching, error) {
+	privKey, _, err := crypto.GenerateEd25519Key(rand.Reader)
+	if err != nil {
+		return nil, genesis, nil, fmt.Errorf("failed to generate dry-run key: %w", err)
+	}
+	dryRunSigner, err := noop.NewNoopSigner(privKey)
+	if err != nil {
+		return nil, genesis, nil, err
+	}
+	genesis.ProposerAddress, err = dryRunSigner.GetAddress()
+	if err != nil {
+		return nil, genesis, nil, err
+	}
+	return dryRunSigner, genesis, dssync.MutexWrap(ds.NewMapDatastore()), nil
+}
+
+// discardBroadcaster stands in for the sync services of a dry-run aggregator, which does not
+// gossip the blocks it produces.
+type discardBroadcaster[T any] struct{}
+
+// WriteToStoreAndBroadcast discards the payload.
+func (discardBroadcaster[T]) WriteToStoreAndBroadcast(context.Context, T) error {
+	return nil
+}
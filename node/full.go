@@ -8,11 +8,16 @@ import (
 	"fmt"
 	"net/http"
 	"net/http/pprof"
+	"strconv"
+	"strings"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	ds "github.com/ipfs/go-datastore"
 	ktds "github.com/ipfs/go-datastore/keytransform"
+	"github.com/libp2p/go-libp2p/core/network"
+	"github.com/libp2p/go-libp2p/core/peer"
 	"github.com/prometheus/client_golang/prometheus"
 	"github.com/prometheus/client_golang/prometheus/promhttp"
 	"github.com/rs/zerolog"
@@ -21,14 +26,21 @@ import (
 	coreda "github.com/evstack/ev-node/core/da"
 	coreexecutor "github.com/evstack/ev-node/core/execution"
 	coresequencer "github.com/evstack/ev-node/core/sequencer"
+	"github.com/evstack/ev-node/pkg/alert"
+	"github.com/evstack/ev-node/pkg/buildinfo"
 	"github.com/evstack/ev-node/pkg/config"
+	"github.com/evstack/ev-node/pkg/errlog"
 	genesispkg "github.com/evstack/ev-node/pkg/genesis"
+	"github.com/evstack/ev-node/pkg/journal"
 	"github.com/evstack/ev-node/pkg/p2p"
+	"github.com/evstack/ev-node/pkg/preview"
 	rpcserver "github.com/evstack/ev-node/pkg/rpc/server"
 	"github.com/evstack/ev-node/pkg/service"
 	"github.com/evstack/ev-node/pkg/signer"
 	"github.com/evstack/ev-node/pkg/store"
 	evsync "github.com/evstack/ev-node/pkg/sync"
+	"github.com/evstack/ev-node/pkg/webhook"
+	"github.com/evstack/ev-node/types"
 )
 
 // prefixes used in KV store to separate rollkit data from execution environment data (if the same data base is reused)
@@ -38,6 +50,17 @@ const (
 	// genesisChunkSize is the maximum size, in bytes, of each
 	// chunk in the genesis structure for the chunked API
 	genesisChunkSize = 16 * 1024 * 1024 // 16 MiB
+
+	// alertEvaluationInterval is the interval at which the alert rules are evaluated
+	alertEvaluationInterval = time.Second
+
+	// pruningInterval is the interval at which the store is pruned, unless the pruning strategy
+	// is archive
+	pruningInterval = time.Minute
+
+	// peerEventsBufferSize is the number of peer events buffered for the journal, beyond which
+	// events are dropped rather than blocking the notifications of the network
+	peerEventsBufferSize = 256
 )
 
 var _ Node = &FullNode{}
@@ -54,6 +77,8 @@ type FullNode struct {
 	nodeConfig config.Config
 
 	da coreda.DA
+	// upgradeExec is the executor the node switches to once the chain is replayed into it
+	upgradeExec coreexecutor.Executor
 
 	p2pClient    *p2p.Client
 	hSyncService *evsync.HeaderSyncService
@@ -61,10 +86,26 @@ type FullNode struct {
 	Store        store.Store
 	blockManager *block.Manager
 	reaper       *block.Reaper
+	alerts       *alert.Evaluator
+	readiness    []rpcserver.ReadinessCheck
+	webhook      *webhook.Notifier
+	eventWebhook *webhook.Notifier
+	previews     *preview.Service
+	pruning      *store.PruningManager
+	journal      *journal.Journal
+	errors       *errlog.Registry
+	info         rpcserver.NodeInfo
 
 	prometheusSrv *http.Server
 	pprofSrv      *http.Server
 	rpcServer     *http.Server
+	rpcDrainer    *rpcserver.Drainer
+
+	// shutdown is closed to stop the node, once
+	shutdown     chan struct{}
+	shutdownOnce sync.Once
+	// drainTimeout overrides rpc.drain_timeout when the node is stopped by the Drain admin RPC
+	drainTimeout atomic.Int64
 }
 
 // newFullNode creates a new Rollkit full node.
@@ -82,15 +123,38 @@ func newFullNode(
 	logger zerolog.Logger,
 	nodeOpts NodeOptions,
 ) (fn *FullNode, err error) {
+	if nodeConfig.RPC.Replica && nodeConfig.Node.Aggregator {
+		return nil, errors.New("read replica mode cannot be enabled on an aggregator")
+	}
+	if nodeConfig.Node.ShadowReplica && nodeConfig.Node.Aggregator {
+		return nil, errors.New("shadow replica mode cannot be enabled on an aggregator")
+	}
+	if _, ok := exec.(coreexecutor.Simulator); nodeConfig.Node.ShadowReplica && !ok {
+		logger.Warn().Msg("the executor cannot simulate transactions: execution divergence reports will not pinpoint the diverging transaction")
+	}
+	if nodeConfig.Node.DryRun {
+		if !nodeConfig.Node.Aggregator {
+			return nil, errors.New("dry-run mode requires an aggregator")
+		}
+		signer, genesis, database, err = dryRunSetup(genesis)
+		if err != nil {
+			return nil, err
+		}
+	}
+	if err := registerMetadataNamespaces(nodeConfig.RPC.MetadataNamespaces); err != nil {
+		return nil, err
+	}
+
 	seqMetrics, _ := metricsProvider(genesis.ChainID)
 
+	errs := errlog.NewRegistry()
 	mainKV := newPrefixKV(database, EvPrefix)
-	headerSyncService, err := initHeaderSyncService(mainKV, nodeConfig, genesis, p2pClient, logger)
+	headerSyncService, err := initHeaderSyncService(mainKV, nodeConfig, genesis, p2pClient, logger, errs)
 	if err != nil {
 		return nil, err
 	}
 
-	dataSyncService, err := initDataSyncService(mainKV, nodeConfig, genesis, p2pClient, logger)
+	dataSyncService, err := initDataSyncService(mainKV, nodeConfig, genesis, p2pClient, logger, errs)
 	if err != nil {
 		return nil, err
 	}
@@ -107,6 +171,7 @@ func newFullNode(
 		sequencer,
 		da,
 		logger,
+		errs,
 		headerSyncService,
 		dataSyncService,
 		seqMetrics,
@@ -122,12 +187,18 @@ func newFullNode(
 		sequencer,
 		genesis.ChainID,
 		nodeConfig.Node.BlockTime.Duration,
-		logger.With().Str("component", "Reaper").Logger(), // Get Reaper's own logger
+		errs.Logger(logger, "Reaper"), // Get Reaper's own logger
 		mainKV,
 	)
 
 	// Connect the reaper to the manager for transaction notifications
 	reaper.SetManager(blockManager)
+	reaper.SetLimits(nodeConfig.Node.ReapMaxBytes, nodeConfig.Node.ReapMaxGas)
+	reaper.SetDropBlobPointers(genesis.BlobPointers)
+
+	eventJournal := journal.New(rktStore)
+	blockManager.SetJournal(eventJournal)
+	blockManager.SetSyncPeers(&syncPeers{p2pClient: p2pClient, services: []peerStatsSource{headerSyncService, dataSyncService}})
 
 	node := &FullNode{
 		genesis:      genesis,
@@ -136,10 +207,57 @@ func newFullNode(
 		blockManager: blockManager,
 		reaper:       reaper,
 		da:           da,
+		upgradeExec:  nodeOpts.UpgradeExecutor,
 		Store:        rktStore,
 		hSyncService: headerSyncService,
 		dSyncService: dataSyncService,
+		journal:      eventJournal,
+		errors:       errs,
+		info: rpcserver.NodeInfo{
+			Version:   nodeOpts.Version,
+			GitCommit: nodeOpts.GitCommit,
+			ChainID:   genesis.ChainID,
+			Build:     buildinfo.Read(),
+		},
+		shutdown: make(chan struct{}),
+	}
+	node.alerts = newAlertEvaluator(nodeConfig, genesis, blockManager, p2pClient, signer, logger, errs)
+	node.readiness = newReadinessChecks(nodeConfig, p2pClient, signer, blockManager)
+	if nodeConfig.RPC.WebhookURL != "" {
+		node.webhook = webhook.NewNotifier(
+			nodeConfig.RPC.WebhookURL,
+			nodeConfig.RPC.WebhookSecret,
+			rktStore,
+			nodeConfig.Node.BlockTime.Duration,
+			errs.Logger(logger, "Webhook"),
+		)
+	}
+	if nodeConfig.RPC.EventWebhookURL != "" {
+		node.eventWebhook = webhook.NewEventNotifier(
+			nodeConfig.RPC.EventWebhookURL,
+			nodeConfig.RPC.WebhookSecret,
+			rktStore,
+			nodeConfig.Node.BlockTime.Duration,
+			errs.Logger(logger, "EventWebhook"),
+		)
+	}
+
+	// a dry run gossips nothing
+	if nodeConfig.Node.PreviewBlocks && !nodeConfig.Node.DryRun {
+		node.previews = preview.NewService(p2pClient, genesis, rktStore, errs.Logger(logger, "Previews"))
+		blockManager.SetPreviews(node.previews)
+	}
+
+	node.pruning, err = store.NewPruningManager(
+		rktStore,
+		store.PruningStrategy(nodeConfig.Node.PruningStrategy),
+		nodeConfig.Node.PruningKeepRecent,
+		errs.Logger(logger, "Pruning"),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create pruning manager: %w", err)
 	}
+	blockManager.SetPruningManager(node.pruning)
 
 	node.BaseService = *service.NewBaseService(logger, "Node", node)
 
@@ -152,8 +270,9 @@ func initHeaderSyncService(
 	genesis genesispkg.Genesis,
 	p2pClient *p2p.Client,
 	logger zerolog.Logger,
+	errs *errlog.Registry,
 ) (*evsync.HeaderSyncService, error) {
-	headerSyncService, err := evsync.NewHeaderSyncService(mainKV, nodeConfig, genesis, p2pClient, logger.With().Str("component", "HeaderSyncService").Logger())
+	headerSyncService, err := evsync.NewHeaderSyncService(mainKV, nodeConfig, genesis, p2pClient, errs.Logger(logger, "HeaderSyncService"))
 	if err != nil {
 		return nil, fmt.Errorf("error while initializing HeaderSyncService: %w", err)
 	}
@@ -166,8 +285,9 @@ func initDataSyncService(
 	genesis genesispkg.Genesis,
 	p2pClient *p2p.Client,
 	logger zerolog.Logger,
+	errs *errlog.Registry,
 ) (*evsync.DataSyncService, error) {
-	dataSyncService, err := evsync.NewDataSyncService(mainKV, nodeConfig, genesis, p2pClient, logger.With().Str("component", "DataSyncService").Logger())
+	dataSyncService, err := evsync.NewDataSyncService(mainKV, nodeConfig, genesis, p2pClient, errs.Logger(logger, "DataSyncService"))
 	if err != nil {
 		return nil, fmt.Errorf("error while initializing DataSyncService: %w", err)
 	}
@@ -192,6 +312,7 @@ func initBlockManager(
 	sequencer coresequencer.Sequencer,
 	da coreda.DA,
 	logger zerolog.Logger,
+	errs *errlog.Registry,
 	headerSyncService *evsync.HeaderSyncService,
 	dataSyncService *evsync.DataSyncService,
 	seqMetrics *block.Metrics,
@@ -199,6 +320,19 @@ func initBlockManager(
 ) (*block.Manager, error) {
 	logger.Debug().Bytes("address", genesis.ProposerAddress).Msg("Proposer address")
 
+	var (
+		headerBroadcaster interface {
+			WriteToStoreAndBroadcast(context.Context, *types.SignedHeader) error
+		} = headerSyncService
+		dataBroadcaster interface {
+			WriteToStoreAndBroadcast(context.Context, *types.Data) error
+		} = dataSyncService
+	)
+	if nodeConfig.Node.DryRun {
+		headerBroadcaster = discardBroadcaster[*types.SignedHeader]{}
+		dataBroadcaster = discardBroadcaster[*types.Data]{}
+	}
+
 	blockManager, err := block.NewManager(
 		ctx,
 		signer,
@@ -208,11 +342,11 @@ func initBlockManager(
 		exec,
 		sequencer,
 		da,
-		logger.With().Str("component", "BlockManager").Logger(), // Get BlockManager's own logger
+		errs.Logger(logger, "BlockManager"), // Get BlockManager's own logger
 		headerSyncService.Store(),
 		dataSyncService.Store(),
-		headerSyncService,
-		dataSyncService,
+		headerBroadcaster,
+		dataBroadcaster,
 		seqMetrics,
 		managerOpts,
 	)
@@ -222,6 +356,162 @@ func initBlockManager(
 	return blockManager, nil
 }
 
+// newAlertEvaluator creates the evaluator of the built-in alert rules applicable to the node.
+func newAlertEvaluator(
+	nodeConfig config.Config,
+	genesis genesispkg.Genesis,
+	blockManager *block.Manager,
+	p2pClient *p2p.Client,
+	signer signer.Signer,
+	logger zerolog.Logger,
+	errs *errlog.Registry,
+) *alert.Evaluator {
+	blockTime := nodeConfig.Node.BlockTime.Duration
+	if nodeConfig.Node.LazyMode {
+		blockTime = nodeConfig.Node.LazyBlockInterval.Duration
+	}
+
+	rules := []alert.Rule{
+		alert.NoRecentBlockRule(blockTime, func() time.Time {
+			state := blockManager.GetLastState()
+			if state.LastBlockHeight < genesis.InitialHeight {
+				return time.Time{}
+			}
+			return state.LastBlockTime
+		}),
+		alert.NoPeersRule(func() int { return len(p2pClient.PeerIDs()) }),
+	}
+	if nodeConfig.Node.ShadowReplica {
+		rules = append(rules, alert.ExecutionDivergenceRule(func() (uint64, bool) {
+			if divergence := blockManager.ExecutionDivergence(); divergence != nil {
+				return divergence.Height, true
+			}
+			return 0, false
+		}))
+	}
+	if nodeConfig.Node.Aggregator {
+		if nodeConfig.Node.AlertDABacklog > 0 {
+			rules = append(rules, alert.DABacklogRule(nodeConfig.Node.AlertDABacklog, blockManager.NumPendingDA))
+		}
+		if signer != nil {
+			rules = append(rules, alert.SignerUnreachableRule(func() error {
+				_, err := signer.GetPublic()
+				return err
+			}))
+		}
+	}
+
+	return alert.NewEvaluator(rules, alertEvaluationInterval, errs.Logger(logger, "Alerts"))
+}
+
+// peerStatsSource is a sync service reporting the contributions of peers to the sync.
+type peerStatsSource interface {
+	PeerStats() map[peer.ID]evsync.PeerSyncStats
+}
+
+// syncPeers reports to the block manager the totals of the peer stats of the sync services.
+type syncPeers struct {
+	p2pClient *p2p.Client
+	services  []peerStatsSource
+}
+
+// SyncPeerStats implements block.SyncPeers.
+func (s *syncPeers) SyncPeerStats() block.SyncPeerStats {
+	stats := block.SyncPeerStats{Peers: len(s.p2pClient.PeerIDs())}
+	for _, service := range s.services {
+		for _, peerStats := range service.PeerStats() {
+			stats.Served += peerStats.Served
+			stats.Requests += peerStats.Requests
+			stats.FailedRequests += peerStats.FailedRequests
+		}
+	}
+	return stats
+}
+
+// nodeAdmin implements the runtime control of the node served by the admin RPC.
+type nodeAdmin struct {
+	node *FullNode
+}
+
+// Shutdown implements rpcserver.NodeAdmin.
+func (a *nodeAdmin) Shutdown() {
+	a.node.shutdownOnce.Do(func() { close(a.node.shutdown) })
+}
+
+// Drain implements rpcserver.NodeAdmin.
+func (a *nodeAdmin) Drain(timeout time.Duration) {
+	a.node.drainTimeout.Store(int64(timeout))
+	a.Shutdown()
+}
+
+// TriggerDASubmission implements rpcserver.NodeAdmin.
+func (a *nodeAdmin) TriggerDASubmission() (uint64, uint64, error) {
+	if !a.node.nodeConfig.Node.Aggregator {
+		return 0, 0, rpcserver.ErrNotAggregator
+	}
+	headers, data := a.node.blockManager.TriggerDASubmission()
+	return headers, data, nil
+}
+
+// DisconnectPeer implements rpcserver.NodeAdmin.
+func (a *nodeAdmin) DisconnectPeer(id peer.ID, ban bool) error {
+	return a.node.p2pClient.DisconnectPeer(id, ban)
+}
+
+// CompactStore implements rpcserver.NodeAdmin.
+func (a *nodeAdmin) CompactStore(ctx context.Context) error {
+	return a.node.blockManager.CompactStore(ctx)
+}
+
+// SetMetadata implements rpcserver.NodeAdmin.
+func (a *nodeAdmin) SetMetadata(ctx context.Context, namespace, key string, value []byte) error {
+	return store.SetNamespacedMetadata(ctx, a.node.Store, namespace, key, value)
+}
+
+// registerMetadataNamespaces registers the comma-separated namespaces of application metadata of
+// the configuration, so that out-of-process execution layers can write to them with the
+// SetMetadata admin RPC.
+func registerMetadataNamespaces(list string) error {
+	for _, namespace := range strings.Split(list, ",") {
+		if namespace = strings.TrimSpace(namespace); namespace == "" {
+			continue
+		}
+		if err := store.RegisterMetadataNamespace(namespace); err != nil {
+			return fmt.Errorf("invalid %s: %w", config.FlagRPCMetadataNamespaces, err)
+		}
+	}
+	return nil
+}
+
+// newReadinessChecks creates the readiness checks of the node, in addition to the store and DA
+// checks of the RPC server.
+func newReadinessChecks(nodeConfig config.Config, p2pClient *p2p.Client, signer signer.Signer, blockManager *block.Manager) []rpcserver.ReadinessCheck {
+	checks := []rpcserver.ReadinessCheck{p2pReadinessCheck(p2pClient)}
+	if nodeConfig.Node.Aggregator && signer != nil {
+		checks = append(checks, rpcserver.ReadinessCheck{
+			Name:     "signer",
+			Critical: true,
+			Check: func(context.Context) error {
+				_, err := signer.GetPublic()
+				return err
+			},
+		})
+	}
+	if nodeConfig.Node.MaxDiskUsage > 0 {
+		// a node at its disk quota still serves queries, so it is only degraded
+		checks = append(checks, rpcserver.ReadinessCheck{
+			Name: "disk_quota",
+			Check: func(context.Context) error {
+				if readOnly, usage := blockManager.DiskQuotaStatus(); readOnly {
+					return fmt.Errorf("store uses %d of %d bytes, no blocks are written", usage, nodeConfig.Node.MaxDiskUsage)
+				}
+				return nil
+			},
+		})
+	}
+	return checks
+}
+
 // initGenesisChunks creates a chunked format of the genesis document to make it easier to
 // iterate through larger genesis structures.
 func (n *FullNode) initGenesisChunks() error {
@@ -327,11 +617,39 @@ func (n *FullNode) Run(parentCtx context.Context) error {
 		n.prometheusSrv, n.pprofSrv = n.startInstrumentationServer()
 	}
 
+	n.info.StartTime = time.Now()
+
 	// Start RPC server
-	handler, err := rpcserver.NewServiceHandler(n.Store, n.p2pClient, n.Logger, n.nodeConfig)
+	// only aggregators submit transactions to the sequencer
+	var submitted rpcserver.SubmittedTxs
+	if n.nodeConfig.Node.Aggregator {
+		submitted = n.reaper
+	}
+	handler, err := rpcserver.NewServiceHandler(n.Store, n.p2pClient, n.Logger, n.nodeConfig, rpcserver.ServiceOptions{
+		Executor:        n.blockManager,
+		DA:              n.da,
+		Alerts:          n.alerts,
+		Errors:          n.errors,
+		SubmittedTxs:    submitted,
+		SyncStatus:      n.blockManager,
+		Previews:        n.blockManager,
+		Admin:           &nodeAdmin{node: n},
+		Info:            n.info,
+		ReadinessChecks: n.readiness,
+	})
 	if err != nil {
 		return fmt.Errorf("error creating RPC handler: %w", err)
 	}
+	if n.nodeConfig.RPC.Replica {
+		handler = rpcserver.NewReplicaHandler(handler, rpcserver.ReplicaOptions{
+			CacheTTL:     n.nodeConfig.RPC.ReplicaCacheTTL.Duration,
+			MaxLagBlocks: n.nodeConfig.RPC.ReplicaMaxLagBlocks,
+			Lag:          n.replicaLag,
+		}, n.errors.Logger(n.Logger, "Replica"))
+	}
+	// the requests are always tracked, as the Drain admin RPC drains them even if rpc.drain_timeout is 0
+	n.rpcDrainer = rpcserver.NewDrainer(n.nodeConfig.RPC.DrainTimeout.Duration)
+	handler = n.rpcDrainer.Handler(handler)
 
 	n.rpcServer = &http.Server{
 		Addr:         n.nodeConfig.RPC.Address,
@@ -341,12 +659,9 @@ func (n *FullNode) Run(parentCtx context.Context) error {
 		IdleTimeout:  120 * time.Second,
 	}
 
-	go func() {
-		n.Logger.Info().Str("addr", n.nodeConfig.RPC.Address).Msg("started RPC server")
-		if err := n.rpcServer.ListenAndServe(); err != nil && !errors.Is(err, http.ErrServerClosed) {
-			n.Logger.Error().Err(err).Msg("RPC server error")
-		}
-	}()
+	if err := serveRPC(n.rpcServer, n.nodeConfig, n.Logger); err != nil {
+		return fmt.Errorf("error starting RPC server: %w", err)
+	}
 
 	n.Logger.Info().Msg("starting P2P client")
 	err = n.p2pClient.Start(ctx)
@@ -362,6 +677,23 @@ func (n *FullNode) Run(parentCtx context.Context) error {
 		return fmt.Errorf("error while starting data sync service: %w", err)
 	}
 
+	if n.previews != nil {
+		if err = n.previews.Start(ctx); err != nil {
+			return fmt.Errorf("error while starting preview service: %w", err)
+		}
+	}
+
+	if err := n.journal.RecordVersion(ctx, n.info.Version); err != nil {
+		n.Logger.Warn().Err(err).Msg("failed to record node version in journal")
+	}
+	n.recordEvent(ctx, journal.EventNodeStarted, "node started", map[string]string{
+		"version":    n.info.Version,
+		"aggregator": strconv.FormatBool(n.nodeConfig.Node.Aggregator),
+	})
+	peerEventsCh := make(chan peerEvent, peerEventsBufferSize)
+	peerEvents := n.peerEventsNotifiee(peerEventsCh)
+	n.p2pClient.Host().Network().Notify(peerEvents)
+
 	// only the first error is propagated
 	// any error is an issue, so blocking is not a problem
 	errCh := make(chan error, 1)
@@ -374,13 +706,30 @@ func (n *FullNode) Run(parentCtx context.Context) error {
 			f()
 		}()
 	}
+	spawnWorker(func() { n.alerts.Run(ctx) })
+	spawnWorker(func() { n.recordPeerEvents(ctx, peerEventsCh) })
+	if n.webhook != nil {
+		spawnWorker(func() { n.webhook.Run(ctx) })
+	}
+	if n.eventWebhook != nil {
+		spawnWorker(func() { n.eventWebhook.Run(ctx) })
+	}
 	if n.nodeConfig.Node.Aggregator {
 		n.Logger.Info().Dur("block_time", n.nodeConfig.Node.BlockTime.Duration).Msg("working in aggregator mode")
 		spawnWorker(func() { n.blockManager.AggregationLoop(ctx, errCh) })
 		spawnWorker(func() { n.reaper.Start(ctx) })
-		spawnWorker(func() { n.blockManager.HeaderSubmissionLoop(ctx) })
-		spawnWorker(func() { n.blockManager.DataSubmissionLoop(ctx) })
-		spawnWorker(func() { n.blockManager.DAIncluderLoop(ctx, errCh) })
+		switch {
+		case n.nodeConfig.Node.DryRun:
+			// nothing is submitted, so nothing is included on DA
+			spawnWorker(func() { n.blockManager.DryRunSubmissionLoop(ctx) })
+		case n.nodeConfig.DA.CombinedBlobs:
+			spawnWorker(func() { n.blockManager.CombinedSubmissionLoop(ctx) })
+			spawnWorker(func() { n.blockManager.DAIncluderLoop(ctx, errCh) })
+		default:
+			spawnWorker(func() { n.blockManager.HeaderSubmissionLoop(ctx) })
+			spawnWorker(func() { n.blockManager.DataSubmissionLoop(ctx) })
+			spawnWorker(func() { n.blockManager.DAIncluderLoop(ctx, errCh) })
+		}
 	} else {
 		spawnWorker(func() { n.blockManager.RetrieveLoop(ctx) })
 		spawnWorker(func() { n.blockManager.HeaderStoreRetrieveLoop(ctx) })
@@ -388,31 +737,76 @@ func (n *FullNode) Run(parentCtx context.Context) error {
 		spawnWorker(func() { n.blockManager.SyncLoop(ctx, errCh) })
 		spawnWorker(func() { n.blockManager.DAIncluderLoop(ctx, errCh) })
 	}
+	if n.nodeConfig.Node.MaxDiskUsage > 0 {
+		spawnWorker(func() { n.blockManager.DiskQuotaLoop(ctx) })
+	}
+	spawnWorker(func() { n.blockManager.SequencerFeesLoop(ctx) })
+	spawnWorker(func() { n.pruning.Run(ctx, pruningInterval) })
+	if n.nodeConfig.Node.CompactionInterval.Duration > 0 {
+		spawnWorker(func() { n.blockManager.CompactionLoop(ctx) })
+	}
+	if n.upgradeExec != nil {
+		spawnWorker(func() {
+			// a failed upgrade leaves the node on its executor
+			if err := n.blockManager.UpgradeExecutor(ctx, n.upgradeExec); err != nil && ctx.Err() == nil {
+				n.Logger.Error().Err(err).Msg("failed to upgrade executor")
+			}
+		})
+	}
 
+	var stopErr error
+	stopRequested := false
+	drained := false
 	select {
 	case err := <-errCh:
 		if err != nil {
 			n.Logger.Error().Err(err).Msg("unrecoverable error in one of the go routines")
+			stopErr = err
 			cancelNode() // propagate shutdown to all child goroutines
 		}
 	case <-parentCtx.Done():
 		// Block until parent context is canceled
 		n.Logger.Info().Msg("context canceled, stopping node")
+		// drain the RPC requests while the node still serves them
+		drainRPC(n.rpcDrainer, n.nodeConfig.RPC.DrainTimeout.Duration, n.Logger)
+		drained = true
+		cancelNode() // propagate shutdown to all child goroutines
+	case <-n.shutdown:
+		n.Logger.Info().Msg("shutdown requested, stopping node")
+		stopRequested = true
+		// drain the RPC requests while the node still serves them
+		drainTimeout := n.nodeConfig.RPC.DrainTimeout.Duration
+		if requested := time.Duration(n.drainTimeout.Load()); requested > 0 {
+			drainTimeout = requested
+		}
+		drainRPC(n.rpcDrainer, drainTimeout, n.Logger)
+		drained = true
 		cancelNode() // propagate shutdown to all child goroutines
 	}
 
 	// Perform cleanup
 	n.Logger.Info().Msg("halting full node and its sub services...")
+	if !drained {
+		drainRPC(n.rpcDrainer, n.nodeConfig.RPC.DrainTimeout.Duration, n.Logger)
+	}
 	// wait for all worker Go routines to finish so that we have
 	// no in-flight tasks while shutting down
 	wg.Wait()
 
+	n.p2pClient.Host().Network().StopNotify(peerEvents)
+
 	// Use a timeout context to ensure shutdown doesn't hang
 	shutdownCtx, cancel := context.WithTimeout(context.Background(), 9*time.Second)
 	defer cancel()
 
 	var multiErr error // Use a multierror variable
 
+	if stopErr != nil {
+		n.recordEvent(shutdownCtx, journal.EventNodeStopped, "node stopped on error", map[string]string{"error": stopErr.Error()})
+	} else {
+		n.recordEvent(shutdownCtx, journal.EventNodeStopped, "node stopped", nil)
+	}
+
 	// Stop Header Sync Service
 	err = n.hSyncService.Stop(shutdownCtx)
 	if err != nil {
@@ -499,13 +893,76 @@ func (n *FullNode) Run(parentCtx context.Context) error {
 
 	// Return the original context error if it exists (e.g., context cancelled)
 	// or the combined shutdown error if the context cancellation was clean.
-	if ctx.Err() != nil {
+	// A requested shutdown is not an error.
+	if ctx.Err() != nil && !stopRequested {
 		return ctx.Err()
 	}
 
 	return multiErr // Return shutdown errors if context was okay
 }
 
+// recordEvent records an event to the journal, logging failures.
+func (n *FullNode) recordEvent(ctx context.Context, eventType, message string, attrs map[string]string) {
+	if err := n.journal.Record(ctx, eventType, message, attrs); err != nil {
+		n.Logger.Warn().Err(err).Str("type", eventType).Msg("failed to record event in journal")
+	}
+}
+
+// peerEvent is a peer connection event to record to the journal.
+type peerEvent struct {
+	eventType string
+	message   string
+	attrs     map[string]string
+}
+
+// peerEventsNotifiee returns a notifiee queuing an event to events when the node gets connected
+// to a peer, or loses its last connection to it. The notifications of the network are not
+// blocked by the store: events are dropped while events is full.
+func (n *FullNode) peerEventsNotifiee(events chan<- peerEvent) network.Notifiee {
+	queue := func(event peerEvent) {
+		select {
+		case events <- event:
+		default:
+			n.Logger.Warn().Str("type", event.eventType).Str("peer", event.attrs["peer"]).Msg("peer events buffer full, event not recorded in journal")
+		}
+	}
+	return &network.NotifyBundle{
+		ConnectedF: func(net network.Network, conn network.Conn) {
+			peerID := conn.RemotePeer()
+			if len(net.ConnsToPeer(peerID)) > 1 {
+				return
+			}
+			queue(peerEvent{journal.EventPeerConnected, "peer connected", map[string]string{
+				"peer":    peerID.String(),
+				"address": conn.RemoteMultiaddr().String(),
+			}})
+		},
+		DisconnectedF: func(net network.Network, conn network.Conn) {
+			peerID := conn.RemotePeer()
+			if net.Connectedness(peerID) == network.Connected {
+				return
+			}
+			queue(peerEvent{journal.EventPeerDisconnected, "peer disconnected", map[string]string{
+				"peer":    peerID.String(),
+				"address": conn.RemoteMultiaddr().String(),
+			}})
+		},
+	}
+}
+
+// recordPeerEvents records the peer events queued by the notifiee of peerEventsNotifiee to the
+// journal until ctx is done.
+func (n *FullNode) recordPeerEvents(ctx context.Context, events <-chan peerEvent) {
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case event := <-events:
+			n.recordEvent(ctx, event.eventType, event.message, event.attrs)
+		}
+	}
+}
+
 // GetGenesis returns entire genesis doc.
 func (n *FullNode) GetGenesis() genesispkg.Genesis {
 	return n.genesis
@@ -520,6 +977,25 @@ func (n *FullNode) GetGenesisChunks() ([]string, error) {
 	return n.genChunks, nil
 }
 
+// replicaLag returns the number of blocks the node is behind the highest header received from the network.
+func (n *FullNode) replicaLag(ctx context.Context) (uint64, error) {
+	height, err := n.Store.Height(ctx)
+	if err != nil {
+		return 0, err
+	}
+	head := n.hSyncService.Store().Height()
+	if head <= height {
+		return 0, nil
+	}
+	return head - height, nil
+}
+
+// Alerts returns the evaluator of the node's alert rules.
+// Subscribe to it to be notified when alerts start or stop firing.
+func (n *FullNode) Alerts() *alert.Evaluator {
+	return n.alerts
+}
+
 // IsRunning returns true if the node is running.
 func (n *FullNode) IsRunning() bool {
 	return n.blockManager != nil
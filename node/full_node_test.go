@@ -10,11 +10,14 @@ import (
 	"testing"
 	"time"
 
+	"github.com/ipfs/go-datastore/query"
 	"github.com/rs/zerolog"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
 	"github.com/evstack/ev-node/pkg/service"
+	"github.com/evstack/ev-node/pkg/store"
+	"github.com/evstack/ev-node/types"
 )
 
 func TestStartInstrumentationServer(t *testing.T) {
@@ -78,3 +81,81 @@ func TestStartInstrumentationServer(t *testing.T) {
 		assert.NoError(err, "Pprof server shutdown should not return error")
 	}
 }
+
+func TestFullNodeAdmin(t *testing.T) {
+	require := require.New(t)
+
+	node, cleanup := createNodeWithCleanup(t, getTestConfig(t, 1001))
+	defer cleanup()
+
+	errCh := make(chan error, 1)
+	go func() { errCh <- node.Run(context.Background()) }()
+	require.NoError(waitForFirstBlock(node, Store))
+
+	admin := &nodeAdmin{node: node}
+	_, _, err := admin.TriggerDASubmission()
+	require.NoError(err)
+	require.NoError(admin.CompactStore(context.Background()))
+
+	ctx := context.Background()
+	require.ErrorIs(admin.SetMetadata(ctx, "test-node-admin", "checkpoint", []byte{1}), store.ErrInvalidMetadataKey)
+	require.NoError(store.RegisterMetadataNamespace("test-node-admin"))
+	require.NoError(admin.SetMetadata(ctx, "test-node-admin", "checkpoint", []byte{1}))
+	value, err := node.Store.GetMetadata(ctx, store.AppMetadataKey+"/test-node-admin/checkpoint")
+	require.NoError(err)
+	require.Equal([]byte{1}, value)
+
+	// the node stops without its context being canceled
+	admin.Shutdown()
+	admin.Shutdown()
+	select {
+	case err := <-errCh:
+		require.NoError(err)
+	case <-time.After(10 * time.Second):
+		t.Fatal("node did not stop")
+	}
+}
+
+func TestRegisterMetadataNamespaces(t *testing.T) {
+	require.NoError(t, registerMetadataNamespaces(" test-config-a,, test-config-b "))
+	assert.Subset(t, store.MetadataNamespaces(), []string{"test-config-a", "test-config-b"})
+	assert.Error(t, registerMetadataNamespaces("Invalid/Namespace"))
+}
+
+func TestFullNodeDryRun(t *testing.T) {
+	require := require.New(t)
+
+	config := getTestConfig(t, 1002)
+	config.Node.DryRun = true
+	executor, sequencer, dac, p2pClient, ds, _, stopDAHeightTicker := createTestComponents(t, config)
+	node, cleanup := createNodeWithCustomComponents(t, config, executor, sequencer, dac, p2pClient, ds, stopDAHeightTicker)
+	defer cleanup()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	errCh := make(chan error, 1)
+	go func() { errCh <- node.Run(ctx) }()
+	require.NoError(waitForAtLeastNBlocks(node, 3, Store))
+
+	// the blocks are signed with a throwaway key and kept out of the data directory
+	header, _, err := node.Store.GetBlockData(ctx, 1)
+	require.NoError(err)
+	genesis, _, _ := types.GetGenesisWithPrivkey("test-chain")
+	require.NotEqual(genesis.ProposerAddress, header.ProposerAddress)
+	results, err := ds.Query(ctx, query.Query{KeysOnly: true})
+	require.NoError(err)
+	entries, err := results.Rest()
+	require.NoError(err)
+	require.Empty(entries)
+
+	cancel()
+	select {
+	case <-errCh:
+	case <-time.After(10 * time.Second):
+		t.Fatal("node did not stop")
+	}
+
+	// a dry run requires an aggregator
+	config.Node.Aggregator = false
+	_, err = newFullNode(context.Background(), config, p2pClient, nil, genesis, ds, executor, sequencer, dac, DefaultMetricsProvider(config.Instrumentation), zerolog.Nop(), NodeOptions{})
+	require.ErrorContains(err, "requires an aggregator")
+}
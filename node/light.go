@@ -10,6 +10,7 @@ import (
 	ds "github.com/ipfs/go-datastore"
 	"github.com/rs/zerolog"
 
+	"github.com/evstack/ev-node/pkg/buildinfo"
 	"github.com/evstack/ev-node/pkg/config"
 	"github.com/evstack/ev-node/pkg/genesis"
 	"github.com/evstack/ev-node/pkg/p2p"
@@ -30,7 +31,9 @@ type LightNode struct {
 	hSyncService *sync.HeaderSyncService
 	Store        store.Store
 	rpcServer    *http.Server
+	rpcDrainer   *rpcserver.Drainer
 	nodeConfig   config.Config
+	info         rpcserver.NodeInfo
 
 	running bool
 }
@@ -41,6 +44,7 @@ func newLightNode(
 	p2pClient *p2p.Client,
 	database ds.Batching,
 	logger zerolog.Logger,
+	nodeOpts NodeOptions,
 ) (ln *LightNode, err error) {
 	headerSyncService, err := sync.NewHeaderSyncService(database, conf, genesis, p2pClient, logger.With().Str("component", "HeaderSyncService").Logger())
 	if err != nil {
@@ -54,6 +58,12 @@ func newLightNode(
 		hSyncService: headerSyncService,
 		Store:        store,
 		nodeConfig:   conf,
+		info: rpcserver.NodeInfo{
+			Version:   nodeOpts.Version,
+			GitCommit: nodeOpts.GitCommit,
+			ChainID:   genesis.ChainID,
+			Build:     buildinfo.Read(),
+		},
 	}
 
 	node.BaseService = *service.NewBaseService(logger, "LightNode", node)
@@ -76,11 +86,16 @@ func (ln *LightNode) Run(parentCtx context.Context) error {
 	}()
 
 	ln.running = true
+	ln.info.StartTime = time.Now()
 	// Start RPC server
-	handler, err := rpcserver.NewServiceHandler(ln.Store, ln.P2P, ln.Logger, ln.nodeConfig)
+	handler, err := rpcserver.NewServiceHandler(ln.Store, ln.P2P, ln.Logger, ln.nodeConfig, rpcserver.ServiceOptions{Info: ln.info, ReadinessChecks: []rpcserver.ReadinessCheck{p2pReadinessCheck(ln.P2P)}})
 	if err != nil {
 		return fmt.Errorf("error creating RPC handler: %w", err)
 	}
+	if ln.nodeConfig.RPC.DrainTimeout.Duration > 0 {
+		ln.rpcDrainer = rpcserver.NewDrainer(ln.nodeConfig.RPC.DrainTimeout.Duration)
+		handler = ln.rpcDrainer.Handler(handler)
+	}
 
 	ln.rpcServer = &http.Server{
 		Addr:         ln.nodeConfig.RPC.Address,
@@ -90,12 +105,9 @@ func (ln *LightNode) Run(parentCtx context.Context) error {
 		IdleTimeout:  120 * time.Second,
 	}
 
-	go func() {
-		ln.Logger.Info().Str("addr", ln.nodeConfig.RPC.Address).Msg("started RPC server")
-		if err := ln.rpcServer.ListenAndServe(); err != nil && !errors.Is(err, http.ErrServerClosed) {
-			ln.Logger.Error().Err(err).Msg("RPC server error")
-		}
-	}()
+	if err := serveRPC(ln.rpcServer, ln.nodeConfig, ln.Logger); err != nil {
+		return fmt.Errorf("error starting RPC server: %w", err)
+	}
 
 	if err := ln.P2P.Start(ctx); err != nil {
 		return fmt.Errorf("error while starting P2P client: %w", err)
@@ -110,6 +122,7 @@ func (ln *LightNode) Run(parentCtx context.Context) error {
 	cancelNode()
 
 	ln.Logger.Info().Msg("halting light node and its sub services...")
+	drainRPC(ln.rpcDrainer, ln.nodeConfig.RPC.DrainTimeout.Duration, ln.Logger)
 
 	shutdownCtx, cancel := context.WithTimeout(context.Background(), 2*time.Second)
 	defer cancel()
@@ -45,7 +45,7 @@ func TestLightNodeLifecycle(t *testing.T) {
 	p2pClient, err := p2p.NewClient(conf.P2P, p2pKey.PrivKey, db, gen.ChainID, logger, p2pMetrics)
 	require.NoError(err)
 
-	ln, err := newLightNode(conf, gen, p2pClient, db, logger)
+	ln, err := newLightNode(conf, gen, p2pClient, db, logger, NodeOptions{})
 	require.NoError(err)
 	require.NotNil(ln)
 
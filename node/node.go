@@ -2,6 +2,10 @@ package node
 
 import (
 	"context"
+	"errors"
+	"net/http"
+	"path/filepath"
+	"time"
 
 	ds "github.com/ipfs/go-datastore"
 	"github.com/rs/zerolog"
@@ -13,6 +17,7 @@ import (
 	"github.com/evstack/ev-node/pkg/config"
 	"github.com/evstack/ev-node/pkg/genesis"
 	"github.com/evstack/ev-node/pkg/p2p"
+	rpcserver "github.com/evstack/ev-node/pkg/rpc/server"
 	"github.com/evstack/ev-node/pkg/service"
 	"github.com/evstack/ev-node/pkg/signer"
 )
@@ -26,6 +31,15 @@ type Node interface {
 
 type NodeOptions struct {
 	ManagerOptions block.ManagerOptions
+	// Version is the version of the node binary. A change of version between two starts is
+	// recorded in the event journal.
+	Version string
+	// GitCommit is the git commit the node binary was built from.
+	GitCommit string
+	// UpgradeExecutor, if set, is a new executor started with an empty state, e.g. a new major
+	// version of the execution client, into which a full node replays the chain in the background
+	// before switching to it. See block.Manager.UpgradeExecutor.
+	UpgradeExecutor coreexecutor.Executor
 }
 
 // NewNode returns a new Full or Light Node based on the config
@@ -46,11 +60,13 @@ func NewNode(
 	nodeOptions NodeOptions,
 ) (Node, error) {
 	if conf.Node.Light {
-		return newLightNode(conf, genesis, p2pClient, database, logger)
+		return newLightNode(conf, genesis, p2pClient, database, logger, nodeOptions)
 	}
 
 	if err := nodeOptions.ManagerOptions.Validate(); err != nil {
+		blobResolver := nodeOptions.ManagerOptions.BlobResolver
 		nodeOptions.ManagerOptions = block.DefaultManagerOptions()
+		nodeOptions.ManagerOptions.BlobResolver = blobResolver
 	}
 
 	return newFullNode(
@@ -68,3 +84,62 @@ func NewNode(
 		nodeOptions,
 	)
 }
+
+// p2pReadinessCheck checks that the P2P client is listening, which is not the case before it
+// is started or if it failed to.
+func p2pReadinessCheck(p2pClient *p2p.Client) rpcserver.ReadinessCheck {
+	return rpcserver.ReadinessCheck{
+		Name:     "p2p",
+		Critical: true,
+		Check: func(context.Context) error {
+			if p2pClient.Host() == nil || len(p2pClient.Addrs()) == 0 {
+				return errors.New("P2P client not listening")
+			}
+			return nil
+		},
+	}
+}
+
+// drainRPC rejects new RPC requests and waits up to timeout for the in-flight ones to complete,
+// before the services they depend on are stopped. Nothing is drained if drainer is nil or timeout
+// is not positive.
+func drainRPC(drainer *rpcserver.Drainer, timeout time.Duration, logger zerolog.Logger) {
+	if drainer == nil || timeout <= 0 {
+		return
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+	logger.Info().Dur("timeout", timeout).Msg("draining RPC requests")
+	if err := drainer.Drain(ctx); err != nil {
+		logger.Warn().Err(err).Msg("RPC requests still in flight after drain timeout")
+	}
+}
+
+// serveRPC serves the RPC server on its TCP address and, if configured, on a unix socket.
+// The unix socket is listened on first, so that an unusable path fails the start of the node.
+func serveRPC(server *http.Server, cfg config.Config, logger zerolog.Logger) error {
+	if cfg.RPC.UnixSocket != "" {
+		path := cfg.RPC.UnixSocket
+		if !filepath.IsAbs(path) {
+			path = filepath.Join(cfg.RootDir, path)
+		}
+		listener, err := rpcserver.ListenUnix(path)
+		if err != nil {
+			return err
+		}
+		go func() {
+			logger.Info().Str("socket", path).Msg("started RPC server on unix socket")
+			if err := server.Serve(listener); err != nil && !errors.Is(err, http.ErrServerClosed) {
+				logger.Error().Err(err).Msg("RPC unix socket server error")
+			}
+		}()
+	}
+
+	go func() {
+		logger.Info().Str("addr", cfg.RPC.Address).Msg("started RPC server")
+		if err := server.ListenAndServe(); err != nil && !errors.Is(err, http.ErrServerClosed) {
+			logger.Error().Err(err).Msg("RPC server error")
+		}
+	}()
+	return nil
+}
@@ -0,0 +1,284 @@
+//go:build integration
+
+package node
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/ipfs/go-datastore"
+	dssync "github.com/ipfs/go-datastore/sync"
+	"github.com/libp2p/go-libp2p/core/crypto"
+	"github.com/libp2p/go-libp2p/core/peer"
+	mocknet "github.com/libp2p/go-libp2p/p2p/net/mock"
+	"github.com/multiformats/go-multiaddr"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/require"
+
+	coreda "github.com/evstack/ev-node/core/da"
+	coreexecutor "github.com/evstack/ev-node/core/execution"
+	coresequencer "github.com/evstack/ev-node/core/sequencer"
+	evconfig "github.com/evstack/ev-node/pkg/config"
+	"github.com/evstack/ev-node/pkg/p2p"
+	"github.com/evstack/ev-node/pkg/p2p/key"
+	"github.com/evstack/ev-node/pkg/signer"
+	remote_signer "github.com/evstack/ev-node/pkg/signer/noop"
+	"github.com/evstack/ev-node/types"
+)
+
+// simNetwork is a sequencer, node 0, and full nodes connected over an in-memory libp2p network
+// whose links can be cut, restored and delayed, so that network faults are reproduced precisely.
+// Every node has its own DA layer, on which nothing is ever included, so that the full nodes
+// only sync over P2P. A node syncing while none of its peers has the missing blocks waits for
+// them until restarted, so the scenarios heal every partition they create.
+type simNetwork struct {
+	t       *testing.T
+	mn      mocknet.Mocknet
+	nodes   []*FullNode
+	peers   []peer.ID
+	cancels []context.CancelFunc
+	wg      sync.WaitGroup
+}
+
+// newSimNetwork creates a network of numNodes nodes where only the given pairs of nodes are linked.
+// Full nodes use the nodes they are linked to and started before them as seeds, from which they
+// fetch the first block when they start.
+func newSimNetwork(t *testing.T, numNodes int, links [][2]int) *simNetwork {
+	t.Helper()
+	n := &simNetwork{t: t, mn: mocknet.New()}
+	t.Cleanup(n.stop)
+
+	genesis, genesisValidatorKey, _ := types.GetGenesisWithPrivkey("test-chain")
+	for i := range numNodes {
+		var privKey crypto.PrivKey = genesisValidatorKey
+		if i > 0 {
+			nodeKey, err := key.GenerateNodeKey()
+			require.NoError(t, err)
+			privKey = nodeKey.PrivKey
+		}
+		addr, err := multiaddr.NewMultiaddr(fmt.Sprintf("/ip4/127.0.0.1/tcp/%d", 4000+i))
+		require.NoError(t, err)
+		h, err := n.mn.AddPeer(privKey, addr)
+		require.NoError(t, err)
+		n.peers = append(n.peers, h.ID())
+	}
+
+	seeds := make([][]string, numNodes)
+	for _, link := range links {
+		a, b := link[0], link[1]
+		_, err := n.mn.LinkPeers(n.peers[a], n.peers[b])
+		require.NoError(t, err)
+		seeds[max(a, b)] = append(seeds[max(a, b)], n.addr(min(a, b)))
+	}
+
+	for i := range numNodes {
+		config := getTestConfig(t, i)
+		config.Node.Aggregator = i == 0
+		config.P2P.Peers = strings.Join(seeds[i], ",")
+		// blocks are never included on DA, so that full nodes only sync over P2P
+		config.DA.BlockTime = evconfig.DurationWrapper{Duration: 100 * time.Second}
+
+		h := n.mn.Host(n.peers[i])
+		p2pClient, err := p2p.NewClientWithHost(config.P2P, h.Peerstore().PrivKey(h.ID()), dssync.MutexWrap(datastore.NewMapDatastore()), "test-chain", zerolog.Nop(), p2p.NopMetrics(), h)
+		require.NoError(t, err)
+		var nodeSigner signer.Signer
+		if i == 0 {
+			nodeSigner, err = remote_signer.NewNoopSigner(genesisValidatorKey)
+			require.NoError(t, err)
+		}
+
+		ctx, cancel := context.WithCancel(context.Background())
+		n.cancels = append(n.cancels, cancel)
+		node, err := NewNode(
+			ctx,
+			config,
+			coreexecutor.NewDummyExecutor(),
+			coresequencer.NewDummySequencer(),
+			coreda.NewDummyDA(100_000, 0, 0, config.DA.BlockTime.Duration),
+			nodeSigner,
+			p2pClient,
+			genesis,
+			dssync.MutexWrap(datastore.NewMapDatastore()),
+			DefaultMetricsProvider(evconfig.DefaultInstrumentationConfig()),
+			zerolog.Nop(),
+			NodeOptions{},
+		)
+		require.NoError(t, err)
+		n.nodes = append(n.nodes, node.(*FullNode))
+	}
+	return n
+}
+
+// addr returns the P2P address of node i.
+func (n *simNetwork) addr(i int) string {
+	return fmt.Sprintf("%s/p2p/%s", n.mn.Host(n.peers[i]).Addrs()[0], n.peers[i])
+}
+
+// start starts the sequencer, waits for its first block, then starts the full nodes in order,
+// each once the previous one synced the first block.
+func (n *simNetwork) start() {
+	n.t.Helper()
+	for i := range n.nodes {
+		n.run(i)
+		require.NoError(n.t, waitForFirstBlock(n.nodes[i], Store), "node %d did not start", i)
+	}
+}
+
+func (n *simNetwork) run(i int) {
+	ctx, cancel := context.WithCancel(context.Background())
+	n.cancels = append(n.cancels, cancel)
+	n.wg.Add(1)
+	go func() {
+		defer n.wg.Done()
+		if err := n.nodes[i].Run(ctx); err != nil && !errors.Is(err, context.Canceled) {
+			n.t.Logf("Error running node %d: %v", i, err)
+		}
+	}()
+}
+
+func (n *simNetwork) stop() {
+	for _, cancel := range n.cancels {
+		cancel()
+	}
+	done := make(chan struct{})
+	go func() {
+		n.wg.Wait()
+		close(done)
+	}()
+	select {
+	case <-done:
+	case <-time.After(10 * time.Second):
+		n.t.Log("Warning: Not all nodes stopped gracefully within timeout")
+	}
+	_ = n.mn.Close()
+}
+
+// cut removes the link between nodes a and b and closes their connections, as a network
+// partition between them would.
+func (n *simNetwork) cut(a, b int) {
+	n.t.Helper()
+	require.NoError(n.t, n.mn.UnlinkPeers(n.peers[a], n.peers[b]))
+	require.NoError(n.t, n.mn.DisconnectPeers(n.peers[a], n.peers[b]))
+}
+
+// restore links nodes a and b again and reconnects them. Both nodes dial, so that each has
+// registered the connection by the time the first block reaches it over the restored link.
+func (n *simNetwork) restore(a, b int) {
+	n.t.Helper()
+	_, err := n.mn.LinkPeers(n.peers[a], n.peers[b])
+	require.NoError(n.t, err)
+	for _, pair := range [][2]int{{a, b}, {b, a}} {
+		target := n.mn.Host(n.peers[pair[1]])
+		require.NoError(n.t, n.mn.Host(n.peers[pair[0]]).Connect(context.Background(), peer.AddrInfo{ID: target.ID(), Addrs: target.Addrs()}))
+	}
+}
+
+// partition cuts the links between the nodes of different groups.
+func (n *simNetwork) partition(groups ...[]int) {
+	n.t.Helper()
+	for i, group := range groups {
+		for _, other := range groups[i+1:] {
+			for _, a := range group {
+				for _, b := range other {
+					if len(n.mn.LinksBetweenPeers(n.peers[a], n.peers[b])) > 0 {
+						n.cut(a, b)
+					}
+				}
+			}
+		}
+	}
+}
+
+// delay sets the latency of the messages between nodes a and b.
+func (n *simNetwork) delay(a, b int, latency time.Duration) {
+	n.t.Helper()
+	links := n.mn.LinksBetweenPeers(n.peers[a], n.peers[b])
+	require.NotEmpty(n.t, links)
+	for _, link := range links {
+		link.SetOptions(mocknet.LinkOptions{Latency: latency})
+	}
+}
+
+// height returns the store height of node i.
+func (n *simNetwork) height(i int) uint64 {
+	n.t.Helper()
+	height, err := getNodeHeightFromStore(n.nodes[i])
+	require.NoError(n.t, err)
+	return height
+}
+
+// requireConverged waits for every node to reach the height of the sequencer, then checks that
+// they all hold the same blocks.
+func (n *simNetwork) requireConverged() {
+	n.t.Helper()
+	target := n.height(0)
+	for i, node := range n.nodes {
+		require.NoError(n.t, waitForAtLeastNBlocks(node, target, Store), "node %d did not converge", i)
+	}
+	assertAllNodesSynced(n.t, n.nodes, target)
+}
+
+// waitForProgress waits for the sequencer to produce blocks more blocks.
+func (n *simNetwork) waitForProgress(blocks uint64) {
+	n.t.Helper()
+	require.NoError(n.t, waitForAtLeastNBlocks(n.nodes[0], n.height(0)+blocks, Store))
+}
+
+// TestP2PSimulationPartition cuts the sequencer off from the full nodes, checks that they stall
+// while the sequencer keeps producing blocks, and that the chain converges once the partition heals.
+func TestP2PSimulationPartition(t *testing.T) {
+	net := newSimNetwork(t, 3, [][2]int{{0, 1}, {0, 2}, {1, 2}})
+	net.start()
+	net.requireConverged()
+
+	net.partition([]int{0}, []int{1, 2})
+	net.waitForProgress(5)
+	stalled := net.height(0)
+	for i := 1; i < 3; i++ {
+		require.Less(t, net.height(i)+3, stalled, "node %d synced through the partition", i)
+	}
+
+	net.restore(0, 1)
+	net.restore(0, 2)
+	net.waitForProgress(2)
+	net.requireConverged()
+}
+
+// TestP2PSimulationAsymmetricConnectivity checks that a full node which cannot reach the
+// sequencer, but reaches a full node which can, follows the chain through it.
+func TestP2PSimulationAsymmetricConnectivity(t *testing.T) {
+	net := newSimNetwork(t, 4, [][2]int{{0, 1}, {1, 2}, {2, 3}})
+	net.start()
+	net.waitForProgress(5)
+	net.requireConverged()
+
+	// the blocks are relayed over two hops, one of them slow
+	net.delay(1, 2, 150*time.Millisecond)
+	net.waitForProgress(10)
+	net.delay(1, 2, 0)
+	net.requireConverged()
+}
+
+// TestP2PSimulationReordering delays the link between the sequencer and a full node by several
+// block times, so that the node receives every block first through the other full node and then
+// again, out of order, from the sequencer, and checks that it converges without forking.
+func TestP2PSimulationReordering(t *testing.T) {
+	net := newSimNetwork(t, 3, [][2]int{{0, 1}, {0, 2}, {1, 2}})
+	net.start()
+	net.requireConverged()
+
+	net.delay(0, 1, 350*time.Millisecond)
+	net.delay(1, 2, 50*time.Millisecond)
+	net.waitForProgress(10)
+	// the latency changes again while blocks are in flight
+	net.delay(0, 1, 0)
+	net.delay(0, 2, 250*time.Millisecond)
+	net.waitForProgress(10)
+	net.delay(0, 2, 0)
+	net.requireConverged()
+}
@@ -6,6 +6,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"path/filepath"
 	"sync"
 	"testing"
 	"time"
@@ -16,6 +17,7 @@ import (
 	coreda "github.com/evstack/ev-node/core/da"
 	coreexecutor "github.com/evstack/ev-node/core/execution"
 	evconfig "github.com/evstack/ev-node/pkg/config"
+	"github.com/evstack/ev-node/test/fixture"
 
 	testutils "github.com/celestiaorg/utils/test"
 )
@@ -258,6 +260,45 @@ func TestStateRecovery(t *testing.T) {
 	require.GreaterOrEqual(recoveredHeight, originalHeight)
 }
 
+// TestStateRecoveryFromFixture verifies that a node can start from a fixture dumped from another node,
+// instead of replaying block production.
+func TestStateRecoveryFromFixture(t *testing.T) {
+	require := require.New(t)
+
+	config := getTestConfig(t, 1)
+	executor, sequencer, dac, p2pClient, ds, _, stopDAHeightTicker := createTestComponents(t, config)
+	node, cleanup := createNodeWithCustomComponents(t, config, executor, sequencer, dac, p2pClient, ds, stopDAHeightTicker)
+	defer cleanup()
+
+	var runningWg sync.WaitGroup
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	startNodeInBackground(t, []*FullNode{node}, []context.Context{ctx}, &runningWg, 0)
+	require.NoError(waitForAtLeastNBlocks(node, 10, Store))
+
+	cancel()
+	runningWg.Wait()
+	originalState, err := node.Store.GetState(context.Background())
+	require.NoError(err)
+
+	dumped, err := fixture.Dump(context.Background(), "stopped-aggregator", config, node.genesis, ds)
+	require.NoError(err)
+	path := filepath.Join(t.TempDir(), "fixture.json")
+	require.NoError(dumped.Save(path))
+
+	loaded, err := fixture.Load(path)
+	require.NoError(err)
+	executor, sequencer, dac, p2pClient, ds, _, stopDAHeightTicker = createTestComponents(t, loaded.Config)
+	require.NoError(loaded.Restore(context.Background(), ds))
+	node, cleanup = createNodeWithCustomComponents(t, loaded.Config, executor, sequencer, dac, p2pClient, ds, stopDAHeightTicker)
+	defer cleanup()
+
+	recoveredState, err := node.Store.GetState(context.Background())
+	require.NoError(err)
+	require.Equal(originalState.LastBlockHeight, recoveredState.LastBlockHeight)
+	require.Equal(originalState.AppHash, recoveredState.AppHash)
+}
+
 // TestMaxPendingHeadersAndData verifies that the sequencer will stop producing blocks when the maximum number of pending headers or data is reached.
 // It reconfigures the node with a low max pending value, waits for block production, and checks the pending block count.
 func TestMaxPendingHeadersAndData(t *testing.T) {
@@ -0,0 +1,156 @@
+package alert
+
+import (
+	"context"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/rs/zerolog"
+)
+
+// Rule is an alert condition evaluated periodically inside the node.
+type Rule struct {
+	// Name uniquely identifies the rule.
+	Name string
+	// Description explains the condition in human readable form.
+	Description string
+	// Check evaluates the condition. It returns whether the alert is firing and a message
+	// describing the current value of the condition.
+	Check func(ctx context.Context) (firing bool, message string)
+}
+
+// State is the current state of a rule.
+type State struct {
+	Name        string
+	Description string
+	Firing      bool
+	// Message describes the current value of the condition.
+	Message string
+	// Since is the time of the last transition between firing and resolved.
+	// It is zero if the rule has not been evaluated yet.
+	Since time.Time
+}
+
+// Evaluator evaluates a set of rules and keeps track of their states.
+// Transitions between firing and resolved are published to subscribers.
+type Evaluator struct {
+	rules    []Rule
+	interval time.Duration
+	logger   zerolog.Logger
+
+	mu          sync.RWMutex
+	states      map[string]State
+	subscribers map[chan State]struct{}
+}
+
+// NewEvaluator creates an Evaluator for the given rules, evaluated every interval once Run is called.
+func NewEvaluator(rules []Rule, interval time.Duration, logger zerolog.Logger) *Evaluator {
+	states := make(map[string]State, len(rules))
+	for _, r := range rules {
+		states[r.Name] = State{Name: r.Name, Description: r.Description}
+	}
+	return &Evaluator{
+		rules:       rules,
+		interval:    interval,
+		logger:      logger,
+		states:      states,
+		subscribers: make(map[chan State]struct{}),
+	}
+}
+
+// Run evaluates the rules every interval until the context is canceled.
+func (e *Evaluator) Run(ctx context.Context) {
+	ticker := time.NewTicker(e.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			e.Evaluate(ctx)
+		}
+	}
+}
+
+// Evaluate evaluates all rules once and publishes the resulting transitions.
+func (e *Evaluator) Evaluate(ctx context.Context) {
+	now := time.Now()
+	for _, r := range e.rules {
+		firing, message := r.Check(ctx)
+
+		e.mu.Lock()
+		prev := e.states[r.Name]
+		state := State{
+			Name:        r.Name,
+			Description: r.Description,
+			Firing:      firing,
+			Message:     message,
+			Since:       prev.Since,
+		}
+		transition := prev.Since.IsZero() || prev.Firing != firing
+		if transition {
+			state.Since = now
+		}
+		e.states[r.Name] = state
+		e.mu.Unlock()
+
+		// the initial evaluation of a resolved rule is not a transition worth reporting
+		if !transition || (prev.Since.IsZero() && !firing) {
+			continue
+		}
+		if firing {
+			e.logger.Warn().Str("alert", r.Name).Str("message", message).Msg("alert firing")
+		} else {
+			e.logger.Info().Str("alert", r.Name).Str("message", message).Msg("alert resolved")
+		}
+		e.publish(state)
+	}
+}
+
+// Alerts returns the current states of all rules, sorted by name.
+func (e *Evaluator) Alerts() []State {
+	e.mu.RLock()
+	defer e.mu.RUnlock()
+
+	states := make([]State, 0, len(e.states))
+	for _, s := range e.states {
+		states = append(states, s)
+	}
+	sort.Slice(states, func(i, j int) bool { return states[i].Name < states[j].Name })
+	return states
+}
+
+// Subscribe returns a channel receiving the state of a rule whenever it starts or stops firing,
+// and a function to cancel the subscription. Transitions are dropped for subscribers whose
+// buffer is full, so that a slow subscriber cannot block the evaluation.
+func (e *Evaluator) Subscribe(buffer int) (<-chan State, func()) {
+	ch := make(chan State, buffer)
+
+	e.mu.Lock()
+	e.subscribers[ch] = struct{}{}
+	e.mu.Unlock()
+
+	var once sync.Once
+	return ch, func() {
+		once.Do(func() {
+			e.mu.Lock()
+			delete(e.subscribers, ch)
+			e.mu.Unlock()
+			close(ch)
+		})
+	}
+}
+
+func (e *Evaluator) publish(state State) {
+	e.mu.RLock()
+	defer e.mu.RUnlock()
+	for ch := range e.subscribers {
+		select {
+		case ch <- state:
+		default:
+			e.logger.Debug().Str("alert", state.Name).Msg("dropping alert transition for slow subscriber")
+		}
+	}
+}
@@ -0,0 +1,162 @@
+package alert
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEvaluator(t *testing.T) {
+	ctx := context.Background()
+	peers := 1
+	pendingHeaders := uint64(0)
+	e := NewEvaluator([]Rule{
+		NoPeersRule(func() int { return peers }),
+		DABacklogRule(10, func() (uint64, uint64) { return pendingHeaders, 0 }),
+	}, time.Second, zerolog.Nop())
+
+	transitions, cancel := e.Subscribe(10)
+	defer cancel()
+
+	// rules are listed before their first evaluation
+	alerts := e.Alerts()
+	require.Len(t, alerts, 2)
+	assert.Equal(t, RuleDABacklog, alerts[0].Name)
+	assert.Equal(t, RuleNoPeers, alerts[1].Name)
+	assert.True(t, alerts[0].Since.IsZero())
+
+	// resolved rules are not reported on the first evaluation
+	e.Evaluate(ctx)
+	require.Empty(t, transitions)
+	alerts = e.Alerts()
+	assert.False(t, alerts[1].Firing)
+	assert.False(t, alerts[1].Since.IsZero())
+	resolvedSince := alerts[1].Since
+
+	peers = 0
+	e.Evaluate(ctx)
+	require.Len(t, transitions, 1)
+	state := <-transitions
+	assert.Equal(t, RuleNoPeers, state.Name)
+	assert.True(t, state.Firing)
+	assert.Equal(t, "0 connected peers", state.Message)
+	assert.False(t, state.Since.Before(resolvedSince))
+	firingSince := state.Since
+
+	// a rule that keeps firing is not reported again and keeps its transition time
+	pendingHeaders = 11
+	e.Evaluate(ctx)
+	require.Len(t, transitions, 1)
+	state = <-transitions
+	assert.Equal(t, RuleDABacklog, state.Name)
+	assert.Equal(t, "11 headers and 0 data pending DA submission", state.Message)
+	assert.Equal(t, firingSince, e.Alerts()[1].Since)
+
+	peers = 3
+	e.Evaluate(ctx)
+	require.Len(t, transitions, 1)
+	state = <-transitions
+	assert.Equal(t, RuleNoPeers, state.Name)
+	assert.False(t, state.Firing)
+
+	// canceled subscriptions are closed and no longer receive transitions
+	cancel()
+	_, ok := <-transitions
+	assert.False(t, ok)
+	peers = 0
+	e.Evaluate(ctx)
+}
+
+func TestEvaluatorSlowSubscriber(t *testing.T) {
+	firing := false
+	e := NewEvaluator([]Rule{{
+		Name:  "test",
+		Check: func(context.Context) (bool, string) { return firing, "" },
+	}}, time.Second, zerolog.Nop())
+	transitions, cancel := e.Subscribe(1)
+	defer cancel()
+
+	e.Evaluate(context.Background())
+	for i := 0; i < 3; i++ {
+		firing = !firing
+		e.Evaluate(context.Background())
+	}
+	// transitions beyond the buffer are dropped instead of blocking the evaluation
+	require.Len(t, transitions, 1)
+	assert.True(t, (<-transitions).Firing)
+	// the state is up to date regardless
+	assert.True(t, e.Alerts()[0].Firing)
+}
+
+func TestEvaluatorRun(t *testing.T) {
+	e := NewEvaluator([]Rule{NoPeersRule(func() int { return 0 })}, 10*time.Millisecond, zerolog.Nop())
+	transitions, cancel := e.Subscribe(1)
+	defer cancel()
+
+	ctx, cancelRun := context.WithCancel(context.Background())
+	defer cancelRun()
+	go e.Run(ctx)
+
+	select {
+	case state := <-transitions:
+		assert.Equal(t, RuleNoPeers, state.Name)
+		assert.True(t, state.Firing)
+	case <-time.After(time.Second):
+		t.Fatal("expected alert to fire")
+	}
+}
+
+func TestRules(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("no recent block", func(t *testing.T) {
+		last := time.Time{}
+		r := NoRecentBlockRule(time.Second, func() time.Time { return last })
+		firing, _ := r.Check(ctx)
+		assert.False(t, firing, "must not fire before the first block")
+
+		last = time.Now().Add(-4 * time.Second)
+		firing, _ = r.Check(ctx)
+		assert.False(t, firing)
+
+		last = time.Now().Add(-6 * time.Second)
+		firing, _ = r.Check(ctx)
+		assert.True(t, firing)
+	})
+
+	t.Run("da backlog", func(t *testing.T) {
+		r := DABacklogRule(10, func() (uint64, uint64) { return 10, 11 })
+		firing, _ := r.Check(ctx)
+		assert.True(t, firing, "must fire if data exceeds the threshold")
+
+		r = DABacklogRule(10, func() (uint64, uint64) { return 10, 10 })
+		firing, _ = r.Check(ctx)
+		assert.False(t, firing)
+	})
+
+	t.Run("signer unreachable", func(t *testing.T) {
+		r := SignerUnreachableRule(func() error { return errors.New("connection refused") })
+		firing, message := r.Check(ctx)
+		assert.True(t, firing)
+		assert.Equal(t, "connection refused", message)
+
+		r = SignerUnreachableRule(func() error { return nil })
+		firing, _ = r.Check(ctx)
+		assert.False(t, firing)
+	})
+	t.Run("execution divergence", func(t *testing.T) {
+		r := ExecutionDivergenceRule(func() (uint64, bool) { return 0, false })
+		firing, _ := r.Check(ctx)
+		assert.False(t, firing)
+
+		r = ExecutionDivergenceRule(func() (uint64, bool) { return 42, true })
+		firing, message := r.Check(ctx)
+		assert.True(t, firing)
+		assert.Contains(t, message, "height 42")
+	})
+}
@@ -0,0 +1,94 @@
+package alert
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+// Names of the built-in rules.
+const (
+	RuleDABacklog           = "da_backlog"
+	RuleNoRecentBlock       = "no_recent_block"
+	RuleNoPeers             = "no_peers"
+	RuleSignerUnreachable   = "signer_unreachable"
+	RuleExecutionDivergence = "execution_divergence"
+)
+
+// noRecentBlockIntervals is the number of block times without a block after which NoRecentBlockRule fires.
+const noRecentBlockIntervals = 5
+
+// DABacklogRule fires when more than threshold headers or data are waiting for DA submission.
+// pending returns the number of pending headers and data.
+func DABacklogRule(threshold uint64, pending func() (headers, data uint64)) Rule {
+	return Rule{
+		Name:        RuleDABacklog,
+		Description: fmt.Sprintf("more than %d headers or data pending DA submission", threshold),
+		Check: func(context.Context) (bool, string) {
+			headers, data := pending()
+			return headers > threshold || data > threshold,
+				fmt.Sprintf("%d headers and %d data pending DA submission", headers, data)
+		},
+	}
+}
+
+// NoRecentBlockRule fires when no block was produced or synced for 5 block times.
+// lastBlockTime returns the time of the latest block, or the zero time if there is none yet.
+func NoRecentBlockRule(blockTime time.Duration, lastBlockTime func() time.Time) Rule {
+	limit := noRecentBlockIntervals * blockTime
+	return Rule{
+		Name:        RuleNoRecentBlock,
+		Description: fmt.Sprintf("no block in %s (%dx block time)", limit, noRecentBlockIntervals),
+		Check: func(context.Context) (bool, string) {
+			last := lastBlockTime()
+			if last.IsZero() {
+				return false, "no block yet"
+			}
+			age := time.Since(last).Truncate(time.Millisecond)
+			return age > limit, fmt.Sprintf("last block %s ago", age)
+		},
+	}
+}
+
+// NoPeersRule fires when the node is not connected to any peer.
+func NoPeersRule(peerCount func() int) Rule {
+	return Rule{
+		Name:        RuleNoPeers,
+		Description: "no connected P2P peers",
+		Check: func(context.Context) (bool, string) {
+			n := peerCount()
+			return n == 0, fmt.Sprintf("%d connected peers", n)
+		},
+	}
+}
+
+// SignerUnreachableRule fires when the block signer cannot be reached.
+// ping is expected to perform a cheap round trip to the signer, e.g. fetching its public key.
+func SignerUnreachableRule(ping func() error) Rule {
+	return Rule{
+		Name:        RuleSignerUnreachable,
+		Description: "block signer is unreachable",
+		Check: func(context.Context) (bool, string) {
+			if err := ping(); err != nil {
+				return true, err.Error()
+			}
+			return false, "signer reachable"
+		},
+	}
+}
+
+// ExecutionDivergenceRule fires once the execution of a shadow replica diverged from the sequencer.
+// divergence returns the height of the diverging block, if any.
+func ExecutionDivergenceRule(divergence func() (height uint64, diverged bool)) Rule {
+	return Rule{
+		Name:        RuleExecutionDivergence,
+		Description: "state root diverged from the sequencer",
+		Check: func(context.Context) (bool, string) {
+			height, diverged := divergence()
+			if !diverged {
+				return false, "no divergence"
+			}
+			return true, fmt.Sprintf("state root diverged from the sequencer at height %d", height)
+		},
+	}
+}
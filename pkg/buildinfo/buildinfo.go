@@ -0,0 +1,123 @@
+// Package buildinfo reports the provenance of the node binary, so that the operators of a network
+// can confirm that all its nodes run the same audited build.
+package buildinfo
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"maps"
+	"runtime/debug"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+
+	pb "github.com/evstack/ev-node/types/pb/evnode/v1"
+)
+
+// Builder identifies who built the binary, e.g. a CI job. It is set at build time.
+var Builder string
+
+// ErrDigestMismatch is returned by Verify when a binary was not built from the expected inputs.
+var ErrDigestMismatch = errors.New("build digest mismatch")
+
+// ErrDirtyBuild is returned by Verify when a binary was built from a checkout with uncommitted
+// changes, whose sources are not identified by the VCS revision.
+var ErrDirtyBuild = errors.New("build from modified sources")
+
+// ignoredSettings are the build settings left out of the digest. The linker flags set the builder
+// and the version strings of the binary, which are not inputs of the build itself.
+var ignoredSettings = map[string]bool{"-ldflags": true}
+
+var read = sync.OnceValue(func() *pb.BuildInfo {
+	info, ok := debug.ReadBuildInfo()
+	if !ok {
+		return &pb.BuildInfo{Builder: Builder}
+	}
+	return FromDebug(info, Builder)
+})
+
+// Read returns the provenance of the running binary.
+func Read() *pb.BuildInfo {
+	return read()
+}
+
+// FromDebug returns the provenance of a binary from the build information embedded by the Go
+// toolchain, and the builder set at build time.
+func FromDebug(info *debug.BuildInfo, builder string) *pb.BuildInfo {
+	build := &pb.BuildInfo{
+		GoVersion: info.GoVersion,
+		Main:      moduleVersion(&info.Main),
+		Builder:   builder,
+		Settings:  make(map[string]string, len(info.Settings)),
+	}
+	for _, setting := range info.Settings {
+		switch setting.Key {
+		case "vcs.revision":
+			build.VcsRevision = setting.Value
+		case "vcs.time":
+			build.VcsTime = setting.Value
+		case "vcs.modified":
+			build.VcsModified, _ = strconv.ParseBool(setting.Value)
+		}
+		build.Settings[setting.Key] = setting.Value
+	}
+	for _, dep := range info.Deps {
+		build.Deps = append(build.Deps, moduleVersion(dep))
+	}
+	build.Digest = Digest(build)
+	return build
+}
+
+// moduleVersion returns the module actually linked into the binary, i.e. its replacement if the
+// module was replaced.
+func moduleVersion(module *debug.Module) *pb.ModuleVersion {
+	if module.Replace != nil {
+		module = module.Replace
+	}
+	return &pb.ModuleVersion{Path: module.Path, Version: module.Version, Sum: module.Sum}
+}
+
+// Digest returns the hex encoded SHA-256 digest of the build inputs of a binary: the Go toolchain,
+// the versions and checksums of the modules and the build settings, including the VCS revision.
+// The builder and the linker flags are left out, so that binaries built reproducibly from the same
+// sources have the same digest.
+func Digest(build *pb.BuildInfo) string {
+	h := sha256.New()
+	fmt.Fprintf(h, "go\t%s\n", build.GetGoVersion())
+	fmt.Fprintf(h, "mod\t%s\t%s\t%s\n", build.GetMain().GetPath(), build.GetMain().GetVersion(), build.GetMain().GetSum())
+	deps := slices.Clone(build.GetDeps())
+	slices.SortFunc(deps, func(a, b *pb.ModuleVersion) int { return strings.Compare(a.GetPath(), b.GetPath()) })
+	for _, dep := range deps {
+		fmt.Fprintf(h, "dep\t%s\t%s\t%s\n", dep.GetPath(), dep.GetVersion(), dep.GetSum())
+	}
+	for _, key := range slices.Sorted(maps.Keys(build.GetSettings())) {
+		if !ignoredSettings[key] {
+			fmt.Fprintf(h, "build\t%s=%s\n", key, build.GetSettings()[key])
+		}
+	}
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+// Verify checks that the provenance reported by a node matches the expected build digest, e.g. the
+// digest of the audited build of the network, and that the binary was not built from modified
+// sources. The digest is recomputed from the reported build inputs, which must be consistent with
+// it. The provenance is reported by the node itself: Verify detects nodes running another build by
+// mistake, but not a node lying about its build, which can report the inputs of the expected one.
+func Verify(build *pb.BuildInfo, digest string) error {
+	if build == nil {
+		return errors.New("node does not report its build")
+	}
+	if build.GetVcsModified() || build.GetSettings()["vcs.modified"] == "true" {
+		return fmt.Errorf("%w: node runs build %s of revision %s with uncommitted changes", ErrDirtyBuild, build.GetDigest(), build.GetVcsRevision())
+	}
+	if actual := Digest(build); actual != build.GetDigest() {
+		return fmt.Errorf("%w: reported digest %s does not match the reported build inputs (%s)", ErrDigestMismatch, build.GetDigest(), actual)
+	}
+	if build.GetDigest() != digest {
+		return fmt.Errorf("%w: node runs build %s (revision %s), expected %s", ErrDigestMismatch, build.GetDigest(), build.GetVcsRevision(), digest)
+	}
+	return nil
+}
@@ -0,0 +1,84 @@
+package buildinfo
+
+import (
+	"runtime/debug"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func testBuildInfo() *debug.BuildInfo {
+	return &debug.BuildInfo{
+		GoVersion: "go1.24.0",
+		Main:      debug.Module{Path: "github.com/evstack/ev-node/apps/testapp", Version: "(devel)"},
+		Deps: []*debug.Module{
+			{Path: "github.com/rs/zerolog", Version: "v1.34.0", Sum: "h1:zerolog"},
+			{
+				Path: "github.com/evstack/ev-node", Version: "v1.0.0", Sum: "h1:upstream",
+				Replace: &debug.Module{Path: "../..", Version: ""},
+			},
+		},
+		Settings: []debug.BuildSetting{
+			{Key: "-trimpath", Value: "true"},
+			{Key: "-ldflags", Value: "-X github.com/evstack/ev-node/pkg/buildinfo.Builder=ci"},
+			{Key: "GOOS", Value: "linux"},
+			{Key: "vcs.revision", Value: "abcdef"},
+			{Key: "vcs.time", Value: "2025-01-02T03:04:05Z"},
+			{Key: "vcs.modified", Value: "true"},
+		},
+	}
+}
+
+func TestFromDebug(t *testing.T) {
+	build := FromDebug(testBuildInfo(), "ci")
+	require.Equal(t, "go1.24.0", build.GoVersion)
+	require.Equal(t, "github.com/evstack/ev-node/apps/testapp", build.Main.Path)
+	require.Equal(t, "abcdef", build.VcsRevision)
+	require.Equal(t, "2025-01-02T03:04:05Z", build.VcsTime)
+	require.True(t, build.VcsModified)
+	require.Equal(t, "ci", build.Builder)
+	require.Equal(t, "linux", build.Settings["GOOS"])
+	require.Len(t, build.Deps, 2)
+	// replaced modules are reported as the module actually linked
+	require.Equal(t, "../..", build.Deps[1].Path)
+	require.Equal(t, Digest(build), build.Digest)
+}
+
+func TestDigest(t *testing.T) {
+	build := FromDebug(testBuildInfo(), "ci")
+
+	// the builder and the linker flags are not inputs of the build
+	other := testBuildInfo()
+	other.Settings[1].Value = "-X github.com/evstack/ev-node/pkg/buildinfo.Builder=someone-else"
+	require.Equal(t, build.Digest, FromDebug(other, "someone-else").Digest)
+
+	// the order of the dependencies does not matter
+	other = testBuildInfo()
+	other.Deps[0], other.Deps[1] = other.Deps[1], other.Deps[0]
+	require.Equal(t, build.Digest, FromDebug(other, "ci").Digest)
+
+	other = testBuildInfo()
+	other.Deps[0].Version = "v1.35.0"
+	require.NotEqual(t, build.Digest, FromDebug(other, "ci").Digest)
+
+	other = testBuildInfo()
+	other.Settings[3].Value = "123456"
+	require.NotEqual(t, build.Digest, FromDebug(other, "ci").Digest)
+}
+
+func TestVerify(t *testing.T) {
+	dirty := FromDebug(testBuildInfo(), "ci")
+	require.ErrorIs(t, Verify(dirty, dirty.Digest), ErrDirtyBuild)
+
+	info := testBuildInfo()
+	info.Settings[len(info.Settings)-1].Value = "false"
+	build := FromDebug(info, "ci")
+	require.NoError(t, Verify(build, build.Digest))
+	require.ErrorIs(t, Verify(build, "other"), ErrDigestMismatch)
+	require.Error(t, Verify(nil, build.Digest))
+
+	// the reported digest must match the reported build inputs
+	build.VcsRevision = "123456"
+	build.Settings["vcs.revision"] = "123456"
+	require.ErrorIs(t, Verify(build, build.Digest), ErrDigestMismatch)
+}
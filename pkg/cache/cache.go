@@ -13,6 +13,9 @@ type Cache[T any] struct {
 	items      *sync.Map
 	hashes     *sync.Map
 	daIncluded *sync.Map
+
+	// spill bounds the memory used by items, nil if unbounded
+	spill *spill
 }
 
 // NewCache returns a new Cache struct
@@ -28,6 +31,9 @@ func NewCache[T any]() *Cache[T] {
 func (c *Cache[T]) GetItem(height uint64) *T {
 	item, ok := c.items.Load(height)
 	if !ok {
+		if c.spill != nil {
+			return c.getSpilledItem(height)
+		}
 		return nil
 	}
 	val := item.(*T)
@@ -36,11 +42,21 @@ func (c *Cache[T]) GetItem(height uint64) *T {
 
 // SetItem sets an item in the cache by height
 func (c *Cache[T]) SetItem(height uint64, item *T) {
+	if c.spill != nil {
+		c.setItemBounded(height, item)
+		return
+	}
 	c.items.Store(height, item)
 }
 
 // DeleteItem deletes an item from the cache by height
 func (c *Cache[T]) DeleteItem(height uint64) {
+	if c.spill != nil {
+		c.spill.mu.Lock()
+		defer c.spill.mu.Unlock()
+		c.deleteItemBoundedLocked(height)
+		return
+	}
 	c.items.Delete(height)
 }
 
@@ -119,7 +135,8 @@ func loadMapGob[K comparable, V any](filePath string) (map[K]V, error) {
 	return m, nil
 }
 
-// SaveToDisk saves the cache contents to disk in the specified folder.
+// SaveToDisk saves the cache contents to disk in the specified folder. Items spilled to disk are
+// copied as they are rather than read back into memory.
 // It's the caller's responsibility to ensure that type T (and any types it contains)
 // are registered with the gob package if necessary (e.g., using gob.Register).
 func (c *Cache[T]) SaveToDisk(folderPath string) error {
@@ -151,6 +168,11 @@ func (c *Cache[T]) SaveToDisk(folderPath string) error {
 		return invalidItemsErr
 	}
 
+	// items spilled to disk are saved as they are, next to the ones in memory
+	if err := c.saveSpilledItems(filepath.Join(folderPath, spilledItemsDirname)); err != nil {
+		return err
+	}
+
 	if err := saveMapGob(filepath.Join(folderPath, itemsByHeightFilename), itemsByHeightMap); err != nil {
 		return err
 	}
@@ -196,7 +218,10 @@ func (c *Cache[T]) LoadFromDisk(folderPath string) error {
 		return fmt.Errorf("failed to load items by height: %w", err)
 	}
 	for k, v := range itemsByHeightMap {
-		c.items.Store(k, v)
+		c.SetItem(k, v)
+	}
+	if err := c.loadSpilledItems(filepath.Join(folderPath, spilledItemsDirname)); err != nil {
+		return fmt.Errorf("failed to load spilled items: %w", err)
 	}
 
 	// load items by hash
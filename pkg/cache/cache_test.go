@@ -331,3 +331,89 @@ func TestCachePersistence_Overwrite(t *testing.T) {
 	assert.Equal(t, val2, *loadedVal2, "Item from second save should be present")
 	assert.True(t, cache3.IsDAIncluded("hash2"), "DAIncluded hash from second save should be present")
 }
+
+// spillItem is a cache item that can be spilled to disk.
+type spillItem struct {
+	Data string
+}
+
+func (s *spillItem) MarshalBinary() ([]byte, error) {
+	return []byte(s.Data), nil
+}
+
+func (s *spillItem) UnmarshalBinary(data []byte) error {
+	s.Data = string(data)
+	return nil
+}
+
+// TestCacheSpill tests that items beyond the memory limit are spilled to disk and read back.
+func TestCacheSpill(t *testing.T) {
+	spillDir := filepath.Join(t.TempDir(), "spill")
+	cache := NewCache[spillItem]()
+	require.NoError(t, cache.EnableSpill(10, spillDir))
+
+	cache.SetItem(1, &spillItem{Data: "aaaa"})
+	cache.SetItem(2, &spillItem{Data: "bbbb"})
+	cache.SetItem(3, &spillItem{Data: "cccc"}) // does not fit anymore
+	assert.Equal(t, uint64(8), cache.MemoryBytes())
+	assert.Equal(t, 1, cache.SpilledItems())
+	assert.FileExists(t, filepath.Join(spillDir, "3.bin"))
+
+	// spilled items are transparently read back
+	require.NotNil(t, cache.GetItem(3))
+	assert.Equal(t, "cccc", cache.GetItem(3).Data)
+	assert.Equal(t, "aaaa", cache.GetItem(1).Data)
+
+	// deleting an item releases its memory, so new items are kept in memory again
+	cache.DeleteItem(1)
+	assert.Equal(t, uint64(4), cache.MemoryBytes())
+	cache.SetItem(4, &spillItem{Data: "dddd"})
+	assert.Equal(t, uint64(8), cache.MemoryBytes())
+	assert.Equal(t, 1, cache.SpilledItems())
+
+	// replacing a spilled item
+	cache.SetItem(3, &spillItem{Data: "eeeeee"})
+	assert.Equal(t, "eeeeee", cache.GetItem(3).Data)
+	assert.Equal(t, 1, cache.SpilledItems())
+
+	// deleting a spilled item removes its file
+	cache.DeleteItem(3)
+	assert.Nil(t, cache.GetItem(3))
+	assert.Zero(t, cache.SpilledItems())
+	assert.NoFileExists(t, filepath.Join(spillDir, "3.bin"))
+
+	// spilled items are persisted as they are, with the in-memory ones
+	cache.SetItem(5, &spillItem{Data: "ffff"})
+	require.Equal(t, 1, cache.SpilledItems())
+	gob.Register(&spillItem{})
+	persistDir := t.TempDir()
+	require.NoError(t, cache.SaveToDisk(persistDir))
+	assert.FileExists(t, filepath.Join(persistDir, spilledItemsDirname, "5.bin"))
+
+	loaded := NewCache[spillItem]()
+	require.NoError(t, loaded.LoadFromDisk(persistDir))
+	for height, want := range map[uint64]string{2: "bbbb", 4: "dddd", 5: "ffff"} {
+		require.NotNil(t, loaded.GetItem(height))
+		assert.Equal(t, want, loaded.GetItem(height).Data)
+	}
+
+	// and loaded back to disk by a cache spilling to disk
+	bounded := NewCache[spillItem]()
+	require.NoError(t, bounded.EnableSpill(10, filepath.Join(t.TempDir(), "spill")))
+	require.NoError(t, bounded.LoadFromDisk(persistDir))
+	assert.Equal(t, 1, bounded.SpilledItems())
+	assert.Equal(t, uint64(8), bounded.MemoryBytes())
+	require.NotNil(t, bounded.GetItem(5))
+	assert.Equal(t, "ffff", bounded.GetItem(5).Data)
+
+	// saving again without spilled items drops the previously saved ones
+	bounded.DeleteItem(5)
+	require.NoError(t, bounded.SaveToDisk(persistDir))
+	assert.NoDirExists(t, filepath.Join(persistDir, spilledItemsDirname))
+}
+
+// TestCacheSpill_UnsupportedType tests that spilling requires binary encodable items.
+func TestCacheSpill_UnsupportedType(t *testing.T) {
+	cache := NewCache[string]()
+	require.Error(t, cache.EnableSpill(10, t.TempDir()))
+}
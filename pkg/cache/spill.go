@@ -0,0 +1,239 @@
+package cache
+
+import (
+	"encoding"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+// spilledItemsDirname is the directory of the spilled items in a folder the cache is saved to.
+const spilledItemsDirname = "spilled"
+
+// spill bounds the memory used by the items of a cache.
+// Items that do not fit in the memory limit are written to a directory and read back on access.
+type spill struct {
+	mu       sync.Mutex
+	maxBytes uint64
+	dir      string
+
+	// memBytes is the encoded size of all items kept in memory
+	memBytes uint64
+	// sizes holds the encoded size of every item kept in memory
+	sizes map[uint64]uint64
+	// onDisk holds the heights of the items spilled to disk
+	onDisk map[uint64]struct{}
+}
+
+func (s *spill) path(height uint64) string {
+	return filepath.Join(s.dir, spillFilename(height))
+}
+
+func spillFilename(height uint64) string {
+	return fmt.Sprintf("%d.bin", height)
+}
+
+// EnableSpill bounds the memory used by the items of the cache to maxBytes, measured by their
+// encoded size. Items set while the limit is reached are written to dir instead and read back
+// from disk on access, so that a node far behind the chain head does not run out of memory.
+// Any files left in dir by a previous run are removed.
+//
+// *T must implement encoding.BinaryMarshaler and encoding.BinaryUnmarshaler.
+// EnableSpill must be called before the cache is used.
+func (c *Cache[T]) EnableSpill(maxBytes uint64, dir string) error {
+	if _, ok := any(new(T)).(encoding.BinaryMarshaler); !ok {
+		return fmt.Errorf("cache item type %T does not implement encoding.BinaryMarshaler", new(T))
+	}
+	if _, ok := any(new(T)).(encoding.BinaryUnmarshaler); !ok {
+		return fmt.Errorf("cache item type %T does not implement encoding.BinaryUnmarshaler", new(T))
+	}
+	if err := os.RemoveAll(dir); err != nil {
+		return fmt.Errorf("failed to clean spill directory %s: %w", dir, err)
+	}
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return fmt.Errorf("failed to create spill directory %s: %w", dir, err)
+	}
+
+	c.spill = &spill{
+		maxBytes: maxBytes,
+		dir:      dir,
+		sizes:    make(map[uint64]uint64),
+		onDisk:   make(map[uint64]struct{}),
+	}
+	return nil
+}
+
+// MemoryBytes returns the encoded size of the items kept in memory.
+// It is only tracked if spilling is enabled, and 0 otherwise.
+func (c *Cache[T]) MemoryBytes() uint64 {
+	if c.spill == nil {
+		return 0
+	}
+	c.spill.mu.Lock()
+	defer c.spill.mu.Unlock()
+	return c.spill.memBytes
+}
+
+// SpilledItems returns the number of items currently spilled to disk.
+func (c *Cache[T]) SpilledItems() int {
+	if c.spill == nil {
+		return 0
+	}
+	c.spill.mu.Lock()
+	defer c.spill.mu.Unlock()
+	return len(c.spill.onDisk)
+}
+
+// setItemBounded stores the item in memory if it fits in the memory limit, and on disk otherwise.
+// Items that cannot be encoded or written are kept in memory rather than lost.
+func (c *Cache[T]) setItemBounded(height uint64, item *T) {
+	s := c.spill
+	bz, err := any(item).(encoding.BinaryMarshaler).MarshalBinary()
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	c.deleteItemBoundedLocked(height)
+
+	if err == nil && s.memBytes+uint64(len(bz)) > s.maxBytes {
+		if err = os.WriteFile(s.path(height), bz, 0o600); err == nil {
+			s.onDisk[height] = struct{}{}
+			return
+		}
+	}
+
+	c.items.Store(height, item)
+	s.sizes[height] = uint64(len(bz))
+	s.memBytes += uint64(len(bz))
+}
+
+// getSpilledItem reads a spilled item back from disk. It returns nil if the item was not spilled.
+func (c *Cache[T]) getSpilledItem(height uint64) *T {
+	s := c.spill
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if _, ok := s.onDisk[height]; !ok {
+		return nil
+	}
+
+	bz, err := os.ReadFile(s.path(height))
+	if err != nil {
+		return nil
+	}
+	item := new(T)
+	if err := any(item).(encoding.BinaryUnmarshaler).UnmarshalBinary(bz); err != nil {
+		return nil
+	}
+	return item
+}
+
+// deleteItemBoundedLocked removes the item from memory or disk and releases its accounted memory.
+func (c *Cache[T]) deleteItemBoundedLocked(height uint64) {
+	s := c.spill
+	c.items.Delete(height)
+	if size, ok := s.sizes[height]; ok {
+		s.memBytes -= size
+		delete(s.sizes, height)
+	}
+	if _, ok := s.onDisk[height]; ok {
+		_ = os.Remove(s.path(height))
+		delete(s.onDisk, height)
+	}
+}
+
+// saveSpilledItems copies the files of the spilled items to dir as they are, so that saving the
+// cache does not read them back into memory. Files left in dir by a previous save are removed.
+func (c *Cache[T]) saveSpilledItems(dir string) error {
+	if err := os.RemoveAll(dir); err != nil {
+		return fmt.Errorf("failed to clean directory %s: %w", dir, err)
+	}
+	if c.spill == nil {
+		return nil
+	}
+	s := c.spill
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if len(s.onDisk) == 0 {
+		return nil
+	}
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return fmt.Errorf("failed to create directory %s: %w", dir, err)
+	}
+	for height := range s.onDisk {
+		if err := copyFile(s.path(height), filepath.Join(dir, spillFilename(height))); err != nil {
+			return fmt.Errorf("failed to save spilled item %d: %w", height, err)
+		}
+	}
+	return nil
+}
+
+// loadSpilledItems loads the spilled items saved in dir. If spilling is enabled, they are copied to
+// the spill directory without being read into memory, and otherwise they are decoded, which
+// requires *T to implement encoding.BinaryUnmarshaler.
+func (c *Cache[T]) loadSpilledItems(dir string) error {
+	entries, err := os.ReadDir(dir)
+	if os.IsNotExist(err) {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("failed to read directory %s: %w", dir, err)
+	}
+	for _, entry := range entries {
+		height, err := strconv.ParseUint(strings.TrimSuffix(entry.Name(), ".bin"), 10, 64)
+		if err != nil || entry.IsDir() {
+			continue
+		}
+		path := filepath.Join(dir, entry.Name())
+
+		if s := c.spill; s != nil {
+			s.mu.Lock()
+			c.deleteItemBoundedLocked(height)
+			err := copyFile(path, s.path(height))
+			if err == nil {
+				s.onDisk[height] = struct{}{}
+			}
+			s.mu.Unlock()
+			if err != nil {
+				return fmt.Errorf("failed to load spilled item %d: %w", height, err)
+			}
+			continue
+		}
+
+		item := new(T)
+		unmarshaler, ok := any(item).(encoding.BinaryUnmarshaler)
+		if !ok {
+			return fmt.Errorf("cache item type %T does not implement encoding.BinaryUnmarshaler", item)
+		}
+		bz, err := os.ReadFile(path)
+		if err != nil {
+			return fmt.Errorf("failed to read spilled item %d: %w", height, err)
+		}
+		if err := unmarshaler.UnmarshalBinary(bz); err != nil {
+			return fmt.Errorf("failed to decode spilled item %d: %w", height, err)
+		}
+		c.items.Store(height, item)
+	}
+	return nil
+}
+
+// copyFile copies the file at src to dst, streaming its content.
+func copyFile(src, dst string) error {
+	in, err := os.Open(src)
+	if err != nil {
+		return err
+	}
+	defer in.Close()
+
+	out, err := os.OpenFile(dst, os.O_WRONLY|os.O_CREATE|os.O_TRUNC, 0o600)
+	if err != nil {
+		return err
+	}
+	if _, err := io.Copy(out, in); err != nil {
+		_ = out.Close()
+		return err
+	}
+	return out.Close()
+}
@@ -0,0 +1,65 @@
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	rollconf "github.com/evstack/ev-node/pkg/config"
+	genesispkg "github.com/evstack/ev-node/pkg/genesis"
+)
+
+// initChainGenesis writes the genesis of the network selected with the chain option to the
+// config directory, where apps load it from, unless a genesis of the same chain is already there.
+func initChainGenesis(nodeConfig rollconf.Config) error {
+	if nodeConfig.Chain == "" {
+		return nil
+	}
+
+	profile, err := rollconf.ResolveChainProfile(nodeConfig.RootDir, nodeConfig.Chain)
+	if err != nil {
+		return err
+	}
+	genesisJSON, err := profile.GenesisJSON()
+	if err != nil || genesisJSON == nil {
+		return err
+	}
+
+	return writeGenesis(nodeConfig.RootDir, genesisJSON, fmt.Sprintf("chain %q", nodeConfig.Chain))
+}
+
+// writeGenesis validates a genesis document obtained from source and writes it to the config
+// directory of home, unless a genesis of the same chain ID is already there.
+func writeGenesis(home string, genesisJSON []byte, source string) error {
+	var genesis genesispkg.Genesis
+	if err := json.Unmarshal(genesisJSON, &genesis); err != nil {
+		return fmt.Errorf("invalid genesis of %s: %w", source, err)
+	}
+	if err := genesis.Validate(); err != nil {
+		return fmt.Errorf("invalid genesis of %s: %w", source, err)
+	}
+
+	genesisPath := genesispkg.GenesisPath(home)
+	if _, err := os.Stat(genesisPath); err == nil {
+		existing, err := genesispkg.LoadGenesis(genesisPath)
+		if err != nil {
+			return err
+		}
+		if existing.ChainID != genesis.ChainID {
+			return fmt.Errorf("genesis at %s is for chain ID %q, not %q of %s", genesisPath, existing.ChainID, genesis.ChainID, source)
+		}
+		return nil
+	} else if !os.IsNotExist(err) {
+		return fmt.Errorf("failed to check for existing genesis file at %s: %w", genesisPath, err)
+	}
+
+	if err := os.MkdirAll(filepath.Dir(genesisPath), 0o750); err != nil {
+		return fmt.Errorf("error creating config directory: %w", err)
+	}
+	// the document is written as is, so that fields unknown to this version are kept
+	if err := os.WriteFile(genesisPath, genesisJSON, 0o600); err != nil {
+		return fmt.Errorf("error writing genesis file: %w", err)
+	}
+	return nil
+}
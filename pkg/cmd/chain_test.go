@@ -0,0 +1,51 @@
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	rollconf "github.com/evstack/ev-node/pkg/config"
+	genesispkg "github.com/evstack/ev-node/pkg/genesis"
+)
+
+func TestInitChainGenesis(t *testing.T) {
+	home := t.TempDir()
+	genesis := genesispkg.NewGenesis("my-testnet-1", 1, time.Unix(1_700_000_000, 0).UTC(), []byte("proposer"))
+	genesisJSON, err := json.Marshal(genesis)
+	require.NoError(t, err)
+	registryPath := rollconf.ChainRegistryPath(home)
+	require.NoError(t, os.MkdirAll(filepath.Dir(registryPath), 0o750))
+	registry := fmt.Sprintf("my-testnet:\n  genesis: '%s'\nno-genesis:\n  bootnodes: [/ip4/10.0.0.1/tcp/7676]\n", genesisJSON)
+	require.NoError(t, os.WriteFile(registryPath, []byte(registry), 0o600))
+
+	// nodes not joining a named network are left alone
+	require.NoError(t, initChainGenesis(rollconf.Config{RootDir: home}))
+	require.NoFileExists(t, genesispkg.GenesisPath(home))
+	require.NoError(t, initChainGenesis(rollconf.Config{RootDir: home, Chain: "no-genesis"}))
+	require.NoFileExists(t, genesispkg.GenesisPath(home))
+
+	// the genesis of the network is written where apps load it from
+	require.NoError(t, initChainGenesis(rollconf.Config{RootDir: home, Chain: "my-testnet"}))
+	loaded, err := genesispkg.LoadGenesis(genesispkg.GenesisPath(home))
+	require.NoError(t, err)
+	require.Equal(t, "my-testnet-1", loaded.ChainID)
+	require.Equal(t, []byte("proposer"), loaded.ProposerAddress)
+
+	// an existing genesis of the same chain is kept
+	require.NoError(t, initChainGenesis(rollconf.Config{RootDir: home, Chain: "my-testnet"}))
+
+	// an existing genesis of another chain is not overwritten
+	other := genesispkg.NewGenesis("other-chain", 1, time.Now(), []byte("proposer"))
+	require.NoError(t, other.Save(genesispkg.GenesisPath(home)))
+	err = initChainGenesis(rollconf.Config{RootDir: home, Chain: "my-testnet"})
+	require.ErrorContains(t, err, `is for chain ID "other-chain"`)
+
+	err = initChainGenesis(rollconf.Config{RootDir: home, Chain: "unknown-net"})
+	require.ErrorContains(t, err, `unknown chain "unknown-net"`)
+}
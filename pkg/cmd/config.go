@@ -0,0 +1,109 @@
+package cmd
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	rollconf "github.com/evstack/ev-node/pkg/config"
+	"github.com/evstack/ev-node/pkg/rpc/client"
+)
+
+// flagNodeAddress is the flag for pointing a command at the RPC address of a running node.
+const flagNodeAddress = "node-rpc"
+
+// ConfigCmd returns a command for inspecting and validating node configuration.
+func ConfigCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "config",
+		Short: "Inspect and validate node configuration",
+	}
+
+	cmd.AddCommand(configSchemaCmd())
+	cmd.AddCommand(configValidateCmd())
+
+	return cmd
+}
+
+func configSchemaCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "schema",
+		Short: "Print the JSON Schema of the configuration file",
+		Long: `Print a JSON Schema describing every option of the configuration file supported by this binary,
+including descriptions and default values. The schema can be used to validate configuration files in
+infrastructure-as-code pipelines or to provide editor completion.`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			schema, err := rollconf.JSONSchema()
+			if err != nil {
+				return fmt.Errorf("failed to generate config schema: %w", err)
+			}
+
+			cmd.Println(string(schema))
+			return nil
+		},
+	}
+}
+
+func configValidateCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "validate [config-file]",
+		Short: "Validate a configuration file",
+		Long: `Validate a configuration file against the options supported by this binary.
+If no file is given, the configuration file in the home directory is validated.
+
+With --node-rpc, the file is validated by the running node at the given RPC address instead,
+which checks it against the version the node is actually running.`,
+		Args: cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			var configPath string
+			if len(args) > 0 {
+				configPath = args[0]
+			} else {
+				nodeConfig, err := rollconf.Load(cmd)
+				if err != nil {
+					return fmt.Errorf("failed to load node config: %w", err)
+				}
+				configPath = nodeConfig.ConfigPath()
+			}
+
+			data, err := os.ReadFile(configPath)
+			if err != nil {
+				return fmt.Errorf("failed to read config file: %w", err)
+			}
+
+			var problems []string
+			nodeAddr, _ := cmd.Flags().GetString(flagNodeAddress)
+			if nodeAddr != "" {
+				if !strings.HasPrefix(nodeAddr, "http://") && !strings.HasPrefix(nodeAddr, "https://") {
+					nodeAddr = "http://" + nodeAddr
+				}
+				resp, err := client.NewClient(nodeAddr).ValidateConfig(context.Background(), data)
+				if err != nil {
+					return fmt.Errorf("error calling ValidateConfig RPC: %w", err)
+				}
+				problems = resp.Errors
+			} else {
+				for _, err := range rollconf.ValidateYAML(data) {
+					problems = append(problems, err.Error())
+				}
+			}
+
+			if len(problems) > 0 {
+				for _, p := range problems {
+					cmd.PrintErrln(p)
+				}
+				return errors.New("configuration is invalid")
+			}
+
+			cmd.Printf("%s is valid\n", configPath)
+			return nil
+		},
+	}
+	cmd.Flags().String(flagNodeAddress, "", "RPC address of a running node to validate against (host:port)")
+	return cmd
+}
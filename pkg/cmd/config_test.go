@@ -0,0 +1,86 @@
+package cmd
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/rs/zerolog"
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/net/http2"
+	"golang.org/x/net/http2/h2c"
+
+	"github.com/evstack/ev-node/pkg/config"
+	"github.com/evstack/ev-node/pkg/rpc/server"
+	"github.com/evstack/ev-node/types/pb/evnode/v1/v1connect"
+)
+
+func newConfigTestRoot() *cobra.Command {
+	rootCmd := &cobra.Command{Use: "root", SilenceUsage: true, SilenceErrors: true}
+	config.AddGlobalFlags(rootCmd, "test")
+	rootCmd.AddCommand(ConfigCmd())
+	return rootCmd
+}
+
+func TestConfigSchemaCmd(t *testing.T) {
+	output, err := executeCommandC(newConfigTestRoot(), "config", "schema")
+	require.NoError(t, err)
+
+	var schema map[string]any
+	require.NoError(t, json.Unmarshal([]byte(output), &schema))
+	require.Equal(t, config.JSONSchemaDraft, schema["$schema"])
+	require.Contains(t, schema["properties"], "da")
+}
+
+func TestConfigValidateCmd(t *testing.T) {
+	dir := t.TempDir()
+
+	validPath := filepath.Join(dir, "valid.yaml")
+	require.NoError(t, os.WriteFile(validPath, []byte("node:\n  block_time: 2s\n"), 0o600))
+
+	invalidPath := filepath.Join(dir, "invalid.yaml")
+	require.NoError(t, os.WriteFile(invalidPath, []byte("node:\n  block_time: fast\n  foo: bar\n"), 0o600))
+
+	t.Run("valid file", func(t *testing.T) {
+		output, err := executeCommandC(newConfigTestRoot(), "config", "validate", validPath)
+		require.NoError(t, err)
+		require.Contains(t, output, "is valid")
+	})
+
+	t.Run("invalid file", func(t *testing.T) {
+		output, err := executeCommandC(newConfigTestRoot(), "config", "validate", invalidPath)
+		require.Error(t, err)
+		require.Contains(t, output, "node.block_time")
+		require.Contains(t, output, "node.foo: unknown configuration option")
+	})
+
+	t.Run("home directory config", func(t *testing.T) {
+		home := t.TempDir()
+		cfg := config.DefaultConfig
+		cfg.RootDir = home
+		require.NoError(t, cfg.SaveAsYaml())
+
+		output, err := executeCommandC(newConfigTestRoot(), "config", "validate", "--home", home)
+		require.NoError(t, err, output)
+		require.Contains(t, output, cfg.ConfigPath())
+	})
+
+	t.Run("validate against running node", func(t *testing.T) {
+		mux := http.NewServeMux()
+		path, handler := v1connect.NewConfigServiceHandler(server.NewConfigServer(config.DefaultConfig, zerolog.Nop()))
+		mux.Handle(path, handler)
+		httpServer := httptest.NewServer(h2c.NewHandler(mux, &http2.Server{}))
+		defer httpServer.Close()
+
+		_, err := executeCommandC(newConfigTestRoot(), "config", "validate", validPath, "--node-rpc", httpServer.URL)
+		require.NoError(t, err)
+
+		output, err := executeCommandC(newConfigTestRoot(), "config", "validate", invalidPath, "--node-rpc", httpServer.URL)
+		require.Error(t, err)
+		require.Contains(t, output, "node.foo: unknown configuration option")
+	})
+}
@@ -0,0 +1,21 @@
+package cmd
+
+import (
+	"context"
+
+	"github.com/rs/zerolog"
+
+	coreda "github.com/evstack/ev-node/core/da"
+	"github.com/evstack/ev-node/da/jsonrpc"
+	rollconf "github.com/evstack/ev-node/pkg/config"
+)
+
+// NewDA is the DAFactory of nodes using the JSON-RPC DA client, e.g. with celestia-node or a local
+// DA, for the commands reading from DA.
+func NewDA(ctx context.Context, nodeConfig rollconf.Config, logger zerolog.Logger) (coreda.DA, error) {
+	daJrpc, err := jsonrpc.NewClient(ctx, logger, nodeConfig.DA.Address, nodeConfig.DA.AuthToken, nodeConfig.DA.GasPrice, nodeConfig.DA.GasMultiplier)
+	if err != nil {
+		return nil, err
+	}
+	return &daJrpc.DA, nil
+}
@@ -0,0 +1,432 @@
+package cmd
+
+import (
+	"bytes"
+	"context"
+	"encoding/csv"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"connectrpc.com/connect"
+	"github.com/libp2p/go-libp2p/core/crypto"
+	"github.com/spf13/cobra"
+
+	rollconf "github.com/evstack/ev-node/pkg/config"
+	genesispkg "github.com/evstack/ev-node/pkg/genesis"
+	"github.com/evstack/ev-node/pkg/rpc/client"
+	"github.com/evstack/ev-node/pkg/signer"
+	"github.com/evstack/ev-node/pkg/signer/file"
+	"github.com/evstack/ev-node/types"
+)
+
+const (
+	flagDAMappingFrom   = "from"
+	flagDAMappingTo     = "to"
+	flagDAMappingFormat = "format"
+	flagDAMappingOutput = "output"
+	flagDAMappingSettle = "settlement-txs"
+	flagDAMappingPubKey = "pubkey"
+	flagDAMappingAddr   = "address"
+
+	// daMappingSignatureExt is appended to the export path to get the detached signature path.
+	daMappingSignatureExt = ".sig"
+)
+
+var daMappingCSVHeader = []string{"height", "header_hash", "header_da_height", "data_commitment", "data_da_height", "settlement_tx"}
+
+// DAMappingEntry maps a single Evolve height to where its header and data were published on DA,
+// and to the settlement transaction of the height if the chain settles anywhere.
+// DataCommitment is the commitment of the DA blob holding the data, empty if the data of the block
+// was not published, i.e. it has no transactions or is not DA included yet.
+type DAMappingEntry struct {
+	Height         uint64 `json:"height"`
+	HeaderHash     string `json:"header_hash"`
+	HeaderDAHeight uint64 `json:"header_da_height"`
+	DataCommitment string `json:"data_commitment"`
+	DataDAHeight   uint64 `json:"data_da_height"`
+	SettlementTx   string `json:"settlement_tx,omitempty"`
+}
+
+// DAMappingSignature is the detached signature written next to a DA mapping export.
+// It signs the exact bytes of the export file. Address is the address of PubKey, informational
+// only: verifiers derive the address from PubKey and check it against the expected signer.
+type DAMappingSignature struct {
+	Address   string `json:"address"`
+	PubKey    []byte `json:"pub_key"`
+	Signature []byte `json:"signature"`
+}
+
+// DAMappingCmd returns a command for exporting and verifying the Evolve height to DA height mapping.
+func DAMappingCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "da-mapping",
+		Short: "Export and verify the mapping of blocks to DA heights",
+	}
+
+	cmd.AddCommand(exportDAMappingCmd())
+	cmd.AddCommand(verifyDAMappingCmd())
+
+	return cmd
+}
+
+func exportDAMappingCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "export",
+		Short: "Export a signed mapping of blocks to DA heights",
+		Long: `Export, for every block in a height range, the block's header hash, the DA heights at which the
+header and data were included, the commitment of the DA blob holding the data, and the settlement
+transaction (if any).
+
+The node does not track settlement, so the settlement transactions are read from the CSV file given
+with --settlement-txs, with a height and a settlement transaction per row, e.g. as indexed from the
+settlement layer. The settlement_tx column is left empty for the heights it does not list.
+
+The mapping is read from a running node over RPC and written as CSV or JSON. A detached signature made
+with the local signer key is written next to the export (<output>.sig), so auditors can check that the
+export was produced by the operator and then independently verify each row against the DA layer.`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			nodeConfig, err := rollconf.Load(cmd)
+			if err != nil {
+				return fmt.Errorf("failed to load node config: %w", err)
+			}
+
+			from, _ := cmd.Flags().GetUint64(flagDAMappingFrom)
+			to, _ := cmd.Flags().GetUint64(flagDAMappingTo)
+			format, _ := cmd.Flags().GetString(flagDAMappingFormat)
+			output, _ := cmd.Flags().GetString(flagDAMappingOutput)
+			if output == "" {
+				return fmt.Errorf("--%s is required", flagDAMappingOutput)
+			}
+			if format != "csv" && format != "json" {
+				return fmt.Errorf("unsupported format %q, use csv or json", format)
+			}
+			var settlementTxs map[uint64]string
+			if path, _ := cmd.Flags().GetString(flagDAMappingSettle); path != "" {
+				if settlementTxs, err = readSettlementTxs(path); err != nil {
+					return err
+				}
+			}
+
+			passphrase, _ := cmd.Flags().GetString(rollconf.FlagSignerPassphrase)
+			if passphrase == "" {
+				return fmt.Errorf("passphrase is required to sign the export. Please provide it using the --%s flag", rollconf.FlagSignerPassphrase)
+			}
+			signerPath := nodeConfig.Signer.SignerPath
+			if !filepath.IsAbs(signerPath) {
+				signerPath = filepath.Join(nodeConfig.RootDir, signerPath)
+			}
+			s, err := file.LoadFileSystemSigner(signerPath, []byte(passphrase))
+			if err != nil {
+				return fmt.Errorf("failed to load signer: %w", err)
+			}
+
+			rpcClient := client.NewClient(nodeRPCURL(cmd, nodeConfig))
+			ctx := context.Background()
+
+			if to == 0 {
+				state, err := rpcClient.GetState(ctx)
+				if err != nil {
+					return fmt.Errorf("error calling GetState RPC: %w", err)
+				}
+				to = state.LastBlockHeight
+			}
+			if from == 0 || from > to {
+				return fmt.Errorf("invalid height range [%d, %d]", from, to)
+			}
+
+			entries, err := fetchDAMapping(ctx, rpcClient, from, to)
+			if err != nil {
+				return err
+			}
+			for i := range entries {
+				entries[i].SettlementTx = settlementTxs[entries[i].Height]
+			}
+
+			data, err := encodeDAMapping(entries, format)
+			if err != nil {
+				return err
+			}
+
+			sig, err := signDAMapping(s, data)
+			if err != nil {
+				return err
+			}
+			sigBz, err := json.MarshalIndent(sig, "", "  ")
+			if err != nil {
+				return fmt.Errorf("failed to encode signature: %w", err)
+			}
+
+			if err := os.WriteFile(output, data, 0o600); err != nil {
+				return fmt.Errorf("failed to write export: %w", err)
+			}
+			if err := os.WriteFile(output+daMappingSignatureExt, sigBz, 0o600); err != nil {
+				return fmt.Errorf("failed to write signature: %w", err)
+			}
+
+			cmd.Printf("Exported %d blocks [%d, %d] to %s (signature: %s)\n", len(entries), from, to, output, output+daMappingSignatureExt)
+			return nil
+		},
+	}
+
+	cmd.Flags().Uint64(flagDAMappingFrom, 1, "first block height to export")
+	cmd.Flags().Uint64(flagDAMappingTo, 0, "last block height to export (0 for the latest height)")
+	cmd.Flags().String(flagDAMappingFormat, "csv", "export format (csv, json)")
+	cmd.Flags().String(flagDAMappingOutput, "", "path of the export file")
+	cmd.Flags().String(flagDAMappingSettle, "", "CSV file of the settlement transactions by height (height,settlement_tx)")
+	cmd.Flags().String(flagNodeAddress, "", "RPC address of the node to export from (defaults to the configured RPC address)")
+	cmd.Flags().String(rollconf.FlagSignerPassphrase, "", "passphrase for the signer key")
+	return cmd
+}
+
+func verifyDAMappingCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "verify <export-file> [signature-file]",
+		Short: "Verify the signature of a DA mapping export",
+		Long: `Verify that a DA mapping export matches its detached signature, made by the expected signer.
+If no signature file is given, <export-file>.sig is used.
+
+The expected signer is given by its public key (--pubkey) or address (--address), as printed by
+keys show-validator, and defaults to the proposer in the genesis of the node home.`,
+		Args: cobra.RangeArgs(1, 2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			sigPath := args[0] + daMappingSignatureExt
+			if len(args) > 1 {
+				sigPath = args[1]
+			}
+
+			data, err := os.ReadFile(args[0])
+			if err != nil {
+				return fmt.Errorf("failed to read export: %w", err)
+			}
+			sigBz, err := os.ReadFile(sigPath)
+			if err != nil {
+				return fmt.Errorf("failed to read signature: %w", err)
+			}
+
+			var sig DAMappingSignature
+			if err := json.Unmarshal(sigBz, &sig); err != nil {
+				return fmt.Errorf("failed to decode signature: %w", err)
+			}
+
+			signer, err := expectedDAMappingSigner(cmd)
+			if err != nil {
+				return err
+			}
+			if err := VerifyDAMappingSignature(data, sig, signer); err != nil {
+				return err
+			}
+
+			cmd.Printf("Signature valid, signed by %s\n", hex.EncodeToString(signer))
+			return nil
+		},
+	}
+
+	cmd.Flags().String(flagDAMappingPubKey, "", "hex encoded public key of the expected signer")
+	cmd.Flags().String(flagDAMappingAddr, "", "hex encoded address of the expected signer")
+	cmd.MarkFlagsMutuallyExclusive(flagDAMappingPubKey, flagDAMappingAddr)
+	return cmd
+}
+
+// expectedDAMappingSigner returns the address of the signer an export must be signed by: the one
+// given by the --pubkey or --address flag, or the proposer in the genesis of the node home.
+func expectedDAMappingSigner(cmd *cobra.Command) ([]byte, error) {
+	if pubKeyHex, _ := cmd.Flags().GetString(flagDAMappingPubKey); pubKeyHex != "" {
+		raw, err := hex.DecodeString(strings.TrimPrefix(pubKeyHex, "0x"))
+		if err != nil {
+			return nil, fmt.Errorf("invalid --%s: %w", flagDAMappingPubKey, err)
+		}
+		pubKey, err := crypto.UnmarshalEd25519PublicKey(raw)
+		if err != nil {
+			return nil, fmt.Errorf("invalid --%s: %w", flagDAMappingPubKey, err)
+		}
+		return types.KeyAddress(pubKey), nil
+	}
+	if addressHex, _ := cmd.Flags().GetString(flagDAMappingAddr); addressHex != "" {
+		address, err := hex.DecodeString(strings.TrimPrefix(addressHex, "0x"))
+		if err != nil {
+			return nil, fmt.Errorf("invalid --%s: %w", flagDAMappingAddr, err)
+		}
+		return address, nil
+	}
+
+	nodeConfig, err := rollconf.Load(cmd)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load node config: %w", err)
+	}
+	genesis, err := genesispkg.LoadGenesis(genesispkg.GenesisPath(nodeConfig.RootDir))
+	if err != nil {
+		return nil, fmt.Errorf("failed to load the genesis for the expected signer, set --%s or --%s instead: %w", flagDAMappingPubKey, flagDAMappingAddr, err)
+	}
+	return genesis.ProposerAddress, nil
+}
+
+// nodeRPCURL returns the base URL of the node RPC, preferring the --node-rpc flag over the configuration.
+func nodeRPCURL(cmd *cobra.Command, nodeConfig rollconf.Config) string {
+	addr, _ := cmd.Flags().GetString(flagNodeAddress)
+	if addr == "" {
+		return rollconf.RPCClientURL(nodeConfig.RPC.Address)
+	}
+	if !strings.HasPrefix(addr, "http://") && !strings.HasPrefix(addr, "https://") {
+		addr = "http://" + addr
+	}
+	return addr
+}
+
+// fetchDAMapping reads the mapping of every height in [from, to] from the node. The data commitments
+// are read from the DA layer by the node, so it must have access to it.
+func fetchDAMapping(ctx context.Context, rpcClient *client.Client, from, to uint64) ([]DAMappingEntry, error) {
+	entries := make([]DAMappingEntry, 0, to-from+1)
+	for height := from; height <= to; height++ {
+		resp, err := rpcClient.GetBlockByHeight(ctx, height)
+		if err != nil {
+			return nil, fmt.Errorf("error fetching block %d: %w", height, err)
+		}
+
+		var header types.SignedHeader
+		if err := header.FromProto(resp.Block.Header); err != nil {
+			return nil, fmt.Errorf("error decoding header %d: %w", height, err)
+		}
+
+		entry := DAMappingEntry{
+			Height:         height,
+			HeaderHash:     hex.EncodeToString(header.Hash()),
+			HeaderDAHeight: resp.HeaderDaHeight,
+			DataDAHeight:   resp.DataDaHeight,
+		}
+
+		inclusion, err := rpcClient.GetDAInclusionProof(ctx, height)
+		if err == nil {
+			if inclusion.Data != nil {
+				entry.DataCommitment = hex.EncodeToString(inclusion.Data.Commitment)
+			}
+		} else if connect.CodeOf(err) != connect.CodeNotFound {
+			return nil, fmt.Errorf("error fetching DA inclusion of block %d: %w", height, err)
+		}
+
+		entries = append(entries, entry)
+	}
+	return entries, nil
+}
+
+// readSettlementTxs reads the settlement transactions by height from a CSV file with a height and a
+// settlement transaction per row. A header row is skipped.
+func readSettlementTxs(path string) (map[uint64]string, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read settlement transactions: %w", err)
+	}
+
+	r := csv.NewReader(bytes.NewReader(data))
+	r.FieldsPerRecord = 2
+	records, err := r.ReadAll()
+	if err != nil {
+		return nil, fmt.Errorf("failed to read settlement transactions: %w", err)
+	}
+	txs := make(map[uint64]string, len(records))
+	for i, record := range records {
+		height, err := strconv.ParseUint(strings.TrimSpace(record[0]), 10, 64)
+		if err != nil {
+			if i == 0 {
+				continue
+			}
+			return nil, fmt.Errorf("invalid height %q in settlement transactions: %w", record[0], err)
+		}
+		txs[height] = strings.TrimSpace(record[1])
+	}
+	return txs, nil
+}
+
+// encodeDAMapping encodes the entries as csv or json.
+func encodeDAMapping(entries []DAMappingEntry, format string) ([]byte, error) {
+	if format == "json" {
+		data, err := json.MarshalIndent(entries, "", "  ")
+		if err != nil {
+			return nil, fmt.Errorf("failed to encode export: %w", err)
+		}
+		return data, nil
+	}
+
+	var buf bytes.Buffer
+	w := csv.NewWriter(&buf)
+	if err := w.Write(daMappingCSVHeader); err != nil {
+		return nil, fmt.Errorf("failed to encode export: %w", err)
+	}
+	for _, e := range entries {
+		record := []string{
+			strconv.FormatUint(e.Height, 10),
+			e.HeaderHash,
+			strconv.FormatUint(e.HeaderDAHeight, 10),
+			e.DataCommitment,
+			strconv.FormatUint(e.DataDAHeight, 10),
+			e.SettlementTx,
+		}
+		if err := w.Write(record); err != nil {
+			return nil, fmt.Errorf("failed to encode export: %w", err)
+		}
+	}
+	w.Flush()
+	if err := w.Error(); err != nil {
+		return nil, fmt.Errorf("failed to encode export: %w", err)
+	}
+	return buf.Bytes(), nil
+}
+
+// signDAMapping signs the export bytes with the given signer.
+func signDAMapping(s signer.Signer, data []byte) (*DAMappingSignature, error) {
+	signature, err := s.Sign(data)
+	if err != nil {
+		return nil, fmt.Errorf("failed to sign export: %w", err)
+	}
+	pubKey, err := s.GetPublic()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get public key: %w", err)
+	}
+	pubKeyBz, err := crypto.MarshalPublicKey(pubKey)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal public key: %w", err)
+	}
+	address, err := s.GetAddress()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get address: %w", err)
+	}
+
+	return &DAMappingSignature{
+		Address:   hex.EncodeToString(address),
+		PubKey:    pubKeyBz,
+		Signature: signature,
+	}, nil
+}
+
+// VerifyDAMappingSignature checks that sig is a valid signature of the export bytes by the signer
+// with the given address. The address of the signature is derived from its public key.
+func VerifyDAMappingSignature(data []byte, sig DAMappingSignature, signer []byte) error {
+	pubKey, err := crypto.UnmarshalPublicKey(sig.PubKey)
+	if err != nil {
+		return fmt.Errorf("failed to decode public key: %w", err)
+	}
+	address := types.KeyAddress(pubKey)
+	if address == nil {
+		return errors.New("failed to derive the address of the public key")
+	}
+	if !bytes.Equal(address, signer) {
+		return fmt.Errorf("export signed by %s, expected %s", hex.EncodeToString(address), hex.EncodeToString(signer))
+	}
+	if sig.Address != "" && sig.Address != hex.EncodeToString(address) {
+		return fmt.Errorf("signature address %s does not match its public key", sig.Address)
+	}
+	ok, err := pubKey.Verify(data, sig.Signature)
+	if err != nil {
+		return fmt.Errorf("failed to verify signature: %w", err)
+	}
+	if !ok {
+		return errors.New("signature does not match export")
+	}
+	return nil
+}
@@ -0,0 +1,207 @@
+package cmd
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/binary"
+	"encoding/csv"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/rs/zerolog"
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	coreda "github.com/evstack/ev-node/core/da"
+	"github.com/evstack/ev-node/pkg/config"
+	"github.com/evstack/ev-node/pkg/genesis"
+	"github.com/evstack/ev-node/pkg/rpc/server"
+	"github.com/evstack/ev-node/pkg/signer/file"
+	"github.com/evstack/ev-node/pkg/store"
+	"github.com/evstack/ev-node/test/mocks"
+	"github.com/evstack/ev-node/types"
+)
+
+func TestDAMappingExportAndVerify(t *testing.T) {
+	ctx := context.Background()
+	chainID := "da-mapping-test"
+
+	// populate a store with three blocks and their DA heights, and the DA layer with their blobs
+	kv, err := store.NewDefaultInMemoryKVStore()
+	require.NoError(t, err)
+	s := store.New(kv)
+	headers := make([]*types.SignedHeader, 3)
+	datas := make([]*types.Data, 3)
+	daBlobs := map[string]map[uint64][]byte{"ns-header": {}, "ns-data": {}}
+	for i := range headers {
+		height := uint64(i + 1)
+		headers[i], datas[i] = types.GetRandomBlock(height, 2, chainID)
+		require.NoError(t, s.SaveBlockData(ctx, headers[i], datas[i], &types.Signature{}))
+
+		daHeight := make([]byte, 8)
+		binary.LittleEndian.PutUint64(daHeight, 100+height)
+		require.NoError(t, s.SetMetadata(ctx, fmt.Sprintf("%s/%d/h", store.HeightToDAHeightKey, height), daHeight))
+		binary.LittleEndian.PutUint64(daHeight, 200+height)
+		require.NoError(t, s.SetMetadata(ctx, fmt.Sprintf("%s/%d/d", store.HeightToDAHeightKey, height), daHeight))
+
+		daBlobs["ns-header"][100+height], err = headers[i].MarshalBinary()
+		require.NoError(t, err)
+		daBlobs["ns-data"][200+height], err = (&types.SignedData{Data: *datas[i], Signature: headers[i].Signature, Signer: headers[i].Signer}).MarshalBinary()
+		require.NoError(t, err)
+	}
+	require.NoError(t, s.SetHeight(ctx, 3))
+
+	blobID := func(daHeight uint64, blob []byte) coreda.ID {
+		commitment := sha256.Sum256(blob)
+		return append(binary.LittleEndian.AppendUint64(nil, daHeight), commitment[:]...)
+	}
+	mockDA := mocks.NewMockDA(t)
+	mockDA.On("GetIDs", mock.Anything, mock.Anything, mock.Anything).Return(
+		func(_ context.Context, daHeight uint64, ns []byte) (*coreda.GetIDsResult, error) {
+			result := &coreda.GetIDsResult{}
+			if blob, ok := daBlobs[string(ns)][daHeight]; ok {
+				result.IDs = append(result.IDs, blobID(daHeight, blob))
+			}
+			return result, nil
+		}).Maybe()
+	mockDA.On("Get", mock.Anything, mock.Anything, mock.Anything).Return(
+		func(_ context.Context, ids []coreda.ID, ns []byte) ([]coreda.Blob, error) {
+			return []coreda.Blob{daBlobs[string(ns)][binary.LittleEndian.Uint64(ids[0])]}, nil
+		}).Maybe()
+	mockDA.On("GetProofs", mock.Anything, mock.Anything, mock.Anything).Return([]coreda.Proof{[]byte("proof")}, nil).Maybe()
+
+	cfg := config.DefaultConfig
+	cfg.DA.HeaderNamespace = "ns-header"
+	cfg.DA.DataNamespace = "ns-data"
+	handler, err := server.NewServiceHandler(s, &mocks.MockP2PRPC{}, zerolog.Nop(), cfg, server.ServiceOptions{DA: mockDA})
+	require.NoError(t, err)
+	httpServer := httptest.NewServer(handler)
+	defer httpServer.Close()
+
+	// create the signer key in the home directory
+	home := t.TempDir()
+	passphrase := "test-passphrase"
+	signer, err := file.CreateFileSystemSigner(filepath.Join(home, "config"), []byte(passphrase))
+	require.NoError(t, err)
+	address, err := signer.GetAddress()
+	require.NoError(t, err)
+	// the export is expected to be signed by the genesis proposer by default
+	require.NoError(t, genesis.CreateGenesis(home, chainID, 1, address))
+
+	newRoot := func() *cobra.Command {
+		rootCmd := &cobra.Command{Use: "root", SilenceUsage: true, SilenceErrors: true}
+		config.AddGlobalFlags(rootCmd, "test")
+		rootCmd.AddCommand(DAMappingCmd())
+		return rootCmd
+	}
+
+	t.Run("csv", func(t *testing.T) {
+		dir := t.TempDir()
+		output := filepath.Join(dir, "mapping.csv")
+		settlementTxs := filepath.Join(dir, "settlement.csv")
+		require.NoError(t, os.WriteFile(settlementTxs, []byte("height,settlement_tx\n2,0xabcd\n"), 0o600))
+		out, err := executeCommandC(newRoot(), "da-mapping", "export",
+			"--home", home, "--node-rpc", httpServer.URL, "--from", "1", "--to", "3",
+			"--output", output, "--settlement-txs", settlementTxs, "--"+config.FlagSignerPassphrase, passphrase)
+		require.NoError(t, err, out)
+
+		data, err := os.ReadFile(output)
+		require.NoError(t, err)
+		records, err := csv.NewReader(strings.NewReader(string(data))).ReadAll()
+		require.NoError(t, err)
+		require.Len(t, records, 4)
+		require.Equal(t, daMappingCSVHeader, records[0])
+		// the data commitment is the commitment of the DA blob, not of the block data
+		dataCommitment := blobID(202, daBlobs["ns-data"][202])[8:]
+		require.Equal(t, []string{
+			"2",
+			hex.EncodeToString(headers[1].Hash()),
+			"102",
+			hex.EncodeToString(dataCommitment),
+			"202",
+			"0xabcd",
+		}, records[2])
+		// heights without settlement transaction
+		require.Empty(t, records[3][5])
+
+		out, err = executeCommandC(newRoot(), "da-mapping", "verify", output, "--home", home)
+		require.NoError(t, err, out)
+		require.Contains(t, out, "Signature valid, signed by "+hex.EncodeToString(address))
+
+		// tampering with the export invalidates the signature
+		require.NoError(t, os.WriteFile(output, append(data, []byte("4,,,,,\n")...), 0o600))
+		_, err = executeCommandC(newRoot(), "da-mapping", "verify", output, "--home", home)
+		require.Error(t, err)
+	})
+
+	t.Run("json defaults to latest height", func(t *testing.T) {
+		// GetState is needed to resolve the latest height
+		require.NoError(t, s.UpdateState(ctx, types.State{ChainID: chainID, LastBlockHeight: 3}))
+
+		output := filepath.Join(t.TempDir(), "mapping.json")
+		out, err := executeCommandC(newRoot(), "da-mapping", "export",
+			"--home", home, "--node-rpc", httpServer.URL, "--format", "json",
+			"--output", output, "--"+config.FlagSignerPassphrase, passphrase)
+		require.NoError(t, err, out)
+
+		data, err := os.ReadFile(output)
+		require.NoError(t, err)
+		var entries []DAMappingEntry
+		require.NoError(t, json.Unmarshal(data, &entries))
+		require.Len(t, entries, 3)
+		require.Equal(t, uint64(103), entries[2].HeaderDAHeight)
+		require.Empty(t, entries[2].SettlementTx)
+
+		_, err = executeCommandC(newRoot(), "da-mapping", "verify", output, "--address", "0x"+hex.EncodeToString(address))
+		require.NoError(t, err)
+	})
+
+	t.Run("signature of a foreign key", func(t *testing.T) {
+		output := filepath.Join(t.TempDir(), "mapping.csv")
+		out, err := executeCommandC(newRoot(), "da-mapping", "export",
+			"--home", home, "--node-rpc", httpServer.URL, "--from", "1", "--to", "3",
+			"--output", output, "--"+config.FlagSignerPassphrase, passphrase)
+		require.NoError(t, err, out)
+		data, err := os.ReadFile(output)
+		require.NoError(t, err)
+
+		// a valid signature of the export by another key, claiming the address of the sequencer
+		foreign, err := file.CreateFileSystemSigner(t.TempDir(), []byte("foreign-passphrase"))
+		require.NoError(t, err)
+		sig, err := signDAMapping(foreign, data)
+		require.NoError(t, err)
+		sig.Address = hex.EncodeToString(address)
+		sigBz, err := json.Marshal(sig)
+		require.NoError(t, err)
+		require.NoError(t, os.WriteFile(output+daMappingSignatureExt, sigBz, 0o600))
+
+		_, err = executeCommandC(newRoot(), "da-mapping", "verify", output, "--home", home)
+		require.ErrorContains(t, err, "expected "+hex.EncodeToString(address))
+
+		pubKey, err := signer.GetPublic()
+		require.NoError(t, err)
+		raw, err := pubKey.Raw()
+		require.NoError(t, err)
+		_, err = executeCommandC(newRoot(), "da-mapping", "verify", output, "--pubkey", "0x"+hex.EncodeToString(raw))
+		require.ErrorContains(t, err, "expected "+hex.EncodeToString(address))
+
+		// and the foreign key is accepted only when expected
+		foreignAddress, err := foreign.GetAddress()
+		require.NoError(t, err)
+		_, err = executeCommandC(newRoot(), "da-mapping", "verify", output, "--address", hex.EncodeToString(foreignAddress))
+		require.ErrorContains(t, err, "does not match its public key")
+	})
+
+	t.Run("missing passphrase", func(t *testing.T) {
+		_, err := executeCommandC(newRoot(), "da-mapping", "export",
+			"--home", home, "--node-rpc", httpServer.URL, "--output", filepath.Join(t.TempDir(), "mapping.csv"))
+		require.ErrorContains(t, err, "passphrase is required")
+	})
+}
@@ -0,0 +1,53 @@
+package cmd
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	rollconf "github.com/evstack/ev-node/pkg/config"
+	genesispkg "github.com/evstack/ev-node/pkg/genesis"
+	"github.com/evstack/ev-node/pkg/rpc/client"
+)
+
+// FetchGenesisCmd returns a command writing the genesis served by a running node to the config
+// directory, to bootstrap a new node of the same network.
+func FetchGenesisCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "fetch-genesis",
+		Short: "Fetch the genesis from a running node",
+		Long: `Fetch the genesis document of a network from a running node of the network over RPC, and write it to
+the config directory of this node.
+
+An existing genesis is kept if it is for the same chain ID, and the command fails otherwise.`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			nodeConfig, err := rollconf.Load(cmd)
+			if err != nil {
+				return fmt.Errorf("failed to load node config: %w", err)
+			}
+
+			url := nodeRPCURL(cmd, nodeConfig)
+			resp, err := client.NewClient(url).GetGenesis(context.Background())
+			if err != nil {
+				return fmt.Errorf("error calling GetGenesis RPC: %w", err)
+			}
+			if hash := sha256.Sum256(resp.Genesis); !bytes.Equal(hash[:], resp.Hash) {
+				return fmt.Errorf("genesis served by %s does not match its hash", url)
+			}
+			if err := writeGenesis(nodeConfig.RootDir, resp.Genesis, url); err != nil {
+				return err
+			}
+
+			cmd.Printf("Genesis of chain %s written to %s\n", resp.ChainId, genesispkg.GenesisPath(nodeConfig.RootDir))
+			return nil
+		},
+	}
+
+	cmd.Flags().String(flagNodeAddress, "", "RPC address of the node to fetch the genesis from")
+	_ = cmd.MarkFlagRequired(flagNodeAddress)
+	return cmd
+}
@@ -0,0 +1,62 @@
+package cmd
+
+import (
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/rs/zerolog"
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/require"
+
+	"github.com/evstack/ev-node/pkg/config"
+	genesispkg "github.com/evstack/ev-node/pkg/genesis"
+	"github.com/evstack/ev-node/pkg/rpc/server"
+	"github.com/evstack/ev-node/pkg/store"
+)
+
+func TestFetchGenesisCmd(t *testing.T) {
+	nodeConfig := config.DefaultConfig
+	nodeConfig.RootDir = t.TempDir()
+	genesis := genesispkg.NewGenesis("fetch-chain", 1, time.Unix(1_700_000_000, 0).UTC(), []byte("proposer"))
+	saveGenesis := func(home string, genesis genesispkg.Genesis) {
+		require.NoError(t, os.MkdirAll(filepath.Dir(genesispkg.GenesisPath(home)), 0o750))
+		require.NoError(t, genesis.Save(genesispkg.GenesisPath(home)))
+	}
+	saveGenesis(nodeConfig.RootDir, genesis)
+
+	kv, err := store.NewDefaultInMemoryKVStore()
+	require.NoError(t, err)
+	handler, err := server.NewServiceHandler(store.New(kv), nil, zerolog.Nop(), nodeConfig, server.ServiceOptions{})
+	require.NoError(t, err)
+	httpServer := httptest.NewServer(handler)
+	defer httpServer.Close()
+
+	fetch := func(home string) (string, error) {
+		rootCmd := &cobra.Command{Use: "root"}
+		config.AddGlobalFlags(rootCmd, "test")
+		rootCmd.AddCommand(FetchGenesisCmd())
+		return executeCommandC(rootCmd, "fetch-genesis", "--home", home, "--node-rpc", httpServer.URL)
+	}
+
+	home := t.TempDir()
+	out, err := fetch(home)
+	require.NoError(t, err, out)
+	require.Contains(t, out, "Genesis of chain fetch-chain written to")
+	fetched, err := os.ReadFile(genesispkg.GenesisPath(home))
+	require.NoError(t, err)
+	served, err := os.ReadFile(genesispkg.GenesisPath(nodeConfig.RootDir))
+	require.NoError(t, err)
+	require.Equal(t, served, fetched)
+
+	// fetching again keeps the genesis of the same chain
+	_, err = fetch(home)
+	require.NoError(t, err)
+
+	other := t.TempDir()
+	saveGenesis(other, genesispkg.NewGenesis("other-chain", 1, time.Now(), []byte("proposer")))
+	_, err = fetch(other)
+	require.ErrorContains(t, err, `is for chain ID "other-chain"`)
+}
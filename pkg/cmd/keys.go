@@ -1,11 +1,16 @@
 package cmd
 
 import (
+	"crypto/sha256"
+	"encoding/base64"
 	"encoding/hex"
+	"encoding/json"
 	"fmt"
 	"os"
 	"path/filepath"
+	"strings"
 
+	"github.com/libp2p/go-libp2p/core/crypto"
 	"github.com/spf13/cobra"
 
 	rollconf "github.com/evstack/ev-node/pkg/config"
@@ -21,6 +26,7 @@ func KeysCmd() *cobra.Command {
 
 	cmd.AddCommand(exportKeyCmd())
 	cmd.AddCommand(importKeyCmd())
+	cmd.AddCommand(showValidatorCmd())
 
 	return cmd
 }
@@ -125,3 +131,99 @@ If a 'signer.json' file exists in your home directory, you must use the --force
 	cmd.Flags().String(rollconf.FlagSignerPassphrase, "", "Passphrase to encrypt the imported key")
 	return cmd
 }
+
+// Output formats of the show-validator command.
+const (
+	validatorFormatJSON       = "json"
+	validatorFormatPubKey     = "pubkey"
+	validatorFormatBase64     = "base64"
+	validatorFormatAddress    = "address"
+	validatorFormatEthAddress = "eth-address"
+	validatorFormatBLS        = "bls"
+)
+
+// validatorInfo describes the sequencer key in the forms used by settlement and bridge contracts.
+type validatorInfo struct {
+	Type         string `json:"type"`
+	PubKey       string `json:"pub_key"`
+	PubKeyBase64 string `json:"pub_key_base64"`
+	Address      string `json:"address"`
+}
+
+func showValidatorCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "show-validator",
+		Short: "Show the sequencer public key for contract deployment",
+		Long: `Show the public key of the locally saved signing key in the formats expected by
+settlement and bridge contract constructors. No passphrase is required.
+
+Formats:
+  json      all formats below as a JSON object (default)
+  pubkey    raw public key, 0x-prefixed hex
+  base64    raw public key, base64
+  address   sequencer address as set in block headers, 0x-prefixed hex
+
+Sequencer keys are Ed25519. The raw public key is already its compact 32 byte form,
+which fits a Solidity bytes32. Ed25519 keys have no Ethereum address or BLS form,
+so the eth-address and bls formats are rejected.
+`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			nodeConfig, err := rollconf.Load(cmd)
+			if err != nil {
+				return fmt.Errorf("failed to load node config: %w", err)
+			}
+
+			format, err := cmd.Flags().GetString("format")
+			if err != nil {
+				return err
+			}
+
+			pubKey, err := file.LoadPublicKey(filepath.Dir(nodeConfig.ConfigPath()))
+			if err != nil {
+				return fmt.Errorf("failed to load public key: %w", err)
+			}
+
+			info, err := newValidatorInfo(pubKey)
+			if err != nil {
+				return err
+			}
+
+			switch format {
+			case validatorFormatJSON:
+				out, err := json.MarshalIndent(info, "", "  ")
+				if err != nil {
+					return fmt.Errorf("failed to marshal validator info: %w", err)
+				}
+				cmd.Println(string(out))
+			case validatorFormatPubKey:
+				cmd.Println(info.PubKey)
+			case validatorFormatBase64:
+				cmd.Println(info.PubKeyBase64)
+			case validatorFormatAddress:
+				cmd.Println(info.Address)
+			case validatorFormatEthAddress, validatorFormatBLS:
+				return fmt.Errorf("%s keys have no %s form, use the pubkey format instead", info.Type, format)
+			default:
+				return fmt.Errorf("unknown format %q", format)
+			}
+			return nil
+		},
+	}
+	cmd.Flags().String("format", validatorFormatJSON, "Output format (json|pubkey|base64|address)")
+	return cmd
+}
+
+func newValidatorInfo(pubKey crypto.PubKey) (validatorInfo, error) {
+	raw, err := pubKey.Raw()
+	if err != nil {
+		return validatorInfo{}, fmt.Errorf("failed to get raw public key: %w", err)
+	}
+	// same derivation as the signer address
+	address := sha256.Sum256(raw)
+	return validatorInfo{
+		Type:         strings.ToLower(pubKey.Type().String()),
+		PubKey:       "0x" + hex.EncodeToString(raw),
+		PubKeyBase64: base64.StdEncoding.EncodeToString(raw),
+		Address:      "0x" + hex.EncodeToString(address[:]),
+	}, nil
+}
@@ -2,7 +2,9 @@ package cmd_test
 
 import (
 	"bytes"
+	"encoding/base64"
 	"encoding/hex"
+	"encoding/json"
 	"os"
 	"path/filepath"
 	"strings"
@@ -256,3 +258,62 @@ func TestImportKeyCmd(t *testing.T) {
 		assert.True(pubKey.Equals(originalPubKey))
 	})
 }
+
+func TestShowValidatorCmd(t *testing.T) {
+	homeDir := t.TempDir()
+	keyPath := filepath.Join(homeDir, "config")
+	s, err := file.CreateFileSystemSigner(keyPath, []byte("test-password"))
+	require.NoError(t, err)
+	pubKey, err := s.GetPublic()
+	require.NoError(t, err)
+	rawPubKey, err := pubKey.Raw()
+	require.NoError(t, err)
+	address, err := s.GetAddress()
+	require.NoError(t, err)
+
+	run := func(format string) (string, error) {
+		root := setupRootCmd()
+		outBuf := new(bytes.Buffer)
+		root.SetOut(outBuf)
+		args := []string{"keys", "show-validator", "--" + rollconf.FlagRootDir, homeDir}
+		if format != "" {
+			args = append(args, "--format", format)
+		}
+		root.SetArgs(args)
+		err := root.Execute()
+		return strings.TrimSpace(outBuf.String()), err
+	}
+
+	out, err := run("")
+	require.NoError(t, err)
+	var info map[string]string
+	require.NoError(t, json.Unmarshal([]byte(out), &info))
+	assert.Equal(t, map[string]string{
+		"type":           "ed25519",
+		"pub_key":        "0x" + hex.EncodeToString(rawPubKey),
+		"pub_key_base64": base64.StdEncoding.EncodeToString(rawPubKey),
+		"address":        "0x" + hex.EncodeToString(address),
+	}, info)
+
+	out, err = run("pubkey")
+	require.NoError(t, err)
+	assert.Equal(t, "0x"+hex.EncodeToString(rawPubKey), out)
+
+	out, err = run("base64")
+	require.NoError(t, err)
+	assert.Equal(t, base64.StdEncoding.EncodeToString(rawPubKey), out)
+
+	out, err = run("address")
+	require.NoError(t, err)
+	assert.Equal(t, "0x"+hex.EncodeToString(address), out)
+
+	_, err = run("eth-address")
+	require.ErrorContains(t, err, "ed25519 keys have no eth-address form")
+
+	_, err = run("unknown")
+	require.ErrorContains(t, err, "unknown format")
+
+	root := setupRootCmd()
+	root.SetArgs([]string{"keys", "show-validator", "--" + rollconf.FlagRootDir, t.TempDir()})
+	require.ErrorContains(t, root.Execute(), "failed to load public key")
+}
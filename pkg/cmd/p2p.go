@@ -11,6 +11,8 @@ import (
 	"github.com/spf13/cobra"
 	"google.golang.org/protobuf/types/known/emptypb"
 
+	rollconf "github.com/evstack/ev-node/pkg/config"
+	pb "github.com/evstack/ev-node/types/pb/evnode/v1"
 	rpc "github.com/evstack/ev-node/types/pb/evnode/v1/v1connect"
 )
 
@@ -36,7 +38,7 @@ var NetInfoCmd = &cobra.Command{
 			Transport: http.DefaultTransport,
 		}
 
-		baseURL := fmt.Sprintf("http://%s", rpcAddress)
+		baseURL := rollconf.RPCClientURL(rpcAddress)
 
 		// Create P2P client
 		p2pClient := rpc.NewP2PServiceClient(
@@ -74,16 +76,25 @@ var NetInfoCmd = &cobra.Command{
 
 		fmt.Fprintf(w, "%s\n", strings.Repeat("-", 50))
 		// Also get peer information
-		peerResp, err := p2pClient.GetPeerInfo(
-			context.Background(),
-			connect.NewRequest(&emptypb.Empty{}),
-		)
-		if err != nil {
-			return fmt.Errorf("error calling GetPeerInfo RPC: %w", err)
+		var peers []*pb.PeerInfo
+		peerReq := &pb.GetPeerInfoRequest{Page: &pb.PageRequest{}}
+		for {
+			peerResp, err := p2pClient.GetPeerInfo(
+				context.Background(),
+				connect.NewRequest(peerReq),
+			)
+			if err != nil {
+				return fmt.Errorf("error calling GetPeerInfo RPC: %w", err)
+			}
+			peers = append(peers, peerResp.Msg.Peers...)
+			if peerResp.Msg.Page.GetNextPageToken() == "" {
+				break
+			}
+			peerReq.Page.PageToken = peerResp.Msg.Page.GetNextPageToken()
 		}
 
 		// Print connected peers in a table-like format
-		peerCount := len(peerResp.Msg.Peers)
+		peerCount := len(peers)
 		fmt.Fprintf(w, "👥 CONNECTED PEERS: \033[1;33m%d\033[0m\n", peerCount)
 
 		if peerCount > 0 {
@@ -91,7 +102,7 @@ var NetInfoCmd = &cobra.Command{
 			fmt.Fprintf(w, "%-5s %-20s %s\n", "NO.", "PEER ID", "ADDRESS")
 			fmt.Fprintf(w, "%s\n", strings.Repeat("-", 50))
 
-			for i, peer := range peerResp.Msg.Peers {
+			for i, peer := range peers {
 				// Truncate peer ID if it's too long for display
 				peerID := peer.Id
 				if len(peerID) > 18 {
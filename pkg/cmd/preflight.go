@@ -0,0 +1,121 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	ds "github.com/ipfs/go-datastore"
+	ktds "github.com/ipfs/go-datastore/keytransform"
+	"github.com/multiformats/go-multiaddr"
+	manet "github.com/multiformats/go-multiaddr/net"
+
+	coreda "github.com/evstack/ev-node/core/da"
+	coreexecutor "github.com/evstack/ev-node/core/execution"
+	"github.com/evstack/ev-node/node"
+	rollconf "github.com/evstack/ev-node/pkg/config"
+	"github.com/evstack/ev-node/pkg/preflight"
+	"github.com/evstack/ev-node/pkg/store"
+)
+
+const (
+	// preflightTimeout bounds the duration of each preflight check
+	preflightTimeout = 10 * time.Second
+	// minFreeDiskSpace is the disk space required in the node home directory to start
+	minFreeDiskSpace = 1 << 30
+	// maxClockSkew is how far the local clock may be behind the latest block time
+	maxClockSkew = 5 * time.Second
+)
+
+// preflightChecks returns the checks to run before starting a node with the given configuration.
+func preflightChecks(
+	nodeConfig rollconf.Config,
+	executor coreexecutor.Executor,
+	da coreda.DA,
+	datastore ds.Batching,
+) ([]preflight.Check, error) {
+	listenAddrs, err := nodeListenAddrs(nodeConfig)
+	if err != nil {
+		return nil, err
+	}
+
+	checks := []preflight.Check{
+		preflight.Ports(listenAddrs),
+		preflight.DiskSpace(existingParent(nodeConfig.RootDir), minFreeDiskSpace),
+	}
+	if nodeConfig.Node.Light {
+		return checks, nil
+	}
+
+	checks = append(checks, preflight.DA(da, [][]byte{
+		[]byte(nodeConfig.DA.GetHeaderNamespace()),
+		[]byte(nodeConfig.DA.GetDataNamespace()),
+	}, nodeConfig.DA.StartHeight))
+	if pinger, ok := executor.(preflight.Pinger); ok {
+		checks = append(checks, preflight.Execution(pinger))
+	}
+
+	s := store.New(ktds.Wrap(datastore, ktds.PrefixTransform{Prefix: ds.NewKey(node.EvPrefix)}))
+	checks = append(checks, preflight.ClockSkew(func(ctx context.Context) (time.Time, error) {
+		height, err := s.Height(ctx)
+		if err != nil || height == 0 {
+			return time.Time{}, err
+		}
+		header, err := s.GetHeader(ctx, height)
+		if err != nil {
+			return time.Time{}, err
+		}
+		return header.Time(), nil
+	}, maxClockSkew))
+
+	return checks, nil
+}
+
+// nodeListenAddrs returns the addresses the node listens on with the given configuration.
+func nodeListenAddrs(nodeConfig rollconf.Config) ([]preflight.ListenAddr, error) {
+	addrs := []preflight.ListenAddr{{Name: "rpc", Network: "tcp", Address: nodeConfig.RPC.Address}}
+	for _, a := range strings.Split(nodeConfig.P2P.ListenAddress, ",") {
+		a = strings.TrimSpace(a)
+		if a == "" {
+			continue
+		}
+		maddr, err := multiaddr.NewMultiaddr(a)
+		if err != nil {
+			return nil, fmt.Errorf("invalid P2P listen address %q: %w", a, err)
+		}
+		netAddr, err := manet.ToNetAddr(maddr)
+		if err != nil {
+			// e.g. QUIC or websocket addresses, which are bound by libp2p transports
+			continue
+		}
+		addrs = append(addrs, preflight.ListenAddr{Name: "p2p", Network: netAddr.Network(), Address: netAddr.String()})
+	}
+
+	if instrumentation := nodeConfig.Instrumentation; instrumentation != nil {
+		if instrumentation.IsPrometheusEnabled() {
+			addrs = append(addrs, preflight.ListenAddr{Name: "prometheus", Network: "tcp", Address: instrumentation.PrometheusListenAddr})
+		}
+		if instrumentation.IsPprofEnabled() {
+			addrs = append(addrs, preflight.ListenAddr{Name: "pprof", Network: "tcp", Address: instrumentation.GetPprofListenAddr()})
+		}
+	}
+	return addrs, nil
+}
+
+// existingParent returns the closest existing ancestor of dir, which is dir itself if it exists.
+func existingParent(dir string) string {
+	dir = filepath.Clean(dir)
+	for {
+		if _, err := os.Stat(dir); err == nil {
+			return dir
+		}
+		parent := filepath.Dir(dir)
+		if parent == dir {
+			return dir
+		}
+		dir = parent
+	}
+}
@@ -0,0 +1,38 @@
+package cmd
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	rollconf "github.com/evstack/ev-node/pkg/config"
+	"github.com/evstack/ev-node/pkg/preflight"
+)
+
+func TestNodeListenAddrs(t *testing.T) {
+	cfg := rollconf.DefaultConfig
+	cfg.RPC.Address = "127.0.0.1:7331"
+	cfg.P2P.ListenAddress = "/ip4/0.0.0.0/tcp/7676, /ip4/0.0.0.0/udp/7676/quic-v1"
+	instrumentation := *cfg.Instrumentation
+	instrumentation.Prometheus = true
+	cfg.Instrumentation = &instrumentation
+
+	addrs, err := nodeListenAddrs(cfg)
+	require.NoError(t, err)
+	assert.Equal(t, []preflight.ListenAddr{
+		{Name: "rpc", Network: "tcp", Address: "127.0.0.1:7331"},
+		{Name: "p2p", Network: "tcp", Address: "0.0.0.0:7676"},
+		{Name: "prometheus", Network: "tcp", Address: instrumentation.PrometheusListenAddr},
+	}, addrs)
+
+	cfg.P2P.ListenAddress = "not-a-multiaddr"
+	_, err = nodeListenAddrs(cfg)
+	require.Error(t, err)
+}
+
+func TestExistingParent(t *testing.T) {
+	dir := t.TempDir()
+	assert.Equal(t, dir, existingParent(dir))
+	assert.Equal(t, dir, existingParent(dir+"/missing/nested"))
+}
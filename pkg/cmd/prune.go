@@ -0,0 +1,175 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/rs/zerolog"
+	"github.com/spf13/cobra"
+
+	"github.com/evstack/ev-node/block"
+	coreda "github.com/evstack/ev-node/core/da"
+	rollconf "github.com/evstack/ev-node/pkg/config"
+	"github.com/evstack/ev-node/pkg/journal"
+	"github.com/evstack/ev-node/pkg/store"
+)
+
+const (
+	flagHeightsFrom = "from"
+	flagHeightsTo   = "to"
+)
+
+// DAFactory creates the DA client of the node from its configuration, for commands reading from DA.
+type DAFactory func(ctx context.Context, nodeConfig rollconf.Config, logger zerolog.Logger) (coreda.DA, error)
+
+// PruneHeightsCmd returns a command deleting the data of a range of DA included heights from the
+// store of the node named dbName, keeping the headers and DA heights to restore it later.
+func PruneHeightsCmd(dbName string) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "prune-heights",
+		Short: "Delete the data of DA included heights, keeping what is needed to restore it from DA",
+		Long: `Delete the transactions of the blocks in a height range from the store. The headers, signatures and
+the DA heights the data was included at are kept, so that restore-heights can re-fetch and re-verify the
+data from DA when it is needed again. Only heights included on DA, below the current height, can be
+pruned. The node must be stopped.`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			nodeConfig, err := ParseConfig(cmd)
+			if err != nil {
+				return err
+			}
+			from, to, err := heightsRange(cmd)
+			if err != nil {
+				return err
+			}
+
+			ctx := context.Background()
+			s, pruner, err := openPrunableStore(nodeConfig, dbName)
+			if err != nil {
+				return err
+			}
+			defer s.Close()
+
+			pruned := 0
+			for height := from; height <= to; height++ {
+				if err := pruner.PruneBlockData(ctx, height); err != nil {
+					return fmt.Errorf("failed to prune height %d: %w", height, err)
+				}
+				if ok, err := pruner.IsPruned(ctx, height); err == nil && ok {
+					pruned++
+				}
+			}
+
+			if err := journal.New(s).Record(ctx, journal.EventHeightsPruned, "block data pruned", map[string]string{
+				"from": strconv.FormatUint(from, 10),
+				"to":   strconv.FormatUint(to, 10),
+			}); err != nil {
+				cmd.PrintErrf("failed to record pruning in event journal: %v\n", err)
+			}
+
+			cmd.Printf("Pruned the data of heights [%d, %d] (%d heights with transactions)\n", from, to, pruned)
+			return nil
+		},
+	}
+
+	addHeightsRangeFlags(cmd)
+	return cmd
+}
+
+// RestoreHeightsCmd returns a command restoring the pruned data of a range of heights of the node
+// named dbName from DA, using the DA client created by newDA.
+func RestoreHeightsCmd(dbName string, newDA DAFactory) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "restore-heights",
+		Short: "Restore the pruned data of heights from DA",
+		Long: `Re-fetch the data of pruned heights from DA, at the DA heights recorded when they were included,
+verify it against the headers kept in the store and save it again. Heights which are not pruned are
+skipped. The node must be stopped.`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			nodeConfig, err := ParseConfig(cmd)
+			if err != nil {
+				return err
+			}
+			from, to, err := heightsRange(cmd)
+			if err != nil {
+				return err
+			}
+
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+			logger := SetupLogger(nodeConfig.Log)
+
+			s, pruner, err := openPrunableStore(nodeConfig, dbName)
+			if err != nil {
+				return err
+			}
+			defer s.Close()
+			da, err := newDA(ctx, nodeConfig, logger)
+			if err != nil {
+				return fmt.Errorf("failed to create DA client: %w", err)
+			}
+
+			restored := 0
+			for height := from; height <= to; height++ {
+				if pruned, err := pruner.IsPruned(ctx, height); err != nil {
+					return err
+				} else if !pruned {
+					continue
+				}
+				daHeight, err := block.RestorePrunedData(ctx, s, da, nodeConfig.DA, logger, height)
+				if err != nil {
+					return fmt.Errorf("failed to restore height %d: %w", height, err)
+				}
+				cmd.Printf("Restored height %d from DA height %d\n", height, daHeight)
+				restored++
+			}
+
+			if err := journal.New(s).Record(ctx, journal.EventHeightsRestored, "pruned block data restored from DA", map[string]string{
+				"from": strconv.FormatUint(from, 10),
+				"to":   strconv.FormatUint(to, 10),
+			}); err != nil {
+				cmd.PrintErrf("failed to record restoration in event journal: %v\n", err)
+			}
+
+			cmd.Printf("Restored %d pruned heights in [%d, %d]\n", restored, from, to)
+			return nil
+		},
+	}
+
+	addHeightsRangeFlags(cmd)
+	return cmd
+}
+
+func addHeightsRangeFlags(cmd *cobra.Command) {
+	cmd.Flags().Uint64(flagHeightsFrom, 0, "first height of the range")
+	cmd.Flags().Uint64(flagHeightsTo, 0, "last height of the range")
+	_ = cmd.MarkFlagRequired(flagHeightsFrom)
+	_ = cmd.MarkFlagRequired(flagHeightsTo)
+}
+
+// heightsRange returns the height range of the --from and --to flags.
+func heightsRange(cmd *cobra.Command) (uint64, uint64, error) {
+	from, _ := cmd.Flags().GetUint64(flagHeightsFrom)
+	to, _ := cmd.Flags().GetUint64(flagHeightsTo)
+	if from == 0 || from > to {
+		return 0, 0, fmt.Errorf("invalid height range [%d, %d]", from, to)
+	}
+	return from, to, nil
+}
+
+// openPrunableStore opens the store of the node named dbName.
+func openPrunableStore(nodeConfig rollconf.Config, dbName string) (store.Store, store.Pruner, error) {
+	datastore, err := store.NewKVStore(nodeConfig.DBBackend, nodeConfig.RootDir, nodeConfig.DBPath, dbName)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to open store: %w", err)
+	}
+	s := store.New(datastore)
+	pruner, ok := s.(store.Pruner)
+	if !ok {
+		_ = s.Close()
+		return nil, nil, fmt.Errorf("store does not support pruning")
+	}
+	return s, pruner, nil
+}
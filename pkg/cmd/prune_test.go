@@ -0,0 +1,83 @@
+package cmd
+
+import (
+	"context"
+	"encoding/binary"
+	"fmt"
+	"testing"
+
+	"github.com/rs/zerolog"
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	coreda "github.com/evstack/ev-node/core/da"
+	rollconf "github.com/evstack/ev-node/pkg/config"
+	"github.com/evstack/ev-node/pkg/store"
+	"github.com/evstack/ev-node/test/mocks"
+	"github.com/evstack/ev-node/types"
+)
+
+func TestPruneAndRestoreHeightsCmd(t *testing.T) {
+	ctx := context.Background()
+	home := t.TempDir()
+	const dbName = "test"
+
+	// populate the store with three DA included blocks, whose data is on DA
+	datastore, err := store.NewDefaultKVStore(home, rollconf.DefaultConfig.DBPath, dbName)
+	require.NoError(t, err)
+	s := store.New(datastore)
+	daBlobs := map[uint64][]byte{}
+	for h := uint64(1); h <= 3; h++ {
+		header, data := types.GetRandomBlock(h, 2, "test-chain")
+		require.NoError(t, s.SaveBlockData(ctx, header, data, &header.Signature))
+		require.NoError(t, s.SetHeight(ctx, h))
+		require.NoError(t, s.SetMetadata(ctx, fmt.Sprintf("%s/%d/d", store.HeightToDAHeightKey, h), binary.LittleEndian.AppendUint64(nil, 10+h)))
+		daBlobs[10+h], err = (&types.SignedData{Data: *data, Signature: header.Signature, Signer: header.Signer}).MarshalBinary()
+		require.NoError(t, err)
+	}
+	require.NoError(t, s.SetMetadata(ctx, store.DAIncludedHeightKey, binary.LittleEndian.AppendUint64(nil, 3)))
+	require.NoError(t, s.Close())
+
+	mockDA := mocks.NewMockDA(t)
+	mockDA.On("GetIDs", mock.Anything, mock.Anything, mock.Anything).Return(
+		func(_ context.Context, daHeight uint64, _ []byte) (*coreda.GetIDsResult, error) {
+			return &coreda.GetIDsResult{IDs: []coreda.ID{binary.LittleEndian.AppendUint64(nil, daHeight)}}, nil
+		})
+	mockDA.On("Get", mock.Anything, mock.Anything, mock.Anything).Return(
+		func(_ context.Context, ids []coreda.ID, _ []byte) ([]coreda.Blob, error) {
+			return []coreda.Blob{daBlobs[binary.LittleEndian.Uint64(ids[0])]}, nil
+		})
+	newDA := func(context.Context, rollconf.Config, zerolog.Logger) (coreda.DA, error) {
+		return mockDA, nil
+	}
+
+	newRoot := func() *cobra.Command {
+		rootCmd := &cobra.Command{Use: "root", SilenceUsage: true, SilenceErrors: true}
+		rollconf.AddGlobalFlags(rootCmd, "test")
+		rootCmd.AddCommand(PruneHeightsCmd(dbName), RestoreHeightsCmd(dbName, newDA))
+		return rootCmd
+	}
+
+	// the current height cannot be pruned
+	_, err = executeCommandC(newRoot(), "prune-heights", "--home", home, "--from", "1", "--to", "3")
+	require.ErrorContains(t, err, "failed to prune height 3")
+
+	out, err := executeCommandC(newRoot(), "prune-heights", "--home", home, "--from", "1", "--to", "2")
+	require.NoError(t, err, out)
+	require.Contains(t, out, "Pruned the data of heights [1, 2] (2 heights with transactions)")
+
+	out, err = executeCommandC(newRoot(), "restore-heights", "--home", home, "--from", "2", "--to", "3")
+	require.NoError(t, err, out)
+	require.Contains(t, out, "Restored height 2 from DA height 12")
+	require.Contains(t, out, "Restored 1 pruned heights in [2, 3]")
+
+	datastore, err = store.NewDefaultKVStore(home, rollconf.DefaultConfig.DBPath, dbName)
+	require.NoError(t, err)
+	s = store.New(datastore)
+	defer s.Close()
+	_, _, err = s.GetBlockData(ctx, 1)
+	require.ErrorIs(t, err, store.ErrPruned)
+	_, _, err = s.GetBlockData(ctx, 2)
+	require.NoError(t, err)
+}
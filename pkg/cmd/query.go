@@ -0,0 +1,243 @@
+package cmd
+
+import (
+	"context"
+	"encoding/binary"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+	"time"
+	"unicode/utf8"
+
+	"github.com/spf13/cobra"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+
+	rollconf "github.com/evstack/ev-node/pkg/config"
+	"github.com/evstack/ev-node/pkg/rpc/client"
+	"github.com/evstack/ev-node/types"
+	pb "github.com/evstack/ev-node/types/pb/evnode/v1"
+)
+
+const (
+	flagQueryOutput = "output"
+
+	queryOutputTable = "table"
+	queryOutputJSON  = "json"
+)
+
+// QueryCmd returns a command inspecting a running node over RPC, so that operators do not need
+// grpcurl.
+func QueryCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "query",
+		Short: "Query a running node over RPC",
+		Long: `Query a running node over RPC: its blocks, state, metadata, peers, network information and health.
+
+The node is reached at its configured RPC address, or at --node-rpc. Results are printed as a table,
+or with --output json as the JSON encoding of the RPC responses, e.g. to be processed with jq.`,
+	}
+
+	cmd.PersistentFlags().StringP(flagQueryOutput, "o", queryOutputTable, "output format (table, json)")
+	cmd.PersistentFlags().String(flagNodeAddress, "", "RPC address of the node (defaults to the configured RPC address)")
+
+	cmd.AddCommand(
+		queryBlockCmd(),
+		queryStateCmd(),
+		queryMetadataCmd(),
+		queryPeersCmd(),
+		queryNetInfoCmd(),
+		queryHealthCmd(),
+	)
+	return cmd
+}
+
+// runQuery returns the RunE of a query subcommand, which runs query against the node and writes its
+// result with the output format of the command, as JSON or with table.
+func runQuery[T proto.Message](query func(ctx context.Context, rpcClient *client.Client, args []string) (T, error), table func(w io.Writer, result T)) func(cmd *cobra.Command, args []string) error {
+	return func(cmd *cobra.Command, args []string) error {
+		output, _ := cmd.Flags().GetString(flagQueryOutput)
+		if output != queryOutputTable && output != queryOutputJSON {
+			return fmt.Errorf("unsupported output %q, use %s or %s", output, queryOutputTable, queryOutputJSON)
+		}
+		nodeConfig, err := rollconf.Load(cmd)
+		if err != nil {
+			return fmt.Errorf("failed to load node config: %w", err)
+		}
+
+		result, err := query(cmd.Context(), client.NewClient(nodeRPCURL(cmd, nodeConfig)), args)
+		if err != nil {
+			return err
+		}
+
+		out := cmd.OutOrStdout()
+		if output == queryOutputJSON {
+			bz, err := protojson.MarshalOptions{Multiline: true, Indent: "  "}.Marshal(result)
+			if err != nil {
+				return fmt.Errorf("failed to encode result: %w", err)
+			}
+			fmt.Fprintln(out, string(bz))
+			return nil
+		}
+		w := tabwriter.NewWriter(out, 2, 0, 2, ' ', 0)
+		table(w, result)
+		return w.Flush()
+	}
+}
+
+func queryBlockCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "block [height|hash]",
+		Short: "Show a block by height or hex encoded hash, the latest block by default",
+		Args:  cobra.MaximumNArgs(1),
+		RunE: runQuery(func(ctx context.Context, rpcClient *client.Client, args []string) (*pb.GetBlockResponse, error) {
+			if len(args) == 0 {
+				// height 0 is the latest block
+				return rpcClient.GetBlockByHeight(ctx, 0)
+			}
+			if height, err := strconv.ParseUint(args[0], 10, 64); err == nil {
+				return rpcClient.GetBlockByHeight(ctx, height)
+			}
+			hash, err := hex.DecodeString(strings.TrimPrefix(args[0], "0x"))
+			if err != nil {
+				return nil, fmt.Errorf("invalid block height or hash %q", args[0])
+			}
+			return rpcClient.GetBlockByHash(ctx, hash)
+		}, renderBlock),
+	}
+}
+
+// renderBlock writes the header and data of a block.
+func renderBlock(w io.Writer, resp *pb.GetBlockResponse) {
+	var header types.SignedHeader
+	if err := header.FromProto(resp.Block.GetHeader()); err != nil {
+		fmt.Fprintf(w, "Invalid header:\t%v\n", err)
+		return
+	}
+	fmt.Fprintf(w, "Height:\t%d\n", header.Height())
+	fmt.Fprintf(w, "Hash:\t%s\n", header.Hash())
+	fmt.Fprintf(w, "Time:\t%s\n", header.Time().UTC().Format(time.RFC3339Nano))
+	fmt.Fprintf(w, "Chain ID:\t%s\n", header.ChainID())
+	fmt.Fprintf(w, "Last header hash:\t%s\n", header.LastHeaderHash)
+	fmt.Fprintf(w, "Data hash:\t%s\n", header.DataHash)
+	fmt.Fprintf(w, "App hash:\t%s\n", header.AppHash)
+	fmt.Fprintf(w, "Proposer:\t%X\n", header.ProposerAddress)
+	fmt.Fprintf(w, "Transactions:\t%d\n", len(resp.Block.GetData().GetTxs()))
+	fmt.Fprintf(w, "Header DA height:\t%s\n", formatDAHeight(resp.HeaderDaHeight))
+	fmt.Fprintf(w, "Data DA height:\t%s\n", formatDAHeight(resp.DataDaHeight))
+}
+
+// formatDAHeight formats a DA height, 0 meaning not included on DA yet.
+func formatDAHeight(height uint64) string {
+	if height == 0 {
+		return "not included"
+	}
+	return strconv.FormatUint(height, 10)
+}
+
+func queryStateCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "state",
+		Short: "Show the state of the node after its latest block",
+		Args:  cobra.NoArgs,
+		RunE: runQuery(func(ctx context.Context, rpcClient *client.Client, _ []string) (*pb.State, error) {
+			return rpcClient.GetState(ctx)
+		}, func(w io.Writer, state *pb.State) {
+			fmt.Fprintf(w, "Chain ID:\t%s\n", state.ChainId)
+			fmt.Fprintf(w, "Initial height:\t%d\n", state.InitialHeight)
+			fmt.Fprintf(w, "Last block height:\t%d\n", state.LastBlockHeight)
+			fmt.Fprintf(w, "Last block time:\t%s\n", state.LastBlockTime.AsTime().UTC().Format(time.RFC3339Nano))
+			fmt.Fprintf(w, "DA height:\t%d\n", state.DaHeight)
+			fmt.Fprintf(w, "App hash:\t%X\n", state.AppHash)
+			fmt.Fprintf(w, "Last results hash:\t%X\n", state.LastResultsHash)
+		}),
+	}
+}
+
+func queryMetadataCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "metadata <key>",
+		Short: "Show the value of a metadata key of the store",
+		Args:  cobra.ExactArgs(1),
+		RunE: runQuery(func(ctx context.Context, rpcClient *client.Client, args []string) (*pb.GetMetadataResponse, error) {
+			value, err := rpcClient.GetMetadata(ctx, args[0])
+			if err != nil {
+				return nil, err
+			}
+			return &pb.GetMetadataResponse{Value: value}, nil
+		}, func(w io.Writer, resp *pb.GetMetadataResponse) {
+			fmt.Fprintf(w, "Hex:\t%X\n", resp.Value)
+			if len(resp.Value) == 8 {
+				// heights are stored as 8 little endian bytes
+				fmt.Fprintf(w, "Uint64:\t%d\n", binary.LittleEndian.Uint64(resp.Value))
+			}
+			if utf8.Valid(resp.Value) {
+				fmt.Fprintf(w, "String:\t%s\n", resp.Value)
+			}
+		}),
+	}
+}
+
+func queryPeersCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "peers",
+		Short: "List the peers of the node",
+		Args:  cobra.NoArgs,
+		RunE: runQuery(func(ctx context.Context, rpcClient *client.Client, _ []string) (*pb.GetPeerInfoResponse, error) {
+			peers, err := rpcClient.GetPeerInfo(ctx)
+			if err != nil {
+				return nil, err
+			}
+			return &pb.GetPeerInfoResponse{Peers: peers}, nil
+		}, func(w io.Writer, resp *pb.GetPeerInfoResponse) {
+			fmt.Fprintf(w, "ID\tADDRESS\tDIRECTION\tCONNECTED FOR\tVERSION\n")
+			for _, peer := range resp.Peers {
+				age := "-"
+				if peer.ConnectionAge != nil {
+					age = peer.ConnectionAge.AsDuration().Round(time.Second).String()
+				}
+				direction := strings.ToLower(strings.TrimPrefix(peer.Direction.String(), "PEER_DIRECTION_"))
+				fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\n", peer.Id, peer.Address, direction, age, peer.ProtocolVersion)
+			}
+		}),
+	}
+}
+
+func queryNetInfoCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "net-info",
+		Short: "Show the network information of the node",
+		Args:  cobra.NoArgs,
+		RunE: runQuery(func(ctx context.Context, rpcClient *client.Client, _ []string) (*pb.NetInfo, error) {
+			return rpcClient.GetNetInfo(ctx)
+		}, func(w io.Writer, netInfo *pb.NetInfo) {
+			fmt.Fprintf(w, "Node ID:\t%s\n", netInfo.Id)
+			for _, addr := range netInfo.ListenAddresses {
+				fmt.Fprintf(w, "Listen address:\t%s/p2p/%s\n", addr, netInfo.Id)
+			}
+			fmt.Fprintf(w, "Connected peers:\t%d\n", len(netInfo.ConnectedPeers))
+		}),
+	}
+}
+
+func queryHealthCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "health",
+		Short: "Show the readiness of the node and the results of its checks",
+		Args:  cobra.NoArgs,
+		RunE: runQuery(func(ctx context.Context, rpcClient *client.Client, _ []string) (*pb.ReadyzResponse, error) {
+			return rpcClient.GetReadiness(ctx)
+		}, func(w io.Writer, resp *pb.ReadyzResponse) {
+			fmt.Fprintf(w, "Status:\t%s\n", resp.Status)
+			for _, check := range resp.Checks {
+				fmt.Fprintf(w, "Check %s:\t%s\t%s\n", check.Name, check.Status, check.Message)
+			}
+			for _, e := range resp.Errors {
+				fmt.Fprintf(w, "Error %s:\t%s\n", e.Subsystem, e.Message)
+			}
+		}),
+	}
+}
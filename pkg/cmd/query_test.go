@@ -0,0 +1,70 @@
+package cmd
+
+import (
+	"context"
+	"encoding/binary"
+	"encoding/json"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/rs/zerolog"
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/evstack/ev-node/pkg/config"
+	"github.com/evstack/ev-node/pkg/rpc/server"
+	"github.com/evstack/ev-node/pkg/store"
+	"github.com/evstack/ev-node/types"
+)
+
+func TestQueryCmd(t *testing.T) {
+	ctx := context.Background()
+	kv, err := store.NewDefaultInMemoryKVStore()
+	require.NoError(t, err)
+	s := store.New(kv)
+	header, data := types.GetRandomBlock(1, 3, "query-chain")
+	require.NoError(t, s.SaveBlockData(ctx, header, data, &header.Signature))
+	require.NoError(t, s.SetHeight(ctx, 1))
+	require.NoError(t, s.UpdateState(ctx, types.State{ChainID: "query-chain", InitialHeight: 1, LastBlockHeight: 1}))
+	require.NoError(t, s.SetMetadata(ctx, "answer", binary.LittleEndian.AppendUint64(nil, 42)))
+
+	handler, err := server.NewServiceHandler(s, nil, zerolog.Nop(), config.DefaultConfig, server.ServiceOptions{})
+	require.NoError(t, err)
+	httpServer := httptest.NewServer(handler)
+	defer httpServer.Close()
+
+	query := func(args ...string) (string, error) {
+		rootCmd := &cobra.Command{Use: "root"}
+		config.AddGlobalFlags(rootCmd, "test")
+		rootCmd.AddCommand(QueryCmd())
+		return executeCommandC(rootCmd, append([]string{"query", "--home", t.TempDir(), "--node-rpc", httpServer.URL}, args...)...)
+	}
+
+	out, err := query("block", "1")
+	require.NoError(t, err, out)
+	assert.Contains(t, out, "Height:")
+	assert.Contains(t, out, header.Hash().String())
+	assert.Regexp(t, `Transactions:\s+3\n`, out)
+	assert.Contains(t, out, "not included")
+
+	out, err = query("block", header.Hash().String())
+	require.NoError(t, err, out)
+	assert.Contains(t, out, header.Hash().String())
+
+	out, err = query("state", "-o", "json")
+	require.NoError(t, err, out)
+	var state map[string]any
+	require.NoError(t, json.Unmarshal([]byte(out), &state), out)
+	assert.Equal(t, "query-chain", state["chainId"])
+	assert.Equal(t, "1", state["lastBlockHeight"])
+
+	out, err = query("metadata", "answer")
+	require.NoError(t, err, out)
+	assert.Regexp(t, `Uint64:\s+42\n`, out)
+
+	_, err = query("state", "-o", "yaml")
+	assert.ErrorContains(t, err, "unsupported output")
+	_, err = query("block", "not-a-hash")
+	assert.ErrorContains(t, err, "invalid block height or hash")
+}
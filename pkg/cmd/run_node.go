@@ -21,11 +21,13 @@ import (
 	rollconf "github.com/evstack/ev-node/pkg/config"
 	genesispkg "github.com/evstack/ev-node/pkg/genesis"
 	"github.com/evstack/ev-node/pkg/p2p"
+	"github.com/evstack/ev-node/pkg/preflight"
 	"github.com/evstack/ev-node/pkg/signer"
 	"github.com/evstack/ev-node/pkg/signer/file"
 )
 
 // ParseConfig is an helpers that loads the node configuration and validates it.
+// If a network is selected with the chain option, its genesis is written to the config directory.
 func ParseConfig(cmd *cobra.Command) (rollconf.Config, error) {
 	nodeConfig, err := rollconf.Load(cmd)
 	if err != nil {
@@ -36,6 +38,10 @@ func ParseConfig(cmd *cobra.Command) (rollconf.Config, error) {
 		return rollconf.Config{}, fmt.Errorf("failed to validate node config: %w", err)
 	}
 
+	if err := initChainGenesis(nodeConfig); err != nil {
+		return rollconf.Config{}, fmt.Errorf("failed to initialize genesis of chain %q: %w", nodeConfig.Chain, err)
+	}
+
 	return nodeConfig, nil
 }
 
@@ -90,7 +96,10 @@ func StartNode(
 
 	// create a new remote signer
 	var signer signer.Signer
-	if nodeConfig.Signer.SignerType == "file" && nodeConfig.Node.Aggregator {
+	if nodeConfig.Node.DryRun {
+		// the node signs its blocks with a throwaway key
+		logger.Warn().Msg("running in dry-run mode: blocks are produced but neither gossiped nor submitted to the DA layer")
+	} else if nodeConfig.Signer.SignerType == "file" && nodeConfig.Node.Aggregator {
 		passphrase, err := cmd.Flags().GetString(rollconf.FlagSignerPassphrase)
 		if err != nil {
 			return err
@@ -111,8 +120,26 @@ func StartNode(
 		return fmt.Errorf("unknown remote signer type: %s", nodeConfig.Signer.SignerType)
 	}
 
+	// check the environment before starting any service, reporting all failures at once
+	if !nodeConfig.Node.SkipPreflight {
+		checks, err := preflightChecks(nodeConfig, executor, da, datastore)
+		if err != nil {
+			return err
+		}
+		if err := preflight.Report(cmd.ErrOrStderr(), preflight.Run(ctx, checks, preflightTimeout)); err != nil {
+			return fmt.Errorf("%w, see the summary above or skip them with --%s", err, rollconf.FlagSkipPreflight)
+		}
+	}
+
 	metrics := node.DefaultMetricsProvider(nodeConfig.Instrumentation)
 
+	if nodeOptions.Version == "" {
+		nodeOptions.Version = Version
+	}
+	if nodeOptions.GitCommit == "" {
+		nodeOptions.GitCommit = GitSHA
+	}
+
 	// Create and start the node
 	rollnode, err := node.NewNode(
 		ctx,
@@ -156,7 +183,8 @@ func StartNode(
 		}
 	}()
 
-	// Wait for interrupt signal to gracefully shut down the server
+	// Wait for interrupt signal to gracefully shut down the server. The node drains its RPC
+	// requests for rpc.drain_timeout, failing its readiness, before stopping its services.
 	quit := make(chan os.Signal, 1)
 	signal.Notify(quit, os.Interrupt, syscall.SIGTERM)
 
@@ -165,14 +193,19 @@ func StartNode(
 		logger.Info().Msg("shutting down node...")
 		cancel()
 	case err := <-errCh:
+		// the node stops without error when its shutdown is requested through the admin RPC
+		if err == nil {
+			logger.Info().Msg("node stopped")
+			return nil
+		}
 		logger.Error().Err(err).Msg("node error")
 		cancel()
 		return err
 	}
 
-	// Wait for node to finish shutting down
+	// Wait for node to finish shutting down, after draining its RPC requests
 	select {
-	case <-time.After(5 * time.Second):
+	case <-time.After(5*time.Second + nodeConfig.RPC.DrainTimeout.Duration):
 		logger.Info().Msg("Node shutdown timed out")
 	case err := <-errCh:
 		if err != nil && !errors.Is(err, context.Canceled) {
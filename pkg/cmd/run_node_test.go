@@ -2,6 +2,7 @@ package cmd
 
 import (
 	"context"
+	"net"
 	"os"
 	"path/filepath"
 	"reflect"
@@ -563,6 +564,10 @@ func TestStartNodeErrors(t *testing.T) {
 	_, err = filesigner.CreateFileSystemSigner(dummySignerPath, []byte("password"))
 	assert.NoError(t, err)
 
+	busyListener, err := net.Listen("tcp", "127.0.0.1:0")
+	assert.NoError(t, err)
+	defer busyListener.Close()
+
 	testCases := []struct {
 		name           string
 		configModifier func(cfg *rollconf.Config)
@@ -627,6 +632,14 @@ func TestStartNodeErrors(t *testing.T) {
 			},
 			expectedError: "no such file or directory",
 		},
+		{
+			name: "PreflightPortInUse",
+			configModifier: func(cfg *rollconf.Config) {
+				cfg.RootDir = tmpDir
+				cfg.RPC.Address = busyListener.Addr().String()
+			},
+			expectedError: "preflight checks failed: ports",
+		},
 		// TODO: Add test case for node.NewNode error if possible with mocks
 	}
 
@@ -0,0 +1,78 @@
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/evstack/ev-node/pkg/scaffold"
+)
+
+const (
+	flagScaffoldVM     = "vm"
+	flagScaffoldName   = "name"
+	flagScaffoldModule = "module"
+	flagScaffoldOutput = "output"
+	flagScaffoldNode   = "ev-node-dir"
+)
+
+// ScaffoldCmd returns a command generating the repository of a new chain built on the node.
+func ScaffoldCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "scaffold",
+		Short: "Generate the repository of a new chain",
+		Long: `Generate a ready-to-run repository for a new chain: the main package wiring the node with the
+execution client of the VM, the genesis of the execution client, a docker-compose running the DA layer,
+the execution client and the node, and an end-to-end smoke test. The chain is built against the
+ev-node checkout of --ev-node-dir, which its go.mod replaces the ev-node modules with.
+
+The evm VM runs ev-reth through the Engine API, the grpc VM any execution service implementing the
+execution gRPC service. The repository is written to the output directory, ./<name> by default, which
+must not exist or be empty.`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			vm, err := cmd.Flags().GetString(flagScaffoldVM)
+			if err != nil {
+				return fmt.Errorf("failed to get '%s' flag: %w", flagScaffoldVM, err)
+			}
+			name, err := cmd.Flags().GetString(flagScaffoldName)
+			if err != nil {
+				return fmt.Errorf("failed to get '%s' flag: %w", flagScaffoldName, err)
+			}
+			module, err := cmd.Flags().GetString(flagScaffoldModule)
+			if err != nil {
+				return fmt.Errorf("failed to get '%s' flag: %w", flagScaffoldModule, err)
+			}
+			output, err := cmd.Flags().GetString(flagScaffoldOutput)
+			if err != nil {
+				return fmt.Errorf("failed to get '%s' flag: %w", flagScaffoldOutput, err)
+			}
+			if output == "" {
+				output = name
+			}
+			nodeDir, err := cmd.Flags().GetString(flagScaffoldNode)
+			if err != nil {
+				return fmt.Errorf("failed to get '%s' flag: %w", flagScaffoldNode, err)
+			}
+
+			files, err := scaffold.Generate(output, scaffold.Options{Name: name, Module: module, VM: vm, NodeDir: nodeDir})
+			if err != nil {
+				return err
+			}
+			for _, file := range files {
+				cmd.Printf("  %s\n", file)
+			}
+			cmd.Printf("Chain %s generated in %s, run `go mod tidy` there to fetch its dependencies\n", name, output)
+			return nil
+		},
+	}
+
+	cmd.Flags().String(flagScaffoldVM, scaffold.VMEVM, fmt.Sprintf("VM of the chain (%s, %s or %s)", scaffold.VMEVM, scaffold.VMGRPC, scaffold.VMABCI))
+	cmd.Flags().String(flagScaffoldName, "", "name of the chain, used as binary name and chain ID")
+	cmd.Flags().String(flagScaffoldModule, "", "Go module path of the chain (default the name)")
+	cmd.Flags().String(flagScaffoldOutput, "", "directory to generate the chain in (default ./<name>)")
+	cmd.Flags().String(flagScaffoldNode, "", "directory of the ev-node checkout the chain is built against")
+	_ = cmd.MarkFlagRequired(flagScaffoldName)
+	_ = cmd.MarkFlagRequired(flagScaffoldNode)
+	return cmd
+}
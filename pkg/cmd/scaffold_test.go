@@ -0,0 +1,35 @@
+package cmd
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/require"
+)
+
+func TestScaffoldCmd(t *testing.T) {
+	output := filepath.Join(t.TempDir(), "mychain")
+	scaffold := func(args ...string) (string, error) {
+		rootCmd := &cobra.Command{Use: "root"}
+		rootCmd.AddCommand(ScaffoldCmd())
+		return executeCommandC(rootCmd, append([]string{"scaffold"}, args...)...)
+	}
+
+	out, err := scaffold("--vm", "grpc", "--name", "mychain", "--ev-node-dir", "../..", "--output", output)
+	require.NoError(t, err, out)
+	require.Contains(t, out, "run.go")
+	require.Contains(t, out, "Chain mychain generated in "+output)
+	_, err = os.Stat(filepath.Join(output, "main.go"))
+	require.NoError(t, err)
+
+	// the output directory is not overwritten
+	_, err = scaffold("--vm", "grpc", "--name", "mychain", "--ev-node-dir", "../..", "--output", output)
+	require.ErrorContains(t, err, "not empty")
+
+	_, err = scaffold("--vm", "abci", "--name", "mychain", "--ev-node-dir", "../..", "--output", t.TempDir())
+	require.ErrorContains(t, err, "not supported")
+	_, err = scaffold("--vm", "grpc", "--name", "mychain", "--ev-node-dir", t.TempDir(), "--output", t.TempDir())
+	require.ErrorContains(t, err, "not an ev-node checkout")
+}
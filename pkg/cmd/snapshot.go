@@ -0,0 +1,116 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"os"
+
+	ds "github.com/ipfs/go-datastore"
+	ktds "github.com/ipfs/go-datastore/keytransform"
+	"github.com/spf13/cobra"
+
+	"github.com/evstack/ev-node/node"
+	rollconf "github.com/evstack/ev-node/pkg/config"
+	"github.com/evstack/ev-node/pkg/store"
+)
+
+// SnapshotCmd returns a command exporting the store of the node named dbName to a snapshot, and
+// restoring it from one.
+func SnapshotCmd(dbName string) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "snapshot",
+		Short: "Export the store of the node to a snapshot, or restore it from one",
+		Long: `Export the blocks, state and metadata of the store of a node to a snapshot file, and restore them
+into the empty store of another node, so that it bootstraps from the snapshot instead of syncing the
+whole chain. The snapshot does not include the state of the execution client, which must be restored
+or synced up to the height of the snapshot separately.`,
+	}
+
+	cmd.AddCommand(&cobra.Command{
+		Use:   "export <file>",
+		Short: "Export the store of the node to a snapshot file",
+		Long:  "Export all the entries of the store of the node to a snapshot file. The node must be stopped.",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			nodeConfig, err := ParseConfig(cmd)
+			if err != nil {
+				return err
+			}
+			s, snapshotter, err := openSnapshotStore(nodeConfig, dbName)
+			if err != nil {
+				return err
+			}
+			defer s.Close()
+
+			file, err := os.OpenFile(args[0], os.O_WRONLY|os.O_CREATE|os.O_EXCL, 0o600)
+			if err != nil {
+				return fmt.Errorf("failed to create snapshot file: %w", err)
+			}
+			info, err := snapshotter.ExportSnapshot(context.Background(), file)
+			if err == nil {
+				err = file.Sync()
+			}
+			if closeErr := file.Close(); err == nil {
+				err = closeErr
+			}
+			if err != nil {
+				_ = os.Remove(args[0])
+				return fmt.Errorf("failed to export snapshot: %w", err)
+			}
+
+			cmd.Printf("Exported snapshot of height %d to %s (%d entries in %d chunks)\n", info.Height, args[0], info.Entries, info.Chunks)
+			return nil
+		},
+	})
+
+	cmd.AddCommand(&cobra.Command{
+		Use:   "restore <file>",
+		Short: "Restore the store of the node from a snapshot file",
+		Long: `Restore the store of the node from a snapshot file. The store must be empty, and the node must be
+stopped. Every chunk of the snapshot is verified against its checksum before it is written; if the
+restoration fails, clean the store with unsafe-clean before restoring again.`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			nodeConfig, err := ParseConfig(cmd)
+			if err != nil {
+				return err
+			}
+			file, err := os.Open(args[0])
+			if err != nil {
+				return fmt.Errorf("failed to open snapshot file: %w", err)
+			}
+			defer file.Close()
+
+			s, snapshotter, err := openSnapshotStore(nodeConfig, dbName)
+			if err != nil {
+				return err
+			}
+			defer s.Close()
+
+			info, err := snapshotter.ImportSnapshot(context.Background(), file)
+			if err != nil {
+				return fmt.Errorf("failed to restore snapshot: %w", err)
+			}
+
+			cmd.Printf("Restored snapshot of height %d from %s (%d entries in %d chunks)\n", info.Height, args[0], info.Entries, info.Chunks)
+			return nil
+		},
+	})
+
+	return cmd
+}
+
+// openSnapshotStore opens the store of the node named dbName, under the prefix the node keeps it.
+func openSnapshotStore(nodeConfig rollconf.Config, dbName string) (store.Store, store.Snapshotter, error) {
+	datastore, err := store.NewKVStore(nodeConfig.DBBackend, nodeConfig.RootDir, nodeConfig.DBPath, dbName)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to open store: %w", err)
+	}
+	s := store.New(ktds.Wrap(datastore, ktds.PrefixTransform{Prefix: ds.NewKey(node.EvPrefix)}))
+	snapshotter, ok := s.(store.Snapshotter)
+	if !ok {
+		_ = s.Close()
+		return nil, nil, fmt.Errorf("store does not support snapshots")
+	}
+	return s, snapshotter, nil
+}
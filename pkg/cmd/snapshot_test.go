@@ -0,0 +1,69 @@
+package cmd
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+
+	ds "github.com/ipfs/go-datastore"
+	ktds "github.com/ipfs/go-datastore/keytransform"
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/require"
+
+	"github.com/evstack/ev-node/node"
+	rollconf "github.com/evstack/ev-node/pkg/config"
+	"github.com/evstack/ev-node/pkg/store"
+	"github.com/evstack/ev-node/types"
+)
+
+func TestSnapshotCmd(t *testing.T) {
+	ctx := context.Background()
+	const dbName = "test"
+	openStore := func(home string) store.Store {
+		datastore, err := store.NewDefaultKVStore(home, rollconf.DefaultConfig.DBPath, dbName)
+		require.NoError(t, err)
+		return store.New(ktds.Wrap(datastore, ktds.PrefixTransform{Prefix: ds.NewKey(node.EvPrefix)}))
+	}
+
+	source := t.TempDir()
+	s := openStore(source)
+	for h := uint64(1); h <= 3; h++ {
+		header, data := types.GetRandomBlock(h, 2, "test-chain")
+		require.NoError(t, s.SaveBlockData(ctx, header, data, &header.Signature))
+		require.NoError(t, s.SetHeight(ctx, h))
+	}
+	require.NoError(t, s.Close())
+
+	newRoot := func() *cobra.Command {
+		rootCmd := &cobra.Command{Use: "root", SilenceUsage: true, SilenceErrors: true}
+		rollconf.AddGlobalFlags(rootCmd, "test")
+		rootCmd.AddCommand(SnapshotCmd(dbName))
+		return rootCmd
+	}
+
+	file := filepath.Join(t.TempDir(), "snapshot.bin")
+	out, err := executeCommandC(newRoot(), "snapshot", "export", file, "--home", source)
+	require.NoError(t, err, out)
+	require.Contains(t, out, "Exported snapshot of height 3 to "+file)
+
+	// an existing snapshot is not overwritten
+	_, err = executeCommandC(newRoot(), "snapshot", "export", file, "--home", source)
+	require.Error(t, err)
+
+	target := t.TempDir()
+	out, err = executeCommandC(newRoot(), "snapshot", "restore", file, "--home", target)
+	require.NoError(t, err, out)
+	require.Contains(t, out, "Restored snapshot of height 3 from "+file)
+
+	// the store of the source is not empty
+	_, err = executeCommandC(newRoot(), "snapshot", "restore", file, "--home", source)
+	require.ErrorContains(t, err, "not empty")
+
+	s = openStore(target)
+	defer s.Close()
+	height, err := s.Height(ctx)
+	require.NoError(t, err)
+	require.Equal(t, uint64(3), height)
+	_, _, err = s.GetBlockData(ctx, 3)
+	require.NoError(t, err)
+}
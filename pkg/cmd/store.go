@@ -4,8 +4,12 @@ import (
 	"fmt"
 	"os"
 	"path/filepath"
+	"slices"
+	"strings"
 
 	"github.com/spf13/cobra"
+
+	"github.com/evstack/ev-node/pkg/store"
 )
 
 // UnsafeCleanDataDir removes all contents of the specified data directory.
@@ -53,3 +57,40 @@ This operation is unsafe and cannot be undone. Use with caution!`,
 		return nil
 	},
 }
+
+// MigrateStoreCmd returns a command converting the store of the node named dbName to another
+// backend.
+func MigrateStoreCmd(dbName string) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "migrate-store",
+		Short: "Convert the store of the node to another backend",
+		Long: `Convert the store of the node to another storage engine, e.g. from badger to pebble. All the entries
+of the store are copied to a store of the new backend, which then replaces it; the previous store is
+kept next to it with a .<backend>.bak suffix, and can be removed once the node runs on the new one.
+The node must be stopped, and db_backend set to the new backend in its configuration afterwards.`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			nodeConfig, err := ParseConfig(cmd)
+			if err != nil {
+				return err
+			}
+			backend, err := cmd.Flags().GetString("to")
+			if err != nil {
+				return err
+			}
+			if !slices.Contains(store.Backends, backend) {
+				return fmt.Errorf("unknown store backend %q, expected one of %s", backend, strings.Join(store.Backends, ", "))
+			}
+
+			info, err := store.MigrateKVStore(cmd.Context(), backend, nodeConfig.RootDir, nodeConfig.DBPath, dbName)
+			if err != nil {
+				return err
+			}
+			cmd.Printf("Migrated %d entries from %s to %s, the %s store is backed up at %s\n", info.Entries, info.From, info.To, info.From, info.Backup)
+			cmd.Printf("Set db_backend: %s in the configuration before starting the node\n", info.To)
+			return nil
+		},
+	}
+	cmd.Flags().String("to", store.BackendPebble, fmt.Sprintf("backend to convert the store to (%s)", strings.Join(store.Backends, ", ")))
+	return cmd
+}
@@ -2,6 +2,7 @@ package cmd
 
 import (
 	"bytes"
+	"context"
 	"fmt"
 	"os"
 	"path/filepath"
@@ -9,6 +10,10 @@ import (
 
 	"github.com/spf13/cobra"
 	"github.com/stretchr/testify/require"
+
+	rollconf "github.com/evstack/ev-node/pkg/config"
+	"github.com/evstack/ev-node/pkg/store"
+	"github.com/evstack/ev-node/types"
 )
 
 func TestUnsafeCleanDataDir(t *testing.T) {
@@ -85,3 +90,43 @@ func TestStoreUnsafeCleanCmd(t *testing.T) {
 	// Check output message (optional)
 	require.Contains(t, buf.String(), fmt.Sprintf("All contents of the data directory at %s have been removed.", dataDir))
 }
+
+func TestMigrateStoreCmd(t *testing.T) {
+	ctx := context.Background()
+	const dbName = "test"
+	home := t.TempDir()
+
+	datastore, err := store.NewKVStore(store.BackendBadger, home, rollconf.DefaultConfig.DBPath, dbName)
+	require.NoError(t, err)
+	s := store.New(datastore)
+	header, data := types.GetRandomBlock(1, 2, "test-chain")
+	require.NoError(t, s.SaveBlockData(ctx, header, data, &header.Signature))
+	require.NoError(t, s.SetHeight(ctx, 1))
+	require.NoError(t, s.Close())
+
+	newRoot := func() *cobra.Command {
+		rootCmd := &cobra.Command{Use: "root", SilenceUsage: true, SilenceErrors: true}
+		rollconf.AddGlobalFlags(rootCmd, "test")
+		rootCmd.AddCommand(MigrateStoreCmd(dbName))
+		return rootCmd
+	}
+
+	_, err = executeCommandC(newRoot(), "migrate-store", "--to", "rocksdb", "--home", home)
+	require.ErrorContains(t, err, "unknown store backend")
+
+	out, err := executeCommandC(newRoot(), "migrate-store", "--to", store.BackendPebble, "--home", home)
+	require.NoError(t, err, out)
+	require.Contains(t, out, "from badger to pebble")
+	require.Contains(t, out, "Set db_backend: pebble")
+
+	_, err = executeCommandC(newRoot(), "migrate-store", "--to", store.BackendPebble, "--home", home)
+	require.ErrorContains(t, err, "already uses the pebble backend")
+
+	datastore, err = store.NewKVStore(store.BackendPebble, home, rollconf.DefaultConfig.DBPath, dbName)
+	require.NoError(t, err)
+	s = store.New(datastore)
+	defer s.Close()
+	_, migrated, err := s.GetBlockData(ctx, 1)
+	require.NoError(t, err)
+	require.Equal(t, data.Txs, migrated.Txs)
+}
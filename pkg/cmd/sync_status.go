@@ -0,0 +1,231 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"os/signal"
+	"slices"
+	"strings"
+	"syscall"
+	"text/tabwriter"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	rollconf "github.com/evstack/ev-node/pkg/config"
+	"github.com/evstack/ev-node/pkg/rpc/client"
+	pb "github.com/evstack/ev-node/types/pb/evnode/v1"
+)
+
+const (
+	flagSyncStatusWatch    = "watch"
+	flagSyncStatusInterval = "interval"
+
+	// syncRateWindow is the number of polls the throughput and ETA are computed over.
+	syncRateWindow = 30
+
+	// clearScreen moves the cursor home and clears the terminal, to redraw the progress in place.
+	clearScreen = "\033[H\033[2J"
+)
+
+// syncSample is the sync status of the node at a point in time.
+type syncSample struct {
+	at     time.Time
+	status *pb.GetSyncStatusResponse
+}
+
+// syncProgress computes the throughput of each sync stage over the last polls.
+type syncProgress struct {
+	samples []syncSample
+}
+
+func (p *syncProgress) add(at time.Time, status *pb.GetSyncStatusResponse) {
+	p.samples = append(p.samples, syncSample{at: at, status: status})
+	if len(p.samples) > syncRateWindow {
+		p.samples = p.samples[len(p.samples)-syncRateWindow:]
+	}
+}
+
+// rate returns the increase per second of the height returned by height over the window, and
+// false if there are not enough samples yet.
+func (p *syncProgress) rate(height func(*pb.GetSyncStatusResponse) uint64) (float64, bool) {
+	if len(p.samples) < 2 {
+		return 0, false
+	}
+	first, last := p.samples[0], p.samples[len(p.samples)-1]
+	elapsed := last.at.Sub(first.at).Seconds()
+	if elapsed <= 0 {
+		return 0, false
+	}
+	from, to := height(first.status), height(last.status)
+	if to < from {
+		return 0, true
+	}
+	return float64(to-from) / elapsed, true
+}
+
+// eta returns the time left to catch up with the network at the current throughput, and false
+// if the node is not catching up.
+func (p *syncProgress) eta() (time.Duration, bool) {
+	last := p.samples[len(p.samples)-1].status
+	if last.NetworkHeight == 0 {
+		return 0, false
+	}
+	if last.Height >= last.NetworkHeight {
+		return 0, true
+	}
+	applied, ok := p.rate((*pb.GetSyncStatusResponse).GetHeight)
+	if !ok {
+		return 0, false
+	}
+	produced, _ := p.rate((*pb.GetSyncStatusResponse).GetNetworkHeight)
+	if applied <= produced {
+		return 0, false
+	}
+	left := float64(last.NetworkHeight-last.Height) / (applied - produced)
+	return max(time.Duration(left*float64(time.Second)).Round(time.Second), time.Second), true
+}
+
+// SyncStatusCmd returns a command printing the sync progress of a running node.
+func SyncStatusCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "sync-status",
+		Short: "Show the sync progress of a running node",
+		Long: `Show the sync progress of a running node: its height against the network height, the DA heights
+and the sources the applied headers and data were obtained from.
+
+With --watch, the node is polled until interrupted and the progress is redrawn in place with the
+throughput of each sync stage and the estimated time left to catch up with the network.`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			nodeConfig, err := rollconf.Load(cmd)
+			if err != nil {
+				return fmt.Errorf("failed to load node config: %w", err)
+			}
+			watch, _ := cmd.Flags().GetBool(flagSyncStatusWatch)
+			interval, _ := cmd.Flags().GetDuration(flagSyncStatusInterval)
+			if interval <= 0 {
+				return fmt.Errorf("--%s must be positive", flagSyncStatusInterval)
+			}
+
+			rpcClient := client.NewClient(nodeRPCURL(cmd, nodeConfig))
+			ctx, cancel := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+			defer cancel()
+
+			out := cmd.OutOrStdout()
+			progress := &syncProgress{}
+			ticker := time.NewTicker(interval)
+			defer ticker.Stop()
+			for {
+				status, err := rpcClient.GetSyncStatus(ctx)
+				if err != nil {
+					if ctx.Err() != nil {
+						return nil
+					}
+					return fmt.Errorf("error calling GetSyncStatus RPC: %w", err)
+				}
+				progress.add(time.Now(), status)
+
+				if watch {
+					fmt.Fprint(out, clearScreen)
+				}
+				renderSyncStatus(out, progress)
+				if !watch {
+					return nil
+				}
+
+				select {
+				case <-ctx.Done():
+					return nil
+				case <-ticker.C:
+				}
+			}
+		},
+	}
+
+	cmd.Flags().Bool(flagSyncStatusWatch, false, "poll the node and redraw the progress until interrupted")
+	cmd.Flags().Duration(flagSyncStatusInterval, 2*time.Second, "interval between polls with --watch")
+	cmd.Flags().String(flagNodeAddress, "", "RPC address of the node (defaults to the configured RPC address)")
+	return cmd
+}
+
+// renderSyncStatus writes the last sync status of progress, with the throughput and ETA once
+// there are enough samples.
+func renderSyncStatus(out io.Writer, progress *syncProgress) {
+	status := progress.samples[len(progress.samples)-1].status
+	w := tabwriter.NewWriter(out, 2, 0, 2, ' ', 0)
+
+	state := "\033[1;32mSYNCED\033[0m"
+	if status.Syncing {
+		state = "\033[1;33mSYNCING\033[0m"
+	}
+	fmt.Fprintf(w, "%s\n", strings.Repeat("=", 50))
+	fmt.Fprintf(w, "🔄 SYNC STATUS: %s\n", state)
+	fmt.Fprintf(w, "%s\n", strings.Repeat("=", 50))
+
+	network := "unknown"
+	if status.NetworkHeight > 0 {
+		network = fmt.Sprintf("%d (%.1f%%)", status.NetworkHeight, 100*float64(min(status.Height, status.NetworkHeight))/float64(status.NetworkHeight))
+	}
+	fmt.Fprintf(w, "Height:\t\033[1;36m%d\033[0m\n", status.Height)
+	fmt.Fprintf(w, "Network height:\t%s\n", network)
+	fmt.Fprintf(w, "DA height:\t%d\n", status.DaHeight)
+	fmt.Fprintf(w, "DA included height:\t%d\n", status.DaIncludedHeight)
+	if status.PrunedBaseHeight > 0 {
+		fmt.Fprintf(w, "Pruned up to height:\t%d\n", status.PrunedBaseHeight)
+	}
+
+	fmt.Fprintf(w, "%s\n", strings.Repeat("-", 50))
+	fmt.Fprintf(w, "📈 THROUGHPUT\n")
+	stages := []struct {
+		name   string
+		unit   string
+		height func(*pb.GetSyncStatusResponse) uint64
+	}{
+		{"Blocks applied", "blocks/s", (*pb.GetSyncStatusResponse).GetHeight},
+		{"Network headers", "headers/s", (*pb.GetSyncStatusResponse).GetNetworkHeight},
+		{"DA retrieval", "DA heights/s", (*pb.GetSyncStatusResponse).GetDaHeight},
+		{"DA inclusion", "blocks/s", (*pb.GetSyncStatusResponse).GetDaIncludedHeight},
+	}
+	for _, stage := range stages {
+		if rate, ok := progress.rate(stage.height); ok {
+			fmt.Fprintf(w, "%s:\t%.2f %s\n", stage.name, rate, stage.unit)
+		} else {
+			fmt.Fprintf(w, "%s:\tmeasuring...\n", stage.name)
+		}
+	}
+	switch eta, ok := progress.eta(); {
+	case !ok:
+		fmt.Fprintf(w, "ETA:\tunknown\n")
+	case eta == 0:
+		fmt.Fprintf(w, "ETA:\tcaught up\n")
+	default:
+		fmt.Fprintf(w, "ETA:\t\033[1;33m%s\033[0m\n", eta)
+	}
+
+	fmt.Fprintf(w, "%s\n", strings.Repeat("-", 50))
+	fmt.Fprintf(w, "📦 SOURCES (since node start)\n")
+	fmt.Fprintf(w, "Headers:\t%s\n", formatSyncSources(status.HeadersBySource))
+	fmt.Fprintf(w, "Data:\t%s\n", formatSyncSources(status.DataBySource))
+	fmt.Fprintf(w, "%s\n", strings.Repeat("=", 50))
+	w.Flush()
+}
+
+// formatSyncSources formats counts by sync source, sorted by source.
+func formatSyncSources(counts map[string]uint64) string {
+	if len(counts) == 0 {
+		return "none"
+	}
+	sources := make([]string, 0, len(counts))
+	for source := range counts {
+		sources = append(sources, source)
+	}
+	slices.Sort(sources)
+	parts := make([]string, len(sources))
+	for i, source := range sources {
+		parts[i] = fmt.Sprintf("%s=%d", source, counts[source])
+	}
+	return strings.Join(parts, " ")
+}
@@ -0,0 +1,92 @@
+package cmd
+
+import (
+	"context"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/rs/zerolog"
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/evstack/ev-node/pkg/config"
+	"github.com/evstack/ev-node/pkg/rpc/server"
+	"github.com/evstack/ev-node/pkg/store"
+	pb "github.com/evstack/ev-node/types/pb/evnode/v1"
+)
+
+func TestSyncStatusCmd(t *testing.T) {
+	kv, err := store.NewDefaultInMemoryKVStore()
+	require.NoError(t, err)
+	s := store.New(kv)
+	require.NoError(t, s.SetHeight(context.Background(), 40))
+
+	status := server.StaticSyncStatus(server.SyncStatus{
+		NetworkHeight:    100,
+		DAHeight:         7,
+		DAIncludedHeight: 30,
+		HeadersBySource:  map[string]uint64{"p2p": 35, "da": 5},
+		DataBySource:     map[string]uint64{"empty": 40},
+	})
+	handler, err := server.NewServiceHandler(s, nil, zerolog.Nop(), config.DefaultConfig, server.ServiceOptions{SyncStatus: status})
+	require.NoError(t, err)
+	httpServer := httptest.NewServer(handler)
+	defer httpServer.Close()
+
+	rootCmd := &cobra.Command{Use: "root"}
+	config.AddGlobalFlags(rootCmd, "test")
+	rootCmd.AddCommand(SyncStatusCmd())
+	out, err := executeCommandC(rootCmd, "sync-status", "--home", t.TempDir(), "--node-rpc", httpServer.URL)
+	require.NoError(t, err, out)
+
+	assert.Contains(t, out, "SYNCING")
+	assert.Contains(t, out, "100 (40.0%)")
+	assert.Contains(t, out, "Blocks applied:")
+	assert.Contains(t, out, "measuring...")
+	assert.Contains(t, out, "da=5 p2p=35")
+	assert.Contains(t, out, "empty=40")
+	// a single snapshot is not redrawn
+	assert.NotContains(t, out, clearScreen)
+}
+
+func TestSyncProgress(t *testing.T) {
+	start := time.Now()
+	progress := &syncProgress{}
+	progress.add(start, &pb.GetSyncStatusResponse{Height: 100, NetworkHeight: 1000, DaHeight: 10})
+	_, ok := progress.eta()
+	assert.False(t, ok)
+
+	// 50 blocks/s applied while the network produces 5 blocks/s
+	progress.add(start.Add(10*time.Second), &pb.GetSyncStatusResponse{Height: 600, NetworkHeight: 1050, DaHeight: 30})
+	rate, ok := progress.rate((*pb.GetSyncStatusResponse).GetHeight)
+	require.True(t, ok)
+	assert.InDelta(t, 50, rate, 0.001)
+	rate, ok = progress.rate((*pb.GetSyncStatusResponse).GetDaHeight)
+	require.True(t, ok)
+	assert.InDelta(t, 2, rate, 0.001)
+	eta, ok := progress.eta()
+	require.True(t, ok)
+	assert.Equal(t, 10*time.Second, eta)
+
+	var sb strings.Builder
+	renderSyncStatus(&sb, progress)
+	assert.Contains(t, sb.String(), "50.00 blocks/s")
+	assert.Contains(t, sb.String(), "10s")
+
+	// the node does not catch up if the network is faster
+	progress.add(start.Add(20*time.Second), &pb.GetSyncStatusResponse{Height: 700, NetworkHeight: 2000})
+	_, ok = progress.eta()
+	assert.False(t, ok)
+
+	// only the last polls are kept
+	for i := range 2 * syncRateWindow {
+		progress.add(start.Add(time.Duration(30+i)*time.Second), &pb.GetSyncStatusResponse{Height: 2000, NetworkHeight: 2000})
+	}
+	assert.Len(t, progress.samples, syncRateWindow)
+	eta, ok = progress.eta()
+	require.True(t, ok)
+	assert.Zero(t, eta)
+}
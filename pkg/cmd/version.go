@@ -6,6 +6,8 @@ import (
 	"text/tabwriter"
 
 	"github.com/spf13/cobra"
+
+	"github.com/evstack/ev-node/pkg/buildinfo"
 )
 
 var (
@@ -31,7 +33,10 @@ var VersionCmd = &cobra.Command{
 		w := tabwriter.NewWriter(out, 2, 0, 2, ' ', 0)
 		_, err1 := fmt.Fprintf(w, "\nevolve version:\t%v\n", Version)
 		_, err2 := fmt.Fprintf(w, "evolve git sha:\t%v\n", GitSHA)
-		_, err3 := fmt.Fprintln(w, "")
-		return errors.Join(err1, err2, err3, w.Flush())
+		build := buildinfo.Read()
+		_, err3 := fmt.Fprintf(w, "evolve builder:\t%v\n", build.GetBuilder())
+		_, err4 := fmt.Fprintf(w, "evolve build digest:\t%v\n", build.GetDigest())
+		_, err5 := fmt.Fprintln(w, "")
+		return errors.Join(err1, err2, err3, err4, err5, w.Flush())
 	},
 }
@@ -7,6 +7,8 @@ import (
 	"github.com/spf13/cobra"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+
+	"github.com/evstack/ev-node/pkg/buildinfo"
 )
 
 // executeCommand executes the given Cobra command with the provided args
@@ -30,6 +32,7 @@ func TestVersionCmd_Success(t *testing.T) {
 	require.NoError(t, err)
 	assert.Contains(t, output, "v0.1.0-test")
 	assert.Contains(t, output, "abcdef123test")
+	assert.Contains(t, output, buildinfo.Read().GetDigest())
 }
 
 func TestVersionCmd_MissingVersion(t *testing.T) {
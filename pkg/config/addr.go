@@ -2,40 +2,48 @@ package config
 
 import (
 	"errors"
+	"net"
 	"strings"
 
 	"github.com/multiformats/go-multiaddr"
 )
 
-var errInvalidAddress = errors.New("invalid address format, expected [protocol://][<NODE_ID>@]<IPv4>:<PORT>")
+var errInvalidAddress = errors.New("invalid address format, expected [protocol://][<NODE_ID>@]<HOST>:<PORT>")
 
 // TranslateAddresses updates conf by changing Cosmos-style addresses to Multiaddr format.
 func TranslateAddresses(conf *Config) error {
-	if conf.P2P.ListenAddress != "" {
-		addr, err := GetMultiAddr(conf.P2P.ListenAddress)
-		if err != nil {
-			return err
-		}
-		conf.P2P.ListenAddress = addr.String()
+	var err error
+	if conf.P2P.ListenAddress, err = translateAddressList(conf.P2P.ListenAddress); err != nil {
+		return err
+	}
+	if conf.P2P.ExternalAddresses, err = translateAddressList(conf.P2P.ExternalAddresses); err != nil {
+		return err
+	}
+	if conf.P2P.Peers, err = translateAddressList(conf.P2P.Peers); err != nil {
+		return err
 	}
 
-	seeds := strings.Split(conf.P2P.Peers, ",")
-	for i, seed := range seeds {
-		if seed != "" {
-			addr, err := GetMultiAddr(seed)
+	return nil
+}
+
+// translateAddressList translates a comma separated list of Cosmos-style addresses to Multiaddr format.
+func translateAddressList(list string) (string, error) {
+	addrs := strings.Split(list, ",")
+	for i, a := range addrs {
+		if a != "" {
+			addr, err := GetMultiAddr(a)
 			if err != nil {
-				return err
+				return "", err
 			}
-			seeds[i] = addr.String()
+			addrs[i] = addr.String()
 		}
 	}
-	conf.P2P.Peers = strings.Join(seeds, ",")
-
-	return nil
+	return strings.Join(addrs, ","), nil
 }
 
 // GetMultiAddr converts single Cosmos-style network address into Multiaddr.
-// Input format: [protocol://][<NODE_ID>@]<IPv4>:<PORT>
+// Input format: [protocol://][<NODE_ID>@]<HOST>:<PORT>, where HOST is an IPv4 address, an IPv6
+// address in brackets (e.g. [::1]:7676) or a DNS name.
 func GetMultiAddr(addr string) (multiaddr.Multiaddr, error) {
 	var err error
 	var p2pID multiaddr.Multiaddr
@@ -53,11 +61,11 @@ func GetMultiAddr(addr string) (multiaddr.Multiaddr, error) {
 		}
 		addr = addr[at+1:]
 	}
-	parts = strings.Split(addr, ":")
-	if len(parts) != 2 {
+	host, port, err := net.SplitHostPort(addr)
+	if err != nil {
 		return nil, errInvalidAddress
 	}
-	maddr, err := multiaddr.NewMultiaddr("/ip4/" + parts[0] + "/" + proto + "/" + parts[1])
+	maddr, err := multiaddr.NewMultiaddr("/" + hostProtocol(host) + "/" + host + "/" + proto + "/" + port)
 	if err != nil {
 		return nil, err
 	}
@@ -66,3 +74,34 @@ func GetMultiAddr(addr string) (multiaddr.Multiaddr, error) {
 	}
 	return maddr, nil
 }
+
+// hostProtocol returns the multiaddr protocol of a host: ip4 for IPv4 addresses, including
+// IPv4-mapped IPv6 ones, ip6 for the other IPv6 addresses and dns for names.
+func hostProtocol(host string) string {
+	ip := net.ParseIP(host)
+	switch {
+	case ip == nil:
+		return "dns"
+	case ip.To4() != nil:
+		return "ip4"
+	default:
+		return "ip6"
+	}
+}
+
+// RPCClientURL returns the URL at which clients on the same host reach an RPC server listening on
+// the given host:port address. Unspecified hosts, as used to listen on all interfaces, are
+// replaced by the loopback address of the same IP version.
+func RPCClientURL(address string) string {
+	host, port, err := net.SplitHostPort(address)
+	if err != nil {
+		return "http://" + address
+	}
+	switch host {
+	case "", "0.0.0.0":
+		host = "127.0.0.1"
+	case "::":
+		host = "::1"
+	}
+	return "http://" + net.JoinHostPort(host, port)
+}
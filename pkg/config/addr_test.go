@@ -28,6 +28,18 @@ func TestTranslateAddresses(t *testing.T) {
 			Config{P2P: P2PConfig{ListenAddress: validIP}},
 			"",
 		},
+		{
+			"multiple listen and external addresses",
+			Config{P2P: P2PConfig{ListenAddress: legactIP + "," + legactIP, ExternalAddresses: "1.2.3.4:1234"}},
+			Config{P2P: P2PConfig{ListenAddress: validIP + "," + validIP, ExternalAddresses: "/ip4/1.2.3.4/tcp/1234"}},
+			"",
+		},
+		{
+			"invalid external address",
+			Config{P2P: P2PConfig{ExternalAddresses: invalidCosmos}},
+			Config{},
+			errInvalidAddress.Error(),
+		},
 		{
 			"valid seed address",
 			Config{P2P: P2PConfig{Peers: legactIP + "," + legactIP}},
@@ -71,6 +83,9 @@ func TestGetMultiaddr(t *testing.T) {
 	valid := mustGetMultiaddr(t, "/ip4/127.0.0.1/tcp/1234")
 	withID := mustGetMultiaddr(t, "/ip4/127.0.0.1/tcp/1234/p2p/k2k4r8oqamigqdo6o7hsbfwd45y70oyynp98usk7zmyfrzpqxh1pohl7")
 	udpWithID := mustGetMultiaddr(t, "/ip4/127.0.0.1/udp/1234/p2p/k2k4r8oqamigqdo6o7hsbfwd45y70oyynp98usk7zmyfrzpqxh1pohl7")
+	ip6 := mustGetMultiaddr(t, "/ip6/2001:db8::7/tcp/1234")
+	ip6WithID := mustGetMultiaddr(t, "/ip6/::1/tcp/1234/p2p/k2k4r8oqamigqdo6o7hsbfwd45y70oyynp98usk7zmyfrzpqxh1pohl7")
+	dns := mustGetMultiaddr(t, "/dns/node.example.com/tcp/1234")
 
 	cases := []struct {
 		name        string
@@ -85,6 +100,11 @@ func TestGetMultiaddr(t *testing.T) {
 		{"valid", "127.0.0.1:1234", valid, ""},
 		{"valid with id", "k2k4r8oqamigqdo6o7hsbfwd45y70oyynp98usk7zmyfrzpqxh1pohl7@127.0.0.1:1234", withID, ""},
 		{"valid with id and proto", "udp://k2k4r8oqamigqdo6o7hsbfwd45y70oyynp98usk7zmyfrzpqxh1pohl7@127.0.0.1:1234", udpWithID, ""},
+		{"ipv6", "[2001:db8::7]:1234", ip6, ""},
+		{"ipv6 with id", "k2k4r8oqamigqdo6o7hsbfwd45y70oyynp98usk7zmyfrzpqxh1pohl7@[::1]:1234", ip6WithID, ""},
+		{"ipv6 without brackets", "2001:db8::7:1234", nil, errInvalidAddress.Error()},
+		{"ipv4-mapped ipv6", "[::ffff:127.0.0.1]:1234", valid, ""},
+		{"dns", "node.example.com:1234", dns, ""},
 	}
 
 	for _, c := range cases {
@@ -103,6 +123,23 @@ func TestGetMultiaddr(t *testing.T) {
 	}
 }
 
+func TestRPCClientURL(t *testing.T) {
+	t.Parallel()
+
+	cases := map[string]string{
+		"127.0.0.1:7331":   "http://127.0.0.1:7331",
+		"0.0.0.0:7331":     "http://127.0.0.1:7331",
+		":7331":            "http://127.0.0.1:7331",
+		"[::]:7331":        "http://[::1]:7331",
+		"[::1]:7331":       "http://[::1]:7331",
+		"[2001:db8::7]:80": "http://[2001:db8::7]:80",
+		"localhost:7331":   "http://localhost:7331",
+	}
+	for address, expected := range cases {
+		assert.Equal(t, expected, RPCClientURL(address), address)
+	}
+}
+
 func mustGetMultiaddr(t *testing.T, addr string) multiaddr.Multiaddr {
 	t.Helper()
 	maddr, err := multiaddr.NewMultiaddr(addr)
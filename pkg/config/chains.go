@@ -0,0 +1,153 @@
+package config
+
+import (
+	"errors"
+	"fmt"
+	"maps"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"sync"
+
+	"github.com/goccy/go-yaml"
+	"github.com/spf13/viper"
+)
+
+// ChainRegistryName is the filename of the user chain registry in the config directory.
+const ChainRegistryName = "chains.yaml"
+
+// ChainProfile describes a known network, so that a node can join it by name.
+// Fields left empty are not applied to the node configuration.
+type ChainProfile struct {
+	// Genesis is the JSON genesis document of the network, for profiles bundled in a binary.
+	Genesis string `yaml:"genesis"`
+	// GenesisFile is the path of the genesis document, relative to the registry file.
+	GenesisFile string `yaml:"genesis_file"`
+	// Bootnodes are the P2P addresses of the peers to connect to on startup.
+	Bootnodes []string `yaml:"bootnodes"`
+	// DA is the DA layer the network is posted to.
+	DA ChainProfileDA `yaml:"da"`
+}
+
+// ChainProfileDA holds the DA settings of a ChainProfile.
+type ChainProfileDA struct {
+	Address         string `yaml:"address"`
+	Namespace       string `yaml:"namespace"`
+	HeaderNamespace string `yaml:"header_namespace"`
+	DataNamespace   string `yaml:"data_namespace"`
+	StartHeight     uint64 `yaml:"start_height"`
+}
+
+// ChainRegistry maps network names to their profiles.
+type ChainRegistry map[string]ChainProfile
+
+var (
+	bundledChainsMu sync.RWMutex
+	bundledChains   = ChainRegistry{}
+)
+
+// RegisterChainProfile bundles the profile of a network in the binary. Apps call it at
+// initialization for the networks they support; profiles of the user registry with the same
+// name take precedence.
+func RegisterChainProfile(name string, profile ChainProfile) {
+	bundledChainsMu.Lock()
+	defer bundledChainsMu.Unlock()
+	bundledChains[name] = profile
+}
+
+// ChainRegistryPath returns the path of the user chain registry of a home directory.
+func ChainRegistryPath(home string) string {
+	return filepath.Join(home, AppConfigDir, ChainRegistryName)
+}
+
+// LoadChainRegistry reads a chain registry file. A missing file is an empty registry.
+// Relative genesis file paths are resolved against the directory of the registry.
+func LoadChainRegistry(path string) (ChainRegistry, error) {
+	data, err := os.ReadFile(filepath.Clean(path))
+	if errors.Is(err, os.ErrNotExist) {
+		return ChainRegistry{}, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read chain registry: %w", err)
+	}
+
+	registry := ChainRegistry{}
+	if err := yaml.Unmarshal(data, &registry); err != nil {
+		return nil, fmt.Errorf("invalid chain registry %s: %w", path, err)
+	}
+	for name, profile := range registry {
+		if profile.GenesisFile != "" && !filepath.IsAbs(profile.GenesisFile) {
+			profile.GenesisFile = filepath.Join(filepath.Dir(path), profile.GenesisFile)
+			registry[name] = profile
+		}
+	}
+	return registry, nil
+}
+
+// ResolveChainProfile returns the profile of the named network from the user registry of the
+// home directory or, if it does not define it, from the profiles bundled in the binary.
+func ResolveChainProfile(home, name string) (ChainProfile, error) {
+	registry, err := LoadChainRegistry(ChainRegistryPath(home))
+	if err != nil {
+		return ChainProfile{}, err
+	}
+	if profile, ok := registry[name]; ok {
+		return profile, nil
+	}
+
+	bundledChainsMu.RLock()
+	defer bundledChainsMu.RUnlock()
+	if profile, ok := bundledChains[name]; ok {
+		return profile, nil
+	}
+
+	known := append(slices.Collect(maps.Keys(bundledChains)), slices.Collect(maps.Keys(registry))...)
+	slices.Sort(known)
+	known = slices.Compact(known)
+	return ChainProfile{}, fmt.Errorf("unknown chain %q, known chains: [%s]", name, strings.Join(known, ", "))
+}
+
+// GenesisJSON returns the genesis document of the network, nil if the profile has none.
+func (p ChainProfile) GenesisJSON() ([]byte, error) {
+	if p.Genesis != "" {
+		return []byte(p.Genesis), nil
+	}
+	if p.GenesisFile == "" {
+		return nil, nil
+	}
+	data, err := os.ReadFile(filepath.Clean(p.GenesisFile))
+	if err != nil {
+		return nil, fmt.Errorf("failed to read genesis of chain profile: %w", err)
+	}
+	return data, nil
+}
+
+// applyChainProfile uses the profile of the network selected with the chain option as defaults
+// of v, so that the configuration file and flags still take precedence over it.
+func applyChainProfile(v *viper.Viper, home string) error {
+	name := v.GetString(FlagChain)
+	if name == "" {
+		return nil
+	}
+
+	profile, err := ResolveChainProfile(home, name)
+	if err != nil {
+		return err
+	}
+
+	setDefault := func(key, value string) {
+		if value != "" {
+			v.SetDefault(key, value)
+		}
+	}
+	setDefault("p2p.peers", strings.Join(profile.Bootnodes, ","))
+	setDefault("da.address", profile.DA.Address)
+	setDefault("da.namespace", profile.DA.Namespace)
+	setDefault("da.header_namespace", profile.DA.HeaderNamespace)
+	setDefault("da.data_namespace", profile.DA.DataNamespace)
+	if profile.DA.StartHeight != 0 {
+		v.SetDefault("da.start_height", profile.DA.StartHeight)
+	}
+	return nil
+}
@@ -0,0 +1,114 @@
+package config
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testChainRegistry = `
+my-testnet:
+  genesis_file: genesis/my-testnet.json
+  bootnodes:
+    - /ip4/10.0.0.1/tcp/7676/p2p/12D3KooWA
+    - /ip4/10.0.0.2/tcp/7676/p2p/12D3KooWB
+  da:
+    address: http://da.my-testnet:7980
+    header_namespace: my-testnet-headers
+    data_namespace: my-testnet-data
+    start_height: 1200
+`
+
+func writeChainRegistry(t *testing.T, home, content string) {
+	t.Helper()
+	path := ChainRegistryPath(home)
+	require.NoError(t, os.MkdirAll(filepath.Dir(path), 0o750))
+	require.NoError(t, os.WriteFile(path, []byte(content), 0o600))
+}
+
+func loadWithArgs(t *testing.T, args ...string) (Config, error) {
+	t.Helper()
+	cmd := &cobra.Command{Use: "test"}
+	AddFlags(cmd)
+	AddGlobalFlags(cmd, "test")
+	require.NoError(t, cmd.ParseFlags(args))
+	return Load(cmd)
+}
+
+func TestLoadChainProfile(t *testing.T) {
+	home := t.TempDir()
+	writeChainRegistry(t, home, testChainRegistry)
+	// the configuration file takes precedence over the profile
+	require.NoError(t, os.WriteFile(filepath.Join(home, AppConfigDir, ConfigName), []byte("da:\n  data_namespace: custom-data\n"), 0o600))
+
+	config, err := loadWithArgs(t, "--home", home, "--chain", "my-testnet", "--evnode.da.address", "http://flag-da:7980")
+	require.NoError(t, err)
+
+	assert.Equal(t, "my-testnet", config.Chain)
+	assert.Equal(t, "/ip4/10.0.0.1/tcp/7676/p2p/12D3KooWA,/ip4/10.0.0.2/tcp/7676/p2p/12D3KooWB", config.P2P.Peers)
+	assert.Equal(t, "my-testnet-headers", config.DA.HeaderNamespace)
+	assert.Equal(t, uint64(1200), config.DA.StartHeight)
+	assert.Equal(t, "custom-data", config.DA.DataNamespace, "the configuration file should take precedence")
+	assert.Equal(t, "http://flag-da:7980", config.DA.Address, "flags should take precedence")
+	// options not in the profile keep their defaults
+	assert.Equal(t, DefaultConfig.DA.BlockTime, config.DA.BlockTime)
+	assert.Equal(t, DefaultConfig.P2P.ListenAddress, config.P2P.ListenAddress)
+
+	// without a chain, the profiles are not applied
+	config, err = loadWithArgs(t, "--home", home)
+	require.NoError(t, err)
+	assert.Equal(t, DefaultConfig.P2P.Peers, config.P2P.Peers)
+	assert.Equal(t, DefaultConfig.DA.HeaderNamespace, config.DA.HeaderNamespace)
+
+	_, err = loadWithArgs(t, "--home", home, "--chain", "unknown-net")
+	require.ErrorContains(t, err, `unknown chain "unknown-net", known chains: [my-testnet]`)
+}
+
+func TestResolveChainProfile(t *testing.T) {
+	RegisterChainProfile("bundled-net", ChainProfile{
+		Genesis:   `{"chain_id":"bundled-net"}`,
+		Bootnodes: []string{"/ip4/10.0.0.3/tcp/7676/p2p/12D3KooWC"},
+	})
+	RegisterChainProfile("my-testnet", ChainProfile{DA: ChainProfileDA{Address: "http://bundled-da:7980"}})
+	t.Cleanup(func() {
+		delete(bundledChains, "bundled-net")
+		delete(bundledChains, "my-testnet")
+	})
+
+	home := t.TempDir()
+	writeChainRegistry(t, home, testChainRegistry)
+	genesisPath := filepath.Join(home, AppConfigDir, "genesis", "my-testnet.json")
+	require.NoError(t, os.MkdirAll(filepath.Dir(genesisPath), 0o750))
+	require.NoError(t, os.WriteFile(genesisPath, []byte(`{"chain_id":"my-testnet"}`), 0o600))
+
+	// the user registry takes precedence over the bundled profiles
+	profile, err := ResolveChainProfile(home, "my-testnet")
+	require.NoError(t, err)
+	assert.Equal(t, "http://da.my-testnet:7980", profile.DA.Address)
+	assert.Equal(t, genesisPath, profile.GenesisFile, "genesis files are relative to the registry")
+	genesis, err := profile.GenesisJSON()
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"chain_id":"my-testnet"}`, string(genesis))
+
+	profile, err = ResolveChainProfile(home, "bundled-net")
+	require.NoError(t, err)
+	genesis, err = profile.GenesisJSON()
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"chain_id":"bundled-net"}`, string(genesis))
+
+	// profiles may leave the genesis to the user
+	genesis, err = ChainProfile{}.GenesisJSON()
+	require.NoError(t, err)
+	assert.Nil(t, genesis)
+
+	_, err = ResolveChainProfile(t.TempDir(), "unknown-net")
+	require.ErrorContains(t, err, "known chains: [bundled-net, my-testnet]")
+
+	writeChainRegistry(t, home, "my-testnet: [")
+	_, err = ResolveChainProfile(home, "my-testnet")
+	require.ErrorContains(t, err, "invalid chain registry")
+}
@@ -24,8 +24,12 @@ const (
 
 	// FlagRootDir is a flag for specifying the root directory
 	FlagRootDir = "home"
+	// FlagChain is a flag for specifying the named network profile to join
+	FlagChain = "chain"
 	// FlagDBPath is a flag for specifying the database path
 	FlagDBPath = FlagPrefixEvnode + "db_path"
+	// FlagDBBackend is a flag for specifying the database backend
+	FlagDBBackend = FlagPrefixEvnode + "db_backend"
 
 	// Node configuration flags
 
@@ -41,8 +45,38 @@ const (
 	FlagLazyAggregator = FlagPrefixEvnode + "node.lazy_mode"
 	// FlagMaxPendingHeadersAndData is a flag to limit and pause block production when too many headers or data are waiting for DA confirmation
 	FlagMaxPendingHeadersAndData = FlagPrefixEvnode + "node.max_pending_headers_and_data"
+	// FlagMaxSyncCacheBytes is a flag to bound the memory used by each sync cache, spilling the overflow to disk
+	FlagMaxSyncCacheBytes = FlagPrefixEvnode + "node.max_sync_cache_bytes"
+	// FlagAlertDABacklog is a flag to set the number of headers or data pending DA submission above which an alert fires
+	FlagAlertDABacklog = FlagPrefixEvnode + "node.alert_da_backlog"
+	// FlagSkipPreflight is a flag to start the node without running the preflight checks
+	FlagSkipPreflight = FlagPrefixEvnode + "node.skip_preflight"
+	// FlagReapMaxBytes is a flag to bound the total size of the transactions pulled at once from the execution mempool
+	FlagReapMaxBytes = FlagPrefixEvnode + "node.reap_max_bytes"
+	// FlagReapMaxGas is a flag to bound the total gas of the transactions pulled at once from the execution mempool
+	FlagReapMaxGas = FlagPrefixEvnode + "node.reap_max_gas"
 	// FlagLazyBlockTime is a flag for specifying the maximum interval between blocks in lazy aggregation mode
 	FlagLazyBlockTime = FlagPrefixEvnode + "node.lazy_block_interval"
+	// FlagMaxDiskUsage is a flag to set the disk usage of the store above which the node stops writing blocks
+	FlagMaxDiskUsage = FlagPrefixEvnode + "node.max_disk_usage"
+	// FlagBlockTimeAutoscale is a flag for autoscaling the block time of an aggregator to its load
+	FlagBlockTimeAutoscale = FlagPrefixEvnode + "node.block_time_autoscale"
+	// FlagMinBlockTime is a flag for the block time an autoscaling aggregator shrinks its block time toward
+	FlagMinBlockTime = FlagPrefixEvnode + "node.min_block_time"
+	// FlagDryRun is a flag for running an aggregator producing blocks without publishing them
+	FlagDryRun = FlagPrefixEvnode + "node.dry_run"
+	// FlagPreviewBlocks is a flag for gossiping unsigned preview blocks ahead of their signed header
+	FlagPreviewBlocks = FlagPrefixEvnode + "node.preview_blocks"
+	// FlagShadowReplica is a flag for cross-checking the state roots of the sequencer to detect execution nondeterminism
+	FlagShadowReplica = FlagPrefixEvnode + "node.shadow_replica"
+	// FlagPruningStrategy is a flag for the strategy pruning the blocks of the store
+	FlagPruningStrategy = FlagPrefixEvnode + "node.pruning_strategy"
+	// FlagPruningKeepRecent is a flag for the number of recent blocks kept by the default pruning strategy
+	FlagPruningKeepRecent = FlagPrefixEvnode + "node.pruning_keep_recent"
+	// FlagCompactionInterval is a flag for the interval at which the store is compacted
+	FlagCompactionInterval = FlagPrefixEvnode + "node.compaction_interval"
+	// FlagOrderflowSources is a flag for the HTTP orderflow endpoints the sequencer pulls transaction bundles from
+	FlagOrderflowSources = FlagPrefixEvnode + "node.orderflow_sources"
 
 	// Data Availability configuration flags
 
@@ -70,17 +104,29 @@ const (
 	FlagDAMempoolTTL = FlagPrefixEvnode + "da.mempool_ttl"
 	// FlagDAMaxSubmitAttempts is a flag for specifying the maximum DA submit attempts
 	FlagDAMaxSubmitAttempts = FlagPrefixEvnode + "da.max_submit_attempts"
+	// FlagDACombinedBlobs is a flag for submitting the header and data of each height in a single DA blob
+	FlagDACombinedBlobs = FlagPrefixEvnode + "da.combined_blobs"
+	// FlagDADataSegmentSize is a flag for laying out the data blobs in segments of the given size
+	FlagDADataSegmentSize = FlagPrefixEvnode + "da.data_segment_size"
 
 	// P2P configuration flags
 
-	// FlagP2PListenAddress is a flag for specifying the P2P listen address
+	// FlagP2PListenAddress is a flag for specifying the P2P listen addresses
 	FlagP2PListenAddress = FlagPrefixEvnode + "p2p.listen_address"
+	// FlagP2PExternalAddresses is a flag for specifying the P2P addresses advertised to peers
+	FlagP2PExternalAddresses = FlagPrefixEvnode + "p2p.external_addresses"
 	// FlagP2PPeers is a flag for specifying the P2P peers
 	FlagP2PPeers = FlagPrefixEvnode + "p2p.peers"
 	// FlagP2PBlockedPeers is a flag for specifying the P2P blocked peers
 	FlagP2PBlockedPeers = FlagPrefixEvnode + "p2p.blocked_peers"
 	// FlagP2PAllowedPeers is a flag for specifying the P2P allowed peers
 	FlagP2PAllowedPeers = FlagPrefixEvnode + "p2p.allowed_peers"
+	// FlagP2PPriorityPeers is a flag for specifying the peers new headers and data are pushed to directly
+	FlagP2PPriorityPeers = FlagPrefixEvnode + "p2p.priority_peers"
+	// FlagP2PGossipFanout is a flag for specifying the number of peers new headers and data are gossiped to
+	FlagP2PGossipFanout = FlagPrefixEvnode + "p2p.gossip_fanout"
+	// FlagP2PRebroadcastRecent is a flag for specifying the number of recent headers and data pushed to newly connected peers
+	FlagP2PRebroadcastRecent = FlagPrefixEvnode + "p2p.rebroadcast_recent"
 
 	// Instrumentation configuration flags
 
@@ -121,13 +167,46 @@ const (
 	FlagRPCAddress = FlagPrefixEvnode + "rpc.address"
 	// FlagRPCEnableDAVisualization is a flag for enabling DA visualization endpoints
 	FlagRPCEnableDAVisualization = FlagPrefixEvnode + "rpc.enable_da_visualization"
+	// FlagRPCWebhookURL is a flag for specifying the endpoint notified of DA included blocks
+	FlagRPCWebhookURL = FlagPrefixEvnode + "rpc.webhook_url"
+	// FlagRPCEventWebhookURL is a flag for specifying the endpoint notified of the events of the node
+	FlagRPCEventWebhookURL = FlagPrefixEvnode + "rpc.event_webhook_url"
+	// FlagRPCWebhookSecret is a flag for specifying the secret used to sign webhook notifications
+	//nolint:gosec
+	FlagRPCWebhookSecret = FlagPrefixEvnode + "rpc.webhook_secret"
+	// FlagRPCReplica is a flag for serving RPC traffic as a read replica
+	FlagRPCReplica = FlagPrefixEvnode + "rpc.replica"
+	// FlagRPCReplicaCacheTTL is a flag for specifying how long a read replica caches RPC responses
+	FlagRPCReplicaCacheTTL = FlagPrefixEvnode + "rpc.replica_cache_ttl"
+	// FlagRPCReplicaMaxLagBlocks is a flag for specifying the lag above which a read replica rejects RPC requests
+	FlagRPCReplicaMaxLagBlocks = FlagPrefixEvnode + "rpc.replica_max_lag_blocks"
+	// FlagRPCDrainTimeout is a flag for specifying how long in-flight RPC requests may complete on shutdown
+	FlagRPCDrainTimeout = FlagPrefixEvnode + "rpc.drain_timeout"
+	// FlagRPCAuthToken is a flag for specifying the static bearer token required by protected RPCs
+	FlagRPCAuthToken = FlagPrefixEvnode + "rpc.auth_token"
+	// FlagRPCAuthJWTSecret is a flag for specifying the hex-encoded secret of the JWTs accepted by protected RPCs
+	FlagRPCAuthJWTSecret = FlagPrefixEvnode + "rpc.auth_jwt_secret"
+	// FlagRPCAuthRoleTokens is a flag for specifying the static bearer tokens scoped to a role
+	FlagRPCAuthRoleTokens = FlagPrefixEvnode + "rpc.auth_role_tokens" // #nosec G101
+	// FlagRPCAuthServices is a flag for specifying the services whose every RPC requires a bearer token
+	FlagRPCAuthServices = FlagPrefixEvnode + "rpc.auth_services"
+	// FlagRPCCORSAllowedOrigins is a flag for specifying the origins allowed to make cross-origin RPC requests
+	FlagRPCCORSAllowedOrigins = FlagPrefixEvnode + "rpc.cors_allowed_origins"
+	// FlagRPCCORSAllowedHeaders is a flag for specifying additional headers allowed in cross-origin RPC requests
+	FlagRPCCORSAllowedHeaders = FlagPrefixEvnode + "rpc.cors_allowed_headers"
+	// FlagRPCUnixSocket is a flag for specifying the unix socket the RPC server also listens on
+	FlagRPCUnixSocket = FlagPrefixEvnode + "rpc.unix_socket"
+	// FlagRPCMetadataNamespaces is a flag for specifying the namespaces of application metadata writable with the SetMetadata RPC
+	FlagRPCMetadataNamespaces = FlagPrefixEvnode + "rpc.metadata_namespaces"
 )
 
 // Config stores Rollkit configuration.
 type Config struct {
 	// Base configuration
-	RootDir string `mapstructure:"-" yaml:"-" comment:"Root directory where rollkit files are located"`
-	DBPath  string `mapstructure:"db_path" yaml:"db_path" comment:"Path inside the root directory where the database is located"`
+	RootDir   string `mapstructure:"-" yaml:"-" comment:"Root directory where rollkit files are located"`
+	DBPath    string `mapstructure:"db_path" yaml:"db_path" comment:"Path inside the root directory where the database is located"`
+	DBBackend string `mapstructure:"db_backend" yaml:"db_backend" comment:"Storage engine of the database: badger (BadgerDB v4) or pebble. Pebble paces its compactions, avoiding the write stalls of large stores. An existing database must be converted to another backend with the migrate-store command."`
+	Chain     string `mapstructure:"chain" yaml:"chain" comment:"Name of the network to join. The genesis, bootnodes, DA address and namespaces of the network are read from the chain registry (config/chains.yaml) or the profiles bundled in the binary, unless set explicitly. Empty to configure the network manually."`
 	// P2P configuration
 	P2P P2PConfig `mapstructure:"p2p" yaml:"p2p"`
 
@@ -164,6 +243,8 @@ type DAConfig struct {
 	StartHeight       uint64          `mapstructure:"start_height" yaml:"start_height" comment:"Starting block height on the DA layer from which to begin syncing. Useful when deploying a new chain on an existing DA chain."`
 	MempoolTTL        uint64          `mapstructure:"mempool_ttl" yaml:"mempool_ttl" comment:"Number of DA blocks after which a transaction is considered expired and dropped from the mempool. Controls retry backoff timing."`
 	MaxSubmitAttempts int             `mapstructure:"max_submit_attempts" yaml:"max_submit_attempts" comment:"Maximum number of attempts to submit data to the DA layer before giving up. Higher values provide more resilience but can delay error reporting."`
+	CombinedBlobs     bool            `mapstructure:"combined_blobs" yaml:"combined_blobs" comment:"Submit the header and data of each height in a single blob to the header namespace, halving the number of blobs and their fixed costs. Consumers of the header namespace then also download the data."`
+	DataSegmentSize   uint32          `mapstructure:"data_segment_size" yaml:"data_segment_size" comment:"Lay out the data blobs in independently decodable segments of this size in bytes, with an index, so that DA layers and light clients can sample or fetch a range of transactions without the whole blob. 0 submits the data as a single protobuf message."`
 }
 
 // GetHeaderNamespace returns the namespace for header submissions, falling back to the legacy namespace if not set
@@ -199,6 +280,21 @@ type NodeConfig struct {
 	MaxPendingHeadersAndData uint64          `mapstructure:"max_pending_headers_and_data" yaml:"max_pending_headers_and_data" comment:"Maximum number of headers or data pending DA submission. When this limit is reached, the aggregator pauses block production until some headers or data are confirmed. Use 0 for no limit."`
 	LazyMode                 bool            `mapstructure:"lazy_mode" yaml:"lazy_mode" comment:"Enables lazy aggregation mode, where blocks are only produced when transactions are available or after LazyBlockTime. Optimizes resources by avoiding empty block creation during periods of inactivity."`
 	LazyBlockInterval        DurationWrapper `mapstructure:"lazy_block_interval" yaml:"lazy_block_interval" comment:"Maximum interval between blocks in lazy aggregation mode (LazyAggregator). Ensures blocks are produced periodically even without transactions to keep the chain active. Generally larger than BlockTime."`
+	MaxSyncCacheBytes        uint64          `mapstructure:"max_sync_cache_bytes" yaml:"max_sync_cache_bytes" comment:"Maximum memory in bytes used by each of the header and data caches holding blocks waiting to be synced. Blocks beyond the limit are spilled to disk under the data directory. Use 0 for no limit."`
+	AlertDABacklog           uint64          `mapstructure:"alert_da_backlog" yaml:"alert_da_backlog" comment:"Number of headers or data pending DA submission above which the da_backlog alert fires. Alerts are evaluated by the node and exposed by the GetAlerts RPC. Use 0 to disable the alert."`
+	SkipPreflight            bool            `mapstructure:"skip_preflight" yaml:"skip_preflight" comment:"Start the node without checking the DA layer, execution client, listen ports, disk space and clock first."`
+	ReapMaxBytes             uint64          `mapstructure:"reap_max_bytes" yaml:"reap_max_bytes" comment:"Maximum total size in bytes of the transactions pulled at once from the execution layer mempool, for execution layers supporting limits. Use 0 for no limit."`
+	ReapMaxGas               uint64          `mapstructure:"reap_max_gas" yaml:"reap_max_gas" comment:"Maximum total gas of the transactions pulled at once from the execution layer mempool, for execution layers supporting limits. Use 0 for no limit."`
+	DryRun                   bool            `mapstructure:"dry_run" yaml:"dry_run" comment:"Run the aggregator in dry-run mode, to validate a configuration or DA layer against real traffic: blocks are produced, executed and signed with a throwaway key in an in-memory store, and the DA layer computes the commitments of their blobs, but nothing is gossiped or submitted to the DA layer. The size and estimated cost of the DA submissions are logged instead. Requires an aggregator, and an execution client which is not used by another node."`
+	BlockTimeAutoscale       bool            `mapstructure:"block_time_autoscale" yaml:"block_time_autoscale" comment:"Autoscale the block time of the aggregator to its load: the block time shrinks toward min_block_time while blocks keep carrying transactions, and relaxes back toward block_time while they are empty, balancing latency against DA cost. The block time stays within the block_time_bounds of the genesis, which are required, and is recorded for every block produced."`
+	MinBlockTime             DurationWrapper `mapstructure:"min_block_time" yaml:"min_block_time" comment:"Block time an aggregator autoscaling its block time shrinks it toward under sustained load (duration). Use 0 for the minimum of the block_time_bounds of the genesis."`
+	MaxDiskUsage             uint64          `mapstructure:"max_disk_usage" yaml:"max_disk_usage" comment:"Maximum disk usage in bytes of the store. Above 90% of it, the node prunes the store at once according to pruning_strategy and collects its garbage; when it is reached, the node stops producing and syncing blocks and reports itself as degraded until the usage drops below it. Use 0 for no limit."`
+	PreviewBlocks            bool            `mapstructure:"preview_blocks" yaml:"preview_blocks" comment:"Gossip unsigned preview blocks: the aggregator publishes each block as soon as it is executed, before signing it, and full nodes serve the previews they receive to the SubscribePreviewBlocks RPC, so that read replicas and UIs can show blocks at minimum latency. Previews are not verified, and are never stored or synced: blocks are only accepted once their signed header is received. Must be enabled on the aggregator and on the nodes serving previews."`
+	ShadowReplica            bool            `mapstructure:"shadow_replica" yaml:"shadow_replica" comment:"Run the node as a shadow replica detecting execution nondeterminism: the state root of every synced block is cross-checked against the one committed by the sequencer, and on a divergence the node stops syncing, raises the execution_divergence alert and records a report. With an execution client able to simulate transactions, which the EVM and gRPC execution clients are not, the report pinpoints the first transaction whose execution is not reproducible by re-executing the block. Requires a non-aggregator node."`
+	PruningStrategy          string          `mapstructure:"pruning_strategy" yaml:"pruning_strategy" comment:"Strategy pruning the blocks of the store, which otherwise grows unbounded: archive keeps all the blocks; default deletes the data of the blocks below the latest pruning_keep_recent blocks, keeping their headers so that the data can be restored from DA with restore-heights; everything deletes the blocks below the latest 2 blocks entirely. Only blocks included on DA are pruned."`
+	PruningKeepRecent        uint64          `mapstructure:"pruning_keep_recent" yaml:"pruning_keep_recent" comment:"Number of recent blocks whose data is kept by the default pruning strategy."`
+	CompactionInterval       DurationWrapper `mapstructure:"compaction_interval" yaml:"compaction_interval" comment:"Interval at which the store is compacted, reclaiming the disk space of the data deleted from it, e.g. by pruning, whose tombstones otherwise accumulate and slow down reads on long-running nodes (duration). The store can also be compacted on demand with the CompactStore admin RPC. Use 0 to disable scheduled compactions."`
+	OrderflowSources         string          `mapstructure:"orderflow_sources" yaml:"orderflow_sources" comment:"Comma-separated name=url entries of HTTP orderflow endpoints, e.g. private orderflow services or block builders, which the single sequencer of an aggregator pulls transaction bundles from for every batch, ahead of its queue. Empty to disable."`
 
 	// Header configuration
 	TrustedHash string `mapstructure:"trusted_hash" yaml:"trusted_hash" comment:"Initial trusted hash used to bootstrap the header exchange service. Allows nodes to start synchronizing from a specific trusted point in the chain instead of genesis. When provided, the node will fetch the corresponding header/block from peers using this hash and use it as a starting point for synchronization. If not provided, the node will attempt to fetch the genesis block instead."`
@@ -213,10 +309,14 @@ type LogConfig struct {
 
 // P2PConfig contains all peer-to-peer networking configuration parameters
 type P2PConfig struct {
-	ListenAddress string `mapstructure:"listen_address" yaml:"listen_address" comment:"Address to listen for incoming connections (host:port)"`
-	Peers         string `mapstructure:"peers" yaml:"peers" comment:"Comma separated list of peers to connect to"`
-	BlockedPeers  string `mapstructure:"blocked_peers" yaml:"blocked_peers" comment:"Comma separated list of peer IDs to block from connecting"`
-	AllowedPeers  string `mapstructure:"allowed_peers" yaml:"allowed_peers" comment:"Comma separated list of peer IDs to allow connections from"`
+	ListenAddress     string `mapstructure:"listen_address" yaml:"listen_address" comment:"Comma separated list of addresses to listen for incoming connections (host:port or multiaddr)"`
+	ExternalAddresses string `mapstructure:"external_addresses" yaml:"external_addresses" comment:"Comma separated list of addresses advertised to peers instead of the listen addresses (host:port or multiaddr). Use when the node is behind NAT or a load balancer."`
+	Peers             string `mapstructure:"peers" yaml:"peers" comment:"Comma separated list of peers to connect to"`
+	BlockedPeers      string `mapstructure:"blocked_peers" yaml:"blocked_peers" comment:"Comma separated list of peer IDs to block from connecting"`
+	AllowedPeers      string `mapstructure:"allowed_peers" yaml:"allowed_peers" comment:"Comma separated list of peer IDs to allow connections from"`
+	PriorityPeers     string `mapstructure:"priority_peers" yaml:"priority_peers" comment:"Comma separated list of peers (multiaddr with peer ID), e.g. the public RPC full nodes of the sequencer, to which new headers and data are always pushed directly instead of through the gossip mesh. The node stays connected to them."`
+	GossipFanout      int    `mapstructure:"gossip_fanout" yaml:"gossip_fanout" comment:"Number of peers new headers and data are gossiped to, i.e. the gossipsub mesh degree. Use 0 for the gossipsub default of 6."`
+	RebroadcastRecent int    `mapstructure:"rebroadcast_recent" yaml:"rebroadcast_recent" comment:"Number of the latest headers and data pushed to every newly connected peer, so that late joiners learn about the recent heights without waiting for the next block to be gossiped. Use 0 to disable."`
 }
 
 // SignerConfig contains all signer configuration parameters
@@ -227,8 +327,23 @@ type SignerConfig struct {
 
 // RPCConfig contains all RPC server configuration parameters
 type RPCConfig struct {
-	Address               string `mapstructure:"address" yaml:"address" comment:"Address to bind the RPC server to (host:port). Default: 127.0.0.1:7331"`
-	EnableDAVisualization bool   `mapstructure:"enable_da_visualization" yaml:"enable_da_visualization" comment:"Enable DA visualization endpoints for monitoring blob submissions. Default: false"`
+	Address               string          `mapstructure:"address" yaml:"address" comment:"Address to bind the RPC server to (host:port). Default: 127.0.0.1:7331"`
+	EnableDAVisualization bool            `mapstructure:"enable_da_visualization" yaml:"enable_da_visualization" comment:"Enable DA visualization endpoints for monitoring blob submissions. Default: false"`
+	WebhookURL            string          `mapstructure:"webhook_url" yaml:"webhook_url" comment:"Endpoint notified with an HTTP POST of the transactions and DA heights of every block once it is included on DA. Empty to disable."`
+	EventWebhookURL       string          `mapstructure:"event_webhook_url" yaml:"event_webhook_url" comment:"Endpoint notified with an HTTP POST of every event recorded in the event journal of the node. Empty to disable."`
+	WebhookSecret         string          `mapstructure:"webhook_secret" yaml:"webhook_secret" comment:"Secret used to sign webhook notifications with HMAC-SHA256 in the X-Evnode-Signature header. Empty to send unsigned notifications."`
+	Replica               bool            `mapstructure:"replica" yaml:"replica" comment:"Serve RPC traffic as a read replica: responses are cached and report the replica lag in the X-Rollkit-Lag-Blocks header. Requires a non-aggregator node."`
+	ReplicaCacheTTL       DurationWrapper `mapstructure:"replica_cache_ttl" yaml:"replica_cache_ttl" comment:"Time a read replica serves RPC responses from its cache. Use 0 to disable caching."`
+	ReplicaMaxLagBlocks   uint64          `mapstructure:"replica_max_lag_blocks" yaml:"replica_max_lag_blocks" comment:"Number of blocks a read replica may be behind the network head before it rejects RPC requests with 503 Service Unavailable. Use 0 for no limit."`
+	DrainTimeout          DurationWrapper `mapstructure:"drain_timeout" yaml:"drain_timeout" comment:"Grace period for in-flight RPC requests to complete on shutdown. New requests are rejected with 503 Service Unavailable and a Retry-After header meanwhile. Use 0 to disable draining."`
+	AuthToken             string          `mapstructure:"auth_token" yaml:"auth_token" comment:"Static bearer token required in the Authorization header of mutating and administrative RPCs. Empty to disable token authentication."`
+	AuthJWTSecret         string          `mapstructure:"auth_jwt_secret" yaml:"auth_jwt_secret" comment:"Hex-encoded secret of the HS256 JWTs accepted as bearer tokens, as for the engine API of execution clients. Tokens must be issued within a minute of the request. Empty to disable JWT authentication."`
+	AuthRoleTokens        string          `mapstructure:"auth_role_tokens" yaml:"auth_role_tokens" comment:"Comma-separated identity:role:token entries of static bearer tokens scoped to the read-only, operator or admin role. The identity names the holder in the audit log of administrative RPCs."`
+	AuthServices          string          `mapstructure:"auth_services" yaml:"auth_services" comment:"Comma-separated services, e.g. evnode.v1.P2PService, whose read-only RPCs also require a bearer token. Read-only RPCs of other services stay open."`
+	CORSAllowedOrigins    string          `mapstructure:"cors_allowed_origins" yaml:"cors_allowed_origins" comment:"Comma-separated origins, e.g. https://explorer.example.com, allowed to call the RPC server from a browser. Use * to allow any origin. Empty to disable CORS."`
+	CORSAllowedHeaders    string          `mapstructure:"cors_allowed_headers" yaml:"cors_allowed_headers" comment:"Comma-separated request headers allowed in cross-origin requests, in addition to the Connect, gRPC-Web and Authorization headers."`
+	UnixSocket            string          `mapstructure:"unix_socket" yaml:"unix_socket" comment:"Path of a unix socket the RPC server listens on in addition to its TCP address, for co-located sidecars. Relative paths are resolved against the home directory. Empty to disable."`
+	MetadataNamespaces    string          `mapstructure:"metadata_namespaces" yaml:"metadata_namespaces" comment:"Comma-separated namespaces of application metadata, e.g. the name of an out-of-process execution layer, that the SetMetadata admin RPC may write to."`
 }
 
 // Validate ensures that the root directory exists.
@@ -276,6 +391,8 @@ func AddFlags(cmd *cobra.Command) {
 
 	// Add base flags
 	cmd.Flags().String(FlagDBPath, def.DBPath, "path for the node database")
+	cmd.Flags().String(FlagDBBackend, def.DBBackend, "storage engine of the node database (badger, pebble)")
+	cmd.Flags().String(FlagChain, def.Chain, "name of the network to join, using its genesis, bootnodes, DA address and namespaces from the chain registry")
 
 	// Node configuration flags
 	cmd.Flags().Bool(FlagAggregator, def.Node.Aggregator, "run node in aggregator mode")
@@ -285,6 +402,21 @@ func AddFlags(cmd *cobra.Command) {
 	cmd.Flags().Bool(FlagLazyAggregator, def.Node.LazyMode, "produce blocks only when transactions are available or after lazy block time")
 	cmd.Flags().Uint64(FlagMaxPendingHeadersAndData, def.Node.MaxPendingHeadersAndData, "maximum headers or data pending DA confirmation before pausing block production (0 for no limit)")
 	cmd.Flags().Duration(FlagLazyBlockTime, def.Node.LazyBlockInterval.Duration, "maximum interval between blocks in lazy aggregation mode")
+	cmd.Flags().Uint64(FlagMaxSyncCacheBytes, def.Node.MaxSyncCacheBytes, "maximum memory in bytes used by each sync cache before spilling blocks to disk (0 for no limit)")
+	cmd.Flags().Uint64(FlagAlertDABacklog, def.Node.AlertDABacklog, "number of headers or data pending DA submission above which an alert fires (0 to disable)")
+	cmd.Flags().Bool(FlagSkipPreflight, def.Node.SkipPreflight, "start the node without running the preflight checks")
+	cmd.Flags().Uint64(FlagReapMaxBytes, def.Node.ReapMaxBytes, "maximum total size of the transactions pulled at once from the execution mempool (0 for no limit)")
+	cmd.Flags().Uint64(FlagReapMaxGas, def.Node.ReapMaxGas, "maximum total gas of the transactions pulled at once from the execution mempool (0 for no limit)")
+	cmd.Flags().Uint64(FlagMaxDiskUsage, def.Node.MaxDiskUsage, "maximum disk usage in bytes of the store before the node stops writing blocks (0 for no limit)")
+	cmd.Flags().Bool(FlagBlockTimeAutoscale, def.Node.BlockTimeAutoscale, "autoscale the block time to the load, within the block time bounds of the genesis (aggregator only)")
+	cmd.Flags().Duration(FlagMinBlockTime, def.Node.MinBlockTime.Duration, "block time an autoscaling aggregator shrinks its block time toward under load (0 for the genesis minimum)")
+	cmd.Flags().Bool(FlagDryRun, def.Node.DryRun, "produce blocks without publishing them, signed with a throwaway key, to validate the configuration (aggregator only)")
+	cmd.Flags().Bool(FlagPreviewBlocks, def.Node.PreviewBlocks, "gossip unsigned preview blocks ahead of their signed header, for low latency reads")
+	cmd.Flags().String(FlagPruningStrategy, def.Node.PruningStrategy, "strategy pruning the blocks of the store (archive, default, everything)")
+	cmd.Flags().Uint64(FlagPruningKeepRecent, def.Node.PruningKeepRecent, "number of recent blocks whose data is kept by the default pruning strategy")
+	cmd.Flags().Duration(FlagCompactionInterval, def.Node.CompactionInterval.Duration, "interval at which the store is compacted (0 to disable)")
+	cmd.Flags().String(FlagOrderflowSources, def.Node.OrderflowSources, "comma-separated name=url HTTP orderflow endpoints the sequencer pulls transaction bundles from (aggregator only)")
+	cmd.Flags().Bool(FlagShadowReplica, def.Node.ShadowReplica, "cross-check the state roots of the sequencer and report execution nondeterminism (non-aggregator only)")
 
 	// Data Availability configuration flags
 	cmd.Flags().String(FlagDAAddress, def.DA.Address, "DA address (host:port)")
@@ -299,16 +431,37 @@ func AddFlags(cmd *cobra.Command) {
 	cmd.Flags().String(FlagDASubmitOptions, def.DA.SubmitOptions, "DA submit options")
 	cmd.Flags().Uint64(FlagDAMempoolTTL, def.DA.MempoolTTL, "number of DA blocks until transaction is dropped from the mempool")
 	cmd.Flags().Int(FlagDAMaxSubmitAttempts, def.DA.MaxSubmitAttempts, "maximum number of attempts to submit data to the DA layer before giving up")
+	cmd.Flags().Bool(FlagDACombinedBlobs, def.DA.CombinedBlobs, "submit the header and data of each height in a single DA blob to the header namespace")
+	cmd.Flags().Uint32(FlagDADataSegmentSize, def.DA.DataSegmentSize, "lay out the data blobs in segments of this size in bytes (0 to disable)")
 
 	// P2P configuration flags
-	cmd.Flags().String(FlagP2PListenAddress, def.P2P.ListenAddress, "P2P listen address (host:port)")
+	cmd.Flags().String(FlagP2PListenAddress, def.P2P.ListenAddress, "Comma separated list of P2P listen addresses (host:port)")
+	cmd.Flags().String(FlagP2PExternalAddresses, def.P2P.ExternalAddresses, "Comma separated list of P2P addresses advertised to peers (host:port)")
 	cmd.Flags().String(FlagP2PPeers, def.P2P.Peers, "Comma separated list of seed nodes to connect to")
 	cmd.Flags().String(FlagP2PBlockedPeers, def.P2P.BlockedPeers, "Comma separated list of nodes to ignore")
 	cmd.Flags().String(FlagP2PAllowedPeers, def.P2P.AllowedPeers, "Comma separated list of nodes to whitelist")
+	cmd.Flags().String(FlagP2PPriorityPeers, def.P2P.PriorityPeers, "Comma separated list of peers to push new headers and data to directly")
+	cmd.Flags().Int(FlagP2PGossipFanout, def.P2P.GossipFanout, "number of peers new headers and data are gossiped to (0 for the gossipsub default)")
+	cmd.Flags().Int(FlagP2PRebroadcastRecent, def.P2P.RebroadcastRecent, "number of the latest headers and data pushed to newly connected peers (0 to disable)")
 
 	// RPC configuration flags
 	cmd.Flags().String(FlagRPCAddress, def.RPC.Address, "RPC server address (host:port)")
 	cmd.Flags().Bool(FlagRPCEnableDAVisualization, def.RPC.EnableDAVisualization, "enable DA visualization endpoints for monitoring blob submissions")
+	cmd.Flags().String(FlagRPCWebhookURL, def.RPC.WebhookURL, "endpoint notified of the transactions and DA heights of every DA included block")
+	cmd.Flags().String(FlagRPCEventWebhookURL, def.RPC.EventWebhookURL, "endpoint notified of every event recorded in the event journal of the node")
+	cmd.Flags().String(FlagRPCWebhookSecret, def.RPC.WebhookSecret, "secret used to sign webhook notifications (HMAC-SHA256)")
+	cmd.Flags().Bool(FlagRPCReplica, def.RPC.Replica, "serve RPC traffic as a read replica with response caching and lag reporting")
+	cmd.Flags().Duration(FlagRPCReplicaCacheTTL, def.RPC.ReplicaCacheTTL.Duration, "time a read replica caches RPC responses (0 to disable)")
+	cmd.Flags().Uint64(FlagRPCReplicaMaxLagBlocks, def.RPC.ReplicaMaxLagBlocks, "blocks a read replica may lag behind before rejecting RPC requests (0 for no limit)")
+	cmd.Flags().Duration(FlagRPCDrainTimeout, def.RPC.DrainTimeout.Duration, "grace period for in-flight RPC requests to complete on shutdown (0 to disable draining)")
+	cmd.Flags().String(FlagRPCAuthToken, def.RPC.AuthToken, "static bearer token required by mutating and administrative RPCs")
+	cmd.Flags().String(FlagRPCAuthJWTSecret, def.RPC.AuthJWTSecret, "hex-encoded secret of the HS256 JWTs accepted as bearer tokens by mutating and administrative RPCs")
+	cmd.Flags().String(FlagRPCAuthRoleTokens, def.RPC.AuthRoleTokens, "comma-separated identity:role:token bearer tokens scoped to the read-only, operator or admin role")
+	cmd.Flags().String(FlagRPCAuthServices, def.RPC.AuthServices, "comma-separated services whose read-only RPCs also require a bearer token")
+	cmd.Flags().String(FlagRPCCORSAllowedOrigins, def.RPC.CORSAllowedOrigins, "comma-separated origins allowed to make cross-origin RPC requests (* for any, empty to disable CORS)")
+	cmd.Flags().String(FlagRPCCORSAllowedHeaders, def.RPC.CORSAllowedHeaders, "comma-separated additional request headers allowed in cross-origin RPC requests")
+	cmd.Flags().String(FlagRPCUnixSocket, def.RPC.UnixSocket, "path of a unix socket the RPC server also listens on (empty to disable)")
+	cmd.Flags().String(FlagRPCMetadataNamespaces, def.RPC.MetadataNamespaces, "comma-separated namespaces of application metadata writable with the SetMetadata RPC")
 
 	// Instrumentation configuration flags
 	instrDef := DefaultInstrumentationConfig()
@@ -326,8 +479,9 @@ func AddFlags(cmd *cobra.Command) {
 
 // Load loads the node configuration in the following order of precedence:
 // 1. DefaultNodeConfig() (lowest priority)
-// 2. YAML configuration file
-// 3. Command line flags (highest priority)
+// 2. Profile of the network selected with the chain option
+// 3. YAML configuration file
+// 4. Command line flags (highest priority)
 func Load(cmd *cobra.Command) (Config, error) {
 	home, _ := cmd.Flags().GetString(FlagRootDir)
 	if home == "" {
@@ -366,6 +520,10 @@ func Load(cmd *cobra.Command) (Config, error) {
 	// it will use the defaults
 	_ = v.ReadInConfig()
 
+	if err := applyChainProfile(v, home); err != nil {
+		return Config{}, err
+	}
+
 	return loadFromViper(v, home)
 }
 
@@ -51,6 +51,8 @@ func TestAddFlags(t *testing.T) {
 
 	// Test specific flags
 	assertFlagValue(t, flags, FlagDBPath, DefaultConfig.DBPath)
+	assertFlagValue(t, flags, FlagDBBackend, DefaultConfig.DBBackend)
+	assertFlagValue(t, flags, FlagChain, DefaultConfig.Chain)
 
 	// Node flags
 	assertFlagValue(t, flags, FlagAggregator, DefaultConfig.Node.Aggregator)
@@ -60,6 +62,21 @@ func TestAddFlags(t *testing.T) {
 	assertFlagValue(t, flags, FlagLazyAggregator, DefaultConfig.Node.LazyMode)
 	assertFlagValue(t, flags, FlagMaxPendingHeadersAndData, DefaultConfig.Node.MaxPendingHeadersAndData)
 	assertFlagValue(t, flags, FlagLazyBlockTime, DefaultConfig.Node.LazyBlockInterval.Duration)
+	assertFlagValue(t, flags, FlagMaxSyncCacheBytes, DefaultConfig.Node.MaxSyncCacheBytes)
+	assertFlagValue(t, flags, FlagAlertDABacklog, DefaultConfig.Node.AlertDABacklog)
+	assertFlagValue(t, flags, FlagSkipPreflight, DefaultConfig.Node.SkipPreflight)
+	assertFlagValue(t, flags, FlagReapMaxBytes, DefaultConfig.Node.ReapMaxBytes)
+	assertFlagValue(t, flags, FlagReapMaxGas, DefaultConfig.Node.ReapMaxGas)
+	assertFlagValue(t, flags, FlagMaxDiskUsage, DefaultConfig.Node.MaxDiskUsage)
+	assertFlagValue(t, flags, FlagDryRun, DefaultConfig.Node.DryRun)
+	assertFlagValue(t, flags, FlagBlockTimeAutoscale, DefaultConfig.Node.BlockTimeAutoscale)
+	assertFlagValue(t, flags, FlagMinBlockTime, DefaultConfig.Node.MinBlockTime.Duration)
+	assertFlagValue(t, flags, FlagPreviewBlocks, DefaultConfig.Node.PreviewBlocks)
+	assertFlagValue(t, flags, FlagShadowReplica, DefaultConfig.Node.ShadowReplica)
+	assertFlagValue(t, flags, FlagPruningStrategy, DefaultConfig.Node.PruningStrategy)
+	assertFlagValue(t, flags, FlagPruningKeepRecent, DefaultConfig.Node.PruningKeepRecent)
+	assertFlagValue(t, flags, FlagCompactionInterval, DefaultConfig.Node.CompactionInterval.Duration)
+	assertFlagValue(t, flags, FlagOrderflowSources, DefaultConfig.Node.OrderflowSources)
 
 	// DA flags
 	assertFlagValue(t, flags, FlagDAAddress, DefaultConfig.DA.Address)
@@ -72,12 +89,18 @@ func TestAddFlags(t *testing.T) {
 	assertFlagValue(t, flags, FlagDASubmitOptions, DefaultConfig.DA.SubmitOptions)
 	assertFlagValue(t, flags, FlagDAMempoolTTL, DefaultConfig.DA.MempoolTTL)
 	assertFlagValue(t, flags, FlagDAMaxSubmitAttempts, DefaultConfig.DA.MaxSubmitAttempts)
+	assertFlagValue(t, flags, FlagDACombinedBlobs, DefaultConfig.DA.CombinedBlobs)
+	assertFlagValue(t, flags, FlagDADataSegmentSize, DefaultConfig.DA.DataSegmentSize)
 
 	// P2P flags
 	assertFlagValue(t, flags, FlagP2PListenAddress, DefaultConfig.P2P.ListenAddress)
+	assertFlagValue(t, flags, FlagP2PExternalAddresses, DefaultConfig.P2P.ExternalAddresses)
 	assertFlagValue(t, flags, FlagP2PPeers, DefaultConfig.P2P.Peers)
 	assertFlagValue(t, flags, FlagP2PBlockedPeers, DefaultConfig.P2P.BlockedPeers)
 	assertFlagValue(t, flags, FlagP2PAllowedPeers, DefaultConfig.P2P.AllowedPeers)
+	assertFlagValue(t, flags, FlagP2PPriorityPeers, DefaultConfig.P2P.PriorityPeers)
+	assertFlagValue(t, flags, FlagP2PGossipFanout, DefaultConfig.P2P.GossipFanout)
+	assertFlagValue(t, flags, FlagP2PRebroadcastRecent, DefaultConfig.P2P.RebroadcastRecent)
 
 	// Instrumentation flags
 	instrDef := DefaultInstrumentationConfig()
@@ -99,9 +122,24 @@ func TestAddFlags(t *testing.T) {
 
 	// RPC flags
 	assertFlagValue(t, flags, FlagRPCAddress, DefaultConfig.RPC.Address)
+	assertFlagValue(t, flags, FlagRPCWebhookURL, DefaultConfig.RPC.WebhookURL)
+	assertFlagValue(t, flags, FlagRPCEventWebhookURL, DefaultConfig.RPC.EventWebhookURL)
+	assertFlagValue(t, flags, FlagRPCWebhookSecret, DefaultConfig.RPC.WebhookSecret)
+	assertFlagValue(t, flags, FlagRPCReplica, DefaultConfig.RPC.Replica)
+	assertFlagValue(t, flags, FlagRPCReplicaCacheTTL, DefaultConfig.RPC.ReplicaCacheTTL.Duration)
+	assertFlagValue(t, flags, FlagRPCReplicaMaxLagBlocks, DefaultConfig.RPC.ReplicaMaxLagBlocks)
+	assertFlagValue(t, flags, FlagRPCDrainTimeout, DefaultConfig.RPC.DrainTimeout.Duration)
+	assertFlagValue(t, flags, FlagRPCAuthToken, DefaultConfig.RPC.AuthToken)
+	assertFlagValue(t, flags, FlagRPCAuthJWTSecret, DefaultConfig.RPC.AuthJWTSecret)
+	assertFlagValue(t, flags, FlagRPCAuthRoleTokens, DefaultConfig.RPC.AuthRoleTokens)
+	assertFlagValue(t, flags, FlagRPCAuthServices, DefaultConfig.RPC.AuthServices)
+	assertFlagValue(t, flags, FlagRPCCORSAllowedOrigins, DefaultConfig.RPC.CORSAllowedOrigins)
+	assertFlagValue(t, flags, FlagRPCCORSAllowedHeaders, DefaultConfig.RPC.CORSAllowedHeaders)
+	assertFlagValue(t, flags, FlagRPCUnixSocket, DefaultConfig.RPC.UnixSocket)
+	assertFlagValue(t, flags, FlagRPCMetadataNamespaces, DefaultConfig.RPC.MetadataNamespaces)
 
 	// Count the number of flags we're explicitly checking
-	expectedFlagCount := 38 // Update this number if you add more flag checks above
+	expectedFlagCount := 76 // Update this number if you add more flag checks above
 
 	// Get the actual number of flags (both regular and persistent)
 	actualFlagCount := 0
@@ -37,11 +37,13 @@ func DefaultRootDirWithName(appName string) string {
 
 // DefaultConfig keeps default values of NodeConfig
 var DefaultConfig = Config{
-	RootDir: DefaultRootDir,
-	DBPath:  "data",
+	RootDir:   DefaultRootDir,
+	DBPath:    "data",
+	DBBackend: "badger",
 	P2P: P2PConfig{
-		ListenAddress: "/ip4/0.0.0.0/tcp/7676",
-		Peers:         "",
+		ListenAddress:     "/ip4/0.0.0.0/tcp/7676",
+		Peers:             "",
+		RebroadcastRecent: 5,
 	},
 	Node: NodeConfig{
 		Aggregator:        false,
@@ -50,6 +52,9 @@ var DefaultConfig = Config{
 		LazyBlockInterval: DurationWrapper{60 * time.Second},
 		Light:             false,
 		TrustedHash:       "",
+		AlertDABacklog:    100,
+		PruningStrategy:   "archive",
+		PruningKeepRecent: 362880,
 	},
 	DA: DAConfig{
 		Address:           "http://localhost:7980",
@@ -72,6 +77,8 @@ var DefaultConfig = Config{
 		SignerPath: "config",
 	},
 	RPC: RPCConfig{
-		Address: "127.0.0.1:7331",
+		Address:         "127.0.0.1:7331",
+		ReplicaCacheTTL: DurationWrapper{time.Second},
+		DrainTimeout:    DurationWrapper{5 * time.Second},
 	},
 }
@@ -0,0 +1,258 @@
+package config
+
+import (
+	"encoding/json"
+	"fmt"
+	"maps"
+	"reflect"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/goccy/go-yaml"
+)
+
+// JSONSchemaDraft is the JSON Schema dialect emitted by JSONSchema.
+const JSONSchemaDraft = "https://json-schema.org/draft/2020-12/schema"
+
+// durationPattern matches the strings accepted by time.ParseDuration.
+const durationPattern = `^[-+]?(0|([0-9]*(\.[0-9]*)?(ns|us|µs|ms|s|m|h))+)$`
+
+var durationWrapperType = reflect.TypeOf(DurationWrapper{})
+
+// JSONSchema returns a JSON Schema describing every option of the YAML configuration
+// file understood by this version, including descriptions and default values.
+func JSONSchema() ([]byte, error) {
+	schema := schemaFor(reflect.TypeOf(Config{}), reflect.ValueOf(DefaultConfig))
+	schema["$schema"] = JSONSchemaDraft
+	schema["title"] = "ev-node configuration"
+
+	return json.MarshalIndent(schema, "", "  ")
+}
+
+// schemaFor builds the schema of a single configuration type. def holds the default
+// value for the type and may be invalid when no default exists.
+func schemaFor(t reflect.Type, def reflect.Value) map[string]any {
+	if t.Kind() == reflect.Pointer {
+		t = t.Elem()
+		if def.IsValid() {
+			def = def.Elem()
+		}
+	}
+
+	schema := map[string]any{}
+	if t == durationWrapperType {
+		schema["type"] = "string"
+		schema["pattern"] = durationPattern
+		if def.IsValid() {
+			schema["default"] = def.Interface().(DurationWrapper).String()
+		}
+		return schema
+	}
+
+	switch t.Kind() {
+	case reflect.Struct:
+		properties := map[string]any{}
+		for _, field := range yamlFields(t) {
+			var fieldDef reflect.Value
+			if def.IsValid() {
+				fieldDef = def.FieldByIndex(field.Index)
+			}
+			prop := schemaFor(field.Type, fieldDef)
+			if comment := field.Tag.Get("comment"); comment != "" {
+				prop["description"] = comment
+			}
+			properties[yamlName(field)] = prop
+		}
+		schema["type"] = "object"
+		schema["properties"] = properties
+		schema["additionalProperties"] = false
+		return schema
+	case reflect.Slice, reflect.Array:
+		schema["type"] = "array"
+		schema["items"] = schemaFor(t.Elem(), reflect.Value{})
+	case reflect.Map:
+		schema["type"] = "object"
+		schema["additionalProperties"] = schemaFor(t.Elem(), reflect.Value{})
+	case reflect.Bool:
+		schema["type"] = "boolean"
+	case reflect.String:
+		schema["type"] = "string"
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		schema["type"] = "integer"
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		schema["type"] = "integer"
+		schema["minimum"] = 0
+	case reflect.Float32, reflect.Float64:
+		schema["type"] = "number"
+	}
+
+	if def.IsValid() && !((def.Kind() == reflect.Slice || def.Kind() == reflect.Map) && def.IsNil()) {
+		schema["default"] = def.Interface()
+	}
+	return schema
+}
+
+// ValidateYAML checks a YAML configuration document against the options known to
+// this version and returns every problem found. An empty result means the
+// document would be accepted by the node.
+func ValidateYAML(data []byte) []error {
+	var doc map[string]any
+	if err := yaml.Unmarshal(data, &doc); err != nil {
+		return []error{fmt.Errorf("invalid YAML: %w", err)}
+	}
+
+	errs := validateValue(reflect.TypeOf(Config{}), "", doc)
+	if len(errs) > 0 {
+		return errs
+	}
+
+	// the document is structurally valid, check the values themselves
+	cfg := DefaultConfig
+	cfg.Instrumentation = DefaultInstrumentationConfig()
+	if err := yaml.Unmarshal(data, &cfg); err != nil {
+		return []error{fmt.Errorf("failed decoding config: %w", err)}
+	}
+	if cfg.Instrumentation != nil {
+		if err := cfg.Instrumentation.ValidateBasic(); err != nil {
+			errs = append(errs, fmt.Errorf("instrumentation: %w", err))
+		}
+	}
+
+	return errs
+}
+
+// validateValue checks that v can be decoded into a value of type t.
+func validateValue(t reflect.Type, path string, v any) []error {
+	if v == nil {
+		return nil
+	}
+	if t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+
+	if t == durationWrapperType {
+		s, ok := v.(string)
+		if !ok {
+			return []error{fmt.Errorf("%s: expected duration string, got %T", path, v)}
+		}
+		if _, err := time.ParseDuration(s); err != nil {
+			return []error{fmt.Errorf("%s: %w", path, err)}
+		}
+		return nil
+	}
+
+	rv := reflect.ValueOf(v)
+	switch t.Kind() {
+	case reflect.Struct:
+		m, ok := v.(map[string]any)
+		if !ok {
+			return []error{fmt.Errorf("%s: expected object, got %T", path, v)}
+		}
+
+		fields := make(map[string]reflect.StructField)
+		for _, field := range yamlFields(t) {
+			fields[yamlName(field)] = field
+		}
+
+		keys := make([]string, 0, len(m))
+		for k := range m {
+			keys = append(keys, k)
+		}
+		slices.Sort(keys)
+
+		var errs []error
+		for _, k := range keys {
+			fieldPath := k
+			if path != "" {
+				fieldPath = path + "." + k
+			}
+			field, ok := fields[k]
+			if !ok {
+				errs = append(errs, fmt.Errorf("%s: unknown configuration option", fieldPath))
+				continue
+			}
+			errs = append(errs, validateValue(field.Type, fieldPath, m[k])...)
+		}
+		return errs
+	case reflect.Slice, reflect.Array:
+		items, ok := v.([]any)
+		if !ok {
+			return []error{fmt.Errorf("%s: expected array, got %T", path, v)}
+		}
+		var errs []error
+		for i, item := range items {
+			errs = append(errs, validateValue(t.Elem(), fmt.Sprintf("%s[%d]", path, i), item)...)
+		}
+		return errs
+	case reflect.Map:
+		m, ok := v.(map[string]any)
+		if !ok {
+			return []error{fmt.Errorf("%s: expected object, got %T", path, v)}
+		}
+		var errs []error
+		for _, k := range slices.Sorted(maps.Keys(m)) {
+			errs = append(errs, validateValue(t.Elem(), path+"."+k, m[k])...)
+		}
+		return errs
+	case reflect.Bool:
+		if rv.Kind() != reflect.Bool {
+			return []error{fmt.Errorf("%s: expected boolean, got %T", path, v)}
+		}
+	case reflect.String:
+		if rv.Kind() != reflect.String {
+			return []error{fmt.Errorf("%s: expected string, got %T", path, v)}
+		}
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		if !rv.CanInt() && !rv.CanUint() {
+			return []error{fmt.Errorf("%s: expected integer, got %T", path, v)}
+		}
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		if !rv.CanInt() && !rv.CanUint() {
+			return []error{fmt.Errorf("%s: expected integer, got %T", path, v)}
+		}
+		if rv.CanInt() && rv.Int() < 0 {
+			return []error{fmt.Errorf("%s: must not be negative", path)}
+		}
+	case reflect.Float32, reflect.Float64:
+		if !rv.CanInt() && !rv.CanUint() && !rv.CanFloat() {
+			return []error{fmt.Errorf("%s: expected number, got %T", path, v)}
+		}
+	}
+
+	return nil
+}
+
+// yamlFields returns the exported fields of t that are present in the YAML file,
+// flattening embedded structs.
+func yamlFields(t reflect.Type) []reflect.StructField {
+	var fields []reflect.StructField
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		if !field.IsExported() {
+			continue
+		}
+
+		yamlTag := field.Tag.Get("yaml")
+		if yamlTag == "" || yamlTag == "-" {
+			continue
+		}
+
+		if field.Type.Kind() == reflect.Struct && field.Anonymous {
+			for _, embedded := range yamlFields(field.Type) {
+				embedded.Index = append([]int{i}, embedded.Index...)
+				fields = append(fields, embedded)
+			}
+			continue
+		}
+
+		fields = append(fields, field)
+	}
+	return fields
+}
+
+// yamlName returns the YAML key of a struct field.
+func yamlName(field reflect.StructField) string {
+	name, _, _ := strings.Cut(field.Tag.Get("yaml"), ",")
+	return name
+}
@@ -0,0 +1,153 @@
+package config
+
+import (
+	"encoding/json"
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestJSONSchema(t *testing.T) {
+	data, err := JSONSchema()
+	require.NoError(t, err)
+
+	var schema map[string]any
+	require.NoError(t, json.Unmarshal(data, &schema))
+	require.Equal(t, JSONSchemaDraft, schema["$schema"])
+	require.Equal(t, "object", schema["type"])
+
+	properties := schema["properties"].(map[string]any)
+	require.NotContains(t, properties, "root_dir", "fields excluded from YAML must not be in the schema")
+
+	da := properties["da"].(map[string]any)
+	daProps := da["properties"].(map[string]any)
+
+	gasPrice := daProps["gas_price"].(map[string]any)
+	require.Equal(t, "number", gasPrice["type"])
+	require.Equal(t, DefaultConfig.DA.GasPrice, gasPrice["default"])
+	require.NotEmpty(t, gasPrice["description"])
+
+	blockTime := daProps["block_time"].(map[string]any)
+	require.Equal(t, "string", blockTime["type"])
+	require.Equal(t, DefaultConfig.DA.BlockTime.String(), blockTime["default"])
+
+	startHeight := daProps["start_height"].(map[string]any)
+	require.Equal(t, "integer", startHeight["type"])
+	require.Equal(t, float64(0), startHeight["minimum"])
+
+	instrumentation := properties["instrumentation"].(map[string]any)
+	instrProps := instrumentation["properties"].(map[string]any)
+	require.Equal(t, "boolean", instrProps["prometheus"].(map[string]any)["type"])
+}
+
+func TestValidateYAML(t *testing.T) {
+	testCases := []struct {
+		name   string
+		yaml   string
+		errors []string
+	}{
+		{
+			name: "valid config",
+			yaml: `
+db_path: data
+node:
+  aggregator: true
+  block_time: 500ms
+da:
+  gas_price: 1
+  start_height: 10
+instrumentation:
+  prometheus: true
+`,
+		},
+		{
+			name: "empty document",
+			yaml: "",
+		},
+		{
+			name:   "unknown option",
+			yaml:   "node:\n  blocktime: 1s\n",
+			errors: []string{"node.blocktime: unknown configuration option"},
+		},
+		{
+			name: "wrong types",
+			yaml: `
+node:
+  aggregator: "yes"
+  block_time: 5
+da:
+  start_height: -1
+  gas_price: cheap
+`,
+			errors: []string{
+				"da.gas_price: expected number, got string",
+				"da.start_height: must not be negative",
+				"node.aggregator: expected boolean, got string",
+				"node.block_time: expected duration string, got uint64",
+			},
+		},
+		{
+			name:   "invalid duration",
+			yaml:   "da:\n  block_time: soon\n",
+			errors: []string{`da.block_time: time: invalid duration "soon"`},
+		},
+		{
+			name:   "invalid value",
+			yaml:   "instrumentation:\n  max_open_connections: -1\n",
+			errors: []string{"instrumentation: max_open_connections can't be negative"},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			errs := ValidateYAML([]byte(tc.yaml))
+			msgs := make([]string, len(errs))
+			for i, err := range errs {
+				msgs[i] = err.Error()
+			}
+			if len(tc.errors) == 0 {
+				require.Empty(t, msgs)
+				return
+			}
+			require.Equal(t, tc.errors, msgs)
+		})
+	}
+}
+
+func TestSchemaForCollections(t *testing.T) {
+	type collections struct {
+		Peers  []string                   `yaml:"peers"`
+		Limits map[string]uint64          `yaml:"limits"`
+		Waits  map[string]DurationWrapper `yaml:"waits"`
+	}
+	def := collections{Peers: []string{"a"}}
+
+	schema := schemaFor(reflect.TypeOf(def), reflect.ValueOf(def))
+	properties := schema["properties"].(map[string]any)
+	peers := properties["peers"].(map[string]any)
+	require.Equal(t, "array", peers["type"])
+	require.Equal(t, "string", peers["items"].(map[string]any)["type"])
+	require.Equal(t, []string{"a"}, peers["default"])
+	limits := properties["limits"].(map[string]any)
+	require.Equal(t, "object", limits["type"])
+	require.Equal(t, "integer", limits["additionalProperties"].(map[string]any)["type"])
+	require.NotContains(t, limits, "default", "nil maps have no default")
+
+	errs := validateValue(reflect.TypeOf(def), "", map[string]any{
+		"peers":  []any{"b", 1},
+		"limits": map[string]any{"x": uint64(1), "y": -1},
+		"waits":  map[string]any{"z": "soon"},
+	})
+	msgs := make([]string, len(errs))
+	for i, err := range errs {
+		msgs[i] = err.Error()
+	}
+	require.Equal(t, []string{
+		"limits.y: must not be negative",
+		"peers[1]: expected string, got int",
+		`waits.z: time: invalid duration "soon"`,
+	}, msgs)
+	require.Equal(t, []error{errors.New("peers: expected array, got string")}, validateValue(reflect.TypeOf(def), "", map[string]any{"peers": "a"}))
+}
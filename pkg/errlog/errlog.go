@@ -0,0 +1,153 @@
+package errlog
+
+import (
+	"bytes"
+	"encoding/json"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/rs/zerolog"
+)
+
+// Entry is the most recent error of a subsystem.
+type Entry struct {
+	Subsystem string
+	// Message is the message of the most recent error.
+	Message string
+	// Count is the number of errors since the node started.
+	Count uint64
+	// FirstSeen is the time of the first error since the node started.
+	FirstSeen time.Time
+	// LastSeen is the time of the most recent error.
+	LastSeen time.Time
+}
+
+// Registry keeps the most recent error of every subsystem of the node, so that the cause of an
+// unhealthy node can be found without access to its logs.
+//
+// A nil Registry discards all errors.
+type Registry struct {
+	mu      sync.RWMutex
+	entries map[string]Entry
+}
+
+// NewRegistry creates an empty Registry.
+func NewRegistry() *Registry {
+	return &Registry{entries: make(map[string]Entry)}
+}
+
+// Record records an error of a subsystem.
+func (r *Registry) Record(subsystem, message string) {
+	if r == nil {
+		return
+	}
+	now := time.Now()
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	entry, ok := r.entries[subsystem]
+	if !ok {
+		entry = Entry{Subsystem: subsystem, FirstSeen: now}
+	}
+	entry.Message = message
+	entry.Count++
+	entry.LastSeen = now
+	r.entries[subsystem] = entry
+}
+
+// Entries returns the most recent error of every subsystem which reported one, sorted by
+// subsystem.
+func (r *Registry) Entries() []Entry {
+	if r == nil {
+		return nil
+	}
+
+	r.mu.RLock()
+	entries := make([]Entry, 0, len(r.entries))
+	for _, entry := range r.entries {
+		entries = append(entries, entry)
+	}
+	r.mu.RUnlock()
+
+	sort.Slice(entries, func(i, j int) bool { return entries[i].Subsystem < entries[j].Subsystem })
+	return entries
+}
+
+// Logger returns the logger of a subsystem, whose error logs are recorded in the registry with
+// the text of their error field, e.g. "failed to submit headers: timeout".
+func (r *Registry) Logger(logger zerolog.Logger, subsystem string) zerolog.Logger {
+	logger = logger.With().Str("component", subsystem).Logger()
+	if r == nil {
+		return logger
+	}
+	// zerolog does not expose the fields of an event to hooks, nor the output of a logger, so the
+	// events of the subsystem are encoded without output and passed on to its logger once recorded
+	return zerolog.New(recorder{registry: r, subsystem: subsystem, next: logger}).Level(logger.GetLevel())
+}
+
+// recorder records the error logs of a subsystem and writes every log to the logger of the
+// subsystem, with its fields in order.
+type recorder struct {
+	registry  *Registry
+	subsystem string
+	next      zerolog.Logger
+}
+
+// Write implements io.Writer.
+func (w recorder) Write(p []byte) (int, error) {
+	return w.WriteLevel(zerolog.NoLevel, p)
+}
+
+// WriteLevel implements zerolog.LevelWriter.
+func (w recorder) WriteLevel(level zerolog.Level, p []byte) (int, error) {
+	e := w.next.WithLevel(level)
+	if e == nil {
+		return len(p), nil
+	}
+	message, errText, ok := decodeEvent(e, p)
+	if !ok {
+		e.Msg(string(bytes.TrimSpace(p)))
+		return len(p), nil
+	}
+	if level >= zerolog.ErrorLevel && level != zerolog.NoLevel {
+		recorded := message
+		if errText != "" {
+			recorded += ": " + errText
+		}
+		w.registry.Record(w.subsystem, recorded)
+	}
+	e.Msg(message)
+	return len(p), nil
+}
+
+// decodeEvent adds the fields of the JSON encoded event p to e, and returns its message and the
+// text of its error field.
+func decodeEvent(e *zerolog.Event, p []byte) (message, errText string, ok bool) {
+	dec := json.NewDecoder(bytes.NewReader(p))
+	if tok, err := dec.Token(); err != nil || tok != json.Delim('{') {
+		return "", "", false
+	}
+	for dec.More() {
+		tok, err := dec.Token()
+		if err != nil {
+			return "", "", false
+		}
+		key, _ := tok.(string)
+		var value json.RawMessage
+		if err := dec.Decode(&value); err != nil {
+			return "", "", false
+		}
+		switch key {
+		case zerolog.LevelFieldName:
+		case zerolog.MessageFieldName:
+			_ = json.Unmarshal(value, &message)
+		case zerolog.ErrorFieldName:
+			_ = json.Unmarshal(value, &errText)
+			e.RawJSON(key, value)
+		default:
+			e.RawJSON(key, value)
+		}
+	}
+	return message, errText, true
+}
@@ -0,0 +1,67 @@
+package errlog
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRegistry(t *testing.T) {
+	r := NewRegistry()
+	require.Empty(t, r.Entries())
+
+	r.Record("Reaper", "failed to get txs")
+	r.Record("BlockManager", "failed to submit headers")
+	first := r.Entries()[0]
+	r.Record("BlockManager", "failed to submit data")
+
+	entries := r.Entries()
+	require.Len(t, entries, 2)
+	require.Equal(t, "BlockManager", entries[0].Subsystem)
+	require.Equal(t, "failed to submit data", entries[0].Message)
+	require.Equal(t, uint64(2), entries[0].Count)
+	require.Equal(t, first.FirstSeen, entries[0].FirstSeen)
+	require.False(t, entries[0].LastSeen.Before(first.LastSeen))
+	require.Equal(t, "Reaper", entries[1].Subsystem)
+	require.Equal(t, uint64(1), entries[1].Count)
+
+	// a nil registry discards errors
+	var nilRegistry *Registry
+	nilRegistry.Record("Reaper", "failed to get txs")
+	require.Empty(t, nilRegistry.Entries())
+}
+
+func TestRegistryLogger(t *testing.T) {
+	var buf bytes.Buffer
+	r := NewRegistry()
+	logger := r.Logger(zerolog.New(&buf), "BlockManager")
+
+	logger.Info().Msg("block produced")
+	logger.Warn().Msg("slow block")
+	require.Empty(t, r.Entries())
+
+	logger.Error().Err(errors.New("timeout")).Msg("failed to submit headers")
+	entries := r.Entries()
+	require.Len(t, entries, 1)
+	require.Equal(t, "BlockManager", entries[0].Subsystem)
+	require.Equal(t, "failed to submit headers: timeout", entries[0].Message)
+	require.Contains(t, buf.String(), `"component":"BlockManager"`)
+	// the logs are written with their fields in order
+	require.Contains(t, buf.String(), `{"level":"error","component":"BlockManager","error":"timeout","message":"failed to submit headers"}`)
+
+	// errors of loggers derived from the subsystem logger are recorded too
+	derived := logger.With().Uint64("height", 1).Logger()
+	derived.Error().Msg("failed to apply block")
+	require.Equal(t, uint64(2), r.Entries()[0].Count)
+	require.Equal(t, "failed to apply block", r.Entries()[0].Message)
+	require.Contains(t, buf.String(), `"component":"BlockManager","height":1,"message":"failed to apply block"`)
+
+	// the level of the logger is kept
+	quiet := r.Logger(zerolog.New(&buf).Level(zerolog.WarnLevel), "Reaper")
+	buf.Reset()
+	quiet.Info().Msg("txs fetched")
+	require.Empty(t, buf.String())
+}
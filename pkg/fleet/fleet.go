@@ -0,0 +1,261 @@
+// Package fleet queries a fleet of nodes of a chain at once, e.g. the sequencer and full nodes
+// run by an operator, and aggregates their status to spot the nodes which lag behind or diverge.
+package fleet
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"sort"
+	"sync"
+
+	"github.com/evstack/ev-node/pkg/rpc/client"
+	"github.com/evstack/ev-node/types"
+	pb "github.com/evstack/ev-node/types/pb/evnode/v1"
+)
+
+// NodeClient queries a node. It is implemented by the RPC client of a node.
+type NodeClient interface {
+	GetHealth(ctx context.Context) (pb.HealthStatus, error)
+	GetReadiness(ctx context.Context) (*pb.ReadyzResponse, error)
+	GetSyncStatus(ctx context.Context) (*pb.GetSyncStatusResponse, error)
+	GetHeader(ctx context.Context, height uint64) (*pb.GetHeaderResponse, error)
+}
+
+var _ NodeClient = (*client.Client)(nil)
+
+// Node is a node of a fleet.
+type Node struct {
+	// Name identifies the node in the results, e.g. its host name
+	Name   string
+	Client NodeClient
+}
+
+// Fleet queries the nodes of a fleet concurrently.
+type Fleet struct {
+	nodes []Node
+}
+
+// New creates a Fleet of the nodes, whose names must be unique.
+func New(nodes ...Node) (*Fleet, error) {
+	names := make(map[string]bool, len(nodes))
+	for _, node := range nodes {
+		if names[node.Name] {
+			return nil, fmt.Errorf("duplicate node name %q", node.Name)
+		}
+		names[node.Name] = true
+	}
+	return &Fleet{nodes: nodes}, nil
+}
+
+// FromURLs creates a Fleet of the nodes serving RPCs at the URLs, by node name. The clients of
+// the nodes share the options.
+func FromURLs(urls map[string]string, opts ...client.Option) *Fleet {
+	nodes := make([]Node, 0, len(urls))
+	for name, url := range urls {
+		nodes = append(nodes, Node{Name: name, Client: client.NewClient(url, opts...)})
+	}
+	sort.Slice(nodes, func(i, j int) bool { return nodes[i].Name < nodes[j].Name })
+	return &Fleet{nodes: nodes}
+}
+
+// Nodes returns the nodes of the fleet.
+func (f *Fleet) Nodes() []Node {
+	return f.nodes
+}
+
+// Result is the result of a query of a node.
+type Result[T any] struct {
+	Node  string
+	Value T
+	Err   error
+}
+
+// Query runs query against every node of the fleet concurrently, and returns the results in the
+// order of the nodes of the fleet once all the queries returned.
+func Query[T any](ctx context.Context, f *Fleet, query func(ctx context.Context, node NodeClient) (T, error)) []Result[T] {
+	results := make([]Result[T], len(f.nodes))
+	var wg sync.WaitGroup
+	for i, node := range f.nodes {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			value, err := query(ctx, node.Client)
+			results[i] = Result[T]{Node: node.Name, Value: value, Err: err}
+		}()
+	}
+	wg.Wait()
+	return results
+}
+
+// Heights returns the height of the latest block applied by every node.
+func (f *Fleet) Heights(ctx context.Context) []Result[uint64] {
+	return Query(ctx, f, func(ctx context.Context, node NodeClient) (uint64, error) {
+		status, err := node.GetSyncStatus(ctx)
+		if err != nil {
+			return 0, err
+		}
+		return status.Height, nil
+	})
+}
+
+// Health returns the health of every node.
+func (f *Fleet) Health(ctx context.Context) []Result[pb.HealthStatus] {
+	return Query(ctx, f, func(ctx context.Context, node NodeClient) (pb.HealthStatus, error) {
+		return node.GetHealth(ctx)
+	})
+}
+
+// NodeStatus is the status of a node of a fleet.
+type NodeStatus struct {
+	Name string
+	// Err is the error of the first query the node failed to answer. The fields of the queries
+	// which were not answered are unset.
+	Err error
+
+	// Health is the liveness of the node
+	Health pb.HealthStatus
+	// Readiness is the overall readiness of the node
+	Readiness pb.HealthStatus
+	// Height is the height of the latest block applied by the node
+	Height uint64
+	// DAIncludedHeight is the height of the latest block included on DA
+	DAIncludedHeight uint64
+	// DABacklog is the number of blocks applied by the node and not included on DA yet
+	DABacklog uint64
+	// Syncing is set if the node is behind the network
+	Syncing bool
+	// Lag is the number of blocks the node is behind the highest node of the fleet
+	Lag uint64
+}
+
+// Divergence is a height at which the nodes of a fleet have different headers.
+type Divergence struct {
+	Height uint64
+	// Hashes are the hashes of the headers of the nodes at the height, by node name
+	Hashes map[string]types.Hash
+}
+
+// Report is the aggregated status of a fleet.
+type Report struct {
+	// Nodes are the statuses of the nodes, in the order of the nodes of the fleet
+	Nodes []NodeStatus
+	// MinHeight and MaxHeight are the lowest and highest heights of the nodes which answered
+	MinHeight uint64
+	MaxHeight uint64
+	// Divergence is set if the nodes which answered have different headers at MinHeight
+	Divergence *Divergence
+}
+
+// Unreachable returns the names of the nodes which failed to answer.
+func (r *Report) Unreachable() []string {
+	var names []string
+	for _, node := range r.Nodes {
+		if node.Err != nil {
+			names = append(names, node.Name)
+		}
+	}
+	return names
+}
+
+// Status queries the status of every node, and checks that the nodes have the same header at the
+// lowest of their heights, the highest height they all applied.
+func (f *Fleet) Status(ctx context.Context) *Report {
+	results := Query(ctx, f, queryStatus)
+	report := &Report{Nodes: make([]NodeStatus, len(results))}
+	first := true
+	for i, result := range results {
+		status := result.Value
+		status.Name, status.Err = result.Node, result.Err
+		report.Nodes[i] = status
+		if status.Err != nil {
+			continue
+		}
+		if first || status.Height < report.MinHeight {
+			report.MinHeight = status.Height
+		}
+		report.MaxHeight = max(report.MaxHeight, status.Height)
+		first = false
+	}
+	for i := range report.Nodes {
+		if report.Nodes[i].Err == nil {
+			report.Nodes[i].Lag = report.MaxHeight - report.Nodes[i].Height
+		}
+	}
+	if report.MinHeight > 0 {
+		report.Divergence = f.divergence(ctx, report)
+	}
+	return report
+}
+
+// queryStatus queries the status of a node.
+func queryStatus(ctx context.Context, node NodeClient) (NodeStatus, error) {
+	var status NodeStatus
+	health, err := node.GetHealth(ctx)
+	if err != nil {
+		return status, fmt.Errorf("failed to get health: %w", err)
+	}
+	readiness, err := node.GetReadiness(ctx)
+	if err != nil {
+		return status, fmt.Errorf("failed to get readiness: %w", err)
+	}
+	syncStatus, err := node.GetSyncStatus(ctx)
+	if err != nil {
+		return status, fmt.Errorf("failed to get sync status: %w", err)
+	}
+	status.Health = health
+	status.Readiness = readiness.Status
+	status.Height = syncStatus.Height
+	status.DAIncludedHeight = syncStatus.DaIncludedHeight
+	if syncStatus.Height > syncStatus.DaIncludedHeight {
+		status.DABacklog = syncStatus.Height - syncStatus.DaIncludedHeight
+	}
+	status.Syncing = syncStatus.Syncing
+	return status, nil
+}
+
+// divergence compares the headers of the nodes which answered at the lowest of their heights.
+// The nodes failing to return their header are marked unreachable.
+func (f *Fleet) divergence(ctx context.Context, report *Report) *Divergence {
+	reachable := &Fleet{}
+	for i, node := range f.nodes {
+		if report.Nodes[i].Err == nil {
+			reachable.nodes = append(reachable.nodes, node)
+		}
+	}
+	results := Query(ctx, reachable, func(ctx context.Context, node NodeClient) (types.Hash, error) {
+		resp, err := node.GetHeader(ctx, report.MinHeight)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get header %d: %w", report.MinHeight, err)
+		}
+		var header types.Header
+		if err := header.FromProto(resp.Header.GetHeader()); err != nil {
+			return nil, fmt.Errorf("invalid header %d: %w", report.MinHeight, err)
+		}
+		return header.Hash(), nil
+	})
+
+	divergence := &Divergence{Height: report.MinHeight, Hashes: make(map[string]types.Hash, len(results))}
+	var reference types.Hash
+	diverged := false
+	for _, result := range results {
+		if result.Err != nil {
+			for i := range report.Nodes {
+				if report.Nodes[i].Name == result.Node {
+					report.Nodes[i].Err = result.Err
+				}
+			}
+			continue
+		}
+		divergence.Hashes[result.Node] = result.Value
+		if reference == nil {
+			reference = result.Value
+		} else if !bytes.Equal(reference, result.Value) {
+			diverged = true
+		}
+	}
+	if !diverged {
+		return nil
+	}
+	return divergence
+}
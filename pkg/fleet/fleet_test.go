@@ -0,0 +1,89 @@
+package fleet
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	pb "github.com/evstack/ev-node/types/pb/evnode/v1"
+)
+
+// node is a node at height whose headers have the app hash appHash, failing all queries if err
+// is set.
+type node struct {
+	height     uint64
+	daIncluded uint64
+	appHash    []byte
+	err        error
+}
+
+func (n *node) GetHealth(context.Context) (pb.HealthStatus, error) {
+	return pb.HealthStatus_PASS, n.err
+}
+
+func (n *node) GetReadiness(context.Context) (*pb.ReadyzResponse, error) {
+	return &pb.ReadyzResponse{Status: pb.HealthStatus_WARN}, n.err
+}
+
+func (n *node) GetSyncStatus(context.Context) (*pb.GetSyncStatusResponse, error) {
+	return &pb.GetSyncStatusResponse{Height: n.height, DaIncludedHeight: n.daIncluded}, n.err
+}
+
+func (n *node) GetHeader(_ context.Context, height uint64) (*pb.GetHeaderResponse, error) {
+	if n.err != nil {
+		return nil, n.err
+	}
+	return &pb.GetHeaderResponse{Header: &pb.SignedHeader{Header: &pb.Header{Height: height, AppHash: n.appHash}}}, nil
+}
+
+func TestNew_DuplicateName(t *testing.T) {
+	_, err := New(Node{Name: "a", Client: &node{}}, Node{Name: "a", Client: &node{}})
+	require.Error(t, err)
+}
+
+func TestFleet_Status(t *testing.T) {
+	f, err := New(
+		Node{Name: "sequencer", Client: &node{height: 12, daIncluded: 8, appHash: []byte("a")}},
+		Node{Name: "full-1", Client: &node{height: 10, daIncluded: 8, appHash: []byte("a")}},
+		Node{Name: "full-2", Client: &node{err: errors.New("connection refused")}},
+	)
+	require.NoError(t, err)
+
+	report := f.Status(context.Background())
+	require.Len(t, report.Nodes, 3)
+	require.Equal(t, uint64(10), report.MinHeight)
+	require.Equal(t, uint64(12), report.MaxHeight)
+	require.Nil(t, report.Divergence)
+	require.Equal(t, []string{"full-2"}, report.Unreachable())
+
+	sequencer := report.Nodes[0]
+	require.Equal(t, "sequencer", sequencer.Name)
+	require.Equal(t, pb.HealthStatus_PASS, sequencer.Health)
+	require.Equal(t, pb.HealthStatus_WARN, sequencer.Readiness)
+	require.Equal(t, uint64(4), sequencer.DABacklog)
+	require.Zero(t, sequencer.Lag)
+	require.Equal(t, uint64(2), report.Nodes[1].Lag)
+	require.ErrorContains(t, report.Nodes[2].Err, "connection refused")
+
+	heights := f.Heights(context.Background())
+	require.Equal(t, uint64(12), heights[0].Value)
+	require.Error(t, heights[2].Err)
+}
+
+func TestFleet_Status_Divergence(t *testing.T) {
+	f, err := New(
+		Node{Name: "a", Client: &node{height: 5, appHash: []byte("a")}},
+		Node{Name: "b", Client: &node{height: 5, appHash: []byte("a")}},
+		Node{Name: "c", Client: &node{height: 6, appHash: []byte("c")}},
+	)
+	require.NoError(t, err)
+
+	report := f.Status(context.Background())
+	require.NotNil(t, report.Divergence)
+	require.Equal(t, uint64(5), report.Divergence.Height)
+	require.Len(t, report.Divergence.Hashes, 3)
+	require.Equal(t, report.Divergence.Hashes["a"], report.Divergence.Hashes["b"])
+	require.NotEqual(t, report.Divergence.Hashes["a"], report.Divergence.Hashes["c"])
+}
@@ -1,6 +1,7 @@
 package genesis
 
 import (
+	"encoding/json"
 	"fmt"
 	"time"
 )
@@ -15,6 +16,67 @@ type Genesis struct {
 	GenesisDAStartTime time.Time `json:"genesis_da_start_height"` // TODO: change to uint64 and remove time.Time, basically we need a mechanism to convert DAHeight to time.Time
 	InitialHeight      uint64    `json:"initial_height"`
 	ProposerAddress    []byte    `json:"proposer_address"`
+	// FeeMarket optionally sets EIP-1559-like fee market parameters enforced by execution
+	// environments supporting them, such as the EVM adapter.
+	FeeMarket *FeeMarket `json:"fee_market,omitempty"`
+	// SystemCalls optionally lets the execution environment request protocol actions, e.g. from a
+	// governance contract, in execution environments supporting them, such as the EVM adapter.
+	SystemCalls *SystemCalls `json:"system_calls,omitempty"`
+	// BlockTimeBounds optionally bounds the block time of the aggregator, e.g. when autoscaling
+	// it to its load. Full nodes reject blocks timestamped less than the minimum after the
+	// previous block.
+	BlockTimeBounds *BlockTimeBounds `json:"block_time_bounds,omitempty"`
+	// BlobPointers enables the blob pointers of the sequencer, see types.BlobPointer. Without it,
+	// transactions starting with the blob pointer prefix are executed as they are.
+	BlobPointers bool `json:"blob_pointers,omitempty"`
+}
+
+// FeeMarket holds EIP-1559-like fee market parameters of the chain.
+type FeeMarket struct {
+	// BaseFeeFloor is the minimum base fee per unit of gas.
+	BaseFeeFloor uint64 `json:"base_fee_floor"`
+	// TargetGas is the gas used per block at which the base fee stays constant.
+	// 0 leaves the target to the execution environment.
+	TargetGas uint64 `json:"target_gas"`
+}
+
+// SystemCalls holds the parameters of the protocol actions requested by the execution environment.
+type SystemCalls struct {
+	// Contract is the address of the contract whose events request the actions.
+	Contract string `json:"contract"`
+	// MinDelay is the minimum number of blocks between the block requesting an action and its
+	// activation height, so that operators can prepare, e.g. for an upgrade.
+	MinDelay uint64 `json:"min_delay"`
+}
+
+// BlockTimeBounds are the lowest and highest block times of the chain.
+type BlockTimeBounds struct {
+	Min Duration `json:"min"`
+	Max Duration `json:"max"`
+}
+
+// Duration is a time.Duration encoded in JSON as a string, e.g. "500ms".
+type Duration struct {
+	time.Duration
+}
+
+// MarshalJSON encodes the duration as a string.
+func (d Duration) MarshalJSON() ([]byte, error) {
+	return json.Marshal(d.String())
+}
+
+// UnmarshalJSON decodes a duration string.
+func (d *Duration) UnmarshalJSON(b []byte) error {
+	var s string
+	if err := json.Unmarshal(b, &s); err != nil {
+		return fmt.Errorf("duration must be a string, e.g. \"500ms\": %w", err)
+	}
+	duration, err := time.ParseDuration(s)
+	if err != nil {
+		return err
+	}
+	d.Duration = duration
+	return nil
 }
 
 // NewGenesis creates a new Genesis instance.
@@ -54,5 +116,14 @@ func (g Genesis) Validate() error {
 		return fmt.Errorf("proposer_address cannot be nil")
 	}
 
+	if b := g.BlockTimeBounds; b != nil {
+		if b.Min.Duration <= 0 {
+			return fmt.Errorf("block_time_bounds.min must be positive, got %s", b.Min)
+		}
+		if b.Max.Duration < b.Min.Duration {
+			return fmt.Errorf("block_time_bounds.max %s is less than block_time_bounds.min %s", b.Max, b.Min)
+		}
+	}
+
 	return nil
 }
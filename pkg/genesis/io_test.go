@@ -47,6 +47,17 @@ func TestLoadAndSaveGenesis(t *testing.T) {
 			},
 			wantErr: false,
 		},
+		{
+			name: "valid genesis - fee market",
+			genesis: Genesis{
+				ChainID:            "test-chain-3",
+				InitialHeight:      1,
+				GenesisDAStartTime: validTime,
+				ProposerAddress:    []byte("proposer-address"),
+				FeeMarket:          &FeeMarket{BaseFeeFloor: 1_000_000_000, TargetGas: 15_000_000},
+			},
+			wantErr: false,
+		},
 		{
 			name: "invalid genesis - empty chain ID",
 			genesis: Genesis{
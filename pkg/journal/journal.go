@@ -0,0 +1,310 @@
+package journal
+
+import (
+	"context"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"slices"
+	"sort"
+	"strconv"
+	"sync"
+	"time"
+
+	ds "github.com/ipfs/go-datastore"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/evstack/ev-node/pkg/store"
+	pb "github.com/evstack/ev-node/types/pb/evnode/v1"
+)
+
+// Types of the events recorded by the node.
+const (
+	EventNodeStarted         = "node_started"
+	EventNodeStopped         = "node_stopped"
+	EventNodeUpgraded        = "node_upgraded"
+	EventDASubmissionFailed  = "da_submission_failed"
+	EventPeerConnected       = "peer_connected"
+	EventPeerDisconnected    = "peer_disconnected"
+	EventRollback            = "rollback"
+	EventDataQuarantined     = "data_quarantined"
+	EventSyncStalled         = "sync_stalled"
+	EventDiskQuotaExceeded   = "disk_quota_exceeded"
+	EventHeightsPruned       = "heights_pruned"
+	EventHeightsRestored     = "heights_restored"
+	EventSystemCallScheduled = "system_call_scheduled"
+	EventSystemCallApplied   = "system_call_applied"
+	EventExecutorSwitched    = "executor_switched"
+	EventExecutionDivergence = "execution_divergence"
+)
+
+const (
+	// Capacity is the number of events kept in the journal. Once it is full, every new event
+	// replaces the oldest one.
+	Capacity = 10_000
+
+	// eventKeyPrefix is the metadata key prefix of the journal slots.
+	// Full keys are like: ej/<sequence % Capacity>
+	eventKeyPrefix = "ej"
+	// lastSequenceKey is the metadata key of the sequence of the last recorded event.
+	lastSequenceKey = "ej-last"
+	// versionKey is the metadata key of the node version seen at the last start.
+	versionKey = "ej-version"
+)
+
+// Journal is a bounded journal of significant node events persisted in the store, so that
+// they outlive the node logs. Events are numbered by a sequence starting at 1 and kept in a
+// ring of Capacity slots.
+//
+// A nil Journal discards all events.
+type Journal struct {
+	store store.Store
+
+	mu sync.Mutex
+	// last is the sequence of the last recorded event, loaded on first use
+	last   uint64
+	loaded bool
+}
+
+// New creates a Journal persisting events in s.
+func New(s store.Store) *Journal {
+	return &Journal{store: s}
+}
+
+// Record appends an event of the given type to the journal.
+func (j *Journal) Record(ctx context.Context, eventType, message string, attrs map[string]string) error {
+	if j == nil {
+		return nil
+	}
+
+	j.mu.Lock()
+	defer j.mu.Unlock()
+
+	if !j.loaded {
+		last, err := j.lastSequence(ctx)
+		if err != nil {
+			return err
+		}
+		j.last, j.loaded = last, true
+	}
+
+	event := &pb.Event{
+		Sequence:   j.last + 1,
+		Time:       timestamppb.Now(),
+		Type:       eventType,
+		Message:    message,
+		Attributes: attrs,
+	}
+	value, err := proto.Marshal(event)
+	if err != nil {
+		return fmt.Errorf("failed to marshal event: %w", err)
+	}
+	// the slot is written before the sequence: an event whose sequence was not persisted is
+	// ignored by Events and overwritten by the next one
+	if err := j.store.SetMetadata(ctx, eventKey(event.Sequence), value); err != nil {
+		return fmt.Errorf("failed to save event: %w", err)
+	}
+	if err := j.store.SetMetadata(ctx, lastSequenceKey, encodeSequence(event.Sequence)); err != nil {
+		return fmt.Errorf("failed to save event sequence: %w", err)
+	}
+	j.last = event.Sequence
+	return nil
+}
+
+// RecordVersion records an EventNodeUpgraded event if version differs from the version
+// recorded at the previous start, and remembers it for the next one.
+func (j *Journal) RecordVersion(ctx context.Context, version string) error {
+	if j == nil || version == "" {
+		return nil
+	}
+
+	previous, err := j.store.GetMetadata(ctx, versionKey)
+	if err != nil && !errors.Is(err, ds.ErrNotFound) {
+		return fmt.Errorf("failed to load node version: %w", err)
+	}
+	if string(previous) == version {
+		return nil
+	}
+	if len(previous) > 0 {
+		if err := j.Record(ctx, EventNodeUpgraded, fmt.Sprintf("node upgraded from %s to %s", previous, version), map[string]string{
+			"from": string(previous),
+			"to":   version,
+		}); err != nil {
+			return err
+		}
+	}
+	if err := j.store.SetMetadata(ctx, versionKey, []byte(version)); err != nil {
+		return fmt.Errorf("failed to save node version: %w", err)
+	}
+	return nil
+}
+
+// Events returns the journal events recorded in [from, to) whose type is one of types, in the
+// order they were recorded. A zero from or to leaves the range open on that side, and an empty
+// types matches all events.
+//
+// The events kept are those between the head and the tail of the ring, i.e. the oldest and the
+// last recorded sequences. Events are recorded in time order, so the first event of the range is
+// found by binary search between them and the scan stops at the end of the range: only the events
+// of the range are read.
+func (j *Journal) Events(ctx context.Context, from, to time.Time, types []string) ([]*pb.Event, error) {
+	if j == nil {
+		return nil, nil
+	}
+
+	head, tail, err := j.bounds(ctx)
+	if err != nil {
+		return nil, err
+	}
+	first := head
+	if !from.IsZero() {
+		var searchErr error
+		first = head + uint64(sort.Search(int(tail+1-head), func(i int) bool {
+			event, err := j.Event(ctx, head+uint64(i))
+			if errors.Is(err, ds.ErrNotFound) {
+				return false
+			}
+			if err != nil {
+				searchErr = err
+				return true
+			}
+			return !event.Time.AsTime().Before(from)
+		}))
+		if searchErr != nil {
+			return nil, fmt.Errorf("failed to search events: %w", searchErr)
+		}
+	}
+
+	var events []*pb.Event
+	for seq := first; seq <= tail; seq++ {
+		event, err := j.Event(ctx, seq)
+		// the slot may be missing or hold an event recorded concurrently after tail was read
+		if errors.Is(err, ds.ErrNotFound) {
+			continue
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to load event %d: %w", seq, err)
+		}
+		ts := event.Time.AsTime()
+		if !to.IsZero() && !ts.Before(to) {
+			break
+		}
+		if !from.IsZero() && ts.Before(from) {
+			continue
+		}
+		if len(types) > 0 && !slices.Contains(types, event.Type) {
+			continue
+		}
+		events = append(events, event)
+	}
+	return events, nil
+}
+
+// bounds returns the sequences of the oldest event kept in the journal and of the last recorded
+// one. The head is after the tail when no event was recorded.
+func (j *Journal) bounds(ctx context.Context) (head, tail uint64, err error) {
+	tail, err = j.lastSequence(ctx)
+	if err != nil {
+		return 0, 0, err
+	}
+	head = 1
+	if tail > Capacity {
+		head = tail - Capacity + 1
+	}
+	return head, tail, nil
+}
+
+// EventsAfter returns the journal events recorded after the event with sequence after whose
+// type is one of types, in the order they were recorded, and the sequence of the last recorded
+// event. An empty types matches all events.
+func (j *Journal) EventsAfter(ctx context.Context, after uint64, types []string) ([]*pb.Event, uint64, error) {
+	if j == nil {
+		return nil, after, nil
+	}
+
+	last, err := j.lastSequence(ctx)
+	if err != nil {
+		return nil, after, err
+	}
+	first := after + 1
+	if last >= Capacity && first <= last-Capacity {
+		first = last - Capacity + 1
+	}
+
+	var events []*pb.Event
+	for seq := first; seq <= last; seq++ {
+		value, err := j.store.GetMetadata(ctx, eventKey(seq))
+		if errors.Is(err, ds.ErrNotFound) {
+			continue
+		}
+		if err != nil {
+			return nil, after, fmt.Errorf("failed to load event %d: %w", seq, err)
+		}
+		var event pb.Event
+		if err := proto.Unmarshal(value, &event); err != nil {
+			return nil, after, fmt.Errorf("failed to unmarshal event %d: %w", seq, err)
+		}
+		if event.Sequence != seq {
+			continue
+		}
+		if len(types) > 0 && !slices.Contains(types, event.Type) {
+			continue
+		}
+		events = append(events, &event)
+	}
+	return events, max(last, after), nil
+}
+
+// Event returns the event with sequence seq, or ds.ErrNotFound if it was not recorded or was
+// replaced by a later event once the journal was full.
+func (j *Journal) Event(ctx context.Context, seq uint64) (*pb.Event, error) {
+	if j == nil {
+		return nil, ds.ErrNotFound
+	}
+
+	value, err := j.store.GetMetadata(ctx, eventKey(seq))
+	if err != nil {
+		return nil, err
+	}
+	var event pb.Event
+	if err := proto.Unmarshal(value, &event); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal event %d: %w", seq, err)
+	}
+	if event.Sequence != seq {
+		return nil, ds.ErrNotFound
+	}
+	return &event, nil
+}
+
+// LastSequence returns the sequence of the last recorded event, 0 if none was recorded.
+func (j *Journal) LastSequence(ctx context.Context) (uint64, error) {
+	if j == nil {
+		return 0, nil
+	}
+	return j.lastSequence(ctx)
+}
+
+func (j *Journal) lastSequence(ctx context.Context) (uint64, error) {
+	value, err := j.store.GetMetadata(ctx, lastSequenceKey)
+	if errors.Is(err, ds.ErrNotFound) {
+		return 0, nil
+	}
+	if err != nil {
+		return 0, fmt.Errorf("failed to load event sequence: %w", err)
+	}
+	if len(value) != 8 {
+		return 0, fmt.Errorf("invalid event sequence length: %d", len(value))
+	}
+	return binary.LittleEndian.Uint64(value), nil
+}
+
+func eventKey(seq uint64) string {
+	return store.GenerateKey([]string{eventKeyPrefix, strconv.FormatUint(seq%Capacity, 10)})
+}
+
+func encodeSequence(seq uint64) []byte {
+	value := make([]byte, 8)
+	binary.LittleEndian.PutUint64(value, seq)
+	return value
+}
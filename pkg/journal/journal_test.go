@@ -0,0 +1,156 @@
+package journal
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	ds "github.com/ipfs/go-datastore"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/evstack/ev-node/pkg/store"
+)
+
+func newTestJournal(t *testing.T) (*Journal, store.Store) {
+	t.Helper()
+	kv, err := store.NewDefaultInMemoryKVStore()
+	require.NoError(t, err)
+	s := store.New(kv)
+	return New(s), s
+}
+
+func TestJournalRecordAndQuery(t *testing.T) {
+	ctx := context.Background()
+	j, s := newTestJournal(t)
+
+	require.NoError(t, j.Record(ctx, EventNodeStarted, "node started", nil))
+	require.NoError(t, j.Record(ctx, EventPeerConnected, "peer connected", map[string]string{"peer": "p1"}))
+	mid := time.Now()
+	time.Sleep(time.Millisecond)
+	require.NoError(t, j.Record(ctx, EventDASubmissionFailed, "boom", nil))
+	require.NoError(t, j.Record(ctx, EventNodeStopped, "node stopped", nil))
+
+	events, err := j.Events(ctx, time.Time{}, time.Time{}, nil)
+	require.NoError(t, err)
+	require.Len(t, events, 4)
+	for i, event := range events {
+		assert.Equal(t, uint64(i+1), event.Sequence)
+	}
+	assert.Equal(t, "p1", events[1].Attributes["peer"])
+
+	events, err = j.Events(ctx, mid, time.Time{}, nil)
+	require.NoError(t, err)
+	require.Len(t, events, 2)
+	assert.Equal(t, EventDASubmissionFailed, events[0].Type)
+
+	events, err = j.Events(ctx, time.Time{}, mid, []string{EventPeerConnected, EventNodeStopped})
+	require.NoError(t, err)
+	require.Len(t, events, 1)
+	assert.Equal(t, EventPeerConnected, events[0].Type)
+
+	// a new journal over the same store continues the sequence
+	require.NoError(t, New(s).Record(ctx, EventRollback, "rollback", nil))
+	events, err = New(s).Events(ctx, time.Time{}, time.Time{}, []string{EventRollback})
+	require.NoError(t, err)
+	require.Len(t, events, 1)
+	assert.Equal(t, uint64(5), events[0].Sequence)
+
+	events, last, err := j.EventsAfter(ctx, 2, []string{EventPeerConnected, EventNodeStopped, EventRollback})
+	require.NoError(t, err)
+	require.Len(t, events, 2)
+	assert.Equal(t, uint64(4), events[0].Sequence)
+	assert.Equal(t, uint64(5), events[1].Sequence)
+	assert.Equal(t, uint64(5), last)
+
+	events, last, err = j.EventsAfter(ctx, last, nil)
+	require.NoError(t, err)
+	assert.Empty(t, events)
+	assert.Equal(t, uint64(5), last)
+}
+
+func TestJournalIsBounded(t *testing.T) {
+	ctx := context.Background()
+	j, _ := newTestJournal(t)
+
+	for i := 0; i < Capacity+10; i++ {
+		require.NoError(t, j.Record(ctx, EventPeerConnected, "peer connected", nil))
+	}
+	events, err := j.Events(ctx, time.Time{}, time.Time{}, nil)
+	require.NoError(t, err)
+	require.Len(t, events, Capacity)
+	assert.Equal(t, uint64(11), events[0].Sequence)
+	assert.Equal(t, uint64(Capacity+10), events[len(events)-1].Sequence)
+
+	// replaced events are no longer found
+	event, err := j.Event(ctx, 11)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(11), event.Sequence)
+	_, err = j.Event(ctx, 10)
+	require.ErrorIs(t, err, ds.ErrNotFound)
+}
+
+// countingStore is a store counting the reads of metadata.
+type countingStore struct {
+	store.Store
+	reads int
+}
+
+func (s *countingStore) GetMetadata(ctx context.Context, key string) ([]byte, error) {
+	s.reads++
+	return s.Store.GetMetadata(ctx, key)
+}
+
+func TestJournalEventsReadsRange(t *testing.T) {
+	ctx := context.Background()
+	_, s := newTestJournal(t)
+	counting := &countingStore{Store: s}
+	j := New(counting)
+
+	for i := 0; i < 1000; i++ {
+		require.NoError(t, j.Record(ctx, EventPeerConnected, "peer connected", nil))
+	}
+	from := time.Now()
+	time.Sleep(time.Millisecond)
+	for i := 0; i < 3; i++ {
+		require.NoError(t, j.Record(ctx, EventPeerDisconnected, "peer disconnected", nil))
+	}
+
+	// the first event of the range is searched, not scanned
+	counting.reads = 0
+	events, err := j.Events(ctx, from, time.Time{}, nil)
+	require.NoError(t, err)
+	require.Len(t, events, 3)
+	assert.Equal(t, uint64(1001), events[0].Sequence)
+	assert.Less(t, counting.reads, 30)
+
+	// the scan stops at the end of the range
+	counting.reads = 0
+	events, err = j.Events(ctx, time.Time{}, from, []string{EventPeerDisconnected})
+	require.NoError(t, err)
+	assert.Empty(t, events)
+	assert.LessOrEqual(t, counting.reads, 1002)
+}
+
+func TestJournalRecordVersion(t *testing.T) {
+	ctx := context.Background()
+	j, _ := newTestJournal(t)
+
+	require.NoError(t, j.RecordVersion(ctx, "v1.0.0"))
+	require.NoError(t, j.RecordVersion(ctx, "v1.0.0"))
+	require.NoError(t, j.RecordVersion(ctx, "v1.1.0"))
+
+	events, err := j.Events(ctx, time.Time{}, time.Time{}, nil)
+	require.NoError(t, err)
+	require.Len(t, events, 1)
+	assert.Equal(t, EventNodeUpgraded, events[0].Type)
+	assert.Equal(t, map[string]string{"from": "v1.0.0", "to": "v1.1.0"}, events[0].Attributes)
+}
+
+func TestNilJournal(t *testing.T) {
+	var j *Journal
+	require.NoError(t, j.Record(context.Background(), EventNodeStarted, "node started", nil))
+	events, err := j.Events(context.Background(), time.Time{}, time.Time{}, nil)
+	require.NoError(t, err)
+	assert.Empty(t, events)
+}
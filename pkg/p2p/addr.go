@@ -0,0 +1,48 @@
+package p2p
+
+import (
+	"net"
+
+	"github.com/libp2p/go-libp2p/core/peer"
+	"github.com/multiformats/go-multiaddr"
+	manet "github.com/multiformats/go-multiaddr/net"
+)
+
+// normalizeAddr returns the form of a peer address to dial, and false if the address cannot be
+// dialed from another host. IPv4-mapped IPv6 addresses, as reported by dual-stack listeners
+// (e.g. /ip6/::ffff:1.2.3.4/tcp/7676), are converted to IPv4, while unspecified bind addresses
+// and IPv6 link-local addresses, which are only meaningful on the host of the peer, are dropped.
+func normalizeAddr(addr multiaddr.Multiaddr) (multiaddr.Multiaddr, bool) {
+	if manet.IsIPUnspecified(addr) || manet.IsIP6LinkLocal(addr) {
+		return nil, false
+	}
+	first, rest := multiaddr.SplitFirst(addr)
+	if first == nil || first.Code() != multiaddr.P_IP6 {
+		return addr, true
+	}
+	ip4 := net.IP(first.RawValue()).To4()
+	if ip4 == nil {
+		return addr, true
+	}
+	component, err := multiaddr.NewComponent("ip4", ip4.String())
+	if err != nil {
+		return addr, true
+	}
+	return component.Encapsulate(rest), true
+}
+
+// normalizeAddrInfo normalizes the addresses of a peer exchanged through discovery or configured,
+// dropping those which cannot be dialed and the duplicates.
+func normalizeAddrInfo(info peer.AddrInfo) peer.AddrInfo {
+	addrs := make([]multiaddr.Multiaddr, 0, len(info.Addrs))
+	seen := make(map[string]bool, len(info.Addrs))
+	for _, addr := range info.Addrs {
+		addr, ok := normalizeAddr(addr)
+		if !ok || seen[addr.String()] {
+			continue
+		}
+		seen[addr.String()] = true
+		addrs = append(addrs, addr)
+	}
+	return peer.AddrInfo{ID: info.ID, Addrs: addrs}
+}
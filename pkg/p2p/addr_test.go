@@ -0,0 +1,38 @@
+package p2p
+
+import (
+	"testing"
+
+	"github.com/libp2p/go-libp2p/core/peer"
+	"github.com/multiformats/go-multiaddr"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNormalizeAddrInfo(t *testing.T) {
+	id, err := peer.Decode("12D3KooWJbD9TQoMSSSUyfhHMmgVY3LqCjxYFz8wQ92Qa6DAqtmh")
+	require.NoError(t, err)
+	info := peer.AddrInfo{ID: id, Addrs: []multiaddr.Multiaddr{
+		multiaddr.StringCast("/ip4/203.0.113.7/tcp/7676"),
+		multiaddr.StringCast("/ip6/2001:db8::7/tcp/7676"),
+		// the IPv4-mapped form of the first address, as reported by dual-stack listeners
+		multiaddr.StringCast("/ip6/::ffff:203.0.113.7/tcp/7676"),
+		multiaddr.StringCast("/ip6/::ffff:198.51.100.1/udp/7676/quic-v1"),
+		multiaddr.StringCast("/ip4/0.0.0.0/tcp/7676"),
+		multiaddr.StringCast("/ip6/::/tcp/7676"),
+		multiaddr.StringCast("/ip6/fe80::1/tcp/7676"),
+		multiaddr.StringCast("/dns6/node.example.com/tcp/7676"),
+	}}
+
+	normalized := normalizeAddrInfo(info)
+	require.Equal(t, id, normalized.ID)
+	var addrs []string
+	for _, addr := range normalized.Addrs {
+		addrs = append(addrs, addr.String())
+	}
+	require.Equal(t, []string{
+		"/ip4/203.0.113.7/tcp/7676",
+		"/ip6/2001:db8::7/tcp/7676",
+		"/ip4/198.51.100.1/udp/7676/quic-v1",
+		"/dns6/node.example.com/tcp/7676",
+	}, addrs)
+}
@@ -8,6 +8,7 @@ import (
 	"strings"
 	"time"
 
+	lru "github.com/hashicorp/golang-lru/v2"
 	"github.com/ipfs/go-datastore"
 	libp2p "github.com/libp2p/go-libp2p"
 	dht "github.com/libp2p/go-libp2p-kad-dht"
@@ -35,6 +36,10 @@ const (
 
 	// peerLimit defines limit of number of peers returned during active peer discovery.
 	peerLimit = 60
+
+	// lastSeenLimit is the number of disconnected peers whose last connection time is kept, the
+	// least recently disconnected being evicted first.
+	lastSeenLimit = 1024
 )
 
 // Client is a P2P client, implemented with libp2p.
@@ -56,6 +61,9 @@ type Client struct {
 	ps    *pubsub.PubSub
 
 	metrics *Metrics
+
+	// lastSeen is when peers were last connected, recorded when they disconnect
+	lastSeen *lru.Cache[peer.ID, time.Time]
 }
 
 // NewClient creates new Client object.
@@ -75,13 +83,19 @@ func NewClient(
 		return nil, fmt.Errorf("failed to create connection gater: %w", err)
 	}
 
+	lastSeen, err := lru.New[peer.ID, time.Time](lastSeenLimit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create last seen cache: %w", err)
+	}
+
 	return &Client{
-		conf:    conf,
-		gater:   gater,
-		privKey: privKey,
-		chainID: chainID,
-		logger:  logger,
-		metrics: metrics,
+		conf:     conf,
+		gater:    gater,
+		privKey:  privKey,
+		chainID:  chainID,
+		logger:   logger,
+		metrics:  metrics,
+		lastSeen: lastSeen,
 	}, nil
 }
 
@@ -136,6 +150,11 @@ func (c *Client) Start(ctx context.Context) error {
 
 func (c *Client) startWithHost(ctx context.Context, h host.Host) error {
 	c.host = h
+	c.host.Network().Notify(&network.NotifyBundle{
+		DisconnectedF: func(_ network.Network, conn network.Conn) {
+			c.lastSeen.Add(conn.RemotePeer(), time.Now())
+		},
+	})
 	for _, a := range c.host.Addrs() {
 		c.logger.Info().Str("address", fmt.Sprintf("%s/p2p/%s", a, c.host.ID())).Msg("listening on address")
 	}
@@ -190,6 +209,11 @@ func (c *Client) Host() host.Host {
 	return c.host
 }
 
+// Metrics returns the metrics of the Client, also reported to by services using its host
+func (c *Client) Metrics() *Metrics {
+	return c.metrics
+}
+
 // PubSub returns the libp2p node pubsub for adding future subscriptions
 func (c *Client) PubSub() *pubsub.PubSub {
 	return c.ps
@@ -200,6 +224,20 @@ func (c *Client) ConnectionGater() *conngater.BasicConnectionGater {
 	return c.gater
 }
 
+// DisconnectPeer closes the connections to the peer with the given ID. If ban is set, the peer is
+// first blocked by the connection gater, which persists the ban, so that it cannot reconnect.
+func (c *Client) DisconnectPeer(id peer.ID, ban bool) error {
+	if c.host == nil {
+		return errors.New("p2p client is not started")
+	}
+	if ban {
+		if err := c.gater.BlockPeer(id); err != nil {
+			return fmt.Errorf("failed to block peer: %w", err)
+		}
+	}
+	return c.host.Network().ClosePeer(id)
+}
+
 // Info returns client ID, ListenAddr, and Network info
 func (c *Client) Info() (string, string, string, error) {
 	rawKey, err := c.privKey.GetPublic().Raw()
@@ -240,12 +278,42 @@ func (c *Client) Peers() []PeerConnection {
 }
 
 func (c *Client) listen() (host.Host, error) {
-	maddr, err := multiaddr.NewMultiaddr(c.conf.ListenAddress)
+	listenAddrs, err := parseMultiaddrList(c.conf.ListenAddress)
 	if err != nil {
-		return nil, err
+		return nil, fmt.Errorf("invalid listen address: %w", err)
 	}
 
-	return libp2p.New(libp2p.ListenAddrs(maddr), libp2p.Identity(c.privKey), libp2p.ConnectionGater(c.gater))
+	opts := []libp2p.Option{libp2p.ListenAddrs(listenAddrs...), libp2p.Identity(c.privKey), libp2p.ConnectionGater(c.gater)}
+
+	// advertise the external addresses instead of the bind addresses, which are not reachable behind NAT
+	externalAddrs, err := parseMultiaddrList(c.conf.ExternalAddresses)
+	if err != nil {
+		return nil, fmt.Errorf("invalid external address: %w", err)
+	}
+	if len(externalAddrs) > 0 {
+		opts = append(opts, libp2p.AddrsFactory(func([]multiaddr.Multiaddr) []multiaddr.Multiaddr {
+			return externalAddrs
+		}))
+	}
+
+	return libp2p.New(opts...)
+}
+
+// parseMultiaddrList parses a comma separated string of multiaddrs, skipping empty entries
+func parseMultiaddrList(list string) ([]multiaddr.Multiaddr, error) {
+	var addrs []multiaddr.Multiaddr
+	for _, a := range strings.Split(list, ",") {
+		a = strings.TrimSpace(a)
+		if a == "" {
+			continue
+		}
+		maddr, err := multiaddr.NewMultiaddr(a)
+		if err != nil {
+			return nil, err
+		}
+		addrs = append(addrs, maddr)
+	}
+	return addrs, nil
 }
 
 func (c *Client) setupDHT(ctx context.Context) error {
@@ -334,7 +402,7 @@ func (c *Client) findPeers(ctx context.Context) error {
 	}
 
 	for peer := range peerCh {
-		go c.tryConnect(ctx, peer)
+		go c.tryConnect(ctx, normalizeAddrInfo(peer))
 	}
 
 	return nil
@@ -353,14 +421,40 @@ func (c *Client) tryConnect(ctx context.Context, peer peer.AddrInfo) {
 }
 
 func (c *Client) setupGossiping(ctx context.Context) error {
+	if c.conf.GossipFanout < 0 {
+		return fmt.Errorf("gossip fanout must not be negative, got %d", c.conf.GossipFanout)
+	}
+
+	var opts []pubsub.Option
+	if priorityPeers := c.parseAddrInfoList(c.conf.PriorityPeers); len(priorityPeers) > 0 {
+		c.logger.Info().Str("peers", c.conf.PriorityPeers).Msg("pushing headers and data directly to priority peers")
+		opts = append(opts, pubsub.WithDirectPeers(priorityPeers))
+	}
+	if c.conf.GossipFanout > 0 {
+		opts = append(opts, pubsub.WithGossipSubParams(gossipSubParams(c.conf.GossipFanout)))
+	}
+
 	var err error
-	c.ps, err = pubsub.NewGossipSub(ctx, c.host)
+	c.ps, err = pubsub.NewGossipSub(ctx, c.host, opts...)
 	if err != nil {
 		return err
 	}
 	return nil
 }
 
+// gossipSubParams returns the default gossipsub parameters with a mesh degree of fanout,
+// adjusting the other degrees to keep them consistent.
+func gossipSubParams(fanout int) pubsub.GossipSubParams {
+	params := pubsub.DefaultGossipSubParams()
+	params.D = fanout
+	params.Dlo = max(fanout*5/6, 1)
+	params.Dhi = 2 * fanout
+	params.Dscore = min(params.Dscore, fanout)
+	// outbound peers must stay below Dlo and at most half of the mesh
+	params.Dout = min(params.Dout, params.Dlo-1, fanout/2)
+	return params
+}
+
 // parseAddrInfoList parses a comma separated string of multiaddrs into a list of peer.AddrInfo structs
 func (c *Client) parseAddrInfoList(addrInfoStr string) []peer.AddrInfo {
 	if len(addrInfoStr) == 0 {
@@ -379,7 +473,7 @@ func (c *Client) parseAddrInfoList(addrInfoStr string) []peer.AddrInfo {
 			c.logger.Error().Str("address", maddr.String()).Err(err).Msg("failed to create addr info for peer")
 			continue
 		}
-		addrs = append(addrs, *addrInfo)
+		addrs = append(addrs, normalizeAddrInfo(*addrInfo))
 	}
 	return addrs
 }
@@ -403,11 +497,33 @@ func (c *Client) GetPeers() ([]peer.AddrInfo, error) {
 		if p.ID == c.host.ID() {
 			continue
 		}
-		peers = append(peers, p)
+		peers = append(peers, normalizeAddrInfo(p))
 	}
 	return peers, nil
 }
 
+// PeerDetails implements PeerDetailsProvider.
+func (c *Client) PeerDetails(id peer.ID) PeerDetails {
+	var details PeerDetails
+	for _, conn := range c.host.Network().ConnsToPeer(id) {
+		stat := conn.Stat()
+		if !details.Connected || stat.Opened.Before(details.ConnectedAt) {
+			details.Connected = true
+			details.Outbound = stat.Direction == network.DirOutbound
+			details.ConnectedAt = stat.Opened
+		}
+	}
+	if details.Connected {
+		details.LastSeen = time.Now()
+	} else {
+		details.LastSeen, _ = c.lastSeen.Get(id)
+	}
+	if version, err := c.host.Peerstore().Get(id, "ProtocolVersion"); err == nil {
+		details.ProtocolVersion, _ = version.(string)
+	}
+	return details
+}
+
 func (c *Client) GetNetworkInfo() (NetworkInfo, error) {
 	var addrs []string
 	for _, a := range c.host.Addrs() {
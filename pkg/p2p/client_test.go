@@ -3,14 +3,17 @@ package p2p
 import (
 	"context"
 	"fmt"
+	"net"
 	"os"
 	"path/filepath"
+	"slices"
 	"testing"
 	"time"
 
 	"github.com/ipfs/go-datastore"
 	dssync "github.com/ipfs/go-datastore/sync"
 	libp2p "github.com/libp2p/go-libp2p"
+	pubsub "github.com/libp2p/go-libp2p-pubsub"
 	"github.com/libp2p/go-libp2p/core/host"
 	"github.com/libp2p/go-libp2p/core/peer"
 	"github.com/multiformats/go-multiaddr"
@@ -141,6 +144,229 @@ func TestClientStartup(t *testing.T) {
 	}
 }
 
+func TestClientListenAndExternalAddresses(t *testing.T) {
+	require := require.New(t)
+
+	tempDir := t.TempDir()
+	ClientInitFiles(t, tempDir)
+	nodeKey, err := key.LoadOrGenNodeKey(filepath.Join(tempDir, "config", "node_key.json"))
+	require.NoError(err)
+
+	conf := config.P2PConfig{
+		ListenAddress:     "/ip4/127.0.0.1/tcp/0, /ip4/127.0.0.1/udp/0/quic-v1",
+		ExternalAddresses: "/ip4/203.0.113.7/tcp/7676,/dns4/node.example.com/tcp/7676",
+	}
+	client, err := NewClient(conf, nodeKey.PrivKey, dssync.MutexWrap(datastore.NewMapDatastore()), "test-chain", zerolog.Nop(), NopMetrics())
+	require.NoError(err)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	require.NoError(client.Start(ctx))
+	defer func() { _ = client.Close() }()
+
+	// the node binds every listen address
+	var bound []string
+	for _, addr := range client.Host().Network().ListenAddresses() {
+		if _, err := addr.ValueForProtocol(multiaddr.P_IP4); err == nil {
+			bound = append(bound, addr.String())
+		}
+	}
+	require.Len(bound, 2)
+
+	// but only advertises the external ones
+	advertised := client.Addrs()
+	require.Len(advertised, 2)
+	require.Equal("/ip4/203.0.113.7/tcp/7676", advertised[0].String())
+	require.Equal("/dns4/node.example.com/tcp/7676", advertised[1].String())
+
+	netInfo, err := client.GetNetworkInfo()
+	require.NoError(err)
+	require.Equal(fmt.Sprintf("/ip4/203.0.113.7/tcp/7676/p2p/%s", client.Host().ID()), netInfo.ListenAddress[0])
+
+	t.Run("invalid external address", func(t *testing.T) {
+		conf := config.P2PConfig{ListenAddress: "/ip4/127.0.0.1/tcp/0", ExternalAddresses: "not-a-multiaddr"}
+		client, err := NewClient(conf, nodeKey.PrivKey, dssync.MutexWrap(datastore.NewMapDatastore()), "test-chain", zerolog.Nop(), NopMetrics())
+		require.NoError(err)
+		require.ErrorContains(client.Start(ctx), "invalid external address")
+	})
+}
+
+func TestClientDualStack(t *testing.T) {
+	require := require.New(t)
+	if l, err := net.Listen("tcp6", "[::1]:0"); err != nil {
+		t.Skip("IPv6 is not available")
+	} else {
+		_ = l.Close()
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	newClient := func(conf config.P2PConfig) *Client {
+		t.Helper()
+		tempDir := t.TempDir()
+		ClientInitFiles(t, tempDir)
+		nodeKey, err := key.LoadOrGenNodeKey(filepath.Join(tempDir, "config", "node_key.json"))
+		require.NoError(err)
+		client, err := NewClient(conf, nodeKey.PrivKey, dssync.MutexWrap(datastore.NewMapDatastore()), "test-chain", zerolog.Nop(), NopMetrics())
+		require.NoError(err)
+		require.NoError(client.Start(ctx))
+		t.Cleanup(func() { _ = client.Close() })
+		return client
+	}
+
+	// a dual-stack node binds both IP versions
+	dualStack := newClient(config.P2PConfig{ListenAddress: "/ip4/127.0.0.1/tcp/0,/ip6/::1/tcp/0"})
+	var ip6Addr multiaddr.Multiaddr
+	versions := map[int]bool{}
+	for _, addr := range dualStack.Host().Network().ListenAddresses() {
+		first, _ := multiaddr.SplitFirst(addr)
+		versions[first.Code()] = true
+		if first.Code() == multiaddr.P_IP6 {
+			ip6Addr = addr
+		}
+	}
+	require.True(versions[multiaddr.P_IP4])
+	require.True(versions[multiaddr.P_IP6])
+
+	// and IPv6-only peers connect to it
+	ip6Only := newClient(config.P2PConfig{
+		ListenAddress: "/ip6/::1/tcp/0",
+		Peers:         fmt.Sprintf("%s/p2p/%s", ip6Addr, dualStack.Host().ID()),
+	})
+	require.Eventually(func() bool {
+		return slices.Contains(ip6Only.PeerIDs(), dualStack.Host().ID())
+	}, 10*time.Second, 100*time.Millisecond)
+}
+
+func TestClientPriorityPeers(t *testing.T) {
+	require := require.New(t)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	newClient := func(conf config.P2PConfig) *Client {
+		t.Helper()
+		tempDir := t.TempDir()
+		ClientInitFiles(t, tempDir)
+		nodeKey, err := key.LoadOrGenNodeKey(filepath.Join(tempDir, "config", "node_key.json"))
+		require.NoError(err)
+		client, err := NewClient(conf, nodeKey.PrivKey, dssync.MutexWrap(datastore.NewMapDatastore()), "test-chain", zerolog.Nop(), NopMetrics())
+		require.NoError(err)
+		return client
+	}
+
+	fullNode := newClient(config.P2PConfig{ListenAddress: "/ip4/127.0.0.1/tcp/0"})
+	require.NoError(fullNode.Start(ctx))
+	defer func() { _ = fullNode.Close() }()
+
+	// the sequencer is not bootstrapped with the full node, but connects to it as a priority peer
+	sequencer := newClient(config.P2PConfig{
+		ListenAddress: "/ip4/127.0.0.1/tcp/0",
+		PriorityPeers: fmt.Sprintf("%s/p2p/%s", fullNode.Addrs()[0], fullNode.Host().ID()),
+		GossipFanout:  3,
+	})
+	require.NoError(sequencer.Start(ctx))
+	defer func() { _ = sequencer.Close() }()
+
+	require.Eventually(func() bool {
+		return slices.Contains(sequencer.PeerIDs(), fullNode.Host().ID())
+	}, 10*time.Second, 100*time.Millisecond)
+
+	t.Run("negative fanout", func(t *testing.T) {
+		client := newClient(config.P2PConfig{ListenAddress: "/ip4/127.0.0.1/tcp/0", GossipFanout: -1})
+		require.ErrorContains(client.Start(ctx), "gossip fanout must not be negative")
+		_ = client.Close()
+	})
+}
+
+func TestClientDisconnectPeer(t *testing.T) {
+	require := require.New(t)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	newClient := func(conf config.P2PConfig) *Client {
+		t.Helper()
+		nodeKey, err := key.GenerateNodeKey()
+		require.NoError(err)
+		conf.ListenAddress = "/ip4/127.0.0.1/tcp/0"
+		client, err := NewClient(conf, nodeKey.PrivKey, dssync.MutexWrap(datastore.NewMapDatastore()), "test-chain", zerolog.Nop(), NopMetrics())
+		require.NoError(err)
+		require.NoError(client.Start(ctx))
+		t.Cleanup(func() { _ = client.Close() })
+		return client
+	}
+	addr := func(c *Client) string {
+		return fmt.Sprintf("%s/p2p/%s", c.Addrs()[0], c.Host().ID())
+	}
+
+	kept, banned := newClient(config.P2PConfig{}), newClient(config.P2PConfig{})
+	client := newClient(config.P2PConfig{Peers: addr(kept) + "," + addr(banned)})
+	require.Eventually(func() bool {
+		return slices.Contains(client.PeerIDs(), kept.Host().ID()) && slices.Contains(client.PeerIDs(), banned.Host().ID())
+	}, 10*time.Second, 100*time.Millisecond)
+
+	require.NoError(client.DisconnectPeer(kept.Host().ID(), false))
+	require.NoError(client.DisconnectPeer(banned.Host().ID(), true))
+	require.NotContains(client.PeerIDs(), banned.Host().ID())
+	require.Contains(client.ConnectionGater().ListBlockedPeers(), banned.Host().ID())
+	require.NotContains(client.ConnectionGater().ListBlockedPeers(), kept.Host().ID())
+
+	// only the peer which is not banned can reconnect
+	_, err := client.Host().Network().DialPeer(ctx, kept.Host().ID())
+	require.NoError(err)
+	_, err = client.Host().Network().DialPeer(ctx, banned.Host().ID())
+	require.Error(err)
+}
+
+func TestClientPeerDetails(t *testing.T) {
+	require := require.New(t)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	newClient := func(conf config.P2PConfig) *Client {
+		t.Helper()
+		nodeKey, err := key.GenerateNodeKey()
+		require.NoError(err)
+		conf.ListenAddress = "/ip4/127.0.0.1/tcp/0"
+		client, err := NewClient(conf, nodeKey.PrivKey, dssync.MutexWrap(datastore.NewMapDatastore()), "test-chain", zerolog.Nop(), NopMetrics())
+		require.NoError(err)
+		require.NoError(client.Start(ctx))
+		t.Cleanup(func() { _ = client.Close() })
+		return client
+	}
+
+	seed := newClient(config.P2PConfig{})
+	client := newClient(config.P2PConfig{Peers: fmt.Sprintf("%s/p2p/%s", seed.Addrs()[0], seed.Host().ID())})
+	require.Eventually(func() bool {
+		return slices.Contains(client.PeerIDs(), seed.Host().ID())
+	}, 10*time.Second, 100*time.Millisecond)
+
+	details := client.PeerDetails(seed.Host().ID())
+	require.True(details.Connected)
+	require.True(details.Outbound)
+	require.False(details.ConnectedAt.IsZero())
+	require.False(details.LastSeen.IsZero())
+	require.False(seed.PeerDetails(client.Host().ID()).Outbound)
+
+	// disconnections are notified asynchronously
+	require.NoError(client.DisconnectPeer(seed.Host().ID(), false))
+	require.Eventually(func() bool {
+		details = client.PeerDetails(seed.Host().ID())
+		return !details.Connected && !details.LastSeen.IsZero()
+	}, 10*time.Second, 10*time.Millisecond)
+	require.True(details.ConnectedAt.IsZero())
+}
+
+func TestGossipSubParams(t *testing.T) {
+	for _, fanout := range []int{1, 2, 3, 6, 8, 20} {
+		params := gossipSubParams(fanout)
+		assert.Equal(t, fanout, params.D)
+		assert.True(t, params.Dlo >= 1 && params.Dlo <= params.D && params.D <= params.Dhi, "fanout %d: %+v", fanout, params)
+		assert.True(t, params.Dout < params.Dlo && params.Dout <= params.D/2, "fanout %d: Dout %d", fanout, params.Dout)
+		assert.LessOrEqual(t, params.Dscore, params.D, "fanout %d", fanout)
+	}
+	assert.Equal(t, pubsub.DefaultGossipSubParams(), gossipSubParams(6))
+}
+
 func TestBootstrapping(t *testing.T) {
 	assert := assert.New(t)
 	logger := zerolog.Nop()
@@ -29,6 +29,16 @@ type Metrics struct {
 	MessageReceiveBytesTotal metrics.Counter `metrics_labels:"message_type"`
 	// Number of bytes of each message type sent.
 	MessageSendBytesTotal metrics.Counter `metrics_labels:"message_type"`
+	// Number of headers or data served by the peers to a sync service. The contributions of each
+	// peer are returned by the sync service rather than labelled, so that the number of series
+	// does not grow with the peers.
+	SyncServedTotal metrics.Counter `metrics_labels:"sync"`
+	// Number of bytes served by the peers to a sync service.
+	SyncServedBytesTotal metrics.Counter `metrics_labels:"sync"`
+	// Number of requests of a sync service to the peers.
+	SyncRequestsTotal metrics.Counter `metrics_labels:"sync"`
+	// Number of failed requests of a sync service to the peers.
+	SyncFailedRequestsTotal metrics.Counter `metrics_labels:"sync"`
 }
 
 // PrometheusMetrics returns Metrics build using Prometheus client library.
@@ -82,6 +92,30 @@ func PrometheusMetrics(namespace string, labelsAndValues ...string) *Metrics {
 			Name:      "message_send_bytes_total",
 			Help:      "Number of bytes of each message type sent.",
 		}, append(labels, "message_type")).With(labelsAndValues...),
+		SyncServedTotal: prometheus.NewCounterFrom(stdprometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: MetricsSubsystem,
+			Name:      "sync_served_total",
+			Help:      "Number of headers or data served by the peers to a sync service.",
+		}, append(labels, "sync")).With(labelsAndValues...),
+		SyncServedBytesTotal: prometheus.NewCounterFrom(stdprometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: MetricsSubsystem,
+			Name:      "sync_served_bytes_total",
+			Help:      "Number of bytes served by the peers to a sync service.",
+		}, append(labels, "sync")).With(labelsAndValues...),
+		SyncRequestsTotal: prometheus.NewCounterFrom(stdprometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: MetricsSubsystem,
+			Name:      "sync_requests_total",
+			Help:      "Number of requests of a sync service to the peers.",
+		}, append(labels, "sync")).With(labelsAndValues...),
+		SyncFailedRequestsTotal: prometheus.NewCounterFrom(stdprometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: MetricsSubsystem,
+			Name:      "sync_failed_requests_total",
+			Help:      "Number of failed requests of a sync service to the peers.",
+		}, append(labels, "sync")).With(labelsAndValues...),
 	}
 }
 
@@ -95,5 +129,10 @@ func NopMetrics() *Metrics {
 		NumTxs:                   discard.NewGauge(),
 		MessageReceiveBytesTotal: discard.NewCounter(),
 		MessageSendBytesTotal:    discard.NewCounter(),
+
+		SyncServedTotal:         discard.NewCounter(),
+		SyncServedBytesTotal:    discard.NewCounter(),
+		SyncRequestsTotal:       discard.NewCounter(),
+		SyncFailedRequestsTotal: discard.NewCounter(),
 	}
 }
@@ -1,6 +1,10 @@
 package p2p
 
-import "github.com/libp2p/go-libp2p/core/peer"
+import (
+	"time"
+
+	"github.com/libp2p/go-libp2p/core/peer"
+)
 
 // P2PRPC defines the interface for managing peer connections
 type P2PRPC interface {
@@ -16,3 +20,25 @@ type NetworkInfo struct {
 	ListenAddress  []string
 	ConnectedPeers []peer.ID
 }
+
+// PeerDetailsProvider is an optional interface of P2PRPC implementations able to describe the
+// connections to peers.
+type PeerDetailsProvider interface {
+	// PeerDetails returns the details of the connection to the given peer.
+	PeerDetails(id peer.ID) PeerDetails
+}
+
+// PeerDetails describes the connection to a peer.
+type PeerDetails struct {
+	// Connected is whether the peer is connected.
+	Connected bool
+	// Outbound is whether the node opened the oldest connection to the peer.
+	Outbound bool
+	// ConnectedAt is when the oldest connection to the peer was opened, if connected.
+	ConnectedAt time.Time
+	// LastSeen is the last time the peer was connected, zero if never connected or if it is not
+	// among the last 1024 disconnected peers.
+	LastSeen time.Time
+	// ProtocolVersion is the protocol version announced by the peer, empty if unknown.
+	ProtocolVersion string
+}
@@ -0,0 +1,131 @@
+package preflight
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"strings"
+	"time"
+
+	coreda "github.com/evstack/ev-node/core/da"
+)
+
+// Pinger is implemented by executors that can check the connection to their execution client.
+type Pinger interface {
+	// Ping returns an error if the execution client is unreachable or rejects the connection.
+	Ping(ctx context.Context) error
+}
+
+// ListenAddr is an address the node listens on.
+type ListenAddr struct {
+	// Name identifies the service listening on the address.
+	Name string
+	// Network is "tcp" or "udp".
+	Network string
+	Address string
+}
+
+// DA checks that the DA layer is reachable and accepts queries for the namespaces.
+// The namespaces are queried at height, which is typically the DA start height of the chain.
+func DA(da coreda.DA, namespaces [][]byte, height uint64) Check {
+	return Check{
+		Name: "da",
+		Hint: "check that the DA node is running, that the DA address and auth token are correct, and that the header and data namespaces are valid for the DA layer",
+		Run: func(ctx context.Context) error {
+			if _, err := da.GasPrice(ctx); err != nil {
+				return fmt.Errorf("DA layer unreachable: %w", err)
+			}
+			for _, namespace := range namespaces {
+				_, err := da.GetIDs(ctx, height, namespace)
+				// errors are compared as strings, since the JSON-RPC client does not preserve wrapping
+				if err != nil &&
+					!strings.Contains(err.Error(), coreda.ErrBlobNotFound.Error()) &&
+					!strings.Contains(err.Error(), coreda.ErrHeightFromFuture.Error()) {
+					return fmt.Errorf("failed to query namespace %q at DA height %d: %w", namespace, height, err)
+				}
+			}
+			return nil
+		},
+	}
+}
+
+// Execution checks that the execution client is reachable and accepts the connection,
+// e.g. that an Engine API accepts the JWT secret.
+func Execution(pinger Pinger) Check {
+	return Check{
+		Name: "execution",
+		Hint: "check that the execution client is running, that its URLs and JWT secret match the node configuration, and that it was initialized with the chain genesis",
+		Run:  pinger.Ping,
+	}
+}
+
+// Ports checks that the node can bind all the addresses it listens on.
+func Ports(addrs []ListenAddr) Check {
+	return Check{
+		Name: "ports",
+		Hint: "stop the process using the port or change the listen address in the node configuration",
+		Run: func(ctx context.Context) error {
+			var errs []error
+			for _, addr := range addrs {
+				if err := bind(addr); err != nil {
+					errs = append(errs, fmt.Errorf("%s: %w", addr.Name, err))
+				}
+			}
+			return errors.Join(errs...)
+		},
+	}
+}
+
+func bind(addr ListenAddr) error {
+	if strings.HasPrefix(addr.Network, "udp") {
+		conn, err := net.ListenPacket(addr.Network, addr.Address)
+		if err != nil {
+			return err
+		}
+		return conn.Close()
+	}
+	l, err := net.Listen(addr.Network, addr.Address)
+	if err != nil {
+		return err
+	}
+	return l.Close()
+}
+
+// DiskSpace checks that the volume of dir has at least minFree bytes available.
+func DiskSpace(dir string, minFree uint64) Check {
+	return Check{
+		Name: "disk",
+		Hint: "free up disk space or move the node home directory to a larger volume",
+		Run: func(ctx context.Context) error {
+			free, err := freeDiskSpace(dir)
+			if err != nil {
+				return err
+			}
+			if free < minFree {
+				return fmt.Errorf("%d MiB available in %s, at least %d MiB required", free>>20, dir, minFree>>20)
+			}
+			return nil
+		},
+	}
+}
+
+// ClockSkew checks that the local clock is not behind the time of the latest block by more than
+// maxSkew, as blocks produced or verified with a late clock would be rejected.
+// latestBlockTime returns the zero time if there is no block yet.
+func ClockSkew(latestBlockTime func(ctx context.Context) (time.Time, error), maxSkew time.Duration) Check {
+	return Check{
+		Name: "clock",
+		Hint: "synchronize the system clock, e.g. by enabling NTP",
+		Run: func(ctx context.Context) error {
+			blockTime, err := latestBlockTime(ctx)
+			if err != nil {
+				return fmt.Errorf("failed to get latest block time: %w", err)
+			}
+			if skew := blockTime.Sub(time.Now()); skew > maxSkew {
+				return fmt.Errorf("local clock is %s behind the latest block time %s", skew.Round(time.Millisecond), blockTime.UTC().Format(time.RFC3339))
+			}
+			return nil
+		},
+	}
+}
@@ -0,0 +1,8 @@
+//go:build !unix
+
+package preflight
+
+// freeDiskSpace is not supported on this platform.
+func freeDiskSpace(string) (uint64, error) {
+	return 0, ErrSkipped
+}
@@ -0,0 +1,14 @@
+//go:build unix
+
+package preflight
+
+import "syscall"
+
+// freeDiskSpace returns the number of bytes available to unprivileged users on the volume of dir.
+func freeDiskSpace(dir string) (uint64, error) {
+	var stat syscall.Statfs_t
+	if err := syscall.Statfs(dir, &stat); err != nil {
+		return 0, err
+	}
+	return uint64(stat.Bavail) * uint64(stat.Bsize), nil //nolint:gosec // block size is positive
+}
@@ -0,0 +1,94 @@
+// Package preflight checks the environment of a node before its services start, so that
+// misconfigurations are reported together, with remediation hints, instead of crashing the node
+// midway through its startup.
+package preflight
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+	"sync"
+	"time"
+)
+
+var (
+	// ErrFailed is returned by Report when at least one check failed.
+	ErrFailed = errors.New("preflight checks failed")
+	// ErrSkipped is returned by a check that cannot run in the current environment.
+	ErrSkipped = errors.New("skipped")
+)
+
+// Check is a single preflight check.
+type Check struct {
+	// Name identifies the check in the summary.
+	Name string
+	// Hint tells the operator how to fix a failure.
+	Hint string
+	// Run returns an error if the check fails.
+	Run func(ctx context.Context) error
+}
+
+// Result is the outcome of a check.
+type Result struct {
+	Name     string
+	Hint     string
+	Err      error
+	Duration time.Duration
+}
+
+// Passed returns whether the check passed or was skipped.
+func (r Result) Passed() bool {
+	return r.Err == nil || errors.Is(r.Err, ErrSkipped)
+}
+
+// Run runs the checks concurrently, each bounded by timeout, and returns their results in the
+// order of the checks.
+func Run(ctx context.Context, checks []Check, timeout time.Duration) []Result {
+	results := make([]Result, len(checks))
+	var wg sync.WaitGroup
+	for i, check := range checks {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			checkCtx, cancel := context.WithTimeout(ctx, timeout)
+			defer cancel()
+
+			start := time.Now()
+			err := check.Run(checkCtx)
+			results[i] = Result{Name: check.Name, Hint: check.Hint, Err: err, Duration: time.Since(start)}
+		}()
+	}
+	wg.Wait()
+	return results
+}
+
+// Report writes a pass/fail summary of the results to w, with the hint of every failed check.
+// It returns an error wrapping ErrFailed and naming the failed checks if any.
+func Report(w io.Writer, results []Result) error {
+	var failed []string
+	fmt.Fprintln(w, "Preflight checks:")
+	for _, r := range results {
+		status := "PASS"
+		switch {
+		case errors.Is(r.Err, ErrSkipped):
+			status = "SKIP"
+		case r.Err != nil:
+			status = "FAIL"
+			failed = append(failed, r.Name)
+		}
+		fmt.Fprintf(w, "  [%s] %-10s %s\n", status, r.Name, r.Duration.Round(time.Millisecond))
+		if r.Err != nil {
+			fmt.Fprintf(w, "         %v\n", r.Err)
+		}
+		if !r.Passed() && r.Hint != "" {
+			fmt.Fprintf(w, "         hint: %s\n", r.Hint)
+		}
+	}
+
+	if len(failed) > 0 {
+		return fmt.Errorf("%w: %s", ErrFailed, strings.Join(failed, ", "))
+	}
+	return nil
+}
@@ -0,0 +1,110 @@
+package preflight
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"math"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	coreda "github.com/evstack/ev-node/core/da"
+)
+
+type pingerFunc func(ctx context.Context) error
+
+func (f pingerFunc) Ping(ctx context.Context) error { return f(ctx) }
+
+func TestRunAndReport(t *testing.T) {
+	checks := []Check{
+		{Name: "ok", Run: func(context.Context) error { return nil }},
+		{Name: "skipped", Hint: "not shown", Run: func(context.Context) error { return ErrSkipped }},
+		{Name: "slow", Hint: "speed it up", Run: func(ctx context.Context) error {
+			<-ctx.Done()
+			return ctx.Err()
+		}},
+		{Name: "broken", Hint: "fix it", Run: func(context.Context) error { return errors.New("boom") }},
+	}
+
+	results := Run(context.Background(), checks, 50*time.Millisecond)
+	require.Len(t, results, len(checks))
+	for i, check := range checks {
+		assert.Equal(t, check.Name, results[i].Name)
+	}
+	assert.True(t, results[0].Passed())
+	assert.True(t, results[1].Passed())
+	assert.ErrorIs(t, results[2].Err, context.DeadlineExceeded)
+
+	var out bytes.Buffer
+	err := Report(&out, results)
+	require.ErrorIs(t, err, ErrFailed)
+	assert.Contains(t, err.Error(), "slow, broken")
+	assert.Contains(t, out.String(), "[PASS] ok")
+	assert.Contains(t, out.String(), "[SKIP] skipped")
+	assert.Contains(t, out.String(), "[FAIL] broken")
+	assert.Contains(t, out.String(), "boom")
+	assert.Contains(t, out.String(), "hint: fix it")
+	assert.NotContains(t, out.String(), "not shown")
+
+	require.NoError(t, Report(&out, results[:2]))
+}
+
+func TestDACheck(t *testing.T) {
+	ctx := context.Background()
+	da := coreda.NewDummyDA(100_000, 0, 0, time.Second)
+	namespaces := [][]byte{[]byte("header"), []byte("data")}
+
+	require.NoError(t, DA(da, namespaces, 0).Run(ctx))
+	// heights the DA layer has not reached yet are not an error
+	require.NoError(t, DA(da, namespaces, 100).Run(ctx))
+}
+
+func TestExecutionCheck(t *testing.T) {
+	check := Execution(pingerFunc(func(context.Context) error { return errors.New("401 Unauthorized") }))
+	require.EqualError(t, check.Run(context.Background()), "401 Unauthorized")
+}
+
+func TestPortsCheck(t *testing.T) {
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	defer l.Close()
+
+	free := []ListenAddr{
+		{Name: "rpc", Network: "tcp", Address: "127.0.0.1:0"},
+		{Name: "p2p", Network: "udp", Address: "127.0.0.1:0"},
+	}
+	require.NoError(t, Ports(free).Run(context.Background()))
+
+	err = Ports(append(free, ListenAddr{Name: "metrics", Network: "tcp", Address: l.Addr().String()})).Run(context.Background())
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "metrics")
+}
+
+func TestDiskSpaceCheck(t *testing.T) {
+	dir := t.TempDir()
+	require.NoError(t, DiskSpace(dir, 0).Run(context.Background()))
+
+	err := DiskSpace(dir, math.MaxUint64).Run(context.Background())
+	if errors.Is(err, ErrSkipped) {
+		t.Skip("disk space is not supported on this platform")
+	}
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "MiB available")
+}
+
+func TestClockSkewCheck(t *testing.T) {
+	blockTime := func(ts time.Time) func(context.Context) (time.Time, error) {
+		return func(context.Context) (time.Time, error) { return ts, nil }
+	}
+
+	require.NoError(t, ClockSkew(blockTime(time.Time{}), time.Second).Run(context.Background()))
+	require.NoError(t, ClockSkew(blockTime(time.Now().Add(-time.Hour)), time.Second).Run(context.Background()))
+
+	err := ClockSkew(blockTime(time.Now().Add(time.Hour)), time.Second).Run(context.Background())
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "local clock is")
+}
@@ -0,0 +1,205 @@
+// Package preview gossips the preview blocks of a chain: the blocks published by the aggregator as
+// soon as it executes them, before signing their header, so that read replicas and UIs can show
+// them at minimum latency. Previews are hints only: they carry no signature, and a block is only
+// accepted by the nodes once its signed header is received.
+package preview
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+
+	pubsub "github.com/libp2p/go-libp2p-pubsub"
+	"github.com/libp2p/go-libp2p/core/peer"
+	"github.com/rs/zerolog"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/evstack/ev-node/pkg/genesis"
+	"github.com/evstack/ev-node/pkg/store"
+	"github.com/evstack/ev-node/types"
+	pb "github.com/evstack/ev-node/types/pb/evnode/v1"
+)
+
+// subscriberBuffer is the number of previews buffered for a subscriber, above which the previews
+// are dropped for it rather than delaying the others.
+const subscriberBuffer = 16
+
+// ErrNotStarted is returned when publishing a preview before the service is started.
+var ErrNotStarted = errors.New("preview service not started")
+
+// TopicID returns the ID of the gossipsub topic of the preview blocks of a chain.
+func TopicID(chainID string) string {
+	return chainID + "-preview"
+}
+
+// Router provides the gossipsub router of the node once its P2P client is started.
+type Router interface {
+	PubSub() *pubsub.PubSub
+}
+
+// Service publishes the preview blocks of an aggregator and receives the ones gossiped by peers.
+type Service struct {
+	router   Router
+	chainID  string
+	proposer []byte
+	store    store.Store
+	logger   zerolog.Logger
+
+	mu          sync.Mutex
+	topic       *pubsub.Topic
+	latest      *pb.Block
+	subscribers map[chan *pb.Block]struct{}
+}
+
+// NewService creates a preview service on the gossipsub router of the node. The store is used to
+// drop the previews of the blocks the node already has.
+func NewService(router Router, gen genesis.Genesis, s store.Store, logger zerolog.Logger) *Service {
+	return &Service{
+		router:      router,
+		chainID:     gen.ChainID,
+		proposer:    gen.ProposerAddress,
+		store:       s,
+		logger:      logger,
+		subscribers: make(map[chan *pb.Block]struct{}),
+	}
+}
+
+// Start joins the preview topic and receives the previews gossiped by peers until ctx is done. The
+// P2P client of the node must be started first.
+func (s *Service) Start(ctx context.Context) error {
+	ps := s.router.PubSub()
+	topicID := TopicID(s.chainID)
+	if err := ps.RegisterTopicValidator(topicID, s.validate); err != nil {
+		return fmt.Errorf("failed to register preview validator: %w", err)
+	}
+	topic, err := ps.Join(topicID)
+	if err != nil {
+		return fmt.Errorf("failed to join preview topic: %w", err)
+	}
+	sub, err := topic.Subscribe()
+	if err != nil {
+		_ = topic.Close()
+		return fmt.Errorf("failed to subscribe to preview topic: %w", err)
+	}
+
+	s.mu.Lock()
+	s.topic = topic
+	s.mu.Unlock()
+
+	go func() {
+		defer func() {
+			sub.Cancel()
+			s.mu.Lock()
+			s.topic = nil
+			s.mu.Unlock()
+			_ = topic.Close()
+			_ = ps.UnregisterTopicValidator(topicID)
+		}()
+		for {
+			msg, err := sub.Next(ctx)
+			if err != nil {
+				return
+			}
+			// the previews published by the node are delivered by Publish
+			if msg.Local {
+				continue
+			}
+			if block, ok := msg.ValidatorData.(*pb.Block); ok {
+				s.deliver(block)
+			}
+		}
+	}()
+	return nil
+}
+
+// Publish gossips the preview of a block executed by the aggregator, before its header is signed.
+func (s *Service) Publish(ctx context.Context, header *types.Header, data *types.Data) error {
+	s.mu.Lock()
+	topic := s.topic
+	s.mu.Unlock()
+	if topic == nil {
+		return ErrNotStarted
+	}
+
+	block := &pb.Block{
+		Header: &pb.SignedHeader{Header: header.ToProto(), Signer: &pb.Signer{}},
+		Data:   data.ToProto(),
+	}
+	msg, err := proto.Marshal(block)
+	if err != nil {
+		return fmt.Errorf("failed to marshal preview: %w", err)
+	}
+	if err := topic.Publish(ctx, msg); err != nil {
+		return err
+	}
+	s.deliver(block)
+	return nil
+}
+
+// validate accepts the previews of the chain proposed by the sequencer of the genesis, above the
+// height of the store, and stores the decoded block as the validator data of the message.
+func (s *Service) validate(ctx context.Context, _ peer.ID, msg *pubsub.Message) pubsub.ValidationResult {
+	var block pb.Block
+	if err := proto.Unmarshal(msg.Data, &block); err != nil {
+		return pubsub.ValidationReject
+	}
+	var header types.Header
+	if block.Header == nil || header.FromProto(block.Header.Header) != nil || block.Data == nil {
+		return pubsub.ValidationReject
+	}
+	if header.ChainID() != s.chainID || !bytes.Equal(header.ProposerAddress, s.proposer) {
+		return pubsub.ValidationReject
+	}
+	height, err := s.store.Height(ctx)
+	if err != nil || header.Height() <= height {
+		return pubsub.ValidationIgnore
+	}
+	msg.ValidatorData = &block
+	return pubsub.ValidationAccept
+}
+
+// deliver records a preview as the latest one, if it is above the latest one, and sends it to the
+// subscribers.
+func (s *Service) deliver(block *pb.Block) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.latest != nil && block.Header.Header.Height <= s.latest.Header.Header.Height {
+		return
+	}
+	s.latest = block
+	for ch := range s.subscribers {
+		select {
+		case ch <- block:
+		default:
+			s.logger.Debug().Uint64("height", block.Header.Header.Height).Msg("dropped preview for slow subscriber")
+		}
+	}
+}
+
+// Latest returns the latest preview published or received, nil if there is none.
+func (s *Service) Latest() *pb.Block {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.latest
+}
+
+// Subscribe returns the previews published or received from now on, in height order, and a
+// function to stop receiving them. Previews are dropped for a subscriber which does not keep up.
+func (s *Service) Subscribe() (<-chan *pb.Block, func()) {
+	ch := make(chan *pb.Block, subscriberBuffer)
+	s.mu.Lock()
+	s.subscribers[ch] = struct{}{}
+	s.mu.Unlock()
+
+	var once sync.Once
+	return ch, func() {
+		once.Do(func() {
+			s.mu.Lock()
+			delete(s.subscribers, ch)
+			s.mu.Unlock()
+		})
+	}
+}
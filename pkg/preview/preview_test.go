@@ -0,0 +1,76 @@
+package preview
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	pubsub "github.com/libp2p/go-libp2p-pubsub"
+	mocknet "github.com/libp2p/go-libp2p/p2p/net/mock"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/require"
+
+	"github.com/evstack/ev-node/pkg/genesis"
+	"github.com/evstack/ev-node/pkg/store"
+	"github.com/evstack/ev-node/types"
+	pb "github.com/evstack/ev-node/types/pb/evnode/v1"
+)
+
+func TestServiceGossipsPreviews(t *testing.T) {
+	ctx, cancel := context.WithCancel(t.Context())
+	defer cancel()
+	const chainID = "test-chain"
+	header, data, _ := types.GenerateRandomBlockCustom(&types.BlockConfig{Height: 1, NTxs: 2}, chainID)
+	gen := genesis.Genesis{ChainID: chainID, ProposerAddress: header.ProposerAddress}
+
+	mn, err := mocknet.FullMeshConnected(2)
+	require.NoError(t, err)
+	services := make([]*Service, 2)
+	for i, h := range mn.Hosts() {
+		ps, err := pubsub.NewGossipSub(ctx, h)
+		require.NoError(t, err)
+		kv, err := store.NewDefaultInMemoryKVStore()
+		require.NoError(t, err)
+		services[i] = NewService(router{ps}, gen, store.New(kv), zerolog.Nop())
+	}
+	sequencer, replica := services[0], services[1]
+
+	require.ErrorIs(t, sequencer.Publish(ctx, &header.Header, data), ErrNotStarted)
+	require.NoError(t, sequencer.Start(ctx))
+	require.NoError(t, replica.Start(ctx))
+	published, stop := sequencer.Subscribe()
+	defer stop()
+	received, stop := replica.Subscribe()
+	defer stop()
+
+	// the previews are published until the gossipsub mesh is formed
+	var preview *pb.Block
+	for preview == nil {
+		require.NoError(t, sequencer.Publish(ctx, &header.Header, data))
+		select {
+		case preview = <-received:
+		case <-time.After(100 * time.Millisecond):
+		case <-ctx.Done():
+			t.Fatal("expected preview")
+		}
+	}
+	require.Equal(t, header.Height(), preview.Header.Header.Height)
+	require.Empty(t, preview.Header.Signature)
+	require.Len(t, preview.Data.Txs, 2)
+	require.Equal(t, preview, replica.Latest())
+	require.Equal(t, header.Height(), (<-published).Header.Header.Height)
+
+	// the previews of another proposer are rejected
+	forged, forgedData, _ := types.GenerateRandomBlockCustom(&types.BlockConfig{Height: 2}, chainID)
+	require.Error(t, sequencer.Publish(ctx, &forged.Header, forgedData))
+	require.Equal(t, header.Height(), sequencer.Latest().Header.Header.Height)
+}
+
+// router provides a gossipsub router.
+type router struct {
+	ps *pubsub.PubSub
+}
+
+func (r router) PubSub() *pubsub.PubSub {
+	return r.ps
+}
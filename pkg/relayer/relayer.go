@@ -0,0 +1,195 @@
+// Package relayer relays the signed headers of a chain to an on-chain light client, such as the
+// light client contract of a bridge on a settlement layer.
+package relayer
+
+import (
+	"context"
+	"encoding/binary"
+	"fmt"
+	"time"
+
+	"github.com/rs/zerolog"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/evstack/ev-node/pkg/store"
+	pb "github.com/evstack/ev-node/types/pb/evnode/v1"
+)
+
+const (
+	// DefaultBatchSize is the default maximum number of headers submitted at once
+	DefaultBatchSize = 20
+	// DefaultPollInterval is the default interval at which new headers are checked for
+	DefaultPollInterval = 5 * time.Second
+
+	// maxBackoff is the maximum time to wait before retrying a failed submission
+	maxBackoff = time.Minute
+)
+
+// HeaderSource provides the headers to relay. It is implemented by the RPC client of a node.
+type HeaderSource interface {
+	GetState(ctx context.Context) (*pb.State, error)
+	GetMetadata(ctx context.Context, key string) ([]byte, error)
+	GetHeaderRange(ctx context.Context, fromHeight, toHeight uint64) ([]*pb.SignedHeader, error)
+}
+
+// Submitter submits headers to an on-chain light client.
+type Submitter interface {
+	// LatestHeight returns the height of the last header accepted by the light client,
+	// so that relaying resumes after it.
+	LatestHeight(ctx context.Context) (uint64, error)
+	// SubmitHeaders submits protobuf encoded signed headers of consecutive heights, in order,
+	// and returns once the light client accepted them.
+	SubmitHeaders(ctx context.Context, headers [][]byte) error
+}
+
+// Config is the configuration of a Relayer.
+type Config struct {
+	// BatchSize is the maximum number of headers submitted at once
+	BatchSize int
+	// PollInterval is the interval at which the source is checked for new headers
+	PollInterval time.Duration
+	// DAIncludedOnly restricts relaying to headers of DA included blocks
+	DAIncludedOnly bool
+}
+
+// DefaultConfig returns the default relayer configuration.
+func DefaultConfig() Config {
+	return Config{
+		BatchSize:    DefaultBatchSize,
+		PollInterval: DefaultPollInterval,
+	}
+}
+
+// Relayer polls a node for new headers and submits them in batches to a light client.
+//
+// Headers are relayed in order: a failed submission is retried with exponential backoff until
+// it succeeds. Relaying starts after the latest height of the light client, which is queried
+// again after a failure, so that relaying resumes where it, or another relayer, stopped.
+type Relayer struct {
+	source    HeaderSource
+	submitter Submitter
+	config    Config
+	logger    zerolog.Logger
+
+	relayed uint64
+	// resync is set after a failed submission, which may still have been accepted
+	resync bool
+}
+
+// NewRelayer creates a Relayer submitting the headers of source to submitter.
+func NewRelayer(source HeaderSource, submitter Submitter, config Config, logger zerolog.Logger) *Relayer {
+	if config.BatchSize <= 0 {
+		config.BatchSize = DefaultBatchSize
+	}
+	if config.PollInterval <= 0 {
+		config.PollInterval = DefaultPollInterval
+	}
+	return &Relayer{
+		source:    source,
+		submitter: submitter,
+		config:    config,
+		logger:    logger,
+	}
+}
+
+// Run relays headers until the context is canceled.
+func (r *Relayer) Run(ctx context.Context) error {
+	relayed, err := r.submitter.LatestHeight(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to get latest height of light client: %w", err)
+	}
+	r.relayed = relayed
+	r.logger.Info().Uint64("fromHeight", relayed+1).Int("batchSize", r.config.BatchSize).Msg("starting header relay")
+
+	ticker := time.NewTicker(r.config.PollInterval)
+	defer ticker.Stop()
+
+	backoff := r.config.PollInterval
+	for {
+		if err := r.relay(ctx); err != nil {
+			r.resync = true
+			r.logger.Warn().Err(err).Uint64("height", r.relayed+1).Dur("retryIn", backoff).Msg("failed to relay headers")
+			select {
+			case <-ctx.Done():
+				return nil
+			case <-time.After(backoff):
+			}
+			backoff = min(2*backoff, maxBackoff)
+			continue
+		}
+		backoff = r.config.PollInterval
+
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+		}
+	}
+}
+
+// RelayedHeight returns the height of the last relayed header.
+func (r *Relayer) RelayedHeight() uint64 {
+	return r.relayed
+}
+
+// relay submits the headers available at the source in batches.
+func (r *Relayer) relay(ctx context.Context) error {
+	if r.resync {
+		relayed, err := r.submitter.LatestHeight(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to get latest height of light client: %w", err)
+		}
+		r.relayed, r.resync = relayed, false
+	}
+
+	latest, err := r.latestHeight(ctx)
+	if err != nil {
+		return err
+	}
+
+	for r.relayed < latest {
+		from := r.relayed + 1
+		to := min(from+uint64(r.config.BatchSize)-1, latest) //nolint:gosec // batch size is positive
+		headers, err := r.source.GetHeaderRange(ctx, from, to)
+		if err != nil {
+			return fmt.Errorf("failed to get headers %d to %d: %w", from, to, err)
+		}
+		if len(headers) == 0 {
+			return fmt.Errorf("no headers returned from height %d", from)
+		}
+
+		batch := make([][]byte, len(headers))
+		for i, header := range headers {
+			if batch[i], err = proto.Marshal(header); err != nil {
+				return err
+			}
+		}
+		if err := r.submitter.SubmitHeaders(ctx, batch); err != nil {
+			return fmt.Errorf("failed to submit headers %d to %d: %w", from, from+uint64(len(batch))-1, err)
+		}
+
+		r.relayed = from + uint64(len(batch)) - 1
+		r.logger.Info().Uint64("fromHeight", from).Uint64("toHeight", r.relayed).Msg("relayed headers")
+	}
+	return nil
+}
+
+// latestHeight returns the height of the last header to relay.
+func (r *Relayer) latestHeight(ctx context.Context) (uint64, error) {
+	if r.config.DAIncludedOnly {
+		bz, err := r.source.GetMetadata(ctx, store.DAIncludedHeightKey)
+		if err != nil {
+			return 0, fmt.Errorf("failed to get DA included height: %w", err)
+		}
+		if len(bz) != 8 {
+			return 0, fmt.Errorf("invalid DA included height")
+		}
+		return binary.LittleEndian.Uint64(bz), nil
+	}
+
+	state, err := r.source.GetState(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("failed to get state: %w", err)
+	}
+	return state.LastBlockHeight, nil
+}
@@ -0,0 +1,120 @@
+package relayer
+
+import (
+	"context"
+	"encoding/binary"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/evstack/ev-node/pkg/store"
+	pb "github.com/evstack/ev-node/types/pb/evnode/v1"
+)
+
+// headerSource serves headers of heights 1 to height.
+type headerSource struct {
+	mu         sync.Mutex
+	height     uint64
+	daIncluded uint64
+}
+
+func (s *headerSource) GetState(context.Context) (*pb.State, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return &pb.State{LastBlockHeight: s.height}, nil
+}
+
+func (s *headerSource) GetMetadata(_ context.Context, key string) ([]byte, error) {
+	if key != store.DAIncludedHeightKey {
+		return nil, errors.New("not found")
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	bz := make([]byte, 8)
+	binary.LittleEndian.PutUint64(bz, s.daIncluded)
+	return bz, nil
+}
+
+func (s *headerSource) GetHeaderRange(_ context.Context, from, to uint64) ([]*pb.SignedHeader, error) {
+	var headers []*pb.SignedHeader
+	for height := from; height <= to; height++ {
+		headers = append(headers, &pb.SignedHeader{Header: &pb.Header{Height: height}})
+	}
+	return headers, nil
+}
+
+// submitter records the heights of the submitted headers, failing the first failures submissions.
+type submitter struct {
+	mu       sync.Mutex
+	latest   uint64
+	failures int
+	batches  [][]uint64
+}
+
+func (s *submitter) LatestHeight(context.Context) (uint64, error) {
+	return s.latest, nil
+}
+
+func (s *submitter) SubmitHeaders(_ context.Context, headers [][]byte) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.failures > 0 {
+		s.failures--
+		return errors.New("transaction reverted")
+	}
+	var batch []uint64
+	for _, bz := range headers {
+		var header pb.SignedHeader
+		if err := proto.Unmarshal(bz, &header); err != nil {
+			return err
+		}
+		batch = append(batch, header.Header.Height)
+	}
+	s.batches = append(s.batches, batch)
+	return nil
+}
+
+func (s *submitter) submitted() [][]uint64 {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return append([][]uint64(nil), s.batches...)
+}
+
+func TestRelayer(t *testing.T) {
+	source := &headerSource{height: 5}
+	sub := &submitter{latest: 1, failures: 1}
+	r := NewRelayer(source, sub, Config{BatchSize: 3, PollInterval: 10 * time.Millisecond}, zerolog.Nop())
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan error)
+	go func() { done <- r.Run(ctx) }()
+
+	// relaying resumes after the latest height of the light client, and retries failures
+	require.Eventually(t, func() bool { return len(sub.submitted()) == 2 }, time.Second, 5*time.Millisecond)
+	assert.Equal(t, [][]uint64{{2, 3, 4}, {5}}, sub.submitted())
+
+	source.mu.Lock()
+	source.height = 6
+	source.mu.Unlock()
+	require.Eventually(t, func() bool { return len(sub.submitted()) == 3 }, time.Second, 5*time.Millisecond)
+	assert.Equal(t, []uint64{6}, sub.submitted()[2])
+
+	cancel()
+	require.NoError(t, <-done)
+	assert.Equal(t, uint64(6), r.RelayedHeight())
+}
+
+func TestRelayer_DAIncludedOnly(t *testing.T) {
+	source := &headerSource{height: 5, daIncluded: 2}
+	sub := &submitter{}
+	r := NewRelayer(source, sub, Config{BatchSize: 10, DAIncludedOnly: true}, zerolog.Nop())
+
+	require.NoError(t, r.relay(context.Background()))
+	assert.Equal(t, [][]uint64{{1, 2}}, sub.submitted())
+}
@@ -0,0 +1,96 @@
+package client
+
+import (
+	"encoding/hex"
+	"strconv"
+
+	lru "github.com/hashicorp/golang-lru/v2"
+	"google.golang.org/protobuf/proto"
+
+	pb "github.com/evstack/ev-node/types/pb/evnode/v1"
+)
+
+// WithCache caches up to entries responses of the node which no longer change: the blocks by
+// hash and the headers by height once included on DA, and the headers of GetHeaderRange by
+// height once included on DA too. It speeds up tools reading the same blocks repeatedly, e.g. explorers and indexers.
+//
+// Blocks are assumed to never be rolled back: a client of a node whose chain was rolled back
+// may return the blocks before the rollback.
+func WithCache(entries int) Option {
+	return func(o *options) {
+		o.cacheEntries = entries
+	}
+}
+
+// responseCache is a bounded cache of responses, evicting the least recently used ones. A nil
+// responseCache caches nothing.
+type responseCache struct {
+	lru *lru.Cache[string, proto.Message]
+}
+
+// newResponseCache creates a cache of entries responses, nil if entries is not positive.
+func newResponseCache(entries int) *responseCache {
+	if entries <= 0 {
+		return nil
+	}
+	cache, _ := lru.New[string, proto.Message](entries)
+	return &responseCache{lru: cache}
+}
+
+// cached returns a copy of the cached response of key, so that callers may modify it.
+func cached[T proto.Message](c *responseCache, key string) (T, bool) {
+	var zero T
+	if c == nil {
+		return zero, false
+	}
+	msg, ok := c.lru.Get(key)
+	if !ok {
+		return zero, false
+	}
+	return proto.Clone(msg).(T), true
+}
+
+// add caches a copy of the response of key.
+func (c *responseCache) add(key string, msg proto.Message) {
+	if c == nil {
+		return
+	}
+	c.lru.Add(key, proto.Clone(msg))
+}
+
+// cachedHeaderRange returns the headers of the range if they are all cached, in which case they
+// all exist.
+func (c *Client) cachedHeaderRange(from, to uint64) ([]*pb.SignedHeader, bool) {
+	if c.cache == nil || from == 0 || to < from {
+		return nil, false
+	}
+	var headers []*pb.SignedHeader
+	for height := from; ; height++ {
+		header, ok := cached[*pb.SignedHeader](c.cache, signedHeaderKey(height))
+		if !ok {
+			return nil, false
+		}
+		headers = append(headers, header)
+		if height == to {
+			return headers, true
+		}
+	}
+}
+
+func blockByHashKey(hash []byte) string {
+	return "block/" + hex.EncodeToString(hash)
+}
+
+func headerKey(height uint64) string {
+	return "header/" + strconv.FormatUint(height, 10)
+}
+
+func signedHeaderKey(height uint64) string {
+	return "signed-header/" + strconv.FormatUint(height, 10)
+}
+
+// blockIsFinal returns whether the DA heights of the block response are final, i.e. the header
+// and the data, unless empty and thus not submitted, are included on DA.
+func blockIsFinal(resp *pb.GetBlockResponse) bool {
+	return resp.HeaderDaHeight != 0 && (resp.DataDaHeight != 0 || len(resp.GetBlock().GetData().GetTxs()) == 0)
+}
@@ -0,0 +1,92 @@
+package client
+
+import (
+	"context"
+	"encoding/binary"
+	"testing"
+
+	ds "github.com/ipfs/go-datastore"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/evstack/ev-node/test/mocks"
+	"github.com/evstack/ev-node/types"
+)
+
+func TestClientWithCache(t *testing.T) {
+	mockStore := mocks.NewMockStore(t)
+	daHeight := make([]byte, 8)
+	binary.LittleEndian.PutUint64(daHeight, 42)
+	mockStore.On("GetMetadata", mock.Anything, mock.Anything).Return(daHeight, nil)
+
+	// every block is read from the store once
+	hash := []byte("block_hash")
+	header, data := types.GetRandomBlock(3, 2, "test-chain")
+	mockStore.On("GetBlockByHash", mock.Anything, hash).Return(header, data, nil).Once()
+	mockStore.On("GetHeader", mock.Anything, uint64(3)).Return(header, nil).Once()
+	mockStore.On("Height", mock.Anything).Return(uint64(5), nil).Once()
+	for height := uint64(1); height <= 5; height++ {
+		header := &types.SignedHeader{Header: types.Header{BaseHeader: types.BaseHeader{Height: height}}}
+		mockStore.On("GetHeader", mock.Anything, height).Return(header, nil).Once()
+	}
+
+	testServer, _ := setupTestServer(t, mockStore, mocks.NewMockP2PRPC(t))
+	defer testServer.Close()
+	client := NewClient(testServer.URL, WithCache(100))
+	ctx := context.Background()
+
+	for range 2 {
+		block, err := client.GetBlockByHash(ctx, hash)
+		require.NoError(t, err)
+		require.Equal(t, uint64(42), block.HeaderDaHeight)
+		require.Len(t, block.Block.Data.Txs, 2)
+		// the cached response is not modified by the caller
+		block.Block.Data.Txs = nil
+
+		resp, err := client.GetHeader(ctx, 3)
+		require.NoError(t, err)
+		require.Equal(t, uint64(3), resp.Header.Header.Height)
+
+		headers, err := client.GetHeaderRange(ctx, 1, 5)
+		require.NoError(t, err)
+		require.Len(t, headers, 5)
+	}
+
+	// a subrange is served from the cache
+	headers, err := client.GetHeaderRange(ctx, 2, 4)
+	require.NoError(t, err)
+	require.Len(t, headers, 3)
+	require.Equal(t, uint64(2), headers[0].Header.Height)
+	mockStore.AssertExpectations(t)
+}
+
+func TestClientWithCache_NotFinal(t *testing.T) {
+	mockStore := mocks.NewMockStore(t)
+	mockStore.On("GetMetadata", mock.Anything, mock.Anything).Return(nil, ds.ErrNotFound).Maybe()
+
+	// the block is not included on DA yet, so it is read again
+	hash := []byte("block_hash")
+	header, data := types.GetRandomBlock(3, 2, "test-chain")
+	mockStore.On("GetBlockByHash", mock.Anything, hash).Return(header, data, nil).Twice()
+	// as are the headers of a range
+	mockStore.On("Height", mock.Anything).Return(uint64(2), nil).Twice()
+	for height := uint64(1); height <= 2; height++ {
+		header := &types.SignedHeader{Header: types.Header{BaseHeader: types.BaseHeader{Height: height}}}
+		mockStore.On("GetHeader", mock.Anything, height).Return(header, nil).Twice()
+	}
+
+	testServer, _ := setupTestServer(t, mockStore, mocks.NewMockP2PRPC(t))
+	defer testServer.Close()
+	client := NewClient(testServer.URL, WithCache(100))
+
+	for range 2 {
+		block, err := client.GetBlockByHash(context.Background(), hash)
+		require.NoError(t, err)
+		require.Zero(t, block.HeaderDaHeight)
+
+		headers, err := client.GetHeaderRange(context.Background(), 1, 2)
+		require.NoError(t, err)
+		require.Len(t, headers, 2)
+	}
+	mockStore.AssertExpectations(t)
+}
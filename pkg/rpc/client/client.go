@@ -2,36 +2,164 @@ package client
 
 import (
 	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"net"
 	"net/http"
+	"time"
 
 	"connectrpc.com/connect"
+	"google.golang.org/protobuf/types/known/durationpb"
 	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
 
+	"github.com/evstack/ev-node/pkg/buildinfo"
 	pb "github.com/evstack/ev-node/types/pb/evnode/v1"
 	rpc "github.com/evstack/ev-node/types/pb/evnode/v1/v1connect"
 )
 
-// Client is the client for StoreService, P2PService, HealthService, and ConfigService
+// Client is the client for StoreService, P2PService, HealthService, ConfigService, FeeService
+// and AdminService
 type Client struct {
 	storeClient  rpc.StoreServiceClient
 	p2pClient    rpc.P2PServiceClient
 	healthClient rpc.HealthServiceClient
 	configClient rpc.ConfigServiceClient
+	feeClient    rpc.FeeServiceClient
+	adminClient  rpc.AdminServiceClient
+
+	// cache is nil unless enabled by WithCache
+	cache *responseCache
+}
+
+// Option configures a Client.
+type Option func(*options)
+
+type options struct {
+	tokenSource func(ctx context.Context) (string, error)
+	unixSocket  string
+	dialer      func(ctx context.Context, network, addr string) (net.Conn, error)
+	transport   http.RoundTripper
+	tlsConfig   *tls.Config
+	rootCAs     *x509.CertPool
+	retryPolicy *RetryPolicy
+
+	dialTimeout          time.Duration
+	readTimeout          time.Duration
+	keepaliveInterval    time.Duration
+	keepaliveTimeout     time.Duration
+	maxConcurrentStreams int
+
+	failoverURLs     []string
+	loadBalanceReads bool
+
+	cacheEntries int
+	metrics      *Metrics
+}
+
+// WithBearerToken authenticates the requests of the client with a bearer token, i.e. the
+// static token or a JWT accepted by the RPCs protected by the node. Requests are not authenticated
+// if token is empty.
+func WithBearerToken(token string) Option {
+	return WithTokenSource(func(context.Context) (string, error) {
+		return token, nil
+	})
+}
+
+// WithTokenSource authenticates every request of the client with the bearer token returned by
+// source for the context of the request, e.g. a JWT signed for each request or refreshed before
+// it expires. A request fails with the error of source, if any, without being sent, and is sent
+// without authentication if source returns an empty token.
+func WithTokenSource(source func(ctx context.Context) (string, error)) Option {
+	return func(o *options) {
+		o.tokenSource = source
+	}
+}
+
+// WithTLSConfig sets the TLS configuration of the connections to https URLs, e.g. to present a
+// client certificate.
+func WithTLSConfig(config *tls.Config) Option {
+	return func(o *options) {
+		o.tlsConfig = config
+	}
+}
+
+// WithRootCAs sets the certificate authorities trusted to verify the certificate of the node,
+// instead of the ones of the system, e.g. for nodes serving a certificate of a private CA.
+func WithRootCAs(pool *x509.CertPool) Option {
+	return func(o *options) {
+		o.rootCAs = pool
+	}
+}
+
+// WithUnixSocket connects the client to the unix socket of the node at path, see the
+// rpc.unix_socket configuration. The host of the base URL is then ignored, e.g. http://localhost.
+func WithUnixSocket(path string) Option {
+	return func(o *options) {
+		o.unixSocket = path
+	}
+}
+
+// bearerTokenClient sets the Authorization header of the requests it sends.
+type bearerTokenClient struct {
+	next  connect.HTTPClient
+	token func(ctx context.Context) (string, error)
+}
+
+func (c *bearerTokenClient) Do(req *http.Request) (*http.Response, error) {
+	token, err := c.token(req.Context())
+	if err != nil {
+		return nil, fmt.Errorf("failed to get bearer token: %w", err)
+	}
+	if token != "" {
+		req.Header.Set("Authorization", "Bearer "+token)
+	}
+	return c.next.Do(req)
 }
 
 // NewClient creates a new RPC client
-func NewClient(baseURL string) *Client {
-	httpClient := http.DefaultClient
-	storeClient := rpc.NewStoreServiceClient(httpClient, baseURL, connect.WithGRPC())
-	p2pClient := rpc.NewP2PServiceClient(httpClient, baseURL, connect.WithGRPC())
-	healthClient := rpc.NewHealthServiceClient(httpClient, baseURL, connect.WithGRPC())
-	configClient := rpc.NewConfigServiceClient(httpClient, baseURL, connect.WithGRPC())
+func NewClient(baseURL string, opts ...Option) *Client {
+	var o options
+	for _, opt := range opts {
+		opt(&o)
+	}
+
+	httpClient := newHTTPClient(&o)
+	if o.tokenSource != nil {
+		httpClient = &bearerTokenClient{next: httpClient, token: o.tokenSource}
+	}
+	clientOpts := []connect.ClientOption{connect.WithGRPC()}
+	if o.metrics != nil {
+		// outermost, so that a retried request is observed once
+		clientOpts = append(clientOpts, connect.WithInterceptors(o.metrics.interceptor()))
+	}
+	if o.retryPolicy != nil {
+		clientOpts = append(clientOpts, connect.WithInterceptors(retryInterceptor(*o.retryPolicy)))
+	}
+	if o.readTimeout > 0 {
+		clientOpts = append(clientOpts, connect.WithInterceptors(readTimeoutInterceptor(o.readTimeout)))
+	}
+	if len(o.failoverURLs) > 0 {
+		// each attempt of a retried request fails over on its own
+		httpClient = newFailoverClient(httpClient, baseURL, o.failoverURLs, o.loadBalanceReads)
+		clientOpts = append(clientOpts, connect.WithInterceptors(readOnlyInterceptor()))
+	}
+	storeClient := rpc.NewStoreServiceClient(httpClient, baseURL, clientOpts...)
+	p2pClient := rpc.NewP2PServiceClient(httpClient, baseURL, clientOpts...)
+	healthClient := rpc.NewHealthServiceClient(httpClient, baseURL, clientOpts...)
+	configClient := rpc.NewConfigServiceClient(httpClient, baseURL, clientOpts...)
+	feeClient := rpc.NewFeeServiceClient(httpClient, baseURL, clientOpts...)
+	adminClient := rpc.NewAdminServiceClient(httpClient, baseURL, clientOpts...)
 
 	return &Client{
 		storeClient:  storeClient,
 		p2pClient:    p2pClient,
 		healthClient: healthClient,
 		configClient: configClient,
+		feeClient:    feeClient,
+		adminClient:  adminClient,
+		cache:        newResponseCache(o.cacheEntries),
 	}
 }
 
@@ -51,8 +179,35 @@ func (c *Client) GetBlockByHeight(ctx context.Context, height uint64) (*pb.GetBl
 	return resp.Msg, nil
 }
 
+// GetBlockStream retrieves the block at the given height, or the latest block if the height is 0,
+// in chunks of at most maxChunkSize bytes of transactions (0 for the server default). handle is
+// called with every chunk in order, the first one carrying the header of the block.
+func (c *Client) GetBlockStream(ctx context.Context, height, maxChunkSize uint64, handle func(*pb.GetBlockStreamResponse) error) error {
+	req := connect.NewRequest(&pb.GetBlockStreamRequest{
+		Identifier:   &pb.GetBlockStreamRequest_Height{Height: height},
+		MaxChunkSize: maxChunkSize,
+	})
+
+	stream, err := c.storeClient.GetBlockStream(ctx, req)
+	if err != nil {
+		return err
+	}
+	defer stream.Close()
+
+	for stream.Receive() {
+		if err := handle(stream.Msg()); err != nil {
+			return err
+		}
+	}
+	return stream.Err()
+}
+
 // GetBlockByHash returns the full GetBlockResponse for a block by hash
 func (c *Client) GetBlockByHash(ctx context.Context, hash []byte) (*pb.GetBlockResponse, error) {
+	if block, ok := cached[*pb.GetBlockResponse](c.cache, blockByHashKey(hash)); ok {
+		return block, nil
+	}
+
 	req := connect.NewRequest(&pb.GetBlockRequest{
 		Identifier: &pb.GetBlockRequest_Hash{
 			Hash: hash,
@@ -64,6 +219,9 @@ func (c *Client) GetBlockByHash(ctx context.Context, hash []byte) (*pb.GetBlockR
 		return nil, err
 	}
 
+	if blockIsFinal(resp.Msg) {
+		c.cache.add(blockByHashKey(hash), resp.Msg)
+	}
 	return resp.Msg, nil
 }
 
@@ -92,15 +250,275 @@ func (c *Client) GetMetadata(ctx context.Context, key string) ([]byte, error) {
 	return resp.Msg.Value, nil
 }
 
-// GetPeerInfo returns information about the connected peers
+// GetMetadataBatch returns the metadata of several keys in a single request, e.g. for tools
+// polling several keys. The entries are in the order of keys, with Found unset for the keys which
+// are not set.
+func (c *Client) GetMetadataBatch(ctx context.Context, keys []string) ([]*pb.MetadataEntry, error) {
+	req := connect.NewRequest(&pb.GetMetadataBatchRequest{
+		Keys: keys,
+	})
+
+	resp, err := c.storeClient.GetMetadataBatch(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	return resp.Msg.Entries, nil
+}
+
+// GetStateDiff returns the execution state changes made by the block at the given height
+func (c *Client) GetStateDiff(ctx context.Context, height uint64) (*pb.StateDiff, error) {
+	req := connect.NewRequest(&pb.GetStateDiffRequest{
+		Height: height,
+	})
+
+	resp, err := c.storeClient.GetStateDiff(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	return resp.Msg.Diff, nil
+}
+
+// GetSequencerFees returns the sequencing fees collected by the block at the given height,
+// or the latest block if height is 0
+func (c *Client) GetSequencerFees(ctx context.Context, height uint64) (*pb.SequencerFees, error) {
+	req := connect.NewRequest(&pb.GetSequencerFeesRequest{
+		Height: height,
+	})
+
+	resp, err := c.storeClient.GetSequencerFees(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	return resp.Msg.Fees, nil
+}
+
+// GetHeader returns the signed header of the block at the given height, or of the latest block if
+// height is 0, without the block data.
+func (c *Client) GetHeader(ctx context.Context, height uint64) (*pb.GetHeaderResponse, error) {
+	if height != 0 {
+		if header, ok := cached[*pb.GetHeaderResponse](c.cache, headerKey(height)); ok {
+			return header, nil
+		}
+	}
+
+	req := connect.NewRequest(&pb.GetHeaderRequest{
+		Height: height,
+	})
+
+	resp, err := c.storeClient.GetHeader(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	// the DA height of the header is set once it is included on DA
+	if height != 0 && resp.Msg.HeaderDaHeight != 0 {
+		c.cache.add(headerKey(height), resp.Msg)
+	}
+	return resp.Msg, nil
+}
+
+// GetHeaderRange returns the signed headers of the blocks from fromHeight to toHeight inclusive,
+// without the block data, fetching all the pages. Heights above the latest block are ignored.
+func (c *Client) GetHeaderRange(ctx context.Context, fromHeight, toHeight uint64) ([]*pb.SignedHeader, error) {
+	if headers, ok := c.cachedHeaderRange(fromHeight, toHeight); ok {
+		return headers, nil
+	}
+
+	var daIncluded uint64
+	headers, err := AllPages(ctx, 0, func(ctx context.Context, page *pb.PageRequest) ([]*pb.SignedHeader, *pb.PageResponse, error) {
+		resp, err := c.storeClient.GetHeaderRange(ctx, connect.NewRequest(&pb.GetHeaderRangeRequest{
+			FromHeight: fromHeight,
+			ToHeight:   toHeight,
+			Page:       page,
+		}))
+		if err != nil {
+			return nil, nil, err
+		}
+		daIncluded = max(daIncluded, resp.Msg.DaIncludedHeight)
+		return resp.Msg.Headers, resp.Msg.Page, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	// as for GetHeader, only the headers included on DA are cached
+	for _, header := range headers {
+		if height := header.GetHeader().GetHeight(); height <= daIncluded {
+			c.cache.add(signedHeaderKey(height), header)
+		}
+	}
+	return headers, nil
+}
+
+// SearchBlocks returns the blocks matching the predicates of the request. The search continues
+// with the next_page_token of the page of the response if it is not empty.
+func (c *Client) SearchBlocks(ctx context.Context, req *pb.SearchBlocksRequest) (*pb.SearchBlocksResponse, error) {
+	resp, err := c.storeClient.SearchBlocks(ctx, connect.NewRequest(req))
+	if err != nil {
+		return nil, err
+	}
+
+	return resp.Msg, nil
+}
+
+// GetTxStatus returns whether the transaction with the given SHA-256 hash of its raw bytes is
+// pending in the sequencer or included in a block, with the block height and DA inclusion.
+func (c *Client) GetTxStatus(ctx context.Context, txHash []byte) (*pb.GetTxStatusResponse, error) {
+	req := connect.NewRequest(&pb.GetTxStatusRequest{
+		TxHash: txHash,
+	})
+
+	resp, err := c.storeClient.GetTxStatus(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	return resp.Msg, nil
+}
+
+// WaitForTxInclusion is like GetTxStatus but the node only responds once the transaction is
+// included in a block, or after timeout with its status at that time. The node caps the timeout
+// to one minute.
+func (c *Client) WaitForTxInclusion(ctx context.Context, txHash []byte, timeout time.Duration) (*pb.GetTxStatusResponse, error) {
+	req := connect.NewRequest(&pb.GetTxStatusRequest{
+		TxHash:           txHash,
+		WaitForInclusion: durationpb.New(timeout),
+	})
+
+	resp, err := c.storeClient.GetTxStatus(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	return resp.Msg, nil
+}
+
+// GetBlockByTxHash returns the latest block including the transaction with the given SHA-256
+// hash of its raw bytes, and the index of the transaction in its data.
+func (c *Client) GetBlockByTxHash(ctx context.Context, txHash []byte) (*pb.GetBlockByTxHashResponse, error) {
+	req := connect.NewRequest(&pb.GetBlockByTxHashRequest{
+		TxHash: txHash,
+	})
+
+	resp, err := c.storeClient.GetBlockByTxHash(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	return resp.Msg, nil
+}
+
+// GetTxProof returns the proof that the transaction with the given SHA-256 hash of its raw bytes
+// is included in a block: the signed header of the block and its transactions, which
+// types.TxProof verifies against the data hash of the header.
+func (c *Client) GetTxProof(ctx context.Context, txHash []byte) (*pb.GetTxProofResponse, error) {
+	req := connect.NewRequest(&pb.GetTxProofRequest{
+		TxHash: txHash,
+	})
+
+	resp, err := c.storeClient.GetTxProof(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	return resp.Msg, nil
+}
+
+// GetSyncStatus returns the sync progress of the node: its height, the network and DA heights
+// and the number of headers and data applied since it started, by sync source.
+func (c *Client) GetSyncStatus(ctx context.Context) (*pb.GetSyncStatusResponse, error) {
+	resp, err := c.storeClient.GetSyncStatus(ctx, connect.NewRequest(&emptypb.Empty{}))
+	if err != nil {
+		return nil, err
+	}
+
+	return resp.Msg, nil
+}
+
+// GetDAInclusionProof returns the DA blobs containing the header and data of the block at the
+// given height, with their commitments and inclusion proofs.
+func (c *Client) GetDAInclusionProof(ctx context.Context, height uint64) (*pb.GetDAInclusionProofResponse, error) {
+	req := connect.NewRequest(&pb.GetDAInclusionProofRequest{
+		Height: height,
+	})
+
+	resp, err := c.storeClient.GetDAInclusionProof(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	return resp.Msg, nil
+}
+
+// GetDAInfo returns the DA layer of the node: its backend, network, namespaces, max blob size and
+// the DA heights it has reached.
+func (c *Client) GetDAInfo(ctx context.Context) (*pb.GetDAInfoResponse, error) {
+	resp, err := c.storeClient.GetDAInfo(ctx, connect.NewRequest(&emptypb.Empty{}))
+	if err != nil {
+		return nil, err
+	}
+
+	return resp.Msg, nil
+}
+
+// GetExecutionConsistency returns the execution blocks of the count latest heights, 10 if 0, and
+// whether they are consistent with the store of the node.
+func (c *Client) GetExecutionConsistency(ctx context.Context, count uint32) (*pb.GetExecutionConsistencyResponse, error) {
+	req := connect.NewRequest(&pb.GetExecutionConsistencyRequest{
+		Count: count,
+	})
+
+	resp, err := c.storeClient.GetExecutionConsistency(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	return resp.Msg, nil
+}
+
+// GetEvents returns the node events recorded in [from, to) whose type is one of types, fetching
+// all the pages.
+// A zero from or to leaves the range open on that side, and no types matches all events.
+func (c *Client) GetEvents(ctx context.Context, from, to time.Time, types ...string) ([]*pb.Event, error) {
+	msg := &pb.GetEventsRequest{Types: types}
+	if !from.IsZero() {
+		msg.From = timestamppb.New(from)
+	}
+	if !to.IsZero() {
+		msg.To = timestamppb.New(to)
+	}
+
+	return AllPages(ctx, 0, func(ctx context.Context, page *pb.PageRequest) ([]*pb.Event, *pb.PageResponse, error) {
+		msg.Page = page
+		resp, err := c.storeClient.GetEvents(ctx, connect.NewRequest(msg))
+		if err != nil {
+			return nil, nil, err
+		}
+		return resp.Msg.Events, resp.Msg.Page, nil
+	})
+}
+
+// GetPeerInfo returns information about the connected peers, fetching all the pages
 func (c *Client) GetPeerInfo(ctx context.Context) ([]*pb.PeerInfo, error) {
-	req := connect.NewRequest(&emptypb.Empty{})
-	resp, err := c.p2pClient.GetPeerInfo(ctx, req)
+	return AllPages(ctx, 0, func(ctx context.Context, page *pb.PageRequest) ([]*pb.PeerInfo, *pb.PageResponse, error) {
+		resp, err := c.GetPeerInfoPage(ctx, &pb.GetPeerInfoRequest{Page: page})
+		if err != nil {
+			return nil, nil, err
+		}
+		return resp.Peers, resp.Page, nil
+	})
+}
+
+// GetPeerInfoPage returns a page of information about the connected peers
+func (c *Client) GetPeerInfoPage(ctx context.Context, req *pb.GetPeerInfoRequest) (*pb.GetPeerInfoResponse, error) {
+	resp, err := c.p2pClient.GetPeerInfo(ctx, connect.NewRequest(req))
 	if err != nil {
 		return nil, err
 	}
 
-	return resp.Msg.Peers, nil
+	return resp.Msg, nil
 }
 
 // GetNetInfo returns information about the network
@@ -124,6 +542,37 @@ func (c *Client) GetHealth(ctx context.Context) (pb.HealthStatus, error) {
 	return resp.Msg.Status, nil
 }
 
+// GetReadiness calls the HealthService.Readyz endpoint and returns the readiness of the node
+// with the results of its readiness checks
+func (c *Client) GetReadiness(ctx context.Context) (*pb.ReadyzResponse, error) {
+	req := connect.NewRequest(&emptypb.Empty{})
+	resp, err := c.healthClient.Readyz(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	return resp.Msg, nil
+}
+
+// GetAlerts returns the current state of the alert rules evaluated by the node
+func (c *Client) GetAlerts(ctx context.Context) ([]*pb.Alert, error) {
+	req := connect.NewRequest(&emptypb.Empty{})
+	resp, err := c.healthClient.GetAlerts(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	return resp.Msg.Alerts, nil
+}
+
+// GetErrors returns the most recent error of every subsystem of the node which reported one
+func (c *Client) GetErrors(ctx context.Context) ([]*pb.SubsystemError, error) {
+	req := connect.NewRequest(&emptypb.Empty{})
+	resp, err := c.healthClient.GetErrors(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	return resp.Msg.Errors, nil
+}
+
 // GetNamespace returns the namespace configuration for this network
 func (c *Client) GetNamespace(ctx context.Context) (*pb.GetNamespaceResponse, error) {
 	req := connect.NewRequest(&emptypb.Empty{})
@@ -133,3 +582,126 @@ func (c *Client) GetNamespace(ctx context.Context) (*pb.GetNamespaceResponse, er
 	}
 	return resp.Msg, nil
 }
+
+// ValidateConfig checks a YAML configuration file against the options supported by the node
+func (c *Client) ValidateConfig(ctx context.Context, cfg []byte) (*pb.ValidateConfigResponse, error) {
+	req := connect.NewRequest(&pb.ValidateConfigRequest{
+		Config: cfg,
+	})
+	resp, err := c.configClient.ValidateConfig(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	return resp.Msg, nil
+}
+
+// GetNodeInfo returns the version, chain and components of the node
+func (c *Client) GetNodeInfo(ctx context.Context) (*pb.GetNodeInfoResponse, error) {
+	req := connect.NewRequest(&emptypb.Empty{})
+	resp, err := c.configClient.GetNodeInfo(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	return resp.Msg, nil
+}
+
+// GetGenesis returns the genesis document of the network of the node, to bootstrap new nodes
+func (c *Client) GetGenesis(ctx context.Context) (*pb.GetGenesisResponse, error) {
+	req := connect.NewRequest(&emptypb.Empty{})
+	resp, err := c.configClient.GetGenesis(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	return resp.Msg, nil
+}
+
+// VerifyBuild checks that the node runs the build with the given digest, e.g. the audited build
+// of the network. It fails with buildinfo.ErrDigestMismatch if the node reports another build, and
+// with buildinfo.ErrDirtyBuild if it was built from modified sources. The build is self-reported,
+// so this catches misconfigured nodes, not dishonest ones.
+func (c *Client) VerifyBuild(ctx context.Context, digest string) error {
+	info, err := c.GetNodeInfo(ctx)
+	if err != nil {
+		return err
+	}
+	return buildinfo.Verify(info.GetBuildInfo(), digest)
+}
+
+// EstimateTxFee returns the estimated execution and DA cost of a raw transaction
+func (c *Client) EstimateTxFee(ctx context.Context, tx []byte) (*pb.EstimateTxFeeResponse, error) {
+	req := connect.NewRequest(&pb.EstimateTxFeeRequest{
+		Tx: tx,
+	})
+	resp, err := c.feeClient.EstimateTxFee(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	return resp.Msg, nil
+}
+
+// Shutdown stops the node gracefully. The admin RPCs require a client authenticated with
+// WithBearerToken.
+func (c *Client) Shutdown(ctx context.Context) error {
+	_, err := c.adminClient.Shutdown(ctx, connect.NewRequest(&emptypb.Empty{}))
+	return err
+}
+
+// SetLogLevel changes the log level of the node and returns the previous level
+func (c *Client) SetLogLevel(ctx context.Context, level string) (string, error) {
+	req := connect.NewRequest(&pb.SetLogLevelRequest{
+		Level: level,
+	})
+	resp, err := c.adminClient.SetLogLevel(ctx, req)
+	if err != nil {
+		return "", err
+	}
+	return resp.Msg.PreviousLevel, nil
+}
+
+// TriggerDASubmission makes an aggregator submit the headers and data pending DA without waiting
+// for the next DA block time
+func (c *Client) TriggerDASubmission(ctx context.Context) (*pb.TriggerDASubmissionResponse, error) {
+	resp, err := c.adminClient.TriggerDASubmission(ctx, connect.NewRequest(&emptypb.Empty{}))
+	if err != nil {
+		return nil, err
+	}
+	return resp.Msg, nil
+}
+
+// DisconnectPeer closes the connections of the node to a peer, banning it if ban is set
+func (c *Client) DisconnectPeer(ctx context.Context, peerID string, ban bool) error {
+	req := connect.NewRequest(&pb.DisconnectPeerRequest{
+		PeerId: peerID,
+		Ban:    ban,
+	})
+	_, err := c.adminClient.DisconnectPeer(ctx, req)
+	return err
+}
+
+// CompactStore reclaims the disk space of the data deleted from the store of the node
+func (c *Client) CompactStore(ctx context.Context) error {
+	_, err := c.adminClient.CompactStore(ctx, connect.NewRequest(&emptypb.Empty{}))
+	return err
+}
+
+// SetMetadata saves a value of application metadata on the node, under a namespace registered by
+// the application. The value is readable with GetMetadata at the key app/<namespace>/<key>.
+func (c *Client) SetMetadata(ctx context.Context, namespace, key string, value []byte) error {
+	_, err := c.adminClient.SetMetadata(ctx, connect.NewRequest(&pb.SetMetadataRequest{
+		Namespace: namespace,
+		Key:       key,
+		Value:     value,
+	}))
+	return err
+}
+
+// Drain drains the RPC server of the node, giving its in-flight requests up to timeout to complete,
+// then stops the node. A zero timeout uses the drain timeout configured on the node.
+func (c *Client) Drain(ctx context.Context, timeout time.Duration) error {
+	req := &pb.DrainRequest{}
+	if timeout > 0 {
+		req.Timeout = durationpb.New(timeout)
+	}
+	_, err := c.adminClient.Drain(ctx, connect.NewRequest(req))
+	return err
+}
@@ -1,12 +1,23 @@
 package client
 
 import (
+	"bytes"
 	"context"
+	"crypto/sha256"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"net"
 	"net/http"
 	"net/http/httptest"
+	"path/filepath"
 	"testing"
 	"time"
 
+	"connectrpc.com/connect"
+	ds "github.com/ipfs/go-datastore"
 	"github.com/libp2p/go-libp2p/core/peer"
 	"github.com/multiformats/go-multiaddr"
 	"github.com/rs/zerolog"
@@ -14,12 +25,20 @@ import (
 	"github.com/stretchr/testify/require"
 	"golang.org/x/net/http2"
 	"golang.org/x/net/http2/h2c"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
 
+	coreda "github.com/evstack/ev-node/core/da"
+	coreexecution "github.com/evstack/ev-node/core/execution"
+	"github.com/evstack/ev-node/pkg/buildinfo"
 	"github.com/evstack/ev-node/pkg/config"
+	"github.com/evstack/ev-node/pkg/journal"
 	"github.com/evstack/ev-node/pkg/p2p"
 	"github.com/evstack/ev-node/pkg/rpc/server"
+	"github.com/evstack/ev-node/pkg/store"
 	"github.com/evstack/ev-node/test/mocks"
 	"github.com/evstack/ev-node/types"
+	pb "github.com/evstack/ev-node/types/pb/evnode/v1"
 	rpc "github.com/evstack/ev-node/types/pb/evnode/v1/v1connect"
 )
 
@@ -32,7 +51,7 @@ func setupTestServer(t *testing.T, mockStore *mocks.MockStore, mockP2P *mocks.Mo
 	logger := zerolog.Nop()
 	storeServer := server.NewStoreServer(mockStore, logger)
 	p2pServer := server.NewP2PServer(mockP2P)
-	healthServer := server.NewHealthServer()
+	healthServer := server.NewHealthServer(nil)
 
 	// Create config server with test config
 	testConfig := config.DefaultConfig
@@ -55,6 +74,10 @@ func setupTestServer(t *testing.T, mockStore *mocks.MockStore, mockP2P *mocks.Mo
 	configPath, configHandler := rpc.NewConfigServiceHandler(configServer)
 	mux.Handle(configPath, configHandler)
 
+	// Register the fee service
+	feePath, feeHandler := rpc.NewFeeServiceHandler(server.NewFeeServer(server.StaticExecutor(mocks.NewMockExecutor(t)), nil, logger))
+	mux.Handle(feePath, feeHandler)
+
 	// Create an HTTP server with h2c for HTTP/2 support
 	testServer := httptest.NewServer(h2c.NewHandler(mux, &http2.Server{}))
 
@@ -121,6 +144,83 @@ func TestClientGetMetadata(t *testing.T) {
 	mockStore.AssertExpectations(t)
 }
 
+func TestClientGetMetadataBatch(t *testing.T) {
+	mockStore := mocks.NewMockStore(t)
+	mockStore.On("GetMetadata", mock.Anything, "a").Return([]byte("1"), nil)
+	mockStore.On("GetMetadata", mock.Anything, "b").Return(nil, ds.ErrNotFound)
+	testServer, client := setupTestServer(t, mockStore, mocks.NewMockP2PRPC(t))
+	defer testServer.Close()
+
+	entries, err := client.GetMetadataBatch(context.Background(), []string{"a", "b"})
+	require.NoError(t, err)
+	require.Len(t, entries, 2)
+	require.True(t, entries[0].Found)
+	require.Equal(t, []byte("1"), entries[0].Value)
+	require.False(t, entries[1].Found)
+}
+
+func TestClientGetStateDiff(t *testing.T) {
+	mockStore := mocks.NewMockStore(t)
+	mockP2P := mocks.NewMockP2PRPC(t)
+
+	diff := &pb.StateDiff{Height: 3, Changes: []*pb.StateChange{{Key: []byte("k"), Value: []byte("v")}}}
+	bz, err := proto.Marshal(diff)
+	require.NoError(t, err)
+	mockStore.On("GetMetadata", mock.Anything, fmt.Sprintf("%s/%d", store.StateDiffKey, 3)).Return(bz, nil)
+
+	testServer, client := setupTestServer(t, mockStore, mockP2P)
+	defer testServer.Close()
+
+	result, err := client.GetStateDiff(context.Background(), 3)
+	require.NoError(t, err)
+	require.True(t, proto.Equal(diff, result))
+	mockStore.AssertExpectations(t)
+}
+
+func TestClientGetSequencerFees(t *testing.T) {
+	mockStore := mocks.NewMockStore(t)
+	mockP2P := mocks.NewMockP2PRPC(t)
+
+	fees := &pb.SequencerFees{Height: 3, Recipient: []byte("coinbase"), Collected: "21000", TotalCollected: "21000", RecipientBalance: "21000"}
+	bz, err := proto.Marshal(fees)
+	require.NoError(t, err)
+	mockStore.On("GetMetadata", mock.Anything, fmt.Sprintf("%s/%d", store.SequencerFeesKey, 3)).Return(bz, nil)
+
+	testServer, client := setupTestServer(t, mockStore, mockP2P)
+	defer testServer.Close()
+
+	result, err := client.GetSequencerFees(context.Background(), 3)
+	require.NoError(t, err)
+	require.True(t, proto.Equal(fees, result))
+	mockStore.AssertExpectations(t)
+}
+
+func TestClientGetEvents(t *testing.T) {
+	mockStore := mocks.NewMockStore(t)
+	mockP2P := mocks.NewMockP2PRPC(t)
+
+	event := &pb.Event{Sequence: 1, Time: timestamppb.Now(), Type: journal.EventNodeStarted, Message: "node started"}
+	bz, err := proto.Marshal(event)
+	require.NoError(t, err)
+	last := make([]byte, 8)
+	binary.LittleEndian.PutUint64(last, 1)
+	mockStore.On("GetMetadata", mock.Anything, "ej-last").Return(last, nil)
+	mockStore.On("GetMetadata", mock.Anything, "/ej/1").Return(bz, nil)
+
+	testServer, client := setupTestServer(t, mockStore, mockP2P)
+	defer testServer.Close()
+
+	events, err := client.GetEvents(context.Background(), time.Time{}, time.Time{}, journal.EventNodeStarted)
+	require.NoError(t, err)
+	require.Len(t, events, 1)
+	require.True(t, proto.Equal(event, events[0]))
+
+	events, err = client.GetEvents(context.Background(), time.Time{}, time.Time{}, journal.EventRollback)
+	require.NoError(t, err)
+	require.Empty(t, events)
+	mockStore.AssertExpectations(t)
+}
+
 func TestClientGetBlockByHeight(t *testing.T) {
 	// Create mocks
 	mockStore := mocks.NewMockStore(t)
@@ -147,6 +247,427 @@ func TestClientGetBlockByHeight(t *testing.T) {
 	mockStore.AssertExpectations(t)
 }
 
+func TestClientGetHeader(t *testing.T) {
+	mockStore := mocks.NewMockStore(t)
+	mockP2P := mocks.NewMockP2PRPC(t)
+
+	header := &types.SignedHeader{Header: types.Header{BaseHeader: types.BaseHeader{Height: 10}}}
+	mockStore.On("GetHeader", mock.Anything, uint64(10)).Return(header, nil)
+	mockStore.On("GetMetadata", mock.Anything, fmt.Sprintf("%s/%d/h", store.HeightToDAHeightKey, 10)).Return(nil, ds.ErrNotFound)
+
+	testServer, client := setupTestServer(t, mockStore, mockP2P)
+	defer testServer.Close()
+
+	resp, err := client.GetHeader(context.Background(), 10)
+	require.NoError(t, err)
+	require.Equal(t, uint64(10), resp.Header.Header.Height)
+	require.Zero(t, resp.HeaderDaHeight)
+	mockStore.AssertExpectations(t)
+}
+
+func TestClientGetHeaderRange(t *testing.T) {
+	mockStore := mocks.NewMockStore(t)
+	mockP2P := mocks.NewMockP2PRPC(t)
+
+	mockStore.On("Height", mock.Anything).Return(uint64(2), nil)
+	mockStore.On("GetMetadata", mock.Anything, store.DAIncludedHeightKey).Return(nil, ds.ErrNotFound)
+	for height := uint64(1); height <= 2; height++ {
+		header := &types.SignedHeader{Header: types.Header{BaseHeader: types.BaseHeader{Height: height}}}
+		mockStore.On("GetHeader", mock.Anything, height).Return(header, nil)
+	}
+
+	testServer, client := setupTestServer(t, mockStore, mockP2P)
+	defer testServer.Close()
+
+	headers, err := client.GetHeaderRange(context.Background(), 1, 2)
+	require.NoError(t, err)
+	require.Len(t, headers, 2)
+	require.Equal(t, uint64(2), headers[1].Header.Height)
+	mockStore.AssertExpectations(t)
+}
+
+func TestClientGetHeaderRange_Pages(t *testing.T) {
+	mockStore := mocks.NewMockStore(t)
+	mockP2P := mocks.NewMockP2PRPC(t)
+
+	// the range spans several pages
+	const latest = 2500
+	mockStore.On("Height", mock.Anything).Return(uint64(latest), nil)
+	mockStore.On("GetMetadata", mock.Anything, store.DAIncludedHeightKey).Return(nil, ds.ErrNotFound)
+	mockStore.On("GetHeader", mock.Anything, mock.Anything).Return(func(_ context.Context, height uint64) (*types.SignedHeader, error) {
+		return &types.SignedHeader{Header: types.Header{BaseHeader: types.BaseHeader{Height: height}}}, nil
+	})
+
+	testServer, client := setupTestServer(t, mockStore, mockP2P)
+	defer testServer.Close()
+
+	headers, err := client.GetHeaderRange(context.Background(), 1, latest+10)
+	require.NoError(t, err)
+	require.Len(t, headers, latest)
+	for i, header := range headers {
+		require.Equal(t, uint64(i+1), header.Header.Height)
+	}
+}
+
+func TestTypedClient(t *testing.T) {
+	mockStore := mocks.NewMockStore(t)
+	mockP2P := mocks.NewMockP2PRPC(t)
+
+	state := types.State{
+		ChainID:         "test-chain",
+		AppHash:         []byte("app_hash"),
+		InitialHeight:   1,
+		LastBlockHeight: 10,
+		LastBlockTime:   time.Now().UTC(),
+	}
+	header, data := types.GetRandomBlock(10, 3, "test-chain")
+	mockStore.On("GetState", mock.Anything).Return(state, nil)
+	mockStore.On("GetBlockData", mock.Anything, uint64(10)).Return(header, data, nil)
+	mockStore.On("Height", mock.Anything).Return(uint64(10), nil)
+	mockStore.On("GetHeader", mock.Anything, uint64(10)).Return(header, nil)
+	mockStore.On("GetMetadata", mock.Anything, mock.Anything).Return(nil, ds.ErrNotFound)
+
+	testServer, client := setupTestServer(t, mockStore, mockP2P)
+	defer testServer.Close()
+	typed := client.Typed()
+
+	gotState, err := typed.GetState(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, state.ChainID, gotState.ChainID)
+	require.Equal(t, state.LastBlockHeight, gotState.LastBlockHeight)
+	require.True(t, state.LastBlockTime.Equal(gotState.LastBlockTime))
+
+	block, err := typed.GetBlockByHeight(context.Background(), 10)
+	require.NoError(t, err)
+	require.Equal(t, header.Hash(), block.Header.Hash())
+	require.Equal(t, header.Signature, block.Header.Signature)
+	require.Equal(t, data.Txs, block.Data.Txs)
+
+	headers, err := typed.GetHeaderRange(context.Background(), 10, 10)
+	require.NoError(t, err)
+	require.Len(t, headers, 1)
+	require.Equal(t, header.Hash(), headers[0].Hash())
+	mockStore.AssertExpectations(t)
+}
+
+func TestClientBlockIterator(t *testing.T) {
+	mockStore := mocks.NewMockStore(t)
+	mockP2P := mocks.NewMockP2PRPC(t)
+
+	mockStore.On("GetState", mock.Anything).Return(types.State{LastBlockHeight: 20}, nil)
+	mockStore.On("GetMetadata", mock.Anything, mock.Anything).Return(nil, ds.ErrNotFound).Maybe()
+	for height := uint64(1); height <= 20; height++ {
+		header, data := types.GetRandomBlock(height, 1, "test-chain")
+		if height == 15 {
+			mockStore.On("GetBlockData", mock.Anything, height).Return(nil, nil, errors.New("disk failure")).Maybe()
+			continue
+		}
+		mockStore.On("GetBlockData", mock.Anything, height).Return(header, data, nil).Maybe()
+	}
+
+	testServer, client := setupTestServer(t, mockStore, mockP2P)
+	defer testServer.Close()
+
+	var heights []uint64
+	it := client.BlockIterator(context.Background(), 2, 12)
+	for it.Next() {
+		heights = append(heights, it.Block().Block.Header.Header.Height)
+	}
+	it.Close()
+	require.NoError(t, it.Err())
+	require.Len(t, heights, 11)
+	for i, height := range heights {
+		require.Equal(t, uint64(2+i), height, "blocks are returned in height order")
+	}
+
+	// heights above the latest block are ignored
+	heights = nil
+	it = client.BlockIterator(context.Background(), 19, 100)
+	for it.Next() {
+		heights = append(heights, it.Block().Block.Header.Header.Height)
+	}
+	it.Close()
+	require.NoError(t, it.Err())
+	require.Equal(t, []uint64{19, 20}, heights)
+
+	// the iteration ends on the first block which cannot be fetched
+	heights = nil
+	it = client.BlockIterator(context.Background(), 10, 20)
+	for it.Next() {
+		heights = append(heights, it.Block().Block.Header.Header.Height)
+	}
+	it.Close()
+	require.Error(t, it.Err())
+	require.False(t, it.Next())
+	require.Equal(t, []uint64{10, 11, 12, 13, 14}, heights)
+}
+
+func TestClientGetTxStatus(t *testing.T) {
+	mockStore := mocks.NewMockStore(t)
+	mockP2P := mocks.NewMockP2PRPC(t)
+
+	hash := sha256.Sum256([]byte("tx"))
+	height := make([]byte, 8)
+	binary.LittleEndian.PutUint64(height, 5)
+	mockStore.On("GetMetadata", mock.Anything, fmt.Sprintf("%s/%x", store.TxIndexKey, hash)).Return(height, nil)
+	mockStore.On("GetMetadata", mock.Anything, fmt.Sprintf("%s/%d/d", store.HeightToDAHeightKey, 5)).Return(nil, ds.ErrNotFound)
+	mockStore.On("GetMetadata", mock.Anything, store.DAIncludedHeightKey).Return(height, nil)
+	mockStore.On("GetBlockData", mock.Anything, uint64(5)).Return(&types.SignedHeader{}, &types.Data{Txs: types.Txs{[]byte("other"), []byte("tx")}}, nil)
+
+	testServer, client := setupTestServer(t, mockStore, mockP2P)
+	defer testServer.Close()
+
+	status, err := client.GetTxStatus(context.Background(), hash[:])
+	require.NoError(t, err)
+	require.Equal(t, pb.TxStatus_TX_STATUS_INCLUDED, status.Status)
+	require.Equal(t, uint64(5), status.Height)
+	require.Equal(t, uint32(1), status.Index)
+	require.True(t, status.DaIncluded)
+	mockStore.AssertExpectations(t)
+}
+
+func TestClientGetBlockByTxHash(t *testing.T) {
+	mockStore := mocks.NewMockStore(t)
+	mockP2P := mocks.NewMockP2PRPC(t)
+
+	header, data := types.GetRandomBlock(5, 2, "test-chain")
+	hash := sha256.Sum256(data.Txs[1])
+	location := make([]byte, 12)
+	binary.LittleEndian.PutUint64(location, 5)
+	binary.LittleEndian.PutUint32(location[8:], 1)
+	mockStore.On("GetMetadata", mock.Anything, fmt.Sprintf("%s/%x", store.TxIndexKey, hash)).Return(location, nil)
+	mockStore.On("GetBlockData", mock.Anything, uint64(5)).Return(header, data, nil)
+	mockStore.On("GetMetadata", mock.Anything, fmt.Sprintf("%s/%d/h", store.HeightToDAHeightKey, 5)).Return(nil, ds.ErrNotFound)
+	mockStore.On("GetMetadata", mock.Anything, fmt.Sprintf("%s/%d/d", store.HeightToDAHeightKey, 5)).Return(nil, ds.ErrNotFound)
+
+	testServer, client := setupTestServer(t, mockStore, mockP2P)
+	defer testServer.Close()
+
+	block, err := client.GetBlockByTxHash(context.Background(), hash[:])
+	require.NoError(t, err)
+	require.Equal(t, uint64(5), block.Block.Header.Header.Height)
+	require.Equal(t, uint32(1), block.Index)
+
+	proof, err := client.GetTxProof(context.Background(), hash[:])
+	require.NoError(t, err)
+	require.Equal(t, header.DataHash, types.Hash(proof.Header.Header.DataHash))
+	require.Len(t, proof.Txs, 2)
+	require.Equal(t, uint32(1), proof.Index)
+	mockStore.AssertExpectations(t)
+}
+
+func TestClientGetSyncStatus(t *testing.T) {
+	mockStore := mocks.NewMockStore(t)
+	mockStore.On("Height", mock.Anything).Return(uint64(7), nil)
+	mockStore.On("GetMetadata", mock.Anything, store.PrunedBaseHeightKey).Return(nil, ds.ErrNotFound)
+
+	status := server.StaticSyncStatus(server.SyncStatus{NetworkHeight: 9, HeadersBySource: map[string]uint64{"p2p": 7}})
+	handler, err := server.NewServiceHandler(mockStore, mocks.NewMockP2PRPC(t), zerolog.Nop(), config.DefaultConfig, server.ServiceOptions{SyncStatus: status})
+	require.NoError(t, err)
+	testServer := httptest.NewServer(handler)
+	defer testServer.Close()
+
+	resp, err := NewClient(testServer.URL).GetSyncStatus(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, uint64(7), resp.Height)
+	require.Equal(t, uint64(9), resp.NetworkHeight)
+	require.True(t, resp.Syncing)
+	require.Equal(t, map[string]uint64{"p2p": 7}, resp.HeadersBySource)
+}
+
+// blockInfoExecutor is an executor exposing a single execution block, at height 1.
+type blockInfoExecutor struct {
+	*mocks.MockExecutor
+}
+
+func (blockInfoExecutor) GetBlockInfo(ctx context.Context, height uint64) (coreexecution.BlockInfo, error) {
+	return coreexecution.BlockInfo{Number: height, Hash: []byte("hash"), StateRoot: []byte("root")}, nil
+}
+
+func (e blockInfoExecutor) GetLatestBlockInfo(ctx context.Context) (coreexecution.BlockInfo, error) {
+	return e.GetBlockInfo(ctx, 1)
+}
+
+func TestClientGetExecutionConsistency(t *testing.T) {
+	mockStore := mocks.NewMockStore(t)
+	mockStore.On("Height", mock.Anything).Return(uint64(1), nil)
+	mockStore.On("GetState", mock.Anything).Return(types.State{AppHash: []byte("root")}, nil)
+	mockStore.On("GetHeader", mock.Anything, uint64(1)).Return(&types.SignedHeader{}, nil)
+
+	exec := blockInfoExecutor{mocks.NewMockExecutor(t)}
+	handler, err := server.NewServiceHandler(mockStore, mocks.NewMockP2PRPC(t), zerolog.Nop(), config.DefaultConfig, server.ServiceOptions{Executor: server.StaticExecutor(exec)})
+	require.NoError(t, err)
+	testServer := httptest.NewServer(handler)
+	defer testServer.Close()
+
+	resp, err := NewClient(testServer.URL).GetExecutionConsistency(context.Background(), 0)
+	require.NoError(t, err)
+	require.Equal(t, uint64(1), resp.Height)
+	require.Equal(t, uint64(1), resp.ExecutionHeight)
+	require.True(t, resp.Consistent)
+	require.Len(t, resp.Blocks, 1)
+	require.Equal(t, []byte("hash"), resp.Blocks[0].ExecutionHash)
+}
+
+func TestClientGetDAInclusionProof(t *testing.T) {
+	mockStore := mocks.NewMockStore(t)
+	header, data := types.GetRandomBlock(3, 0, "test-chain")
+	headerBz, err := header.MarshalBinary()
+	require.NoError(t, err)
+	daHeight := make([]byte, 8)
+	binary.LittleEndian.PutUint64(daHeight, 20)
+	mockStore.On("GetBlockData", mock.Anything, uint64(3)).Return(header, data, nil)
+	mockStore.On("GetMetadata", mock.Anything, fmt.Sprintf("%s/%d/h", store.HeightToDAHeightKey, 3)).Return(daHeight, nil)
+
+	id := append(binary.LittleEndian.AppendUint64(nil, 20), []byte("commitment")...)
+	ns := []byte(config.DefaultConfig.DA.GetHeaderNamespace())
+	mockDA := mocks.NewMockDA(t)
+	mockDA.On("GetIDs", mock.Anything, uint64(20), ns).Return(&coreda.GetIDsResult{IDs: []coreda.ID{id}}, nil)
+	mockDA.On("Get", mock.Anything, []coreda.ID{id}, ns).Return([]coreda.Blob{headerBz}, nil)
+	mockDA.On("GetProofs", mock.Anything, []coreda.ID{id}, ns).Return([]coreda.Proof{[]byte("proof")}, nil)
+
+	handler, err := server.NewServiceHandler(mockStore, mocks.NewMockP2PRPC(t), zerolog.Nop(), config.DefaultConfig, server.ServiceOptions{DA: mockDA})
+	require.NoError(t, err)
+	testServer := httptest.NewServer(handler)
+	defer testServer.Close()
+
+	resp, err := NewClient(testServer.URL).GetDAInclusionProof(context.Background(), 3)
+	require.NoError(t, err)
+	require.Equal(t, uint64(3), resp.Height)
+	require.Equal(t, uint64(20), resp.Header.DaHeight)
+	require.Equal(t, []byte("commitment"), resp.Header.Commitment)
+	require.Equal(t, []byte("proof"), resp.Header.Proof)
+	require.Nil(t, resp.Data)
+}
+
+func TestClientGetDAInfo(t *testing.T) {
+	handler, err := server.NewServiceHandler(mocks.NewMockStore(t), mocks.NewMockP2PRPC(t), zerolog.Nop(), config.DefaultConfig, server.ServiceOptions{DA: coreda.NewDummyDA(2048, 0, 0, 0)})
+	require.NoError(t, err)
+	testServer := httptest.NewServer(handler)
+	defer testServer.Close()
+
+	resp, err := NewClient(testServer.URL).GetDAInfo(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, "dummy", resp.Backend)
+	require.Equal(t, "dummy", resp.NetworkId)
+	require.Equal(t, uint64(2048), resp.MaxBlobSize)
+	require.NotEmpty(t, resp.HeaderNamespace)
+	require.NotEmpty(t, resp.DataNamespace)
+}
+
+func TestClientGetNodeInfo(t *testing.T) {
+	startTime := time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC)
+	info := server.NodeInfo{Version: "v1.2.3", GitCommit: "abcdef", ChainID: "test-chain", StartTime: startTime}
+	handler, err := server.NewServiceHandler(mocks.NewMockStore(t), mocks.NewMockP2PRPC(t), zerolog.Nop(), config.DefaultConfig, server.ServiceOptions{DA: mocks.NewMockDA(t), Info: info})
+	require.NoError(t, err)
+	testServer := httptest.NewServer(handler)
+	defer testServer.Close()
+
+	resp, err := NewClient(testServer.URL).GetNodeInfo(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, "v1.2.3", resp.Version)
+	require.Equal(t, "abcdef", resp.GitCommit)
+	require.Equal(t, "test-chain", resp.ChainId)
+	require.Equal(t, "full", resp.Mode)
+	require.Empty(t, resp.ExecutionClient)
+	require.Equal(t, "unknown", resp.DaBackend)
+	require.Equal(t, startTime, resp.StartTime.AsTime())
+}
+
+func TestClientVerifyBuild(t *testing.T) {
+	build := buildinfo.Read()
+	info := server.NodeInfo{ChainID: "test-chain", Build: build}
+	handler, err := server.NewServiceHandler(mocks.NewMockStore(t), mocks.NewMockP2PRPC(t), zerolog.Nop(), config.DefaultConfig, server.ServiceOptions{Info: info})
+	require.NoError(t, err)
+	testServer := httptest.NewServer(handler)
+	defer testServer.Close()
+
+	client := NewClient(testServer.URL)
+	require.NoError(t, client.VerifyBuild(context.Background(), build.Digest))
+	require.ErrorIs(t, client.VerifyBuild(context.Background(), "other"), buildinfo.ErrDigestMismatch)
+}
+
+func TestClientGetBlockStream(t *testing.T) {
+	ctx := context.Background()
+	kv, err := store.NewDefaultInMemoryKVStore()
+	require.NoError(t, err)
+	s := store.New(kv)
+	header, data := types.GetRandomBlock(1, 0, "test-chain")
+	data.Txs = types.Txs{
+		bytes.Repeat([]byte{1}, 100),
+		bytes.Repeat([]byte{2}, 100),
+		bytes.Repeat([]byte{3}, 300),
+		bytes.Repeat([]byte{4}, 100),
+		bytes.Repeat([]byte{5}, 100),
+	}
+	require.NoError(t, s.SaveBlockData(ctx, header, data, &header.Signature))
+	require.NoError(t, s.SetHeight(ctx, 1))
+
+	handler, err := server.NewServiceHandler(s, nil, zerolog.Nop(), config.DefaultConfig, server.ServiceOptions{})
+	require.NoError(t, err)
+	testServer := httptest.NewServer(handler)
+	defer testServer.Close()
+	client := NewClient(testServer.URL)
+
+	var chunks []*pb.GetBlockStreamResponse
+	require.NoError(t, client.GetBlockStream(ctx, 0, 250, func(chunk *pb.GetBlockStreamResponse) error {
+		chunks = append(chunks, chunk)
+		return nil
+	}))
+	// the transaction larger than the maximum is sent alone
+	require.Len(t, chunks, 3)
+	require.Equal(t, uint64(1), chunks[0].Header.Header.Height)
+	require.Equal(t, uint64(5), chunks[0].TxCount)
+	require.NotNil(t, chunks[0].Metadata)
+	require.Nil(t, chunks[1].Header)
+	var txs [][]byte
+	for i, chunk := range chunks {
+		require.Len(t, chunk.Txs, []int{2, 1, 2}[i])
+		txs = append(txs, chunk.Txs...)
+	}
+	for i, tx := range data.Txs {
+		require.Equal(t, []byte(tx), txs[i])
+	}
+
+	// the whole block fits in a chunk of the default size
+	chunks = nil
+	require.NoError(t, client.GetBlockStream(ctx, 1, 0, func(chunk *pb.GetBlockStreamResponse) error {
+		chunks = append(chunks, chunk)
+		return nil
+	}))
+	require.Len(t, chunks, 1)
+	require.Len(t, chunks[0].Txs, 5)
+
+	err = client.GetBlockStream(ctx, 2, 0, func(*pb.GetBlockStreamResponse) error { return nil })
+	require.Equal(t, connect.CodeNotFound, connect.CodeOf(err))
+}
+
+func TestClientWaitForTxInclusion(t *testing.T) {
+	mockStore := mocks.NewMockStore(t)
+	mockP2P := mocks.NewMockP2PRPC(t)
+
+	hash := sha256.Sum256([]byte("tx"))
+	height := make([]byte, 8)
+	binary.LittleEndian.PutUint64(height, 7)
+	txIndexKey := fmt.Sprintf("%s/%x", store.TxIndexKey, hash)
+	mockStore.On("GetMetadata", mock.Anything, txIndexKey).Return(nil, ds.ErrNotFound).Twice()
+	mockStore.On("GetMetadata", mock.Anything, txIndexKey).Return(height, nil)
+	mockStore.On("GetMetadata", mock.Anything, fmt.Sprintf("%s/%d/d", store.HeightToDAHeightKey, 7)).Return(nil, ds.ErrNotFound)
+	mockStore.On("GetMetadata", mock.Anything, store.DAIncludedHeightKey).Return(nil, ds.ErrNotFound)
+	mockStore.On("GetBlockData", mock.Anything, uint64(7)).Return(&types.SignedHeader{}, &types.Data{Txs: types.Txs{[]byte("tx")}}, nil)
+
+	testServer, client := setupTestServer(t, mockStore, mockP2P)
+	defer testServer.Close()
+
+	status, err := client.WaitForTxInclusion(context.Background(), hash[:], 5*time.Second)
+	require.NoError(t, err)
+	require.Equal(t, pb.TxStatus_TX_STATUS_INCLUDED, status.Status)
+	require.Equal(t, uint64(7), status.Height)
+	require.Equal(t, uint32(0), status.Index)
+	require.False(t, status.DaIncluded)
+	mockStore.AssertExpectations(t)
+}
+
 func TestClientGetBlockByHash(t *testing.T) {
 	// Create mocks
 	mockStore := mocks.NewMockStore(t)
@@ -259,6 +780,218 @@ func TestClientGetHealth(t *testing.T) {
 	require.NotEqual(t, healthStatus.String(), "UNKNOWN")
 }
 
+func TestClientGetReadiness(t *testing.T) {
+	mockStore := mocks.NewMockStore(t)
+	mockP2P := mocks.NewMockP2PRPC(t)
+
+	testServer, client := setupTestServer(t, mockStore, mockP2P)
+	defer testServer.Close()
+
+	// the test server has no readiness checks
+	readiness, err := client.GetReadiness(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, pb.HealthStatus_PASS, readiness.Status)
+	require.Empty(t, readiness.Checks)
+}
+
+func TestClientWithBearerToken(t *testing.T) {
+	mockStore := mocks.NewMockStore(t)
+	mockP2P := mocks.NewMockP2PRPC(t)
+	mockP2P.On("GetPeers").Return([]peer.AddrInfo{}, nil)
+
+	cfg := config.DefaultConfig
+	cfg.RPC.AuthToken = "secret-token"
+	cfg.RPC.AuthServices = rpc.P2PServiceName
+	handler, err := server.NewServiceHandler(mockStore, mockP2P, zerolog.Nop(), cfg, server.ServiceOptions{})
+	require.NoError(t, err)
+	testServer := httptest.NewServer(handler)
+	defer testServer.Close()
+
+	_, err = NewClient(testServer.URL).GetPeerInfo(context.Background())
+	require.ErrorContains(t, err, "missing bearer token")
+
+	peers, err := NewClient(testServer.URL, WithBearerToken("secret-token")).GetPeerInfo(context.Background())
+	require.NoError(t, err)
+	require.Empty(t, peers)
+
+	// an empty token sends no Authorization header
+	var authorization []string
+	transport := roundTripperFunc(func(req *http.Request) (*http.Response, error) {
+		authorization = req.Header.Values("Authorization")
+		return http.DefaultTransport.RoundTrip(req)
+	})
+	_, err = NewClient(testServer.URL, WithTransport(transport), WithBearerToken("")).GetPeerInfo(context.Background())
+	require.ErrorContains(t, err, "missing bearer token")
+	require.Empty(t, authorization)
+}
+
+func TestClientWithTLSAndTokenSource(t *testing.T) {
+	mockStore := mocks.NewMockStore(t)
+	mockP2P := mocks.NewMockP2PRPC(t)
+	mockP2P.On("GetPeers").Return([]peer.AddrInfo{}, nil)
+
+	cfg := config.DefaultConfig
+	cfg.RPC.AuthToken = "secret-token"
+	cfg.RPC.AuthServices = rpc.P2PServiceName
+	handler, err := server.NewServiceHandler(mockStore, mockP2P, zerolog.Nop(), cfg, server.ServiceOptions{})
+	require.NoError(t, err)
+	testServer := httptest.NewUnstartedServer(handler)
+	testServer.EnableHTTP2 = true
+	testServer.StartTLS()
+	defer testServer.Close()
+
+	// the certificate of the test server is not trusted by the system
+	_, err = NewClient(testServer.URL, WithBearerToken("secret-token")).GetPeerInfo(context.Background())
+	require.ErrorContains(t, err, "certificate")
+
+	roots := x509.NewCertPool()
+	roots.AddCert(testServer.Certificate())
+	var calls int
+	source := func(context.Context) (string, error) {
+		calls++
+		if calls > 2 {
+			return "", errors.New("token expired")
+		}
+		return "secret-token", nil
+	}
+	c := NewClient(testServer.URL, WithRootCAs(roots), WithTokenSource(source))
+	for range 2 {
+		peers, err := c.GetPeerInfo(context.Background())
+		require.NoError(t, err)
+		require.Empty(t, peers)
+	}
+	_, err = c.GetPeerInfo(context.Background())
+	require.ErrorContains(t, err, "token expired")
+
+	// the TLS configuration is used as is, apart from the root CAs
+	_, err = NewClient(testServer.URL, WithTLSConfig(&tls.Config{MinVersion: tls.VersionTLS12, RootCAs: roots}), WithBearerToken("secret-token")).GetPeerInfo(context.Background())
+	require.NoError(t, err)
+}
+
+func TestClientWithUnixSocket(t *testing.T) {
+	mockStore := mocks.NewMockStore(t)
+	mockStore.On("GetState", mock.Anything).Return(types.State{ChainID: "test-chain", LastBlockHeight: 7}, nil)
+	handler, err := server.NewServiceHandler(mockStore, mocks.NewMockP2PRPC(t), zerolog.Nop(), config.DefaultConfig, server.ServiceOptions{})
+	require.NoError(t, err)
+
+	socket := filepath.Join(t.TempDir(), "rpc.sock")
+	listener, err := server.ListenUnix(socket)
+	require.NoError(t, err)
+	testServer := &http.Server{Handler: handler, ReadHeaderTimeout: time.Second}
+	go func() { _ = testServer.Serve(listener) }()
+	defer testServer.Close()
+
+	state, err := NewClient("http://localhost", WithUnixSocket(socket)).GetState(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, uint64(7), state.LastBlockHeight)
+}
+
+func TestClientDualStack(t *testing.T) {
+	mockStore := mocks.NewMockStore(t)
+	mockStore.On("GetState", mock.Anything).Return(types.State{ChainID: "test-chain", LastBlockHeight: 7}, nil)
+	handler, err := server.NewServiceHandler(mockStore, mocks.NewMockP2PRPC(t), zerolog.Nop(), config.DefaultConfig, server.ServiceOptions{})
+	require.NoError(t, err)
+
+	// a server listening on all interfaces of both IP versions, as with rpc.address "[::]:7331"
+	listener, err := net.Listen("tcp", "[::]:0")
+	if err != nil {
+		t.Skip("IPv6 is not available")
+	}
+	testServer := &http.Server{Handler: handler, ReadHeaderTimeout: time.Second}
+	go func() { _ = testServer.Serve(listener) }()
+	defer testServer.Close()
+	port := listener.Addr().(*net.TCPAddr).Port
+
+	for _, address := range []string{
+		fmt.Sprintf("[::]:%d", port),
+		fmt.Sprintf("127.0.0.1:%d", port),
+	} {
+		state, err := NewClient(config.RPCClientURL(address)).GetState(context.Background())
+		require.NoError(t, err, address)
+		require.Equal(t, uint64(7), state.LastBlockHeight)
+	}
+}
+
+// followerAdmin is the server.NodeAdmin of a node which is not an aggregator.
+type followerAdmin struct {
+	disconnected []peer.ID
+	drainTimeout time.Duration
+	metadata     map[string][]byte
+}
+
+func (a *followerAdmin) Shutdown() {}
+
+func (a *followerAdmin) Drain(timeout time.Duration) { a.drainTimeout = timeout }
+
+func (a *followerAdmin) TriggerDASubmission() (uint64, uint64, error) {
+	return 0, 0, server.ErrNotAggregator
+}
+
+func (a *followerAdmin) DisconnectPeer(id peer.ID, _ bool) error {
+	a.disconnected = append(a.disconnected, id)
+	return nil
+}
+
+func (a *followerAdmin) CompactStore(context.Context) error { return nil }
+
+func (a *followerAdmin) SetMetadata(_ context.Context, namespace, key string, value []byte) error {
+	a.metadata[namespace+"/"+key] = value
+	return nil
+}
+
+func TestClientAdmin(t *testing.T) {
+	admin := &followerAdmin{metadata: make(map[string][]byte)}
+	cfg := config.DefaultConfig
+	cfg.RPC.AuthToken = "secret-token"
+	handler, err := server.NewServiceHandler(mocks.NewMockStore(t), mocks.NewMockP2PRPC(t), zerolog.Nop(), cfg, server.ServiceOptions{Admin: admin})
+	require.NoError(t, err)
+	testServer := httptest.NewServer(handler)
+	defer testServer.Close()
+
+	ctx := context.Background()
+	require.ErrorContains(t, NewClient(testServer.URL).CompactStore(ctx), "missing bearer token")
+
+	client := NewClient(testServer.URL, WithBearerToken("secret-token"))
+	require.NoError(t, client.CompactStore(ctx))
+	require.NoError(t, client.Shutdown(ctx))
+
+	defer zerolog.SetGlobalLevel(zerolog.GlobalLevel())
+	zerolog.SetGlobalLevel(zerolog.WarnLevel)
+	previous, err := client.SetLogLevel(ctx, "debug")
+	require.NoError(t, err)
+	require.Equal(t, "warn", previous)
+
+	_, err = client.TriggerDASubmission(ctx)
+	require.ErrorContains(t, err, server.ErrNotAggregator.Error())
+
+	peerID, err := peer.Decode("12D3KooWJbD9TQoMSSSUyfhHMmgVY3LqCjxYFz8wQ92Qa6DAqtmh")
+	require.NoError(t, err)
+	require.NoError(t, client.DisconnectPeer(ctx, peerID.String(), true))
+	require.Equal(t, []peer.ID{peerID}, admin.disconnected)
+
+	require.NoError(t, client.SetMetadata(ctx, "exec", "checkpoint", []byte{1}))
+	require.Equal(t, map[string][]byte{"exec/checkpoint": {1}}, admin.metadata)
+
+	// the drain timeout defaults to rpc.drain_timeout
+	require.NoError(t, client.Drain(ctx, 0))
+	require.Equal(t, cfg.RPC.DrainTimeout.Duration, admin.drainTimeout)
+	require.NoError(t, client.Drain(ctx, time.Minute))
+	require.Equal(t, time.Minute, admin.drainTimeout)
+}
+
+func TestClientGetAlerts(t *testing.T) {
+	mockStore := mocks.NewMockStore(t)
+	mockP2P := mocks.NewMockP2PRPC(t)
+
+	testServer, client := setupTestServer(t, mockStore, mockP2P)
+	defer testServer.Close()
+
+	// the test server does not evaluate alert rules
+	alerts, err := client.GetAlerts(context.Background())
+	require.NoError(t, err)
+	require.Empty(t, alerts)
+}
+
 func TestClientGetNamespace(t *testing.T) {
 	// Create mocks
 	mockStore := mocks.NewMockStore(t)
@@ -278,3 +1011,159 @@ func TestClientGetNamespace(t *testing.T) {
 	require.NotEmpty(t, namespaceResp.HeaderNamespace)
 	require.NotEmpty(t, namespaceResp.DataNamespace)
 }
+
+func TestClientEstimateTxFee(t *testing.T) {
+	// Create mocks
+	mockStore := mocks.NewMockStore(t)
+	mockP2P := mocks.NewMockP2PRPC(t)
+
+	// Setup test server and client
+	testServer, client := setupTestServer(t, mockStore, mockP2P)
+	defer testServer.Close()
+
+	// Call EstimateTxFee
+	tx := []byte("some transaction payload")
+	feeResp, err := client.EstimateTxFee(context.Background(), tx)
+
+	// Assert expectations
+	require.NoError(t, err)
+	require.Equal(t, uint64(len(tx)), feeResp.TxSize)
+	require.NotZero(t, feeResp.CompressedSize)
+}
+
+func TestClientSubscribeBlocks(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	kv, err := store.NewDefaultInMemoryKVStore()
+	require.NoError(t, err)
+	s := store.New(kv)
+	addBlock := func(height uint64) {
+		header, data := types.GetRandomBlock(height, 1, "test-chain")
+		require.NoError(t, s.SaveBlockData(ctx, header, data, &header.Signature))
+		require.NoError(t, s.SetHeight(ctx, height))
+		require.NoError(t, s.UpdateState(ctx, types.State{ChainID: "test-chain", LastBlockHeight: height}))
+	}
+	addBlock(1)
+	addBlock(2)
+
+	handler, err := server.NewServiceHandler(s, nil, zerolog.Nop(), config.DefaultConfig, server.ServiceOptions{})
+	require.NoError(t, err)
+	testServer := httptest.NewServer(handler)
+	defer testServer.Close()
+	client := NewClient(testServer.URL)
+
+	receive := func(blocks <-chan *pb.Block) uint64 {
+		t.Helper()
+		select {
+		case block := <-blocks:
+			return block.Header.Header.Height
+		case <-time.After(5 * time.Second):
+			t.Fatal("no block received")
+			return 0
+		}
+	}
+
+	// the past blocks are streamed first
+	blocks, err := client.SubscribeBlocks(ctx, 1)
+	require.NoError(t, err)
+	require.Equal(t, uint64(1), receive(blocks))
+	require.Equal(t, uint64(2), receive(blocks))
+
+	// subscribing from the next block
+	next, err := client.SubscribeBlocks(ctx, 0)
+	require.NoError(t, err)
+	addBlock(3)
+	require.Equal(t, uint64(3), receive(blocks))
+	require.Equal(t, uint64(3), receive(next))
+
+	// the subscription resumes after the last block received when the stream fails
+	testServer.CloseClientConnections()
+	addBlock(4)
+	addBlock(5)
+	require.Equal(t, uint64(4), receive(next))
+	require.Equal(t, uint64(5), receive(next))
+
+	cancel()
+	require.Eventually(t, func() bool {
+		select {
+		case _, ok := <-next:
+			return !ok
+		default:
+			return false
+		}
+	}, 5*time.Second, 10*time.Millisecond)
+
+	// read-only tokens may subscribe to the protected store service
+	cfg := config.DefaultConfig
+	cfg.RPC.AuthToken = "secret-token"
+	cfg.RPC.AuthRoleTokens = "indexer:read-only:reader-token"
+	cfg.RPC.AuthServices = "evnode.v1.StoreService"
+	authHandler, err := server.NewServiceHandler(s, nil, zerolog.Nop(), cfg, server.ServiceOptions{})
+	require.NoError(t, err)
+	authServer := httptest.NewServer(authHandler)
+	defer authServer.Close()
+	readCtx, cancelRead := context.WithCancel(context.Background())
+	defer cancelRead()
+	reader, err := NewClient(authServer.URL, WithBearerToken("reader-token")).SubscribeBlocks(readCtx, 1)
+	require.NoError(t, err)
+	require.Equal(t, uint64(1), receive(reader))
+
+	// the subscription ends when the node rejects it
+	rejected, err := NewClient(authServer.URL).SubscribeBlocks(context.Background(), 1)
+	require.NoError(t, err)
+	select {
+	case _, ok := <-rejected:
+		require.False(t, ok)
+	case <-time.After(5 * time.Second):
+		t.Fatal("subscription not closed")
+	}
+}
+
+// previewSource gossips the previews sent to its channel.
+type previewSource struct {
+	previews chan *pb.Block
+}
+
+func (s previewSource) SubscribePreviews() (<-chan *pb.Block, func(), bool) {
+	return s.previews, func() {}, true
+}
+
+func TestClientSubscribePreviewBlocks(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	kv, err := store.NewDefaultInMemoryKVStore()
+	require.NoError(t, err)
+	s := store.New(kv)
+
+	// the subscription ends on nodes which do not gossip previews
+	handler, err := server.NewServiceHandler(s, nil, zerolog.Nop(), config.DefaultConfig, server.ServiceOptions{})
+	require.NoError(t, err)
+	disabled := httptest.NewServer(handler)
+	defer disabled.Close()
+	select {
+	case _, ok := <-NewClient(disabled.URL).SubscribePreviewBlocks(ctx):
+		require.False(t, ok)
+	case <-time.After(5 * time.Second):
+		t.Fatal("subscription not closed")
+	}
+
+	source := previewSource{previews: make(chan *pb.Block)}
+	handler, err = server.NewServiceHandler(s, nil, zerolog.Nop(), config.DefaultConfig, server.ServiceOptions{Previews: source})
+	require.NoError(t, err)
+	testServer := httptest.NewServer(handler)
+	defer testServer.Close()
+	previews := NewClient(testServer.URL).SubscribePreviewBlocks(ctx)
+
+	header, data := types.GetRandomBlock(7, 2, "test-chain")
+	preview := &pb.Block{Header: &pb.SignedHeader{Header: header.Header.ToProto(), Signer: &pb.Signer{}}, Data: data.ToProto()}
+	source.previews <- preview
+	select {
+	case got := <-previews:
+		require.Equal(t, uint64(7), got.Header.Header.Height)
+		require.Len(t, got.Data.Txs, 2)
+	case <-time.After(5 * time.Second):
+		t.Fatal("no preview received")
+	}
+	// the stream is closed before the server
+	cancel()
+}
@@ -0,0 +1,142 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync/atomic"
+
+	"connectrpc.com/connect"
+)
+
+// WithFailoverURLs adds endpoints serving the same node RPCs, e.g. the other full nodes of a
+// highly available deployment. Requests go to the endpoint which last answered, starting with the
+// base URL, and fail over to the next endpoint when it is down. Requests with side effects only
+// fail over when the connection to the endpoint cannot be established, so that they are never
+// applied twice.
+func WithFailoverURLs(urls ...string) Option {
+	return func(o *options) {
+		o.failoverURLs = append(o.failoverURLs, urls...)
+	}
+}
+
+// WithLoadBalancedReads spreads the requests without side effects over all the endpoints in turn,
+// instead of sending them to the endpoint which last answered.
+func WithLoadBalancedReads() Option {
+	return func(o *options) {
+		o.loadBalanceReads = true
+	}
+}
+
+// readOnlyKey marks the context of requests without side effects.
+type readOnlyKey struct{}
+
+// readOnlyInterceptor marks the context of the requests to procedures without side effects or
+// declared idempotent, for failoverClient to tell them apart.
+func readOnlyInterceptor() connect.UnaryInterceptorFunc {
+	return func(next connect.UnaryFunc) connect.UnaryFunc {
+		return func(ctx context.Context, req connect.AnyRequest) (connect.AnyResponse, error) {
+			if req.Spec().IdempotencyLevel != connect.IdempotencyUnknown {
+				ctx = context.WithValue(ctx, readOnlyKey{}, true)
+			}
+			return next(ctx, req)
+		}
+	}
+}
+
+// failoverClient sends the requests of the client to one of several endpoints serving the same
+// RPCs, rewriting the URLs built from the base URL of the first endpoint.
+type failoverClient struct {
+	next             connect.HTTPClient
+	basePath         string
+	endpoints        []*url.URL
+	loadBalanceReads bool
+	// err is the error parsing the endpoint URLs, returned by every request
+	err error
+
+	// preferred is the index of the endpoint which last answered
+	preferred atomic.Uint32
+	// turn is the number of the last load balanced request
+	turn atomic.Uint32
+}
+
+func newFailoverClient(next connect.HTTPClient, baseURL string, failoverURLs []string, loadBalanceReads bool) *failoverClient {
+	c := &failoverClient{next: next, loadBalanceReads: loadBalanceReads}
+	for _, rawURL := range append([]string{baseURL}, failoverURLs...) {
+		endpoint, err := url.Parse(strings.TrimRight(rawURL, "/"))
+		if err != nil {
+			c.err = fmt.Errorf("invalid endpoint URL %q: %w", rawURL, err)
+			return c
+		}
+		c.endpoints = append(c.endpoints, endpoint)
+	}
+	c.basePath = c.endpoints[0].Path
+	return c
+}
+
+func (c *failoverClient) Do(req *http.Request) (*http.Response, error) {
+	if c.err != nil {
+		return nil, c.err
+	}
+	readOnly, _ := req.Context().Value(readOnlyKey{}).(bool)
+	n := uint32(len(c.endpoints))
+	first := c.preferred.Load()
+	if readOnly && c.loadBalanceReads {
+		first = c.turn.Add(1) % n
+	}
+	procedure := strings.TrimPrefix(req.URL.Path, c.basePath)
+
+	var errs []error
+	for i := range n {
+		index := (first + i) % n
+		attempt := req.Clone(req.Context())
+		endpoint := *c.endpoints[index]
+		endpoint.Path += procedure
+		endpoint.RawQuery = req.URL.RawQuery
+		attempt.URL, attempt.Host = &endpoint, ""
+		if i > 0 && req.Body != nil && req.Body != http.NoBody {
+			if req.GetBody == nil {
+				break
+			}
+			body, err := req.GetBody()
+			if err != nil {
+				break
+			}
+			attempt.Body = body
+		}
+
+		resp, err := c.next.Do(attempt)
+		switch {
+		case err == nil && !(readOnly && isUnavailableStatus(resp.StatusCode)):
+			c.preferred.Store(index)
+			return resp, nil
+		case err == nil:
+			resp.Body.Close()
+			err = fmt.Errorf("endpoint answered %s", resp.Status)
+		case req.Context().Err() != nil:
+			return nil, err
+		case !readOnly && !isDialError(err):
+			// the request may have reached the endpoint
+			return nil, err
+		}
+		errs = append(errs, fmt.Errorf("%s: %w", c.endpoints[index].Host, err))
+	}
+	return nil, fmt.Errorf("all endpoints failed: %w", errors.Join(errs...))
+}
+
+// isUnavailableStatus returns whether the status of a response shows that the endpoint is down,
+// e.g. when answered by a reverse proxy in front of it.
+func isUnavailableStatus(status int) bool {
+	return status == http.StatusBadGateway || status == http.StatusServiceUnavailable || status == http.StatusGatewayTimeout
+}
+
+// isDialError returns whether the connection to the endpoint could not be established, so that
+// the request was not sent.
+func isDialError(err error) bool {
+	var opErr *net.OpError
+	return errors.As(err, &opErr) && opErr.Op == "dial"
+}
@@ -0,0 +1,91 @@
+package client
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"connectrpc.com/connect"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/net/http2"
+	"golang.org/x/net/http2/h2c"
+
+	rpc "github.com/evstack/ev-node/types/pb/evnode/v1/v1connect"
+)
+
+// downURL returns the URL of a server which is not listening anymore.
+func downURL(t *testing.T) string {
+	t.Helper()
+	server := httptest.NewServer(http.NotFoundHandler())
+	server.Close()
+	return server.URL
+}
+
+func TestFailover(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("reads fail over to the next endpoint", func(t *testing.T) {
+		server, url := newFlakyServer(t, connect.CodeUnavailable, 0)
+		client := NewClient(downURL(t), WithFailoverURLs(url))
+		for range 3 {
+			info, err := client.GetNodeInfo(ctx)
+			require.NoError(t, err)
+			require.Equal(t, "test-chain", info.ChainId)
+		}
+		require.Equal(t, int32(3), server.calls.Load())
+	})
+
+	t.Run("writes fail over when the endpoint refuses connections", func(t *testing.T) {
+		server, url := newFlakyServer(t, connect.CodeUnavailable, 0)
+		require.NoError(t, NewClient(downURL(t), WithFailoverURLs(url)).Shutdown(ctx))
+		require.Equal(t, int32(1), server.calls.Load())
+	})
+
+	t.Run("unavailable proxies are skipped by reads", func(t *testing.T) {
+		proxy := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+			w.WriteHeader(http.StatusBadGateway)
+		}))
+		t.Cleanup(proxy.Close)
+		server, url := newFlakyServer(t, connect.CodeUnavailable, 0)
+		_, err := NewClient(proxy.URL, WithFailoverURLs(url)).GetNodeInfo(ctx)
+		require.NoError(t, err)
+		require.Equal(t, int32(1), server.calls.Load())
+	})
+
+	t.Run("all endpoints down", func(t *testing.T) {
+		_, err := NewClient(downURL(t), WithFailoverURLs(downURL(t))).GetNodeInfo(ctx)
+		require.Equal(t, connect.CodeUnavailable, connect.CodeOf(err))
+	})
+
+	t.Run("reads are load balanced", func(t *testing.T) {
+		first, firstURL := newFlakyServer(t, connect.CodeUnavailable, 0)
+		second, secondURL := newFlakyServer(t, connect.CodeUnavailable, 0)
+		client := NewClient(firstURL, WithFailoverURLs(secondURL), WithLoadBalancedReads())
+		for range 4 {
+			_, err := client.GetNodeInfo(ctx)
+			require.NoError(t, err)
+		}
+		require.Equal(t, int32(2), first.calls.Load())
+		require.Equal(t, int32(2), second.calls.Load())
+
+		// writes stay on the endpoint which last answered
+		for range 2 {
+			require.NoError(t, client.Shutdown(ctx))
+		}
+		require.Equal(t, int32(6), first.calls.Load()+second.calls.Load())
+		require.True(t, first.calls.Load() == 4 || second.calls.Load() == 4)
+	})
+
+	t.Run("endpoint paths are kept", func(t *testing.T) {
+		server := &flakyServer{code: connect.CodeUnavailable}
+		mux := http.NewServeMux()
+		mux.Handle(rpc.NewConfigServiceHandler(server))
+		prefixed := httptest.NewServer(h2c.NewHandler(http.StripPrefix("/rpc", mux), &http2.Server{}))
+		t.Cleanup(prefixed.Close)
+
+		_, err := NewClient(downURL(t)+"/node/", WithFailoverURLs(prefixed.URL+"/rpc")).GetNodeInfo(ctx)
+		require.NoError(t, err)
+		require.Equal(t, int32(1), server.calls.Load())
+	})
+}
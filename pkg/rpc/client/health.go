@@ -0,0 +1,86 @@
+package client
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// Ping checks that the node is reachable by calling its liveness endpoint, and returns the error
+// of the call if it is not, e.g. when ctx is done before the node answers.
+func (c *Client) Ping(ctx context.Context) error {
+	_, err := c.GetHealth(ctx)
+	return err
+}
+
+// HealthChange is a change of the reachability of the node observed by a HealthMonitor.
+type HealthChange struct {
+	Reachable bool
+	// Err is the error of the ping which found the node unreachable, nil if reachable
+	Err error
+	// Time is the time of the ping
+	Time time.Time
+}
+
+// HealthMonitor pings a node in the background, see Client.MonitorHealth.
+type HealthMonitor struct {
+	mu     sync.Mutex
+	known  bool
+	change HealthChange
+}
+
+// MonitorHealth pings the node every interval until ctx is done, each ping waiting at most
+// interval for the answer of the node, and calls onChange, if not nil, with the first result and
+// then every time the node becomes unreachable or reachable again. onChange is called from the
+// goroutine of the monitor, which waits for it to return.
+func (c *Client) MonitorHealth(ctx context.Context, interval time.Duration, onChange func(HealthChange)) *HealthMonitor {
+	m := &HealthMonitor{}
+	go func() {
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+		for {
+			pingCtx, cancel := context.WithTimeout(ctx, interval)
+			err := c.Ping(pingCtx)
+			cancel()
+			if ctx.Err() != nil {
+				return
+			}
+			if change, changed := m.observe(err); changed && onChange != nil {
+				onChange(change)
+			}
+
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+			}
+		}
+	}()
+	return m
+}
+
+// observe records the result of a ping and returns the resulting state, and whether it changed.
+func (m *HealthMonitor) observe(err error) (HealthChange, bool) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	reachable := err == nil
+	changed := !m.known || m.change.Reachable != reachable
+	m.known = true
+	m.change = HealthChange{Reachable: reachable, Err: err, Time: time.Now()}
+	return m.change, changed
+}
+
+// Reachable reports whether the last ping reached the node, false before the first ping.
+func (m *HealthMonitor) Reachable() bool {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return m.change.Reachable
+}
+
+// Last returns the result of the last ping, and false before the first ping.
+func (m *HealthMonitor) Last() (HealthChange, bool) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return m.change, m.known
+}
@@ -0,0 +1,64 @@
+package client
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/evstack/ev-node/pkg/rpc/server"
+	rpc "github.com/evstack/ev-node/types/pb/evnode/v1/v1connect"
+)
+
+func TestClientPingAndMonitorHealth(t *testing.T) {
+	var down atomic.Bool
+	healthPath, healthHandler := rpc.NewHealthServiceHandler(server.NewHealthServer(nil))
+	mux := http.NewServeMux()
+	mux.Handle(healthPath, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if down.Load() {
+			w.WriteHeader(http.StatusBadGateway)
+			return
+		}
+		healthHandler.ServeHTTP(w, r)
+	}))
+	testServer := httptest.NewServer(mux)
+	defer testServer.Close()
+	client := NewClient(testServer.URL)
+
+	require.NoError(t, client.Ping(context.Background()))
+	canceled, cancel := context.WithCancel(context.Background())
+	cancel()
+	require.Error(t, client.Ping(canceled))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	changes := make(chan HealthChange, 10)
+	monitor := client.MonitorHealth(ctx, 10*time.Millisecond, func(change HealthChange) {
+		changes <- change
+	})
+	next := func() HealthChange {
+		select {
+		case change := <-changes:
+			return change
+		case <-time.After(2 * time.Second):
+			t.Fatal("expected health change")
+			return HealthChange{}
+		}
+	}
+
+	require.True(t, next().Reachable)
+	require.True(t, monitor.Reachable())
+	down.Store(true)
+	change := next()
+	require.False(t, change.Reachable)
+	require.Error(t, change.Err)
+	down.Store(false)
+	require.True(t, next().Reachable)
+	last, ok := monitor.Last()
+	require.True(t, ok)
+	require.NoError(t, last.Err)
+}
@@ -0,0 +1,115 @@
+package client
+
+import (
+	"context"
+
+	pb "github.com/evstack/ev-node/types/pb/evnode/v1"
+)
+
+// blockIteratorPrefetch is the number of blocks a BlockIterator fetches ahead of the caller.
+const blockIteratorPrefetch = 8
+
+// BlockIterator iterates over the blocks of a range, e.g. for an indexer syncing the history of
+// the chain. The blocks are fetched concurrently ahead of the caller, up to a bounded number of
+// blocks, so that a slow caller does not make the iterator buffer the whole range.
+//
+//	it := c.BlockIterator(ctx, 1, 1000)
+//	defer it.Close()
+//	for it.Next() {
+//		process(it.Block())
+//	}
+//	if err := it.Err(); err != nil {
+//		...
+//	}
+type BlockIterator struct {
+	ctx    context.Context
+	cancel context.CancelFunc
+	// pending are the results of the blocks being fetched, in height order
+	pending chan chan blockResult
+
+	block *pb.GetBlockResponse
+	err   error
+}
+
+type blockResult struct {
+	block *pb.GetBlockResponse
+	err   error
+}
+
+// BlockIterator returns an iterator over the blocks from fromHeight to toHeight inclusive. Heights
+// above the latest block when the iteration starts are ignored. The iterator must be closed once
+// done with.
+func (c *Client) BlockIterator(ctx context.Context, fromHeight, toHeight uint64) *BlockIterator {
+	fetchCtx, cancel := context.WithCancel(ctx)
+	it := &BlockIterator{
+		ctx:     ctx,
+		cancel:  cancel,
+		pending: make(chan chan blockResult, blockIteratorPrefetch-1),
+	}
+	go it.fetch(fetchCtx, c, fromHeight, toHeight)
+	return it
+}
+
+// fetch fetches the blocks of the range, as long as fewer than blockIteratorPrefetch blocks are
+// waiting for the caller.
+func (it *BlockIterator) fetch(ctx context.Context, c *Client, from, to uint64) {
+	defer close(it.pending)
+
+	state, err := c.GetState(ctx)
+	if err != nil {
+		result := make(chan blockResult, 1)
+		result <- blockResult{err: err}
+		it.pending <- result
+		return
+	}
+	to = min(to, state.LastBlockHeight)
+
+	for height := max(from, 1); height <= to; height++ {
+		result := make(chan blockResult, 1)
+		select {
+		case it.pending <- result:
+		case <-ctx.Done():
+			return
+		}
+		go func() {
+			block, err := c.GetBlockByHeight(ctx, height)
+			result <- blockResult{block: block, err: err}
+		}()
+	}
+}
+
+// Next advances the iterator to the next block, and returns false at the end of the range or on
+// error.
+func (it *BlockIterator) Next() bool {
+	if it.err != nil {
+		return false
+	}
+	result, ok := <-it.pending
+	if !ok {
+		it.block, it.err = nil, it.ctx.Err()
+		return false
+	}
+	r := <-result
+	if r.err != nil {
+		it.block, it.err = nil, r.err
+		it.cancel()
+		return false
+	}
+	it.block = r.block
+	return true
+}
+
+// Block returns the current block.
+func (it *BlockIterator) Block() *pb.GetBlockResponse {
+	return it.block
+}
+
+// Err returns the error which ended the iteration, if any.
+func (it *BlockIterator) Err() error {
+	return it.err
+}
+
+// Close stops fetching the blocks.
+func (it *BlockIterator) Close() {
+	it.cancel()
+}
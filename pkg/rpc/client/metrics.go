@@ -0,0 +1,130 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"io"
+	"sync"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// Metrics is the telemetry of the requests of RPC clients, by procedure and status code. It is a
+// prometheus.Collector registered by the service embedding the clients, which may share it.
+type Metrics struct {
+	requests *prometheus.CounterVec
+	latency  *prometheus.HistogramVec
+}
+
+var _ prometheus.Collector = (*Metrics)(nil)
+
+// NewMetrics creates the metrics of RPC clients, named after namespace.
+func NewMetrics(namespace string) *Metrics {
+	return &Metrics{
+		requests: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: "rpc_client",
+			Name:      "requests_total",
+			Help:      "Number of RPC requests by procedure and status code.",
+		}, []string{"procedure", "code"}),
+		latency: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Namespace: namespace,
+			Subsystem: "rpc_client",
+			Name:      "request_duration_seconds",
+			Help:      "Duration of RPC requests by procedure and status code. Streams last until closed.",
+			Buckets:   []float64{.001, .005, .01, .025, .05, .1, .25, .5, 1, 2.5, 5, 10},
+		}, []string{"procedure", "code"}),
+	}
+}
+
+func (m *Metrics) Describe(ch chan<- *prometheus.Desc) {
+	m.requests.Describe(ch)
+	m.latency.Describe(ch)
+}
+
+func (m *Metrics) Collect(ch chan<- prometheus.Metric) {
+	m.requests.Collect(ch)
+	m.latency.Collect(ch)
+}
+
+// WithMetrics records the requests of the client and their latency in metrics. A retried request
+// is recorded once, with the outcome of its last attempt.
+func WithMetrics(metrics *Metrics) Option {
+	return func(o *options) {
+		o.metrics = metrics
+	}
+}
+
+// observe records a request to procedure started at start, which completed with err.
+func (m *Metrics) observe(procedure string, start time.Time, err error) {
+	code := "ok"
+	if err != nil {
+		code = connect.CodeOf(err).String()
+	}
+	m.requests.WithLabelValues(procedure, code).Inc()
+	m.latency.WithLabelValues(procedure, code).Observe(time.Since(start).Seconds())
+}
+
+// interceptor returns an interceptor observing the unary requests and streams of a client.
+func (m *Metrics) interceptor() connect.Interceptor {
+	return &metricsInterceptor{metrics: m}
+}
+
+type metricsInterceptor struct {
+	metrics *Metrics
+}
+
+func (i *metricsInterceptor) WrapUnary(next connect.UnaryFunc) connect.UnaryFunc {
+	return func(ctx context.Context, req connect.AnyRequest) (connect.AnyResponse, error) {
+		start := time.Now()
+		resp, err := next(ctx, req)
+		i.metrics.observe(req.Spec().Procedure, start, err)
+		return resp, err
+	}
+}
+
+func (i *metricsInterceptor) WrapStreamingClient(next connect.StreamingClientFunc) connect.StreamingClientFunc {
+	return func(ctx context.Context, spec connect.Spec) connect.StreamingClientConn {
+		start := time.Now()
+		return &observedStream{
+			StreamingClientConn: next(ctx, spec),
+			observe: func(err error) {
+				i.metrics.observe(spec.Procedure, start, err)
+			},
+		}
+	}
+}
+
+func (i *metricsInterceptor) WrapStreamingHandler(next connect.StreamingHandlerFunc) connect.StreamingHandlerFunc {
+	return next
+}
+
+// observedStream records its stream once it ends, with the error ending it, if any.
+type observedStream struct {
+	connect.StreamingClientConn
+	once    sync.Once
+	observe func(err error)
+}
+
+func (s *observedStream) Receive(msg any) error {
+	err := s.StreamingClientConn.Receive(msg)
+	if errors.Is(err, io.EOF) {
+		s.done(nil)
+	} else if err != nil {
+		s.done(err)
+	}
+	return err
+}
+
+func (s *observedStream) CloseResponse() error {
+	err := s.StreamingClientConn.CloseResponse()
+	// a stream closed by the client before its end is canceled
+	s.done(connect.NewError(connect.CodeCanceled, context.Canceled))
+	return err
+}
+
+func (s *observedStream) done(err error) {
+	s.once.Do(func() { s.observe(err) })
+}
@@ -0,0 +1,52 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/evstack/ev-node/test/mocks"
+	"github.com/evstack/ev-node/types"
+)
+
+func TestClientMetrics(t *testing.T) {
+	mockStore := mocks.NewMockStore(t)
+	mockStore.On("GetState", mock.Anything).Return(types.State{LastBlockHeight: 5}, nil).Once()
+	mockStore.On("GetState", mock.Anything).Return(types.State{}, errors.New("no state")).Once()
+	testServer, _ := setupTestServer(t, mockStore, mocks.NewMockP2PRPC(t))
+	defer testServer.Close()
+
+	metrics := NewMetrics("test")
+	registry := prometheus.NewRegistry()
+	registry.MustRegister(metrics)
+	client := NewClient(testServer.URL, WithMetrics(metrics))
+
+	_, err := client.GetState(context.Background())
+	require.NoError(t, err)
+	_, err = client.GetState(context.Background())
+	require.Error(t, err)
+
+	families, err := registry.Gather()
+	require.NoError(t, err)
+	counts := make(map[string]float64)
+	for _, family := range families {
+		if family.GetName() != "test_rpc_client_requests_total" {
+			continue
+		}
+		for _, metric := range family.GetMetric() {
+			labels := make(map[string]string)
+			for _, label := range metric.GetLabel() {
+				labels[label.GetName()] = label.GetValue()
+			}
+			counts[labels["procedure"]+" "+labels["code"]] = metric.GetCounter().GetValue()
+		}
+	}
+	require.Equal(t, map[string]float64{
+		"/evnode.v1.StoreService/GetState ok":        1,
+		"/evnode.v1.StoreService/GetState not_found": 1,
+	}, counts)
+}
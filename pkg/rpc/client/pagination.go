@@ -0,0 +1,32 @@
+package client
+
+import (
+	"context"
+
+	pb "github.com/evstack/ev-node/types/pb/evnode/v1"
+)
+
+// PageFunc fetches a page of a list RPC, returning its items and the page response.
+type PageFunc[T any] func(ctx context.Context, page *pb.PageRequest) ([]T, *pb.PageResponse, error)
+
+// AllPages fetches the pages of a list RPC from the first one with the page size limit, 0 for
+// the default of the RPC, and returns the items of all the pages. A node which does not page the
+// RPC returns all the items in the first page.
+func AllPages[T any](ctx context.Context, limit uint32, fetch PageFunc[T]) ([]T, error) {
+	var items []T
+	page := &pb.PageRequest{Limit: limit}
+	for {
+		pageItems, resp, err := fetch(ctx, page)
+		if err != nil {
+			return nil, err
+		}
+		if items == nil && resp.GetTotal() > 0 {
+			items = make([]T, 0, resp.GetTotal())
+		}
+		items = append(items, pageItems...)
+		if resp.GetNextPageToken() == "" {
+			return items, nil
+		}
+		page = &pb.PageRequest{Limit: limit, PageToken: resp.GetNextPageToken()}
+	}
+}
@@ -0,0 +1,72 @@
+package client
+
+import (
+	"context"
+	"math/rand/v2"
+	"slices"
+	"time"
+
+	"connectrpc.com/connect"
+)
+
+// RetryPolicy configures the retries of the requests failing with a transient error.
+type RetryPolicy struct {
+	// MaxAttempts is the maximum number of attempts of a request, including the first one.
+	MaxAttempts int
+	// InitialBackoff is the wait before the first retry. The wait doubles after every retry, up
+	// to MaxBackoff, and is jittered so that clients retrying together spread their requests.
+	InitialBackoff time.Duration
+	// MaxBackoff is the longest wait between two attempts.
+	MaxBackoff time.Duration
+	// Codes are the error codes retried.
+	Codes []connect.Code
+}
+
+// DefaultRetryPolicy retries requests up to 3 times on the errors of a node which is unavailable
+// or overloaded.
+var DefaultRetryPolicy = RetryPolicy{
+	MaxAttempts:    4,
+	InitialBackoff: 100 * time.Millisecond,
+	MaxBackoff:     2 * time.Second,
+	Codes:          []connect.Code{connect.CodeUnavailable, connect.CodeResourceExhausted, connect.CodeAborted},
+}
+
+// WithRetryPolicy retries the requests of the client failing with one of the codes of the policy.
+// Only the RPCs without side effects or declared idempotent are retried, so that a request is
+// never applied twice, and streaming RPCs are not retried. The wait between attempts ends early
+// when the context of the request is done.
+func WithRetryPolicy(policy RetryPolicy) Option {
+	return func(o *options) {
+		o.retryPolicy = &policy
+	}
+}
+
+// retryInterceptor returns the interceptor applying the policy to unary requests.
+func retryInterceptor(policy RetryPolicy) connect.UnaryInterceptorFunc {
+	return func(next connect.UnaryFunc) connect.UnaryFunc {
+		return func(ctx context.Context, req connect.AnyRequest) (connect.AnyResponse, error) {
+			if req.Spec().IdempotencyLevel == connect.IdempotencyUnknown {
+				return next(ctx, req)
+			}
+
+			backoff := policy.InitialBackoff
+			for attempt := 1; ; attempt++ {
+				resp, err := next(ctx, req)
+				if err == nil || attempt >= policy.MaxAttempts || !slices.Contains(policy.Codes, connect.CodeOf(err)) {
+					return resp, err
+				}
+
+				// wait between half and all of the backoff
+				wait := backoff/2 + rand.N(backoff/2+1)
+				timer := time.NewTimer(wait)
+				select {
+				case <-ctx.Done():
+					timer.Stop()
+					return nil, err
+				case <-timer.C:
+				}
+				backoff = min(2*backoff, policy.MaxBackoff)
+			}
+		}
+	}
+}
@@ -0,0 +1,118 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/net/http2"
+	"golang.org/x/net/http2/h2c"
+	"google.golang.org/protobuf/types/known/emptypb"
+
+	pb "github.com/evstack/ev-node/types/pb/evnode/v1"
+	rpc "github.com/evstack/ev-node/types/pb/evnode/v1/v1connect"
+)
+
+// flakyServer fails its first requests with code.
+type flakyServer struct {
+	rpc.UnimplementedConfigServiceHandler
+	rpc.UnimplementedAdminServiceHandler
+	code     connect.Code
+	failures int32
+	calls    atomic.Int32
+}
+
+func (s *flakyServer) fail() error {
+	if s.calls.Add(1) <= s.failures {
+		return connect.NewError(s.code, errors.New("transient"))
+	}
+	return nil
+}
+
+func (s *flakyServer) GetNodeInfo(context.Context, *connect.Request[emptypb.Empty]) (*connect.Response[pb.GetNodeInfoResponse], error) {
+	if err := s.fail(); err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(&pb.GetNodeInfoResponse{ChainId: "test-chain"}), nil
+}
+
+func (s *flakyServer) Shutdown(context.Context, *connect.Request[emptypb.Empty]) (*connect.Response[emptypb.Empty], error) {
+	if err := s.fail(); err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(&emptypb.Empty{}), nil
+}
+
+func newFlakyServer(t *testing.T, code connect.Code, failures int32) (*flakyServer, string) {
+	t.Helper()
+	server := &flakyServer{code: code, failures: failures}
+	mux := http.NewServeMux()
+	mux.Handle(rpc.NewConfigServiceHandler(server))
+	mux.Handle(rpc.NewAdminServiceHandler(server))
+	httpServer := httptest.NewServer(h2c.NewHandler(mux, &http2.Server{}))
+	t.Cleanup(httpServer.Close)
+	return server, httpServer.URL
+}
+
+func TestRetryPolicy(t *testing.T) {
+	ctx := context.Background()
+	policy := DefaultRetryPolicy
+	policy.InitialBackoff = time.Millisecond
+	policy.MaxBackoff = 5 * time.Millisecond
+
+	t.Run("transient errors are retried", func(t *testing.T) {
+		server, url := newFlakyServer(t, connect.CodeUnavailable, 2)
+		info, err := NewClient(url, WithRetryPolicy(policy)).GetNodeInfo(ctx)
+		require.NoError(t, err)
+		require.Equal(t, "test-chain", info.ChainId)
+		require.Equal(t, int32(3), server.calls.Load())
+	})
+
+	t.Run("attempts are bounded", func(t *testing.T) {
+		server, url := newFlakyServer(t, connect.CodeUnavailable, 10)
+		_, err := NewClient(url, WithRetryPolicy(policy)).GetNodeInfo(ctx)
+		require.Equal(t, connect.CodeUnavailable, connect.CodeOf(err))
+		require.Equal(t, int32(policy.MaxAttempts), server.calls.Load())
+	})
+
+	t.Run("other codes are not retried", func(t *testing.T) {
+		server, url := newFlakyServer(t, connect.CodeInvalidArgument, 1)
+		_, err := NewClient(url, WithRetryPolicy(policy)).GetNodeInfo(ctx)
+		require.Equal(t, connect.CodeInvalidArgument, connect.CodeOf(err))
+		require.Equal(t, int32(1), server.calls.Load())
+	})
+
+	t.Run("requests with side effects are not retried", func(t *testing.T) {
+		server, url := newFlakyServer(t, connect.CodeUnavailable, 1)
+		err := NewClient(url, WithRetryPolicy(policy)).Shutdown(ctx)
+		require.Equal(t, connect.CodeUnavailable, connect.CodeOf(err))
+		require.Equal(t, int32(1), server.calls.Load())
+	})
+
+	t.Run("context cancellation stops the retries", func(t *testing.T) {
+		server, url := newFlakyServer(t, connect.CodeUnavailable, 10)
+		slow := policy
+		slow.InitialBackoff, slow.MaxBackoff = time.Hour, time.Hour
+		ctx, cancel := context.WithTimeout(ctx, 50*time.Millisecond)
+		defer cancel()
+
+		start := time.Now()
+		_, err := NewClient(url, WithRetryPolicy(slow)).GetNodeInfo(ctx)
+		require.Equal(t, connect.CodeUnavailable, connect.CodeOf(err))
+		require.Less(t, time.Since(start), time.Minute)
+		require.Equal(t, int32(1), server.calls.Load())
+	})
+
+	t.Run("no retries by default", func(t *testing.T) {
+		server, url := newFlakyServer(t, connect.CodeUnavailable, 1)
+		_, err := NewClient(url).GetNodeInfo(ctx)
+		require.Equal(t, connect.CodeUnavailable, connect.CodeOf(err))
+		require.Equal(t, int32(1), server.calls.Load())
+	})
+}
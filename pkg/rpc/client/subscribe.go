@@ -0,0 +1,155 @@
+package client
+
+import (
+	"context"
+	"math/rand/v2"
+	"slices"
+	"time"
+
+	"connectrpc.com/connect"
+	"google.golang.org/protobuf/types/known/emptypb"
+
+	pb "github.com/evstack/ev-node/types/pb/evnode/v1"
+)
+
+const (
+	// subscribeInitialBackoff is the wait before reconnecting a failed block subscription. The
+	// wait doubles after every failed attempt, up to subscribeMaxBackoff.
+	subscribeInitialBackoff = 100 * time.Millisecond
+	// subscribeMaxBackoff is the longest wait before reconnecting a block subscription.
+	subscribeMaxBackoff = 5 * time.Second
+)
+
+// subscribeFatalCodes are the error codes ending a block subscription, as reconnecting would fail
+// the same way.
+var subscribeFatalCodes = []connect.Code{
+	connect.CodeInvalidArgument,
+	connect.CodeNotFound,
+	connect.CodeFailedPrecondition,
+	connect.CodeOutOfRange,
+	connect.CodeUnimplemented,
+	connect.CodeUnauthenticated,
+	connect.CodePermissionDenied,
+}
+
+// SubscribeBlocks streams the blocks from fromHeight, or from the next block if 0, then the new
+// blocks as the node produces or syncs them, in height order and without gaps. When the stream
+// fails, e.g. because the node restarts, the subscription reconnects with backoff and resumes
+// after the last block received. The channel is closed when ctx is done, or when the node rejects
+// the subscription, e.g. because the requested blocks were pruned or the token is not authorized.
+func (c *Client) SubscribeBlocks(ctx context.Context, fromHeight uint64) (<-chan *pb.Block, error) {
+	if fromHeight == 0 {
+		// resolved once, so that the blocks produced while reconnecting are not skipped
+		state, err := c.GetState(ctx)
+		if err != nil {
+			return nil, err
+		}
+		fromHeight = state.LastBlockHeight + 1
+	}
+
+	blocks := make(chan *pb.Block)
+	go func() {
+		defer close(blocks)
+		next, backoff := fromHeight, subscribeInitialBackoff
+		for {
+			received, err := c.subscribeBlocks(ctx, next, blocks)
+			if received > next {
+				next, backoff = received, subscribeInitialBackoff
+			}
+			if ctx.Err() != nil || subscribeFatal(err) {
+				return
+			}
+
+			// wait between half and all of the backoff
+			timer := time.NewTimer(backoff/2 + rand.N(backoff/2+1))
+			select {
+			case <-ctx.Done():
+				timer.Stop()
+				return
+			case <-timer.C:
+			}
+			backoff = min(2*backoff, subscribeMaxBackoff)
+		}
+	}()
+	return blocks, nil
+}
+
+// subscribeFatal returns whether the node rejected the subscription. Errors synthesized by the
+// client, e.g. for a stream cut by the network, are not fatal whatever their code.
+func subscribeFatal(err error) bool {
+	return connect.IsWireError(err) && slices.Contains(subscribeFatalCodes, connect.CodeOf(err))
+}
+
+// subscribeBlocks sends the blocks of a single SubscribeBlocks stream from height to blocks, and
+// returns the height following the last block sent with the error ending the stream.
+func (c *Client) subscribeBlocks(ctx context.Context, height uint64, blocks chan<- *pb.Block) (uint64, error) {
+	stream, err := c.storeClient.SubscribeBlocks(ctx, connect.NewRequest(&pb.SubscribeBlocksRequest{FromHeight: height}))
+	if err != nil {
+		return height, err
+	}
+	defer stream.Close()
+
+	for stream.Receive() {
+		block := stream.Msg().GetBlock()
+		select {
+		case blocks <- block:
+		case <-ctx.Done():
+			return height, ctx.Err()
+		}
+		height = block.GetHeader().GetHeader().GetHeight() + 1
+	}
+	return height, stream.Err()
+}
+
+// SubscribePreviewBlocks streams the unsigned preview blocks the node publishes or receives ahead
+// of their signed header, if it gossips previews (node.preview_blocks). Previews are not verified,
+// may never become blocks, and are skipped while the subscription reconnects, so they should only
+// be used to show blocks early, until SubscribeBlocks delivers them. The channel is closed when ctx
+// is done, or when the node rejects the subscription, e.g. because it does not gossip previews.
+func (c *Client) SubscribePreviewBlocks(ctx context.Context) <-chan *pb.Block {
+	previews := make(chan *pb.Block)
+	go func() {
+		defer close(previews)
+		backoff := subscribeInitialBackoff
+		for {
+			received, err := c.subscribePreviewBlocks(ctx, previews)
+			if received {
+				backoff = subscribeInitialBackoff
+			}
+			if ctx.Err() != nil || subscribeFatal(err) {
+				return
+			}
+
+			timer := time.NewTimer(backoff/2 + rand.N(backoff/2+1))
+			select {
+			case <-ctx.Done():
+				timer.Stop()
+				return
+			case <-timer.C:
+			}
+			backoff = min(2*backoff, subscribeMaxBackoff)
+		}
+	}()
+	return previews
+}
+
+// subscribePreviewBlocks sends the previews of a single SubscribePreviewBlocks stream to previews,
+// and returns whether any was sent with the error ending the stream.
+func (c *Client) subscribePreviewBlocks(ctx context.Context, previews chan<- *pb.Block) (bool, error) {
+	stream, err := c.storeClient.SubscribePreviewBlocks(ctx, connect.NewRequest(&emptypb.Empty{}))
+	if err != nil {
+		return false, err
+	}
+	defer stream.Close()
+
+	received := false
+	for stream.Receive() {
+		select {
+		case previews <- stream.Msg().GetBlock():
+			received = true
+		case <-ctx.Done():
+			return received, ctx.Err()
+		}
+	}
+	return received, stream.Err()
+}
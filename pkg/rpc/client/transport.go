@@ -0,0 +1,163 @@
+package client
+
+import (
+	"context"
+	"crypto/tls"
+	"io"
+	"net"
+	"net/http"
+	"sync"
+	"time"
+
+	"connectrpc.com/connect"
+)
+
+// WithDialer connects the client to the node through dial, e.g. the DialContext of an SSH client
+// or of a SOCKS proxy dialer, for nodes only reachable through a tunnel or proxy. dial is called
+// with the address of the node in the URL, and its context bounds the connection as for
+// WithDialTimeout. It replaces the dialer of WithUnixSocket, and the TLS and keepalive options
+// still apply to the connections it returns.
+func WithDialer(dial func(ctx context.Context, network, addr string) (net.Conn, error)) Option {
+	return func(o *options) {
+		o.dialer = dial
+	}
+}
+
+// WithTransport sends the requests of the client through transport, e.g. an http.Transport with a
+// proxy or a round tripper adding headers. The transport is used as is: the dialer, unix socket,
+// TLS and keepalive options are then ignored, while authentication, retries and the limit of
+// concurrent streams still apply.
+func WithTransport(transport http.RoundTripper) Option {
+	return func(o *options) {
+		o.transport = transport
+	}
+}
+
+// WithDialTimeout bounds the time to connect to the node, 30 seconds by default.
+func WithDialTimeout(timeout time.Duration) Option {
+	return func(o *options) {
+		o.dialTimeout = timeout
+	}
+}
+
+// WithReadTimeout bounds the time to get the response of a unary request, for requests whose
+// context has no earlier deadline. Every attempt of a retried request is bound on its own.
+// Streaming requests are not bound.
+func WithReadTimeout(timeout time.Duration) Option {
+	return func(o *options) {
+		o.readTimeout = timeout
+	}
+}
+
+// WithKeepalive makes the client ping the node over HTTP/2 connections idle for interval, and
+// close the connections whose ping is not answered within timeout, so that requests and streams
+// on a connection to an unreachable node fail instead of hanging. Pings only apply to HTTP/2,
+// i.e. to https URLs: requests to http URLs use HTTP/1.1 connections, which only get the TCP
+// keepalives of the dialer, so WithReadTimeout is needed to bound their requests.
+func WithKeepalive(interval, timeout time.Duration) Option {
+	return func(o *options) {
+		o.keepaliveInterval = interval
+		o.keepaliveTimeout = timeout
+	}
+}
+
+// WithMaxConcurrentStreams bounds the number of requests and streams of the client in flight at
+// once. Further requests wait for one to complete, or for their context to be done.
+func WithMaxConcurrentStreams(n int) Option {
+	return func(o *options) {
+		o.maxConcurrentStreams = n
+	}
+}
+
+// newHTTPClient returns the HTTP client sending the requests of a client with the options.
+func newHTTPClient(o *options) connect.HTTPClient {
+	var httpClient connect.HTTPClient = http.DefaultClient
+	switch {
+	case o.transport != nil:
+		httpClient = &http.Client{Transport: o.transport}
+	case o.dialer != nil || o.unixSocket != "" || o.tlsConfig != nil || o.rootCAs != nil || o.dialTimeout > 0 || o.keepaliveInterval > 0:
+		transport := http.DefaultTransport.(*http.Transport).Clone()
+		dialer := &net.Dialer{Timeout: 30 * time.Second, KeepAlive: 30 * time.Second}
+		if o.dialTimeout > 0 {
+			dialer.Timeout = o.dialTimeout
+		}
+		transport.DialContext = dialer.DialContext
+		switch {
+		case o.dialer != nil:
+			transport.DialContext = func(ctx context.Context, network, addr string) (net.Conn, error) {
+				ctx, cancel := context.WithTimeout(ctx, dialer.Timeout)
+				defer cancel()
+				return o.dialer(ctx, network, addr)
+			}
+		case o.unixSocket != "":
+			transport.DialContext = func(ctx context.Context, _, _ string) (net.Conn, error) {
+				return dialer.DialContext(ctx, "unix", o.unixSocket)
+			}
+		}
+		if o.tlsConfig != nil || o.rootCAs != nil {
+			tlsConfig := &tls.Config{MinVersion: tls.VersionTLS12}
+			if o.tlsConfig != nil {
+				tlsConfig = o.tlsConfig.Clone()
+			}
+			if o.rootCAs != nil {
+				tlsConfig.RootCAs = o.rootCAs
+			}
+			transport.TLSClientConfig = tlsConfig
+		}
+		if o.keepaliveInterval > 0 {
+			transport.HTTP2 = &http.HTTP2Config{SendPingTimeout: o.keepaliveInterval, PingTimeout: o.keepaliveTimeout}
+		}
+		httpClient = &http.Client{Transport: transport}
+	}
+	if o.maxConcurrentStreams > 0 {
+		httpClient = &limitedClient{next: httpClient, slots: make(chan struct{}, o.maxConcurrentStreams)}
+	}
+	return httpClient
+}
+
+// readTimeoutInterceptor bounds the unary requests without an earlier deadline by timeout.
+func readTimeoutInterceptor(timeout time.Duration) connect.UnaryInterceptorFunc {
+	return func(next connect.UnaryFunc) connect.UnaryFunc {
+		return func(ctx context.Context, req connect.AnyRequest) (connect.AnyResponse, error) {
+			ctx, cancel := context.WithTimeout(ctx, timeout)
+			defer cancel()
+			return next(ctx, req)
+		}
+	}
+}
+
+// limitedClient bounds the number of requests in flight. A request is in flight until the body
+// of its response is closed, which connect does once done with the response of unary and
+// streaming requests.
+type limitedClient struct {
+	next  connect.HTTPClient
+	slots chan struct{}
+}
+
+func (c *limitedClient) Do(req *http.Request) (*http.Response, error) {
+	select {
+	case c.slots <- struct{}{}:
+	case <-req.Context().Done():
+		return nil, req.Context().Err()
+	}
+	resp, err := c.next.Do(req)
+	if err != nil {
+		<-c.slots
+		return nil, err
+	}
+	resp.Body = &releasingBody{ReadCloser: resp.Body, release: func() { <-c.slots }}
+	return resp, nil
+}
+
+// releasingBody releases the slot of its request when closed.
+type releasingBody struct {
+	io.ReadCloser
+	once    sync.Once
+	release func()
+}
+
+func (b *releasingBody) Close() error {
+	err := b.ReadCloser.Close()
+	b.once.Do(b.release)
+	return err
+}
@@ -0,0 +1,137 @@
+package client
+
+import (
+	"context"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/stretchr/testify/require"
+)
+
+func TestClientWithReadTimeout(t *testing.T) {
+	// the node accepts the connection but never responds
+	stop := make(chan struct{})
+	testServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		<-stop
+	}))
+	defer testServer.Close()
+	defer close(stop)
+
+	start := time.Now()
+	_, err := NewClient(testServer.URL, WithReadTimeout(100*time.Millisecond)).GetState(context.Background())
+	require.Equal(t, connect.CodeDeadlineExceeded, connect.CodeOf(err))
+	require.Less(t, time.Since(start), 5*time.Second)
+
+	// an earlier deadline of the request wins
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	_, err = NewClient(testServer.URL, WithReadTimeout(time.Hour)).GetState(ctx)
+	require.Equal(t, connect.CodeDeadlineExceeded, connect.CodeOf(err))
+}
+
+func TestClientWithMaxConcurrentStreams(t *testing.T) {
+	var inFlight, maxInFlight atomic.Int32
+	release := make(chan struct{})
+	testServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		n := inFlight.Add(1)
+		defer inFlight.Add(-1)
+		for {
+			m := maxInFlight.Load()
+			if n <= m || maxInFlight.CompareAndSwap(m, n) {
+				break
+			}
+		}
+		<-release
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer testServer.Close()
+
+	c := NewClient(testServer.URL, WithMaxConcurrentStreams(2))
+	var wg sync.WaitGroup
+	for range 5 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			_, _ = c.GetState(context.Background())
+		}()
+	}
+	require.Eventually(t, func() bool { return inFlight.Load() == 2 }, 5*time.Second, 10*time.Millisecond)
+	close(release)
+	wg.Wait()
+	require.Equal(t, int32(2), maxInFlight.Load())
+
+	// a request waiting for a slot ends with its context
+	limited := newHTTPClient(&options{maxConcurrentStreams: 1}).(*limitedClient)
+	limited.slots <- struct{}{}
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, testServer.URL, nil)
+	require.NoError(t, err)
+	_, err = limited.Do(req)
+	require.ErrorIs(t, err, context.Canceled)
+}
+
+func TestClientWithKeepaliveAndDialTimeout(t *testing.T) {
+	httpClient := newHTTPClient(&options{
+		dialTimeout:       time.Second,
+		keepaliveInterval: 10 * time.Second,
+		keepaliveTimeout:  5 * time.Second,
+	})
+	transport := httpClient.(*http.Client).Transport.(*http.Transport)
+	require.Equal(t, 10*time.Second, transport.HTTP2.SendPingTimeout)
+	require.Equal(t, 5*time.Second, transport.HTTP2.PingTimeout)
+
+	// nothing listens on the reserved port
+	start := time.Now()
+	_, err := NewClient("http://127.0.0.1:1", WithDialTimeout(time.Second)).GetState(context.Background())
+	require.Error(t, err)
+	require.Less(t, time.Since(start), 5*time.Second)
+
+	// the default client is used without any transport option
+	require.Equal(t, http.DefaultClient, newHTTPClient(&options{}))
+}
+
+func TestClientWithDialerAndTransport(t *testing.T) {
+	var requests atomic.Int32
+	testServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests.Add(1)
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer testServer.Close()
+
+	// the dialer reaches the node whatever the host of the URL, as a tunnel would
+	var dialed atomic.Value
+	dial := func(ctx context.Context, network, addr string) (net.Conn, error) {
+		dialed.Store(addr)
+		var d net.Dialer
+		return d.DialContext(ctx, network, testServer.Listener.Addr().String())
+	}
+	_, err := NewClient("http://node.internal:7331", WithDialer(dial)).GetState(context.Background())
+	require.Error(t, err)
+	require.Equal(t, "node.internal:7331", dialed.Load())
+	require.Equal(t, int32(1), requests.Load())
+
+	// the transport is used as is
+	var roundTrips atomic.Int32
+	transport := roundTripperFunc(func(req *http.Request) (*http.Response, error) {
+		roundTrips.Add(1)
+		return http.DefaultTransport.RoundTrip(req)
+	})
+	_, err = NewClient(testServer.URL, WithTransport(transport), WithUnixSocket("/nonexistent.sock")).GetState(context.Background())
+	require.Error(t, err)
+	require.Equal(t, int32(1), roundTrips.Load())
+	require.Equal(t, int32(2), requests.Load())
+}
+
+// roundTripperFunc is a round tripper calling a function.
+type roundTripperFunc func(*http.Request) (*http.Response, error)
+
+func (f roundTripperFunc) RoundTrip(req *http.Request) (*http.Response, error) {
+	return f(req)
+}
@@ -0,0 +1,145 @@
+package client
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/evstack/ev-node/types"
+	pb "github.com/evstack/ev-node/types/pb/evnode/v1"
+)
+
+// TypedClient calls the RPCs of a Client and converts their responses into the types of the node,
+// e.g. types.State and types.SignedHeader, so that callers do not depend on the protobuf messages.
+type TypedClient struct {
+	c *Client
+}
+
+// Typed returns a TypedClient sending its requests through c.
+func (c *Client) Typed() *TypedClient {
+	return &TypedClient{c: c}
+}
+
+// Block is a block with the DA heights of its header and data, 0 if not included on DA yet.
+type Block struct {
+	Header         *types.SignedHeader
+	Data           *types.Data
+	HeaderDAHeight uint64
+	DataDAHeight   uint64
+}
+
+// GetState returns the current state.
+func (t *TypedClient) GetState(ctx context.Context) (types.State, error) {
+	resp, err := t.c.GetState(ctx)
+	if err != nil {
+		return types.State{}, err
+	}
+	var state types.State
+	if err := state.FromProto(resp); err != nil {
+		return types.State{}, fmt.Errorf("invalid state: %w", err)
+	}
+	return state, nil
+}
+
+// GetBlockByHeight returns a block by height.
+func (t *TypedClient) GetBlockByHeight(ctx context.Context, height uint64) (*Block, error) {
+	resp, err := t.c.GetBlockByHeight(ctx, height)
+	if err != nil {
+		return nil, err
+	}
+	return blockFromProto(resp)
+}
+
+// GetBlockByHash returns a block by hash.
+func (t *TypedClient) GetBlockByHash(ctx context.Context, hash []byte) (*Block, error) {
+	resp, err := t.c.GetBlockByHash(ctx, hash)
+	if err != nil {
+		return nil, err
+	}
+	return blockFromProto(resp)
+}
+
+// GetHeader returns the signed header of a block with the DA height it was included at, 0 if not
+// included yet.
+func (t *TypedClient) GetHeader(ctx context.Context, height uint64) (*types.SignedHeader, uint64, error) {
+	resp, err := t.c.GetHeader(ctx, height)
+	if err != nil {
+		return nil, 0, err
+	}
+	header, err := signedHeaderFromProto(resp.GetHeader())
+	if err != nil {
+		return nil, 0, err
+	}
+	return header, resp.GetHeaderDaHeight(), nil
+}
+
+// GetHeaderRange returns the signed headers of the blocks from fromHeight to toHeight inclusive.
+// Heights above the latest block are ignored.
+func (t *TypedClient) GetHeaderRange(ctx context.Context, fromHeight, toHeight uint64) ([]*types.SignedHeader, error) {
+	resp, err := t.c.GetHeaderRange(ctx, fromHeight, toHeight)
+	if err != nil {
+		return nil, err
+	}
+	headers := make([]*types.SignedHeader, 0, len(resp))
+	for _, h := range resp {
+		header, err := signedHeaderFromProto(h)
+		if err != nil {
+			return nil, err
+		}
+		headers = append(headers, header)
+	}
+	return headers, nil
+}
+
+// SubscribeBlocks streams the blocks from fromHeight, or from the next block if 0, like
+// Client.SubscribeBlocks. The channel is also closed on a block which cannot be converted.
+func (t *TypedClient) SubscribeBlocks(ctx context.Context, fromHeight uint64) (<-chan *Block, error) {
+	ctx, cancel := context.WithCancel(ctx)
+	pbBlocks, err := t.c.SubscribeBlocks(ctx, fromHeight)
+	if err != nil {
+		cancel()
+		return nil, err
+	}
+
+	blocks := make(chan *Block)
+	go func() {
+		defer close(blocks)
+		defer cancel()
+		for pbBlock := range pbBlocks {
+			block, err := blockFromProto(&pb.GetBlockResponse{Block: pbBlock})
+			if err != nil {
+				return
+			}
+			select {
+			case blocks <- block:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+	return blocks, nil
+}
+
+func blockFromProto(resp *pb.GetBlockResponse) (*Block, error) {
+	header, err := signedHeaderFromProto(resp.GetBlock().GetHeader())
+	if err != nil {
+		return nil, err
+	}
+	data := new(types.Data)
+	if err := data.FromProto(resp.GetBlock().GetData()); err != nil {
+		return nil, fmt.Errorf("invalid data: %w", err)
+	}
+	return &Block{
+		Header:         header,
+		Data:           data,
+		HeaderDAHeight: resp.GetHeaderDaHeight(),
+		DataDAHeight:   resp.GetDataDaHeight(),
+	}, nil
+}
+
+func signedHeaderFromProto(h *pb.SignedHeader) (*types.SignedHeader, error) {
+	header := new(types.SignedHeader)
+	if err := header.FromProto(h); err != nil {
+		return nil, fmt.Errorf("invalid header: %w", err)
+	}
+	return header, nil
+}
@@ -0,0 +1,185 @@
+package client
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/evstack/ev-node/pkg/genesis"
+	"github.com/evstack/ev-node/types"
+	pb "github.com/evstack/ev-node/types/pb/evnode/v1"
+)
+
+// ErrUnverified is returned by a VerifyingClient for a header or block which fails verification,
+// e.g. returned by a compromised or misbehaving node.
+var ErrUnverified = errors.New("header failed verification")
+
+// VerifyingClient returns the headers and blocks of a TypedClient only once it verified them
+// against the genesis of the chain, so that consumers do not trust the node serving them: the
+// header is of the chain and height requested, signed by the sequencer of the genesis, and the
+// data of a block is the one committed to by its header.
+type VerifyingClient struct {
+	t        *TypedClient
+	chainID  string
+	proposer []byte
+	// payloadProvider returns the bytes signed by the sequencer, the default payload if nil
+	payloadProvider types.SignaturePayloadProvider
+}
+
+// VerifyOption configures a VerifyingClient.
+type VerifyOption func(*VerifyingClient)
+
+// WithSignaturePayloadProvider verifies the signatures of the headers over the payload returned
+// by provider, for chains whose sequencer signs a custom payload.
+func WithSignaturePayloadProvider(provider types.SignaturePayloadProvider) VerifyOption {
+	return func(v *VerifyingClient) {
+		v.payloadProvider = provider
+	}
+}
+
+// Verifying returns a VerifyingClient sending its requests through c and verifying the responses
+// against gen. Only the chain ID and proposer address of the genesis are used.
+func (c *Client) Verifying(gen genesis.Genesis, opts ...VerifyOption) *VerifyingClient {
+	v := &VerifyingClient{t: c.Typed(), chainID: gen.ChainID, proposer: gen.ProposerAddress}
+	for _, opt := range opts {
+		opt(v)
+	}
+	return v
+}
+
+// GetHeader returns the verified signed header of a block with the DA height it was included at, 0
+// if not included yet.
+func (v *VerifyingClient) GetHeader(ctx context.Context, height uint64) (*types.SignedHeader, uint64, error) {
+	header, daHeight, err := v.t.GetHeader(ctx, height)
+	if err != nil {
+		return nil, 0, err
+	}
+	if err := v.verifyHeader(header, height); err != nil {
+		return nil, 0, err
+	}
+	return header, daHeight, nil
+}
+
+// GetHeaderRange returns the verified signed headers of the blocks from fromHeight to toHeight
+// inclusive. Heights above the latest block are ignored.
+func (v *VerifyingClient) GetHeaderRange(ctx context.Context, fromHeight, toHeight uint64) ([]*types.SignedHeader, error) {
+	headers, err := v.t.GetHeaderRange(ctx, fromHeight, toHeight)
+	if err != nil {
+		return nil, err
+	}
+	if len(headers) > int(toHeight-fromHeight+1) {
+		return nil, fmt.Errorf("%w: %d headers returned for range %d-%d", ErrUnverified, len(headers), fromHeight, toHeight)
+	}
+	for i, header := range headers {
+		if err := v.verifyHeader(header, fromHeight+uint64(i)); err != nil {
+			return nil, err
+		}
+	}
+	return headers, nil
+}
+
+// GetBlockByHeight returns a verified block by height, the latest block if height is 0.
+func (v *VerifyingClient) GetBlockByHeight(ctx context.Context, height uint64) (*Block, error) {
+	block, err := v.t.GetBlockByHeight(ctx, height)
+	if err != nil {
+		return nil, err
+	}
+	if err := v.verifyBlock(block, height); err != nil {
+		return nil, err
+	}
+	return block, nil
+}
+
+// GetBlockByHash returns a verified block by hash.
+func (v *VerifyingClient) GetBlockByHash(ctx context.Context, hash []byte) (*Block, error) {
+	block, err := v.t.GetBlockByHash(ctx, hash)
+	if err != nil {
+		return nil, err
+	}
+	if err := v.verifyBlock(block, 0); err != nil {
+		return nil, err
+	}
+	if !bytes.Equal(block.Header.Hash(), hash) {
+		return nil, fmt.Errorf("%w: block %s returned for hash %X", ErrUnverified, block.Header.Hash(), hash)
+	}
+	return block, nil
+}
+
+// SubscribeBlocks streams the verified blocks from fromHeight, or from the next block if 0, like
+// Client.SubscribeBlocks. The channel is also closed on a block which fails verification.
+func (v *VerifyingClient) SubscribeBlocks(ctx context.Context, fromHeight uint64) (<-chan *Block, error) {
+	ctx, cancel := context.WithCancel(ctx)
+	pbBlocks, err := v.t.c.SubscribeBlocks(ctx, fromHeight)
+	if err != nil {
+		cancel()
+		return nil, err
+	}
+
+	blocks := make(chan *Block)
+	go func() {
+		defer close(blocks)
+		defer cancel()
+		next := fromHeight
+		for pbBlock := range pbBlocks {
+			block, err := blockFromProto(&pb.GetBlockResponse{Block: pbBlock})
+			if err != nil {
+				return
+			}
+			if err := v.verifyBlock(block, next); err != nil {
+				return
+			}
+			next = block.Header.Height() + 1
+			select {
+			case blocks <- block:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+	return blocks, nil
+}
+
+// verifyBlock verifies the header of block, at height unless 0, and that its data is the one
+// committed to by the header.
+func (v *VerifyingClient) verifyBlock(block *Block, height uint64) error {
+	if err := v.verifyHeader(block.Header, height); err != nil {
+		return err
+	}
+	if err := types.Validate(block.Header, block.Data); err != nil {
+		return fmt.Errorf("%w: block %d: %v", ErrUnverified, block.Header.Height(), err)
+	}
+	return nil
+}
+
+// verifyHeader verifies that header is of the chain, at height unless 0, and signed by the
+// sequencer of the genesis.
+func (v *VerifyingClient) verifyHeader(header *types.SignedHeader, height uint64) error {
+	if height != 0 && header.Height() != height {
+		return fmt.Errorf("%w: header %d returned for height %d", ErrUnverified, header.Height(), height)
+	}
+	if header.ChainID() != v.chainID {
+		return fmt.Errorf("%w: header %d is of chain %q, not %q", ErrUnverified, header.Height(), header.ChainID(), v.chainID)
+	}
+	if !bytes.Equal(header.ProposerAddress, v.proposer) {
+		return fmt.Errorf("%w: header %d is proposed by %X, not the sequencer %X", ErrUnverified, header.Height(), header.ProposerAddress, v.proposer)
+	}
+	// the signer of the header is trusted only if its key is the one of the sequencer address
+	if header.Signer.PubKey == nil {
+		return fmt.Errorf("%w: header %d has no signer", ErrUnverified, header.Height())
+	}
+	signer, err := types.NewSigner(header.Signer.PubKey)
+	if err != nil {
+		return fmt.Errorf("%w: header %d: invalid signer: %v", ErrUnverified, header.Height(), err)
+	}
+	if !bytes.Equal(signer.Address, v.proposer) {
+		return fmt.Errorf("%w: header %d is signed by %X, not the sequencer %X", ErrUnverified, header.Height(), signer.Address, v.proposer)
+	}
+	if v.payloadProvider != nil {
+		header.SetCustomVerifier(v.payloadProvider)
+	}
+	if err := header.ValidateBasic(); err != nil {
+		return fmt.Errorf("%w: header %d: %v", ErrUnverified, header.Height(), err)
+	}
+	return nil
+}
@@ -0,0 +1,61 @@
+package client
+
+import (
+	"context"
+	"testing"
+
+	ds "github.com/ipfs/go-datastore"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/evstack/ev-node/pkg/genesis"
+	"github.com/evstack/ev-node/test/mocks"
+	"github.com/evstack/ev-node/types"
+)
+
+func TestVerifyingClient(t *testing.T) {
+	const chainID = "test-chain"
+	config := types.BlockConfig{Height: 5, NTxs: 2}
+	header, data, sequencerKey := types.GenerateRandomBlockCustom(&config, chainID)
+	gen := genesis.Genesis{ChainID: chainID, ProposerAddress: header.ProposerAddress}
+
+	// a block signed by another key on behalf of the sequencer
+	forged := types.BlockConfig{Height: 6, NTxs: 1, ProposerAddr: header.ProposerAddress}
+	forgedHeader, forgedData, _ := types.GenerateRandomBlockCustom(&forged, chainID)
+
+	// a block whose data was replaced
+	tampered := types.BlockConfig{Height: 7, NTxs: 2, PrivKey: sequencerKey}
+	tamperedHeader, tamperedData, _ := types.GenerateRandomBlockCustom(&tampered, chainID)
+	tamperedData.Txs[0] = types.Tx("injected")
+
+	mockStore := mocks.NewMockStore(t)
+	mockStore.On("GetBlockData", mock.Anything, uint64(5)).Return(header, data, nil)
+	mockStore.On("GetBlockData", mock.Anything, uint64(6)).Return(forgedHeader, forgedData, nil)
+	mockStore.On("GetBlockData", mock.Anything, uint64(7)).Return(tamperedHeader, tamperedData, nil)
+	mockStore.On("GetHeader", mock.Anything, uint64(5)).Return(header, nil)
+	mockStore.On("GetHeader", mock.Anything, uint64(6)).Return(forgedHeader, nil)
+	mockStore.On("GetMetadata", mock.Anything, mock.Anything).Return(nil, ds.ErrNotFound)
+
+	testServer, client := setupTestServer(t, mockStore, mocks.NewMockP2PRPC(t))
+	defer testServer.Close()
+	verifying := client.Verifying(gen)
+	ctx := context.Background()
+
+	block, err := verifying.GetBlockByHeight(ctx, 5)
+	require.NoError(t, err)
+	require.Equal(t, header.Hash(), block.Header.Hash())
+	got, _, err := verifying.GetHeader(ctx, 5)
+	require.NoError(t, err)
+	require.Equal(t, header.Hash(), got.Hash())
+
+	_, err = verifying.GetBlockByHeight(ctx, 6)
+	require.ErrorIs(t, err, ErrUnverified)
+	_, _, err = verifying.GetHeader(ctx, 6)
+	require.ErrorIs(t, err, ErrUnverified)
+	_, err = verifying.GetBlockByHeight(ctx, 7)
+	require.ErrorIs(t, err, ErrUnverified)
+
+	// the headers of another chain are rejected
+	_, _, err = client.Verifying(genesis.Genesis{ChainID: "other-chain", ProposerAddress: header.ProposerAddress}).GetHeader(ctx, 5)
+	require.ErrorIs(t, err, ErrUnverified)
+}
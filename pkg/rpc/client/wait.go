@@ -0,0 +1,83 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"time"
+
+	"connectrpc.com/connect"
+)
+
+const (
+	// waitInitialInterval is the wait between the first polls of a node waited for. The wait
+	// doubles after every poll, up to waitMaxInterval.
+	waitInitialInterval = 50 * time.Millisecond
+	// waitMaxInterval is the longest wait between two polls of a node waited for.
+	waitMaxInterval = time.Second
+)
+
+// waitFatalCodes are the error codes ending a wait, as polling again would fail the same way.
+// Other errors, e.g. of a node which is not up yet or has no block yet, are polled through.
+var waitFatalCodes = []connect.Code{
+	connect.CodeInvalidArgument,
+	connect.CodeFailedPrecondition,
+	connect.CodeUnimplemented,
+	connect.CodeUnauthenticated,
+	connect.CodePermissionDenied,
+}
+
+// WaitForHeight waits until the node applied the block at height, polling its state with
+// backoff, and returns the height of its latest block. It fails when ctx is done first.
+func (c *Client) WaitForHeight(ctx context.Context, height uint64) (uint64, error) {
+	return c.waitFor(ctx, "height", height, func(ctx context.Context) (uint64, error) {
+		state, err := c.GetState(ctx)
+		if err != nil {
+			return 0, err
+		}
+		return state.LastBlockHeight, nil
+	})
+}
+
+// WaitForDAInclusion waits until the header and data of the block at height are included on DA,
+// polling the sync status of the node with backoff, and returns the height of the latest DA
+// included block. It fails when ctx is done first.
+func (c *Client) WaitForDAInclusion(ctx context.Context, height uint64) (uint64, error) {
+	return c.waitFor(ctx, "DA included height", height, func(ctx context.Context) (uint64, error) {
+		status, err := c.GetSyncStatus(ctx)
+		if err != nil {
+			return 0, err
+		}
+		return status.DaIncludedHeight, nil
+	})
+}
+
+// waitFor polls current until it reaches height.
+func (c *Client) waitFor(ctx context.Context, what string, height uint64, current func(ctx context.Context) (uint64, error)) (uint64, error) {
+	interval := waitInitialInterval
+	var (
+		last    uint64
+		lastErr error
+	)
+	for {
+		last, lastErr = current(ctx)
+		if lastErr == nil && last >= height {
+			return last, nil
+		}
+		if lastErr != nil && slices.Contains(waitFatalCodes, connect.CodeOf(lastErr)) {
+			return 0, lastErr
+		}
+
+		timer := time.NewTimer(interval)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			if lastErr != nil {
+				return 0, fmt.Errorf("%s %d not reached: %w (last error: %w)", what, height, ctx.Err(), lastErr)
+			}
+			return last, fmt.Errorf("%s %d not reached, at %d: %w", what, height, last, ctx.Err())
+		case <-timer.C:
+		}
+		interval = min(2*interval, waitMaxInterval)
+	}
+}
@@ -0,0 +1,78 @@
+package client
+
+import (
+	"context"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"connectrpc.com/connect"
+	ds "github.com/ipfs/go-datastore"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/evstack/ev-node/pkg/config"
+	"github.com/evstack/ev-node/pkg/rpc/server"
+	"github.com/evstack/ev-node/pkg/store"
+	"github.com/evstack/ev-node/test/mocks"
+	"github.com/evstack/ev-node/types"
+)
+
+// growingStatus is a sync status whose DA included height grows on every call.
+type growingStatus struct {
+	daIncluded atomic.Uint64
+}
+
+func (s *growingStatus) SyncStatus() server.SyncStatus {
+	return server.SyncStatus{DAIncludedHeight: s.daIncluded.Add(1)}
+}
+
+func TestClientWaitForHeight(t *testing.T) {
+	mockStore := mocks.NewMockStore(t)
+	var height atomic.Uint64
+	mockStore.On("GetState", mock.Anything).Return(func(context.Context) (types.State, error) {
+		return types.State{LastBlockHeight: height.Add(1)}, nil
+	})
+	mockStore.On("Height", mock.Anything).Return(uint64(1), nil).Maybe()
+	mockStore.On("GetMetadata", mock.Anything, store.PrunedBaseHeightKey).Return(nil, ds.ErrNotFound).Maybe()
+	status := &growingStatus{}
+	handler, err := server.NewServiceHandler(mockStore, mocks.NewMockP2PRPC(t), zerolog.Nop(), config.DefaultConfig, server.ServiceOptions{SyncStatus: status})
+	require.NoError(t, err)
+	testServer := httptest.NewServer(handler)
+	defer testServer.Close()
+	client := NewClient(testServer.URL)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	latest, err := client.WaitForHeight(ctx, 3)
+	require.NoError(t, err)
+	require.GreaterOrEqual(t, latest, uint64(3))
+
+	latest, err = client.WaitForDAInclusion(ctx, 3)
+	require.NoError(t, err)
+	require.GreaterOrEqual(t, latest, uint64(3))
+
+	// the wait ends with its context
+	shortCtx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancel()
+	_, err = client.WaitForHeight(shortCtx, 1_000_000)
+	require.ErrorIs(t, err, context.DeadlineExceeded)
+}
+
+func TestClientWaitForHeight_NodeNotUp(t *testing.T) {
+	// the node is unreachable until the wait ends
+	ctx, cancel := context.WithTimeout(context.Background(), 200*time.Millisecond)
+	defer cancel()
+	_, err := NewClient("http://127.0.0.1:1").WaitForHeight(ctx, 1)
+	require.ErrorIs(t, err, context.DeadlineExceeded)
+
+	// a node which does not report its sync status fails the wait right away
+	handler, err := server.NewServiceHandler(mocks.NewMockStore(t), mocks.NewMockP2PRPC(t), zerolog.Nop(), config.DefaultConfig, server.ServiceOptions{})
+	require.NoError(t, err)
+	testServer := httptest.NewServer(handler)
+	defer testServer.Close()
+	_, err = NewClient(testServer.URL).WaitForDAInclusion(context.Background(), 1)
+	require.Equal(t, connect.CodeUnimplemented, connect.CodeOf(err))
+}
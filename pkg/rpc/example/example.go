@@ -21,7 +21,7 @@ func StartStoreServer(s store.Store, address string, logger zerolog.Logger) {
 	// Start RPC server
 	rpcAddr := fmt.Sprintf("%s:%d", "localhost", 8080)
 	cfg := config.DefaultConfig
-	handler, err := server.NewServiceHandler(s, nil, logger, cfg)
+	handler, err := server.NewServiceHandler(s, nil, logger, cfg, server.ServiceOptions{})
 	if err != nil {
 		panic(err)
 	}
@@ -81,7 +81,7 @@ func ExampleServer(s store.Store) {
 	// Start RPC server
 	rpcAddr := fmt.Sprintf("%s:%d", "localhost", 8080)
 	cfg := config.DefaultConfig
-	handler, err := server.NewServiceHandler(s, nil, logger, cfg)
+	handler, err := server.NewServiceHandler(s, nil, logger, cfg, server.ServiceOptions{})
 	if err != nil {
 		panic(err)
 	}
@@ -0,0 +1,173 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/libp2p/go-libp2p/core/peer"
+	"github.com/rs/zerolog"
+	"google.golang.org/protobuf/types/known/emptypb"
+
+	"github.com/evstack/ev-node/pkg/store"
+	pb "github.com/evstack/ev-node/types/pb/evnode/v1"
+)
+
+// ErrNotAggregator is returned by NodeAdmin operations which require an aggregator.
+var ErrNotAggregator = errors.New("node is not an aggregator")
+
+// NodeAdmin is the runtime control of a node exposed by the AdminService.
+type NodeAdmin interface {
+	// Shutdown stops the node gracefully. It returns without waiting for the node to stop.
+	Shutdown()
+	// TriggerDASubmission submits the headers and data pending DA without waiting for the next
+	// DA block time, returning how many are pending.
+	TriggerDASubmission() (headers, data uint64, err error)
+	// DisconnectPeer closes the connections to a peer, and blocks it from reconnecting if ban is set.
+	DisconnectPeer(id peer.ID, ban bool) error
+	// CompactStore reclaims the disk space of the data deleted from the store.
+	CompactStore(ctx context.Context) error
+	// Drain stops the node gracefully after draining its RPC server, giving the in-flight requests
+	// up to timeout to complete. It returns without waiting for the node to stop.
+	Drain(timeout time.Duration)
+	// SetMetadata saves a value of application metadata under a registered namespace, failing with
+	// store.ErrInvalidMetadataKey if the namespace is not registered or the key is invalid.
+	SetMetadata(ctx context.Context, namespace, key string, value []byte) error
+}
+
+// defaultDrainTimeout is the grace period of the in-flight requests of the Drain RPC when
+// draining on shutdown is disabled.
+const defaultDrainTimeout = 10 * time.Second
+
+// AdminServer implements the AdminService defined in the proto file
+type AdminServer struct {
+	admin  NodeAdmin
+	logger zerolog.Logger
+	// drainTimeout is the default grace period of the Drain RPC, see rpc.drain_timeout
+	drainTimeout time.Duration
+}
+
+// NewAdminServer creates a new AdminServer instance
+func NewAdminServer(admin NodeAdmin, logger zerolog.Logger) *AdminServer {
+	return &AdminServer{
+		admin:        admin,
+		logger:       logger,
+		drainTimeout: defaultDrainTimeout,
+	}
+}
+
+// Shutdown implements the Shutdown RPC method
+func (a *AdminServer) Shutdown(
+	ctx context.Context,
+	req *connect.Request[emptypb.Empty],
+) (*connect.Response[emptypb.Empty], error) {
+	a.logger.Info().Msg("shutdown requested through the admin RPC")
+	a.admin.Shutdown()
+	return connect.NewResponse(&emptypb.Empty{}), nil
+}
+
+// SetLogLevel implements the SetLogLevel RPC method. The level applies to every logger of the
+// process, like the log level of the configuration.
+func (a *AdminServer) SetLogLevel(
+	ctx context.Context,
+	req *connect.Request[pb.SetLogLevelRequest],
+) (*connect.Response[pb.SetLogLevelResponse], error) {
+	level, err := zerolog.ParseLevel(req.Msg.Level)
+	if err != nil || req.Msg.Level == "" {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("invalid log level %q", req.Msg.Level))
+	}
+
+	previous := zerolog.GlobalLevel()
+	zerolog.SetGlobalLevel(level)
+	a.logger.Info().Str("previous", previous.String()).Str("level", level.String()).Msg("log level changed through the admin RPC")
+
+	return connect.NewResponse(&pb.SetLogLevelResponse{
+		PreviousLevel: previous.String(),
+	}), nil
+}
+
+// TriggerDASubmission implements the TriggerDASubmission RPC method
+func (a *AdminServer) TriggerDASubmission(
+	ctx context.Context,
+	req *connect.Request[emptypb.Empty],
+) (*connect.Response[pb.TriggerDASubmissionResponse], error) {
+	headers, data, err := a.admin.TriggerDASubmission()
+	if errors.Is(err, ErrNotAggregator) {
+		return nil, connect.NewError(connect.CodeFailedPrecondition, err)
+	}
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to trigger DA submission: %w", err))
+	}
+	a.logger.Info().Uint64("pending_headers", headers).Uint64("pending_data", data).Msg("DA submission triggered through the admin RPC")
+
+	return connect.NewResponse(&pb.TriggerDASubmissionResponse{
+		PendingHeaders: headers,
+		PendingData:    data,
+	}), nil
+}
+
+// DisconnectPeer implements the DisconnectPeer RPC method
+func (a *AdminServer) DisconnectPeer(
+	ctx context.Context,
+	req *connect.Request[pb.DisconnectPeerRequest],
+) (*connect.Response[emptypb.Empty], error) {
+	id, err := peer.Decode(req.Msg.PeerId)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("invalid peer ID: %w", err))
+	}
+	if err := a.admin.DisconnectPeer(id, req.Msg.Ban); err != nil {
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to disconnect peer: %w", err))
+	}
+	a.logger.Info().Str("peer", id.String()).Bool("ban", req.Msg.Ban).Msg("peer disconnected through the admin RPC")
+
+	return connect.NewResponse(&emptypb.Empty{}), nil
+}
+
+// CompactStore implements the CompactStore RPC method
+func (a *AdminServer) CompactStore(
+	ctx context.Context,
+	req *connect.Request[emptypb.Empty],
+) (*connect.Response[emptypb.Empty], error) {
+	if err := a.admin.CompactStore(ctx); err != nil {
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to compact store: %w", err))
+	}
+	a.logger.Info().Msg("store compacted through the admin RPC")
+
+	return connect.NewResponse(&emptypb.Empty{}), nil
+}
+
+// Drain implements the Drain RPC method
+func (a *AdminServer) Drain(
+	ctx context.Context,
+	req *connect.Request[pb.DrainRequest],
+) (*connect.Response[emptypb.Empty], error) {
+	timeout := a.drainTimeout
+	if req.Msg.Timeout != nil {
+		if err := req.Msg.Timeout.CheckValid(); err != nil || req.Msg.Timeout.AsDuration() <= 0 {
+			return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("invalid drain timeout %s", req.Msg.Timeout))
+		}
+		timeout = req.Msg.Timeout.AsDuration()
+	}
+	a.logger.Info().Dur("timeout", timeout).Msg("drain requested through the admin RPC")
+	a.admin.Drain(timeout)
+	return connect.NewResponse(&emptypb.Empty{}), nil
+}
+
+// SetMetadata implements the SetMetadata RPC method
+func (a *AdminServer) SetMetadata(
+	ctx context.Context,
+	req *connect.Request[pb.SetMetadataRequest],
+) (*connect.Response[emptypb.Empty], error) {
+	err := a.admin.SetMetadata(ctx, req.Msg.Namespace, req.Msg.Key, req.Msg.Value)
+	if errors.Is(err, store.ErrInvalidMetadataKey) {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to set metadata: %w", err))
+	}
+	a.logger.Debug().Str("namespace", req.Msg.Namespace).Str("key", req.Msg.Key).Int("size", len(req.Msg.Value)).Msg("metadata set through the admin RPC")
+
+	return connect.NewResponse(&emptypb.Empty{}), nil
+}
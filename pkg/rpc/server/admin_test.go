@@ -0,0 +1,152 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/libp2p/go-libp2p/core/peer"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/emptypb"
+
+	"github.com/evstack/ev-node/pkg/config"
+	"github.com/evstack/ev-node/pkg/store"
+	"github.com/evstack/ev-node/test/mocks"
+	pb "github.com/evstack/ev-node/types/pb/evnode/v1"
+	rpc "github.com/evstack/ev-node/types/pb/evnode/v1/v1connect"
+)
+
+// testNodeAdmin is a NodeAdmin recording the operations it is asked to perform.
+type testNodeAdmin struct {
+	shutdown      bool
+	notAggregator bool
+	disconnected  map[peer.ID]bool
+	compactErr    error
+	drainTimeout  time.Duration
+	metadata      map[string][]byte
+}
+
+func (a *testNodeAdmin) Shutdown() { a.shutdown = true }
+
+func (a *testNodeAdmin) Drain(timeout time.Duration) { a.drainTimeout = timeout }
+
+func (a *testNodeAdmin) TriggerDASubmission() (uint64, uint64, error) {
+	if a.notAggregator {
+		return 0, 0, ErrNotAggregator
+	}
+	return 3, 2, nil
+}
+
+func (a *testNodeAdmin) DisconnectPeer(id peer.ID, ban bool) error {
+	a.disconnected[id] = ban
+	return nil
+}
+
+func (a *testNodeAdmin) CompactStore(context.Context) error { return a.compactErr }
+
+func (a *testNodeAdmin) SetMetadata(_ context.Context, namespace, key string, value []byte) error {
+	if namespace != "exec" {
+		return fmt.Errorf("%w: metadata namespace %q is not registered", store.ErrInvalidMetadataKey, namespace)
+	}
+	a.metadata[namespace+"/"+key] = value
+	return nil
+}
+
+func TestAdminServer(t *testing.T) {
+	ctx := context.Background()
+	admin := &testNodeAdmin{disconnected: make(map[peer.ID]bool), metadata: make(map[string][]byte)}
+	server := NewAdminServer(admin, zerolog.Nop())
+
+	_, err := server.Shutdown(ctx, connect.NewRequest(&emptypb.Empty{}))
+	require.NoError(t, err)
+	assert.True(t, admin.shutdown)
+
+	t.Run("log level", func(t *testing.T) {
+		defer zerolog.SetGlobalLevel(zerolog.GlobalLevel())
+		zerolog.SetGlobalLevel(zerolog.InfoLevel)
+
+		resp, err := server.SetLogLevel(ctx, connect.NewRequest(&pb.SetLogLevelRequest{Level: "debug"}))
+		require.NoError(t, err)
+		assert.Equal(t, "info", resp.Msg.PreviousLevel)
+		assert.Equal(t, zerolog.DebugLevel, zerolog.GlobalLevel())
+
+		for _, level := range []string{"", "verbose"} {
+			_, err = server.SetLogLevel(ctx, connect.NewRequest(&pb.SetLogLevelRequest{Level: level}))
+			assert.Equal(t, connect.CodeInvalidArgument, connect.CodeOf(err), "level %q", level)
+		}
+		assert.Equal(t, zerolog.DebugLevel, zerolog.GlobalLevel())
+	})
+
+	resp, err := server.TriggerDASubmission(ctx, connect.NewRequest(&emptypb.Empty{}))
+	require.NoError(t, err)
+	assert.Equal(t, uint64(3), resp.Msg.PendingHeaders)
+	assert.Equal(t, uint64(2), resp.Msg.PendingData)
+	admin.notAggregator = true
+	_, err = server.TriggerDASubmission(ctx, connect.NewRequest(&emptypb.Empty{}))
+	assert.Equal(t, connect.CodeFailedPrecondition, connect.CodeOf(err))
+
+	peerID, err := peer.Decode("12D3KooWJbD9TQoMSSSUyfhHMmgVY3LqCjxYFz8wQ92Qa6DAqtmh")
+	require.NoError(t, err)
+	_, err = server.DisconnectPeer(ctx, connect.NewRequest(&pb.DisconnectPeerRequest{PeerId: peerID.String(), Ban: true}))
+	require.NoError(t, err)
+	assert.Equal(t, map[peer.ID]bool{peerID: true}, admin.disconnected)
+	_, err = server.DisconnectPeer(ctx, connect.NewRequest(&pb.DisconnectPeerRequest{PeerId: "not-a-peer"}))
+	assert.Equal(t, connect.CodeInvalidArgument, connect.CodeOf(err))
+
+	_, err = server.CompactStore(ctx, connect.NewRequest(&emptypb.Empty{}))
+	require.NoError(t, err)
+	admin.compactErr = errors.New("store closed")
+	_, err = server.CompactStore(ctx, connect.NewRequest(&emptypb.Empty{}))
+	assert.Equal(t, connect.CodeInternal, connect.CodeOf(err))
+
+	_, err = server.SetMetadata(ctx, connect.NewRequest(&pb.SetMetadataRequest{Namespace: "exec", Key: "checkpoint", Value: []byte{1}}))
+	require.NoError(t, err)
+	assert.Equal(t, map[string][]byte{"exec/checkpoint": {1}}, admin.metadata)
+	_, err = server.SetMetadata(ctx, connect.NewRequest(&pb.SetMetadataRequest{Namespace: "other", Key: "checkpoint"}))
+	assert.Equal(t, connect.CodeInvalidArgument, connect.CodeOf(err))
+
+	_, err = server.Drain(ctx, connect.NewRequest(&pb.DrainRequest{}))
+	require.NoError(t, err)
+	assert.Equal(t, defaultDrainTimeout, admin.drainTimeout)
+	_, err = server.Drain(ctx, connect.NewRequest(&pb.DrainRequest{Timeout: durationpb.New(30 * time.Second)}))
+	require.NoError(t, err)
+	assert.Equal(t, 30*time.Second, admin.drainTimeout)
+	_, err = server.Drain(ctx, connect.NewRequest(&pb.DrainRequest{Timeout: durationpb.New(-time.Second)}))
+	assert.Equal(t, connect.CodeInvalidArgument, connect.CodeOf(err))
+}
+
+func TestServiceHandlerAdmin(t *testing.T) {
+	admin := &testNodeAdmin{}
+	serve := func(cfg config.Config) rpc.AdminServiceClient {
+		handler, err := NewServiceHandler(mocks.NewMockStore(t), &mocks.MockP2PRPC{}, zerolog.Nop(), cfg, ServiceOptions{Admin: admin})
+		require.NoError(t, err)
+		server := httptest.NewServer(handler)
+		t.Cleanup(server.Close)
+		return rpc.NewAdminServiceClient(server.Client(), server.URL)
+	}
+
+	// the admin service is not served without authentication
+	_, err := serve(config.DefaultConfig).Shutdown(context.Background(), connect.NewRequest(&emptypb.Empty{}))
+	assert.Equal(t, connect.CodeUnimplemented, connect.CodeOf(err))
+	assert.False(t, admin.shutdown)
+
+	cfg := config.DefaultConfig
+	cfg.RPC.AuthToken = "secret-token"
+	client := serve(cfg)
+	_, err = client.Shutdown(context.Background(), connect.NewRequest(&emptypb.Empty{}))
+	assert.Equal(t, connect.CodeUnauthenticated, connect.CodeOf(err))
+	assert.False(t, admin.shutdown)
+
+	req := connect.NewRequest(&emptypb.Empty{})
+	req.Header().Set("Authorization", "Bearer secret-token")
+	_, err = client.Shutdown(context.Background(), req)
+	require.NoError(t, err)
+	assert.True(t, admin.shutdown)
+}
@@ -0,0 +1,354 @@
+package server
+
+import (
+	"context"
+	"crypto/subtle"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/rs/zerolog"
+
+	"github.com/evstack/ev-node/pkg/config"
+	rpc "github.com/evstack/ev-node/types/pb/evnode/v1/v1connect"
+)
+
+// jwtMaxClockSkew is the tolerated difference between the issued at time of a JWT and the clock
+// of the node, as for the engine API of execution clients.
+const jwtMaxClockSkew = 60 * time.Second
+
+var errMissingToken = errors.New("missing bearer token")
+
+// Role is the role of a bearer token, granting access to a subset of the protected RPCs.
+type Role string
+
+const (
+	// RoleReadOnly may only call the RPCs without side effects, e.g. of the services listed in
+	// rpc.auth_services.
+	RoleReadOnly Role = "read-only"
+	// RoleOperator may call every RPC but the destructive administrative ones.
+	RoleOperator Role = "operator"
+	// RoleAdmin may call every RPC.
+	RoleAdmin Role = "admin"
+)
+
+// httpRouteServices maps the plain HTTP routes of the RPC server to the services whose data they
+// serve. A route requires a bearer token when one of its services is listed in
+// AuthOptions.Services. The other routes are open: /health/live and /metrics for probes and
+// scrapers, and /api/v1/docs, which only describes the API. The routes of the JSON gateway are
+// served by the RPCs, so the interceptor applies to them.
+var httpRouteServices = map[string][]string{
+	"/websocket":    {rpc.StoreServiceName, rpc.P2PServiceName},
+	"/health/ready": {rpc.HealthServiceName},
+}
+
+// adminOnlyProcedures are the administrative RPCs stopping the node or affecting its peers, which
+// require the admin role.
+var adminOnlyProcedures = map[string]bool{
+	rpc.AdminServiceShutdownProcedure:       true,
+	rpc.AdminServiceDrainProcedure:          true,
+	rpc.AdminServiceDisconnectPeerProcedure: true,
+}
+
+// ParseRole parses the name of a role.
+func ParseRole(name string) (Role, error) {
+	switch role := Role(name); role {
+	case RoleReadOnly, RoleOperator, RoleAdmin:
+		return role, nil
+	default:
+		return "", fmt.Errorf("unknown role %q", name)
+	}
+}
+
+// permits returns whether the role may call the procedure of spec.
+func (r Role) permits(spec connect.Spec) bool {
+	switch r {
+	case RoleAdmin:
+		return true
+	case RoleOperator:
+		return !adminOnlyProcedures[spec.Procedure]
+	default:
+		return spec.IdempotencyLevel == connect.IdempotencyNoSideEffects
+	}
+}
+
+// RoleToken is a static bearer token scoped to a role, identifying its holder in the audit log.
+type RoleToken struct {
+	Identity string
+	Role     Role
+	Token    string
+}
+
+// caller is the holder of the bearer token of a request.
+type caller struct {
+	identity string
+	role     Role
+}
+
+// AuthOptions configures the authentication of RPC requests with bearer tokens.
+type AuthOptions struct {
+	// Token is a static token accepted as bearer token with the admin role. Empty to disable it.
+	Token string
+	// RoleTokens are static tokens accepted as bearer tokens with their role.
+	RoleTokens []RoleToken
+	// JWTSecret is the secret of the HS256 JWTs accepted as bearer tokens, issued at most
+	// a minute before or after the request. The role of a JWT is its role claim, admin if absent,
+	// and its holder is its sub claim. Empty to disable them.
+	JWTSecret []byte
+	// Services are the fully qualified names of the services, e.g. evnode.v1.P2PService, whose
+	// every RPC requires a bearer token. Other services only require it for RPCs which are not
+	// declared free of side effects, i.e. mutating and administrative RPCs.
+	Services []string
+}
+
+// AuthOptionsFromConfig returns the authentication options of the RPC configuration, or nil
+// if authentication is disabled.
+func AuthOptionsFromConfig(cfg config.RPCConfig) (*AuthOptions, error) {
+	services := splitList(cfg.AuthServices)
+
+	roleTokens, err := parseRoleTokens(cfg.AuthRoleTokens)
+	if err != nil {
+		return nil, err
+	}
+
+	if cfg.AuthToken == "" && cfg.AuthJWTSecret == "" && len(roleTokens) == 0 {
+		if len(services) > 0 {
+			return nil, errors.New("rpc.auth_services requires rpc.auth_token, rpc.auth_role_tokens or rpc.auth_jwt_secret")
+		}
+		return nil, nil
+	}
+
+	opts := &AuthOptions{Token: cfg.AuthToken, RoleTokens: roleTokens, Services: services}
+	if cfg.AuthJWTSecret != "" {
+		secret, err := hex.DecodeString(strings.TrimPrefix(cfg.AuthJWTSecret, "0x"))
+		if err != nil {
+			return nil, fmt.Errorf("failed to decode RPC JWT secret: %w", err)
+		}
+		opts.JWTSecret = secret
+	}
+	return opts, nil
+}
+
+// parseRoleTokens parses comma-separated identity:role:token entries. Malformed entries are
+// reported by their position, as they may be a bare token.
+func parseRoleTokens(list string) ([]RoleToken, error) {
+	var roleTokens []RoleToken
+	seen := make(map[string]bool)
+	for i, entry := range splitList(list) {
+		parts := strings.SplitN(entry, ":", 3)
+		if len(parts) != 3 || parts[0] == "" || parts[2] == "" {
+			return nil, fmt.Errorf("invalid RPC role token at position %d, expected identity:role:token", i+1)
+		}
+		role, err := ParseRole(parts[1])
+		if err != nil {
+			return nil, fmt.Errorf("invalid RPC role token of %s: %w", parts[0], err)
+		}
+		if seen[parts[2]] {
+			return nil, fmt.Errorf("RPC role token of %s is not unique", parts[0])
+		}
+		seen[parts[2]] = true
+		roleTokens = append(roleTokens, RoleToken{Identity: parts[0], Role: role, Token: parts[2]})
+	}
+	return roleTokens, nil
+}
+
+// authInterceptor rejects requests to protected RPCs without a valid bearer token granting a role
+// permitted to call them, and logs the calls to the AdminService with their caller.
+type authInterceptor struct {
+	opts     AuthOptions
+	services map[string]bool
+	logger   zerolog.Logger
+}
+
+// NewAuthInterceptor creates an interceptor requiring a valid bearer token in the Authorization
+// header of the requests to the RPCs protected by opts. Read-only RPCs of other services stay open.
+// Every call to the AdminService, allowed or not, is logged to logger for auditing.
+func NewAuthInterceptor(opts AuthOptions, logger zerolog.Logger) connect.Interceptor {
+	return newAuthInterceptor(opts, logger)
+}
+
+func newAuthInterceptor(opts AuthOptions, logger zerolog.Logger) *authInterceptor {
+	services := make(map[string]bool, len(opts.Services))
+	for _, service := range opts.Services {
+		services[service] = true
+	}
+	return &authInterceptor{opts: opts, services: services, logger: logger}
+}
+
+// NewAuthHTTPHandler wraps the handler of the RPC server, typically created by NewServiceHandler,
+// so that its plain HTTP routes serving the data of the services protected by opts, e.g. the
+// /websocket endpoint when evnode.v1.StoreService is, require a valid bearer token in the
+// Authorization header. Any role may read them. Requests without a valid token are rejected with
+// 401 Unauthorized.
+func NewAuthHTTPHandler(next http.Handler, opts AuthOptions, logger zerolog.Logger) http.Handler {
+	a := newAuthInterceptor(opts, logger)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if a.protectedRoute(r.URL.Path) {
+			token, ok := strings.CutPrefix(r.Header.Get("Authorization"), "Bearer ")
+			if !ok || token == "" {
+				w.Header().Set("WWW-Authenticate", "Bearer")
+				http.Error(w, errMissingToken.Error(), http.StatusUnauthorized)
+				return
+			}
+			if _, err := a.authenticate(token); err != nil {
+				w.Header().Set("WWW-Authenticate", "Bearer")
+				http.Error(w, err.Error(), http.StatusUnauthorized)
+				return
+			}
+		}
+		next.ServeHTTP(w, r)
+	})
+}
+
+// protectedRoute returns whether the plain HTTP route at path requires a bearer token.
+func (a *authInterceptor) protectedRoute(path string) bool {
+	for _, service := range httpRouteServices[path] {
+		if a.services[service] {
+			return true
+		}
+	}
+	return false
+}
+
+func (a *authInterceptor) WrapUnary(next connect.UnaryFunc) connect.UnaryFunc {
+	return func(ctx context.Context, req connect.AnyRequest) (connect.AnyResponse, error) {
+		start := time.Now()
+		caller, err := a.authorize(req.Spec(), req.Header().Get("Authorization"))
+		var resp connect.AnyResponse
+		if err == nil {
+			resp, err = next(ctx, req)
+		}
+		a.audit(req.Spec(), req.Peer(), caller, start, err)
+		return resp, err
+	}
+}
+
+func (a *authInterceptor) WrapStreamingClient(next connect.StreamingClientFunc) connect.StreamingClientFunc {
+	return next
+}
+
+func (a *authInterceptor) WrapStreamingHandler(next connect.StreamingHandlerFunc) connect.StreamingHandlerFunc {
+	return func(ctx context.Context, conn connect.StreamingHandlerConn) error {
+		start := time.Now()
+		caller, err := a.authorize(conn.Spec(), conn.RequestHeader().Get("Authorization"))
+		if err == nil {
+			err = next(ctx, conn)
+		}
+		a.audit(conn.Spec(), conn.Peer(), caller, start, err)
+		return err
+	}
+}
+
+// authorize checks the authorization header of a request to the procedure of spec, and returns
+// the caller holding the bearer token, if any.
+func (a *authInterceptor) authorize(spec connect.Spec, authorization string) (caller, error) {
+	if !a.protected(spec) {
+		return caller{}, nil
+	}
+
+	token, ok := strings.CutPrefix(authorization, "Bearer ")
+	if !ok || token == "" {
+		return caller{}, connect.NewError(connect.CodeUnauthenticated, errMissingToken)
+	}
+	c, err := a.authenticate(token)
+	if err != nil {
+		return caller{}, connect.NewError(connect.CodeUnauthenticated, err)
+	}
+	if !c.role.permits(spec) {
+		return c, connect.NewError(connect.CodePermissionDenied, fmt.Errorf("role %s is not permitted to call %s", c.role, spec.Procedure))
+	}
+	return c, nil
+}
+
+// authenticate returns the holder of a bearer token.
+func (a *authInterceptor) authenticate(token string) (caller, error) {
+	if a.opts.Token != "" && subtle.ConstantTimeCompare([]byte(token), []byte(a.opts.Token)) == 1 {
+		return caller{identity: "auth_token", role: RoleAdmin}, nil
+	}
+	for _, roleToken := range a.opts.RoleTokens {
+		if subtle.ConstantTimeCompare([]byte(token), []byte(roleToken.Token)) == 1 {
+			return caller{identity: roleToken.Identity, role: roleToken.Role}, nil
+		}
+	}
+	if len(a.opts.JWTSecret) > 0 {
+		c, err := a.verifyJWT(token)
+		if err != nil {
+			return caller{}, fmt.Errorf("invalid bearer token: %w", err)
+		}
+		return c, nil
+	}
+	return caller{}, errors.New("invalid bearer token")
+}
+
+// audit logs a call to the AdminService with its caller and outcome.
+func (a *authInterceptor) audit(spec connect.Spec, peer connect.Peer, c caller, start time.Time, err error) {
+	if !strings.HasPrefix(spec.Procedure, "/"+rpc.AdminServiceName+"/") {
+		return
+	}
+	event := a.logger.Info()
+	code := "ok"
+	if err != nil {
+		event = a.logger.Warn().Err(err)
+		code = connect.CodeOf(err).String()
+	}
+	if c.identity == "" {
+		c.identity = "unauthenticated"
+	}
+	event.
+		Str("procedure", spec.Procedure).
+		Str("caller", c.identity).
+		Str("role", string(c.role)).
+		Str("remote_addr", peer.Addr).
+		Str("code", code).
+		Dur("duration", time.Since(start)).
+		Msg("admin RPC call")
+}
+
+// protected returns whether the procedure of spec requires a bearer token.
+func (a *authInterceptor) protected(spec connect.Spec) bool {
+	if spec.IdempotencyLevel != connect.IdempotencyNoSideEffects {
+		return true
+	}
+	service, _, _ := strings.Cut(strings.TrimPrefix(spec.Procedure, "/"), "/")
+	return a.services[service]
+}
+
+// verifyJWT checks that token is a HS256 JWT signed with the secret and issued recently, and
+// returns its holder.
+func (a *authInterceptor) verifyJWT(token string) (caller, error) {
+	claims := jwt.MapClaims{}
+	_, err := jwt.ParseWithClaims(token, claims, func(*jwt.Token) (any, error) {
+		return a.opts.JWTSecret, nil
+	}, jwt.WithValidMethods([]string{jwt.SigningMethodHS256.Alg()}), jwt.WithLeeway(jwtMaxClockSkew))
+	if err != nil {
+		return caller{}, err
+	}
+
+	issuedAt, err := claims.GetIssuedAt()
+	if err != nil {
+		return caller{}, err
+	}
+	if issuedAt == nil {
+		return caller{}, errors.New("missing iat claim")
+	}
+	if skew := time.Since(issuedAt.Time); skew > jwtMaxClockSkew || skew < -jwtMaxClockSkew {
+		return caller{}, fmt.Errorf("token issued at %s, more than %s from now", issuedAt.Time.UTC().Format(time.RFC3339), jwtMaxClockSkew)
+	}
+
+	c := caller{identity: "jwt", role: RoleAdmin}
+	if subject, _ := claims.GetSubject(); subject != "" {
+		c.identity = subject
+	}
+	if name, ok := claims["role"]; ok {
+		roleName, _ := name.(string)
+		if c.role, err = ParseRole(roleName); err != nil {
+			return caller{}, err
+		}
+	}
+	return c, nil
+}
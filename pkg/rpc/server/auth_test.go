@@ -0,0 +1,285 @@
+package server
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"strings"
+	"testing"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/emptypb"
+
+	"github.com/evstack/ev-node/pkg/config"
+	"github.com/evstack/ev-node/pkg/p2p"
+	"github.com/evstack/ev-node/test/mocks"
+	"github.com/evstack/ev-node/types"
+	rpc "github.com/evstack/ev-node/types/pb/evnode/v1/v1connect"
+)
+
+const testAdminProcedure = "/evnode.v1.TestAdminService/Restart"
+
+func signTestJWT(t *testing.T, secret []byte, issuedAt time.Time) string {
+	t.Helper()
+	token, err := jwt.NewWithClaims(jwt.SigningMethodHS256, jwt.MapClaims{"iat": issuedAt.Unix()}).SignedString(secret)
+	require.NoError(t, err)
+	return token
+}
+
+func TestAuthOptionsFromConfig(t *testing.T) {
+	opts, err := AuthOptionsFromConfig(config.RPCConfig{})
+	require.NoError(t, err)
+	assert.Nil(t, opts, "authentication is disabled by default")
+
+	opts, err = AuthOptionsFromConfig(config.RPCConfig{
+		AuthToken:     "secret-token",
+		AuthJWTSecret: "0x0102ff",
+		AuthServices:  "evnode.v1.P2PService, evnode.v1.ConfigService,",
+	})
+	require.NoError(t, err)
+	assert.Equal(t, &AuthOptions{
+		Token:     "secret-token",
+		JWTSecret: []byte{0x01, 0x02, 0xff},
+		Services:  []string{"evnode.v1.P2PService", "evnode.v1.ConfigService"},
+	}, opts)
+
+	_, err = AuthOptionsFromConfig(config.RPCConfig{AuthJWTSecret: "not-hex"})
+	require.ErrorContains(t, err, "failed to decode RPC JWT secret")
+
+	_, err = AuthOptionsFromConfig(config.RPCConfig{AuthServices: "evnode.v1.P2PService"})
+	require.ErrorContains(t, err, "requires rpc.auth_token, rpc.auth_role_tokens or rpc.auth_jwt_secret")
+
+	opts, err = AuthOptionsFromConfig(config.RPCConfig{AuthRoleTokens: "alice:operator:tok:en, monitoring:read-only:ro-token"})
+	require.NoError(t, err)
+	assert.Equal(t, []RoleToken{
+		{Identity: "alice", Role: RoleOperator, Token: "tok:en"},
+		{Identity: "monitoring", Role: RoleReadOnly, Token: "ro-token"},
+	}, opts.RoleTokens)
+
+	for _, roleTokens := range []string{"alice:operator", ":admin:token", "alice:root:token", "alice:admin:token,bob:operator:token"} {
+		_, err = AuthOptionsFromConfig(config.RPCConfig{AuthRoleTokens: roleTokens})
+		require.Error(t, err, roleTokens)
+		require.NotContains(t, err.Error(), "token,", "tokens are not leaked")
+	}
+
+	// a bare token is malformed and reported by its position only
+	_, err = AuthOptionsFromConfig(config.RPCConfig{AuthRoleTokens: "monitoring:read-only:ro-token,bare-secret-token"})
+	require.ErrorContains(t, err, "at position 2")
+	require.NotContains(t, err.Error(), "bare-secret-token", "tokens are not leaked")
+}
+
+func TestAuthInterceptor(t *testing.T) {
+	secret := []byte("jwt-secret")
+	mux := http.NewServeMux()
+	mux.Handle(testAdminProcedure, connect.NewUnaryHandler(testAdminProcedure,
+		func(context.Context, *connect.Request[emptypb.Empty]) (*connect.Response[emptypb.Empty], error) {
+			return connect.NewResponse(&emptypb.Empty{}), nil
+		},
+		connect.WithInterceptors(NewAuthInterceptor(AuthOptions{Token: "secret-token", JWTSecret: secret}, zerolog.Nop())),
+	))
+	server := httptest.NewServer(mux)
+	defer server.Close()
+	client := connect.NewClient[emptypb.Empty, emptypb.Empty](server.Client(), server.URL+testAdminProcedure)
+
+	for _, tc := range []struct {
+		name          string
+		authorization string
+		wantErr       string
+	}{
+		{name: "static token", authorization: "Bearer secret-token"},
+		{name: "jwt", authorization: "Bearer " + signTestJWT(t, secret, time.Now())},
+		{name: "missing token", wantErr: "missing bearer token"},
+		{name: "not a bearer token", authorization: "Basic c2VjcmV0LXRva2Vu", wantErr: "missing bearer token"},
+		{name: "wrong token", authorization: "Bearer wrong-token", wantErr: "invalid bearer token"},
+		{name: "jwt with wrong secret", authorization: "Bearer " + signTestJWT(t, []byte("other"), time.Now()), wantErr: "signature is invalid"},
+		{name: "stale jwt", authorization: "Bearer " + signTestJWT(t, secret, time.Now().Add(-5*time.Minute)), wantErr: "more than 1m0s from now"},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			req := connect.NewRequest(&emptypb.Empty{})
+			if tc.authorization != "" {
+				req.Header().Set("Authorization", tc.authorization)
+			}
+			_, err := client.CallUnary(context.Background(), req)
+			if tc.wantErr == "" {
+				require.NoError(t, err)
+				return
+			}
+			require.Error(t, err)
+			assert.Equal(t, connect.CodeUnauthenticated, connect.CodeOf(err))
+			assert.Contains(t, err.Error(), tc.wantErr)
+		})
+	}
+}
+
+func signTestRoleJWT(t *testing.T, secret []byte, subject, role string) string {
+	t.Helper()
+	token, err := jwt.NewWithClaims(jwt.SigningMethodHS256, jwt.MapClaims{
+		"iat":  time.Now().Unix(),
+		"sub":  subject,
+		"role": role,
+	}).SignedString(secret)
+	require.NoError(t, err)
+	return token
+}
+
+func TestAuthRoles(t *testing.T) {
+	secret := []byte("jwt-secret")
+	var auditLog bytes.Buffer
+	interceptor := NewAuthInterceptor(AuthOptions{
+		Token:     "admin-token",
+		JWTSecret: secret,
+		RoleTokens: []RoleToken{
+			{Identity: "alice", Role: RoleOperator, Token: "operator-token"},
+			{Identity: "monitoring", Role: RoleReadOnly, Token: "read-only-token"},
+		},
+		Services: []string{rpc.StoreServiceName},
+	}, zerolog.New(&auditLog))
+
+	const readProcedure = rpc.StoreServiceGetStateProcedure
+	mux := http.NewServeMux()
+	for _, procedure := range []string{rpc.AdminServiceShutdownProcedure, rpc.AdminServiceCompactStoreProcedure, readProcedure} {
+		opts := []connect.HandlerOption{connect.WithInterceptors(interceptor)}
+		if procedure == readProcedure {
+			opts = append(opts, connect.WithIdempotency(connect.IdempotencyNoSideEffects))
+		}
+		mux.Handle(procedure, connect.NewUnaryHandler(procedure,
+			func(context.Context, *connect.Request[emptypb.Empty]) (*connect.Response[emptypb.Empty], error) {
+				return connect.NewResponse(&emptypb.Empty{}), nil
+			}, opts...))
+	}
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	call := func(procedure, token string) error {
+		client := connect.NewClient[emptypb.Empty, emptypb.Empty](server.Client(), server.URL+procedure)
+		req := connect.NewRequest(&emptypb.Empty{})
+		req.Header().Set("Authorization", "Bearer "+token)
+		_, err := client.CallUnary(context.Background(), req)
+		return err
+	}
+
+	for _, tc := range []struct {
+		name    string
+		token   string
+		allowed []string
+	}{
+		{name: "static token", token: "admin-token", allowed: []string{rpc.AdminServiceShutdownProcedure, rpc.AdminServiceCompactStoreProcedure, readProcedure}},
+		{name: "operator", token: "operator-token", allowed: []string{rpc.AdminServiceCompactStoreProcedure, readProcedure}},
+		{name: "read-only", token: "read-only-token", allowed: []string{readProcedure}},
+		{name: "jwt without role", token: signTestJWT(t, secret, time.Now()), allowed: []string{rpc.AdminServiceShutdownProcedure, rpc.AdminServiceCompactStoreProcedure, readProcedure}},
+		{name: "operator jwt", token: signTestRoleJWT(t, secret, "bob", "operator"), allowed: []string{rpc.AdminServiceCompactStoreProcedure, readProcedure}},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			for _, procedure := range []string{rpc.AdminServiceShutdownProcedure, rpc.AdminServiceCompactStoreProcedure, readProcedure} {
+				err := call(procedure, tc.token)
+				if slices.Contains(tc.allowed, procedure) {
+					require.NoError(t, err, procedure)
+				} else {
+					require.Equal(t, connect.CodePermissionDenied, connect.CodeOf(err), procedure)
+				}
+			}
+		})
+	}
+
+	err := call(readProcedure, signTestRoleJWT(t, secret, "bob", "root"))
+	require.Equal(t, connect.CodeUnauthenticated, connect.CodeOf(err))
+	require.ErrorContains(t, err, `unknown role "root"`)
+
+	// every admin call is audited with its caller, the read-only calls are not
+	auditLog.Reset()
+	require.NoError(t, call(rpc.AdminServiceCompactStoreProcedure, "operator-token"))
+	require.Error(t, call(rpc.AdminServiceShutdownProcedure, "operator-token"))
+	require.Error(t, call(rpc.AdminServiceShutdownProcedure, "wrong-token"))
+	require.NoError(t, call(readProcedure, "operator-token"))
+
+	var entries []map[string]any
+	for _, line := range strings.Split(strings.TrimSpace(auditLog.String()), "\n") {
+		var entry map[string]any
+		require.NoError(t, json.Unmarshal([]byte(line), &entry))
+		entries = append(entries, entry)
+	}
+	require.Len(t, entries, 3)
+	assert.Equal(t, "info", entries[0]["level"])
+	assert.Equal(t, rpc.AdminServiceCompactStoreProcedure, entries[0]["procedure"])
+	assert.Equal(t, "alice", entries[0]["caller"])
+	assert.Equal(t, "operator", entries[0]["role"])
+	assert.Equal(t, "ok", entries[0]["code"])
+	assert.Equal(t, "warn", entries[1]["level"])
+	assert.Equal(t, "alice", entries[1]["caller"])
+	assert.Equal(t, "permission_denied", entries[1]["code"])
+	assert.Equal(t, "unauthenticated", entries[2]["caller"])
+	assert.Equal(t, "unauthenticated", entries[2]["code"])
+}
+
+func TestServiceHandlerAuth(t *testing.T) {
+	mockStore := mocks.NewMockStore(t)
+	mockStore.On("GetState", mock.Anything).Return(types.State{ChainID: "test-chain"}, nil).Maybe()
+	mockP2P := &mocks.MockP2PRPC{}
+	mockP2P.On("GetNetworkInfo").Return(p2p.NetworkInfo{ID: "nid"}, nil).Maybe()
+
+	cfg := config.DefaultConfig
+	cfg.RPC.AuthToken = "secret-token"
+	cfg.RPC.AuthServices = rpc.P2PServiceName
+	handler, err := NewServiceHandler(mockStore, mockP2P, zerolog.Nop(), cfg, ServiceOptions{})
+	require.NoError(t, err)
+	server := httptest.NewServer(handler)
+	defer server.Close()
+
+	// read-only RPCs of other services stay open
+	storeClient := rpc.NewStoreServiceClient(server.Client(), server.URL)
+	_, err = storeClient.GetState(context.Background(), connect.NewRequest(&emptypb.Empty{}))
+	require.NoError(t, err)
+
+	p2pClient := rpc.NewP2PServiceClient(server.Client(), server.URL)
+	_, err = p2pClient.GetNetInfo(context.Background(), connect.NewRequest(&emptypb.Empty{}))
+	assert.Equal(t, connect.CodeUnauthenticated, connect.CodeOf(err))
+
+	req := connect.NewRequest(&emptypb.Empty{})
+	req.Header().Set("Authorization", "Bearer secret-token")
+	_, err = p2pClient.GetNetInfo(context.Background(), req)
+	require.NoError(t, err)
+
+	cfg.RPC.AuthToken = ""
+	_, err = NewServiceHandler(mockStore, mockP2P, zerolog.Nop(), cfg, ServiceOptions{})
+	require.Error(t, err)
+}
+
+func TestAuthHTTPHandler(t *testing.T) {
+	next := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {})
+	server := httptest.NewServer(NewAuthHTTPHandler(next, AuthOptions{
+		RoleTokens: []RoleToken{{Identity: "monitoring", Role: RoleReadOnly, Token: "read-only-token"}},
+		Services:   []string{rpc.StoreServiceName},
+	}, zerolog.Nop()))
+	defer server.Close()
+
+	get := func(path, token string) int {
+		req, err := http.NewRequest(http.MethodGet, server.URL+path, nil)
+		require.NoError(t, err)
+		if token != "" {
+			req.Header.Set("Authorization", "Bearer "+token)
+		}
+		resp, err := server.Client().Do(req)
+		require.NoError(t, err)
+		resp.Body.Close()
+		return resp.StatusCode
+	}
+
+	// the routes of protected services require a token of any role
+	require.Equal(t, http.StatusUnauthorized, get("/websocket", ""))
+	require.Equal(t, http.StatusUnauthorized, get("/websocket", "wrong-token"))
+	require.Equal(t, http.StatusOK, get("/websocket", "read-only-token"))
+
+	// the other routes are open
+	for _, path := range []string{"/health/ready", "/health/live", "/metrics", "/api/v1/docs"} {
+		require.Equal(t, http.StatusOK, get(path, ""), path)
+	}
+}
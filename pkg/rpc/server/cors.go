@@ -0,0 +1,124 @@
+package server
+
+import (
+	"net/http"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/evstack/ev-node/pkg/config"
+)
+
+// corsMaxAge is the time browsers may cache the result of a preflight request.
+const corsMaxAge = 2 * time.Hour
+
+var (
+	// corsAllowedMethods are the methods used by the Connect, gRPC-Web and gRPC protocols and the
+	// HTTP endpoints.
+	corsAllowedMethods = []string{http.MethodGet, http.MethodPost}
+	// corsAllowedHeaders are the request headers of the Connect, gRPC-Web and gRPC protocols, and
+	// the bearer token of the protected RPCs.
+	corsAllowedHeaders = []string{
+		"Content-Type",
+		"Connect-Protocol-Version",
+		"Connect-Timeout-Ms",
+		"Connect-Accept-Encoding",
+		"Connect-Content-Encoding",
+		"Grpc-Timeout",
+		"Grpc-Accept-Encoding",
+		"Grpc-Encoding",
+		"X-Grpc-Web",
+		"X-User-Agent",
+		"Authorization",
+	}
+	// corsExposedHeaders are the response headers of the Connect, gRPC-Web and gRPC protocols, and
+	// those set by the node.
+	corsExposedHeaders = []string{
+		"Content-Encoding",
+		"Connect-Content-Encoding",
+		"Grpc-Status",
+		"Grpc-Message",
+		"Grpc-Status-Details-Bin",
+		"Grpc-Encoding",
+		"Retry-After",
+		LagHeader,
+	}
+)
+
+// CORSOptions configures the cross-origin requests accepted by an RPC handler, so that browser
+// clients, e.g. explorers using Connect-Web, can call the node without a reverse proxy.
+type CORSOptions struct {
+	// AllowedOrigins are the origins allowed to make cross-origin requests. "*" allows any origin.
+	AllowedOrigins []string
+	// AllowedHeaders are the request headers allowed in addition to the Connect, gRPC-Web and
+	// Authorization headers.
+	AllowedHeaders []string
+}
+
+// CORSOptionsFromConfig returns the CORS options of the RPC configuration, or nil if CORS is
+// disabled.
+func CORSOptionsFromConfig(cfg config.RPCConfig) *CORSOptions {
+	origins := splitList(cfg.CORSAllowedOrigins)
+	if len(origins) == 0 {
+		return nil
+	}
+	return &CORSOptions{AllowedOrigins: origins, AllowedHeaders: splitList(cfg.CORSAllowedHeaders)}
+}
+
+// NewCORSHandler wraps next to answer preflight requests and to allow the cross-origin requests
+// of the origins in opts. Requests from other origins are served without CORS headers, so that
+// browsers reject their responses.
+func NewCORSHandler(next http.Handler, opts CORSOptions) http.Handler {
+	allowAny := slices.Contains(opts.AllowedOrigins, "*")
+	allowedHeaders := strings.Join(append(slices.Clone(corsAllowedHeaders), opts.AllowedHeaders...), ", ")
+	allowedMethods := strings.Join(corsAllowedMethods, ", ")
+	exposedHeaders := strings.Join(corsExposedHeaders, ", ")
+	maxAge := strconv.Itoa(int(corsMaxAge.Seconds()))
+
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		origin := r.Header.Get("Origin")
+		if origin == "" {
+			next.ServeHTTP(w, r)
+			return
+		}
+		w.Header().Add("Vary", "Origin")
+		if !allowAny && !slices.Contains(opts.AllowedOrigins, origin) {
+			next.ServeHTTP(w, r)
+			return
+		}
+		if allowAny {
+			w.Header().Set("Access-Control-Allow-Origin", "*")
+		} else {
+			w.Header().Set("Access-Control-Allow-Origin", origin)
+		}
+
+		if r.Method == http.MethodOptions && r.Header.Get("Access-Control-Request-Method") != "" {
+			w.Header().Add("Vary", "Access-Control-Request-Method")
+			w.Header().Add("Vary", "Access-Control-Request-Headers")
+			w.Header().Set("Access-Control-Allow-Methods", allowedMethods)
+			w.Header().Set("Access-Control-Allow-Headers", allowedHeaders)
+			w.Header().Set("Access-Control-Max-Age", maxAge)
+			w.WriteHeader(http.StatusNoContent)
+			return
+		}
+		w.Header().Set("Access-Control-Expose-Headers", exposedHeaders)
+		next.ServeHTTP(w, r)
+	})
+}
+
+// allows returns whether the origin may make cross-origin requests.
+func (o *CORSOptions) allows(origin string) bool {
+	return slices.Contains(o.AllowedOrigins, "*") || slices.Contains(o.AllowedOrigins, origin)
+}
+
+// splitList returns the non-empty trimmed elements of a comma-separated list.
+func splitList(list string) []string {
+	var elems []string
+	for _, elem := range strings.Split(list, ",") {
+		if elem = strings.TrimSpace(elem); elem != "" {
+			elems = append(elems, elem)
+		}
+	}
+	return elems
+}
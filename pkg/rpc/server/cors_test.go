@@ -0,0 +1,128 @@
+package server
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/evstack/ev-node/pkg/config"
+	"github.com/evstack/ev-node/test/mocks"
+	"github.com/evstack/ev-node/types"
+)
+
+func TestCORSOptionsFromConfig(t *testing.T) {
+	assert.Nil(t, CORSOptionsFromConfig(config.RPCConfig{CORSAllowedHeaders: "X-Request-Id"}))
+
+	opts := CORSOptionsFromConfig(config.RPCConfig{
+		CORSAllowedOrigins: "https://a.example.com, https://b.example.com,",
+		CORSAllowedHeaders: "X-Request-Id",
+	})
+	require.NotNil(t, opts)
+	assert.Equal(t, []string{"https://a.example.com", "https://b.example.com"}, opts.AllowedOrigins)
+	assert.Equal(t, []string{"X-Request-Id"}, opts.AllowedHeaders)
+}
+
+func TestCORSHandler(t *testing.T) {
+	next := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte("ok"))
+	})
+	handler := NewCORSHandler(next, CORSOptions{
+		AllowedOrigins: []string{"https://explorer.example.com"},
+		AllowedHeaders: []string{"X-Request-Id"},
+	})
+
+	serve := func(method, origin string, header http.Header) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(method, "/evnode.v1.StoreService/GetState", nil)
+		for key, values := range header {
+			req.Header[key] = values
+		}
+		if origin != "" {
+			req.Header.Set("Origin", origin)
+		}
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, req)
+		return rec
+	}
+
+	t.Run("preflight", func(t *testing.T) {
+		rec := serve(http.MethodOptions, "https://explorer.example.com", http.Header{
+			"Access-Control-Request-Method":  {http.MethodPost},
+			"Access-Control-Request-Headers": {"content-type,connect-protocol-version"},
+		})
+		assert.Equal(t, http.StatusNoContent, rec.Code)
+		assert.Equal(t, "https://explorer.example.com", rec.Header().Get("Access-Control-Allow-Origin"))
+		assert.Contains(t, rec.Header().Get("Access-Control-Allow-Methods"), http.MethodPost)
+		assert.Contains(t, rec.Header().Get("Access-Control-Allow-Headers"), "Connect-Protocol-Version")
+		assert.Contains(t, rec.Header().Get("Access-Control-Allow-Headers"), "X-Request-Id")
+		assert.Empty(t, rec.Body.String())
+	})
+
+	t.Run("allowed origin", func(t *testing.T) {
+		rec := serve(http.MethodPost, "https://explorer.example.com", nil)
+		assert.Equal(t, http.StatusOK, rec.Code)
+		assert.Equal(t, "ok", rec.Body.String())
+		assert.Equal(t, "https://explorer.example.com", rec.Header().Get("Access-Control-Allow-Origin"))
+		assert.Contains(t, rec.Header().Get("Access-Control-Expose-Headers"), "Grpc-Status")
+		assert.Equal(t, "Origin", rec.Header().Get("Vary"))
+	})
+
+	t.Run("other origin", func(t *testing.T) {
+		rec := serve(http.MethodPost, "https://evil.example.com", nil)
+		assert.Equal(t, http.StatusOK, rec.Code)
+		assert.Empty(t, rec.Header().Get("Access-Control-Allow-Origin"))
+	})
+
+	t.Run("same origin", func(t *testing.T) {
+		rec := serve(http.MethodPost, "", nil)
+		assert.Equal(t, "ok", rec.Body.String())
+		assert.Empty(t, rec.Header().Get("Access-Control-Allow-Origin"))
+	})
+
+	t.Run("any origin", func(t *testing.T) {
+		handler := NewCORSHandler(next, CORSOptions{AllowedOrigins: []string{"*"}})
+		req := httptest.NewRequest(http.MethodGet, "/api/v1/health", nil)
+		req.Header.Set("Origin", "https://other.example.com")
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, req)
+		assert.Equal(t, "*", rec.Header().Get("Access-Control-Allow-Origin"))
+	})
+}
+
+func TestServiceHandlerCORS(t *testing.T) {
+	mockStore := mocks.NewMockStore(t)
+	mockStore.On("GetState", mock.Anything).Return(types.State{ChainID: "test-chain"}, nil).Maybe()
+
+	cfg := config.DefaultConfig
+	cfg.RPC.CORSAllowedOrigins = "https://explorer.example.com"
+	handler, err := NewServiceHandler(mockStore, &mocks.MockP2PRPC{}, zerolog.Nop(), cfg, ServiceOptions{})
+	require.NoError(t, err)
+	server := httptest.NewServer(handler)
+	defer server.Close()
+
+	req, err := http.NewRequest(http.MethodOptions, server.URL+"/evnode.v1.StoreService/GetState", nil)
+	require.NoError(t, err)
+	req.Header.Set("Origin", "https://explorer.example.com")
+	req.Header.Set("Access-Control-Request-Method", http.MethodPost)
+	resp, err := server.Client().Do(req)
+	require.NoError(t, err)
+	resp.Body.Close()
+	assert.Equal(t, http.StatusNoContent, resp.StatusCode)
+	assert.Equal(t, "https://explorer.example.com", resp.Header.Get("Access-Control-Allow-Origin"))
+
+	// Connect-Web clients issue JSON POST requests
+	req, err = http.NewRequest(http.MethodPost, server.URL+"/evnode.v1.StoreService/GetState", strings.NewReader("{}"))
+	require.NoError(t, err)
+	req.Header.Set("Origin", "https://explorer.example.com")
+	req.Header.Set("Content-Type", "application/json")
+	resp, err = server.Client().Do(req)
+	require.NoError(t, err)
+	resp.Body.Close()
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+	assert.Equal(t, "https://explorer.example.com", resp.Header.Get("Access-Control-Allow-Origin"))
+}
@@ -0,0 +1,46 @@
+package server
+
+import (
+	"context"
+	"encoding/hex"
+	"errors"
+
+	"connectrpc.com/connect"
+	"google.golang.org/protobuf/types/known/emptypb"
+
+	coreda "github.com/evstack/ev-node/core/da"
+	pb "github.com/evstack/ev-node/types/pb/evnode/v1"
+)
+
+// GetDAInfo implements the GetDAInfo RPC method. The network ID and max blob size are only
+// reported if the DA client implements coreda.NetworkInfoProvider, and left unset when it fails,
+// e.g. while the DA layer is unreachable.
+func (s *StoreServer) GetDAInfo(
+	ctx context.Context,
+	req *connect.Request[emptypb.Empty],
+) (*connect.Response[pb.GetDAInfoResponse], error) {
+	if s.da == nil {
+		return nil, connect.NewError(connect.CodeUnimplemented, errors.New("node has no DA layer"))
+	}
+
+	resp := &pb.GetDAInfoResponse{
+		Backend:         componentType(s.da),
+		HeaderNamespace: hex.EncodeToString(coreda.PrepareNamespace([]byte(s.daConfig.GetHeaderNamespace()))),
+		DataNamespace:   hex.EncodeToString(coreda.PrepareNamespace([]byte(s.daConfig.GetDataNamespace()))),
+	}
+	if provider, ok := s.da.(coreda.NetworkInfoProvider); ok {
+		info, err := provider.NetworkInfo(ctx)
+		if err != nil {
+			s.logger.Warn().Err(err).Msg("failed to get the network info of the DA layer")
+		} else {
+			resp.NetworkId = info.NetworkID
+			resp.MaxBlobSize = info.MaxBlobSize
+		}
+	}
+	if s.syncStatus != nil {
+		status := s.syncStatus.SyncStatus()
+		resp.DaHeight = status.DAHeight
+		resp.DaIncludedHeight = status.DAIncludedHeight
+	}
+	return connect.NewResponse(resp), nil
+}
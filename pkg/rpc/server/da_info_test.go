@@ -0,0 +1,50 @@
+package server
+
+import (
+	"context"
+	"encoding/hex"
+	"testing"
+
+	"connectrpc.com/connect"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/emptypb"
+
+	coreda "github.com/evstack/ev-node/core/da"
+	"github.com/evstack/ev-node/pkg/config"
+	"github.com/evstack/ev-node/pkg/store"
+	"github.com/evstack/ev-node/test/mocks"
+)
+
+func TestGetDAInfo(t *testing.T) {
+	ctx := context.Background()
+	kv, err := store.NewDefaultInMemoryKVStore()
+	require.NoError(t, err)
+	server := NewStoreServer(store.New(kv), zerolog.Nop())
+
+	_, err = server.GetDAInfo(ctx, connect.NewRequest(&emptypb.Empty{}))
+	require.Equal(t, connect.CodeUnimplemented, connect.CodeOf(err))
+
+	server.da = coreda.NewDummyDA(1024, 0, 0, 0)
+	server.daConfig = config.DefaultConfig.DA
+	server.daConfig.HeaderNamespace = "ns-header"
+	server.daConfig.DataNamespace = "ns-data"
+	server.syncStatus = staticSyncStatus{DAHeight: 100, DAIncludedHeight: 8}
+	resp, err := server.GetDAInfo(ctx, connect.NewRequest(&emptypb.Empty{}))
+	require.NoError(t, err)
+	require.Equal(t, "dummy", resp.Msg.Backend)
+	require.Equal(t, "dummy", resp.Msg.NetworkId)
+	require.Equal(t, uint64(1024), resp.Msg.MaxBlobSize)
+	require.Equal(t, hex.EncodeToString(coreda.PrepareNamespace([]byte("ns-header"))), resp.Msg.HeaderNamespace)
+	require.Equal(t, hex.EncodeToString(coreda.PrepareNamespace([]byte("ns-data"))), resp.Msg.DataNamespace)
+	require.Equal(t, uint64(100), resp.Msg.DaHeight)
+	require.Equal(t, uint64(8), resp.Msg.DaIncludedHeight)
+
+	// DA clients which do not report their network only have the coordinates of the node
+	server.da = mocks.NewMockDA(t)
+	resp, err = server.GetDAInfo(ctx, connect.NewRequest(&emptypb.Empty{}))
+	require.NoError(t, err)
+	require.Empty(t, resp.Msg.NetworkId)
+	require.Zero(t, resp.Msg.MaxBlobSize)
+	require.Equal(t, hex.EncodeToString(coreda.PrepareNamespace([]byte("ns-data"))), resp.Msg.DataNamespace)
+}
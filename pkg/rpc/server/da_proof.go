@@ -0,0 +1,149 @@
+package server
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+
+	"connectrpc.com/connect"
+	ds "github.com/ipfs/go-datastore"
+	"google.golang.org/protobuf/proto"
+
+	coreda "github.com/evstack/ev-node/core/da"
+	"github.com/evstack/ev-node/pkg/config"
+	"github.com/evstack/ev-node/types"
+	pb "github.com/evstack/ev-node/types/pb/evnode/v1"
+)
+
+// daNamespaces returns the namespaces the blobs of blocks may have been submitted to: the header
+// and data namespaces, and the legacy namespace used before they were split.
+func daNamespaces(cfg config.DAConfig) []string {
+	var namespaces []string
+	for _, ns := range []string{cfg.GetHeaderNamespace(), cfg.GetDataNamespace(), cfg.Namespace} {
+		if ns != "" && !slices.Contains(namespaces, ns) {
+			namespaces = append(namespaces, ns)
+		}
+	}
+	return namespaces
+}
+
+// GetDAInclusionProof implements the GetDAInclusionProof RPC method. The blobs are searched on the
+// DA layer at the DA heights recorded when the block was DA included, so the proofs are only
+// available once the node has seen the block included.
+func (s *StoreServer) GetDAInclusionProof(
+	ctx context.Context,
+	req *connect.Request[pb.GetDAInclusionProofRequest],
+) (*connect.Response[pb.GetDAInclusionProofResponse], error) {
+	if s.da == nil {
+		return nil, connect.NewError(connect.CodeUnimplemented, errors.New("node has no DA layer"))
+	}
+	height := req.Msg.Height
+	if height == 0 {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("height must be positive"))
+	}
+
+	header, data, err := s.store.GetBlockData(ctx, height)
+	if err != nil {
+		if errors.Is(err, ds.ErrNotFound) {
+			return nil, s.blockError(height, fmt.Errorf("no block for height %d: %w", height, err))
+		}
+		return nil, s.blockError(height, fmt.Errorf("failed to retrieve block data: %w", err))
+	}
+
+	resp := &pb.GetDAInclusionProofResponse{Height: height}
+	headerHash := header.Hash()
+	resp.Header, err = s.findDABlob(ctx, height, "h", func(bz []byte) bool {
+		var headerPb pb.SignedHeader
+		if err := proto.Unmarshal(bz, &headerPb); err != nil {
+			return false
+		}
+		var candidate types.SignedHeader
+		if err := candidate.FromProto(&headerPb); err != nil {
+			return false
+		}
+		return bytes.Equal(candidate.Hash(), headerHash)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// the data of blocks without transactions is not submitted
+	if len(data.Txs) == 0 {
+		return connect.NewResponse(resp), nil
+	}
+	dataCommitment := data.DACommitment()
+	resp.Data, err = s.findDABlob(ctx, height, "d", func(bz []byte) bool {
+		var candidate types.SignedData
+		if err := candidate.UnmarshalBinary(bz); err != nil {
+			return false
+		}
+		return bytes.Equal(candidate.DACommitment(), dataCommitment)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return connect.NewResponse(resp), nil
+}
+
+// findDABlob searches the DA height at which the header ("h") or data ("d") of the block at the
+// given height was included for the blob matching it, and returns its commitment and proof. Blobs
+// combining the header and data of a height match if any of their parts matches.
+func (s *StoreServer) findDABlob(ctx context.Context, height uint64, suffix string, match func([]byte) bool) (*pb.DABlobInclusion, error) {
+	daHeight := s.daHeight(ctx, height, suffix)
+	if daHeight == 0 {
+		return nil, connect.NewError(connect.CodeNotFound, fmt.Errorf("block %d is not DA included yet", height))
+	}
+
+	for _, ns := range s.daNamespaces {
+		idsResult, err := s.da.GetIDs(ctx, daHeight, []byte(ns))
+		if err != nil {
+			if strings.Contains(err.Error(), coreda.ErrBlobNotFound.Error()) {
+				continue
+			}
+			return nil, newError(connect.CodeUnavailable, pb.ErrorReason_ERROR_REASON_DA_UNAVAILABLE, height, fmt.Errorf("failed to get blob IDs at DA height %d: %w", daHeight, err))
+		}
+		if idsResult == nil || len(idsResult.IDs) == 0 {
+			continue
+		}
+		blobs, err := s.da.Get(ctx, idsResult.IDs, []byte(ns))
+		if err != nil {
+			return nil, newError(connect.CodeUnavailable, pb.ErrorReason_ERROR_REASON_DA_UNAVAILABLE, height, fmt.Errorf("failed to get blobs at DA height %d: %w", daHeight, err))
+		}
+
+		for i, blob := range blobs {
+			parts := [][]byte{blob}
+			if envelope, err := types.UnmarshalBlobEnvelope(blob); err == nil {
+				parts = envelope
+			}
+			if i >= len(idsResult.IDs) || !slices.ContainsFunc(parts, match) {
+				continue
+			}
+
+			id := idsResult.IDs[i]
+			_, commitment, err := coreda.SplitID(id)
+			if err != nil {
+				return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("invalid blob ID: %w", err))
+			}
+			proofs, err := s.da.GetProofs(ctx, []coreda.ID{id}, []byte(ns))
+			if err != nil {
+				return nil, newError(connect.CodeUnavailable, pb.ErrorReason_ERROR_REASON_DA_UNAVAILABLE, height, fmt.Errorf("failed to get inclusion proof: %w", err))
+			}
+			if len(proofs) != 1 {
+				return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("expected 1 inclusion proof, got %d", len(proofs)))
+			}
+			return &pb.DABlobInclusion{
+				DaHeight:   daHeight,
+				Namespace:  ns,
+				Id:         id,
+				Commitment: commitment,
+				Proof:      proofs[0],
+			}, nil
+		}
+	}
+
+	return nil, connect.NewError(connect.CodeNotFound, fmt.Errorf("blob of block %d not found at DA height %d", height, daHeight))
+}
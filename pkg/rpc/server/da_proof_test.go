@@ -0,0 +1,138 @@
+package server
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/binary"
+	"fmt"
+	"testing"
+
+	"connectrpc.com/connect"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	coreda "github.com/evstack/ev-node/core/da"
+	"github.com/evstack/ev-node/pkg/config"
+	"github.com/evstack/ev-node/pkg/store"
+	"github.com/evstack/ev-node/test/mocks"
+	"github.com/evstack/ev-node/types"
+	pb "github.com/evstack/ev-node/types/pb/evnode/v1"
+)
+
+// testBlobID returns the ID of a blob in the format of the DA layers, its DA height followed by
+// its commitment.
+func testBlobID(daHeight uint64, blob []byte) coreda.ID {
+	commitment := sha256.Sum256(blob)
+	return append(binary.LittleEndian.AppendUint64(nil, daHeight), commitment[:]...)
+}
+
+func TestGetDAInclusionProof(t *testing.T) {
+	ctx := context.Background()
+	kv, err := store.NewDefaultInMemoryKVStore()
+	require.NoError(t, err)
+	s := store.New(kv)
+
+	setDAHeights := func(height, headerDAHeight, dataDAHeight uint64) {
+		bz := make([]byte, 8)
+		binary.LittleEndian.PutUint64(bz, headerDAHeight)
+		require.NoError(t, s.SetMetadata(ctx, fmt.Sprintf("%s/%d/h", store.HeightToDAHeightKey, height), bz))
+		binary.LittleEndian.PutUint64(bz, dataDAHeight)
+		require.NoError(t, s.SetMetadata(ctx, fmt.Sprintf("%s/%d/d", store.HeightToDAHeightKey, height), bz))
+	}
+
+	// block 1 submitted in separate blobs, block 2 without transactions, block 3 in a single blob,
+	// block 4 not DA included yet
+	var blobs [4]struct{ header, data []byte }
+	for i := range blobs {
+		height := uint64(i + 1)
+		txs := 2
+		if height == 2 {
+			txs = 0
+		}
+		header, data := types.GetRandomBlock(height, txs, "test-chain")
+		require.NoError(t, s.SaveBlockData(ctx, header, data, &header.Signature))
+		blobs[i].header, err = header.MarshalBinary()
+		require.NoError(t, err)
+		blobs[i].data, err = (&types.SignedData{Data: *data, Signature: header.Signature, Signer: header.Signer}).MarshalBinary()
+		require.NoError(t, err)
+	}
+	setDAHeights(1, 10, 11)
+	setDAHeights(2, 11, 11)
+	setDAHeights(3, 12, 12)
+	envelope, err := types.MarshalBlobEnvelope(blobs[2].header, blobs[2].data)
+	require.NoError(t, err)
+
+	cfg := config.DefaultConfig.DA
+	cfg.HeaderNamespace = "ns-header"
+	cfg.DataNamespace = "ns-data"
+	daBlobs := map[string]map[uint64][][]byte{
+		"ns-header": {10: {[]byte("junk"), blobs[0].header}, 11: {blobs[1].header}, 12: {envelope}},
+		"ns-data":   {11: {blobs[0].data}},
+	}
+	mockDA := mocks.NewMockDA(t)
+	mockDA.On("GetIDs", mock.Anything, mock.Anything, mock.Anything).Return(
+		func(_ context.Context, daHeight uint64, ns []byte) (*coreda.GetIDsResult, error) {
+			result := &coreda.GetIDsResult{}
+			for _, blob := range daBlobs[string(ns)][daHeight] {
+				result.IDs = append(result.IDs, testBlobID(daHeight, blob))
+			}
+			return result, nil
+		}).Maybe()
+	mockDA.On("Get", mock.Anything, mock.Anything, mock.Anything).Return(
+		func(_ context.Context, ids []coreda.ID, ns []byte) ([]coreda.Blob, error) {
+			daHeight := binary.LittleEndian.Uint64(ids[0])
+			return daBlobs[string(ns)][daHeight], nil
+		}).Maybe()
+	mockDA.On("GetProofs", mock.Anything, mock.Anything, mock.Anything).Return(
+		func(_ context.Context, ids []coreda.ID, _ []byte) ([]coreda.Proof, error) {
+			return []coreda.Proof{append([]byte("proof-"), ids[0][:8]...)}, nil
+		}).Maybe()
+
+	server := NewStoreServer(s, zerolog.Nop())
+	proof := func(height uint64) (*pb.GetDAInclusionProofResponse, error) {
+		resp, err := server.GetDAInclusionProof(ctx, connect.NewRequest(&pb.GetDAInclusionProofRequest{Height: height}))
+		if err != nil {
+			return nil, err
+		}
+		return resp.Msg, nil
+	}
+
+	_, err = proof(1)
+	require.Equal(t, connect.CodeUnimplemented, connect.CodeOf(err))
+	server.da = mockDA
+	server.daNamespaces = daNamespaces(cfg)
+
+	resp, err := proof(1)
+	require.NoError(t, err)
+	require.Equal(t, uint64(10), resp.Header.DaHeight)
+	require.Equal(t, "ns-header", resp.Header.Namespace)
+	require.Equal(t, testBlobID(10, blobs[0].header), resp.Header.Id)
+	require.Equal(t, testBlobID(10, blobs[0].header)[8:], resp.Header.Commitment)
+	require.Equal(t, append([]byte("proof-"), resp.Header.Id[:8]...), resp.Header.Proof)
+	require.Equal(t, uint64(11), resp.Data.DaHeight)
+	require.Equal(t, "ns-data", resp.Data.Namespace)
+	require.Equal(t, testBlobID(11, blobs[0].data), resp.Data.Id)
+
+	resp, err = proof(2)
+	require.NoError(t, err)
+	require.Equal(t, testBlobID(11, blobs[1].header), resp.Header.Id)
+	require.Nil(t, resp.Data)
+
+	resp, err = proof(3)
+	require.NoError(t, err)
+	require.Equal(t, testBlobID(12, envelope), resp.Header.Id)
+	require.Equal(t, resp.Header.Id, resp.Data.Id)
+
+	_, err = proof(4)
+	require.Equal(t, connect.CodeNotFound, connect.CodeOf(err))
+	_, err = proof(5)
+	require.Equal(t, connect.CodeNotFound, connect.CodeOf(err))
+	_, err = proof(0)
+	require.Equal(t, connect.CodeInvalidArgument, connect.CodeOf(err))
+
+	// the recorded DA height does not contain the block
+	setDAHeights(4, 10, 10)
+	_, err = proof(4)
+	require.Equal(t, connect.CodeNotFound, connect.CodeOf(err))
+}
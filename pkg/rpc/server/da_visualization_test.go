@@ -254,7 +254,7 @@ func TestRegisterCustomHTTPEndpointsDAVisualization(t *testing.T) {
 
 	// Create mux and register endpoints
 	mux := http.NewServeMux()
-	RegisterCustomHTTPEndpoints(mux)
+	RegisterCustomHTTPEndpoints(mux, nil)
 
 	// Test /da endpoint
 	req, err := http.NewRequest("GET", "/da", nil)
@@ -291,7 +291,7 @@ func TestRegisterCustomHTTPEndpointsWithoutServer(t *testing.T) {
 	SetDAVisualizationServer(nil)
 
 	mux := http.NewServeMux()
-	RegisterCustomHTTPEndpoints(mux)
+	RegisterCustomHTTPEndpoints(mux, nil)
 
 	// Test that endpoints return service unavailable when server is not set
 	endpoints := []string{"/da", "/da/submissions", "/da/blob"}
@@ -0,0 +1,103 @@
+package server
+
+import (
+	"context"
+	"math"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	rpc "github.com/evstack/ev-node/types/pb/evnode/v1/v1connect"
+)
+
+// Drainer tracks the requests served by an RPC handler so that they can be drained on shutdown.
+// Once draining, new requests are rejected with 503 Service Unavailable and a Retry-After header,
+// so that load balancers rotate traffic to other nodes, while in-flight requests complete. Health
+// probes are still served, and the readiness of the node fails.
+type Drainer struct {
+	retryAfter string
+
+	mu       sync.Mutex
+	draining bool
+	inFlight sync.WaitGroup
+	// closing is closed when draining starts
+	closing chan struct{}
+}
+
+// drainingKey is the request context key of the channel closed when draining starts.
+type drainingKey struct{}
+
+// Draining returns a channel closed when the Drainer of the request with the given context starts
+// draining, so that long-lived requests, such as streams, can end early. It returns nil if the
+// request is not tracked by a Drainer.
+func Draining(ctx context.Context) <-chan struct{} {
+	closing, _ := ctx.Value(drainingKey{}).(chan struct{})
+	return closing
+}
+
+// NewDrainer creates a Drainer advising rejected clients to retry after retryAfter,
+// rounded up to the second.
+func NewDrainer(retryAfter time.Duration) *Drainer {
+	seconds := max(int64(math.Ceil(retryAfter.Seconds())), 1)
+	return &Drainer{retryAfter: strconv.FormatInt(seconds, 10), closing: make(chan struct{})}
+}
+
+// Handler wraps an RPC handler, typically created by NewServiceHandler, to track its requests.
+func (d *Drainer) Handler(next http.Handler) http.Handler {
+	next = withoutH2C(next)
+	return newH2CHandler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		// health probes are served while draining, so that readiness probes report the drain
+		if isHealthProbe(r) {
+			next.ServeHTTP(w, r.WithContext(context.WithValue(r.Context(), drainingKey{}, d.closing)))
+			return
+		}
+		if !d.begin() {
+			w.Header().Set("Retry-After", d.retryAfter)
+			w.Header().Set("Connection", "close")
+			http.Error(w, "node is shutting down", http.StatusServiceUnavailable)
+			return
+		}
+		defer d.inFlight.Done()
+		next.ServeHTTP(w, r.WithContext(context.WithValue(r.Context(), drainingKey{}, d.closing)))
+	}))
+}
+
+// isHealthProbe reports whether the request is served by the health endpoints or HealthService.
+func isHealthProbe(r *http.Request) bool {
+	return strings.HasPrefix(r.URL.Path, "/health/") || strings.HasPrefix(r.URL.Path, "/"+rpc.HealthServiceName+"/")
+}
+
+// begin registers a new request, and returns false if the drainer is draining.
+func (d *Drainer) begin() bool {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	if d.draining {
+		return false
+	}
+	d.inFlight.Add(1)
+	return true
+}
+
+// Drain rejects new requests and waits until the in-flight requests complete or ctx is done.
+func (d *Drainer) Drain(ctx context.Context) error {
+	d.mu.Lock()
+	if !d.draining {
+		d.draining = true
+		close(d.closing)
+	}
+	d.mu.Unlock()
+
+	done := make(chan struct{})
+	go func() {
+		d.inFlight.Wait()
+		close(done)
+	}()
+	select {
+	case <-done:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
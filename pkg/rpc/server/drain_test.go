@@ -0,0 +1,147 @@
+package server
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/emptypb"
+
+	"github.com/evstack/ev-node/pkg/config"
+	"github.com/evstack/ev-node/test/mocks"
+	pb "github.com/evstack/ev-node/types/pb/evnode/v1"
+	rpc "github.com/evstack/ev-node/types/pb/evnode/v1/v1connect"
+)
+
+func TestDrainer(t *testing.T) {
+	started := make(chan struct{})
+	release := make(chan struct{})
+	next := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/slow" {
+			close(started)
+			<-release
+		}
+		_, _ = w.Write([]byte("ok"))
+	})
+
+	drainer := NewDrainer(1500 * time.Millisecond)
+	srv := httptest.NewServer(drainer.Handler(next))
+	defer srv.Close()
+
+	get := func(path string) (*http.Response, string) {
+		resp, err := http.Get(srv.URL + path)
+		require.NoError(t, err)
+		defer resp.Body.Close()
+		body, err := io.ReadAll(resp.Body)
+		require.NoError(t, err)
+		return resp, string(body)
+	}
+
+	resp, body := get("/fast")
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+	assert.Equal(t, "ok", body)
+
+	// an in-flight request completes while draining
+	slow := make(chan string, 1)
+	go func() {
+		_, body := get("/slow")
+		slow <- body
+	}()
+	<-started
+
+	drained := make(chan error, 1)
+	go func() { drained <- drainer.Drain(context.Background()) }()
+	require.Eventually(t, func() bool {
+		resp, _ := get("/fast")
+		return resp.StatusCode == http.StatusServiceUnavailable
+	}, time.Second, 10*time.Millisecond)
+
+	// new requests are rejected with a retry hint
+	resp, _ = get("/fast")
+	assert.Equal(t, http.StatusServiceUnavailable, resp.StatusCode)
+	assert.Equal(t, "2", resp.Header.Get("Retry-After"))
+
+	select {
+	case <-drained:
+		t.Fatal("drain completed with a request in flight")
+	default:
+	}
+	close(release)
+	assert.Equal(t, "ok", <-slow)
+	require.NoError(t, <-drained)
+}
+
+func TestDrainerTimeout(t *testing.T) {
+	release := make(chan struct{})
+	started := make(chan struct{})
+	drainer := NewDrainer(time.Second)
+	srv := httptest.NewServer(drainer.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		close(started)
+		<-release
+	})))
+	defer srv.Close()
+
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		resp, err := http.Get(srv.URL)
+		if err == nil {
+			_ = resp.Body.Close()
+		}
+	}()
+	<-started
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	require.ErrorIs(t, drainer.Drain(ctx), context.DeadlineExceeded)
+
+	close(release)
+	<-done
+}
+
+func TestDrainerHealthProbes(t *testing.T) {
+	mockStore := mocks.NewMockStore(t)
+	mockStore.On("Height", mock.Anything).Return(uint64(5), nil)
+	handler, err := NewServiceHandler(mockStore, &mocks.MockP2PRPC{}, zerolog.Nop(), config.DefaultConfig, ServiceOptions{})
+	require.NoError(t, err)
+	drainer := NewDrainer(time.Second)
+	drained := drainer.Handler(handler)
+	_, nested := withoutH2C(drained).(*h2cHandler)
+	require.False(t, nested, "h2c is only supported by the outermost handler")
+	srv := httptest.NewServer(drained)
+	defer srv.Close()
+
+	status := func(path string) int {
+		resp, err := http.Get(srv.URL + path)
+		require.NoError(t, err)
+		_ = resp.Body.Close()
+		return resp.StatusCode
+	}
+	healthClient := rpc.NewHealthServiceClient(srv.Client(), srv.URL)
+	readyz := func() *pb.ReadyzResponse {
+		resp, err := healthClient.Readyz(context.Background(), connect.NewRequest(&emptypb.Empty{}))
+		require.NoError(t, err)
+		return resp.Msg
+	}
+
+	assert.Equal(t, http.StatusOK, status("/health/ready"))
+	assert.Equal(t, pb.HealthStatus_PASS, readyz().Status)
+
+	require.NoError(t, drainer.Drain(context.Background()))
+
+	// the node is live but no longer ready while draining, and serves no other request
+	assert.Equal(t, http.StatusOK, status("/health/live"))
+	assert.Equal(t, http.StatusServiceUnavailable, status("/health/ready"))
+	resp := readyz()
+	assert.Equal(t, pb.HealthStatus_FAIL, resp.Status)
+	assert.Equal(t, "drain", resp.Checks[len(resp.Checks)-1].Name)
+	assert.Equal(t, http.StatusServiceUnavailable, status("/api/v1/state"))
+}
@@ -0,0 +1,40 @@
+package server
+
+import (
+	"errors"
+
+	"connectrpc.com/connect"
+	ds "github.com/ipfs/go-datastore"
+
+	"github.com/evstack/ev-node/pkg/store"
+	pb "github.com/evstack/ev-node/types/pb/evnode/v1"
+)
+
+// newError returns a connect error carrying an ErrorDetail with the given reason, so that clients
+// can branch on the reason of the failure without parsing the error message.
+func newError(code connect.Code, reason pb.ErrorReason, height uint64, err error) *connect.Error {
+	connectErr := connect.NewError(code, err)
+	if detail, detailErr := connect.NewErrorDetail(&pb.ErrorDetail{Reason: reason, Height: height}); detailErr == nil {
+		connectErr.AddDetail(detail)
+	}
+	return connectErr
+}
+
+// blockError maps an error reading the block at the given height from the store to a connect
+// error. A block missing from the store is reported as syncing if the network is known to have
+// produced it already.
+func (s *StoreServer) blockError(height uint64, err error) *connect.Error {
+	switch {
+	case errors.Is(err, store.ErrPruned):
+		return newError(connect.CodeNotFound, pb.ErrorReason_ERROR_REASON_PRUNED, height, err)
+	case errors.Is(err, ds.ErrNotFound):
+		if s.syncStatus != nil && height != 0 && height <= s.syncStatus.SyncStatus().NetworkHeight {
+			return newError(connect.CodeNotFound, pb.ErrorReason_ERROR_REASON_SYNCING, height, err)
+		}
+		return newError(connect.CodeNotFound, pb.ErrorReason_ERROR_REASON_BLOCK_NOT_FOUND, height, err)
+	case errors.Is(err, store.ErrCorrupted):
+		return newError(connect.CodeDataLoss, pb.ErrorReason_ERROR_REASON_STORE_CORRUPTED, height, err)
+	default:
+		return connect.NewError(connect.CodeInternal, err)
+	}
+}
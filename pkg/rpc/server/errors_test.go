@@ -0,0 +1,102 @@
+package server
+
+import (
+	"context"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"testing"
+
+	"connectrpc.com/connect"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/emptypb"
+
+	"github.com/evstack/ev-node/pkg/p2p"
+	"github.com/evstack/ev-node/pkg/store"
+	"github.com/evstack/ev-node/test/mocks"
+	"github.com/evstack/ev-node/types"
+	pb "github.com/evstack/ev-node/types/pb/evnode/v1"
+)
+
+// errorDetail returns the ErrorDetail attached to the error of a failed RPC.
+func errorDetail(t *testing.T, err error) *pb.ErrorDetail {
+	t.Helper()
+	var connectErr *connect.Error
+	require.ErrorAs(t, err, &connectErr)
+	for _, detail := range connectErr.Details() {
+		value, err := detail.Value()
+		require.NoError(t, err)
+		if errDetail, ok := value.(*pb.ErrorDetail); ok {
+			return errDetail
+		}
+	}
+	t.Fatalf("error %v has no error detail", err)
+	return nil
+}
+
+func TestStoreServerErrorReasons(t *testing.T) {
+	ctx := context.Background()
+	kv, err := store.NewDefaultInMemoryKVStore()
+	require.NoError(t, err)
+	s := store.New(kv)
+	for height := uint64(1); height <= 2; height++ {
+		header, data := types.GetRandomBlock(height, 1, "test-chain")
+		require.NoError(t, s.SaveBlockData(ctx, header, data, &header.Signature))
+	}
+	require.NoError(t, s.SetHeight(ctx, 2))
+	daIncludedHeight := make([]byte, 8)
+	binary.LittleEndian.PutUint64(daIncludedHeight, 1)
+	require.NoError(t, s.SetMetadata(ctx, store.DAIncludedHeightKey, daIncludedHeight))
+	require.NoError(t, s.SetMetadata(ctx, fmt.Sprintf("%s/1/d", store.HeightToDAHeightKey), daIncludedHeight))
+	require.NoError(t, s.(store.Pruner).PruneBlockData(ctx, 1))
+	server := NewStoreServer(s, zerolog.Nop())
+	server.syncStatus = staticSyncStatus{NetworkHeight: 5}
+
+	getBlock := func(height uint64) error {
+		_, err := server.GetBlock(ctx, connect.NewRequest(&pb.GetBlockRequest{
+			Identifier: &pb.GetBlockRequest_Height{Height: height},
+		}))
+		return err
+	}
+
+	err = getBlock(1)
+	require.Equal(t, connect.CodeNotFound, connect.CodeOf(err))
+	require.Equal(t, pb.ErrorReason_ERROR_REASON_PRUNED, errorDetail(t, err).Reason)
+	require.Equal(t, uint64(1), errorDetail(t, err).Height)
+
+	// the network produced the block but the node has not synced it yet
+	err = getBlock(4)
+	require.Equal(t, connect.CodeNotFound, connect.CodeOf(err))
+	require.Equal(t, pb.ErrorReason_ERROR_REASON_SYNCING, errorDetail(t, err).Reason)
+
+	err = getBlock(6)
+	require.Equal(t, connect.CodeNotFound, connect.CodeOf(err))
+	require.Equal(t, pb.ErrorReason_ERROR_REASON_BLOCK_NOT_FOUND, errorDetail(t, err).Reason)
+
+	_, err = server.GetHeader(ctx, connect.NewRequest(&pb.GetHeaderRequest{Height: 4}))
+	require.Equal(t, connect.CodeNotFound, connect.CodeOf(err))
+	require.Equal(t, pb.ErrorReason_ERROR_REASON_SYNCING, errorDetail(t, err).Reason)
+}
+
+func TestStoreServerCorrupted(t *testing.T) {
+	mockStore := mocks.NewMockStore(t)
+	mockStore.On("GetHeader", mock.Anything, uint64(3)).Return(nil, fmt.Errorf("unmarshal block header: %w: %w", store.ErrCorrupted, errors.New("bad wire type"))).Once()
+	server := NewStoreServer(mockStore, zerolog.Nop())
+
+	_, err := server.GetHeader(context.Background(), connect.NewRequest(&pb.GetHeaderRequest{Height: 3}))
+	require.Equal(t, connect.CodeDataLoss, connect.CodeOf(err))
+	require.Equal(t, pb.ErrorReason_ERROR_REASON_STORE_CORRUPTED, errorDetail(t, err).Reason)
+	require.Equal(t, uint64(3), errorDetail(t, err).Height)
+}
+
+func TestP2PServerUnavailable(t *testing.T) {
+	mockP2P := &mocks.MockP2PRPC{}
+	mockP2P.On("GetNetworkInfo").Return(p2p.NetworkInfo{}, errors.New("p2p disabled"))
+	server := NewP2PServer(mockP2P)
+
+	_, err := server.GetNetInfo(context.Background(), connect.NewRequest(&emptypb.Empty{}))
+	require.Equal(t, connect.CodeUnavailable, connect.CodeOf(err))
+	require.Equal(t, pb.ErrorReason_ERROR_REASON_P2P_UNAVAILABLE, errorDetail(t, err).Reason)
+}
@@ -0,0 +1,85 @@
+package server
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+
+	"connectrpc.com/connect"
+
+	pb "github.com/evstack/ev-node/types/pb/evnode/v1"
+)
+
+const (
+	// defaultConsistencyCount is the number of heights checked by GetExecutionConsistency by default.
+	defaultConsistencyCount = 10
+	// maxConsistencyCount is the maximum number of heights checked by GetExecutionConsistency.
+	maxConsistencyCount = 100
+)
+
+// GetExecutionConsistency implements the GetExecutionConsistency RPC method. A height is consistent
+// if the state root of its execution block is the one the store committed to: the app hash of the
+// next header, or of the state for the latest height.
+func (s *StoreServer) GetExecutionConsistency(
+	ctx context.Context,
+	req *connect.Request[pb.GetExecutionConsistencyRequest],
+) (*connect.Response[pb.GetExecutionConsistencyResponse], error) {
+	if s.blockInfo == nil {
+		return nil, connect.NewError(connect.CodeUnimplemented, errors.New("executor does not expose its blocks"))
+	}
+	count := uint64(req.Msg.Count)
+	if count == 0 {
+		count = defaultConsistencyCount
+	}
+	if count > maxConsistencyCount {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("count must be at most %d", maxConsistencyCount))
+	}
+
+	height, err := s.store.Height(ctx)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to get store height: %w", err))
+	}
+	state, err := s.store.GetState(ctx)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to get state: %w", err))
+	}
+	latest, err := s.blockInfo.GetLatestBlockInfo(ctx)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeUnavailable, fmt.Errorf("failed to get latest execution block: %w", err))
+	}
+
+	resp := &pb.GetExecutionConsistencyResponse{
+		Height:          height,
+		ExecutionHeight: latest.Number,
+		Drift:           int64(latest.Number) - int64(height),
+	}
+	resp.Consistent = resp.Drift == 0
+
+	// the app hash of a header is the state root after the previous height
+	expectedStateRoot := state.AppHash
+	for h := height; h > 0 && uint64(len(resp.Blocks)) < count; h-- {
+		mapping := &pb.ExecutionBlockMapping{
+			Height:            h,
+			ExpectedStateRoot: expectedStateRoot,
+		}
+		if info, err := s.blockInfo.GetBlockInfo(ctx, h); err != nil {
+			mapping.Error = err.Error()
+		} else {
+			mapping.ExecutionNumber = info.Number
+			mapping.ExecutionHash = info.Hash
+			mapping.ExecutionStateRoot = info.StateRoot
+			mapping.Consistent = bytes.Equal(info.StateRoot, expectedStateRoot)
+		}
+		resp.Consistent = resp.Consistent && mapping.Consistent
+		resp.Blocks = append(resp.Blocks, mapping)
+
+		header, err := s.store.GetHeader(ctx, h)
+		if err != nil {
+			return nil, s.blockError(h, fmt.Errorf("failed to get header at height %d: %w", h, err))
+		}
+		expectedStateRoot = header.AppHash
+	}
+
+	return connect.NewResponse(resp), nil
+}
@@ -0,0 +1,101 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"connectrpc.com/connect"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/require"
+
+	coreexecutor "github.com/evstack/ev-node/core/execution"
+	"github.com/evstack/ev-node/pkg/store"
+	"github.com/evstack/ev-node/types"
+	pb "github.com/evstack/ev-node/types/pb/evnode/v1"
+)
+
+// testBlockInfo exposes execution blocks numbered like the heights, with the given state roots.
+type testBlockInfo struct {
+	stateRoots map[uint64][]byte
+	latest     uint64
+}
+
+func (b testBlockInfo) GetBlockInfo(ctx context.Context, height uint64) (coreexecutor.BlockInfo, error) {
+	stateRoot, ok := b.stateRoots[height]
+	if !ok {
+		return coreexecutor.BlockInfo{}, errors.New("block not found")
+	}
+	return coreexecutor.BlockInfo{Number: height, Hash: []byte(fmt.Sprintf("hash%d", height)), StateRoot: stateRoot}, nil
+}
+
+func (b testBlockInfo) GetLatestBlockInfo(ctx context.Context) (coreexecutor.BlockInfo, error) {
+	return b.GetBlockInfo(ctx, b.latest)
+}
+
+func TestGetExecutionConsistency(t *testing.T) {
+	ctx := context.Background()
+	kv, err := store.NewDefaultInMemoryKVStore()
+	require.NoError(t, err)
+	s := store.New(kv)
+
+	// the app hash of each header is the state root after the previous height
+	stateRoots := map[uint64][]byte{}
+	for height := uint64(1); height <= 3; height++ {
+		header, data := types.GetRandomBlock(height, 1, "test-chain")
+		header.AppHash = []byte(fmt.Sprintf("root%d", height-1))
+		require.NoError(t, s.SaveBlockData(ctx, header, data, &header.Signature))
+		stateRoots[height] = []byte(fmt.Sprintf("root%d", height))
+	}
+	require.NoError(t, s.SetHeight(ctx, 3))
+	require.NoError(t, s.UpdateState(ctx, types.State{ChainID: "test-chain", LastBlockHeight: 3, AppHash: []byte("root3")}))
+
+	server := NewStoreServer(s, zerolog.Nop())
+	_, err = server.GetExecutionConsistency(ctx, connect.NewRequest(&pb.GetExecutionConsistencyRequest{}))
+	require.Equal(t, connect.CodeUnimplemented, connect.CodeOf(err))
+
+	blockInfo := testBlockInfo{stateRoots: stateRoots, latest: 3}
+	server.blockInfo = blockInfo
+	resp, err := server.GetExecutionConsistency(ctx, connect.NewRequest(&pb.GetExecutionConsistencyRequest{}))
+	require.NoError(t, err)
+	require.Equal(t, uint64(3), resp.Msg.Height)
+	require.Equal(t, uint64(3), resp.Msg.ExecutionHeight)
+	require.Zero(t, resp.Msg.Drift)
+	require.True(t, resp.Msg.Consistent)
+	require.Len(t, resp.Msg.Blocks, 3)
+	for i, block := range resp.Msg.Blocks {
+		height := uint64(3 - i)
+		require.Equal(t, height, block.Height)
+		require.Equal(t, height, block.ExecutionNumber)
+		require.Equal(t, []byte(fmt.Sprintf("hash%d", height)), block.ExecutionHash)
+		require.Equal(t, stateRoots[height], block.ExpectedStateRoot)
+		require.True(t, block.Consistent)
+	}
+
+	// diverging state root at height 2, execution layer one block ahead
+	blockInfo.stateRoots[2] = []byte("other")
+	blockInfo.stateRoots[4] = []byte("root4")
+	blockInfo.latest = 4
+	server.blockInfo = blockInfo
+	resp, err = server.GetExecutionConsistency(ctx, connect.NewRequest(&pb.GetExecutionConsistencyRequest{Count: 2}))
+	require.NoError(t, err)
+	require.Equal(t, int64(1), resp.Msg.Drift)
+	require.False(t, resp.Msg.Consistent)
+	require.Len(t, resp.Msg.Blocks, 2)
+	require.True(t, resp.Msg.Blocks[0].Consistent)
+	require.False(t, resp.Msg.Blocks[1].Consistent)
+
+	// missing execution block
+	delete(blockInfo.stateRoots, 3)
+	blockInfo.latest = 2
+	server.blockInfo = blockInfo
+	resp, err = server.GetExecutionConsistency(ctx, connect.NewRequest(&pb.GetExecutionConsistencyRequest{Count: 1}))
+	require.NoError(t, err)
+	require.Equal(t, int64(-1), resp.Msg.Drift)
+	require.False(t, resp.Msg.Blocks[0].Consistent)
+	require.Equal(t, "block not found", resp.Msg.Blocks[0].Error)
+
+	_, err = server.GetExecutionConsistency(ctx, connect.NewRequest(&pb.GetExecutionConsistencyRequest{Count: maxConsistencyCount + 1}))
+	require.Equal(t, connect.CodeInvalidArgument, connect.CodeOf(err))
+}
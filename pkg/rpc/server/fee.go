@@ -0,0 +1,95 @@
+package server
+
+import (
+	"bytes"
+	"compress/flate"
+	"context"
+	"fmt"
+
+	"connectrpc.com/connect"
+	"github.com/rs/zerolog"
+
+	coreda "github.com/evstack/ev-node/core/da"
+	coreexecutor "github.com/evstack/ev-node/core/execution"
+	pb "github.com/evstack/ev-node/types/pb/evnode/v1"
+)
+
+// FeeServer implements the FeeService defined in the proto file
+type FeeServer struct {
+	exec   ExecutorProvider
+	da     coreda.DA
+	logger zerolog.Logger
+}
+
+// NewFeeServer creates a new FeeServer instance
+func NewFeeServer(exec ExecutorProvider, da coreda.DA, logger zerolog.Logger) *FeeServer {
+	return &FeeServer{
+		exec:   exec,
+		da:     da,
+		logger: logger,
+	}
+}
+
+// EstimateTxFee implements the EstimateTxFee RPC method.
+// The execution component is only populated when the executor implements
+// coreexecutor.GasEstimator. The DA component is the tx's share of a blob,
+// projected from its compressed size and the current DA gas price.
+func (f *FeeServer) EstimateTxFee(
+	ctx context.Context,
+	req *connect.Request[pb.EstimateTxFeeRequest],
+) (*connect.Response[pb.EstimateTxFeeResponse], error) {
+	tx := req.Msg.Tx
+	if len(tx) == 0 {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("tx must not be empty"))
+	}
+
+	resp := &pb.EstimateTxFeeResponse{
+		TxSize: uint64(len(tx)),
+	}
+
+	if estimator, ok := f.exec.GetExecutor().(coreexecutor.GasEstimator); ok {
+		gas, gasPrice, err := estimator.EstimateGas(ctx, tx)
+		if err != nil {
+			return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("failed to estimate execution gas: %w", err))
+		}
+		resp.ExecutionGas = gas
+		resp.ExecutionGasPrice = gasPrice
+		resp.ExecutionFee = float64(gas) * gasPrice
+	}
+
+	compressedSize, err := compressedTxSize(tx)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to compress tx: %w", err))
+	}
+	resp.CompressedSize = compressedSize
+
+	if f.da != nil {
+		daGasPrice, err := f.da.GasPrice(ctx)
+		if err != nil {
+			f.logger.Warn().Err(err).Msg("failed to get DA gas price, using 0 for fee estimation")
+		} else if daGasPrice > 0 { // a negative gas price means the DA layer picks the price automatically
+			resp.DaGasPrice = daGasPrice
+		}
+	}
+	resp.DaFee = float64(resp.CompressedSize) * resp.DaGasPrice
+	resp.TotalFee = resp.ExecutionFee + resp.DaFee
+
+	return connect.NewResponse(resp), nil
+}
+
+// compressedTxSize returns the size of tx after flate compression, capped at the
+// raw size since incompressible data would not be posted larger than it is.
+func compressedTxSize(tx []byte) (uint64, error) {
+	var buf bytes.Buffer
+	w, err := flate.NewWriter(&buf, flate.BestCompression)
+	if err != nil {
+		return 0, err
+	}
+	if _, err := w.Write(tx); err != nil {
+		return 0, err
+	}
+	if err := w.Close(); err != nil {
+		return 0, err
+	}
+	return uint64(min(buf.Len(), len(tx))), nil
+}
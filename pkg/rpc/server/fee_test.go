@@ -0,0 +1,94 @@
+package server
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"testing"
+
+	"connectrpc.com/connect"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/evstack/ev-node/test/mocks"
+	pb "github.com/evstack/ev-node/types/pb/evnode/v1"
+)
+
+// gasEstimatingExecutor wraps the executor mock with a fixed gas estimate
+type gasEstimatingExecutor struct {
+	*mocks.MockExecutor
+	gas      uint64
+	gasPrice float64
+	err      error
+}
+
+func (e *gasEstimatingExecutor) EstimateGas(ctx context.Context, tx []byte) (uint64, float64, error) {
+	return e.gas, e.gasPrice, e.err
+}
+
+func TestFeeServer_EstimateTxFee(t *testing.T) {
+	tx := bytes.Repeat([]byte("a"), 1000)
+
+	t.Run("execution and DA components", func(t *testing.T) {
+		exec := &gasEstimatingExecutor{MockExecutor: mocks.NewMockExecutor(t), gas: 21000, gasPrice: 2}
+		da := mocks.NewMockDA(t)
+		da.On("GasPrice", mock.Anything).Return(0.5, nil)
+
+		server := NewFeeServer(StaticExecutor(exec), da, zerolog.Nop())
+		resp, err := server.EstimateTxFee(context.Background(), connect.NewRequest(&pb.EstimateTxFeeRequest{Tx: tx}))
+		require.NoError(t, err)
+
+		msg := resp.Msg
+		require.Equal(t, uint64(21000), msg.ExecutionGas)
+		require.Equal(t, float64(42000), msg.ExecutionFee)
+		require.Equal(t, uint64(len(tx)), msg.TxSize)
+		require.Less(t, msg.CompressedSize, msg.TxSize)
+		require.Equal(t, 0.5, msg.DaGasPrice)
+		require.Equal(t, float64(msg.CompressedSize)*0.5, msg.DaFee)
+		require.Equal(t, msg.ExecutionFee+msg.DaFee, msg.TotalFee)
+	})
+
+	t.Run("executor without gas estimation", func(t *testing.T) {
+		da := mocks.NewMockDA(t)
+		da.On("GasPrice", mock.Anything).Return(1.0, nil)
+
+		server := NewFeeServer(StaticExecutor(mocks.NewMockExecutor(t)), da, zerolog.Nop())
+		resp, err := server.EstimateTxFee(context.Background(), connect.NewRequest(&pb.EstimateTxFeeRequest{Tx: tx}))
+		require.NoError(t, err)
+		require.Zero(t, resp.Msg.ExecutionFee)
+		require.Equal(t, resp.Msg.DaFee, resp.Msg.TotalFee)
+	})
+
+	t.Run("automatic DA gas price", func(t *testing.T) {
+		da := mocks.NewMockDA(t)
+		da.On("GasPrice", mock.Anything).Return(-1.0, nil)
+
+		server := NewFeeServer(StaticExecutor(mocks.NewMockExecutor(t)), da, zerolog.Nop())
+		resp, err := server.EstimateTxFee(context.Background(), connect.NewRequest(&pb.EstimateTxFeeRequest{Tx: tx}))
+		require.NoError(t, err)
+		require.Zero(t, resp.Msg.DaGasPrice)
+		require.Zero(t, resp.Msg.DaFee)
+	})
+
+	t.Run("incompressible tx is capped at raw size", func(t *testing.T) {
+		size, err := compressedTxSize([]byte{0x01})
+		require.NoError(t, err)
+		require.Equal(t, uint64(1), size)
+	})
+
+	t.Run("estimation error", func(t *testing.T) {
+		exec := &gasEstimatingExecutor{MockExecutor: mocks.NewMockExecutor(t), err: errors.New("invalid tx")}
+
+		server := NewFeeServer(StaticExecutor(exec), nil, zerolog.Nop())
+		_, err := server.EstimateTxFee(context.Background(), connect.NewRequest(&pb.EstimateTxFeeRequest{Tx: tx}))
+		require.Error(t, err)
+		require.Equal(t, connect.CodeInvalidArgument, connect.CodeOf(err))
+	})
+
+	t.Run("empty tx", func(t *testing.T) {
+		server := NewFeeServer(StaticExecutor(mocks.NewMockExecutor(t)), nil, zerolog.Nop())
+		_, err := server.EstimateTxFee(context.Background(), connect.NewRequest(&pb.EstimateTxFeeRequest{}))
+		require.Equal(t, connect.CodeInvalidArgument, connect.CodeOf(err))
+	})
+}
@@ -0,0 +1,138 @@
+package server
+
+import (
+	"bytes"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/emptypb"
+
+	pb "github.com/evstack/ev-node/types/pb/evnode/v1"
+	rpc "github.com/evstack/ev-node/types/pb/evnode/v1/v1connect"
+)
+
+// gatewayRoute maps a REST endpoint of the JSON gateway to a unary RPC.
+type gatewayRoute struct {
+	// pattern is the ServeMux pattern of the endpoint
+	pattern string
+	// procedure is the RPC called by the endpoint
+	procedure string
+	// request builds the request of the RPC from the path and query of the endpoint
+	request func(r *http.Request) (proto.Message, error)
+}
+
+func emptyGatewayRequest(*http.Request) (proto.Message, error) {
+	return &emptypb.Empty{}, nil
+}
+
+var gatewayRoutes = []gatewayRoute{
+	{
+		pattern:   "GET /api/v1/blocks/latest",
+		procedure: rpc.StoreServiceGetBlockProcedure,
+		request: func(*http.Request) (proto.Message, error) {
+			return &pb.GetBlockRequest{Identifier: &pb.GetBlockRequest_Height{Height: 0}}, nil
+		},
+	},
+	{
+		pattern:   "GET /api/v1/blocks/{height}",
+		procedure: rpc.StoreServiceGetBlockProcedure,
+		request: func(r *http.Request) (proto.Message, error) {
+			height, err := strconv.ParseUint(r.PathValue("height"), 10, 64)
+			if err != nil || height == 0 {
+				return nil, fmt.Errorf("invalid height %q", r.PathValue("height"))
+			}
+			return &pb.GetBlockRequest{Identifier: &pb.GetBlockRequest_Height{Height: height}}, nil
+		},
+	},
+	{
+		pattern:   "GET /api/v1/blocks/hash/{hash}",
+		procedure: rpc.StoreServiceGetBlockProcedure,
+		request: func(r *http.Request) (proto.Message, error) {
+			hash, err := hex.DecodeString(r.PathValue("hash"))
+			if err != nil || len(hash) == 0 {
+				return nil, fmt.Errorf("invalid hash %q", r.PathValue("hash"))
+			}
+			return &pb.GetBlockRequest{Identifier: &pb.GetBlockRequest_Hash{Hash: hash}}, nil
+		},
+	},
+	{
+		pattern:   "GET /api/v1/state",
+		procedure: rpc.StoreServiceGetStateProcedure,
+		request:   emptyGatewayRequest,
+	},
+	{
+		pattern:   "GET /api/v1/metadata/{key}",
+		procedure: rpc.StoreServiceGetMetadataProcedure,
+		request: func(r *http.Request) (proto.Message, error) {
+			return &pb.GetMetadataRequest{Key: r.PathValue("key")}, nil
+		},
+	},
+	{
+		pattern:   "GET /api/v1/peers",
+		procedure: rpc.P2PServiceGetPeerInfoProcedure,
+		request: func(r *http.Request) (proto.Message, error) {
+			query := r.URL.Query()
+			req := &pb.GetPeerInfoRequest{Page: &pb.PageRequest{PageToken: query.Get("page_token")}}
+			if v := query.Get("limit"); v != "" {
+				limit, err := strconv.ParseUint(v, 10, 32)
+				if err != nil {
+					return nil, fmt.Errorf("invalid limit %q", v)
+				}
+				req.Page.Limit = uint32(limit)
+			}
+			if v := query.Get("direction"); v != "" {
+				direction, ok := pb.PeerDirection_value["PEER_DIRECTION_"+strings.ToUpper(v)]
+				if !ok {
+					return nil, fmt.Errorf("invalid direction %q", v)
+				}
+				req.Direction = pb.PeerDirection(direction)
+			}
+			return req, nil
+		},
+	},
+	{
+		pattern:   "GET /api/v1/net",
+		procedure: rpc.P2PServiceGetNetInfoProcedure,
+		request:   emptyGatewayRequest,
+	},
+}
+
+// RegisterGatewayEndpoints registers the REST endpoints of the JSON gateway under /api/v1. Each
+// endpoint is translated into a Connect request with the JSON codec and served by services, so
+// that the RPC interceptors, e.g. for authentication and metrics, apply to the gateway as well.
+// Responses are the JSON encoding of the RPC responses, and errors the JSON encoding of Connect
+// errors with the matching HTTP status.
+func RegisterGatewayEndpoints(mux *http.ServeMux, services http.Handler) {
+	for _, route := range gatewayRoutes {
+		mux.HandleFunc(route.pattern, func(w http.ResponseWriter, r *http.Request) {
+			msg, err := route.request(r)
+			if err != nil {
+				http.Error(w, err.Error(), http.StatusBadRequest)
+				return
+			}
+			body, err := protojson.Marshal(msg)
+			if err != nil {
+				http.Error(w, err.Error(), http.StatusInternalServerError)
+				return
+			}
+
+			req := r.Clone(r.Context())
+			req.Method = http.MethodPost
+			req.URL.Path = route.procedure
+			req.URL.RawPath = ""
+			req.URL.RawQuery = ""
+			req.RequestURI = ""
+			req.Header.Set("Content-Type", "application/json")
+			req.Header.Del("Content-Encoding")
+			req.Body = io.NopCloser(bytes.NewReader(body))
+			req.ContentLength = int64(len(body))
+			services.ServeHTTP(w, req)
+		})
+	}
+}
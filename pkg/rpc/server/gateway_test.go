@@ -0,0 +1,95 @@
+package server
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/libp2p/go-libp2p/core/peer"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/evstack/ev-node/pkg/config"
+	"github.com/evstack/ev-node/pkg/p2p"
+	"github.com/evstack/ev-node/test/mocks"
+	"github.com/evstack/ev-node/types"
+	rpc "github.com/evstack/ev-node/types/pb/evnode/v1/v1connect"
+)
+
+func TestGatewayEndpoints(t *testing.T) {
+	mockStore := mocks.NewMockStore(t)
+	mockStore.On("GetState", mock.Anything).Return(types.State{ChainID: "test-chain", LastBlockHeight: 10}, nil)
+	mockStore.On("GetMetadata", mock.Anything, "foo").Return([]byte("bar"), nil)
+	mockStore.On("Height", mock.Anything).Return(uint64(0), nil)
+	mockP2P := &mocks.MockP2PRPC{}
+	mockP2P.On("GetNetworkInfo").Return(p2p.NetworkInfo{ID: "nid"}, nil)
+	mockP2P.On("GetPeers").Return([]peer.AddrInfo{{ID: "peer1"}, {ID: "peer2"}}, nil)
+
+	cfg := config.DefaultConfig
+	cfg.RPC.AuthToken = "secret-token"
+	cfg.RPC.AuthServices = rpc.P2PServiceName
+	handler, err := NewServiceHandler(mockStore, mockP2P, zerolog.Nop(), cfg, ServiceOptions{})
+	require.NoError(t, err)
+	server := httptest.NewServer(handler)
+	defer server.Close()
+
+	get := func(path, token string) (int, map[string]any) {
+		req, err := http.NewRequest(http.MethodGet, server.URL+path, nil)
+		require.NoError(t, err)
+		if token != "" {
+			req.Header.Set("Authorization", "Bearer "+token)
+		}
+		resp, err := server.Client().Do(req)
+		require.NoError(t, err)
+		defer resp.Body.Close()
+		body, err := io.ReadAll(resp.Body)
+		require.NoError(t, err)
+		var out map[string]any
+		_ = json.Unmarshal(body, &out)
+		return resp.StatusCode, out
+	}
+
+	status, body := get("/api/v1/state", "")
+	require.Equal(t, http.StatusOK, status)
+	state := body["state"].(map[string]any)
+	assert.Equal(t, "test-chain", state["chainId"])
+	assert.Equal(t, "10", state["lastBlockHeight"])
+
+	status, body = get("/api/v1/metadata/foo", "")
+	require.Equal(t, http.StatusOK, status)
+	assert.Equal(t, "YmFy", body["value"])
+
+	// RPC errors are returned as Connect errors
+	status, body = get("/api/v1/blocks/latest", "")
+	assert.Equal(t, http.StatusNotFound, status)
+	assert.Equal(t, "not_found", body["code"])
+
+	status, _ = get("/api/v1/blocks/ten", "")
+	assert.Equal(t, http.StatusBadRequest, status)
+	status, _ = get("/api/v1/blocks/hash/xyz", "")
+	assert.Equal(t, http.StatusBadRequest, status)
+
+	// authentication applies to the gateway as to the RPCs
+	status, _ = get("/api/v1/net", "")
+	assert.Equal(t, http.StatusUnauthorized, status)
+	status, body = get("/api/v1/net", "secret-token")
+	require.Equal(t, http.StatusOK, status)
+	assert.Equal(t, "nid", body["netInfo"].(map[string]any)["id"])
+
+	// pagination and filtering of peers from the query
+	status, body = get("/api/v1/peers?limit=1", "secret-token")
+	require.Equal(t, http.StatusOK, status)
+	assert.Len(t, body["peers"], 1)
+	page := body["page"].(map[string]any)
+	assert.Equal(t, "2", page["total"])
+	assert.NotEmpty(t, page["nextPageToken"])
+	status, body = get("/api/v1/peers?direction=inbound", "secret-token")
+	require.Equal(t, http.StatusOK, status)
+	assert.Empty(t, body["peers"])
+	status, _ = get("/api/v1/peers?direction=sideways", "secret-token")
+	assert.Equal(t, http.StatusBadRequest, status)
+}
@@ -3,11 +3,20 @@ package server
 import (
 	"fmt"
 	"net/http"
+
+	"github.com/rs/zerolog"
+
+	"github.com/evstack/ev-node/pkg/store"
 )
 
 // RegisterCustomHTTPEndpoints is the designated place to add new, non-gRPC, plain HTTP handlers.
-// Additional custom HTTP endpoints can be registered on the mux here.
-func RegisterCustomHTTPEndpoints(mux *http.ServeMux) {
+// Additional custom HTTP endpoints can be registered on the mux here. The /metrics endpoint is
+// only registered if metrics is not nil.
+func RegisterCustomHTTPEndpoints(mux *http.ServeMux, metrics *Metrics) {
+	if metrics != nil {
+		mux.Handle("/metrics", metrics.Handler())
+	}
+
 	mux.HandleFunc("/health/live", func(w http.ResponseWriter, r *http.Request) {
 		w.Header().Set("Content-Type", "text/plain")
 		w.WriteHeader(http.StatusOK)
@@ -67,3 +76,18 @@ func RegisterCustomHTTPEndpoints(mux *http.ServeMux) {
 	//     fmt.Fprintln(w, "My custom endpoint!")
 	// })
 }
+
+// RegisterReadinessEndpoint registers the /health/ready endpoint, reporting the results of the
+// readiness checks of healthServer. Unlike /health/live, it fails while a critical dependency of
+// the node is unavailable.
+func RegisterReadinessEndpoint(mux *http.ServeMux, healthServer *HealthServer) {
+	mux.HandleFunc("/health/ready", healthServer.handleReady)
+}
+
+// RegisterWebSocketEndpoint registers the /websocket endpoint, pushing JSON events for new blocks,
+// DA inclusions and peer connections read from the store, for clients which cannot consume
+// Connect or gRPC streams. Browsers may connect from the origin of the node, or from the origins
+// allowed by cors if not nil.
+func RegisterWebSocketEndpoint(mux *http.ServeMux, store store.Store, cors *CORSOptions, logger zerolog.Logger) {
+	mux.Handle("/websocket", newEventStream(store, cors, logger.With().Str("endpoint", "websocket").Logger()))
+}
@@ -14,7 +14,7 @@ func TestRegisterCustomHTTPEndpoints(t *testing.T) {
 	mux := http.NewServeMux()
 
 	// Register custom HTTP endpoints
-	RegisterCustomHTTPEndpoints(mux)
+	RegisterCustomHTTPEndpoints(mux, nil)
 
 	// Create a new HTTP test server with the mux
 	testServer := httptest.NewServer(mux)
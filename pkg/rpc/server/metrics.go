@@ -0,0 +1,157 @@
+package server
+
+import (
+	"context"
+	"encoding/binary"
+	"errors"
+	"net/http"
+	"time"
+
+	"connectrpc.com/connect"
+	ds "github.com/ipfs/go-datastore"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+	"github.com/rs/zerolog"
+
+	"github.com/evstack/ev-node/pkg/config"
+	"github.com/evstack/ev-node/pkg/p2p"
+	"github.com/evstack/ev-node/pkg/store"
+)
+
+// metricsCollectTimeout bounds the reads of the node state on a scrape
+const metricsCollectTimeout = 5 * time.Second
+
+// DiskUsageReporter is implemented by stores which can report their size on disk.
+type DiskUsageReporter interface {
+	DiskUsage(ctx context.Context) (uint64, error)
+}
+
+// Metrics is the telemetry served by the /metrics endpoint of the RPC server, in the Prometheus
+// text format. It has its own registry, apart from the metrics of the instrumentation server, so
+// that it is available wherever the RPC server is.
+type Metrics struct {
+	registry *prometheus.Registry
+	latency  *prometheus.HistogramVec
+}
+
+// NewMetrics creates the metrics of the RPC server. The state of the node is read from the store
+// and the peer manager on every scrape; peerManager may be nil.
+func NewMetrics(namespace string, store store.Store, peerManager p2p.P2PRPC, logger zerolog.Logger) *Metrics {
+	m := &Metrics{
+		registry: prometheus.NewRegistry(),
+		latency: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Namespace: namespace,
+			Subsystem: "rpc",
+			Name:      "request_duration_seconds",
+			Help:      "Duration of RPC requests by procedure and status code.",
+			Buckets:   []float64{.001, .005, .01, .025, .05, .1, .25, .5, 1, 2.5, 5, 10},
+		}, []string{"procedure", "code"}),
+	}
+	m.registry.MustRegister(m.latency, newNodeCollector(namespace, store, peerManager, logger))
+	return m
+}
+
+// metricsNamespace returns the namespace of the metrics of the node configured with cfg.
+func metricsNamespace(cfg config.Config) string {
+	if cfg.Instrumentation != nil && cfg.Instrumentation.Namespace != "" {
+		return cfg.Instrumentation.Namespace
+	}
+	return config.DefaultInstrumentationConfig().Namespace
+}
+
+// Handler returns the handler of the /metrics endpoint.
+func (m *Metrics) Handler() http.Handler {
+	return promhttp.HandlerFor(m.registry, promhttp.HandlerOpts{})
+}
+
+// Interceptor returns an interceptor observing the latency of unary RPCs.
+func (m *Metrics) Interceptor() connect.Interceptor {
+	return connect.UnaryInterceptorFunc(func(next connect.UnaryFunc) connect.UnaryFunc {
+		return func(ctx context.Context, req connect.AnyRequest) (connect.AnyResponse, error) {
+			start := time.Now()
+			resp, err := next(ctx, req)
+			code := "ok"
+			if err != nil {
+				code = connect.CodeOf(err).String()
+			}
+			m.latency.WithLabelValues(req.Spec().Procedure, code).Observe(time.Since(start).Seconds())
+			return resp, err
+		}
+	})
+}
+
+// nodeCollector collects the state of the node when the metrics are scraped.
+type nodeCollector struct {
+	store       store.Store
+	peerManager p2p.P2PRPC
+	logger      zerolog.Logger
+
+	height           *prometheus.Desc
+	daIncludedHeight *prometheus.Desc
+	peers            *prometheus.Desc
+	storeSize        *prometheus.Desc
+}
+
+func newNodeCollector(namespace string, store store.Store, peerManager p2p.P2PRPC, logger zerolog.Logger) *nodeCollector {
+	desc := func(name, help string) *prometheus.Desc {
+		return prometheus.NewDesc(prometheus.BuildFQName(namespace, "node", name), help, nil, nil)
+	}
+	return &nodeCollector{
+		store:            store,
+		peerManager:      peerManager,
+		logger:           logger,
+		height:           desc("height", "Height of the last block in the store."),
+		daIncludedHeight: desc("da_included_height", "Height of the last block included on DA."),
+		peers:            desc("peers", "Number of connected peers."),
+		storeSize:        desc("store_size_bytes", "Size of the store on disk."),
+	}
+}
+
+func (c *nodeCollector) Describe(ch chan<- *prometheus.Desc) {
+	ch <- c.height
+	ch <- c.daIncludedHeight
+	ch <- c.peers
+	ch <- c.storeSize
+}
+
+// Collect reports the metrics which can be read, logging the failures.
+func (c *nodeCollector) Collect(ch chan<- prometheus.Metric) {
+	ctx, cancel := context.WithTimeout(context.Background(), metricsCollectTimeout)
+	defer cancel()
+
+	gauge := func(desc *prometheus.Desc, value uint64) {
+		ch <- prometheus.MustNewConstMetric(desc, prometheus.GaugeValue, float64(value))
+	}
+
+	if height, err := c.store.Height(ctx); err != nil {
+		c.logger.Error().Err(err).Msg("failed to get height for metrics")
+	} else {
+		gauge(c.height, height)
+	}
+
+	daIncludedHeight, err := c.store.GetMetadata(ctx, store.DAIncludedHeightKey)
+	switch {
+	case errors.Is(err, ds.ErrNotFound):
+		gauge(c.daIncludedHeight, 0)
+	case err != nil:
+		c.logger.Error().Err(err).Msg("failed to get DA included height for metrics")
+	case len(daIncludedHeight) == 8:
+		gauge(c.daIncludedHeight, binary.LittleEndian.Uint64(daIncludedHeight))
+	}
+
+	if c.peerManager != nil {
+		if netInfo, err := c.peerManager.GetNetworkInfo(); err != nil {
+			c.logger.Error().Err(err).Msg("failed to get network info for metrics")
+		} else {
+			gauge(c.peers, uint64(len(netInfo.ConnectedPeers)))
+		}
+	}
+
+	if reporter, ok := c.store.(DiskUsageReporter); ok {
+		if size, err := reporter.DiskUsage(ctx); err != nil {
+			c.logger.Error().Err(err).Msg("failed to get store size for metrics")
+		} else {
+			gauge(c.storeSize, size)
+		}
+	}
+}
@@ -0,0 +1,65 @@
+package server
+
+import (
+	"context"
+	"encoding/binary"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"connectrpc.com/connect"
+	"github.com/libp2p/go-libp2p/core/peer"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/emptypb"
+
+	"github.com/evstack/ev-node/pkg/config"
+	"github.com/evstack/ev-node/pkg/p2p"
+	"github.com/evstack/ev-node/pkg/store"
+	"github.com/evstack/ev-node/test/mocks"
+	pb "github.com/evstack/ev-node/types/pb/evnode/v1"
+	rpc "github.com/evstack/ev-node/types/pb/evnode/v1/v1connect"
+)
+
+func TestMetricsEndpoint(t *testing.T) {
+	ctx := context.Background()
+	kv, err := store.NewDefaultInMemoryKVStore()
+	require.NoError(t, err)
+	s := store.New(kv)
+	require.NoError(t, s.SetHeight(ctx, 12))
+	daIncludedHeight := make([]byte, 8)
+	binary.LittleEndian.PutUint64(daIncludedHeight, 9)
+	require.NoError(t, s.SetMetadata(ctx, store.DAIncludedHeightKey, daIncludedHeight))
+
+	mockP2P := &mocks.MockP2PRPC{}
+	mockP2P.On("GetNetworkInfo").Return(p2p.NetworkInfo{ConnectedPeers: []peer.ID{"peer1", "peer2"}}, nil)
+
+	handler, err := NewServiceHandler(s, mockP2P, zerolog.Nop(), config.DefaultConfig, ServiceOptions{})
+	require.NoError(t, err)
+	server := httptest.NewServer(handler)
+	defer server.Close()
+
+	// observed in the latency histogram
+	storeClient := rpc.NewStoreServiceClient(server.Client(), server.URL)
+	_, err = storeClient.GetMetadata(ctx, connect.NewRequest(&pb.GetMetadataRequest{Key: store.DAIncludedHeightKey}))
+	require.NoError(t, err)
+	_, err = storeClient.GetState(ctx, connect.NewRequest(&emptypb.Empty{}))
+	require.Error(t, err)
+
+	resp, err := http.Get(server.URL + "/metrics")
+	require.NoError(t, err)
+	defer resp.Body.Close()
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	body, err := io.ReadAll(resp.Body)
+	require.NoError(t, err)
+
+	metrics := string(body)
+	assert.Contains(t, metrics, "evnode_node_height 12\n")
+	assert.Contains(t, metrics, "evnode_node_da_included_height 9\n")
+	assert.Contains(t, metrics, "evnode_node_peers 2\n")
+	assert.Contains(t, metrics, "evnode_node_store_size_bytes ")
+	assert.Contains(t, metrics, `evnode_rpc_request_duration_seconds_count{code="ok",procedure="/evnode.v1.StoreService/GetMetadata"} 1`)
+	assert.Contains(t, metrics, `evnode_rpc_request_duration_seconds_count{code="not_found",procedure="/evnode.v1.StoreService/GetState"} 1`)
+}
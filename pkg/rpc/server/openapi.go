@@ -0,0 +1,265 @@
+package server
+
+import (
+	_ "embed"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"regexp"
+	"strings"
+
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
+	"google.golang.org/protobuf/types/descriptorpb"
+)
+
+//go:embed templates/swagger_ui.html
+var swaggerUIHTML []byte
+
+// openAPIObject is an object of an OpenAPI document.
+type openAPIObject = map[string]any
+
+// pathParamRe matches the parameters of a ServeMux pattern, e.g. {height}.
+var pathParamRe = regexp.MustCompile(`\{([a-zA-Z_]+)\}`)
+
+// openAPIGenerator builds an OpenAPI document from the descriptors of the services.
+type openAPIGenerator struct {
+	auth    *AuthOptions
+	schemas openAPIObject
+}
+
+// NewOpenAPISpec returns the OpenAPI 3 document of the unary RPCs of services, i.e. their fully
+// qualified names, called with the Connect protocol and its JSON codec, and of the REST endpoints
+// of the JSON gateway. The schemas are generated from the protobuf descriptors, so the document
+// is always up to date. auth are the authentication options of the server, nil if disabled.
+func NewOpenAPISpec(services []string, auth *AuthOptions) ([]byte, error) {
+	g := &openAPIGenerator{auth: auth, schemas: openAPIObject{}}
+	g.schemas["connect.Error"] = openAPIObject{
+		"type": "object",
+		"properties": openAPIObject{
+			"code":    openAPIObject{"type": "string", "example": "not_found"},
+			"message": openAPIObject{"type": "string"},
+			"details": openAPIObject{"type": "array", "items": openAPIObject{"type": "object"}},
+		},
+	}
+
+	paths := openAPIObject{}
+	var tags []openAPIObject
+	for _, service := range services {
+		sd, err := findServiceDescriptor(service)
+		if err != nil {
+			return nil, err
+		}
+		tags = append(tags, openAPIObject{"name": service})
+		methods := sd.Methods()
+		for i := range methods.Len() {
+			md := methods.Get(i)
+			if md.IsStreamingClient() || md.IsStreamingServer() {
+				continue
+			}
+			op := g.operation(service, md)
+			op["requestBody"] = openAPIObject{
+				"required": true,
+				"content":  openAPIObject{"application/json": openAPIObject{"schema": g.messageSchema(md.Input())}},
+			}
+			paths["/"+service+"/"+string(md.Name())] = openAPIObject{"post": op}
+		}
+	}
+
+	tags = append(tags, openAPIObject{"name": "REST gateway"})
+	for _, route := range gatewayRoutes {
+		method, path, _ := strings.Cut(route.pattern, " ")
+		service, name, _ := strings.Cut(strings.TrimPrefix(route.procedure, "/"), "/")
+		sd, err := findServiceDescriptor(service)
+		if err != nil {
+			return nil, err
+		}
+		md := sd.Methods().ByName(protoreflect.Name(name))
+		if md == nil {
+			return nil, fmt.Errorf("procedure %s of the gateway not found", route.procedure)
+		}
+		op := g.operation("REST gateway", md)
+		op["operationId"] = strings.ToLower(method) + strings.NewReplacer("/", "_", "{", "", "}", "").Replace(path)
+		var params []openAPIObject
+		for _, match := range pathParamRe.FindAllStringSubmatch(path, -1) {
+			params = append(params, openAPIObject{
+				"name":     match[1],
+				"in":       "path",
+				"required": true,
+				"schema":   openAPIObject{"type": "string"},
+			})
+		}
+		if len(params) > 0 {
+			op["parameters"] = params
+		}
+		item, ok := paths[path].(openAPIObject)
+		if !ok {
+			item = openAPIObject{}
+			paths[path] = item
+		}
+		item[strings.ToLower(method)] = op
+	}
+
+	components := openAPIObject{"schemas": g.schemas}
+	if auth != nil {
+		components["securitySchemes"] = openAPIObject{
+			"bearerAuth": openAPIObject{"type": "http", "scheme": "bearer"},
+		}
+	}
+	return json.Marshal(openAPIObject{
+		"openapi": "3.0.3",
+		"info": openAPIObject{
+			"title":       "Evolve Node API",
+			"version":     "v1",
+			"description": "RPCs of the node, called with the Connect protocol and its JSON codec, and the REST endpoints of the JSON gateway. Errors are Connect errors.",
+		},
+		"tags":       tags,
+		"paths":      paths,
+		"components": components,
+	})
+}
+
+// findServiceDescriptor returns the descriptor of the service with the fully qualified name.
+func findServiceDescriptor(service string) (protoreflect.ServiceDescriptor, error) {
+	desc, err := protoregistry.GlobalFiles.FindDescriptorByName(protoreflect.FullName(service))
+	if err != nil {
+		return nil, fmt.Errorf("service %s not found: %w", service, err)
+	}
+	sd, ok := desc.(protoreflect.ServiceDescriptor)
+	if !ok {
+		return nil, fmt.Errorf("%s is not a service", service)
+	}
+	return sd, nil
+}
+
+// operation returns the OpenAPI operation of the RPC of md, without its request.
+func (g *openAPIGenerator) operation(tag string, md protoreflect.MethodDescriptor) openAPIObject {
+	service := string(md.Parent().FullName())
+	op := openAPIObject{
+		"tags":        []string{tag},
+		"operationId": strings.ReplaceAll(service, ".", "_") + "_" + string(md.Name()),
+		"summary":     string(md.Name()),
+		"responses": openAPIObject{
+			"200": openAPIObject{
+				"description": "Success",
+				"content":     openAPIObject{"application/json": openAPIObject{"schema": g.messageSchema(md.Output())}},
+			},
+			"default": openAPIObject{
+				"description": "Error",
+				"content":     openAPIObject{"application/json": openAPIObject{"schema": openAPIObject{"$ref": "#/components/schemas/connect.Error"}}},
+			},
+		},
+	}
+	if g.protected(service, md) {
+		op["security"] = []openAPIObject{{"bearerAuth": []string{}}}
+	}
+	return op
+}
+
+// protected returns whether the RPC of md requires a bearer token, as decided by the
+// authentication interceptor.
+func (g *openAPIGenerator) protected(service string, md protoreflect.MethodDescriptor) bool {
+	if g.auth == nil {
+		return false
+	}
+	opts, _ := md.Options().(*descriptorpb.MethodOptions)
+	if opts.GetIdempotencyLevel() != descriptorpb.MethodOptions_NO_SIDE_EFFECTS {
+		return true
+	}
+	for _, s := range g.auth.Services {
+		if s == service {
+			return true
+		}
+	}
+	return false
+}
+
+// messageSchema returns the schema of a message in its JSON encoding, adding it to the
+// components of the document.
+func (g *openAPIGenerator) messageSchema(md protoreflect.MessageDescriptor) openAPIObject {
+	switch md.FullName() {
+	case "google.protobuf.Timestamp":
+		return openAPIObject{"type": "string", "format": "date-time"}
+	case "google.protobuf.Duration":
+		return openAPIObject{"type": "string", "example": "1.5s"}
+	case "google.protobuf.Empty":
+		return openAPIObject{"type": "object"}
+	}
+	if md.ParentFile().Package() == "google.protobuf" {
+		return openAPIObject{}
+	}
+
+	name := string(md.FullName())
+	ref := openAPIObject{"$ref": "#/components/schemas/" + name}
+	if _, ok := g.schemas[name]; ok {
+		return ref
+	}
+	properties := openAPIObject{}
+	schema := openAPIObject{"type": "object", "properties": properties}
+	// registered before its fields, for recursive messages
+	g.schemas[name] = schema
+	fields := md.Fields()
+	for i := range fields.Len() {
+		fd := fields.Get(i)
+		properties[fd.JSONName()] = g.fieldSchema(fd)
+	}
+	return ref
+}
+
+// fieldSchema returns the schema of a field in its JSON encoding.
+func (g *openAPIGenerator) fieldSchema(fd protoreflect.FieldDescriptor) openAPIObject {
+	if fd.IsMap() {
+		return openAPIObject{"type": "object", "additionalProperties": g.valueSchema(fd.MapValue())}
+	}
+	if fd.IsList() {
+		return openAPIObject{"type": "array", "items": g.valueSchema(fd)}
+	}
+	return g.valueSchema(fd)
+}
+
+// valueSchema returns the schema of a single value of a field in its JSON encoding.
+func (g *openAPIGenerator) valueSchema(fd protoreflect.FieldDescriptor) openAPIObject {
+	switch fd.Kind() {
+	case protoreflect.BoolKind:
+		return openAPIObject{"type": "boolean"}
+	case protoreflect.Int32Kind, protoreflect.Sint32Kind, protoreflect.Sfixed32Kind:
+		return openAPIObject{"type": "integer", "format": "int32"}
+	case protoreflect.Uint32Kind, protoreflect.Fixed32Kind:
+		return openAPIObject{"type": "integer", "format": "uint32"}
+	case protoreflect.Int64Kind, protoreflect.Sint64Kind, protoreflect.Sfixed64Kind:
+		// 64-bit integers are encoded as strings
+		return openAPIObject{"type": "string", "format": "int64"}
+	case protoreflect.Uint64Kind, protoreflect.Fixed64Kind:
+		return openAPIObject{"type": "string", "format": "uint64"}
+	case protoreflect.FloatKind:
+		return openAPIObject{"type": "number", "format": "float"}
+	case protoreflect.DoubleKind:
+		return openAPIObject{"type": "number", "format": "double"}
+	case protoreflect.StringKind:
+		return openAPIObject{"type": "string"}
+	case protoreflect.BytesKind:
+		return openAPIObject{"type": "string", "format": "byte"}
+	case protoreflect.EnumKind:
+		values := fd.Enum().Values()
+		names := make([]string, values.Len())
+		for i := range names {
+			names[i] = string(values.Get(i).Name())
+		}
+		return openAPIObject{"type": "string", "enum": names}
+	default:
+		return g.messageSchema(fd.Message())
+	}
+}
+
+// RegisterOpenAPIEndpoints registers the /api/v1/docs endpoint, serving a Swagger UI, and the
+// /api/v1/docs/openapi.json endpoint, serving the OpenAPI document spec it displays.
+func RegisterOpenAPIEndpoints(mux *http.ServeMux, spec []byte) {
+	mux.HandleFunc("GET /api/v1/docs", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "text/html; charset=utf-8")
+		_, _ = w.Write(swaggerUIHTML)
+	})
+	mux.HandleFunc("GET /api/v1/docs/openapi.json", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write(spec)
+	})
+}
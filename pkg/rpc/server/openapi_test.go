@@ -0,0 +1,72 @@
+package server
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/evstack/ev-node/pkg/config"
+	"github.com/evstack/ev-node/test/mocks"
+	rpc "github.com/evstack/ev-node/types/pb/evnode/v1/v1connect"
+)
+
+func TestOpenAPIEndpoints(t *testing.T) {
+	cfg := config.DefaultConfig
+	cfg.RPC.AuthToken = "secret-token"
+	cfg.RPC.AuthServices = rpc.P2PServiceName
+	handler, err := NewServiceHandler(mocks.NewMockStore(t), &mocks.MockP2PRPC{}, zerolog.Nop(), cfg, ServiceOptions{Admin: &testNodeAdmin{}})
+	require.NoError(t, err)
+	server := httptest.NewServer(handler)
+	defer server.Close()
+
+	resp, err := http.Get(server.URL + "/api/v1/docs")
+	require.NoError(t, err)
+	body, err := io.ReadAll(resp.Body)
+	resp.Body.Close()
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+	assert.Contains(t, string(body), "/api/v1/docs/openapi.json")
+
+	resp, err = http.Get(server.URL + "/api/v1/docs/openapi.json")
+	require.NoError(t, err)
+	defer resp.Body.Close()
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	var spec struct {
+		OpenAPI    string                               `json:"openapi"`
+		Paths      map[string]map[string]map[string]any `json:"paths"`
+		Components struct {
+			Schemas map[string]struct {
+				Properties map[string]map[string]any `json:"properties"`
+			} `json:"schemas"`
+		} `json:"components"`
+	}
+	require.NoError(t, json.NewDecoder(resp.Body).Decode(&spec))
+	assert.Equal(t, "3.0.3", spec.OpenAPI)
+
+	// RPCs, with the bearer token required by the protected ones
+	getBlock := spec.Paths["/evnode.v1.StoreService/GetBlock"]["post"]
+	require.NotNil(t, getBlock)
+	assert.NotContains(t, getBlock, "security")
+	assert.Contains(t, spec.Paths["/evnode.v1.P2PService/GetNetInfo"]["post"], "security")
+	assert.Contains(t, spec.Paths["/evnode.v1.AdminService/Shutdown"]["post"], "security")
+	// the fee service is not served without an executor
+	assert.NotContains(t, spec.Paths, "/evnode.v1.FeeService/EstimateTxFee")
+
+	// REST endpoints of the gateway
+	getBlockByHeight := spec.Paths["/api/v1/blocks/{height}"]["get"]
+	require.NotNil(t, getBlockByHeight)
+	assert.Len(t, getBlockByHeight["parameters"], 1)
+	assert.Contains(t, spec.Paths["/api/v1/net"]["get"], "security")
+
+	// schemas of the JSON encoding of the messages
+	blockResponse := spec.Components.Schemas["evnode.v1.GetBlockResponse"].Properties
+	assert.Equal(t, map[string]any{"type": "string", "format": "uint64"}, blockResponse["headerDaHeight"])
+	assert.Equal(t, map[string]any{"$ref": "#/components/schemas/evnode.v1.Block"}, blockResponse["block"])
+	assert.Contains(t, spec.Components.Schemas, "evnode.v1.SignedHeader")
+}
@@ -0,0 +1,60 @@
+package server
+
+import (
+	"cmp"
+	"fmt"
+	"strconv"
+
+	"connectrpc.com/connect"
+
+	pb "github.com/evstack/ev-node/types/pb/evnode/v1"
+)
+
+// pageLimit returns the number of items of the requested page, defaultLimit if the request sets no
+// limit.
+func pageLimit(page *pb.PageRequest, defaultLimit, maxLimit int) (int, error) {
+	limit := int(page.GetLimit())
+	if limit == 0 {
+		limit = defaultLimit
+	}
+	if limit > maxLimit {
+		return 0, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("limit must be at most %d", maxLimit))
+	}
+	return limit, nil
+}
+
+// paginate returns the page of at most limit items whose key is above after, and the response
+// describing it. The items must be sorted by ascending key, and the zero key must be below the
+// key of every item, so that a zero after selects the first page. The token of the next page is
+// the key of the last item of the page, encoded by token.
+func paginate[T any, K cmp.Ordered](items []T, key func(T) K, after K, limit int, token func(K) string) ([]T, *pb.PageResponse) {
+	total := uint64(len(items))
+	resp := &pb.PageResponse{Total: &total}
+	start := 0
+	for start < len(items) && key(items[start]) <= after {
+		start++
+	}
+	items = items[start:]
+	if len(items) > limit {
+		items = items[:limit]
+		resp.NextPageToken = token(key(items[limit-1]))
+	}
+	return items, resp
+}
+
+// heightToken is the page token of the pages continuing after a height, or a sequence number.
+func heightToken(height uint64) string {
+	return strconv.FormatUint(height, 10)
+}
+
+// parseHeightToken parses a page token created by heightToken, 0 for the first page.
+func parseHeightToken(token string) (uint64, error) {
+	if token == "" {
+		return 0, nil
+	}
+	height, err := strconv.ParseUint(token, 10, 64)
+	if err != nil {
+		return 0, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("invalid page token %q", token))
+	}
+	return height, nil
+}
@@ -0,0 +1,138 @@
+package server
+
+import (
+	"context"
+	"strconv"
+	"testing"
+
+	"connectrpc.com/connect"
+	ds "github.com/ipfs/go-datastore"
+	"github.com/libp2p/go-libp2p/core/peer"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/evstack/ev-node/pkg/journal"
+	"github.com/evstack/ev-node/pkg/store"
+	"github.com/evstack/ev-node/test/mocks"
+	"github.com/evstack/ev-node/types"
+	pb "github.com/evstack/ev-node/types/pb/evnode/v1"
+)
+
+func TestPaginate(t *testing.T) {
+	items := []uint64{1, 2, 3, 5, 8}
+	identity := func(k uint64) uint64 { return k }
+
+	page, resp := paginate(items, identity, 0, 2, heightToken)
+	require.Equal(t, []uint64{1, 2}, page)
+	require.Equal(t, "2", resp.NextPageToken)
+	require.Equal(t, uint64(5), resp.GetTotal())
+
+	page, resp = paginate(items, identity, 3, 2, heightToken)
+	require.Equal(t, []uint64{5, 8}, page)
+	require.Empty(t, resp.NextPageToken)
+
+	page, resp = paginate(items, identity, 8, 2, heightToken)
+	require.Empty(t, page)
+	require.Empty(t, resp.NextPageToken)
+
+	_, err := parseHeightToken("not-a-height")
+	require.Equal(t, connect.CodeInvalidArgument, connect.CodeOf(err))
+	_, err = pageLimit(&pb.PageRequest{Limit: 11}, 5, 10)
+	require.Equal(t, connect.CodeInvalidArgument, connect.CodeOf(err))
+	limit, err := pageLimit(nil, 5, 10)
+	require.NoError(t, err)
+	require.Equal(t, 5, limit)
+}
+
+func TestGetHeaderRange_Pages(t *testing.T) {
+	mockStore := mocks.NewMockStore(t)
+	server := NewStoreServer(mockStore, zerolog.Nop())
+
+	const latest = maxHeaderRange + 10
+	mockStore.On("Height", mock.Anything).Return(uint64(latest), nil)
+	mockStore.On("GetMetadata", mock.Anything, store.DAIncludedHeightKey).Return(nil, ds.ErrNotFound)
+	mockStore.On("GetHeader", mock.Anything, mock.Anything).Return(func(_ context.Context, height uint64) (*types.SignedHeader, error) {
+		return &types.SignedHeader{Header: types.Header{BaseHeader: types.BaseHeader{Height: height}}}, nil
+	})
+
+	// a range above the maximum is allowed when paged
+	var heights []uint64
+	page := &pb.PageRequest{Limit: 400}
+	for {
+		resp, err := server.GetHeaderRange(context.Background(), connect.NewRequest(&pb.GetHeaderRangeRequest{FromHeight: 3, ToHeight: latest + 5, Page: page}))
+		require.NoError(t, err)
+		require.Equal(t, uint64(latest-2), resp.Msg.Page.GetTotal())
+		require.LessOrEqual(t, len(resp.Msg.Headers), 400)
+		for _, header := range resp.Msg.Headers {
+			heights = append(heights, header.Header.Height)
+		}
+		if resp.Msg.Page.NextPageToken == "" {
+			break
+		}
+		page.PageToken = resp.Msg.Page.NextPageToken
+	}
+	require.Len(t, heights, latest-2)
+	for i, height := range heights {
+		require.Equal(t, uint64(i+3), height)
+	}
+
+	_, err := server.GetHeaderRange(context.Background(), connect.NewRequest(&pb.GetHeaderRangeRequest{FromHeight: 1, ToHeight: 2, Page: &pb.PageRequest{Limit: maxHeaderRange + 1}}))
+	require.Equal(t, connect.CodeInvalidArgument, connect.CodeOf(err))
+}
+
+func TestGetEvents_Pages(t *testing.T) {
+	ctx := context.Background()
+	kv, err := store.NewDefaultInMemoryKVStore()
+	require.NoError(t, err)
+	s := store.New(kv)
+	j := journal.New(s)
+	for i := range 5 {
+		require.NoError(t, j.Record(ctx, journal.EventNodeStarted, "node started "+strconv.Itoa(i), nil))
+	}
+	server := NewStoreServer(s, zerolog.Nop())
+
+	var sequences []uint64
+	page := &pb.PageRequest{Limit: 2}
+	for {
+		resp, err := server.GetEvents(ctx, connect.NewRequest(&pb.GetEventsRequest{Page: page}))
+		require.NoError(t, err)
+		require.Equal(t, uint64(5), resp.Msg.Page.GetTotal())
+		for _, event := range resp.Msg.Events {
+			sequences = append(sequences, event.Sequence)
+		}
+		if resp.Msg.Page.NextPageToken == "" {
+			break
+		}
+		page.PageToken = resp.Msg.Page.NextPageToken
+	}
+	require.Equal(t, []uint64{1, 2, 3, 4, 5}, sequences)
+
+	// without a page, all the events are returned
+	resp, err := server.GetEvents(ctx, connect.NewRequest(&pb.GetEventsRequest{}))
+	require.NoError(t, err)
+	require.Len(t, resp.Msg.Events, 5)
+	require.Nil(t, resp.Msg.Page)
+}
+
+func TestGetPeerInfo_Page(t *testing.T) {
+	var peers []peer.AddrInfo
+	for _, id := range []string{"peer3", "peer1", "peer2"} {
+		peers = append(peers, peer.AddrInfo{ID: peer.ID(id)})
+	}
+	mockP2P := &mocks.MockP2PRPC{}
+	mockP2P.On("GetPeers").Return(peers, nil)
+	server := NewP2PServer(mockP2P)
+
+	resp, err := server.GetPeerInfo(context.Background(), connect.NewRequest(&pb.GetPeerInfoRequest{Page: &pb.PageRequest{Limit: 2}}))
+	require.NoError(t, err)
+	require.Len(t, resp.Msg.Peers, 2)
+	require.Equal(t, uint64(3), resp.Msg.Page.GetTotal())
+	require.Equal(t, resp.Msg.Peers[1].Id, resp.Msg.Page.NextPageToken)
+
+	resp, err = server.GetPeerInfo(context.Background(), connect.NewRequest(&pb.GetPeerInfoRequest{Page: &pb.PageRequest{Limit: 2, PageToken: resp.Msg.Page.NextPageToken}}))
+	require.NoError(t, err)
+	require.Len(t, resp.Msg.Peers, 1)
+	require.Equal(t, peer.ID("peer3").String(), resp.Msg.Peers[0].Id)
+	require.Empty(t, resp.Msg.Page.NextPageToken)
+}
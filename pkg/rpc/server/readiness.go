@@ -0,0 +1,161 @@
+package server
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sync"
+	"time"
+
+	"connectrpc.com/connect"
+	"google.golang.org/protobuf/types/known/emptypb"
+
+	coreda "github.com/evstack/ev-node/core/da"
+	"github.com/evstack/ev-node/pkg/store"
+	pb "github.com/evstack/ev-node/types/pb/evnode/v1"
+)
+
+// readinessCheckTimeout bounds the duration of a single readiness check
+const readinessCheckTimeout = 3 * time.Second
+
+// ReadinessCheck checks that a dependency of the node is available.
+type ReadinessCheck struct {
+	// Name identifies the check in the readiness response.
+	Name string
+	// Critical checks make the node not ready when they fail. Other failed checks only
+	// report the node as degraded.
+	Critical bool
+	// Check returns an error if the dependency is unavailable.
+	Check func(ctx context.Context) error
+}
+
+// StoreReadinessCheck checks that the store can be read.
+func StoreReadinessCheck(s store.Store) ReadinessCheck {
+	return ReadinessCheck{
+		Name:     "store",
+		Critical: true,
+		Check: func(ctx context.Context) error {
+			_, err := s.Height(ctx)
+			return err
+		},
+	}
+}
+
+// DAReadinessCheck checks that the DA layer is reachable. A node which cannot reach the DA layer
+// still serves the blocks it has, so the check is not critical.
+func DAReadinessCheck(da coreda.DA) ReadinessCheck {
+	return ReadinessCheck{
+		Name: "da",
+		Check: func(ctx context.Context) error {
+			if _, err := da.GasPrice(ctx); err != nil {
+				return fmt.Errorf("DA layer unreachable: %w", err)
+			}
+			return nil
+		},
+	}
+}
+
+// Readyz implements the HealthService.Readyz RPC
+func (h *HealthServer) Readyz(
+	ctx context.Context,
+	req *connect.Request[emptypb.Empty],
+) (*connect.Response[pb.ReadyzResponse], error) {
+	return connect.NewResponse(h.readiness(ctx)), nil
+}
+
+// readiness runs the readiness checks concurrently and returns their results.
+func (h *HealthServer) readiness(ctx context.Context) *pb.ReadyzResponse {
+	resp := &pb.ReadyzResponse{
+		Status: pb.HealthStatus_PASS,
+		Checks: make([]*pb.ReadinessCheck, len(h.checks)),
+	}
+
+	var wg sync.WaitGroup
+	for i, check := range h.checks {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			checkCtx, cancel := context.WithTimeout(ctx, readinessCheckTimeout)
+			defer cancel()
+
+			result := &pb.ReadinessCheck{Name: check.Name, Status: pb.HealthStatus_PASS}
+			if err := check.Check(checkCtx); err != nil {
+				result.Status = pb.HealthStatus_WARN
+				if check.Critical {
+					result.Status = pb.HealthStatus_FAIL
+				}
+				result.Message = err.Error()
+			}
+			resp.Checks[i] = result
+		}()
+	}
+	wg.Wait()
+
+	// a draining node is not ready, so that load balancers stop routing requests to it
+	if draining := Draining(ctx); draining != nil {
+		select {
+		case <-draining:
+			resp.Checks = append(resp.Checks, &pb.ReadinessCheck{
+				Name:    "drain",
+				Status:  pb.HealthStatus_FAIL,
+				Message: "node is draining its RPC server",
+			})
+		default:
+		}
+	}
+
+	for _, check := range resp.Checks {
+		resp.Status = max(resp.Status, check.Status)
+	}
+	// the errors explain a failed check but do not change the status, as most are transient
+	resp.Errors = h.subsystemErrors()
+	return resp
+}
+
+// readinessJSON is the body of the /health/ready endpoint.
+type readinessJSON struct {
+	Status string               `json:"status"`
+	Checks []readinessCheckJSON `json:"checks"`
+	Errors []subsystemErrorJSON `json:"errors,omitempty"`
+}
+
+type readinessCheckJSON struct {
+	Name    string `json:"name"`
+	Status  string `json:"status"`
+	Message string `json:"message,omitempty"`
+}
+
+type subsystemErrorJSON struct {
+	Subsystem string    `json:"subsystem"`
+	Message   string    `json:"message"`
+	Count     uint64    `json:"count"`
+	FirstSeen time.Time `json:"first_seen"`
+	LastSeen  time.Time `json:"last_seen"`
+}
+
+// handleReady serves the /health/ready endpoint for readiness probes, e.g. of Kubernetes.
+// It responds with 200 OK if the node is ready, even if degraded, and 503 Service Unavailable
+// otherwise, with the results of the checks in a JSON body.
+func (h *HealthServer) handleReady(w http.ResponseWriter, r *http.Request) {
+	resp := h.readiness(r.Context())
+	body := readinessJSON{Status: resp.Status.String(), Checks: make([]readinessCheckJSON, len(resp.Checks))}
+	for i, check := range resp.Checks {
+		body.Checks[i] = readinessCheckJSON{Name: check.Name, Status: check.Status.String(), Message: check.Message}
+	}
+	for _, e := range resp.Errors {
+		body.Errors = append(body.Errors, subsystemErrorJSON{
+			Subsystem: e.Subsystem,
+			Message:   e.Message,
+			Count:     e.Count,
+			FirstSeen: e.FirstSeen.AsTime(),
+			LastSeen:  e.LastSeen.AsTime(),
+		})
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	if resp.Status == pb.HealthStatus_FAIL {
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}
+	_ = json.NewEncoder(w).Encode(body)
+}
@@ -0,0 +1,253 @@
+package server
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/rs/zerolog"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
+	"google.golang.org/protobuf/types/descriptorpb"
+)
+
+const (
+	// LagHeader is the HTTP header reporting how many blocks a replica is behind the network head.
+	LagHeader = "X-Rollkit-Lag-Blocks"
+
+	// maxCachedRequestBytes is the maximum size of a request body for its response to be cached
+	maxCachedRequestBytes = 1 << 20
+	// maxReplicaCacheEntries bounds the number of responses held by the replica cache
+	maxReplicaCacheEntries = 10_000
+)
+
+// ReplicaOptions configures the RPC handler of a read replica.
+type ReplicaOptions struct {
+	// CacheTTL is the time successful responses are served from the cache. 0 disables caching.
+	CacheTTL time.Duration
+	// MaxLagBlocks is the lag above which requests are rejected with 503 Service Unavailable,
+	// so that load balancers route traffic to replicas that are in sync. 0 disables the check.
+	MaxLagBlocks uint64
+	// Lag returns the number of blocks the node is behind the network head.
+	Lag func(ctx context.Context) (uint64, error)
+}
+
+// cachedResponse is a response held by the replica cache.
+type cachedResponse struct {
+	status  int
+	header  http.Header
+	body    []byte
+	expires time.Time
+}
+
+// replicaHandler serves RPC traffic of a read replica.
+type replicaHandler struct {
+	next   http.Handler
+	opts   ReplicaOptions
+	logger zerolog.Logger
+
+	mu    sync.Mutex
+	cache map[[sha256.Size]byte]*cachedResponse
+}
+
+// NewReplicaHandler wraps an RPC handler, typically created by NewServiceHandler, for a read replica
+// serving public RPC traffic. Every response reports the replica lag in the LagHeader header,
+// requests are rejected while the lag exceeds opts.MaxLagBlocks, and successful responses are
+// cached for opts.CacheTTL. Only unauthenticated calls of the unary RPCs without side effects, with
+// the Connect protocol, and GET requests of the JSON gateway are cached; gRPC, streams, health
+// checks and websocket connections are passed through.
+func NewReplicaHandler(next http.Handler, opts ReplicaOptions, logger zerolog.Logger) http.Handler {
+	return newH2CHandler(&replicaHandler{
+		next:   withoutH2C(next),
+		opts:   opts,
+		logger: logger,
+		cache:  make(map[[sha256.Size]byte]*cachedResponse),
+	})
+}
+
+func (h *replicaHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	lag, err := h.opts.Lag(r.Context())
+	if err != nil {
+		h.logger.Error().Err(err).Msg("failed to get replica lag")
+	} else {
+		w.Header().Set(LagHeader, strconv.FormatUint(lag, 10))
+		if h.opts.MaxLagBlocks > 0 && lag > h.opts.MaxLagBlocks && r.URL.Path != "/health/live" {
+			http.Error(w, fmt.Sprintf("replica is %d blocks behind", lag), http.StatusServiceUnavailable)
+			return
+		}
+	}
+
+	if h.opts.CacheTTL <= 0 || !cacheable(r) {
+		h.next.ServeHTTP(w, r)
+		return
+	}
+
+	original := r.Body
+	body, err := io.ReadAll(io.LimitReader(original, maxCachedRequestBytes+1))
+	if err != nil {
+		http.Error(w, "failed to read request body", http.StatusBadRequest)
+		return
+	}
+	if len(body) > maxCachedRequestBytes {
+		r.Body = io.NopCloser(io.MultiReader(bytes.NewReader(body), original))
+		h.next.ServeHTTP(w, r)
+		return
+	}
+	r.Body = io.NopCloser(bytes.NewReader(body))
+
+	key := cacheKey(r, body)
+	if cached := h.get(key); cached != nil {
+		for k, v := range cached.header {
+			w.Header()[k] = v
+		}
+		w.WriteHeader(cached.status)
+		_, _ = w.Write(cached.body)
+		return
+	}
+
+	rec := &recordingWriter{ResponseWriter: w, status: http.StatusOK}
+	h.next.ServeHTTP(rec, r)
+	if rec.status == http.StatusOK {
+		header := w.Header().Clone()
+		header.Del(LagHeader)
+		h.put(key, &cachedResponse{
+			status:  rec.status,
+			header:  header,
+			body:    rec.body.Bytes(),
+			expires: time.Now().Add(h.opts.CacheTTL),
+		})
+	}
+}
+
+// cacheable returns whether the response to the request may be cached.
+func cacheable(r *http.Request) bool {
+	// responses to authenticated requests must not be served to other clients, and upgraded
+	// connections, e.g. of the websocket endpoint, are not responses
+	if r.Header.Get("Authorization") != "" || r.Header.Get("Upgrade") != "" {
+		return false
+	}
+	switch r.Method {
+	case http.MethodGet:
+		if strings.HasPrefix(r.URL.Path, "/api/v1/") {
+			return true
+		}
+	case http.MethodPost:
+		// gRPC responses carry their status in trailers, and streams are not cached
+		contentType := r.Header.Get("Content-Type")
+		if strings.HasPrefix(contentType, "application/grpc") || strings.HasPrefix(contentType, "application/connect+") {
+			return false
+		}
+	default:
+		return false
+	}
+	return noSideEffects(r.URL.Path)
+}
+
+// noSideEffects returns whether path is the procedure of a unary RPC marked with the
+// NO_SIDE_EFFECTS idempotency level.
+func noSideEffects(path string) bool {
+	service, method, ok := strings.Cut(strings.TrimPrefix(path, "/"), "/")
+	if !ok {
+		return false
+	}
+	desc, err := protoregistry.GlobalFiles.FindDescriptorByName(protoreflect.FullName(service + "." + method))
+	if err != nil {
+		return false
+	}
+	md, ok := desc.(protoreflect.MethodDescriptor)
+	if !ok || md.IsStreamingClient() || md.IsStreamingServer() {
+		return false
+	}
+	opts, _ := md.Options().(*descriptorpb.MethodOptions)
+	return opts.GetIdempotencyLevel() == descriptorpb.MethodOptions_NO_SIDE_EFFECTS
+}
+
+// cacheKey identifies a request by everything the response depends on.
+func cacheKey(r *http.Request, body []byte) [sha256.Size]byte {
+	hash := sha256.New()
+	for _, part := range []string{
+		r.Method,
+		r.URL.RequestURI(),
+		r.Header.Get("Content-Type"),
+		r.Header.Get("Accept"),
+		r.Header.Get("Accept-Encoding"),
+		r.Header.Get("Content-Encoding"),
+		r.Header.Get("Connect-Protocol-Version"),
+		r.Header.Get("Connect-Accept-Encoding"),
+		r.Header.Get("Connect-Content-Encoding"),
+	} {
+		hash.Write([]byte(part))
+		hash.Write([]byte{0})
+	}
+	hash.Write(body)
+
+	var key [sha256.Size]byte
+	copy(key[:], hash.Sum(nil))
+	return key
+}
+
+func (h *replicaHandler) get(key [sha256.Size]byte) *cachedResponse {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	cached, ok := h.cache[key]
+	if !ok {
+		return nil
+	}
+	if time.Now().After(cached.expires) {
+		delete(h.cache, key)
+		return nil
+	}
+	return cached
+}
+
+func (h *replicaHandler) put(key [sha256.Size]byte, resp *cachedResponse) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	if len(h.cache) >= maxReplicaCacheEntries {
+		now := time.Now()
+		for k, cached := range h.cache {
+			if now.After(cached.expires) {
+				delete(h.cache, k)
+			}
+		}
+		if len(h.cache) >= maxReplicaCacheEntries {
+			return
+		}
+	}
+	h.cache[key] = resp
+}
+
+// recordingWriter passes a response through while recording its status and body.
+type recordingWriter struct {
+	http.ResponseWriter
+	status      int
+	wroteHeader bool
+	body        bytes.Buffer
+}
+
+func (w *recordingWriter) WriteHeader(status int) {
+	if !w.wroteHeader {
+		w.status = status
+		w.wroteHeader = true
+	}
+	w.ResponseWriter.WriteHeader(status)
+}
+
+func (w *recordingWriter) Write(b []byte) (int, error) {
+	w.wroteHeader = true
+	w.body.Write(b)
+	return w.ResponseWriter.Write(b)
+}
+
+func (w *recordingWriter) Flush() {
+	if f, ok := w.ResponseWriter.(http.Flusher); ok {
+		f.Flush()
+	}
+}
@@ -0,0 +1,173 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestReplicaHandler(t *testing.T) {
+	var calls atomic.Int32
+	next := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls.Add(1)
+		body, _ := io.ReadAll(r.Body)
+		if string(body) == "fail" {
+			http.Error(w, "failed", http.StatusInternalServerError)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write(append([]byte("echo:"), body...))
+	})
+
+	var lag atomic.Uint64
+	srv := httptest.NewServer(NewReplicaHandler(next, ReplicaOptions{
+		CacheTTL:     time.Hour,
+		MaxLagBlocks: 10,
+		Lag:          func(context.Context) (uint64, error) { return lag.Load(), nil },
+	}, zerolog.Nop()))
+	defer srv.Close()
+
+	post := func(contentType, body string) (*http.Response, string) {
+		resp, err := http.Post(srv.URL+"/evnode.v1.StoreService/GetState", contentType, strings.NewReader(body))
+		require.NoError(t, err)
+		defer resp.Body.Close()
+		respBody, err := io.ReadAll(resp.Body)
+		require.NoError(t, err)
+		return resp, string(respBody)
+	}
+
+	resp, body := post("application/json", "a")
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+	assert.Equal(t, "echo:a", body)
+	assert.Equal(t, "0", resp.Header.Get(LagHeader))
+
+	// identical requests are served from the cache, with the current lag
+	lag.Store(3)
+	resp, body = post("application/json", "a")
+	assert.Equal(t, "echo:a", body)
+	assert.Equal(t, "application/json", resp.Header.Get("Content-Type"))
+	assert.Equal(t, "3", resp.Header.Get(LagHeader))
+	assert.Equal(t, int32(1), calls.Load())
+
+	// requests differing in body or protocol are not
+	_, body = post("application/json", "b")
+	assert.Equal(t, "echo:b", body)
+	_, _ = post("application/proto", "a")
+	assert.Equal(t, int32(3), calls.Load())
+
+	// failed responses and gRPC requests are not cached
+	_, _ = post("application/json", "fail")
+	_, _ = post("application/json", "fail")
+	_, _ = post("application/grpc", "a")
+	_, _ = post("application/grpc", "a")
+	assert.Equal(t, int32(7), calls.Load())
+
+	// requests are rejected while the replica lags too much, except liveness checks
+	lag.Store(11)
+	resp, _ = post("application/json", "a")
+	assert.Equal(t, http.StatusServiceUnavailable, resp.StatusCode)
+	assert.Equal(t, "11", resp.Header.Get(LagHeader))
+	liveResp, err := http.Get(srv.URL + "/health/live")
+	require.NoError(t, err)
+	liveResp.Body.Close()
+	assert.Equal(t, int32(8), calls.Load())
+}
+
+func TestReplicaHandlerUncacheable(t *testing.T) {
+	var calls atomic.Int32
+	next := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls.Add(1)
+		_, _ = w.Write([]byte(r.Header.Get("Authorization")))
+	})
+	srv := httptest.NewServer(NewReplicaHandler(next, ReplicaOptions{
+		CacheTTL: time.Hour,
+		Lag:      func(context.Context) (uint64, error) { return 0, nil },
+	}, zerolog.Nop()))
+	defer srv.Close()
+
+	do := func(method, path, token string, header http.Header) string {
+		req, err := http.NewRequest(method, srv.URL+path, strings.NewReader("{}"))
+		require.NoError(t, err)
+		req.Header.Set("Content-Type", "application/json")
+		for k, v := range header {
+			req.Header[k] = v
+		}
+		if token != "" {
+			req.Header.Set("Authorization", "Bearer "+token)
+		}
+		resp, err := http.DefaultClient.Do(req)
+		require.NoError(t, err)
+		defer resp.Body.Close()
+		body, err := io.ReadAll(resp.Body)
+		require.NoError(t, err)
+		return string(body)
+	}
+
+	// authenticated responses are never served to other clients
+	assert.Equal(t, "Bearer secret", do(http.MethodPost, "/evnode.v1.StoreService/GetState", "secret", nil))
+	assert.Empty(t, do(http.MethodPost, "/evnode.v1.StoreService/GetState", "", nil))
+	assert.Equal(t, int32(2), calls.Load())
+
+	// RPCs with side effects, streams, unknown procedures, health checks and websocket connections
+	// are passed through
+	for _, c := range []struct {
+		method, path string
+		header       http.Header
+	}{
+		{http.MethodPost, "/evnode.v1.AdminService/Drain", nil},
+		{http.MethodPost, "/evnode.v1.StoreService/Unknown", nil},
+		{http.MethodPost, "/health/ready", nil},
+		{http.MethodGet, "/health/ready", nil},
+		{http.MethodGet, "/websocket", http.Header{"Connection": {"Upgrade"}, "Upgrade": {"websocket"}}},
+	} {
+		before := calls.Load()
+		do(c.method, c.path, "", c.header)
+		do(c.method, c.path, "", c.header)
+		assert.Equal(t, before+2, calls.Load(), c.path)
+	}
+
+	// the GET endpoints of the JSON gateway are cached
+	before := calls.Load()
+	do(http.MethodGet, "/api/v1/state", "", nil)
+	do(http.MethodGet, "/api/v1/state", "", nil)
+	assert.Equal(t, before+1, calls.Load())
+}
+
+func TestReplicaHandlerWithoutCache(t *testing.T) {
+	var calls atomic.Int32
+	next := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls.Add(1)
+	})
+	srv := httptest.NewServer(NewReplicaHandler(next, ReplicaOptions{
+		Lag: func(context.Context) (uint64, error) { return 0, errors.New("store unavailable") },
+	}, zerolog.Nop()))
+	defer srv.Close()
+
+	for i := 0; i < 2; i++ {
+		resp, err := http.Post(srv.URL, "application/json", strings.NewReader("a"))
+		require.NoError(t, err)
+		resp.Body.Close()
+		assert.Equal(t, http.StatusOK, resp.StatusCode)
+		// the lag is omitted if it cannot be determined
+		assert.Empty(t, resp.Header.Get(LagHeader))
+	}
+	assert.Equal(t, int32(2), calls.Load())
+}
+
+func TestReplicaHandlerCacheExpiry(t *testing.T) {
+	h := &replicaHandler{cache: make(map[[32]byte]*cachedResponse)}
+	key := [32]byte{1}
+	h.put(key, &cachedResponse{status: http.StatusOK, expires: time.Now().Add(-time.Second)})
+	assert.Nil(t, h.get(key))
+	assert.Empty(t, h.cache)
+}
@@ -2,26 +2,40 @@ package server
 
 import (
 	"context"
+	"crypto/sha256"
 	"fmt"
 
 	"net/http"
+	"reflect"
+	"slices"
+	"strings"
 	"time"
 
 	"encoding/binary"
 	"encoding/hex"
+	"encoding/json"
 	"errors"
+	"os"
 
 	"connectrpc.com/connect"
 	"connectrpc.com/grpcreflect"
 	coreda "github.com/evstack/ev-node/core/da"
+	coreexecutor "github.com/evstack/ev-node/core/execution"
 	ds "github.com/ipfs/go-datastore"
+	"github.com/libp2p/go-libp2p/core/peer"
 	"github.com/rs/zerolog"
 	"golang.org/x/net/http2"
 	"golang.org/x/net/http2/h2c"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/durationpb"
 	"google.golang.org/protobuf/types/known/emptypb"
 	"google.golang.org/protobuf/types/known/timestamppb"
 
+	"github.com/evstack/ev-node/pkg/alert"
 	"github.com/evstack/ev-node/pkg/config"
+	"github.com/evstack/ev-node/pkg/errlog"
+	"github.com/evstack/ev-node/pkg/genesis"
+	"github.com/evstack/ev-node/pkg/journal"
 	"github.com/evstack/ev-node/pkg/p2p"
 	"github.com/evstack/ev-node/pkg/store"
 	"github.com/evstack/ev-node/types"
@@ -29,17 +43,156 @@ import (
 	rpc "github.com/evstack/ev-node/types/pb/evnode/v1/v1connect"
 )
 
+// maxHeaderRange is the maximum number of headers returned by GetHeaderRange.
+const maxHeaderRange = 1000
+
+// maxMetadataBatch is the maximum number of keys of a GetMetadataBatch request.
+const maxMetadataBatch = 1000
+
+const (
+	// defaultPeerInfoLimit is the number of peers returned by GetPeerInfo by default.
+	defaultPeerInfoLimit = 100
+	// maxPeerInfoLimit is the maximum number of peers returned by GetPeerInfo.
+	maxPeerInfoLimit = 1000
+	// defaultSearchBlocksLimit is the number of blocks returned by SearchBlocks by default.
+	defaultSearchBlocksLimit = 100
+	// maxSearchBlocksLimit is the maximum number of blocks returned by SearchBlocks.
+	maxSearchBlocksLimit = 1000
+	// defaultEventsLimit is the number of events of a page returned by GetEvents by default.
+	defaultEventsLimit = 100
+	// maxEventsLimit is the maximum number of events of a page returned by GetEvents.
+	maxEventsLimit = 1000
+)
+
+const (
+	// maxTxInclusionWait is the longest GetTxStatus waits for a tx to be included.
+	maxTxInclusionWait = time.Minute
+	// txInclusionPollInterval is how often GetTxStatus checks whether a tx was included.
+	txInclusionPollInterval = 100 * time.Millisecond
+)
+
+// ExecutorProvider provides the executor of the node. It may be switched while the node runs,
+// see block.Manager.UpgradeExecutor, so it is got again for every request.
+type ExecutorProvider interface {
+	GetExecutor() coreexecutor.Executor
+}
+
+// StaticExecutor returns the provider of an executor which is never switched.
+func StaticExecutor(exec coreexecutor.Executor) ExecutorProvider {
+	return staticExecutor{exec: exec}
+}
+
+type staticExecutor struct {
+	exec coreexecutor.Executor
+}
+
+func (s staticExecutor) GetExecutor() coreexecutor.Executor {
+	return s.exec
+}
+
+// executorBlockInfo exposes the blocks of the current executor of the node.
+type executorBlockInfo struct {
+	exec ExecutorProvider
+}
+
+func (e executorBlockInfo) provider() (coreexecutor.BlockInfoProvider, error) {
+	provider, ok := e.exec.GetExecutor().(coreexecutor.BlockInfoProvider)
+	if !ok {
+		return nil, errors.New("executor does not expose its blocks")
+	}
+	return provider, nil
+}
+
+func (e executorBlockInfo) GetBlockInfo(ctx context.Context, blockHeight uint64) (coreexecutor.BlockInfo, error) {
+	provider, err := e.provider()
+	if err != nil {
+		return coreexecutor.BlockInfo{}, err
+	}
+	return provider.GetBlockInfo(ctx, blockHeight)
+}
+
+func (e executorBlockInfo) GetLatestBlockInfo(ctx context.Context) (coreexecutor.BlockInfo, error) {
+	provider, err := e.provider()
+	if err != nil {
+		return coreexecutor.BlockInfo{}, err
+	}
+	return provider.GetLatestBlockInfo(ctx)
+}
+
+// SubmittedTxs reports the transactions submitted to the sequencer by the node.
+type SubmittedTxs interface {
+	// IsTxSubmitted returns whether the transaction with the given hex encoded SHA-256 hash was submitted.
+	IsTxSubmitted(ctx context.Context, txHash string) (bool, error)
+}
+
+// SyncStatus is the sync progress of a node.
+type SyncStatus struct {
+	// NetworkHeight is the height of the latest header known from the network, 0 if unknown.
+	NetworkHeight uint64
+	// DAHeight is the next DA height retrieved by the node.
+	DAHeight uint64
+	// DAIncludedHeight is the height of the latest block included on DA.
+	DAIncludedHeight uint64
+	// HeadersBySource and DataBySource count the headers and data applied since the node
+	// started, by the source they were obtained from.
+	HeadersBySource map[string]uint64
+	DataBySource    map[string]uint64
+}
+
+// SyncStatusProvider reports the sync progress of the node.
+type SyncStatusProvider interface {
+	SyncStatus() SyncStatus
+}
+
+// StaticSyncStatus returns the provider of a sync status which never changes, e.g. in tests.
+func StaticSyncStatus(status SyncStatus) SyncStatusProvider {
+	return staticSyncStatus(status)
+}
+
+type staticSyncStatus SyncStatus
+
+func (s staticSyncStatus) SyncStatus() SyncStatus {
+	return SyncStatus(s)
+}
+
+// PreviewSource provides the unsigned preview blocks gossiped on the network of the chain.
+type PreviewSource interface {
+	// SubscribePreviews returns the previews published or received from now on and a function to
+	// stop receiving them, or false if the node does not gossip previews.
+	SubscribePreviews() (<-chan *pb.Block, func(), bool)
+}
+
 // StoreServer implements the StoreService defined in the proto file
 type StoreServer struct {
 	store  store.Store
 	logger zerolog.Logger
+
+	// submitted is nil if the node does not submit transactions to the sequencer
+	submitted SubmittedTxs
+	// syncStatus is nil if the node does not sync blocks
+	syncStatus SyncStatusProvider
+	// da is nil if the node has no DA layer
+	da coreda.DA
+	// daNamespaces are the namespaces searched for the blobs of blocks
+	daNamespaces []string
+	// daConfig is the configuration of the DA layer of the node
+	daConfig config.DAConfig
+	// blockInfo is nil if the executor does not expose its blocks
+	blockInfo coreexecutor.BlockInfoProvider
+	// exec is nil if the node has no executor
+	exec ExecutorProvider
+	// subscribeInterval is the interval at which SubscribeBlocks checks the store for new blocks
+	subscribeInterval time.Duration
+	// previews is nil if the node does not gossip preview blocks
+	previews PreviewSource
 }
 
 // NewStoreServer creates a new StoreServer instance
 func NewStoreServer(store store.Store, logger zerolog.Logger) *StoreServer {
 	return &StoreServer{
-		store:  store,
-		logger: logger,
+		store:             store,
+		logger:            logger,
+		subscribeInterval: blockSubscriptionPollInterval,
 	}
 }
 
@@ -54,31 +207,15 @@ func (s *StoreServer) GetBlock(
 
 	switch identifier := req.Msg.Identifier.(type) {
 	case *pb.GetBlockRequest_Height:
-		fetchHeight := identifier.Height
-		if fetchHeight == 0 {
-			// Subcase 2a: Height is 0 -> Fetch latest block
-			fetchHeight, err = s.store.Height(ctx)
-			if err != nil {
-				return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to get latest height: %w", err))
-			}
-			if fetchHeight == 0 {
-				return nil, connect.NewError(connect.CodeNotFound, fmt.Errorf("store is empty, no latest block available"))
-			}
-		}
-		// Fetch by the determined height (either specific or latest)
-		header, data, err = s.store.GetBlockData(ctx, fetchHeight)
-
+		header, data, err = s.blockByHeight(ctx, identifier.Height)
 	case *pb.GetBlockRequest_Hash:
-		hash := types.Hash(identifier.Hash)
-		header, data, err = s.store.GetBlockByHash(ctx, hash)
-
+		header, data, err = s.blockByHash(ctx, identifier.Hash)
 	default:
 		// This case handles potential future identifier types or invalid states
 		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("invalid or unsupported identifier type provided"))
 	}
-
 	if err != nil {
-		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to retrieve block data: %w", err))
+		return nil, err
 	}
 
 	// Convert retrieved types to protobuf types
@@ -99,24 +236,344 @@ func (s *StoreServer) GetBlock(
 
 	// Fetch and set DA heights
 	blockHeight := header.Height()
-	if blockHeight > 0 { // DA heights are not stored for genesis/height 0 in the current impl
-		headerDAHeightKey := fmt.Sprintf("%s/%d/h", store.HeightToDAHeightKey, blockHeight)
-		headerDAHeightBytes, err := s.store.GetMetadata(ctx, headerDAHeightKey)
-		if err == nil && len(headerDAHeightBytes) == 8 {
-			resp.HeaderDaHeight = binary.LittleEndian.Uint64(headerDAHeightBytes)
-		} else if err != nil && !errors.Is(err, ds.ErrNotFound) {
-			s.logger.Error().Uint64("height", blockHeight).Err(err).Msg("Error fetching header DA height for block")
+	resp.HeaderDaHeight = s.daHeight(ctx, blockHeight, "h")
+	resp.DataDaHeight = s.daHeight(ctx, blockHeight, "d")
+
+	return connect.NewResponse(resp), nil
+}
+
+// defaultBlockChunkSize is the maximum size of the transactions of a chunk of GetBlockStream, if
+// the request sets none.
+const defaultBlockChunkSize = 1 << 20
+
+// blockSubscriptionPollInterval is the interval at which SubscribeBlocks checks the store for new
+// blocks, shorter than the usual block times.
+const blockSubscriptionPollInterval = 100 * time.Millisecond
+
+// GetBlockStream implements the GetBlockStream RPC method. The block is loaded whole from the
+// store, then sent in chunks of at most the requested size of transactions, so that the consumer
+// and the proxies in between do not handle a single message of the size of the block.
+func (s *StoreServer) GetBlockStream(
+	ctx context.Context,
+	req *connect.Request[pb.GetBlockStreamRequest],
+	stream *connect.ServerStream[pb.GetBlockStreamResponse],
+) error {
+	var header *types.SignedHeader
+	var data *types.Data
+	var err error
+
+	switch identifier := req.Msg.Identifier.(type) {
+	case *pb.GetBlockStreamRequest_Height:
+		header, data, err = s.blockByHeight(ctx, identifier.Height)
+	case *pb.GetBlockStreamRequest_Hash:
+		header, data, err = s.blockByHash(ctx, identifier.Hash)
+	default:
+		return connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("invalid or unsupported identifier type provided"))
+	}
+	if err != nil {
+		return err
+	}
+
+	pbHeader, err := header.ToProto()
+	if err != nil {
+		return connect.NewError(connect.CodeInternal, fmt.Errorf("failed to convert block header to proto format: %w", err))
+	}
+	maxChunkSize := req.Msg.MaxChunkSize
+	if maxChunkSize == 0 {
+		maxChunkSize = defaultBlockChunkSize
+	}
+
+	chunk := &pb.GetBlockStreamResponse{
+		Header:         pbHeader,
+		Metadata:       data.ToProto().Metadata,
+		HeaderDaHeight: s.daHeight(ctx, header.Height(), "h"),
+		DataDaHeight:   s.daHeight(ctx, header.Height(), "d"),
+		TxCount:        uint64(len(data.Txs)),
+	}
+	var chunkSize uint64
+	for _, tx := range data.Txs {
+		if len(chunk.Txs) > 0 && chunkSize+uint64(len(tx)) > maxChunkSize {
+			if err := stream.Send(chunk); err != nil {
+				return err
+			}
+			chunk, chunkSize = &pb.GetBlockStreamResponse{}, 0
+		}
+		chunk.Txs = append(chunk.Txs, tx)
+		chunkSize += uint64(len(tx))
+	}
+	return stream.Send(chunk)
+}
+
+// SubscribeBlocks streams the blocks from the requested height, or from the next block, then polls
+// the store for the new blocks until the client cancels the stream or the node drains.
+func (s *StoreServer) SubscribeBlocks(
+	ctx context.Context,
+	req *connect.Request[pb.SubscribeBlocksRequest],
+	stream *connect.ServerStream[pb.SubscribeBlocksResponse],
+) error {
+	next := req.Msg.FromHeight
+	if next == 0 {
+		height, err := s.store.Height(ctx)
+		if err != nil {
+			return connect.NewError(connect.CodeInternal, fmt.Errorf("failed to get latest height: %w", err))
 		}
+		next = height + 1
+	}
 
-		dataDAHeightKey := fmt.Sprintf("%s/%d/d", store.HeightToDAHeightKey, blockHeight)
-		dataDAHeightBytes, err := s.store.GetMetadata(ctx, dataDAHeightKey)
-		if err == nil && len(dataDAHeightBytes) == 8 {
-			resp.DataDaHeight = binary.LittleEndian.Uint64(dataDAHeightBytes)
-		} else if err != nil && !errors.Is(err, ds.ErrNotFound) {
-			s.logger.Error().Uint64("height", blockHeight).Err(err).Msg("Error fetching data DA height for block")
+	poll := time.NewTicker(s.subscribeInterval)
+	defer poll.Stop()
+	draining := Draining(ctx)
+	for {
+		height, err := s.store.Height(ctx)
+		if err != nil {
+			return connect.NewError(connect.CodeInternal, fmt.Errorf("failed to get latest height: %w", err))
+		}
+		for ; next <= height; next++ {
+			header, data, err := s.blockByHeight(ctx, next)
+			if err != nil {
+				return err
+			}
+			pbHeader, err := header.ToProto()
+			if err != nil {
+				return connect.NewError(connect.CodeInternal, fmt.Errorf("failed to convert block header to proto format: %w", err))
+			}
+			if err := stream.Send(&pb.SubscribeBlocksResponse{Block: &pb.Block{Header: pbHeader, Data: data.ToProto()}}); err != nil {
+				return err
+			}
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-draining:
+			return connect.NewError(connect.CodeUnavailable, errors.New("node is shutting down"))
+		case <-poll.C:
 		}
 	}
+}
 
+// SubscribePreviewBlocks streams the preview blocks published or received by the node until the
+// client cancels the stream or the node drains. Previews missed by a slow client are skipped.
+func (s *StoreServer) SubscribePreviewBlocks(
+	ctx context.Context,
+	req *connect.Request[emptypb.Empty],
+	stream *connect.ServerStream[pb.SubscribePreviewBlocksResponse],
+) error {
+	if s.previews == nil {
+		return connect.NewError(connect.CodeUnimplemented, errors.New("preview blocks are disabled"))
+	}
+	previews, cancel, ok := s.previews.SubscribePreviews()
+	if !ok {
+		return connect.NewError(connect.CodeUnimplemented, errors.New("preview blocks are disabled"))
+	}
+	defer cancel()
+
+	draining := Draining(ctx)
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-draining:
+			return connect.NewError(connect.CodeUnavailable, errors.New("node is shutting down"))
+		case block := <-previews:
+			if err := stream.Send(&pb.SubscribePreviewBlocksResponse{Block: block}); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+// blockByHeight returns the block at the given height, or the latest block if the height is 0.
+func (s *StoreServer) blockByHeight(ctx context.Context, height uint64) (*types.SignedHeader, *types.Data, error) {
+	if height == 0 {
+		latest, err := s.store.Height(ctx)
+		if err != nil {
+			return nil, nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to get latest height: %w", err))
+		}
+		if latest == 0 {
+			return nil, nil, newError(connect.CodeNotFound, pb.ErrorReason_ERROR_REASON_BLOCK_NOT_FOUND, 0, fmt.Errorf("store is empty, no latest block available"))
+		}
+		height = latest
+	}
+	header, data, err := s.store.GetBlockData(ctx, height)
+	if err != nil {
+		return nil, nil, s.blockError(height, fmt.Errorf("failed to retrieve block data: %w", err))
+	}
+	return header, data, nil
+}
+
+// blockByHash returns the block with the given header hash.
+func (s *StoreServer) blockByHash(ctx context.Context, hash []byte) (*types.SignedHeader, *types.Data, error) {
+	header, data, err := s.store.GetBlockByHash(ctx, types.Hash(hash))
+	if err != nil {
+		return nil, nil, s.blockError(0, fmt.Errorf("failed to retrieve block data: %w", err))
+	}
+	return header, data, nil
+}
+
+// daHeight returns the DA height at which the header ("h") or data ("d") of the block at the given
+// height was included, or 0 if it is unknown.
+func (s *StoreServer) daHeight(ctx context.Context, blockHeight uint64, suffix string) uint64 {
+	if blockHeight == 0 { // DA heights are not stored for genesis/height 0 in the current impl
+		return 0
+	}
+	key := fmt.Sprintf("%s/%d/%s", store.HeightToDAHeightKey, blockHeight, suffix)
+	value, err := s.store.GetMetadata(ctx, key)
+	if err == nil && len(value) == 8 {
+		return binary.LittleEndian.Uint64(value)
+	}
+	if err != nil && !errors.Is(err, ds.ErrNotFound) {
+		s.logger.Error().Uint64("height", blockHeight).Str("kind", suffix).Err(err).Msg("Error fetching DA height for block")
+	}
+	return 0
+}
+
+// GetHeader implements the GetHeader RPC method
+func (s *StoreServer) GetHeader(
+	ctx context.Context,
+	req *connect.Request[pb.GetHeaderRequest],
+) (*connect.Response[pb.GetHeaderResponse], error) {
+	height := req.Msg.Height
+	if height == 0 {
+		latest, err := s.store.Height(ctx)
+		if err != nil {
+			return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to get latest height: %w", err))
+		}
+		if latest == 0 {
+			return nil, newError(connect.CodeNotFound, pb.ErrorReason_ERROR_REASON_BLOCK_NOT_FOUND, 0, fmt.Errorf("store is empty, no latest header available"))
+		}
+		height = latest
+	}
+
+	header, err := s.store.GetHeader(ctx, height)
+	if err != nil {
+		if errors.Is(err, ds.ErrNotFound) {
+			return nil, s.blockError(height, fmt.Errorf("no header for height %d: %w", height, err))
+		}
+		return nil, s.blockError(height, fmt.Errorf("failed to retrieve header: %w", err))
+	}
+	pbHeader, err := header.ToProto()
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to convert header to proto format: %w", err))
+	}
+
+	return connect.NewResponse(&pb.GetHeaderResponse{
+		Header:         pbHeader,
+		HeaderDaHeight: s.daHeight(ctx, height, "h"),
+	}), nil
+}
+
+// GetHeaderRange implements the GetHeaderRange RPC method
+func (s *StoreServer) GetHeaderRange(
+	ctx context.Context,
+	req *connect.Request[pb.GetHeaderRangeRequest],
+) (*connect.Response[pb.GetHeaderRangeResponse], error) {
+	from, to := req.Msg.FromHeight, req.Msg.ToHeight
+	if from == 0 || to < from {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("invalid header range [%d, %d]", from, to))
+	}
+	if req.Msg.Page == nil && to-from >= maxHeaderRange {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("header range [%d, %d] exceeds the maximum of %d headers", from, to, maxHeaderRange))
+	}
+
+	latest, err := s.store.Height(ctx)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to get latest height: %w", err))
+	}
+	to = min(to, latest)
+
+	resp := &pb.GetHeaderRangeResponse{}
+	if req.Msg.Page != nil {
+		// the page continues after the last height of the previous page
+		limit, err := pageLimit(req.Msg.Page, maxHeaderRange, maxHeaderRange)
+		if err != nil {
+			return nil, err
+		}
+		after, err := parseHeightToken(req.Msg.Page.PageToken)
+		if err != nil {
+			return nil, err
+		}
+		var total uint64
+		if to >= from {
+			total = to - from + 1
+		}
+		resp.Page = &pb.PageResponse{Total: &total}
+		from = max(from, after+1)
+		if to >= from && to-from >= uint64(limit) {
+			to = from + uint64(limit) - 1
+			resp.Page.NextPageToken = heightToken(to)
+		}
+	}
+	if resp.DaIncludedHeight, err = s.daIncludedHeight(ctx); err != nil {
+		return nil, err
+	}
+	for height := from; height <= to; height++ {
+		header, err := s.store.GetHeader(ctx, height)
+		if err != nil {
+			return nil, s.blockError(height, fmt.Errorf("failed to retrieve header at height %d: %w", height, err))
+		}
+		pbHeader, err := header.ToProto()
+		if err != nil {
+			return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to convert header to proto format: %w", err))
+		}
+		resp.Headers = append(resp.Headers, pbHeader)
+	}
+
+	return connect.NewResponse(resp), nil
+}
+
+// SearchBlocks implements the SearchBlocks RPC method
+func (s *StoreServer) SearchBlocks(
+	ctx context.Context,
+	req *connect.Request[pb.SearchBlocksRequest],
+) (*connect.Response[pb.SearchBlocksResponse], error) {
+	searcher, ok := s.store.(store.BlockSearcher)
+	if !ok {
+		return nil, connect.NewError(connect.CodeUnimplemented, errors.New("store does not index blocks"))
+	}
+	limit, err := pageLimit(req.Msg.Page, defaultSearchBlocksLimit, maxSearchBlocksLimit)
+	if err != nil {
+		return nil, err
+	}
+
+	query := store.BlockQuery{
+		ProposerAddress: req.Msg.ProposerAddress,
+		MinTxs:          req.Msg.MinTxCount,
+		MaxTxs:          req.Msg.MaxTxCount,
+		FromHeight:      req.Msg.FromHeight,
+		ToHeight:        req.Msg.ToHeight,
+		Limit:           limit,
+	}
+	if req.Msg.StartTime != nil {
+		query.StartTime = req.Msg.StartTime.AsTime()
+	}
+	if req.Msg.EndTime != nil {
+		query.EndTime = req.Msg.EndTime.AsTime()
+	}
+	if req.Msg.Page.GetPageToken() != "" {
+		// the token is the height to continue the search from
+		if query.FromHeight, err = parseHeightToken(req.Msg.Page.PageToken); err != nil {
+			return nil, err
+		}
+	}
+	blocks, next, err := searcher.SearchBlocks(ctx, query)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to search blocks: %w", err))
+	}
+
+	resp := &pb.SearchBlocksResponse{Page: &pb.PageResponse{}}
+	if next != 0 {
+		resp.Page.NextPageToken = heightToken(next)
+	}
+	for _, block := range blocks {
+		resp.Blocks = append(resp.Blocks, &pb.BlockSummary{
+			Height:          block.Height,
+			Hash:            block.Hash,
+			Time:            timestamppb.New(block.Time),
+			ProposerAddress: block.ProposerAddress,
+			TxCount:         block.TxCount,
+		})
+	}
 	return connect.NewResponse(resp), nil
 }
 
@@ -165,9 +622,380 @@ func (s *StoreServer) GetMetadata(
 	}), nil
 }
 
+// GetMetadataBatch implements the GetMetadataBatch RPC method. Keys which are not set are
+// returned as not found rather than failing the request.
+func (s *StoreServer) GetMetadataBatch(
+	ctx context.Context,
+	req *connect.Request[pb.GetMetadataBatchRequest],
+) (*connect.Response[pb.GetMetadataBatchResponse], error) {
+	if len(req.Msg.Keys) > maxMetadataBatch {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("%d keys requested, exceeding the maximum of %d", len(req.Msg.Keys), maxMetadataBatch))
+	}
+
+	entries := make([]*pb.MetadataEntry, 0, len(req.Msg.Keys))
+	for _, key := range req.Msg.Keys {
+		value, err := s.store.GetMetadata(ctx, key)
+		switch {
+		case errors.Is(err, ds.ErrNotFound):
+			entries = append(entries, &pb.MetadataEntry{Key: key})
+		case err != nil:
+			return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to get metadata %q: %w", key, err))
+		default:
+			entries = append(entries, &pb.MetadataEntry{Key: key, Value: value, Found: true})
+		}
+	}
+
+	return connect.NewResponse(&pb.GetMetadataBatchResponse{
+		Entries: entries,
+	}), nil
+}
+
+// GetStateDiff implements the GetStateDiff RPC method
+func (s *StoreServer) GetStateDiff(
+	ctx context.Context,
+	req *connect.Request[pb.GetStateDiffRequest],
+) (*connect.Response[pb.GetStateDiffResponse], error) {
+	value, err := s.store.GetMetadata(ctx, fmt.Sprintf("%s/%d", store.StateDiffKey, req.Msg.Height))
+	if err != nil {
+		if errors.Is(err, ds.ErrNotFound) {
+			return nil, connect.NewError(connect.CodeNotFound, fmt.Errorf("no state diff for height %d", req.Msg.Height))
+		}
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to get state diff: %w", err))
+	}
+
+	var diff pb.StateDiff
+	if err := proto.Unmarshal(value, &diff); err != nil {
+		return nil, newError(connect.CodeDataLoss, pb.ErrorReason_ERROR_REASON_STORE_CORRUPTED, req.Msg.Height, fmt.Errorf("failed to decode state diff: %w", err))
+	}
+
+	return connect.NewResponse(&pb.GetStateDiffResponse{
+		Diff: &diff,
+	}), nil
+}
+
+// GetSequencerFees implements the GetSequencerFees RPC method
+func (s *StoreServer) GetSequencerFees(
+	ctx context.Context,
+	req *connect.Request[pb.GetSequencerFeesRequest],
+) (*connect.Response[pb.GetSequencerFeesResponse], error) {
+	height := req.Msg.Height
+	if height == 0 {
+		latest, err := s.store.Height(ctx)
+		if err != nil {
+			return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to get latest height: %w", err))
+		}
+		height = latest
+	}
+
+	fees, err := s.sequencerFees(ctx, height)
+	if err != nil {
+		if errors.Is(err, ds.ErrNotFound) {
+			return nil, connect.NewError(connect.CodeNotFound, fmt.Errorf("no sequencer fees for height %d", height))
+		}
+		if errors.Is(err, store.ErrCorrupted) {
+			return nil, newError(connect.CodeDataLoss, pb.ErrorReason_ERROR_REASON_STORE_CORRUPTED, height, err)
+		}
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to get sequencer fees: %w", err))
+	}
+
+	return connect.NewResponse(&pb.GetSequencerFeesResponse{
+		Fees:       fees,
+		Unverified: true,
+	}), nil
+}
+
+// sequencerFees returns the sequencing fees accounted for the block at the given height.
+func (s *StoreServer) sequencerFees(ctx context.Context, height uint64) (*pb.SequencerFees, error) {
+	value, err := s.store.GetMetadata(ctx, fmt.Sprintf("%s/%d", store.SequencerFeesKey, height))
+	if err != nil {
+		return nil, err
+	}
+	var fees pb.SequencerFees
+	if err := proto.Unmarshal(value, &fees); err != nil {
+		return nil, fmt.Errorf("failed to decode sequencer fees: %w: %w", store.ErrCorrupted, err)
+	}
+	return &fees, nil
+}
+
+// GetEvents implements the GetEvents RPC method
+func (s *StoreServer) GetEvents(
+	ctx context.Context,
+	req *connect.Request[pb.GetEventsRequest],
+) (*connect.Response[pb.GetEventsResponse], error) {
+	var from, to time.Time
+	if req.Msg.From != nil {
+		from = req.Msg.From.AsTime()
+	}
+	if req.Msg.To != nil {
+		to = req.Msg.To.AsTime()
+	}
+
+	var (
+		limit int
+		after uint64
+		err   error
+	)
+	if req.Msg.Page != nil {
+		if limit, err = pageLimit(req.Msg.Page, defaultEventsLimit, maxEventsLimit); err != nil {
+			return nil, err
+		}
+		if after, err = parseHeightToken(req.Msg.Page.PageToken); err != nil {
+			return nil, err
+		}
+	}
+
+	events, err := journal.New(s.store).Events(ctx, from, to, req.Msg.Types)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to get events: %w", err))
+	}
+
+	resp := &pb.GetEventsResponse{Events: events}
+	if req.Msg.Page != nil {
+		// events are paged by sequence
+		resp.Events, resp.Page = paginate(events, (*pb.Event).GetSequence, after, limit, heightToken)
+	}
+	return connect.NewResponse(resp), nil
+}
+
+// GetTxStatus implements the GetTxStatus RPC method. The tx is identified by the SHA-256 hash of
+// the raw tx or, if the executor implements coreexecutor.TxResolver, by its execution layer hash.
+func (s *StoreServer) GetTxStatus(
+	ctx context.Context,
+	req *connect.Request[pb.GetTxStatusRequest],
+) (*connect.Response[pb.GetTxStatusResponse], error) {
+	if len(req.Msg.TxHash) != sha256.Size {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("tx hash must be %d bytes, got %d", sha256.Size, len(req.Msg.TxHash)))
+	}
+
+	hash, resolved := req.Msg.TxHash, false
+	status := func() (*pb.GetTxStatusResponse, error) {
+		resp, err := s.txStatus(ctx, hash)
+		if err != nil || resp.Status != pb.TxStatus_TX_STATUS_UNKNOWN || resolved {
+			return resp, err
+		}
+		// the hash may be the execution layer hash of the tx, which the executor may not know yet
+		tx, err := s.resolveTx(ctx, hash)
+		if err != nil || tx == nil {
+			return resp, err
+		}
+		txHash := sha256.Sum256(tx)
+		hash, resolved = txHash[:], true
+		return s.txStatus(ctx, hash)
+	}
+
+	resp, err := status()
+	if err != nil {
+		return nil, err
+	}
+	if req.Msg.WaitForInclusion == nil || resp.Status == pb.TxStatus_TX_STATUS_INCLUDED {
+		return connect.NewResponse(resp), nil
+	}
+
+	// wait for the tx to be included, returning its last status on timeout. Polls only read the tx
+	// index, as the entries of blocks saved since it stores the index do not need the block data.
+	wait := min(req.Msg.WaitForInclusion.AsDuration(), maxTxInclusionWait)
+	waitCtx, cancel := context.WithTimeout(ctx, wait)
+	defer cancel()
+	ticker := time.NewTicker(txInclusionPollInterval)
+	defer ticker.Stop()
+	for resp.Status != pb.TxStatus_TX_STATUS_INCLUDED {
+		select {
+		case <-waitCtx.Done():
+			if ctx.Err() != nil {
+				return nil, connect.NewError(connect.CodeCanceled, ctx.Err())
+			}
+			return connect.NewResponse(resp), nil
+		case <-ticker.C:
+		}
+		if resp, err = status(); err != nil {
+			return nil, err
+		}
+	}
+
+	return connect.NewResponse(resp), nil
+}
+
+// resolveTx returns the raw tx with the given execution layer hash, nil if the executor does not
+// implement coreexecutor.TxResolver or does not know the tx.
+func (s *StoreServer) resolveTx(ctx context.Context, hash []byte) ([]byte, error) {
+	if s.exec == nil {
+		return nil, nil
+	}
+	resolver, ok := s.exec.GetExecutor().(coreexecutor.TxResolver)
+	if !ok {
+		return nil, nil
+	}
+	tx, err := resolver.ResolveTx(ctx, hash)
+	if errors.Is(err, coreexecutor.ErrTxNotFound) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, connect.NewError(connect.CodeUnavailable, fmt.Errorf("failed to resolve tx from the executor: %w", err))
+	}
+	return tx, nil
+}
+
+// daIncludedHeight returns the height up to which the blocks are included on DA, 0 if none is.
+func (s *StoreServer) daIncludedHeight(ctx context.Context) (uint64, error) {
+	daIncluded, err := s.store.GetMetadata(ctx, store.DAIncludedHeightKey)
+	if errors.Is(err, ds.ErrNotFound) || (err == nil && len(daIncluded) != 8) {
+		return 0, nil
+	}
+	if err != nil {
+		return 0, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to get DA included height: %w", err))
+	}
+	return binary.LittleEndian.Uint64(daIncluded), nil
+}
+
+// txStatus returns the status of the tx with the given hash.
+func (s *StoreServer) txStatus(ctx context.Context, hash []byte) (*pb.GetTxStatusResponse, error) {
+	resp := &pb.GetTxStatusResponse{}
+	location, err := store.GetTxLocation(ctx, s.store, hash)
+	switch {
+	case err == nil:
+		resp.Status = pb.TxStatus_TX_STATUS_INCLUDED
+		resp.Height = location.Height
+		resp.Index, resp.HasIndex = location.Index, true
+		resp.DataDaHeight = s.daHeight(ctx, resp.Height, "d")
+		daIncluded, err := s.daIncludedHeight(ctx)
+		if err != nil {
+			return nil, err
+		}
+		resp.DaIncluded = resp.Height <= daIncluded
+	case !errors.Is(err, ds.ErrNotFound):
+		return nil, s.blockError(0, fmt.Errorf("failed to get tx index: %w", err))
+	case s.submitted != nil:
+		submitted, err := s.submitted.IsTxSubmitted(ctx, hex.EncodeToString(hash))
+		if err != nil {
+			return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to get submitted txs: %w", err))
+		}
+		if submitted {
+			resp.Status = pb.TxStatus_TX_STATUS_PENDING
+		}
+	}
+
+	return resp, nil
+}
+
+// GetBlockByTxHash implements the GetBlockByTxHash RPC method
+func (s *StoreServer) GetBlockByTxHash(
+	ctx context.Context,
+	req *connect.Request[pb.GetBlockByTxHashRequest],
+) (*connect.Response[pb.GetBlockByTxHashResponse], error) {
+	header, data, index, err := s.blockByTxHash(ctx, req.Msg.TxHash)
+	if err != nil {
+		return nil, err
+	}
+	pbHeader, err := header.ToProto()
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to convert block header to proto format: %w", err))
+	}
+
+	return connect.NewResponse(&pb.GetBlockByTxHashResponse{
+		Block:          &pb.Block{Header: pbHeader, Data: data.ToProto()},
+		HeaderDaHeight: s.daHeight(ctx, header.Height(), "h"),
+		DataDaHeight:   s.daHeight(ctx, header.Height(), "d"),
+		Index:          index,
+	}), nil
+}
+
+// GetTxProof implements the GetTxProof RPC method
+func (s *StoreServer) GetTxProof(
+	ctx context.Context,
+	req *connect.Request[pb.GetTxProofRequest],
+) (*connect.Response[pb.GetTxProofResponse], error) {
+	header, data, index, err := s.blockByTxHash(ctx, req.Msg.TxHash)
+	if err != nil {
+		return nil, err
+	}
+	pbHeader, err := header.ToProto()
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to convert block header to proto format: %w", err))
+	}
+
+	proof, err := types.NewTxProof(data, index)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	txs := make([][]byte, len(proof.Txs))
+	for i, tx := range proof.Txs {
+		txs[i] = tx
+	}
+	return connect.NewResponse(&pb.GetTxProofResponse{Header: pbHeader, Txs: txs, Index: proof.Index}), nil
+}
+
+// blockByTxHash returns the latest block including the tx with the given hash, and the index of
+// the tx in its data, from the tx index of the store.
+func (s *StoreServer) blockByTxHash(ctx context.Context, hash []byte) (*types.SignedHeader, *types.Data, uint32, error) {
+	if len(hash) != sha256.Size {
+		return nil, nil, 0, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("tx hash must be %d bytes, got %d", sha256.Size, len(hash)))
+	}
+	location, err := store.GetTxLocation(ctx, s.store, hash)
+	if errors.Is(err, ds.ErrNotFound) {
+		return nil, nil, 0, connect.NewError(connect.CodeNotFound, fmt.Errorf("tx %x is not indexed: %w", hash, err))
+	} else if err != nil {
+		return nil, nil, 0, s.blockError(0, fmt.Errorf("failed to get tx index: %w", err))
+	}
+	header, data, err := s.blockByHeight(ctx, location.Height)
+	if err != nil {
+		return nil, nil, 0, err
+	}
+	if int(location.Index) >= len(data.Txs) {
+		return nil, nil, 0, newError(connect.CodeDataLoss, pb.ErrorReason_ERROR_REASON_STORE_CORRUPTED, location.Height,
+			fmt.Errorf("tx index %d out of range of the %d txs of block %d", location.Index, len(data.Txs), location.Height))
+	}
+	return header, data, location.Index, nil
+}
+
+// GetSyncStatus implements the GetSyncStatus RPC method
+func (s *StoreServer) GetSyncStatus(
+	ctx context.Context,
+	req *connect.Request[emptypb.Empty],
+) (*connect.Response[pb.GetSyncStatusResponse], error) {
+	if s.syncStatus == nil {
+		return nil, connect.NewError(connect.CodeUnimplemented, errors.New("node does not report its sync status"))
+	}
+
+	height, err := s.store.Height(ctx)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to get height: %w", err))
+	}
+	prunedBaseHeight, err := store.PrunedBaseHeight(ctx, s.store)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	status := s.syncStatus.SyncStatus()
+
+	return connect.NewResponse(&pb.GetSyncStatusResponse{
+		Height:           height,
+		NetworkHeight:    status.NetworkHeight,
+		DaHeight:         status.DAHeight,
+		DaIncludedHeight: status.DAIncludedHeight,
+		Syncing:          height < status.NetworkHeight,
+		HeadersBySource:  status.HeadersBySource,
+		DataBySource:     status.DataBySource,
+		PrunedBaseHeight: prunedBaseHeight,
+	}), nil
+}
+
 type ConfigServer struct {
 	config config.Config
 	logger zerolog.Logger
+
+	info NodeInfo
+	// types of the execution and DA clients of the node
+	executionClient string
+	daBackend       string
+}
+
+// NodeInfo describes the software and chain of the node, as reported by GetNodeInfo.
+type NodeInfo struct {
+	Version   string
+	GitCommit string
+	ChainID   string
+	StartTime time.Time
+	// Build is the provenance of the node binary
+	Build *pb.BuildInfo
 }
 
 func NewConfigServer(config config.Config, logger zerolog.Logger) *ConfigServer {
@@ -191,6 +1019,102 @@ func (cs *ConfigServer) GetNamespace(
 	}), nil
 }
 
+// GetNodeInfo implements the GetNodeInfo RPC method
+func (cs *ConfigServer) GetNodeInfo(
+	ctx context.Context,
+	req *connect.Request[emptypb.Empty],
+) (*connect.Response[pb.GetNodeInfoResponse], error) {
+	mode := "full"
+	switch {
+	case cs.config.Node.Light:
+		mode = "light"
+	case cs.config.Node.Aggregator:
+		mode = "aggregator"
+	}
+
+	resp := &pb.GetNodeInfoResponse{
+		Version:         cs.info.Version,
+		GitCommit:       cs.info.GitCommit,
+		ChainId:         cs.info.ChainID,
+		Mode:            mode,
+		ExecutionClient: cs.executionClient,
+		DaBackend:       cs.daBackend,
+		BuildInfo:       cs.info.Build,
+	}
+	if !cs.info.StartTime.IsZero() {
+		resp.StartTime = timestamppb.New(cs.info.StartTime)
+	}
+	return connect.NewResponse(resp), nil
+}
+
+// GetGenesis implements the GetGenesis RPC method. The document is served as loaded by the node,
+// so that fields unknown to this version are kept.
+func (cs *ConfigServer) GetGenesis(
+	ctx context.Context,
+	req *connect.Request[emptypb.Empty],
+) (*connect.Response[pb.GetGenesisResponse], error) {
+	genesisJSON, err := os.ReadFile(genesis.GenesisPath(cs.config.RootDir))
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return nil, connect.NewError(connect.CodeNotFound, errors.New("node has no genesis file"))
+		}
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to read genesis: %w", err))
+	}
+	var doc genesis.Genesis
+	if err := json.Unmarshal(genesisJSON, &doc); err != nil {
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to decode genesis: %w", err))
+	}
+
+	hash := sha256.Sum256(genesisJSON)
+	return connect.NewResponse(&pb.GetGenesisResponse{
+		Genesis: genesisJSON,
+		ChainId: doc.ChainID,
+		Hash:    hash[:],
+	}), nil
+}
+
+// ComponentNamer is implemented by the execution and DA clients of the node to report a stable
+// name, e.g. "evm" or "jsonrpc", in GetNodeInfo and GetDAInfo.
+type ComponentNamer interface {
+	ComponentName() string
+}
+
+// unknownComponent is the name reported for components not implementing ComponentNamer.
+const unknownComponent = "unknown"
+
+// componentType returns the name of a component of the node, or an empty string if the node has
+// none.
+func componentType(component any) string {
+	if component == nil {
+		return ""
+	}
+	if v := reflect.ValueOf(component); v.Kind() == reflect.Pointer && v.IsNil() {
+		return ""
+	}
+	if namer, ok := component.(ComponentNamer); ok {
+		return namer.ComponentName()
+	}
+	return unknownComponent
+}
+
+// ValidateConfig implements the ValidateConfig RPC method
+func (cs *ConfigServer) ValidateConfig(
+	ctx context.Context,
+	req *connect.Request[pb.ValidateConfigRequest],
+) (*connect.Response[pb.ValidateConfigResponse], error) {
+	errs := config.ValidateYAML(req.Msg.Config)
+
+	resp := &pb.ValidateConfigResponse{
+		Valid:  len(errs) == 0,
+		Errors: make([]string, len(errs)),
+	}
+	for i, err := range errs {
+		resp.Errors[i] = err.Error()
+	}
+
+	return connect.NewResponse(resp), nil
+}
+
 // P2PServer implements the P2PService defined in the proto file
 type P2PServer struct {
 	// Add dependencies needed for P2P functionality
@@ -204,28 +1128,54 @@ func NewP2PServer(peerManager p2p.P2PRPC) *P2PServer {
 	}
 }
 
-// GetPeerInfo implements the GetPeerInfo RPC method
+// GetPeerInfo implements the GetPeerInfo RPC method. Peers are ordered by ID, and the page token
+// is the ID of the last peer of the previous page.
 func (p *P2PServer) GetPeerInfo(
 	ctx context.Context,
-	req *connect.Request[emptypb.Empty],
+	req *connect.Request[pb.GetPeerInfoRequest],
 ) (*connect.Response[pb.GetPeerInfoResponse], error) {
+	limit, err := pageLimit(req.Msg.Page, defaultPeerInfoLimit, maxPeerInfoLimit)
+	if err != nil {
+		return nil, err
+	}
+
 	peers, err := p.peerManager.GetPeers()
 	if err != nil {
-		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to get peer info: %w", err))
+		return nil, newError(connect.CodeUnavailable, pb.ErrorReason_ERROR_REASON_P2P_UNAVAILABLE, 0, fmt.Errorf("failed to get peer info: %w", err))
 	}
+	slices.SortFunc(peers, func(a, b peer.AddrInfo) int { return strings.Compare(a.ID.String(), b.ID.String()) })
 
-	// Convert to protobuf format
-	pbPeers := make([]*pb.PeerInfo, len(peers))
-	for i, peer := range peers {
-		pbPeers[i] = &pb.PeerInfo{
-			Id:      peer.ID.String(),
-			Address: peer.String(),
+	detailsProvider, _ := p.peerManager.(p2p.PeerDetailsProvider)
+	now := time.Now()
+	var matching []*pb.PeerInfo
+	for _, addrInfo := range peers {
+		pbPeer := &pb.PeerInfo{
+			Id:      addrInfo.ID.String(),
+			Address: addrInfo.String(),
 		}
+		if detailsProvider != nil {
+			details := detailsProvider.PeerDetails(addrInfo.ID)
+			if details.Connected {
+				pbPeer.Direction = pb.PeerDirection_PEER_DIRECTION_INBOUND
+				if details.Outbound {
+					pbPeer.Direction = pb.PeerDirection_PEER_DIRECTION_OUTBOUND
+				}
+				pbPeer.ConnectionAge = durationpb.New(now.Sub(details.ConnectedAt))
+			}
+			if !details.LastSeen.IsZero() {
+				pbPeer.LastSeen = timestamppb.New(details.LastSeen)
+			}
+			pbPeer.ProtocolVersion = details.ProtocolVersion
+		}
+		if req.Msg.Direction != pb.PeerDirection_PEER_DIRECTION_UNSPECIFIED && pbPeer.Direction != req.Msg.Direction {
+			continue
+		}
+		matching = append(matching, pbPeer)
 	}
 
-	return connect.NewResponse(&pb.GetPeerInfoResponse{
-		Peers: pbPeers,
-	}), nil
+	// the token of a page is the ID of the last peer of the previous page
+	pagePeers, pageResp := paginate(matching, (*pb.PeerInfo).GetId, req.Msg.Page.GetPageToken(), limit, func(id string) string { return id })
+	return connect.NewResponse(&pb.GetPeerInfoResponse{Peers: pagePeers, Page: pageResp}), nil
 }
 
 // GetNetInfo implements the GetNetInfo RPC method
@@ -235,7 +1185,7 @@ func (p *P2PServer) GetNetInfo(
 ) (*connect.Response[pb.GetNetInfoResponse], error) {
 	netInfo, err := p.peerManager.GetNetworkInfo()
 	if err != nil {
-		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to get network info: %w", err))
+		return nil, newError(connect.CodeUnavailable, pb.ErrorReason_ERROR_REASON_P2P_UNAVAILABLE, 0, fmt.Errorf("failed to get network info: %w", err))
 	}
 
 	pbNetInfo := &pb.NetInfo{
@@ -248,12 +1198,28 @@ func (p *P2PServer) GetNetInfo(
 	}), nil
 }
 
+// AlertProvider provides the states of the alert rules evaluated by the node
+type AlertProvider interface {
+	Alerts() []alert.State
+}
+
+// ErrorProvider provides the most recent errors of the subsystems of the node
+type ErrorProvider interface {
+	Entries() []errlog.Entry
+}
+
 // HealthServer implements the HealthService defined in the proto file
-type HealthServer struct{}
+type HealthServer struct {
+	alerts AlertProvider
+	errors ErrorProvider
+	checks []ReadinessCheck
+}
 
-// NewHealthServer creates a new HealthServer instance
-func NewHealthServer() *HealthServer {
-	return &HealthServer{}
+// NewHealthServer creates a new HealthServer instance.
+// alerts may be nil if the node does not evaluate alert rules.
+// checks are run by Readyz; the node is always ready if there are none.
+func NewHealthServer(alerts AlertProvider, checks ...ReadinessCheck) *HealthServer {
+	return &HealthServer{alerts: alerts, checks: checks}
 }
 
 // Livez implements the HealthService.Livez RPC
@@ -267,49 +1233,227 @@ func (h *HealthServer) Livez(
 	}), nil
 }
 
-// NewServiceHandler creates a new HTTP handler for Store, P2P and Health services
-func NewServiceHandler(store store.Store, peerManager p2p.P2PRPC, logger zerolog.Logger, config config.Config) (http.Handler, error) {
+// GetAlerts implements the HealthService.GetAlerts RPC
+func (h *HealthServer) GetAlerts(
+	ctx context.Context,
+	req *connect.Request[emptypb.Empty],
+) (*connect.Response[pb.GetAlertsResponse], error) {
+	resp := &pb.GetAlertsResponse{}
+	if h.alerts == nil {
+		return connect.NewResponse(resp), nil
+	}
+
+	for _, a := range h.alerts.Alerts() {
+		pbAlert := &pb.Alert{
+			Name:        a.Name,
+			Description: a.Description,
+			Firing:      a.Firing,
+			Message:     a.Message,
+		}
+		if !a.Since.IsZero() {
+			pbAlert.Since = timestamppb.New(a.Since)
+		}
+		resp.Alerts = append(resp.Alerts, pbAlert)
+	}
+	return connect.NewResponse(resp), nil
+}
+
+// GetErrors implements the HealthService.GetErrors RPC
+func (h *HealthServer) GetErrors(
+	ctx context.Context,
+	req *connect.Request[emptypb.Empty],
+) (*connect.Response[pb.GetErrorsResponse], error) {
+	return connect.NewResponse(&pb.GetErrorsResponse{Errors: h.subsystemErrors()}), nil
+}
+
+// subsystemErrors returns the most recent errors of the subsystems.
+func (h *HealthServer) subsystemErrors() []*pb.SubsystemError {
+	if h.errors == nil {
+		return nil
+	}
+	entries := h.errors.Entries()
+	errs := make([]*pb.SubsystemError, len(entries))
+	for i, e := range entries {
+		errs[i] = &pb.SubsystemError{
+			Subsystem: e.Subsystem,
+			Message:   e.Message,
+			Count:     e.Count,
+			FirstSeen: timestamppb.New(e.FirstSeen),
+			LastSeen:  timestamppb.New(e.LastSeen),
+		}
+	}
+	return errs
+}
+
+// ServiceOptions are the optional components of the node served by NewServiceHandler. The RPCs
+// depending on a nil component are unimplemented or report nothing, as documented on its field.
+type ServiceOptions struct {
+	// Executor is the executor of the node. The Fee service is only registered when it is set.
+	Executor ExecutorProvider
+	// DA is the DA layer of the node. GetDAInclusionProof and GetDAInfo are unimplemented if it
+	// is nil.
+	DA coreda.DA
+	// Alerts are the alert rules evaluated by the node. GetAlerts returns no alerts if it is nil.
+	Alerts AlertProvider
+	// Errors are the recent errors of the node. GetErrors and Readyz report no errors if it is nil.
+	Errors ErrorProvider
+	// SubmittedTxs are the transactions submitted by the node. GetTxStatus never reports pending
+	// transactions if it is nil.
+	SubmittedTxs SubmittedTxs
+	// SyncStatus is the sync progress of the node. GetSyncStatus is unimplemented if it is nil.
+	SyncStatus SyncStatusProvider
+	// Previews are the preview blocks of the network. SubscribePreviewBlocks is unimplemented if it
+	// is nil.
+	Previews PreviewSource
+	// Admin administers the node. The Admin service is only registered when it is set and
+	// authentication is configured.
+	Admin NodeAdmin
+	// Info describes the node in GetNodeInfo.
+	Info NodeInfo
+	// ReadinessChecks are run by Readyz, in addition to the checks of the store and of the DA
+	// layer.
+	ReadinessChecks []ReadinessCheck
+}
+
+// NewServiceHandler creates a new HTTP handler for Store, P2P and Health services, and for the
+// services of the optional components of the node in opts.
+func NewServiceHandler(store store.Store, peerManager p2p.P2PRPC, logger zerolog.Logger, config config.Config, opts ServiceOptions) (http.Handler, error) {
 	storeServer := NewStoreServer(store, logger)
+	storeServer.submitted = opts.SubmittedTxs
+	storeServer.syncStatus = opts.SyncStatus
+	storeServer.previews = opts.Previews
+	storeServer.da = opts.DA
+	storeServer.daNamespaces = daNamespaces(config.DA)
+	storeServer.daConfig = config.DA
+	storeServer.exec = opts.Executor
+	if opts.Executor != nil {
+		if _, ok := opts.Executor.GetExecutor().(coreexecutor.BlockInfoProvider); ok {
+			storeServer.blockInfo = executorBlockInfo{exec: opts.Executor}
+		}
+	}
 	p2pServer := NewP2PServer(peerManager)
-	healthServer := NewHealthServer()
+	readinessChecks := []ReadinessCheck{StoreReadinessCheck(store)}
+	if opts.DA != nil {
+		readinessChecks = append(readinessChecks, DAReadinessCheck(opts.DA))
+	}
+	healthServer := NewHealthServer(opts.Alerts, append(readinessChecks, opts.ReadinessChecks...)...)
+	healthServer.errors = opts.Errors
 	configServer := NewConfigServer(config, logger)
+	configServer.info = opts.Info
+	if opts.Executor != nil {
+		configServer.executionClient = componentType(opts.Executor.GetExecutor())
+	}
+	configServer.daBackend = componentType(opts.DA)
+
+	authOpts, err := AuthOptionsFromConfig(config.RPC)
+	if err != nil {
+		return nil, err
+	}
+	metrics := NewMetrics(metricsNamespace(config), store, peerManager, logger)
+	handlerOpts := []connect.HandlerOption{connect.WithInterceptors(metrics.Interceptor())}
+	if authOpts != nil {
+		handlerOpts = append(handlerOpts, connect.WithInterceptors(NewAuthInterceptor(*authOpts, logger)))
+	}
 
 	mux := http.NewServeMux()
 
 	compress1KB := connect.WithCompressMinBytes(1024)
-	reflector := grpcreflect.NewStaticReflector(
+	services := []string{
 		rpc.StoreServiceName,
 		rpc.P2PServiceName,
 		rpc.HealthServiceName,
 		rpc.ConfigServiceName,
-	)
+	}
+	if opts.Executor != nil {
+		services = append(services, rpc.FeeServiceName)
+	}
+	// the admin service is only served behind authentication
+	serveAdmin := opts.Admin != nil && authOpts != nil
+	if serveAdmin {
+		services = append(services, rpc.AdminServiceName)
+	}
+	reflector := grpcreflect.NewStaticReflector(services...)
 	mux.Handle(grpcreflect.NewHandlerV1(reflector, compress1KB))
 	mux.Handle(grpcreflect.NewHandlerV1Alpha(reflector, compress1KB))
 
 	// Register StoreService
-	storePath, storeHandler := rpc.NewStoreServiceHandler(storeServer)
+	storePath, storeHandler := rpc.NewStoreServiceHandler(storeServer, handlerOpts...)
 	mux.Handle(storePath, storeHandler)
 
 	// Register P2PService
-	p2pPath, p2pHandler := rpc.NewP2PServiceHandler(p2pServer)
+	p2pPath, p2pHandler := rpc.NewP2PServiceHandler(p2pServer, handlerOpts...)
 	mux.Handle(p2pPath, p2pHandler)
 
 	// Register HealthService
-	healthPath, healthHandler := rpc.NewHealthServiceHandler(healthServer)
+	healthPath, healthHandler := rpc.NewHealthServiceHandler(healthServer, handlerOpts...)
 	mux.Handle(healthPath, healthHandler)
 
-	configPath, configHandler := rpc.NewConfigServiceHandler(configServer)
+	configPath, configHandler := rpc.NewConfigServiceHandler(configServer, handlerOpts...)
 	mux.Handle(configPath, configHandler)
 
+	// Register FeeService
+	if opts.Executor != nil {
+		feePath, feeHandler := rpc.NewFeeServiceHandler(NewFeeServer(opts.Executor, opts.DA, logger), handlerOpts...)
+		mux.Handle(feePath, feeHandler)
+	}
+
+	// Register AdminService
+	if serveAdmin {
+		adminServer := NewAdminServer(opts.Admin, logger)
+		if config.RPC.DrainTimeout.Duration > 0 {
+			adminServer.drainTimeout = config.RPC.DrainTimeout.Duration
+		}
+		adminPath, adminHandler := rpc.NewAdminServiceHandler(adminServer, handlerOpts...)
+		mux.Handle(adminPath, adminHandler)
+	}
+
 	// Register custom HTTP endpoints
-	RegisterCustomHTTPEndpoints(mux)
-
-	// Use h2c to support HTTP/2 without TLS
-	return h2c.NewHandler(mux, &http2.Server{
-		IdleTimeout:          120 * time.Second,
-		MaxReadFrameSize:     1 << 24,
-		MaxConcurrentStreams: 100,
-		ReadIdleTimeout:      30 * time.Second,
-		PingTimeout:          15 * time.Second,
-	}), nil
+	RegisterCustomHTTPEndpoints(mux, metrics)
+	RegisterReadinessEndpoint(mux, healthServer)
+	corsOpts := CORSOptionsFromConfig(config.RPC)
+	RegisterWebSocketEndpoint(mux, store, corsOpts, logger)
+	RegisterGatewayEndpoints(mux, mux)
+	spec, err := NewOpenAPISpec(services, authOpts)
+	if err != nil {
+		return nil, fmt.Errorf("failed to generate OpenAPI document: %w", err)
+	}
+	RegisterOpenAPIEndpoints(mux, spec)
+
+	var handler http.Handler = mux
+	if authOpts != nil {
+		handler = NewAuthHTTPHandler(handler, *authOpts, logger)
+	}
+	if corsOpts != nil {
+		handler = NewCORSHandler(handler, *corsOpts)
+	}
+	return newH2CHandler(handler), nil
+}
+
+// h2cHandler is a handler wrapped by newH2CHandler.
+type h2cHandler struct {
+	http.Handler
+	next http.Handler
+}
+
+// newH2CHandler uses h2c to support HTTP/2 without TLS.
+func newH2CHandler(h http.Handler) http.Handler {
+	return &h2cHandler{
+		Handler: h2c.NewHandler(h, &http2.Server{
+			IdleTimeout:          120 * time.Second,
+			MaxReadFrameSize:     1 << 24,
+			MaxConcurrentStreams: 100,
+			ReadIdleTimeout:      30 * time.Second,
+			PingTimeout:          15 * time.Second,
+		}),
+		next: h,
+	}
+}
+
+// withoutH2C returns the handler wrapped by newH2CHandler, if any, so that wrapping handlers only
+// support h2c once, at the outermost handler, which sees each HTTP/2 stream as a request.
+func withoutH2C(h http.Handler) http.Handler {
+	if wrapped, ok := h.(*h2cHandler); ok {
+		return wrapped.next
+	}
+	return h
 }
@@ -2,11 +2,19 @@ package server
 
 import (
 	"context"
+	"crypto/sha256"
 	"encoding/binary"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
 	"net/http"
 	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
 	"testing"
 	"time"
 
@@ -15,11 +23,21 @@ import (
 	"github.com/libp2p/go-libp2p/core/peer"
 	"github.com/multiformats/go-multiaddr"
 	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/durationpb"
 	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
 
+	coreda "github.com/evstack/ev-node/core/da"
+	coreexecutor "github.com/evstack/ev-node/core/execution"
+	"github.com/evstack/ev-node/pkg/alert"
 	"github.com/evstack/ev-node/pkg/config"
+	"github.com/evstack/ev-node/pkg/errlog"
+	"github.com/evstack/ev-node/pkg/genesis"
+	"github.com/evstack/ev-node/pkg/journal"
 	"github.com/evstack/ev-node/pkg/p2p"
 	"github.com/evstack/ev-node/pkg/store"
 	"github.com/evstack/ev-node/test/mocks"
@@ -172,6 +190,66 @@ func TestGetBlock_Latest(t *testing.T) {
 	mockStore.AssertExpectations(t)
 }
 
+func TestGetHeader(t *testing.T) {
+	mockStore := mocks.NewMockStore(t)
+	server := NewStoreServer(mockStore, zerolog.Nop())
+
+	latestHeight := uint64(20)
+	header := &types.SignedHeader{Header: types.Header{BaseHeader: types.BaseHeader{Height: latestHeight}}}
+	headerDAHeightBytes := make([]byte, 8)
+	binary.LittleEndian.PutUint64(headerDAHeightBytes, 200)
+
+	mockStore.On("Height", mock.Anything).Return(latestHeight, nil).Once()
+	mockStore.On("GetHeader", mock.Anything, latestHeight).Return(header, nil).Once()
+	mockStore.On("GetMetadata", mock.Anything, fmt.Sprintf("%s/%d/h", store.HeightToDAHeightKey, latestHeight)).Return(headerDAHeightBytes, nil).Once()
+	mockStore.On("GetHeader", mock.Anything, uint64(21)).Return(nil, fmt.Errorf("load block header: %w", ds.ErrNotFound)).Once()
+
+	// height 0 returns the latest header, without reading the block data
+	resp, err := server.GetHeader(context.Background(), connect.NewRequest(&pb.GetHeaderRequest{}))
+	require.NoError(t, err)
+	require.Equal(t, latestHeight, resp.Msg.Header.Header.Height)
+	require.Equal(t, uint64(200), resp.Msg.HeaderDaHeight)
+
+	_, err = server.GetHeader(context.Background(), connect.NewRequest(&pb.GetHeaderRequest{Height: 21}))
+	require.Error(t, err)
+	require.Equal(t, connect.CodeNotFound, connect.CodeOf(err))
+	mockStore.AssertExpectations(t)
+	mockStore.AssertNotCalled(t, "GetBlockData", mock.Anything, mock.Anything)
+}
+
+func TestGetHeaderRange(t *testing.T) {
+	mockStore := mocks.NewMockStore(t)
+	server := NewStoreServer(mockStore, zerolog.Nop())
+
+	mockStore.On("Height", mock.Anything).Return(uint64(4), nil).Once()
+	daIncluded := make([]byte, 8)
+	binary.LittleEndian.PutUint64(daIncluded, 3)
+	mockStore.On("GetMetadata", mock.Anything, store.DAIncludedHeightKey).Return(daIncluded, nil).Once()
+	for height := uint64(2); height <= 4; height++ {
+		header := &types.SignedHeader{Header: types.Header{BaseHeader: types.BaseHeader{Height: height}}}
+		mockStore.On("GetHeader", mock.Anything, height).Return(header, nil).Once()
+	}
+
+	// heights above the latest block are ignored
+	resp, err := server.GetHeaderRange(context.Background(), connect.NewRequest(&pb.GetHeaderRangeRequest{FromHeight: 2, ToHeight: 10}))
+	require.NoError(t, err)
+	require.Len(t, resp.Msg.Headers, 3)
+	for i, header := range resp.Msg.Headers {
+		require.Equal(t, uint64(i+2), header.Header.Height)
+	}
+	require.Equal(t, uint64(3), resp.Msg.DaIncludedHeight)
+
+	for _, req := range []*pb.GetHeaderRangeRequest{
+		{FromHeight: 0, ToHeight: 1},
+		{FromHeight: 5, ToHeight: 4},
+		{FromHeight: 1, ToHeight: maxHeaderRange + 1},
+	} {
+		_, err = server.GetHeaderRange(context.Background(), connect.NewRequest(req))
+		require.Equal(t, connect.CodeInvalidArgument, connect.CodeOf(err))
+	}
+	mockStore.AssertExpectations(t)
+}
+
 func TestGetState(t *testing.T) {
 	// Create a mock store
 	mockStore := mocks.NewMockStore(t)
@@ -260,13 +338,552 @@ func TestGetMetadata_Error(t *testing.T) {
 	require.Nil(t, resp)
 }
 
+func TestGetMetadataBatch(t *testing.T) {
+	mockStore := mocks.NewMockStore(t)
+	mockStore.On("GetMetadata", mock.Anything, "a").Return([]byte("1"), nil)
+	mockStore.On("GetMetadata", mock.Anything, "missing").Return(nil, ds.ErrNotFound)
+	mockStore.On("GetMetadata", mock.Anything, "bad").Return(nil, fmt.Errorf("meta error"))
+	server := NewStoreServer(mockStore, zerolog.Nop())
+
+	resp, err := server.GetMetadataBatch(context.Background(), connect.NewRequest(&pb.GetMetadataBatchRequest{Keys: []string{"missing", "a"}}))
+	require.NoError(t, err)
+	require.Len(t, resp.Msg.Entries, 2)
+	require.Equal(t, "missing", resp.Msg.Entries[0].Key)
+	require.False(t, resp.Msg.Entries[0].Found)
+	require.Equal(t, "a", resp.Msg.Entries[1].Key)
+	require.True(t, resp.Msg.Entries[1].Found)
+	require.Equal(t, []byte("1"), resp.Msg.Entries[1].Value)
+
+	_, err = server.GetMetadataBatch(context.Background(), connect.NewRequest(&pb.GetMetadataBatchRequest{Keys: []string{"a", "bad"}}))
+	require.Equal(t, connect.CodeInternal, connect.CodeOf(err))
+
+	_, err = server.GetMetadataBatch(context.Background(), connect.NewRequest(&pb.GetMetadataBatchRequest{Keys: make([]string, maxMetadataBatch+1)}))
+	require.Equal(t, connect.CodeInvalidArgument, connect.CodeOf(err))
+}
+
+func TestGetStateDiff(t *testing.T) {
+	mockStore := mocks.NewMockStore(t)
+	diff := &pb.StateDiff{
+		Height: 5,
+		Changes: []*pb.StateChange{
+			{Key: []byte("a"), Value: []byte("1")},
+			{Key: []byte("b"), Deleted: true},
+		},
+	}
+	bz, err := proto.Marshal(diff)
+	require.NoError(t, err)
+	mockStore.On("GetMetadata", mock.Anything, fmt.Sprintf("%s/%d", store.StateDiffKey, 5)).Return(bz, nil)
+	mockStore.On("GetMetadata", mock.Anything, fmt.Sprintf("%s/%d", store.StateDiffKey, 6)).Return(nil, ds.ErrNotFound)
+
+	server := NewStoreServer(mockStore, zerolog.Nop())
+
+	resp, err := server.GetStateDiff(context.Background(), connect.NewRequest(&pb.GetStateDiffRequest{Height: 5}))
+	require.NoError(t, err)
+	require.True(t, proto.Equal(diff, resp.Msg.Diff))
+
+	_, err = server.GetStateDiff(context.Background(), connect.NewRequest(&pb.GetStateDiffRequest{Height: 6}))
+	require.Error(t, err)
+	require.Equal(t, connect.CodeNotFound, connect.CodeOf(err))
+	mockStore.AssertExpectations(t)
+}
+
+func TestGetSequencerFees(t *testing.T) {
+	mockStore := mocks.NewMockStore(t)
+	fees := &pb.SequencerFees{
+		Height:           5,
+		Recipient:        []byte("coinbase"),
+		Collected:        "21000",
+		TotalCollected:   "63000",
+		RecipientBalance: "1000000",
+		Reconciled:       true,
+		Discrepancy:      "0",
+	}
+	bz, err := proto.Marshal(fees)
+	require.NoError(t, err)
+	mockStore.On("Height", mock.Anything).Return(uint64(5), nil).Once()
+	mockStore.On("GetMetadata", mock.Anything, fmt.Sprintf("%s/%d", store.SequencerFeesKey, 5)).Return(bz, nil).Twice()
+	mockStore.On("GetMetadata", mock.Anything, fmt.Sprintf("%s/%d", store.SequencerFeesKey, 6)).Return(nil, ds.ErrNotFound)
+
+	server := NewStoreServer(mockStore, zerolog.Nop())
+
+	resp, err := server.GetSequencerFees(context.Background(), connect.NewRequest(&pb.GetSequencerFeesRequest{Height: 5}))
+	require.NoError(t, err)
+	require.True(t, proto.Equal(fees, resp.Msg.Fees))
+	require.True(t, resp.Msg.Unverified)
+
+	// height 0 returns the fees of the latest block
+	resp, err = server.GetSequencerFees(context.Background(), connect.NewRequest(&pb.GetSequencerFeesRequest{}))
+	require.NoError(t, err)
+	require.True(t, proto.Equal(fees, resp.Msg.Fees))
+
+	_, err = server.GetSequencerFees(context.Background(), connect.NewRequest(&pb.GetSequencerFeesRequest{Height: 6}))
+	require.Equal(t, connect.CodeNotFound, connect.CodeOf(err))
+	mockStore.AssertExpectations(t)
+}
+
+func TestGetEvents(t *testing.T) {
+	ctx := context.Background()
+	kv, err := store.NewDefaultInMemoryKVStore()
+	require.NoError(t, err)
+	s := store.New(kv)
+
+	j := journal.New(s)
+	require.NoError(t, j.Record(ctx, journal.EventNodeStarted, "node started", nil))
+	require.NoError(t, j.Record(ctx, journal.EventDASubmissionFailed, "failed to submit data to DA", map[string]string{"error": "boom"}))
+
+	server := NewStoreServer(s, zerolog.Nop())
+
+	resp, err := server.GetEvents(ctx, connect.NewRequest(&pb.GetEventsRequest{}))
+	require.NoError(t, err)
+	require.Len(t, resp.Msg.Events, 2)
+
+	resp, err = server.GetEvents(ctx, connect.NewRequest(&pb.GetEventsRequest{Types: []string{journal.EventDASubmissionFailed}}))
+	require.NoError(t, err)
+	require.Len(t, resp.Msg.Events, 1)
+	require.Equal(t, "boom", resp.Msg.Events[0].Attributes["error"])
+
+	resp, err = server.GetEvents(ctx, connect.NewRequest(&pb.GetEventsRequest{From: timestamppb.New(time.Now().Add(time.Hour))}))
+	require.NoError(t, err)
+	require.Empty(t, resp.Msg.Events)
+}
+
+// submittedTxs is a set of submitted transaction hashes.
+type submittedTxs map[string]bool
+
+func (s submittedTxs) IsTxSubmitted(_ context.Context, txHash string) (bool, error) {
+	return s[txHash], nil
+}
+
+func TestGetTxStatus(t *testing.T) {
+	ctx := context.Background()
+	kv, err := store.NewDefaultInMemoryKVStore()
+	require.NoError(t, err)
+	s := store.New(kv)
+
+	header, data := types.GetRandomBlock(1, 2, "test-chain")
+	require.NoError(t, s.SaveBlockData(ctx, header, data, &header.Signature))
+	daHeight := make([]byte, 8)
+	binary.LittleEndian.PutUint64(daHeight, 42)
+	require.NoError(t, s.SetMetadata(ctx, fmt.Sprintf("%s/%d/d", store.HeightToDAHeightKey, 1), daHeight))
+
+	pending := sha256.Sum256([]byte("pending"))
+	server := NewStoreServer(s, zerolog.Nop())
+	server.submitted = submittedTxs{hex.EncodeToString(pending[:]): true}
+
+	status := func(hash []byte) *pb.GetTxStatusResponse {
+		t.Helper()
+		resp, err := server.GetTxStatus(ctx, connect.NewRequest(&pb.GetTxStatusRequest{TxHash: hash}))
+		require.NoError(t, err)
+		return resp.Msg
+	}
+
+	included := sha256.Sum256(data.Txs[1])
+	resp := status(included[:])
+	require.Equal(t, pb.TxStatus_TX_STATUS_INCLUDED, resp.Status)
+	require.Equal(t, uint64(1), resp.Height)
+	require.Equal(t, uint32(1), resp.Index)
+	require.True(t, resp.HasIndex)
+	require.Equal(t, uint64(42), resp.DataDaHeight)
+	require.False(t, resp.DaIncluded)
+
+	// the first tx of a block has an index of 0, which is distinguished from an unset index
+	first := sha256.Sum256(data.Txs[0])
+	resp = status(first[:])
+	require.Equal(t, uint32(0), resp.Index)
+	require.True(t, resp.HasIndex)
+
+	daIncluded := make([]byte, 8)
+	binary.LittleEndian.PutUint64(daIncluded, 1)
+	require.NoError(t, s.SetMetadata(ctx, store.DAIncludedHeightKey, daIncluded))
+	require.True(t, status(included[:]).DaIncluded)
+
+	require.Equal(t, pb.TxStatus_TX_STATUS_PENDING, status(pending[:]).Status)
+
+	unknown := sha256.Sum256([]byte("unknown"))
+	require.Equal(t, pb.TxStatus_TX_STATUS_UNKNOWN, status(unknown[:]).Status)
+	require.False(t, status(unknown[:]).HasIndex)
+
+	// the execution layer hashes of txs are resolved by executors implementing TxResolver
+	executionHash := []byte("execution-layer-hash-of-tx-00000")
+	server.exec = StaticExecutor(txResolver{Executor: coreexecutor.NewDummyExecutor(), txs: map[string][]byte{
+		string(executionHash): data.Txs[1],
+	}})
+	resp = status(executionHash)
+	require.Equal(t, pb.TxStatus_TX_STATUS_INCLUDED, resp.Status)
+	require.Equal(t, uint32(1), resp.Index)
+	require.Equal(t, pb.TxStatus_TX_STATUS_UNKNOWN, status(unknown[:]).Status)
+
+	_, err = server.GetTxStatus(ctx, connect.NewRequest(&pb.GetTxStatusRequest{TxHash: []byte("short")}))
+	require.Equal(t, connect.CodeInvalidArgument, connect.CodeOf(err))
+}
+
+// txResolver resolves the txs of an executor from a map of execution layer hashes.
+type txResolver struct {
+	coreexecutor.Executor
+	txs map[string][]byte
+}
+
+func (r txResolver) ResolveTx(_ context.Context, hash []byte) ([]byte, error) {
+	tx, ok := r.txs[string(hash)]
+	if !ok {
+		return nil, coreexecutor.ErrTxNotFound
+	}
+	return tx, nil
+}
+
+func TestGetTxStatusWaitForInclusion(t *testing.T) {
+	ctx := context.Background()
+	kv, err := store.NewDefaultInMemoryKVStore()
+	require.NoError(t, err)
+	s := store.New(kv)
+	server := NewStoreServer(s, zerolog.Nop())
+
+	header, data := types.GetRandomBlock(1, 3, "test-chain")
+	hash := sha256.Sum256(data.Txs[2])
+	waitFor := func(timeout time.Duration) *pb.GetTxStatusResponse {
+		t.Helper()
+		resp, err := server.GetTxStatus(ctx, connect.NewRequest(&pb.GetTxStatusRequest{
+			TxHash:           hash[:],
+			WaitForInclusion: durationpb.New(timeout),
+		}))
+		require.NoError(t, err)
+		return resp.Msg
+	}
+
+	// the tx is not included before the timeout
+	start := time.Now()
+	require.Equal(t, pb.TxStatus_TX_STATUS_UNKNOWN, waitFor(200*time.Millisecond).Status)
+	require.GreaterOrEqual(t, time.Since(start), 200*time.Millisecond)
+
+	go func() {
+		time.Sleep(200 * time.Millisecond)
+		assert.NoError(t, s.SaveBlockData(ctx, header, data, &header.Signature))
+	}()
+	resp := waitFor(5 * time.Second)
+	require.Equal(t, pb.TxStatus_TX_STATUS_INCLUDED, resp.Status)
+	require.Equal(t, uint64(1), resp.Height)
+	require.Equal(t, uint32(2), resp.Index)
+
+	cancelled, cancel := context.WithCancel(ctx)
+	cancel()
+	unknown := sha256.Sum256([]byte("unknown"))
+	_, err = server.GetTxStatus(cancelled, connect.NewRequest(&pb.GetTxStatusRequest{
+		TxHash:           unknown[:],
+		WaitForInclusion: durationpb.New(time.Second),
+	}))
+	require.Equal(t, connect.CodeCanceled, connect.CodeOf(err))
+}
+
+func TestGetBlockByTxHash(t *testing.T) {
+	ctx := context.Background()
+	kv, err := store.NewDefaultInMemoryKVStore()
+	require.NoError(t, err)
+	s := store.New(kv)
+	server := NewStoreServer(s, zerolog.Nop())
+
+	header, data := types.GetRandomBlock(1, 3, "test-chain")
+	require.NoError(t, s.SaveBlockData(ctx, header, data, &header.Signature))
+	daHeight := make([]byte, 8)
+	binary.LittleEndian.PutUint64(daHeight, 42)
+	require.NoError(t, s.SetMetadata(ctx, fmt.Sprintf("%s/%d/h", store.HeightToDAHeightKey, 1), daHeight))
+
+	hash := sha256.Sum256(data.Txs[2])
+	resp, err := server.GetBlockByTxHash(ctx, connect.NewRequest(&pb.GetBlockByTxHashRequest{TxHash: hash[:]}))
+	require.NoError(t, err)
+	require.Equal(t, uint64(1), resp.Msg.Block.Header.Header.Height)
+	require.Equal(t, uint32(2), resp.Msg.Index)
+	require.Equal(t, data.Txs[2], types.Tx(resp.Msg.Block.Data.Txs[resp.Msg.Index]))
+	require.Equal(t, uint64(42), resp.Msg.HeaderDaHeight)
+
+	unknown := sha256.Sum256([]byte("unknown"))
+	_, err = server.GetBlockByTxHash(ctx, connect.NewRequest(&pb.GetBlockByTxHashRequest{TxHash: unknown[:]}))
+	require.Equal(t, connect.CodeNotFound, connect.CodeOf(err))
+	_, err = server.GetBlockByTxHash(ctx, connect.NewRequest(&pb.GetBlockByTxHashRequest{TxHash: []byte("short")}))
+	require.Equal(t, connect.CodeInvalidArgument, connect.CodeOf(err))
+}
+
+func TestGetTxProof(t *testing.T) {
+	ctx := context.Background()
+	kv, err := store.NewDefaultInMemoryKVStore()
+	require.NoError(t, err)
+	s := store.New(kv)
+	server := NewStoreServer(s, zerolog.Nop())
+
+	header, data := types.GetRandomBlock(1, 3, "test-chain")
+	require.NoError(t, s.SaveBlockData(ctx, header, data, &header.Signature))
+
+	hash := sha256.Sum256(data.Txs[1])
+	resp, err := server.GetTxProof(ctx, connect.NewRequest(&pb.GetTxProofRequest{TxHash: hash[:]}))
+	require.NoError(t, err)
+	require.Equal(t, uint32(1), resp.Msg.Index)
+
+	var proven types.SignedHeader
+	require.NoError(t, proven.FromProto(resp.Msg.Header))
+	require.Equal(t, header.Hash(), proven.Hash())
+	proof := types.TxProof{Index: resp.Msg.Index}
+	for _, tx := range resp.Msg.Txs {
+		proof.Txs = append(proof.Txs, tx)
+	}
+	require.NoError(t, proof.Verify(proven.DataHash, hash[:]))
+
+	unknown := sha256.Sum256([]byte("unknown"))
+	_, err = server.GetTxProof(ctx, connect.NewRequest(&pb.GetTxProofRequest{TxHash: unknown[:]}))
+	require.Equal(t, connect.CodeNotFound, connect.CodeOf(err))
+}
+
+func TestGetSyncStatus(t *testing.T) {
+	ctx := context.Background()
+	kv, err := store.NewDefaultInMemoryKVStore()
+	require.NoError(t, err)
+	s := store.New(kv)
+	require.NoError(t, s.SetHeight(ctx, 10))
+	server := NewStoreServer(s, zerolog.Nop())
+
+	_, err = server.GetSyncStatus(ctx, connect.NewRequest(&emptypb.Empty{}))
+	require.Equal(t, connect.CodeUnimplemented, connect.CodeOf(err))
+
+	server.syncStatus = staticSyncStatus{
+		NetworkHeight:    25,
+		DAHeight:         100,
+		DAIncludedHeight: 8,
+		HeadersBySource:  map[string]uint64{"p2p": 10},
+		DataBySource:     map[string]uint64{"p2p": 6, "empty": 4},
+	}
+	resp, err := server.GetSyncStatus(ctx, connect.NewRequest(&emptypb.Empty{}))
+	require.NoError(t, err)
+	require.Equal(t, uint64(10), resp.Msg.Height)
+	require.Equal(t, uint64(25), resp.Msg.NetworkHeight)
+	require.Equal(t, uint64(100), resp.Msg.DaHeight)
+	require.Equal(t, uint64(8), resp.Msg.DaIncludedHeight)
+	require.True(t, resp.Msg.Syncing)
+	require.Equal(t, map[string]uint64{"p2p": 10}, resp.Msg.HeadersBySource)
+	require.Equal(t, map[string]uint64{"p2p": 6, "empty": 4}, resp.Msg.DataBySource)
+	require.Zero(t, resp.Msg.PrunedBaseHeight)
+
+	// caught up with the network, and pruned
+	require.NoError(t, s.SetHeight(ctx, 25))
+	require.NoError(t, s.SetMetadata(ctx, store.PrunedBaseHeightKey, binary.LittleEndian.AppendUint64(nil, 7)))
+	resp, err = server.GetSyncStatus(ctx, connect.NewRequest(&emptypb.Empty{}))
+	require.NoError(t, err)
+	require.False(t, resp.Msg.Syncing)
+	require.Equal(t, uint64(7), resp.Msg.PrunedBaseHeight)
+}
+
+func TestStoreServer_SearchBlocks(t *testing.T) {
+	ctx := context.Background()
+	kv, err := store.NewDefaultInMemoryKVStore()
+	require.NoError(t, err)
+	s := store.New(kv)
+	start := time.Unix(1_700_000_000, 0)
+	for height := uint64(1); height <= 4; height++ {
+		header, data := types.GetRandomBlock(height, int(height%2), "test-chain")
+		header.BaseHeader.Time = uint64(start.Add(time.Duration(height) * time.Second).UnixNano())
+		require.NoError(t, s.SaveBlockData(ctx, header, data, &header.Signature))
+		require.NoError(t, s.SetHeight(ctx, height))
+	}
+	server := NewStoreServer(s, zerolog.Nop())
+
+	maxTxCount := uint64(0)
+	resp, err := server.SearchBlocks(ctx, connect.NewRequest(&pb.SearchBlocksRequest{MaxTxCount: &maxTxCount}))
+	require.NoError(t, err)
+	require.Len(t, resp.Msg.Blocks, 2)
+	require.Equal(t, uint64(2), resp.Msg.Blocks[0].Height)
+	require.Equal(t, uint64(4), resp.Msg.Blocks[1].Height)
+	require.Empty(t, resp.Msg.Page.NextPageToken)
+
+	resp, err = server.SearchBlocks(ctx, connect.NewRequest(&pb.SearchBlocksRequest{
+		MinTxCount: 1,
+		StartTime:  timestamppb.New(start.Add(2 * time.Second)),
+		Page:       &pb.PageRequest{Limit: 1},
+	}))
+	require.NoError(t, err)
+	require.Len(t, resp.Msg.Blocks, 1)
+	require.Equal(t, uint64(3), resp.Msg.Blocks[0].Height)
+	require.Equal(t, uint64(1), resp.Msg.Blocks[0].TxCount)
+	require.True(t, start.Add(3*time.Second).Equal(resp.Msg.Blocks[0].Time.AsTime()))
+	// no other block has transactions, so the search is complete
+	require.Empty(t, resp.Msg.Page.NextPageToken)
+
+	// paged search
+	var heights []uint64
+	page := &pb.PageRequest{Limit: 1}
+	for {
+		resp, err = server.SearchBlocks(ctx, connect.NewRequest(&pb.SearchBlocksRequest{MinTxCount: 1, Page: page}))
+		require.NoError(t, err)
+		require.Nil(t, resp.Msg.Page.Total)
+		for _, block := range resp.Msg.Blocks {
+			heights = append(heights, block.Height)
+		}
+		if resp.Msg.Page.NextPageToken == "" {
+			break
+		}
+		page.PageToken = resp.Msg.Page.NextPageToken
+	}
+	require.Equal(t, []uint64{1, 3}, heights)
+
+	_, err = server.SearchBlocks(ctx, connect.NewRequest(&pb.SearchBlocksRequest{Page: &pb.PageRequest{Limit: maxSearchBlocksLimit + 1}}))
+	require.Equal(t, connect.CodeInvalidArgument, connect.CodeOf(err))
+
+	_, err = NewStoreServer(mocks.NewMockStore(t), zerolog.Nop()).SearchBlocks(ctx, connect.NewRequest(&pb.SearchBlocksRequest{}))
+	require.Equal(t, connect.CodeUnimplemented, connect.CodeOf(err))
+}
+
+func TestConfigServer_ValidateConfig(t *testing.T) {
+	server := NewConfigServer(config.DefaultConfig, zerolog.Nop())
+
+	resp, err := server.ValidateConfig(context.Background(), connect.NewRequest(&pb.ValidateConfigRequest{
+		Config: []byte("da:\n  gas_price: 0.1\n"),
+	}))
+	require.NoError(t, err)
+	require.True(t, resp.Msg.Valid)
+	require.Empty(t, resp.Msg.Errors)
+
+	resp, err = server.ValidateConfig(context.Background(), connect.NewRequest(&pb.ValidateConfigRequest{
+		Config: []byte("da:\n  gas_price: high\n  unknown: 1\n"),
+	}))
+	require.NoError(t, err)
+	require.False(t, resp.Msg.Valid)
+	require.Len(t, resp.Msg.Errors, 2)
+}
+
+func TestConfigServer_GetNodeInfo(t *testing.T) {
+	startTime := time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC)
+	cfg := config.DefaultConfig
+	cfg.Node.Aggregator = true
+	server := NewConfigServer(cfg, zerolog.Nop())
+	build := &pb.BuildInfo{GoVersion: "go1.24.0", Digest: "digest"}
+	server.info = NodeInfo{Version: "v1.2.3", GitCommit: "abcdef", ChainID: "test-chain", StartTime: startTime, Build: build}
+	server.executionClient = componentType(&mocks.MockExecutor{})
+	server.daBackend = componentType((*mocks.MockDA)(nil))
+
+	resp, err := server.GetNodeInfo(context.Background(), connect.NewRequest(&emptypb.Empty{}))
+	require.NoError(t, err)
+	require.Equal(t, "v1.2.3", resp.Msg.Version)
+	require.Equal(t, "abcdef", resp.Msg.GitCommit)
+	require.Equal(t, "test-chain", resp.Msg.ChainId)
+	require.Equal(t, "aggregator", resp.Msg.Mode)
+	require.Equal(t, "unknown", resp.Msg.ExecutionClient)
+	require.Empty(t, resp.Msg.DaBackend)
+	require.Equal(t, "dummy", componentType(coreexecutor.NewDummyExecutor()))
+	require.Equal(t, startTime, resp.Msg.StartTime.AsTime())
+	require.True(t, proto.Equal(build, resp.Msg.BuildInfo))
+
+	cfg.Node.Aggregator = false
+	cfg.Node.Light = true
+	server.config = cfg
+	resp, err = server.GetNodeInfo(context.Background(), connect.NewRequest(&emptypb.Empty{}))
+	require.NoError(t, err)
+	require.Equal(t, "light", resp.Msg.Mode)
+}
+
+func TestConfigServer_GetGenesis(t *testing.T) {
+	cfg := config.DefaultConfig
+	cfg.RootDir = t.TempDir()
+	server := NewConfigServer(cfg, zerolog.Nop())
+
+	_, err := server.GetGenesis(context.Background(), connect.NewRequest(&emptypb.Empty{}))
+	require.Equal(t, connect.CodeNotFound, connect.CodeOf(err))
+
+	genesisPath := genesis.GenesisPath(cfg.RootDir)
+	require.NoError(t, os.MkdirAll(filepath.Dir(genesisPath), 0o750))
+	require.NoError(t, genesis.NewGenesis("test-chain", 1, time.Now(), []byte("proposer")).Save(genesisPath))
+	genesisJSON, err := os.ReadFile(genesisPath)
+	require.NoError(t, err)
+
+	resp, err := server.GetGenesis(context.Background(), connect.NewRequest(&emptypb.Empty{}))
+	require.NoError(t, err)
+	require.Equal(t, genesisJSON, resp.Msg.Genesis)
+	require.Equal(t, "test-chain", resp.Msg.ChainId)
+	hash := sha256.Sum256(genesisJSON)
+	require.Equal(t, hash[:], resp.Msg.Hash)
+}
+
+// detailedP2P describes the connections to peers whose IDs end with "in" or "out", and reports the
+// others as disconnected since lastSeen.
+type detailedP2P struct {
+	*mocks.MockP2PRPC
+	connectedAt time.Time
+	lastSeen    time.Time
+}
+
+func (p detailedP2P) PeerDetails(id peer.ID) p2p.PeerDetails {
+	switch {
+	case strings.HasSuffix(string(id), "in"), strings.HasSuffix(string(id), "out"):
+		return p2p.PeerDetails{
+			Connected:       true,
+			Outbound:        strings.HasSuffix(string(id), "out"),
+			ConnectedAt:     p.connectedAt,
+			LastSeen:        time.Now(),
+			ProtocolVersion: "/evnode/1.0.0",
+		}
+	}
+	return p2p.PeerDetails{LastSeen: p.lastSeen}
+}
+
+func TestP2PServer_GetPeerInfo_Pagination(t *testing.T) {
+	var peers []peer.AddrInfo
+	for _, id := range []string{"peer1-in", "peer2-out", "peer3", "peer4-out", "peer5-in"} {
+		peers = append(peers, peer.AddrInfo{ID: peer.ID(id)})
+	}
+	slices.Reverse(peers)
+	mockP2P := &mocks.MockP2PRPC{}
+	mockP2P.On("GetPeers").Return(peers, nil)
+	lastSeen := time.Now().Add(-time.Hour).Truncate(time.Second)
+	server := NewP2PServer(detailedP2P{MockP2PRPC: mockP2P, connectedAt: time.Now().Add(-time.Minute), lastSeen: lastSeen})
+	ctx := context.Background()
+
+	// all the pages, ordered by ID
+	var ids []string
+	req := &pb.GetPeerInfoRequest{Page: &pb.PageRequest{Limit: 2}}
+	for {
+		resp, err := server.GetPeerInfo(ctx, connect.NewRequest(req))
+		require.NoError(t, err)
+		require.Equal(t, uint64(5), resp.Msg.Page.GetTotal())
+		require.LessOrEqual(t, len(resp.Msg.Peers), 2)
+		for _, p := range resp.Msg.Peers {
+			ids = append(ids, p.Id)
+		}
+		if resp.Msg.Page.NextPageToken == "" {
+			break
+		}
+		req.Page.PageToken = resp.Msg.Page.NextPageToken
+	}
+	require.Len(t, ids, 5)
+	require.True(t, slices.IsSorted(ids))
+
+	// filtered by direction
+	resp, err := server.GetPeerInfo(ctx, connect.NewRequest(&pb.GetPeerInfoRequest{Direction: pb.PeerDirection_PEER_DIRECTION_OUTBOUND}))
+	require.NoError(t, err)
+	require.Equal(t, uint64(2), resp.Msg.Page.GetTotal())
+	require.Empty(t, resp.Msg.Page.NextPageToken)
+	for _, p := range resp.Msg.Peers {
+		require.Equal(t, pb.PeerDirection_PEER_DIRECTION_OUTBOUND, p.Direction)
+		require.GreaterOrEqual(t, p.ConnectionAge.AsDuration(), time.Minute)
+		require.Equal(t, "/evnode/1.0.0", p.ProtocolVersion)
+		require.NotNil(t, p.LastSeen)
+	}
+
+	// disconnected peer
+	resp, err = server.GetPeerInfo(ctx, connect.NewRequest(&pb.GetPeerInfoRequest{}))
+	require.NoError(t, err)
+	idx := slices.IndexFunc(resp.Msg.Peers, func(p *pb.PeerInfo) bool { return p.Id == peer.ID("peer3").String() })
+	require.GreaterOrEqual(t, idx, 0)
+	disconnected := resp.Msg.Peers[idx]
+	require.Equal(t, pb.PeerDirection_PEER_DIRECTION_UNSPECIFIED, disconnected.Direction)
+	require.Nil(t, disconnected.ConnectionAge)
+	require.Equal(t, lastSeen, disconnected.LastSeen.AsTime().Local())
+
+	_, err = server.GetPeerInfo(ctx, connect.NewRequest(&pb.GetPeerInfoRequest{Page: &pb.PageRequest{Limit: maxPeerInfoLimit + 1}}))
+	require.Equal(t, connect.CodeInvalidArgument, connect.CodeOf(err))
+}
+
 func TestP2PServer_GetPeerInfo(t *testing.T) {
 	mockP2P := &mocks.MockP2PRPC{}
 	addr, err := multiaddr.NewMultiaddr("/ip4/127.0.0.1/tcp/4001")
 	require.NoError(t, err)
 	mockP2P.On("GetPeers").Return([]peer.AddrInfo{{ID: "id1", Addrs: []multiaddr.Multiaddr{addr}}}, nil)
 	server := NewP2PServer(mockP2P)
-	resp, err := server.GetPeerInfo(context.Background(), connect.NewRequest(&emptypb.Empty{}))
+	resp, err := server.GetPeerInfo(context.Background(), connect.NewRequest(&pb.GetPeerInfoRequest{}))
 	require.NoError(t, err)
 	require.Len(t, resp.Msg.Peers, 1)
 	mockP2P.AssertExpectations(t)
@@ -275,7 +892,7 @@ func TestP2PServer_GetPeerInfo(t *testing.T) {
 	mockP2P2 := &mocks.MockP2PRPC{}
 	mockP2P2.On("GetPeers").Return(nil, fmt.Errorf("p2p error"))
 	server2 := NewP2PServer(mockP2P2)
-	resp2, err2 := server2.GetPeerInfo(context.Background(), connect.NewRequest(&emptypb.Empty{}))
+	resp2, err2 := server2.GetPeerInfo(context.Background(), connect.NewRequest(&pb.GetPeerInfoRequest{}))
 	require.Error(t, err2)
 	require.Nil(t, resp2)
 }
@@ -300,12 +917,173 @@ func TestP2PServer_GetNetInfo(t *testing.T) {
 }
 
 func TestHealthServer_Livez(t *testing.T) {
-	h := NewHealthServer()
+	h := NewHealthServer(nil)
 	resp, err := h.Livez(context.Background(), connect.NewRequest(&emptypb.Empty{}))
 	require.NoError(t, err)
 	require.Equal(t, pb.HealthStatus_PASS, resp.Msg.Status)
 }
 
+type staticAlerts []alert.State
+
+func (a staticAlerts) Alerts() []alert.State { return a }
+
+func TestHealthServer_GetAlerts(t *testing.T) {
+	since := time.Unix(1700000000, 0)
+	h := NewHealthServer(staticAlerts{
+		{Name: alert.RuleDABacklog, Description: "backlog", Firing: true, Message: "200 pending", Since: since},
+		{Name: alert.RuleNoPeers, Description: "peers"},
+	})
+	resp, err := h.GetAlerts(context.Background(), connect.NewRequest(&emptypb.Empty{}))
+	require.NoError(t, err)
+	require.Len(t, resp.Msg.Alerts, 2)
+
+	require.Equal(t, alert.RuleDABacklog, resp.Msg.Alerts[0].Name)
+	require.True(t, resp.Msg.Alerts[0].Firing)
+	require.Equal(t, "200 pending", resp.Msg.Alerts[0].Message)
+	require.True(t, since.Equal(resp.Msg.Alerts[0].Since.AsTime()))
+	// rules not evaluated yet have no transition time
+	require.False(t, resp.Msg.Alerts[1].Firing)
+	require.Nil(t, resp.Msg.Alerts[1].Since)
+
+	// nodes without alert rules return no alerts
+	resp, err = NewHealthServer(nil).GetAlerts(context.Background(), connect.NewRequest(&emptypb.Empty{}))
+	require.NoError(t, err)
+	require.Empty(t, resp.Msg.Alerts)
+}
+
+func TestHealthServer_GetErrors(t *testing.T) {
+	// nodes without an error registry report no errors
+	resp, err := NewHealthServer(nil).GetErrors(context.Background(), connect.NewRequest(&emptypb.Empty{}))
+	require.NoError(t, err)
+	require.Empty(t, resp.Msg.Errors)
+
+	errs := errlog.NewRegistry()
+	errs.Record("HeaderSyncService", "failed to sync")
+	errs.Record("BlockManager", "failed to submit headers")
+	errs.Record("BlockManager", "failed to submit data")
+	h := NewHealthServer(nil, ReadinessCheck{Name: "store", Critical: true, Check: func(context.Context) error { return nil }})
+	h.errors = errs
+
+	resp, err = h.GetErrors(context.Background(), connect.NewRequest(&emptypb.Empty{}))
+	require.NoError(t, err)
+	require.Len(t, resp.Msg.Errors, 2)
+	require.Equal(t, "BlockManager", resp.Msg.Errors[0].Subsystem)
+	require.Equal(t, "failed to submit data", resp.Msg.Errors[0].Message)
+	require.Equal(t, uint64(2), resp.Msg.Errors[0].Count)
+	require.False(t, resp.Msg.Errors[0].LastSeen.AsTime().Before(resp.Msg.Errors[0].FirstSeen.AsTime()))
+	require.Equal(t, "HeaderSyncService", resp.Msg.Errors[1].Subsystem)
+
+	// the errors are reported by Readyz without changing the status
+	ready, err := h.Readyz(context.Background(), connect.NewRequest(&emptypb.Empty{}))
+	require.NoError(t, err)
+	require.Equal(t, pb.HealthStatus_PASS, ready.Msg.Status)
+	require.Len(t, ready.Msg.Errors, 2)
+}
+
+func TestHealthServer_Readyz(t *testing.T) {
+	passing := func(context.Context) error { return nil }
+	failing := func(context.Context) error { return errors.New("unavailable") }
+
+	// nodes without readiness checks are always ready
+	resp, err := NewHealthServer(nil).Readyz(context.Background(), connect.NewRequest(&emptypb.Empty{}))
+	require.NoError(t, err)
+	require.Equal(t, pb.HealthStatus_PASS, resp.Msg.Status)
+	require.Empty(t, resp.Msg.Checks)
+
+	// a failed non-critical check reports the node as degraded
+	h := NewHealthServer(nil,
+		ReadinessCheck{Name: "store", Critical: true, Check: passing},
+		ReadinessCheck{Name: "da", Check: failing},
+	)
+	resp, err = h.Readyz(context.Background(), connect.NewRequest(&emptypb.Empty{}))
+	require.NoError(t, err)
+	require.Equal(t, pb.HealthStatus_WARN, resp.Msg.Status)
+	require.Len(t, resp.Msg.Checks, 2)
+	require.Equal(t, "store", resp.Msg.Checks[0].Name)
+	require.Equal(t, pb.HealthStatus_PASS, resp.Msg.Checks[0].Status)
+	require.Empty(t, resp.Msg.Checks[0].Message)
+	require.Equal(t, "da", resp.Msg.Checks[1].Name)
+	require.Equal(t, pb.HealthStatus_WARN, resp.Msg.Checks[1].Status)
+	require.Equal(t, "unavailable", resp.Msg.Checks[1].Message)
+
+	// a failed critical check reports the node as not ready
+	h = NewHealthServer(nil,
+		ReadinessCheck{Name: "store", Critical: true, Check: failing},
+		ReadinessCheck{Name: "da", Check: failing},
+	)
+	resp, err = h.Readyz(context.Background(), connect.NewRequest(&emptypb.Empty{}))
+	require.NoError(t, err)
+	require.Equal(t, pb.HealthStatus_FAIL, resp.Msg.Status)
+	require.Equal(t, pb.HealthStatus_FAIL, resp.Msg.Checks[0].Status)
+
+	// checks are bound by the context of the probe
+	h = NewHealthServer(nil, ReadinessCheck{Name: "signer", Critical: true, Check: func(ctx context.Context) error {
+		<-ctx.Done()
+		return ctx.Err()
+	}})
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	resp, err = h.Readyz(ctx, connect.NewRequest(&emptypb.Empty{}))
+	require.NoError(t, err)
+	require.Equal(t, pb.HealthStatus_FAIL, resp.Msg.Status)
+	require.Equal(t, context.Canceled.Error(), resp.Msg.Checks[0].Message)
+}
+
+func TestHealthReadyEndpoint(t *testing.T) {
+	mockStore := mocks.NewMockStore(t)
+	mockStore.On("Height", mock.Anything).Return(uint64(5), nil)
+	mockDA := &daReadinessStub{err: errors.New("connection refused")}
+
+	ready := true
+	handler, err := NewServiceHandler(mockStore, &mocks.MockP2PRPC{}, zerolog.Nop(), config.DefaultConfig, ServiceOptions{
+		DA: mockDA,
+		ReadinessChecks: []ReadinessCheck{{Name: "p2p", Critical: true, Check: func(context.Context) error {
+			if !ready {
+				return errors.New("P2P client not listening")
+			}
+			return nil
+		}}},
+	})
+	require.NoError(t, err)
+	server := httptest.NewServer(handler)
+	defer server.Close()
+
+	get := func() (int, map[string]any) {
+		resp, err := http.Get(server.URL + "/health/ready")
+		require.NoError(t, err)
+		defer resp.Body.Close()
+		require.Equal(t, "application/json", resp.Header.Get("Content-Type"))
+		var body map[string]any
+		require.NoError(t, json.NewDecoder(resp.Body).Decode(&body))
+		return resp.StatusCode, body
+	}
+
+	// an unreachable DA layer degrades the node, which stays ready
+	status, body := get()
+	require.Equal(t, http.StatusOK, status)
+	require.Equal(t, "WARN", body["status"])
+	checks := body["checks"].([]any)
+	require.Len(t, checks, 3)
+	require.Equal(t, map[string]any{"name": "store", "status": "PASS"}, checks[0])
+	require.Equal(t, "da", checks[1].(map[string]any)["name"])
+	require.Equal(t, "WARN", checks[1].(map[string]any)["status"])
+	require.Contains(t, checks[1].(map[string]any)["message"], "connection refused")
+	require.Equal(t, map[string]any{"name": "p2p", "status": "PASS"}, checks[2])
+
+	ready = false
+	status, body = get()
+	require.Equal(t, http.StatusServiceUnavailable, status)
+	require.Equal(t, "FAIL", body["status"])
+}
+
+// daReadinessStub is a DA layer whose GasPrice returns err.
+type daReadinessStub struct {
+	coreda.DA
+	err error
+}
+
+func (d *daReadinessStub) GasPrice(context.Context) (float64, error) { return 0, d.err }
+
 func TestHealthLiveEndpoint(t *testing.T) {
 	assert := require.New(t)
 
@@ -316,7 +1094,7 @@ func TestHealthLiveEndpoint(t *testing.T) {
 	// Create the service handler
 	logger := zerolog.Nop()
 	testConfig := config.DefaultConfig
-	handler, err := NewServiceHandler(mockStore, mockP2PManager, logger, testConfig)
+	handler, err := NewServiceHandler(mockStore, mockP2PManager, logger, testConfig, ServiceOptions{})
 	assert.NoError(err)
 	assert.NotNil(handler)
 
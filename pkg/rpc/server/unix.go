@@ -0,0 +1,42 @@
+package server
+
+import (
+	"errors"
+	"fmt"
+	"io/fs"
+	"net"
+	"os"
+	"path/filepath"
+)
+
+// unixSocketMode restricts the RPC unix socket to the user and group of the node.
+const unixSocketMode = 0o660
+
+// ListenUnix listens on the unix socket at path, so that co-located sidecars can call the RPC
+// server without a network port. A stale socket left by a previous run is replaced, but any other
+// file at path is an error. The socket is removed when the listener is closed.
+func ListenUnix(path string) (net.Listener, error) {
+	if info, err := os.Lstat(path); err == nil {
+		if info.Mode().Type() != fs.ModeSocket {
+			return nil, fmt.Errorf("RPC unix socket path %s exists and is not a socket", path)
+		}
+		if err := os.Remove(path); err != nil {
+			return nil, fmt.Errorf("failed to remove stale RPC unix socket: %w", err)
+		}
+	} else if !errors.Is(err, fs.ErrNotExist) {
+		return nil, fmt.Errorf("failed to stat RPC unix socket: %w", err)
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0o750); err != nil {
+		return nil, fmt.Errorf("failed to create RPC unix socket directory: %w", err)
+	}
+
+	listener, err := net.Listen("unix", path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to listen on RPC unix socket: %w", err)
+	}
+	if err := os.Chmod(path, unixSocketMode); err != nil {
+		_ = listener.Close()
+		return nil, fmt.Errorf("failed to set RPC unix socket permissions: %w", err)
+	}
+	return listener, nil
+}
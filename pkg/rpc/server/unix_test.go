@@ -0,0 +1,45 @@
+package server
+
+import (
+	"net"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestListenUnix(t *testing.T) {
+	dir := t.TempDir()
+	socket := filepath.Join(dir, "run", "rpc.sock")
+
+	listener, err := ListenUnix(socket)
+	require.NoError(t, err)
+	info, err := os.Stat(socket)
+	require.NoError(t, err)
+	assert.Equal(t, os.FileMode(unixSocketMode), info.Mode().Perm())
+	conn, err := net.Dial("unix", socket)
+	require.NoError(t, err)
+	require.NoError(t, conn.Close())
+
+	// the socket is removed on close
+	require.NoError(t, listener.Close())
+	_, err = os.Stat(socket)
+	assert.True(t, os.IsNotExist(err))
+
+	// a stale socket left by a crashed node is replaced
+	stale, err := net.Listen("unix", socket)
+	require.NoError(t, err)
+	stale.(*net.UnixListener).SetUnlinkOnClose(false)
+	require.NoError(t, stale.Close())
+	listener, err = ListenUnix(socket)
+	require.NoError(t, err)
+	require.NoError(t, listener.Close())
+
+	// other files are not
+	file := filepath.Join(dir, "file")
+	require.NoError(t, os.WriteFile(file, []byte("data"), 0o600))
+	_, err = ListenUnix(file)
+	require.ErrorContains(t, err, "is not a socket")
+}
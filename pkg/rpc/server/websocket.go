@@ -0,0 +1,306 @@
+package server
+
+import (
+	"context"
+	"encoding/binary"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/gorilla/websocket"
+	ds "github.com/ipfs/go-datastore"
+	"github.com/rs/zerolog"
+
+	"github.com/evstack/ev-node/pkg/journal"
+	"github.com/evstack/ev-node/pkg/store"
+)
+
+// Types of the events pushed by the /websocket endpoint.
+const (
+	WebSocketEventNewBlock         = "new_block"
+	WebSocketEventDAIncluded       = "da_included"
+	WebSocketEventPeerConnected    = journal.EventPeerConnected
+	WebSocketEventPeerDisconnected = journal.EventPeerDisconnected
+)
+
+const (
+	// webSocketPollInterval is the interval at which the store is checked for new events
+	webSocketPollInterval = time.Second
+	// webSocketPingInterval is the interval at which connections are pinged to keep them alive
+	webSocketPingInterval = 30 * time.Second
+	// webSocketWriteTimeout is the timeout of writing a message to a connection
+	webSocketWriteTimeout = 10 * time.Second
+	// webSocketBlockCacheSize is the number of recent new_block events cached for the clients
+	webSocketBlockCacheSize = 256
+)
+
+// WebSocketEvent is an event pushed as JSON by the /websocket endpoint.
+type WebSocketEvent struct {
+	Type string    `json:"type"`
+	Time time.Time `json:"time"`
+	// Height is the height of the block, for new_block and da_included events
+	Height uint64 `json:"height,omitempty"`
+	// Hash is the hex encoded hash of the block, for new_block events
+	Hash string `json:"hash,omitempty"`
+	// NumTxs is the number of transactions of the block, for new_block events
+	NumTxs int `json:"num_txs,omitempty"`
+	// Attributes are the attributes of peer events, e.g. the peer ID and address
+	Attributes map[string]string `json:"attributes,omitempty"`
+}
+
+// eventStream serves the /websocket endpoint, pushing to every client the blocks, DA
+// inclusions and peer events recorded in the store after it connected.
+//
+// Clients can restrict the pushed events with the events query parameter, a comma separated
+// list of event types, e.g. /websocket?events=new_block,da_included.
+type eventStream struct {
+	store    store.Store
+	journal  *journal.Journal
+	upgrader websocket.Upgrader
+	interval time.Duration
+	logger   zerolog.Logger
+
+	// blocks caches the new_block events by height, so that every block is read once for all
+	// the clients
+	mu     sync.Mutex
+	blocks map[uint64]WebSocketEvent
+}
+
+// newEventStream creates the handler of the /websocket endpoint. Connections from browsers are
+// only accepted from the origin of the node, or from the origins allowed by cors if not nil.
+func newEventStream(s store.Store, cors *CORSOptions, logger zerolog.Logger) *eventStream {
+	return &eventStream{
+		store:   s,
+		journal: journal.New(s),
+		upgrader: websocket.Upgrader{
+			CheckOrigin: func(r *http.Request) bool {
+				return sameOrigin(r) || (cors != nil && cors.allows(r.Header.Get("Origin")))
+			},
+		},
+		interval: webSocketPollInterval,
+		logger:   logger,
+		blocks:   make(map[uint64]WebSocketEvent),
+	}
+}
+
+// sameOrigin returns whether a request has no Origin header, as requests of clients other than
+// browsers, or comes from the origin of the node.
+func sameOrigin(r *http.Request) bool {
+	origin := r.Header.Get("Origin")
+	if origin == "" {
+		return true
+	}
+	u, err := url.Parse(origin)
+	return err == nil && strings.EqualFold(u.Host, r.Host)
+}
+
+// eventCursor is the position of a client in the event sources.
+type eventCursor struct {
+	height      uint64
+	daIncluded  uint64
+	journalLast uint64
+}
+
+func (e *eventStream) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	var types []string
+	if events := r.URL.Query().Get("events"); events != "" {
+		types = strings.Split(events, ",")
+	}
+
+	ctx := r.Context()
+	cursor, err := e.cursor(ctx)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	conn, err := e.upgrader.Upgrade(w, r, nil)
+	if err != nil {
+		// the upgrader already replied to the client
+		return
+	}
+	defer conn.Close()
+
+	// the read loop processes control messages and detects the client closing the connection
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	go func() {
+		defer cancel()
+		for {
+			if _, _, err := conn.ReadMessage(); err != nil {
+				return
+			}
+		}
+	}()
+
+	poll := time.NewTicker(e.interval)
+	defer poll.Stop()
+	ping := time.NewTicker(webSocketPingInterval)
+	defer ping.Stop()
+	draining := Draining(r.Context())
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-draining:
+			_ = conn.WriteControl(websocket.CloseMessage, websocket.FormatCloseMessage(websocket.CloseGoingAway, "node is shutting down"), time.Now().Add(webSocketWriteTimeout))
+			return
+		case <-ping.C:
+			if err := conn.WriteControl(websocket.PingMessage, nil, time.Now().Add(webSocketWriteTimeout)); err != nil {
+				return
+			}
+		case <-poll.C:
+			// the events read before a failure are still pushed, as the cursor moved past them
+			events, err := e.poll(ctx, &cursor, types)
+			if err != nil {
+				e.logger.Warn().Err(err).Msg("failed to read events for websocket client")
+			}
+			for _, event := range events {
+				_ = conn.SetWriteDeadline(time.Now().Add(webSocketWriteTimeout))
+				if err := conn.WriteJSON(event); err != nil {
+					return
+				}
+			}
+		}
+	}
+}
+
+// cursor returns the current position in the event sources, so that a client only receives
+// the events occurring after it connected.
+func (e *eventStream) cursor(ctx context.Context) (eventCursor, error) {
+	var cursor eventCursor
+	var err error
+	if cursor.height, err = e.store.Height(ctx); err != nil {
+		return cursor, err
+	}
+	if cursor.daIncluded, err = e.daIncludedHeight(ctx); err != nil {
+		return cursor, err
+	}
+	if cursor.journalLast, err = e.journal.LastSequence(ctx); err != nil {
+		return cursor, err
+	}
+	return cursor, nil
+}
+
+// poll returns the events of the given types, or all events if types is empty, that occurred
+// after cursor, and advances it.
+func (e *eventStream) poll(ctx context.Context, cursor *eventCursor, types []string) ([]WebSocketEvent, error) {
+	wants := func(eventType string) bool {
+		return len(types) == 0 || slices.Contains(types, eventType)
+	}
+
+	var events []WebSocketEvent
+	height, err := e.store.Height(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for ; cursor.height < height; cursor.height++ {
+		if !wants(WebSocketEventNewBlock) {
+			continue
+		}
+		event, err := e.blockEvent(ctx, cursor.height+1)
+		if err != nil {
+			return events, err
+		}
+		events = append(events, event)
+	}
+
+	daIncluded, err := e.daIncludedHeight(ctx)
+	if err != nil {
+		return events, err
+	}
+	for ; cursor.daIncluded < daIncluded; cursor.daIncluded++ {
+		if !wants(WebSocketEventDAIncluded) {
+			continue
+		}
+		includedAt, err := e.daIncludedTime(ctx, cursor.daIncluded+1)
+		if err != nil {
+			return events, err
+		}
+		events = append(events, WebSocketEvent{
+			Type:   WebSocketEventDAIncluded,
+			Time:   includedAt.UTC(),
+			Height: cursor.daIncluded + 1,
+		})
+	}
+
+	peerEvents, last, err := e.journal.EventsAfter(ctx, cursor.journalLast, []string{WebSocketEventPeerConnected, WebSocketEventPeerDisconnected})
+	if err != nil {
+		return events, err
+	}
+	cursor.journalLast = last
+	for _, event := range peerEvents {
+		if wants(event.Type) {
+			events = append(events, WebSocketEvent{
+				Type:       event.Type,
+				Time:       event.Time.AsTime().UTC(),
+				Attributes: event.Attributes,
+			})
+		}
+	}
+	return events, nil
+}
+
+// blockEvent returns the new_block event of the block at the given height. Events are cached, so
+// that the clients polling concurrently read each block once.
+func (e *eventStream) blockEvent(ctx context.Context, height uint64) (WebSocketEvent, error) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	if event, ok := e.blocks[height]; ok {
+		return event, nil
+	}
+	header, data, err := e.store.GetBlockData(ctx, height)
+	if err != nil {
+		return WebSocketEvent{}, err
+	}
+	event := WebSocketEvent{
+		Type:   WebSocketEventNewBlock,
+		Time:   header.Time().UTC(),
+		Height: header.Height(),
+		Hash:   hex.EncodeToString(header.Hash()),
+		NumTxs: len(data.Txs),
+	}
+	e.blocks[height] = event
+	if height > webSocketBlockCacheSize {
+		delete(e.blocks, height-webSocketBlockCacheSize)
+	}
+	return event, nil
+}
+
+// daIncludedTime returns the time at which the node found the block at the given height included
+// on DA. Blocks included before the time was recorded report the current time.
+func (e *eventStream) daIncludedTime(ctx context.Context, height uint64) (time.Time, error) {
+	value, err := e.store.GetMetadata(ctx, fmt.Sprintf("%s/%d", store.DAIncludedTimeKey, height))
+	if errors.Is(err, ds.ErrNotFound) {
+		return time.Now(), nil
+	}
+	if err != nil {
+		return time.Time{}, err
+	}
+	if len(value) != 8 {
+		return time.Time{}, fmt.Errorf("invalid DA included time length: %d", len(value))
+	}
+	return time.Unix(0, int64(binary.LittleEndian.Uint64(value))), nil
+}
+
+// daIncludedHeight returns the height of the last DA included block, 0 if none is yet.
+func (e *eventStream) daIncludedHeight(ctx context.Context) (uint64, error) {
+	value, err := e.store.GetMetadata(ctx, store.DAIncludedHeightKey)
+	if errors.Is(err, ds.ErrNotFound) {
+		return 0, nil
+	}
+	if err != nil {
+		return 0, err
+	}
+	if len(value) != 8 {
+		return 0, fmt.Errorf("invalid DA included height length: %d", len(value))
+	}
+	return binary.LittleEndian.Uint64(value), nil
+}
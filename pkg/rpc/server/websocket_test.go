@@ -0,0 +1,169 @@
+package server
+
+import (
+	"context"
+	"encoding/binary"
+	"encoding/hex"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gorilla/websocket"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/evstack/ev-node/pkg/journal"
+	"github.com/evstack/ev-node/pkg/store"
+	"github.com/evstack/ev-node/types"
+)
+
+func TestWebSocketEvents(t *testing.T) {
+	ctx := context.Background()
+	kv, err := store.NewDefaultInMemoryKVStore()
+	require.NoError(t, err)
+	s := store.New(kv)
+
+	saveBlock := func(height uint64) *types.SignedHeader {
+		header, data := types.GetRandomBlock(height, 2, "test-chain")
+		require.NoError(t, s.SaveBlockData(ctx, header, data, &header.Signature))
+		require.NoError(t, s.SetHeight(ctx, height))
+		return header
+	}
+	includedAt := time.Unix(1_700_000_000, 0).UTC()
+	setDAIncluded := func(height uint64) {
+		value := make([]byte, 8)
+		binary.LittleEndian.PutUint64(value, uint64(includedAt.UnixNano()))
+		require.NoError(t, s.SetMetadata(ctx, fmt.Sprintf("%s/%d", store.DAIncludedTimeKey, height), value))
+		binary.LittleEndian.PutUint64(value, height)
+		require.NoError(t, s.SetMetadata(ctx, store.DAIncludedHeightKey, value))
+	}
+	saveBlock(1)
+	setDAIncluded(1)
+
+	stream := newEventStream(s, nil, zerolog.Nop())
+	stream.interval = 10 * time.Millisecond
+	srv := httptest.NewServer(stream)
+	defer srv.Close()
+	url := "ws" + strings.TrimPrefix(srv.URL, "http")
+
+	dial := func(query string) *websocket.Conn {
+		conn, _, err := websocket.DefaultDialer.Dial(url+query, nil)
+		require.NoError(t, err)
+		t.Cleanup(func() { _ = conn.Close() })
+		return conn
+	}
+	all := dial("")
+	peers := dial("?events=peer_connected")
+	// let the clients connect before the events occur
+	time.Sleep(50 * time.Millisecond)
+
+	header := saveBlock(2)
+	setDAIncluded(2)
+	require.NoError(t, journal.New(s).Record(ctx, journal.EventPeerConnected, "peer connected", map[string]string{"peer": "p1"}))
+
+	read := func(conn *websocket.Conn) WebSocketEvent {
+		var event WebSocketEvent
+		require.NoError(t, conn.SetReadDeadline(time.Now().Add(time.Second)))
+		require.NoError(t, conn.ReadJSON(&event))
+		return event
+	}
+
+	// only the events occurring after the client connected are pushed
+	received := map[string]WebSocketEvent{}
+	for range 3 {
+		event := read(all)
+		received[event.Type] = event
+	}
+	assert.Equal(t, uint64(2), received[WebSocketEventNewBlock].Height)
+	assert.Equal(t, hex.EncodeToString(header.Hash()), received[WebSocketEventNewBlock].Hash)
+	assert.Equal(t, 2, received[WebSocketEventNewBlock].NumTxs)
+	assert.Equal(t, uint64(2), received[WebSocketEventDAIncluded].Height)
+	assert.Equal(t, includedAt, received[WebSocketEventDAIncluded].Time)
+	assert.Equal(t, "p1", received[WebSocketEventPeerConnected].Attributes["peer"])
+
+	event := read(peers)
+	assert.Equal(t, WebSocketEventPeerConnected, event.Type)
+	assert.Equal(t, "p1", event.Attributes["peer"])
+}
+
+func TestWebSocketClosedOnDrain(t *testing.T) {
+	kv, err := store.NewDefaultInMemoryKVStore()
+	require.NoError(t, err)
+	drainer := NewDrainer(time.Second)
+	srv := httptest.NewServer(drainer.Handler(newEventStream(store.New(kv), nil, zerolog.Nop())))
+	defer srv.Close()
+
+	conn, _, err := websocket.DefaultDialer.Dial("ws"+strings.TrimPrefix(srv.URL, "http"), nil)
+	require.NoError(t, err)
+	defer conn.Close()
+
+	// the stream ends when draining starts, so that draining does not wait for it
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+	require.NoError(t, drainer.Drain(ctx))
+	_, _, err = conn.ReadMessage()
+	assert.True(t, websocket.IsCloseError(err, websocket.CloseGoingAway))
+}
+
+func TestWebSocketOrigin(t *testing.T) {
+	kv, err := store.NewDefaultInMemoryKVStore()
+	require.NoError(t, err)
+	s := store.New(kv)
+
+	dial := func(cors *CORSOptions, origin string) error {
+		srv := httptest.NewServer(newEventStream(s, cors, zerolog.Nop()))
+		defer srv.Close()
+		header := http.Header{}
+		if origin != "" {
+			header.Set("Origin", origin)
+		}
+		conn, _, err := websocket.DefaultDialer.Dial("ws"+strings.TrimPrefix(srv.URL, "http"), header)
+		if err == nil {
+			conn.Close()
+		}
+		return err
+	}
+
+	// clients other than browsers and the origin of the node are accepted
+	require.NoError(t, dial(nil, ""))
+	// other origins only if they are allowed by CORS
+	require.Error(t, dial(nil, "https://explorer.example"))
+	require.NoError(t, dial(&CORSOptions{AllowedOrigins: []string{"https://explorer.example"}}, "https://explorer.example"))
+	require.Error(t, dial(&CORSOptions{AllowedOrigins: []string{"https://explorer.example"}}, "https://evil.example"))
+	require.NoError(t, dial(&CORSOptions{AllowedOrigins: []string{"*"}}, "https://evil.example"))
+}
+
+// countingStore is a store counting the reads of blocks.
+type countingStore struct {
+	store.Store
+	reads int
+}
+
+func (s *countingStore) GetBlockData(ctx context.Context, height uint64) (*types.SignedHeader, *types.Data, error) {
+	s.reads++
+	return s.Store.GetBlockData(ctx, height)
+}
+
+func TestWebSocketBlockEventsCached(t *testing.T) {
+	ctx := context.Background()
+	kv, err := store.NewDefaultInMemoryKVStore()
+	require.NoError(t, err)
+	s := &countingStore{Store: store.New(kv)}
+	header, data := types.GetRandomBlock(1, 2, "test-chain")
+	require.NoError(t, s.SaveBlockData(ctx, header, data, &header.Signature))
+
+	stream := newEventStream(s, nil, zerolog.Nop())
+	event, err := stream.blockEvent(ctx, 1)
+	require.NoError(t, err)
+	assert.Equal(t, hex.EncodeToString(header.Hash()), event.Hash)
+
+	// the event is served from the cache to the other clients
+	cached, err := stream.blockEvent(ctx, 1)
+	require.NoError(t, err)
+	assert.Equal(t, event, cached)
+	assert.Equal(t, 1, s.reads)
+}
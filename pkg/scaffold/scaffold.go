@@ -0,0 +1,201 @@
+// Package scaffold generates the repository of a new chain built on the node: its main package
+// wiring the node with an execution client, the config and genesis, a docker-compose running the
+// DA layer and the execution client next to the node, and an end-to-end smoke test.
+package scaffold
+
+import (
+	"bytes"
+	"crypto/rand"
+	"embed"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io/fs"
+	"maps"
+	"os"
+	"path"
+	"path/filepath"
+	"regexp"
+	"slices"
+	"strings"
+	"text/template"
+)
+
+// VMs of the generated chains.
+const (
+	// VMEVM runs an EVM execution client, ev-reth, through the Engine API.
+	VMEVM = "evm"
+	// VMGRPC runs any execution client implementing the execution gRPC service.
+	VMGRPC = "grpc"
+	// VMABCI runs an ABCI application. It is not supported yet, as this repository has no ABCI
+	// execution adapter.
+	VMABCI = "abci"
+)
+
+// templateSuffix is the suffix of the templates, removed from the generated files.
+const templateSuffix = ".tmpl"
+
+//go:embed all:templates
+var templates embed.FS
+
+// nameRe matches the valid chain names: they are used as binary name, chain ID and directory.
+var nameRe = regexp.MustCompile(`^[a-z][a-z0-9-]*$`)
+
+// Options are the options of a generated chain.
+type Options struct {
+	// Name of the chain, used as binary name, default chain ID and home directory
+	Name string
+	// Module is the Go module path of the chain, the name if empty
+	Module string
+	// VM is the execution environment of the chain, VMEVM or VMGRPC
+	VM string
+	// NodeDir is the directory of the ev-node checkout the chain is built against: the chain
+	// relies on APIs newer than the published versions of ev-node, so its go.mod replaces the
+	// ev-node modules with the ones of the checkout.
+	NodeDir string
+}
+
+// templateData is the data the templates are executed with.
+type templateData struct {
+	Name   string
+	Module string
+	VM     string
+	// JWTSecret is the secret shared by the node and the EVM execution client
+	JWTSecret string
+	// NodeDir is the path of the ev-node checkout in the replace directives of go.mod, relative
+	// to the generated directory
+	NodeDir string
+}
+
+// Generate writes the repository of a new chain to dir, which must not exist or be empty, and
+// returns the paths of the files written, relative to dir.
+func Generate(dir string, opts Options) ([]string, error) {
+	data, err := newTemplateData(opts)
+	if err != nil {
+		return nil, err
+	}
+	if data.NodeDir, err = replacePath(dir, opts.NodeDir); err != nil {
+		return nil, err
+	}
+	if entries, err := os.ReadDir(dir); err == nil && len(entries) > 0 {
+		return nil, fmt.Errorf("directory %s is not empty", dir)
+	} else if err != nil && !errors.Is(err, fs.ErrNotExist) {
+		return nil, fmt.Errorf("failed to read directory %s: %w", dir, err)
+	}
+
+	files, err := render(data)
+	if err != nil {
+		return nil, err
+	}
+	written := make([]string, 0, len(files))
+	for _, name := range slices.Sorted(maps.Keys(files)) {
+		target := filepath.Join(dir, filepath.FromSlash(name))
+		if err := os.MkdirAll(filepath.Dir(target), 0o750); err != nil {
+			return written, fmt.Errorf("failed to create directory of %s: %w", name, err)
+		}
+		if err := os.WriteFile(target, files[name], fileMode(name)); err != nil {
+			return written, fmt.Errorf("failed to write %s: %w", name, err)
+		}
+		written = append(written, name)
+	}
+	return written, nil
+}
+
+// replacePath returns the path of the ev-node checkout nodeDir in a replace directive of the go.mod
+// of dir, after checking that it is the root of ev-node.
+func replacePath(dir, nodeDir string) (string, error) {
+	if nodeDir == "" {
+		return "", errors.New("the directory of an ev-node checkout is required")
+	}
+	absNode, err := filepath.Abs(nodeDir)
+	if err != nil {
+		return "", err
+	}
+	goMod, err := os.ReadFile(filepath.Join(absNode, "go.mod"))
+	if err != nil || !bytes.HasPrefix(goMod, []byte("module github.com/evstack/ev-node\n")) {
+		return "", fmt.Errorf("%s is not an ev-node checkout", nodeDir)
+	}
+	absDir, err := filepath.Abs(dir)
+	if err != nil {
+		return "", err
+	}
+	rel, err := filepath.Rel(absDir, absNode)
+	if err != nil {
+		return filepath.ToSlash(absNode), nil
+	}
+	rel = filepath.ToSlash(rel)
+	if !strings.HasPrefix(rel, "../") && rel != ".." {
+		rel = "./" + rel
+	}
+	return rel, nil
+}
+
+// newTemplateData validates the options and returns the data of the templates.
+func newTemplateData(opts Options) (templateData, error) {
+	if !nameRe.MatchString(opts.Name) {
+		return templateData{}, fmt.Errorf("invalid chain name %q: expected lowercase letters, digits and dashes, starting with a letter", opts.Name)
+	}
+	switch opts.VM {
+	case VMEVM, VMGRPC:
+	case VMABCI:
+		return templateData{}, errors.New("abci chains are not supported yet: the node has no ABCI execution adapter, use grpc with an ABCI bridge instead")
+	default:
+		return templateData{}, fmt.Errorf("unknown vm %q, expected %q or %q", opts.VM, VMEVM, VMGRPC)
+	}
+
+	data := templateData{Name: opts.Name, Module: opts.Module, VM: opts.VM}
+	if data.Module == "" {
+		data.Module = opts.Name
+	}
+	if opts.VM == VMEVM {
+		secret := make([]byte, 32)
+		if _, err := rand.Read(secret); err != nil {
+			return templateData{}, fmt.Errorf("failed to generate JWT secret: %w", err)
+		}
+		data.JWTSecret = hex.EncodeToString(secret)
+	}
+	return data, nil
+}
+
+// render executes the common templates and the ones of the VM, the latter taking precedence, and
+// returns the generated files by path.
+func render(data templateData) (map[string][]byte, error) {
+	files := make(map[string][]byte)
+	for _, root := range []string{"templates/common", "templates/" + data.VM} {
+		err := fs.WalkDir(templates, root, func(p string, d fs.DirEntry, err error) error {
+			if err != nil || d.IsDir() {
+				return err
+			}
+			src, err := templates.ReadFile(p)
+			if err != nil {
+				return err
+			}
+			tmpl, err := template.New(path.Base(p)).Parse(string(src))
+			if err != nil {
+				return fmt.Errorf("failed to parse template %s: %w", p, err)
+			}
+			var buf bytes.Buffer
+			if err := tmpl.Execute(&buf, data); err != nil {
+				return fmt.Errorf("failed to execute template %s: %w", p, err)
+			}
+			files[strings.TrimSuffix(strings.TrimPrefix(p, root+"/"), templateSuffix)] = buf.Bytes()
+			return nil
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+	return files, nil
+}
+
+// fileMode returns the mode of a generated file: scripts are executable, secrets are private.
+func fileMode(name string) os.FileMode {
+	switch {
+	case strings.HasSuffix(name, ".sh"):
+		return 0o755
+	case strings.HasPrefix(name, "jwttoken/"):
+		return 0o600
+	default:
+		return 0o644
+	}
+}
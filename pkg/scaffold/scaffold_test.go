@@ -0,0 +1,123 @@
+package scaffold
+
+import (
+	"go/format"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestGenerate(t *testing.T) {
+	t.Parallel()
+
+	for _, vm := range []string{VMEVM, VMGRPC} {
+		t.Run(vm, func(t *testing.T) {
+			t.Parallel()
+			dir := filepath.Join(t.TempDir(), "mychain")
+			files, err := Generate(dir, Options{Name: "mychain", Module: "github.com/example/mychain", VM: vm, NodeDir: "../.."})
+			require.NoError(t, err)
+			for _, name := range []string{".gitignore", "Dockerfile", "Makefile", "README.md", "docker-compose.yml", "e2e_test.go", "entrypoint.sh", "go.mod", "main.go", "run.go"} {
+				require.Contains(t, files, name)
+			}
+
+			for _, name := range files {
+				bz, err := os.ReadFile(filepath.Join(dir, name))
+				require.NoError(t, err)
+				require.NotContains(t, string(bz), "{{", name)
+				if strings.HasSuffix(name, ".go") {
+					formatted, err := format.Source(bz)
+					require.NoError(t, err, name)
+					require.Equal(t, string(formatted), string(bz), "%s is not formatted", name)
+				}
+			}
+
+			goMod, err := os.ReadFile(filepath.Join(dir, "go.mod"))
+			require.NoError(t, err)
+			require.True(t, strings.HasPrefix(string(goMod), "module github.com/example/mychain\n"))
+			nodeDir, err := filepath.Abs("../..")
+			require.NoError(t, err)
+			rel, err := filepath.Rel(dir, nodeDir)
+			require.NoError(t, err)
+			require.Contains(t, string(goMod), "github.com/evstack/ev-node/sequencers/single => "+filepath.ToSlash(rel)+"/sequencers/single\n")
+			info, err := os.Stat(filepath.Join(dir, "entrypoint.sh"))
+			require.NoError(t, err)
+			require.NotZero(t, info.Mode()&0o100)
+
+			if vm == VMEVM {
+				secret, err := os.ReadFile(filepath.Join(dir, "jwttoken", "jwt.hex"))
+				require.NoError(t, err)
+				require.Len(t, strings.TrimSpace(string(secret)), 64)
+				compose, err := os.ReadFile(filepath.Join(dir, "docker-compose.yml"))
+				require.NoError(t, err)
+				require.Contains(t, string(compose), strings.TrimSpace(string(secret)))
+				require.Contains(t, files, filepath.ToSlash(filepath.Join("chain", "genesis.json")))
+			}
+		})
+	}
+}
+
+// TestGenerateBuilds vets, thus builds, the generated chains against this checkout.
+func TestGenerateBuilds(t *testing.T) {
+	if testing.Short() {
+		t.Skip("building the generated chains is slow")
+	}
+	goBin, err := exec.LookPath("go")
+	if err != nil {
+		t.Skip("go toolchain not found")
+	}
+	t.Parallel()
+
+	// the checksums of the dependencies are the ones of the apps of the checkout
+	for vm, sums := range map[string][]string{
+		VMEVM:  {"go.sum", "execution/evm/go.sum", "apps/evm/single/go.sum"},
+		VMGRPC: {"go.sum", "execution/grpc/go.sum", "apps/grpc/single/go.sum"},
+	} {
+		t.Run(vm, func(t *testing.T) {
+			t.Parallel()
+			dir := filepath.Join(t.TempDir(), "mychain")
+			_, err := Generate(dir, Options{Name: "mychain", Module: "github.com/example/mychain", VM: vm, NodeDir: "../.."})
+			require.NoError(t, err)
+
+			var lines []string
+			for _, name := range sums {
+				bz, err := os.ReadFile(filepath.Join("../..", name))
+				require.NoError(t, err)
+				lines = append(lines, strings.Split(strings.TrimSpace(string(bz)), "\n")...)
+			}
+			slices.Sort(lines)
+			goSum := strings.Join(slices.Compact(lines), "\n") + "\n"
+			require.NoError(t, os.WriteFile(filepath.Join(dir, "go.sum"), []byte(goSum), 0o600))
+
+			cmd := exec.Command(goBin, "vet", "./...")
+			cmd.Dir = dir
+			cmd.Env = append(os.Environ(), "GOFLAGS=-mod=mod")
+			out, err := cmd.CombinedOutput()
+			require.NoError(t, err, string(out))
+		})
+	}
+}
+
+func TestGenerateErrors(t *testing.T) {
+	t.Parallel()
+
+	_, err := Generate(t.TempDir(), Options{Name: "mychain", VM: VMABCI, NodeDir: "../.."})
+	require.ErrorContains(t, err, "not supported")
+	_, err = Generate(t.TempDir(), Options{Name: "mychain", VM: "wasm", NodeDir: "../.."})
+	require.ErrorContains(t, err, "unknown vm")
+	_, err = Generate(t.TempDir(), Options{Name: "My Chain", VM: VMEVM, NodeDir: "../.."})
+	require.ErrorContains(t, err, "invalid chain name")
+	_, err = Generate(t.TempDir(), Options{Name: "mychain", VM: VMEVM})
+	require.ErrorContains(t, err, "ev-node checkout is required")
+	_, err = Generate(t.TempDir(), Options{Name: "mychain", VM: VMEVM, NodeDir: t.TempDir()})
+	require.ErrorContains(t, err, "not an ev-node checkout")
+
+	dir := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "main.go"), nil, 0o600))
+	_, err = Generate(dir, Options{Name: "mychain", VM: VMEVM, NodeDir: "../.."})
+	require.ErrorContains(t, err, "not empty")
+}
@@ -519,6 +519,24 @@ func TestImportExportPrivateKey(t *testing.T) {
 	})
 }
 
+func TestLoadPublicKey(t *testing.T) {
+	t.Parallel()
+
+	keyPath := t.TempDir()
+	signer, err := CreateFileSystemSigner(keyPath, []byte("secure-test-passphrase"))
+	require.NoError(t, err)
+	expected, err := signer.GetPublic()
+	require.NoError(t, err)
+
+	pubKey, err := LoadPublicKey(keyPath)
+	require.NoError(t, err)
+	assert.True(t, expected.Equals(pubKey))
+
+	_, err = LoadPublicKey(t.TempDir())
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "failed to read key file")
+}
+
 func TestHelperFunctions(t *testing.T) {
 	t.Run("zeroBytes", func(t *testing.T) {
 		data := []byte{1, 2, 3, 4, 5}
@@ -377,6 +377,28 @@ func (s *FileSystemSigner) loadKeys(passphrase []byte) error {
 	return nil
 }
 
+// LoadPublicKey returns the public key from the key file.
+// The public key is stored in plain text, so no passphrase is required.
+func LoadPublicKey(keyPath string) (crypto.PubKey, error) {
+	filePath := filepath.Join(keyPath, "signer.json")
+
+	jsonData, err := os.ReadFile(filePath)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read key file: %w", err)
+	}
+
+	var data keyData
+	if err := json.Unmarshal(jsonData, &data); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal key data: %w", err)
+	}
+
+	pubKey, err := crypto.UnmarshalEd25519PublicKey(data.PubKeyBytes)
+	if err != nil {
+		return nil, fmt.Errorf("failed to unmarshal public key: %w", err)
+	}
+	return pubKey, nil
+}
+
 // Sign signs a message using the private key
 func (s *FileSystemSigner) Sign(message []byte) ([]byte, error) {
 	s.mu.RLock()
@@ -0,0 +1,192 @@
+package store
+
+import (
+	"context"
+	"fmt"
+
+	ds "github.com/ipfs/go-datastore"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/evstack/ev-node/types"
+)
+
+// Batch accumulates writes to the store which are applied atomically by Commit, so that a crash
+// cannot leave a block partially written, e.g. with its header but without its data, signature
+// or state. The writes are not visible before Commit, and the batch must not be used after it.
+type Batch interface {
+	// SaveBlockData saves the block along with its seen signature, as Store.SaveBlockData.
+	SaveBlockData(ctx context.Context, header *types.SignedHeader, data *types.Data, signature *types.Signature) error
+	// SetHeight sets the height of the store if it is higher than the existing height, including
+	// a height set earlier in the batch.
+	SetHeight(ctx context.Context, height uint64) error
+	// UpdateState saves the state at the height of the store, the one set in the batch if any.
+	UpdateState(ctx context.Context, state types.State) error
+	// SetMetadata saves a metadata value, as Store.SetMetadata.
+	SetMetadata(ctx context.Context, key string, value []byte) error
+	// Commit applies all the writes of the batch atomically.
+	Commit(ctx context.Context) error
+}
+
+// defaultBatch is the Batch of a DefaultStore.
+type defaultBatch struct {
+	store *DefaultStore
+	batch ds.Batch
+
+	// refs accumulates the chunks referenced by the saved blocks, whose previous references are
+	// released on commit, when the chunk reference counts are locked
+	refs         *chunkRefs
+	savedHeights map[uint64]struct{}
+	// height is the height set in the batch, 0 if none
+	height uint64
+}
+
+// Batch returns a new batch of writes to the store, applied atomically by its Commit.
+func (s *DefaultStore) Batch(ctx context.Context) (Batch, error) {
+	batch, err := s.db.Batch(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create a new batch: %w", err)
+	}
+	return &defaultBatch{
+		store:        s,
+		batch:        batch,
+		refs:         newChunkRefs(),
+		savedHeights: make(map[uint64]struct{}),
+	}, nil
+}
+
+// SaveBlockData implements Batch.
+func (b *defaultBatch) SaveBlockData(ctx context.Context, header *types.SignedHeader, data *types.Data, signature *types.Signature) error {
+	hash := header.Hash()
+	height := header.Height()
+	if _, ok := b.savedHeights[height]; ok {
+		return fmt.Errorf("block at height %d already saved in the batch", height)
+	}
+	signatureHash := *signature
+	headerBlob, err := header.MarshalBinary()
+	if err != nil {
+		return fmt.Errorf("failed to marshal Header to binary: %w", err)
+	}
+
+	dataKey, staleDataKey := getDataKey(height), getStoredDataKey(height)
+	var dataBlob []byte
+	b.store.chunkMu.Lock()
+	if err := b.store.loadRecentTxs(ctx, b.refs); err != nil {
+		b.store.chunkMu.Unlock()
+		return err
+	}
+	stored, chunked := b.store.encodeStoredData(height, data.ToProto(), b.refs)
+	b.store.chunkMu.Unlock()
+	if chunked {
+		dataKey, staleDataKey = staleDataKey, dataKey
+		dataBlob, err = proto.Marshal(stored)
+	} else {
+		dataBlob, err = data.MarshalBinary()
+	}
+	if err != nil {
+		return fmt.Errorf("failed to marshal Data to binary: %w", err)
+	}
+
+	if err := b.batch.Put(ctx, ds.NewKey(getHeaderKey(height)), headerBlob); err != nil {
+		return fmt.Errorf("failed to put header blob in batch: %w", err)
+	}
+	if err := b.batch.Put(ctx, ds.NewKey(dataKey), dataBlob); err != nil {
+		return fmt.Errorf("failed to put data blob in batch: %w", err)
+	}
+	if err := b.batch.Delete(ctx, ds.NewKey(staleDataKey)); err != nil {
+		return fmt.Errorf("failed to delete stale data blob in batch: %w", err)
+	}
+	if err := b.batch.Put(ctx, ds.NewKey(getSignatureKey(height)), signatureHash[:]); err != nil {
+		return fmt.Errorf("failed to put signature of block blob in batch: %w", err)
+	}
+	if err := b.batch.Put(ctx, ds.NewKey(getIndexKey(hash)), encodeHeight(height)); err != nil {
+		return fmt.Errorf("failed to put index key in batch: %w", err)
+	}
+	if err := b.batch.Put(ctx, ds.NewKey(getBlockIndexKey(height)), encodeBlockIndex(header, data)); err != nil {
+		return fmt.Errorf("failed to put block index key in batch: %w", err)
+	}
+	for _, key := range searchIndexKeys(newBlockSummary(header, data)) {
+		if err := b.batch.Put(ctx, ds.NewKey(key), []byte{}); err != nil {
+			return fmt.Errorf("failed to put search index key in batch: %w", err)
+		}
+	}
+	for i, tx := range data.Txs {
+		if err := b.batch.Put(ctx, ds.NewKey(getTxIndexKey(tx)), encodeTxLocation(TxLocation{Height: height, Index: uint32(i)})); err != nil {
+			return fmt.Errorf("failed to put tx index key in batch: %w", err)
+		}
+	}
+	b.savedHeights[height] = struct{}{}
+	return nil
+}
+
+// SetHeight implements Batch.
+func (b *defaultBatch) SetHeight(ctx context.Context, height uint64) error {
+	currentHeight, err := b.currentHeight(ctx)
+	if err != nil {
+		return err
+	}
+	if height <= currentHeight {
+		return nil
+	}
+	if err := b.batch.Put(ctx, ds.NewKey(getHeightKey()), encodeHeight(height)); err != nil {
+		return fmt.Errorf("failed to put height in batch: %w", err)
+	}
+	b.height = height
+	return nil
+}
+
+// UpdateState implements Batch.
+func (b *defaultBatch) UpdateState(ctx context.Context, state types.State) error {
+	currentHeight, err := b.currentHeight(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to get current height: %w", err)
+	}
+	pbState, err := state.ToProto()
+	if err != nil {
+		return fmt.Errorf("failed to convert type state to protobuf type: %w", err)
+	}
+	data, err := proto.Marshal(pbState)
+	if err != nil {
+		return fmt.Errorf("failed to marshal state to protobuf: %w", err)
+	}
+	if err := b.batch.Put(ctx, ds.NewKey(getStateAtHeightKey(currentHeight)), data); err != nil {
+		return fmt.Errorf("failed to put state in batch: %w", err)
+	}
+	return nil
+}
+
+// SetMetadata implements Batch.
+func (b *defaultBatch) SetMetadata(ctx context.Context, key string, value []byte) error {
+	if err := b.batch.Put(ctx, ds.NewKey(getMetaKey(key)), value); err != nil {
+		return fmt.Errorf("failed to put metadata for key '%s' in batch: %w", key, err)
+	}
+	return nil
+}
+
+// Commit implements Batch. The chunks referenced by the blocks saved again are released, and the
+// reference counts updated, under the lock of the chunks so that concurrent writes do not miss
+// each other's references.
+func (b *defaultBatch) Commit(ctx context.Context) error {
+	b.store.chunkMu.Lock()
+	defer b.store.chunkMu.Unlock()
+
+	for height := range b.savedHeights {
+		if _, err := b.store.releaseStoredData(ctx, height, b.refs); err != nil {
+			return err
+		}
+	}
+	if err := b.store.applyChunkRefs(ctx, b.batch, b.refs); err != nil {
+		return err
+	}
+	if err := b.batch.Commit(ctx); err != nil {
+		return fmt.Errorf("failed to commit batch: %w", err)
+	}
+	return nil
+}
+
+// currentHeight returns the height set in the batch, or the height of the store.
+func (b *defaultBatch) currentHeight(ctx context.Context) (uint64, error) {
+	if b.height != 0 {
+		return b.height, nil
+	}
+	return b.store.Height(ctx)
+}
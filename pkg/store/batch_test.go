@@ -0,0 +1,136 @@
+package store
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"testing"
+
+	ds "github.com/ipfs/go-datastore"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/evstack/ev-node/types"
+)
+
+func TestBatch(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	s := New(mustNewInMem())
+
+	header, data := types.GetRandomBlock(1, 2, "test-batch")
+	state := types.State{ChainID: "test-batch", InitialHeight: 1, LastBlockHeight: 1}
+
+	batch, err := s.Batch(ctx)
+	require.NoError(t, err)
+	require.NoError(t, batch.SaveBlockData(ctx, header, data, &header.Signature))
+	require.NoError(t, batch.SetHeight(ctx, 1))
+	require.NoError(t, batch.UpdateState(ctx, state))
+	require.NoError(t, batch.SetMetadata(ctx, "key", []byte("value")))
+
+	// nothing is written before the batch is committed
+	height, err := s.Height(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(0), height)
+	_, _, err = s.GetBlockData(ctx, 1)
+	assert.Error(t, err)
+	_, err = s.GetStateAtHeight(ctx, 1)
+	assert.Error(t, err)
+	_, err = s.GetMetadata(ctx, "key")
+	assert.ErrorIs(t, err, ds.ErrNotFound)
+
+	require.NoError(t, batch.Commit(ctx))
+
+	height, err = s.Height(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(1), height)
+	savedHeader, savedData, err := s.GetBlockData(ctx, 1)
+	require.NoError(t, err)
+	assert.Equal(t, header.Hash(), savedHeader.Hash())
+	assert.Equal(t, data.Txs, savedData.Txs)
+	signature, err := s.GetSignature(ctx, 1)
+	require.NoError(t, err)
+	assert.Equal(t, header.Signature, *signature)
+	hash := sha256.Sum256(data.Txs[1])
+	location, err := GetTxLocation(ctx, s, hash[:])
+	require.NoError(t, err)
+	assert.Equal(t, TxLocation{Height: 1, Index: 1}, location)
+	value, err := s.GetMetadata(ctx, "key")
+	require.NoError(t, err)
+	assert.Equal(t, []byte("value"), value)
+
+	// the state is saved at the height set in the batch
+	savedState, err := s.GetState(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, state.LastBlockHeight, savedState.LastBlockHeight)
+}
+
+func TestBatchHeight(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	s := New(mustNewInMem())
+	require.NoError(t, s.SetHeight(ctx, 5))
+
+	batch, err := s.Batch(ctx)
+	require.NoError(t, err)
+	// a lower height than the one of the store is ignored
+	require.NoError(t, batch.SetHeight(ctx, 3))
+	require.NoError(t, batch.UpdateState(ctx, types.State{ChainID: "test-batch", LastBlockHeight: 5}))
+	require.NoError(t, batch.SetHeight(ctx, 7))
+	// as is a lower height than the one set in the batch
+	require.NoError(t, batch.SetHeight(ctx, 6))
+	require.NoError(t, batch.UpdateState(ctx, types.State{ChainID: "test-batch", LastBlockHeight: 7}))
+	require.NoError(t, batch.Commit(ctx))
+
+	height, err := s.Height(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(7), height)
+	for _, h := range []uint64{5, 7} {
+		state, err := s.GetStateAtHeight(ctx, h)
+		require.NoError(t, err)
+		assert.Equal(t, h, state.LastBlockHeight)
+	}
+}
+
+func TestBatchSaveBlockDataTwice(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	s := New(mustNewInMem())
+
+	header, data := types.GetRandomBlock(1, 2, "test-batch")
+	batch, err := s.Batch(ctx)
+	require.NoError(t, err)
+	require.NoError(t, batch.SaveBlockData(ctx, header, data, &header.Signature))
+	assert.Error(t, batch.SaveBlockData(ctx, header, data, &header.Signature))
+}
+
+func TestBatchDeduplication(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	s := New(mustNewInMem()).(*DefaultStore)
+
+	systemTx := types.Tx(bytes.Repeat([]byte{0xcd}, 1024))
+	hash := sha256.Sum256(systemTx)
+
+	for h := uint64(1); h <= 3; h++ {
+		header, data := blockWithSystemTx(h, systemTx)
+		// the block is first saved alone for crash recovery, then committed with its state
+		require.NoError(t, s.SaveBlockData(ctx, header, data, &header.Signature))
+		batch, err := s.Batch(ctx)
+		require.NoError(t, err)
+		require.NoError(t, batch.SaveBlockData(ctx, header, data, &header.Signature))
+		require.NoError(t, batch.SetHeight(ctx, h))
+		require.NoError(t, batch.UpdateState(ctx, types.State{ChainID: "test-dedup", InitialHeight: 1, LastBlockHeight: h}))
+		require.NoError(t, batch.Commit(ctx))
+	}
+
+	// saving the blocks again does not add references
+	count, err := s.chunkRefCount(ctx, hash)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(2), count)
+	for h := uint64(1); h <= 3; h++ {
+		_, data, err := s.GetBlockData(ctx, h)
+		require.NoError(t, err)
+		assert.Equal(t, systemTx, data.Txs[0])
+	}
+}
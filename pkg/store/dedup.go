@@ -0,0 +1,266 @@
+package store
+
+import (
+	"cmp"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"path"
+	"slices"
+
+	ds "github.com/ipfs/go-datastore"
+	dsq "github.com/ipfs/go-datastore/query"
+	"google.golang.org/protobuf/proto"
+
+	pb "github.com/evstack/ev-node/types/pb/evnode/v1"
+)
+
+const (
+	// minChunkSize is the size from which a recurring transaction is stored in a chunk. Smaller
+	// transactions are always stored inline, as a chunk reference would not be much smaller.
+	minChunkSize = 128
+	// recentTxsWindow is the number of hashes of recent transactions of at least minChunkSize bytes
+	// remembered to detect recurring transactions.
+	recentTxsWindow = 1 << 16
+)
+
+// recentTxs remembers the height at which the most recently stored transactions were first seen,
+// up to a bounded number of transactions. The window is persisted under /xs/{sha256} in the batches
+// saving the blocks and loaded by the first block saved after a restart, so that transactions
+// recurring across a restart are still deduplicated.
+type recentTxs struct {
+	heights map[[sha256.Size]byte]uint64
+	ring    [][sha256.Size]byte
+	next    int
+	loaded  bool
+}
+
+func newRecentTxs(size int) *recentTxs {
+	return &recentTxs{
+		heights: make(map[[sha256.Size]byte]uint64, size),
+		ring:    make([][sha256.Size]byte, 0, size),
+	}
+}
+
+// recurs records that the transaction is stored at the given height and returns whether it was
+// first seen at another height. Saving the same block again therefore gives the same result. The
+// changes of the window are added to refs, to be persisted with the block.
+func (r *recentTxs) recurs(hash [sha256.Size]byte, height uint64, refs *chunkRefs) bool {
+	if firstSeen, ok := r.heights[hash]; ok {
+		return firstSeen != height
+	}
+	r.add(hash, height, refs)
+	return false
+}
+
+// add adds a transaction to the window, evicting the oldest one if the window is full.
+func (r *recentTxs) add(hash [sha256.Size]byte, height uint64, refs *chunkRefs) {
+	if len(r.ring) < cap(r.ring) {
+		r.ring = append(r.ring, hash)
+	} else {
+		evicted := r.ring[r.next]
+		delete(r.heights, evicted)
+		refs.window[evicted] = 0
+		r.ring[r.next] = hash
+		r.next = (r.next + 1) % len(r.ring)
+	}
+	r.heights[hash] = height
+	refs.window[hash] = height
+}
+
+// loadRecentTxs loads the window of recent transactions persisted by the node, once. Entries
+// beyond the size of the window, left by batches which were not committed, are deleted with refs.
+// chunkMu must be held.
+func (s *DefaultStore) loadRecentTxs(ctx context.Context, refs *chunkRefs) error {
+	if s.recentTxs.loaded {
+		return nil
+	}
+	results, err := s.db.Query(ctx, dsq.Query{Prefix: GenerateKey([]string{recentTxPrefix})})
+	if err != nil {
+		return fmt.Errorf("failed to query recent transactions: %w", err)
+	}
+	defer results.Close()
+
+	type entry struct {
+		hash   [sha256.Size]byte
+		height uint64
+	}
+	var entries []entry
+	for result := range results.Next() {
+		if result.Error != nil {
+			return fmt.Errorf("failed to read recent transactions: %w", result.Error)
+		}
+		var e entry
+		hash, err := hex.DecodeString(path.Base(result.Key))
+		if err != nil || len(hash) != sha256.Size {
+			return fmt.Errorf("%w: invalid recent transaction key: %s", ErrCorrupted, result.Key)
+		}
+		copy(e.hash[:], hash)
+		if e.height, err = decodeHeight(result.Value); err != nil {
+			return err
+		}
+		entries = append(entries, e)
+	}
+
+	// the oldest entries are evicted first
+	slices.SortStableFunc(entries, func(a, b entry) int { return cmp.Compare(a.height, b.height) })
+	for i, e := range entries {
+		if i < len(entries)-cap(s.recentTxs.ring) {
+			refs.window[e.hash] = 0
+			continue
+		}
+		s.recentTxs.ring = append(s.recentTxs.ring, e.hash)
+		s.recentTxs.heights[e.hash] = e.height
+	}
+	s.recentTxs.loaded = true
+	return nil
+}
+
+// chunkRefs accumulates changes of chunk reference counts to apply in a batch.
+type chunkRefs struct {
+	deltas map[[sha256.Size]byte]int64
+	// txs holds the content of chunks referenced by the changes, to create them if needed
+	txs map[[sha256.Size]byte][]byte
+	// window holds the changes of the window of recent transactions, the height at which a
+	// transaction was first seen, or 0 if it was evicted
+	window map[[sha256.Size]byte]uint64
+}
+
+func newChunkRefs() *chunkRefs {
+	return &chunkRefs{
+		deltas: make(map[[sha256.Size]byte]int64),
+		txs:    make(map[[sha256.Size]byte][]byte),
+		window: make(map[[sha256.Size]byte]uint64),
+	}
+}
+
+// encodeStoredData converts block data to the form it is persisted in, replacing the recurring
+// transactions by chunk references added to refs. The second return value is false if no
+// transaction recurs, in which case the block data is persisted as is.
+func (s *DefaultStore) encodeStoredData(height uint64, data *pb.Data, refs *chunkRefs) (*pb.StoredData, bool) {
+	stored := &pb.StoredData{Metadata: data.GetMetadata(), Txs: make([]*pb.StoredTx, len(data.GetTxs()))}
+	chunked := false
+	for i, tx := range data.GetTxs() {
+		if len(tx) < minChunkSize {
+			stored.Txs[i] = &pb.StoredTx{Tx: &pb.StoredTx_Raw{Raw: tx}}
+			continue
+		}
+		hash := sha256.Sum256(tx)
+		if !s.recentTxs.recurs(hash, height, refs) {
+			stored.Txs[i] = &pb.StoredTx{Tx: &pb.StoredTx_Raw{Raw: tx}}
+			continue
+		}
+		refs.deltas[hash]++
+		refs.txs[hash] = tx
+		stored.Txs[i] = &pb.StoredTx{Tx: &pb.StoredTx_Chunk{Chunk: hash[:]}}
+		chunked = true
+	}
+	return stored, chunked
+}
+
+// releaseStoredData adds to refs the release of the chunks referenced by the block data stored
+// at the given height, if any.
+func (s *DefaultStore) releaseStoredData(ctx context.Context, height uint64, refs *chunkRefs) (bool, error) {
+	blob, err := s.db.Get(ctx, ds.NewKey(getStoredDataKey(height)))
+	if errors.Is(err, ds.ErrNotFound) {
+		return false, nil
+	}
+	if err != nil {
+		return false, fmt.Errorf("failed to load stored data: %w", err)
+	}
+	var stored pb.StoredData
+	if err := proto.Unmarshal(blob, &stored); err != nil {
+		return false, fmt.Errorf("failed to unmarshal stored data: %w: %w", ErrCorrupted, err)
+	}
+	for _, tx := range stored.GetTxs() {
+		if chunk := tx.GetChunk(); chunk != nil {
+			var hash [sha256.Size]byte
+			copy(hash[:], chunk)
+			refs.deltas[hash]--
+		}
+	}
+	return true, nil
+}
+
+// applyChunkRefs writes the reference count changes to the batch, creating the chunks that become
+// referenced and deleting the chunks that are no longer referenced, and the changes of the window
+// of recent transactions.
+func (s *DefaultStore) applyChunkRefs(ctx context.Context, batch ds.Batch, refs *chunkRefs) error {
+	for hash, height := range refs.window {
+		key := ds.NewKey(getRecentTxKey(hash))
+		if height == 0 {
+			if err := batch.Delete(ctx, key); err != nil {
+				return fmt.Errorf("failed to delete recent transaction in batch: %w", err)
+			}
+			continue
+		}
+		if err := batch.Put(ctx, key, encodeHeight(height)); err != nil {
+			return fmt.Errorf("failed to put recent transaction in batch: %w", err)
+		}
+	}
+	for hash, delta := range refs.deltas {
+		if delta == 0 {
+			continue
+		}
+		count, err := s.chunkRefCount(ctx, hash)
+		if err != nil {
+			return err
+		}
+		newCount := int64(count) + delta //nolint:gosec // reference counts are far below 2^63
+		switch {
+		case newCount <= 0:
+			if err := batch.Delete(ctx, ds.NewKey(getChunkKey(hash))); err != nil {
+				return fmt.Errorf("failed to delete chunk in batch: %w", err)
+			}
+			if err := batch.Delete(ctx, ds.NewKey(getChunkRefKey(hash))); err != nil {
+				return fmt.Errorf("failed to delete chunk references in batch: %w", err)
+			}
+			continue
+		case count == 0:
+			if err := batch.Put(ctx, ds.NewKey(getChunkKey(hash)), refs.txs[hash]); err != nil {
+				return fmt.Errorf("failed to put chunk in batch: %w", err)
+			}
+		}
+		if err := batch.Put(ctx, ds.NewKey(getChunkRefKey(hash)), encodeHeight(uint64(newCount))); err != nil {
+			return fmt.Errorf("failed to put chunk references in batch: %w", err)
+		}
+	}
+	return nil
+}
+
+func (s *DefaultStore) chunkRefCount(ctx context.Context, hash [sha256.Size]byte) (uint64, error) {
+	countBytes, err := s.db.Get(ctx, ds.NewKey(getChunkRefKey(hash)))
+	if errors.Is(err, ds.ErrNotFound) {
+		return 0, nil
+	}
+	if err != nil {
+		return 0, fmt.Errorf("failed to load chunk references: %w", err)
+	}
+	return decodeHeight(countBytes)
+}
+
+// decodeStoredData resolves the chunk references of stored block data.
+func (s *DefaultStore) decodeStoredData(ctx context.Context, blob []byte) (*pb.Data, error) {
+	var stored pb.StoredData
+	if err := proto.Unmarshal(blob, &stored); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal stored data: %w: %w", ErrCorrupted, err)
+	}
+	data := &pb.Data{Metadata: stored.GetMetadata(), Txs: make([][]byte, len(stored.GetTxs()))}
+	for i, tx := range stored.GetTxs() {
+		chunk := tx.GetChunk()
+		if chunk == nil {
+			data.Txs[i] = tx.GetRaw()
+			continue
+		}
+		var hash [sha256.Size]byte
+		copy(hash[:], chunk)
+		raw, err := s.db.Get(ctx, ds.NewKey(getChunkKey(hash)))
+		if err != nil {
+			return nil, fmt.Errorf("failed to load chunk %s: %w", hex.EncodeToString(chunk), err)
+		}
+		data.Txs[i] = raw
+	}
+	return data, nil
+}
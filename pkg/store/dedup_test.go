@@ -0,0 +1,189 @@
+package store
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"testing"
+
+	ds "github.com/ipfs/go-datastore"
+	dsq "github.com/ipfs/go-datastore/query"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/evstack/ev-node/types"
+)
+
+// blockWithSystemTx returns a random block whose first transaction is systemTx.
+func blockWithSystemTx(height uint64, systemTx types.Tx) (*types.SignedHeader, *types.Data) {
+	header, data := types.GetRandomBlock(height, 2, "test-dedup")
+	data.Txs = append(types.Txs{systemTx}, data.Txs...)
+	return header, data
+}
+
+// storedBytes returns the total size of the keys and values under the prefixes.
+func storedBytes(t *testing.T, kv ds.Datastore, prefixes ...string) int {
+	t.Helper()
+	size := 0
+	for _, prefix := range prefixes {
+		results, err := kv.Query(context.Background(), dsq.Query{Prefix: "/" + prefix})
+		require.NoError(t, err)
+		entries, err := results.Rest()
+		require.NoError(t, err)
+		for _, e := range entries {
+			size += len(e.Key) + len(e.Value)
+		}
+	}
+	return size
+}
+
+func TestDataDeduplication(t *testing.T) {
+	ctx := context.Background()
+	kv := mustNewInMem()
+	s := New(kv).(*DefaultStore)
+
+	systemTx := types.Tx(bytes.Repeat([]byte{0xab}, 1024))
+	hash := sha256.Sum256(systemTx)
+
+	var blocks []*types.Data
+	for h := uint64(1); h <= 3; h++ {
+		header, data := blockWithSystemTx(h, systemTx)
+		require.NoError(t, s.SaveBlockData(ctx, header, data, &header.Signature))
+		// saving a block again, e.g. with its final signature, does not add references
+		require.NoError(t, s.SaveBlockData(ctx, header, data, &header.Signature))
+		require.NoError(t, s.SetHeight(ctx, h))
+		require.NoError(t, s.UpdateState(ctx, types.State{ChainID: "test-dedup", InitialHeight: 1, LastBlockHeight: h}))
+		blocks = append(blocks, data)
+	}
+
+	// the first occurrence is stored inline, the recurring ones reference a chunk
+	_, err := kv.Get(ctx, ds.NewKey(getDataKey(1)))
+	require.NoError(t, err)
+	for h := uint64(2); h <= 3; h++ {
+		_, err := kv.Get(ctx, ds.NewKey(getDataKey(h)))
+		require.ErrorIs(t, err, ds.ErrNotFound)
+		_, err = kv.Get(ctx, ds.NewKey(getStoredDataKey(h)))
+		require.NoError(t, err)
+	}
+	count, err := s.chunkRefCount(ctx, hash)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(2), count)
+
+	for i, expected := range blocks {
+		_, data, err := s.GetBlockData(ctx, uint64(i+1))
+		require.NoError(t, err)
+		assert.Equal(t, expected.Txs, data.Txs)
+		assert.Equal(t, expected.Metadata, data.Metadata)
+	}
+
+	// rolled back blocks release their chunks, which are deleted once unreferenced
+	require.NoError(t, s.Rollback(ctx, 2))
+	count, err = s.chunkRefCount(ctx, hash)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(1), count)
+
+	require.NoError(t, s.Rollback(ctx, 1))
+	count, err = s.chunkRefCount(ctx, hash)
+	require.NoError(t, err)
+	assert.Zero(t, count)
+	_, err = kv.Get(ctx, ds.NewKey(getChunkKey(hash)))
+	assert.ErrorIs(t, err, ds.ErrNotFound)
+	_, err = kv.Get(ctx, ds.NewKey(getStoredDataKey(2)))
+	assert.ErrorIs(t, err, ds.ErrNotFound)
+
+	_, data, err := s.GetBlockData(ctx, 1)
+	require.NoError(t, err)
+	assert.Equal(t, blocks[0].Txs, data.Txs)
+}
+
+func TestDataDeduplicationStoreSize(t *testing.T) {
+	ctx := context.Background()
+	const numBlocks = 100
+	systemTx := types.Tx(bytes.Repeat([]byte{0xcd}, 2048))
+
+	var headers []*types.SignedHeader
+	var datas []*types.Data
+	for h := uint64(1); h <= numBlocks; h++ {
+		header, data := blockWithSystemTx(h, systemTx)
+		headers = append(headers, header)
+		datas = append(datas, data)
+	}
+
+	// baseline: the block data written as is
+	baseline := mustNewInMem()
+	for i, header := range headers {
+		blob, err := datas[i].MarshalBinary()
+		require.NoError(t, err)
+		require.NoError(t, baseline.Put(ctx, ds.NewKey(getDataKey(header.Height())), blob))
+	}
+
+	kv := mustNewInMem()
+	s := New(kv)
+	for i, header := range headers {
+		require.NoError(t, s.SaveBlockData(ctx, header, datas[i], &header.Signature))
+	}
+	dataBytes := storedBytes(t, kv, dataPrefix, storedDataPrefix, chunkPrefix, chunkRefPrefix)
+	baselineBytes := storedBytes(t, baseline, dataPrefix)
+	t.Logf("block data: %d bytes deduplicated, %d bytes as is", dataBytes, baselineBytes)
+	// the system transaction is stored once instead of in every block
+	assert.Less(t, dataBytes, baselineBytes/5)
+}
+
+func TestDataDeduplicationAcrossRestart(t *testing.T) {
+	ctx := context.Background()
+	kv := mustNewInMem()
+	systemTx := types.Tx(bytes.Repeat([]byte{0xef}, 512))
+	hash := sha256.Sum256(systemTx)
+
+	header, data := blockWithSystemTx(1, systemTx)
+	require.NoError(t, New(kv).SaveBlockData(ctx, header, data, &header.Signature))
+
+	// the window of recent transactions is reloaded, so the transaction recurs after a restart
+	s := New(kv).(*DefaultStore)
+	header, data = blockWithSystemTx(2, systemTx)
+	require.NoError(t, s.SaveBlockData(ctx, header, data, &header.Signature))
+	_, err := kv.Get(ctx, ds.NewKey(getStoredDataKey(2)))
+	require.NoError(t, err)
+	count, err := s.chunkRefCount(ctx, hash)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(1), count)
+	_, got, err := s.GetBlockData(ctx, 2)
+	require.NoError(t, err)
+	assert.Equal(t, data.Txs, got.Txs)
+}
+
+func TestRecentTxsWindowPersisted(t *testing.T) {
+	ctx := context.Background()
+	kv := mustNewInMem()
+	s := New(kv).(*DefaultStore)
+	s.recentTxs = newRecentTxs(2)
+
+	var hashes [][sha256.Size]byte
+	for h := uint64(1); h <= 3; h++ {
+		tx := types.Tx(bytes.Repeat([]byte{byte(h)}, minChunkSize))
+		hashes = append(hashes, sha256.Sum256(tx))
+		header, data := types.GetRandomBlock(h, 0, "test-dedup")
+		data.Txs = types.Txs{tx}
+		require.NoError(t, s.SaveBlockData(ctx, header, data, &header.Signature))
+	}
+	persisted := func() map[[sha256.Size]byte]bool {
+		keys := make(map[[sha256.Size]byte]bool)
+		for _, hash := range hashes {
+			has, err := kv.Has(ctx, ds.NewKey(getRecentTxKey(hash)))
+			require.NoError(t, err)
+			keys[hash] = has
+		}
+		return keys
+	}
+	// the evicted transaction is deleted with the block evicting it
+	assert.Equal(t, map[[sha256.Size]byte]bool{hashes[0]: false, hashes[1]: true, hashes[2]: true}, persisted())
+
+	// entries beyond the window are deleted when the window is loaded
+	require.NoError(t, kv.Put(ctx, ds.NewKey(getRecentTxKey(hashes[0])), encodeHeight(1)))
+	restarted := New(kv).(*DefaultStore)
+	restarted.recentTxs = newRecentTxs(2)
+	header, data := types.GetRandomBlock(4, 0, "test-dedup")
+	require.NoError(t, restarted.SaveBlockData(ctx, header, data, &header.Signature))
+	assert.Equal(t, map[[sha256.Size]byte]bool{hashes[0]: false, hashes[1]: true, hashes[2]: true}, persisted())
+	assert.Equal(t, uint64(2), restarted.recentTxs.heights[hashes[1]])
+}
@@ -1,7 +1,11 @@
 package store
 
 import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
 	"strconv"
+	"time"
 
 	"github.com/evstack/ev-node/types"
 )
@@ -12,6 +16,67 @@ const (
 	// Full keys are like: rhb/<evolve_height>/h and rhb/<evolve_height>/d
 	HeightToDAHeightKey = "rhb"
 
+	// HeightToSyncSourceKey is the key prefix used for persisting where the header/data of a synced
+	// Evolve height were obtained from (p2p, da, da-recovery or empty), for diagnostics.
+	// Full keys are like: rss/<evolve_height>/h and rss/<evolve_height>/d
+	HeightToSyncSourceKey = "rss"
+
+	// StateDiffKey is the key prefix used for persisting the execution state diff of a block,
+	// for executors that provide one.
+	// Full keys are like: rsd/<evolve_height>
+	StateDiffKey = "rsd"
+
+	// TxResultsKey is the key prefix used for persisting the execution results of the
+	// transactions of a block, for executors that provide them.
+	// Full keys are like: rtr/<evolve_height>
+	TxResultsKey = "rtr"
+
+	// DAIncludedTimeKey is the key prefix used for persisting the time at which the node found a
+	// block included on DA, in unix nanoseconds.
+	// Full keys are like: rdt/<evolve_height>
+	DAIncludedTimeKey = "rdt"
+
+	// SequencerFeesKey is the key prefix used for persisting the sequencing fees collected by a
+	// block, for executors that report them.
+	// Full keys are like: rsf/<evolve_height>
+	SequencerFeesKey = "rsf"
+
+	// SystemCallsKey is the key prefix used for persisting the system calls requested by the
+	// execution layer, by the height they are applied at.
+	// Full keys are like: rsc/<evolve_height>
+	SystemCallsKey = "rsc"
+
+	// BlockTimeKey is the key prefix used for persisting the block time an aggregator autoscaling
+	// its block time produced a block with, by height.
+	BlockTimeKey = "rbt"
+
+	// OrderflowKey is the key prefix used for persisting the attribution of the transactions of a
+	// block produced by the node to the orderflow sources of the sequencer they were pulled from.
+	// Full keys are like: rof/<evolve_height>
+	OrderflowKey = "rof"
+
+	// ExecutionDivergenceKey is the key prefix used for persisting the report of a block whose
+	// execution by a shadow replica diverged from the sequencer.
+	// Full keys are like: rnd/<evolve_height>
+	ExecutionDivergenceKey = "rnd"
+
+	// TxIndexKey is the key prefix used for persisting the height of the latest block including a
+	// transaction and the index of the transaction in it, by the hex encoded SHA-256 hash of the
+	// raw transaction.
+	// Full keys are like: rtx/<tx_hash>
+	TxIndexKey = "rtx"
+
+	// WebhookOffsetKey is the key prefix used for persisting the last item, height or event
+	// sequence, delivered to a webhook, by stream and sink.
+	// Full keys are like: rwo/<stream>/<sink_id>
+	WebhookOffsetKey = "rwo"
+
+	// AppMetadataKey is the key prefix used for persisting the metadata of applications, e.g. the
+	// checkpoints of execution layers, under the namespaces they registered with
+	// RegisterMetadataNamespace.
+	// Full keys are like: app/<namespace>/<key>
+	AppMetadataKey = "app"
+
 	// DAIncludedHeightKey is the key used for persisting the da included height in store.
 	DAIncludedHeightKey = "d"
 
@@ -21,6 +86,21 @@ const (
 	// LastSubmittedHeaderHeightKey is the key used for persisting the last submitted header height in store.
 	LastSubmittedHeaderHeightKey = "last-submitted-header-height"
 
+	// PrunedBaseHeightKey is the key used for persisting the height up to which the blocks were
+	// pruned by the pruning manager.
+	PrunedBaseHeightKey = "pruned-base-height"
+
+	// SystemBlockTimeKey is the key used for persisting the block time set by a system call.
+	SystemBlockTimeKey = "system-block-time"
+
+	// SequencerFeesHeightKey is the key used for persisting the height up to which the sequencing
+	// fees of the blocks were accounted under SequencerFeesKey.
+	SequencerFeesHeightKey = "sequencer-fees-height"
+
+	// SearchIndexHeightKey is the key used for persisting the height up to which the blocks saved
+	// before the search indexes existed were indexed by proposer, time and transaction count.
+	SearchIndexHeightKey = "search-index-height"
+
 	headerPrefix    = "h"
 	dataPrefix      = "d"
 	signaturePrefix = "c"
@@ -28,6 +108,18 @@ const (
 	metaPrefix      = "m"
 	indexPrefix     = "i"
 	heightPrefix    = "t"
+	// the metadata of blocks, by height, read by SearchBlocks
+	blockIndexPrefix = "bi"
+	// the heights of blocks by proposer, time and number of transactions, queried by SearchBlocks
+	proposerIndexPrefix = "bp"
+	timeIndexPrefix     = "bt"
+	txCountIndexPrefix  = "bx"
+	// block data with chunk references, and the chunks of recurring transactions with their reference counts
+	storedDataPrefix = "dc"
+	chunkPrefix      = "x"
+	chunkRefPrefix   = "xr"
+	// the heights at which the transactions of the deduplication window were first seen
+	recentTxPrefix = "xs"
 )
 
 func getHeaderKey(height uint64) string {
@@ -38,6 +130,22 @@ func getDataKey(height uint64) string {
 	return GenerateKey([]string{dataPrefix, strconv.FormatUint(height, 10)})
 }
 
+func getStoredDataKey(height uint64) string {
+	return GenerateKey([]string{storedDataPrefix, strconv.FormatUint(height, 10)})
+}
+
+func getChunkKey(hash [sha256.Size]byte) string {
+	return GenerateKey([]string{chunkPrefix, hex.EncodeToString(hash[:])})
+}
+
+func getChunkRefKey(hash [sha256.Size]byte) string {
+	return GenerateKey([]string{chunkRefPrefix, hex.EncodeToString(hash[:])})
+}
+
+func getRecentTxKey(hash [sha256.Size]byte) string {
+	return GenerateKey([]string{recentTxPrefix, hex.EncodeToString(hash[:])})
+}
+
 func getSignatureKey(height uint64) string {
 	return GenerateKey([]string{signaturePrefix, strconv.FormatUint(height, 10)})
 }
@@ -54,6 +162,32 @@ func getIndexKey(hash types.Hash) string {
 	return GenerateKey([]string{indexPrefix, hash.String()})
 }
 
+func getBlockIndexKey(height uint64) string {
+	return GenerateKey([]string{blockIndexPrefix, strconv.FormatUint(height, 10)})
+}
+
+func getProposerIndexKey(proposer []byte, height uint64) string {
+	return GenerateKey([]string{proposerIndexPrefix, hex.EncodeToString(proposer), indexKeyUint(height)})
+}
+
+func getTimeIndexKey(t time.Time, height uint64) string {
+	return GenerateKey([]string{timeIndexPrefix, indexKeyUint(uint64(max(t.UnixNano(), 0))), indexKeyUint(height)})
+}
+
+func getTxCountIndexKey(txCount, height uint64) string {
+	return GenerateKey([]string{txCountIndexPrefix, indexKeyUint(txCount), indexKeyUint(height)})
+}
+
+// indexKeyUint formats an integer in an index key, zero-padded so that keys sort numerically.
+func indexKeyUint(v uint64) string {
+	return fmt.Sprintf("%020d", v)
+}
+
 func getHeightKey() string {
 	return GenerateKey([]string{heightPrefix})
 }
+
+func getTxIndexKey(tx []byte) string {
+	hash := sha256.Sum256(tx)
+	return getMetaKey(TxIndexKey + "/" + hex.EncodeToString(hash[:]))
+}
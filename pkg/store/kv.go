@@ -2,6 +2,10 @@ package store
 
 import (
 	"context"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
 	"path"
 	"path/filepath"
 	"strings"
@@ -29,6 +33,68 @@ func NewDefaultKVStore(rootDir, dbPath, dbName string) (ds.Batching, error) {
 	return badger4.NewDatastore(path, nil)
 }
 
+// Backends of the key-value store.
+const (
+	// BackendBadger stores the data with BadgerDB v4, the default.
+	BackendBadger = "badger"
+	// BackendPebble stores the data with Pebble.
+	BackendPebble = "pebble"
+)
+
+// Backends are the backends of the key-value store.
+var Backends = []string{BackendBadger, BackendPebble}
+
+// NewKVStore creates the key-value store of the given backend, BackendBadger if empty. The
+// backend of an existing store must match it: stores are converted to another backend with
+// MigrateKVStore.
+func NewKVStore(backend, rootDir, dbPath, dbName string) (ds.Batching, error) {
+	path := filepath.Join(rootify(rootDir, dbPath), dbName)
+	if backend == "" {
+		backend = BackendBadger
+	}
+	existing, err := DetectBackend(path)
+	if err != nil {
+		return nil, err
+	}
+	if existing != "" && existing != backend {
+		return nil, fmt.Errorf("store %s uses the %s backend, not %s: migrate it with the migrate-store command", path, existing, backend)
+	}
+	return openKVStore(backend, path)
+}
+
+// openKVStore opens the key-value store of the given backend at path.
+func openKVStore(backend, path string) (ds.Batching, error) {
+	switch backend {
+	case BackendBadger:
+		return badger4.NewDatastore(path, nil)
+	case BackendPebble:
+		return NewPebbleDatastore(path, nil)
+	default:
+		return nil, fmt.Errorf("unknown store backend %q, expected one of %s", backend, strings.Join(Backends, ", "))
+	}
+}
+
+// DetectBackend returns the backend of the key-value store at path, from the files of the
+// backend, or an empty string if there is no store.
+func DetectBackend(path string) (string, error) {
+	entries, err := os.ReadDir(path)
+	if errors.Is(err, fs.ErrNotExist) {
+		return "", nil
+	}
+	if err != nil {
+		return "", fmt.Errorf("failed to read store directory: %w", err)
+	}
+	for _, entry := range entries {
+		switch name := entry.Name(); {
+		case name == "KEYREGISTRY":
+			return BackendBadger, nil
+		case strings.HasPrefix(name, "OPTIONS-"):
+			return BackendPebble, nil
+		}
+	}
+	return "", nil
+}
+
 // PrefixEntries retrieves all entries in the datastore whose keys have the supplied prefix
 func PrefixEntries(ctx context.Context, store ds.Datastore, prefix string) (dsq.Results, error) {
 	results, err := store.Query(ctx, dsq.Query{Prefix: prefix})
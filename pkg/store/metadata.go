@@ -0,0 +1,81 @@
+package store
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"maps"
+	"path"
+	"regexp"
+	"slices"
+	"sync"
+)
+
+// ErrInvalidMetadataKey is returned when accessing application metadata under a namespace which is
+// not registered, or with an invalid key.
+var ErrInvalidMetadataKey = errors.New("invalid metadata key")
+
+// metadataNamespacePattern restricts the names of metadata namespaces, so that they cannot escape
+// their prefix.
+var metadataNamespacePattern = regexp.MustCompile(`^[a-z0-9][a-z0-9_.-]{0,63}$`)
+
+var (
+	metadataNamespacesMu sync.RWMutex
+	metadataNamespaces   = map[string]struct{}{}
+)
+
+// RegisterMetadataNamespace registers a namespace of application metadata, whose keys can then be
+// written with SetNamespacedMetadata and the SetMetadata admin RPC. Execution layers call it at
+// initialization, or when they start persisting their own checkpoints through the store.
+// Registering a namespace again is a no-op.
+func RegisterMetadataNamespace(namespace string) error {
+	if !metadataNamespacePattern.MatchString(namespace) {
+		return fmt.Errorf("invalid metadata namespace %q: expected up to 64 lowercase letters, digits, '_', '.' or '-'", namespace)
+	}
+	metadataNamespacesMu.Lock()
+	defer metadataNamespacesMu.Unlock()
+	metadataNamespaces[namespace] = struct{}{}
+	return nil
+}
+
+// MetadataNamespaces returns the registered namespaces of application metadata, sorted.
+func MetadataNamespaces() []string {
+	metadataNamespacesMu.RLock()
+	defer metadataNamespacesMu.RUnlock()
+	return slices.Sorted(maps.Keys(metadataNamespaces))
+}
+
+// NamespacedMetadataKey returns the metadata key of a key of application metadata, under
+// AppMetadataKey and its namespace, which must be registered.
+func NamespacedMetadataKey(namespace, key string) (string, error) {
+	metadataNamespacesMu.RLock()
+	_, ok := metadataNamespaces[namespace]
+	metadataNamespacesMu.RUnlock()
+	if !ok {
+		return "", fmt.Errorf("%w: metadata namespace %q is not registered", ErrInvalidMetadataKey, namespace)
+	}
+	// keys are relative paths within the namespace, e.g. checkpoints/1
+	if key == "" || path.Clean("/"+key) != "/"+key {
+		return "", fmt.Errorf("%w: %q is not a clean relative path", ErrInvalidMetadataKey, key)
+	}
+	return fmt.Sprintf("%s/%s/%s", AppMetadataKey, namespace, key), nil
+}
+
+// SetNamespacedMetadata saves a value of application metadata under a registered namespace.
+func SetNamespacedMetadata(ctx context.Context, s Store, namespace, key string, value []byte) error {
+	metaKey, err := NamespacedMetadataKey(namespace, key)
+	if err != nil {
+		return err
+	}
+	return s.SetMetadata(ctx, metaKey, value)
+}
+
+// GetNamespacedMetadata returns a value of application metadata saved under a registered
+// namespace.
+func GetNamespacedMetadata(ctx context.Context, s Store, namespace, key string) ([]byte, error) {
+	metaKey, err := NamespacedMetadataKey(namespace, key)
+	if err != nil {
+		return nil, err
+	}
+	return s.GetMetadata(ctx, metaKey)
+}
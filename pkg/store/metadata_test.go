@@ -0,0 +1,39 @@
+package store
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNamespacedMetadata(t *testing.T) {
+	ctx := context.Background()
+	s := New(mustNewInMem())
+
+	err := SetNamespacedMetadata(ctx, s, "test-unregistered", "checkpoint", []byte{1})
+	require.ErrorIs(t, err, ErrInvalidMetadataKey)
+
+	for _, namespace := range []string{"", "Upper", "a/b", "-dash", string(make([]byte, 65))} {
+		assert.Error(t, RegisterMetadataNamespace(namespace), namespace)
+	}
+	require.NoError(t, RegisterMetadataNamespace("test-exec"))
+	require.NoError(t, RegisterMetadataNamespace("test-exec"))
+	assert.Contains(t, MetadataNamespaces(), "test-exec")
+
+	require.NoError(t, SetNamespacedMetadata(ctx, s, "test-exec", "checkpoints/1", []byte("state")))
+	value, err := GetNamespacedMetadata(ctx, s, "test-exec", "checkpoints/1")
+	require.NoError(t, err)
+	assert.Equal(t, []byte("state"), value)
+	// the value is readable with its full metadata key, as with the GetMetadata RPC
+	value, err = s.GetMetadata(ctx, AppMetadataKey+"/test-exec/checkpoints/1")
+	require.NoError(t, err)
+	assert.Equal(t, []byte("state"), value)
+
+	// keys cannot escape their namespace
+	for _, key := range []string{"", "/abs", "../d", "a/../../d", "a/", "."} {
+		err := SetNamespacedMetadata(ctx, s, "test-exec", key, []byte{1})
+		assert.ErrorIs(t, err, ErrInvalidMetadataKey, key)
+	}
+}
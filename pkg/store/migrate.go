@@ -0,0 +1,120 @@
+package store
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+
+	ds "github.com/ipfs/go-datastore"
+	dsq "github.com/ipfs/go-datastore/query"
+)
+
+// MigrationInfo describes the migration of a key-value store to another backend.
+type MigrationInfo struct {
+	// From is the backend the store was migrated from
+	From string
+	// To is the backend the store was migrated to
+	To string
+	// Entries is the number of entries copied
+	Entries uint64
+	// Backup is the path the store of the previous backend was moved to
+	Backup string
+}
+
+// MigrateKVStore converts the key-value store at rootDir/dbPath/dbName to the given backend. The
+// entries are copied to a new store next to it, which then replaces it, the previous store being
+// kept as a backup at a path suffixed with its backend, e.g. "testapp.badger.bak". The store must
+// not be open.
+func MigrateKVStore(ctx context.Context, backend, rootDir, dbPath, dbName string) (MigrationInfo, error) {
+	path := filepath.Join(rootify(rootDir, dbPath), dbName)
+	info := MigrationInfo{To: backend}
+	from, err := DetectBackend(path)
+	if err != nil {
+		return info, err
+	}
+	switch from {
+	case "":
+		return info, fmt.Errorf("no store to migrate at %s", path)
+	case backend:
+		return info, fmt.Errorf("store %s already uses the %s backend", path, backend)
+	}
+	info.From = from
+	info.Backup = fmt.Sprintf("%s.%s.bak", path, from)
+	target := path + ".migrating"
+	for _, p := range []string{target, info.Backup} {
+		if _, err := os.Stat(p); err == nil {
+			return info, fmt.Errorf("%s already exists, remove it before migrating", p)
+		} else if !errors.Is(err, fs.ErrNotExist) {
+			return info, err
+		}
+	}
+
+	src, err := openKVStore(from, path)
+	if err != nil {
+		return info, fmt.Errorf("failed to open %s store: %w", from, err)
+	}
+	dst, err := openKVStore(backend, target)
+	if err != nil {
+		_ = src.Close()
+		return info, fmt.Errorf("failed to open %s store: %w", backend, err)
+	}
+	info.Entries, err = copyEntries(ctx, dst, src)
+	if err == nil {
+		err = dst.Sync(ctx, ds.NewKey("/"))
+	}
+	err = errors.Join(err, src.Close(), dst.Close())
+	if err != nil {
+		_ = os.RemoveAll(target)
+		return info, fmt.Errorf("failed to migrate store: %w", err)
+	}
+
+	if err := os.Rename(path, info.Backup); err != nil {
+		return info, fmt.Errorf("failed to back up %s store: %w", from, err)
+	}
+	if err := os.Rename(target, path); err != nil {
+		return info, fmt.Errorf("failed to move %s store to %s: %w", backend, path, err)
+	}
+	return info, nil
+}
+
+// copyEntries copies all the entries of src to dst, in batches of about the size of the chunks
+// of snapshots, and returns the number of entries copied.
+func copyEntries(ctx context.Context, dst ds.Batching, src ds.Datastore) (uint64, error) {
+	results, err := src.Query(ctx, dsq.Query{})
+	if err != nil {
+		return 0, fmt.Errorf("failed to query store: %w", err)
+	}
+	defer results.Close()
+
+	var entries uint64
+	var size int
+	batch, err := dst.Batch(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("failed to create a new batch: %w", err)
+	}
+	for result := range results.Next() {
+		if result.Error != nil {
+			return entries, fmt.Errorf("failed to read store: %w", result.Error)
+		}
+		if err := batch.Put(ctx, ds.RawKey(result.Key), result.Value); err != nil {
+			return entries, fmt.Errorf("failed to copy entry %s: %w", result.Key, err)
+		}
+		entries++
+		if size += len(result.Key) + len(result.Value); size >= snapshotChunkSize {
+			if err := batch.Commit(ctx); err != nil {
+				return entries, fmt.Errorf("failed to commit batch: %w", err)
+			}
+			if batch, err = dst.Batch(ctx); err != nil {
+				return entries, fmt.Errorf("failed to create a new batch: %w", err)
+			}
+			size = 0
+		}
+	}
+	if err := batch.Commit(ctx); err != nil {
+		return entries, fmt.Errorf("failed to commit batch: %w", err)
+	}
+	return entries, nil
+}
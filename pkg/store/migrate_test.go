@@ -0,0 +1,59 @@
+package store
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/evstack/ev-node/types"
+)
+
+func TestMigrateKVStore(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	root := t.TempDir()
+
+	_, err := MigrateKVStore(ctx, BackendPebble, root, "data", "test")
+	require.ErrorContains(t, err, "no store to migrate")
+
+	kv, err := NewKVStore(BackendBadger, root, "data", "test")
+	require.NoError(t, err)
+	s := New(kv)
+	var blocks []*types.Data
+	for h := uint64(1); h <= 3; h++ {
+		header, data := types.GetRandomBlock(h, 2, "test-migrate")
+		require.NoError(t, s.SaveBlockData(ctx, header, data, &header.Signature))
+		require.NoError(t, s.SetHeight(ctx, h))
+		blocks = append(blocks, data)
+	}
+	require.NoError(t, s.Close())
+
+	_, err = MigrateKVStore(ctx, BackendBadger, root, "data", "test")
+	require.ErrorContains(t, err, "already uses the badger backend")
+
+	info, err := MigrateKVStore(ctx, BackendPebble, root, "data", "test")
+	require.NoError(t, err)
+	require.Equal(t, BackendBadger, info.From)
+	require.Equal(t, BackendPebble, info.To)
+	require.NotZero(t, info.Entries)
+	require.Equal(t, filepath.Join(root, "data", "test.badger.bak"), info.Backup)
+
+	backend, err := DetectBackend(info.Backup)
+	require.NoError(t, err)
+	require.Equal(t, BackendBadger, backend)
+
+	kv, err = NewKVStore(BackendPebble, root, "data", "test")
+	require.NoError(t, err)
+	s = New(kv)
+	defer s.Close()
+	height, err := s.Height(ctx)
+	require.NoError(t, err)
+	require.Equal(t, uint64(3), height)
+	for i, expected := range blocks {
+		_, data, err := s.GetBlockData(ctx, uint64(i+1))
+		require.NoError(t, err)
+		require.Equal(t, expected.Txs, data.Txs)
+	}
+}
@@ -0,0 +1,194 @@
+package store
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"path"
+
+	"github.com/cockroachdb/pebble"
+	ds "github.com/ipfs/go-datastore"
+	dsq "github.com/ipfs/go-datastore/query"
+)
+
+// PebbleDatastore is a datastore backed by Pebble, the LSM storage engine of CockroachDB. Its
+// compactions run concurrently with writes and are paced, so large stores do not stall writes the
+// way Badger does when compacting.
+type PebbleDatastore struct {
+	db *pebble.DB
+}
+
+var (
+	_ ds.Batching          = &PebbleDatastore{}
+	_ ds.PersistentFeature = &PebbleDatastore{}
+	_ ds.GCFeature         = &PebbleDatastore{}
+)
+
+// NewPebbleDatastore opens the Pebble datastore in dir, creating it if it does not exist. A nil
+// options uses the defaults of Pebble.
+func NewPebbleDatastore(dir string, opts *pebble.Options) (*PebbleDatastore, error) {
+	db, err := pebble.Open(dir, opts)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open pebble datastore: %w", err)
+	}
+	return &PebbleDatastore{db: db}, nil
+}
+
+// Get implements ds.Read.
+func (d *PebbleDatastore) Get(_ context.Context, key ds.Key) ([]byte, error) {
+	value, closer, err := d.db.Get(key.Bytes())
+	if errors.Is(err, pebble.ErrNotFound) {
+		return nil, ds.ErrNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	defer closer.Close()
+	return bytes.Clone(value), nil
+}
+
+// Has implements ds.Read.
+func (d *PebbleDatastore) Has(ctx context.Context, key ds.Key) (bool, error) {
+	return ds.GetBackedHas(ctx, d, key)
+}
+
+// GetSize implements ds.Read.
+func (d *PebbleDatastore) GetSize(ctx context.Context, key ds.Key) (int, error) {
+	return ds.GetBackedSize(ctx, d, key)
+}
+
+// Query implements ds.Read. The entries under the prefix are read in key order, and the filters,
+// orders, offset and limit of the query applied to them.
+func (d *PebbleDatastore) Query(_ context.Context, q dsq.Query) (dsq.Results, error) {
+	prefix := queryPrefix(q.Prefix)
+	iter, err := d.db.NewIter(&pebble.IterOptions{LowerBound: []byte(prefix), UpperBound: prefixUpperBound(prefix)})
+	if err != nil {
+		return nil, err
+	}
+	valid := iter.First()
+	results := dsq.ResultsFromIterator(q, dsq.Iterator{
+		Next: func() (dsq.Result, bool) {
+			if !valid {
+				return dsq.Result{}, false
+			}
+			entry := dsq.Entry{Key: string(iter.Key())}
+			value := iter.Value()
+			if !q.KeysOnly {
+				entry.Value = bytes.Clone(value)
+			}
+			entry.Size = len(value)
+			valid = iter.Next()
+			return dsq.Result{Entry: entry}, true
+		},
+		Close: iter.Close,
+	})
+
+	// the entries are already in key order and under the prefix
+	naive := q
+	naive.Prefix = ""
+	if len(naive.Orders) > 0 {
+		if _, ok := naive.Orders[0].(dsq.OrderByKey); ok {
+			naive.Orders = nil
+		}
+	}
+	return dsq.NaiveQueryApply(naive, results), nil
+}
+
+// Put implements ds.Write.
+func (d *PebbleDatastore) Put(_ context.Context, key ds.Key, value []byte) error {
+	return d.db.Set(key.Bytes(), value, pebble.NoSync)
+}
+
+// Delete implements ds.Write.
+func (d *PebbleDatastore) Delete(_ context.Context, key ds.Key) error {
+	return d.db.Delete(key.Bytes(), pebble.NoSync)
+}
+
+// Sync implements ds.Datastore by syncing the write-ahead log of all the keys.
+func (d *PebbleDatastore) Sync(context.Context, ds.Key) error {
+	return d.db.LogData(nil, pebble.Sync)
+}
+
+// Close implements ds.Datastore.
+func (d *PebbleDatastore) Close() error {
+	return d.db.Close()
+}
+
+// Batch implements ds.Batching.
+func (d *PebbleDatastore) Batch(context.Context) (ds.Batch, error) {
+	return &pebbleBatch{batch: d.db.NewBatch()}, nil
+}
+
+// DiskUsage implements ds.PersistentFeature.
+func (d *PebbleDatastore) DiskUsage(context.Context) (uint64, error) {
+	return d.db.Metrics().DiskSpaceUsage(), nil
+}
+
+// CollectGarbage implements ds.GCFeature by compacting all the keys, dropping the deleted
+// entries from disk.
+func (d *PebbleDatastore) CollectGarbage(context.Context) error {
+	iter, err := d.db.NewIter(nil)
+	if err != nil {
+		return err
+	}
+	var first, last []byte
+	if iter.First() {
+		first = bytes.Clone(iter.Key())
+	}
+	if iter.Last() {
+		last = bytes.Clone(iter.Key())
+	}
+	if err := iter.Close(); err != nil {
+		return err
+	}
+	if first == nil {
+		return nil
+	}
+	// the end of the range is exclusive
+	return d.db.Compact(first, append(last, 0), true)
+}
+
+// pebbleBatch is a batch of writes to a PebbleDatastore, applied atomically on commit.
+type pebbleBatch struct {
+	batch *pebble.Batch
+}
+
+// Put implements ds.Batch.
+func (b *pebbleBatch) Put(_ context.Context, key ds.Key, value []byte) error {
+	return b.batch.Set(key.Bytes(), value, nil)
+}
+
+// Delete implements ds.Batch.
+func (b *pebbleBatch) Delete(_ context.Context, key ds.Key) error {
+	return b.batch.Delete(key.Bytes(), nil)
+}
+
+// Commit implements ds.Batch.
+func (b *pebbleBatch) Commit(context.Context) error {
+	defer b.batch.Close()
+	return b.batch.Commit(pebble.NoSync)
+}
+
+// queryPrefix returns the prefix of the keys matched by the prefix of a query: a prefix /a
+// matches /a/b but not /ab.
+func queryPrefix(prefix string) string {
+	prefix = path.Clean("/" + prefix)
+	if prefix == "/" {
+		return ""
+	}
+	return prefix + "/"
+}
+
+// prefixUpperBound returns the smallest key greater than all the keys with the given prefix, nil
+// if there is none.
+func prefixUpperBound(prefix string) []byte {
+	upper := []byte(prefix)
+	for i := len(upper) - 1; i >= 0; i-- {
+		if upper[i] < 0xff {
+			upper[i]++
+			return upper[:i+1]
+		}
+	}
+	return nil
+}
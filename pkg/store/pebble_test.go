@@ -0,0 +1,84 @@
+package store
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+
+	ds "github.com/ipfs/go-datastore"
+	dstest "github.com/ipfs/go-datastore/test"
+	"github.com/stretchr/testify/require"
+
+	"github.com/evstack/ev-node/types"
+)
+
+func newTestPebbleDatastore(t *testing.T) *PebbleDatastore {
+	t.Helper()
+	d, err := NewPebbleDatastore(t.TempDir(), nil)
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = d.Close() })
+	return d
+}
+
+func TestPebbleDatastore(t *testing.T) {
+	t.Parallel()
+	dstest.SubtestAll(t, newTestPebbleDatastore(t))
+}
+
+func TestPebbleDatastoreBatch(t *testing.T) {
+	t.Parallel()
+	dstest.RunBatchTest(t, newTestPebbleDatastore(t))
+	dstest.RunBatchDeleteTest(t, newTestPebbleDatastore(t))
+	dstest.RunBatchPutAndDeleteTest(t, newTestPebbleDatastore(t))
+}
+
+func TestPebbleStore(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	d := newTestPebbleDatastore(t)
+	s := New(d)
+
+	for h := uint64(1); h <= 3; h++ {
+		header, data := types.GetRandomBlock(h, 2, "test-pebble")
+		require.NoError(t, s.SaveBlockData(ctx, header, data, &header.Signature))
+		require.NoError(t, s.SetHeight(ctx, h))
+	}
+	height, err := s.Height(ctx)
+	require.NoError(t, err)
+	require.Equal(t, uint64(3), height)
+	_, data, err := s.GetBlockData(ctx, 2)
+	require.NoError(t, err)
+	require.Len(t, data.Txs, 2)
+
+	require.NoError(t, s.(*DefaultStore).CollectGarbage(ctx))
+	usage, err := s.(*DefaultStore).DiskUsage(ctx)
+	require.NoError(t, err)
+	require.NotZero(t, usage)
+}
+
+func TestKVStoreBackends(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	root := t.TempDir()
+
+	_, err := NewKVStore("rocksdb", root, "data", "test")
+	require.ErrorContains(t, err, "unknown store backend")
+
+	kv, err := NewKVStore(BackendPebble, root, "data", "test")
+	require.NoError(t, err)
+	require.NoError(t, kv.Put(ctx, ds.NewKey("/a"), []byte("b")))
+	require.NoError(t, kv.Close())
+
+	backend, err := DetectBackend(filepath.Join(root, "data", "test"))
+	require.NoError(t, err)
+	require.Equal(t, BackendPebble, backend)
+	_, err = NewKVStore(BackendBadger, root, "data", "test")
+	require.ErrorContains(t, err, "uses the pebble backend")
+
+	kv, err = NewKVStore(BackendPebble, root, "data", "test")
+	require.NoError(t, err)
+	value, err := kv.Get(ctx, ds.NewKey("/a"))
+	require.NoError(t, err)
+	require.Equal(t, []byte("b"), value)
+	require.NoError(t, kv.Close())
+}
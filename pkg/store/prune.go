@@ -0,0 +1,266 @@
+package store
+
+import (
+	"bytes"
+	"context"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"strconv"
+
+	ds "github.com/ipfs/go-datastore"
+
+	"github.com/evstack/ev-node/types"
+)
+
+// prunedPrefix is the key prefix of the markers of heights whose data was pruned.
+const prunedPrefix = "pr"
+
+// ErrPruned is returned when reading the data of a block which was pruned. It wraps
+// ds.ErrNotFound, so callers handling missing blocks handle pruned ones alike.
+var ErrPruned = fmt.Errorf("block data pruned: %w", ds.ErrNotFound)
+
+// Pruner is implemented by stores which can prune the data of blocks and restore it later,
+// e.g. from DA.
+type Pruner interface {
+	// PruneBlockData deletes the data of the block at the given height, keeping its header,
+	// signature and metadata, including the DA heights it was included at.
+	PruneBlockData(ctx context.Context, height uint64) error
+	// RestoreBlockData saves the data of a pruned block again. The data must match the data hash
+	// of the header of the block.
+	RestoreBlockData(ctx context.Context, height uint64, data *types.Data) error
+	// IsPruned returns whether the data of the block at the given height was pruned.
+	IsPruned(ctx context.Context, height uint64) (bool, error)
+	// PruneBlock deletes the block at the given height entirely: its header, signature, data and
+	// state. It cannot be restored.
+	PruneBlock(ctx context.Context, height uint64) error
+}
+
+var _ Pruner = &DefaultStore{}
+
+func getPrunedKey(height uint64) string {
+	return GenerateKey([]string{prunedPrefix, strconv.FormatUint(height, 10)})
+}
+
+// PruneBlockData deletes the data of the block at the given height, keeping its header, signature
+// and metadata, so that it can be restored from DA. Only blocks below the current height which are
+// included on DA can be pruned. Blocks without transactions are left as is, their data being
+// derived from the header.
+func (s *DefaultStore) PruneBlockData(ctx context.Context, height uint64) error {
+	if err := s.checkPrunable(ctx, height); err != nil {
+		return err
+	}
+
+	s.chunkMu.Lock()
+	defer s.chunkMu.Unlock()
+
+	if pruned, err := s.IsPruned(ctx, height); err != nil || pruned {
+		return err
+	}
+	data, err := s.getData(ctx, height)
+	if err != nil {
+		return fmt.Errorf("failed to get data at height %d: %w", height, err)
+	}
+	if len(data.Txs) == 0 {
+		return nil
+	}
+	if _, err := s.GetMetadata(ctx, fmt.Sprintf("%s/%d/d", HeightToDAHeightKey, height)); err != nil {
+		return fmt.Errorf("cannot prune height %d: DA height of its data unknown: %w", height, err)
+	}
+
+	batch, err := s.db.Batch(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to create a new batch: %w", err)
+	}
+	if err := s.deleteTxIndex(ctx, batch, data, height); err != nil {
+		return err
+	}
+	// blocks saved before the block index existed are indexed while their data is at hand
+	header, err := s.GetHeader(ctx, height)
+	if err != nil {
+		return fmt.Errorf("failed to get header at height %d: %w", height, err)
+	}
+	if err := batch.Put(ctx, ds.NewKey(getBlockIndexKey(height)), encodeBlockIndex(header, data)); err != nil {
+		return fmt.Errorf("failed to put block index key in batch: %w", err)
+	}
+	if err := batch.Delete(ctx, ds.NewKey(getDataKey(height))); err != nil {
+		return fmt.Errorf("failed to delete data blob in batch: %w", err)
+	}
+	refs := newChunkRefs()
+	chunked, err := s.releaseStoredData(ctx, height, refs)
+	if err != nil {
+		return err
+	}
+	if chunked {
+		if err := batch.Delete(ctx, ds.NewKey(getStoredDataKey(height))); err != nil {
+			return fmt.Errorf("failed to delete data blob in batch: %w", err)
+		}
+	}
+	if err := s.applyChunkRefs(ctx, batch, refs); err != nil {
+		return err
+	}
+	if err := batch.Put(ctx, ds.NewKey(getPrunedKey(height)), []byte{}); err != nil {
+		return fmt.Errorf("failed to put pruned marker in batch: %w", err)
+	}
+	if err := batch.Commit(ctx); err != nil {
+		return fmt.Errorf("failed to commit batch: %w", err)
+	}
+	return nil
+}
+
+// PruneBlock deletes the block at the given height entirely, with its indexes and the state after
+// it, keeping only its metadata. Like PruneBlockData, only blocks below the current height which
+// are included on DA can be pruned. Blocks already deleted are left as is.
+func (s *DefaultStore) PruneBlock(ctx context.Context, height uint64) error {
+	if err := s.checkPrunable(ctx, height); err != nil {
+		return err
+	}
+
+	s.chunkMu.Lock()
+	defer s.chunkMu.Unlock()
+
+	header, err := s.GetHeader(ctx, height)
+	if errors.Is(err, ds.ErrNotFound) {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("failed to get header at height %d: %w", height, err)
+	}
+
+	summary, err := s.blockSummary(ctx, height)
+	if err != nil {
+		return fmt.Errorf("failed to get block index at height %d: %w", height, err)
+	}
+
+	batch, err := s.db.Batch(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to create a new batch: %w", err)
+	}
+	// the data of the block may have been pruned already
+	data, err := s.getData(ctx, height)
+	switch {
+	case err == nil:
+		if err := s.deleteTxIndex(ctx, batch, data, height); err != nil {
+			return err
+		}
+	case !errors.Is(err, ErrPruned):
+		return fmt.Errorf("failed to get data at height %d: %w", height, err)
+	}
+	refs := newChunkRefs()
+	if _, err := s.releaseStoredData(ctx, height, refs); err != nil {
+		return err
+	}
+	if err := s.applyChunkRefs(ctx, batch, refs); err != nil {
+		return err
+	}
+	for _, key := range []string{
+		getHeaderKey(height),
+		getDataKey(height),
+		getStoredDataKey(height),
+		getSignatureKey(height),
+		getIndexKey(header.Hash()),
+		getBlockIndexKey(height),
+		getStateAtHeightKey(height),
+		getPrunedKey(height),
+	} {
+		if err := batch.Delete(ctx, ds.NewKey(key)); err != nil {
+			return fmt.Errorf("failed to delete %s in batch: %w", key, err)
+		}
+	}
+	for _, key := range searchIndexKeys(summary) {
+		if err := batch.Delete(ctx, ds.NewKey(key)); err != nil {
+			return fmt.Errorf("failed to delete search index key in batch: %w", err)
+		}
+	}
+	if err := batch.Commit(ctx); err != nil {
+		return fmt.Errorf("failed to commit batch: %w", err)
+	}
+	return nil
+}
+
+// checkPrunable returns an error if the block at the given height cannot be pruned, because it is
+// not below the current height or not included on DA.
+func (s *DefaultStore) checkPrunable(ctx context.Context, height uint64) error {
+	currentHeight, err := s.Height(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to get current height: %w", err)
+	}
+	if height == 0 || height >= currentHeight {
+		return fmt.Errorf("cannot prune height %d: only heights below the current height %d can be pruned", height, currentHeight)
+	}
+	daIncludedHeightBz, err := s.GetMetadata(ctx, DAIncludedHeightKey)
+	if err != nil && !errors.Is(err, ds.ErrNotFound) {
+		return fmt.Errorf("failed to get DA included height: %w", err)
+	}
+	if len(daIncludedHeightBz) != 8 || binary.LittleEndian.Uint64(daIncludedHeightBz) < height {
+		return fmt.Errorf("cannot prune height %d: not included on DA", height)
+	}
+	return nil
+}
+
+// RestoreBlockData saves the data of a pruned block again, after checking it against the data
+// hash of the header of the block.
+func (s *DefaultStore) RestoreBlockData(ctx context.Context, height uint64, data *types.Data) error {
+	header, err := s.GetHeader(ctx, height)
+	if err != nil {
+		return fmt.Errorf("failed to get header at height %d: %w", height, err)
+	}
+	if data.Metadata != nil && data.Height() != height {
+		return fmt.Errorf("data of height %d does not match height %d", data.Height(), height)
+	}
+	if !bytes.Equal(data.DACommitment(), header.DataHash) {
+		return fmt.Errorf("data does not match the data hash of the header at height %d", height)
+	}
+	dataBlob, err := data.MarshalBinary()
+	if err != nil {
+		return fmt.Errorf("failed to marshal Data to binary: %w", err)
+	}
+
+	s.chunkMu.Lock()
+	defer s.chunkMu.Unlock()
+
+	pruned, err := s.IsPruned(ctx, height)
+	if err != nil {
+		return err
+	}
+	if !pruned {
+		return fmt.Errorf("data of height %d is not pruned", height)
+	}
+
+	batch, err := s.db.Batch(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to create a new batch: %w", err)
+	}
+	if err := batch.Put(ctx, ds.NewKey(getDataKey(height)), dataBlob); err != nil {
+		return fmt.Errorf("failed to put data blob in batch: %w", err)
+	}
+	// the index points to the latest block including a tx, which may be a later one
+	for i, tx := range data.Txs {
+		indexed, err := s.db.Get(ctx, ds.NewKey(getTxIndexKey(tx)))
+		if err != nil && !errors.Is(err, ds.ErrNotFound) {
+			return fmt.Errorf("failed to get tx index key: %w", err)
+		}
+		if location, err := decodeTxLocation(indexed); err == nil && location.Height > height {
+			continue
+		}
+		if err := batch.Put(ctx, ds.NewKey(getTxIndexKey(tx)), encodeTxLocation(TxLocation{Height: height, Index: uint32(i)})); err != nil {
+			return fmt.Errorf("failed to put tx index key in batch: %w", err)
+		}
+	}
+	if err := batch.Delete(ctx, ds.NewKey(getPrunedKey(height))); err != nil {
+		return fmt.Errorf("failed to delete pruned marker in batch: %w", err)
+	}
+	if err := batch.Commit(ctx); err != nil {
+		return fmt.Errorf("failed to commit batch: %w", err)
+	}
+	return nil
+}
+
+// IsPruned returns whether the data of the block at the given height was pruned.
+func (s *DefaultStore) IsPruned(ctx context.Context, height uint64) (bool, error) {
+	pruned, err := s.db.Has(ctx, ds.NewKey(getPrunedKey(height)))
+	if err != nil {
+		return false, fmt.Errorf("failed to get pruned marker: %w", err)
+	}
+	return pruned, nil
+}
@@ -0,0 +1,79 @@
+package store
+
+import (
+	"context"
+	"encoding/binary"
+	"fmt"
+	"testing"
+
+	ds "github.com/ipfs/go-datastore"
+	"github.com/stretchr/testify/require"
+
+	"github.com/evstack/ev-node/types"
+)
+
+func TestPruneAndRestoreBlockData(t *testing.T) {
+	t.Parallel()
+	require := require.New(t)
+
+	ctx := context.Background()
+	store := New(mustNewInMem()).(*DefaultStore)
+	chainID := "test-prune"
+
+	var blocks []*types.Data
+	for h := uint64(1); h <= 4; h++ {
+		txs := 2
+		if h == 2 {
+			txs = 0
+		}
+		header, data := types.GetRandomBlock(h, txs, chainID)
+		require.NoError(store.SaveBlockData(ctx, header, data, &header.Signature))
+		require.NoError(store.SetHeight(ctx, h))
+		require.NoError(store.SetMetadata(ctx, fmt.Sprintf("%s/%d/d", HeightToDAHeightKey, h), binary.LittleEndian.AppendUint64(nil, 100+h)))
+		blocks = append(blocks, data)
+	}
+
+	// heights not included on DA cannot be pruned
+	require.ErrorContains(store.PruneBlockData(ctx, 1), "not included on DA")
+	require.NoError(store.SetMetadata(ctx, DAIncludedHeightKey, binary.LittleEndian.AppendUint64(nil, 4)))
+	// nor the current height
+	require.Error(store.PruneBlockData(ctx, 4))
+
+	require.NoError(store.PruneBlockData(ctx, 1))
+	pruned, err := store.IsPruned(ctx, 1)
+	require.NoError(err)
+	require.True(pruned)
+	_, _, err = store.GetBlockData(ctx, 1)
+	require.ErrorIs(err, ErrPruned)
+	require.ErrorIs(err, ds.ErrNotFound)
+	// the header and the DA coordinates are kept
+	_, err = store.GetHeader(ctx, 1)
+	require.NoError(err)
+	_, err = store.GetMetadata(ctx, fmt.Sprintf("%s/%d/d", HeightToDAHeightKey, 1))
+	require.NoError(err)
+	txIndexKey := func(tx []byte) ds.Key { return ds.NewKey(getTxIndexKey(tx)) }
+	has, err := store.db.Has(ctx, txIndexKey(blocks[0].Txs[0]))
+	require.NoError(err)
+	require.False(has)
+
+	// pruning is idempotent, and blocks without transactions are kept
+	require.NoError(store.PruneBlockData(ctx, 1))
+	require.NoError(store.PruneBlockData(ctx, 2))
+	pruned, err = store.IsPruned(ctx, 2)
+	require.NoError(err)
+	require.False(pruned)
+
+	// only the data committed to by the header is restored
+	require.ErrorContains(store.RestoreBlockData(ctx, 1, blocks[2]), "does not match")
+	require.ErrorContains(store.RestoreBlockData(ctx, 3, blocks[2]), "not pruned")
+	require.NoError(store.RestoreBlockData(ctx, 1, blocks[0]))
+	_, data, err := store.GetBlockData(ctx, 1)
+	require.NoError(err)
+	require.Equal(blocks[0].Txs, data.Txs)
+	pruned, err = store.IsPruned(ctx, 1)
+	require.NoError(err)
+	require.False(pruned)
+	has, err = store.db.Has(ctx, txIndexKey(blocks[0].Txs[0]))
+	require.NoError(err)
+	require.True(has)
+}
@@ -0,0 +1,191 @@
+package store
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	ds "github.com/ipfs/go-datastore"
+	"github.com/rs/zerolog"
+)
+
+// PruningStrategy is the strategy of a PruningManager, selecting which blocks it deletes.
+type PruningStrategy string
+
+const (
+	// PruningArchive keeps all the blocks.
+	PruningArchive PruningStrategy = "archive"
+	// PruningDefault deletes the data of the blocks below a retention window of recent blocks,
+	// keeping their headers, signatures and DA heights to restore the data from DA.
+	PruningDefault PruningStrategy = "default"
+	// PruningEverything deletes the blocks below a minimal retention window entirely, keeping
+	// nothing but their metadata.
+	PruningEverything PruningStrategy = "everything"
+)
+
+// PruningEverythingKeepRecent is the number of recent blocks kept by PruningEverything.
+const PruningEverythingKeepRecent = 2
+
+// maxPrunedPerRound bounds the number of heights pruned by a round, so that pruning a large
+// backlog does not hold the store for long.
+const maxPrunedPerRound = 1000
+
+// ParsePruningStrategy parses the name of a pruning strategy. An empty name is PruningArchive.
+func ParsePruningStrategy(name string) (PruningStrategy, error) {
+	switch strategy := PruningStrategy(name); strategy {
+	case "":
+		return PruningArchive, nil
+	case PruningArchive, PruningDefault, PruningEverything:
+		return strategy, nil
+	default:
+		return "", fmt.Errorf("unknown pruning strategy %q, expected %q, %q or %q", name, PruningArchive, PruningDefault, PruningEverything)
+	}
+}
+
+// PrunedBaseHeight returns the height up to which the blocks of the store were pruned by a
+// PruningManager, 0 if none was.
+func PrunedBaseHeight(ctx context.Context, s Store) (uint64, error) {
+	bz, err := s.GetMetadata(ctx, PrunedBaseHeightKey)
+	if errors.Is(err, ds.ErrNotFound) {
+		return 0, nil
+	}
+	if err != nil {
+		return 0, fmt.Errorf("failed to get pruned base height: %w", err)
+	}
+	return decodeHeight(bz)
+}
+
+// PruningManager deletes the blocks of a store below a retention window of recent blocks,
+// according to its strategy, and tracks the height up to which they were pruned under
+// PrunedBaseHeightKey. Only blocks included on DA are pruned.
+type PruningManager struct {
+	store      Store
+	pruner     Pruner
+	strategy   PruningStrategy
+	keepRecent uint64
+	logger     zerolog.Logger
+}
+
+// NewPruningManager creates a pruning manager of the store with the given strategy. keepRecent is
+// the number of recent blocks kept by PruningDefault, and is ignored by the other strategies.
+func NewPruningManager(s Store, strategy PruningStrategy, keepRecent uint64, logger zerolog.Logger) (*PruningManager, error) {
+	strategy, err := ParsePruningStrategy(string(strategy))
+	if err != nil {
+		return nil, err
+	}
+	switch strategy {
+	case PruningDefault:
+		if keepRecent == 0 {
+			return nil, errors.New("the default pruning strategy requires keeping at least one recent block")
+		}
+	case PruningEverything:
+		keepRecent = PruningEverythingKeepRecent
+	}
+	pruner, ok := s.(Pruner)
+	if !ok && strategy != PruningArchive {
+		return nil, errors.New("store does not support pruning")
+	}
+	return &PruningManager{
+		store:      s,
+		pruner:     pruner,
+		strategy:   strategy,
+		keepRecent: keepRecent,
+		logger:     logger,
+	}, nil
+}
+
+// Run prunes the store every interval until ctx is done. Failures are logged and retried at the
+// next round.
+func (p *PruningManager) Run(ctx context.Context, interval time.Duration) {
+	if p.strategy == PruningArchive {
+		return
+	}
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		if _, err := p.Prune(ctx); err != nil && ctx.Err() == nil {
+			p.logger.Warn().Err(err).Str("strategy", string(p.strategy)).Msg("failed to prune store")
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// Prune runs a round of pruning: the heights above the pruned base height, below the retention
+// window and included on DA, are pruned, up to maxPrunedPerRound of them. It returns the pruned
+// base height after the round.
+func (p *PruningManager) Prune(ctx context.Context) (uint64, error) {
+	base, err := PrunedBaseHeight(ctx, p.store)
+	if err != nil || p.strategy == PruningArchive {
+		return base, err
+	}
+	height, err := p.store.Height(ctx)
+	if err != nil {
+		return base, fmt.Errorf("failed to get height: %w", err)
+	}
+	if height <= p.keepRecent {
+		return base, nil
+	}
+	daIncludedHeightBz, err := p.store.GetMetadata(ctx, DAIncludedHeightKey)
+	if err != nil && !errors.Is(err, ds.ErrNotFound) {
+		return base, fmt.Errorf("failed to get DA included height: %w", err)
+	}
+	var daIncludedHeight uint64
+	if len(daIncludedHeightBz) == heightLength {
+		daIncludedHeight, _ = decodeHeight(daIncludedHeightBz)
+	}
+	target := min(height-p.keepRecent, daIncludedHeight, base+maxPrunedPerRound)
+	if target <= base {
+		return base, nil
+	}
+
+	pruned := base
+	for h := base + 1; h <= target; h++ {
+		if err = p.pruneHeight(ctx, h); err != nil {
+			err = fmt.Errorf("failed to prune height %d: %w", h, err)
+			break
+		}
+		pruned = h
+	}
+	if pruned > base {
+		if err := p.store.SetMetadata(ctx, PrunedBaseHeightKey, encodeHeight(pruned)); err != nil {
+			return base, fmt.Errorf("failed to set pruned base height: %w", err)
+		}
+		p.logger.Debug().Uint64("from", base+1).Uint64("to", pruned).Str("strategy", string(p.strategy)).Msg("pruned store")
+	}
+	return pruned, err
+}
+
+// PruneBacklog runs rounds of pruning until every prunable height is pruned, e.g. to free disk
+// space in an emergency rather than at the pace of Run. It returns the pruned base height after
+// the last round.
+func (p *PruningManager) PruneBacklog(ctx context.Context) (uint64, error) {
+	base, err := PrunedBaseHeight(ctx, p.store)
+	if err != nil {
+		return 0, err
+	}
+	for ctx.Err() == nil {
+		pruned, err := p.Prune(ctx)
+		if err != nil || pruned == base {
+			return pruned, err
+		}
+		base = pruned
+	}
+	return base, ctx.Err()
+}
+
+// pruneHeight prunes the block at the given height according to the strategy. Heights without a
+// block, e.g. below the initial height of the chain, are skipped.
+func (p *PruningManager) pruneHeight(ctx context.Context, height uint64) error {
+	if p.strategy == PruningEverything {
+		return p.pruner.PruneBlock(ctx, height)
+	}
+	if _, err := p.store.GetHeader(ctx, height); errors.Is(err, ds.ErrNotFound) {
+		return nil
+	}
+	return p.pruner.PruneBlockData(ctx, height)
+}
@@ -0,0 +1,105 @@
+package store
+
+import (
+	"context"
+	"encoding/binary"
+	"fmt"
+	"testing"
+
+	ds "github.com/ipfs/go-datastore"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/require"
+
+	"github.com/evstack/ev-node/types"
+)
+
+func TestPruningManager(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	// newStore returns a store of 10 blocks, the first 8 of which are included on DA
+	newStore := func(t *testing.T) *DefaultStore {
+		store := New(mustNewInMem()).(*DefaultStore)
+		for h := uint64(1); h <= 10; h++ {
+			header, data := types.GetRandomBlock(h, 2, "test-pruning")
+			require.NoError(t, store.SaveBlockData(ctx, header, data, &header.Signature))
+			require.NoError(t, store.SetHeight(ctx, h))
+			require.NoError(t, store.SetMetadata(ctx, fmt.Sprintf("%s/%d/d", HeightToDAHeightKey, h), binary.LittleEndian.AppendUint64(nil, 100+h)))
+		}
+		require.NoError(t, store.SetMetadata(ctx, DAIncludedHeightKey, binary.LittleEndian.AppendUint64(nil, 8)))
+		return store
+	}
+
+	t.Run("strategies", func(t *testing.T) {
+		_, err := ParsePruningStrategy("nothing")
+		require.Error(t, err)
+		_, err = NewPruningManager(newStore(t), PruningDefault, 0, zerolog.Nop())
+		require.Error(t, err)
+	})
+
+	t.Run("archive", func(t *testing.T) {
+		store := newStore(t)
+		p, err := NewPruningManager(store, PruningArchive, 0, zerolog.Nop())
+		require.NoError(t, err)
+		base, err := p.Prune(ctx)
+		require.NoError(t, err)
+		require.Zero(t, base)
+		_, _, err = store.GetBlockData(ctx, 1)
+		require.NoError(t, err)
+	})
+
+	t.Run("default", func(t *testing.T) {
+		store := newStore(t)
+		p, err := NewPruningManager(store, PruningDefault, 5, zerolog.Nop())
+		require.NoError(t, err)
+		base, err := p.Prune(ctx)
+		require.NoError(t, err)
+		require.Equal(t, uint64(5), base)
+		stored, err := PrunedBaseHeight(ctx, store)
+		require.NoError(t, err)
+		require.Equal(t, base, stored)
+
+		// the data is deleted, the headers are kept
+		_, _, err = store.GetBlockData(ctx, 5)
+		require.ErrorIs(t, err, ErrPruned)
+		_, err = store.GetHeader(ctx, 5)
+		require.NoError(t, err)
+		_, _, err = store.GetBlockData(ctx, 6)
+		require.NoError(t, err)
+
+		// the next round starts from the pruned base height
+		base, err = p.Prune(ctx)
+		require.NoError(t, err)
+		require.Equal(t, uint64(5), base)
+	})
+
+	t.Run("everything", func(t *testing.T) {
+		store := newStore(t)
+		p, err := NewPruningManager(store, PruningEverything, 0, zerolog.Nop())
+		require.NoError(t, err)
+		// the window of recent blocks is 2 blocks, but blocks are only pruned once on DA
+		base, err := p.Prune(ctx)
+		require.NoError(t, err)
+		require.Equal(t, uint64(8), base)
+
+		header, err := store.GetHeader(ctx, 8)
+		require.ErrorIs(t, err, ErrPruned)
+		require.ErrorIs(t, err, ds.ErrNotFound)
+		require.Nil(t, header)
+		_, err = store.GetSignature(ctx, 8)
+		require.ErrorIs(t, err, ds.ErrNotFound)
+		_, _, err = store.GetBlockData(ctx, 9)
+		require.NoError(t, err)
+	})
+
+	t.Run("backlog", func(t *testing.T) {
+		store := newStore(t)
+		p, err := NewPruningManager(store, PruningDefault, 1, zerolog.Nop())
+		require.NoError(t, err)
+		base, err := p.PruneBacklog(ctx)
+		require.NoError(t, err)
+		require.Equal(t, uint64(8), base)
+		_, _, err = store.GetBlockData(ctx, 8)
+		require.ErrorIs(t, err, ErrPruned)
+	})
+}
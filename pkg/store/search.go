@@ -0,0 +1,414 @@
+package store
+
+import (
+	"bytes"
+	"container/heap"
+	"context"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"math"
+	"path"
+	"slices"
+	"strconv"
+	"time"
+
+	ds "github.com/ipfs/go-datastore"
+	dsq "github.com/ipfs/go-datastore/query"
+
+	"github.com/evstack/ev-node/types"
+)
+
+// blockIndexHeaderLength is the length of the fixed part of a block index entry: the number of
+// transactions, the time in unix nanoseconds and the hash of the header, followed by the address
+// of the proposer.
+const blockIndexHeaderLength = 8 + 8 + 32
+
+// BlockSummary is the metadata of a block matched by SearchBlocks.
+type BlockSummary struct {
+	Height          uint64
+	Hash            types.Hash
+	Time            time.Time
+	ProposerAddress []byte
+	TxCount         uint64
+	// txCountKnown is false for blocks pruned before they were indexed
+	txCountKnown bool
+}
+
+// BlockQuery selects blocks by the metadata of their header and data. Unset fields match any
+// block.
+type BlockQuery struct {
+	// ProposerAddress matches the blocks proposed by the address.
+	ProposerAddress []byte
+	// MinTxs matches the blocks with at least the number of transactions.
+	MinTxs uint64
+	// MaxTxs matches the blocks with at most the number of transactions.
+	MaxTxs *uint64
+	// StartTime matches the blocks produced at or after the time.
+	StartTime time.Time
+	// EndTime matches the blocks produced before the time.
+	EndTime time.Time
+	// FromHeight is the first height searched.
+	FromHeight uint64
+	// ToHeight is the last height searched, or 0 for the current height.
+	ToHeight uint64
+	// Limit is the maximum number of blocks returned, or 0 for no limit.
+	Limit int
+}
+
+// BlockSearcher is implemented by stores which keep the metadata of blocks to search them.
+type BlockSearcher interface {
+	// SearchBlocks returns the blocks matching the query in ascending height order, and the height
+	// to continue the search from, or 0 if the search is complete.
+	SearchBlocks(ctx context.Context, query BlockQuery) ([]BlockSummary, uint64, error)
+}
+
+var _ BlockSearcher = &DefaultStore{}
+
+// searchIndexBatchSize is the number of blocks indexed per batch when indexing the blocks saved
+// before the search indexes existed.
+const searchIndexBatchSize = 1000
+
+// SearchBlocks returns the blocks matching the query in ascending height order. The blocks are
+// looked up in the indexes written by SaveBlockData: the time range is resolved to a height range
+// with the time index, then the heights of the candidate blocks are read from the proposer index
+// if the query selects a proposer, from the transaction count index if it selects a number of
+// transactions, or are the whole height range otherwise. Candidates are checked against the
+// metadata of the block, so that entries left by blocks overwritten or rolled back concurrently
+// are not matched. The returned height continues the search if the limit is reached.
+func (s *DefaultStore) SearchBlocks(ctx context.Context, query BlockQuery) ([]BlockSummary, uint64, error) {
+	if err := s.indexBlocks(ctx); err != nil {
+		return nil, 0, err
+	}
+
+	from, to := max(query.FromHeight, 1), query.ToHeight
+	height, err := s.Height(ctx)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to get current height: %w", err)
+	}
+	if to == 0 || to > height {
+		to = height
+	}
+
+	if !query.StartTime.IsZero() {
+		first, err := s.searchHeightAt(ctx, from, to, query.StartTime)
+		if err != nil {
+			return nil, 0, err
+		}
+		from = first
+	}
+	if !query.EndTime.IsZero() {
+		end, err := s.searchHeightAt(ctx, from, to, query.EndTime)
+		if err != nil {
+			return nil, 0, err
+		}
+		to = end - 1
+	}
+	if from > to {
+		return nil, 0, nil
+	}
+
+	var blocks []BlockSummary
+	var next uint64
+	visit := func(h uint64) (bool, error) {
+		if query.Limit > 0 && len(blocks) == query.Limit {
+			next = h
+			return false, nil
+		}
+		summary, err := s.blockSummary(ctx, h)
+		if errors.Is(err, ds.ErrNotFound) {
+			// below the initial height of the chain, or rolled back
+			return true, nil
+		}
+		if err != nil {
+			return false, err
+		}
+		if query.matches(summary) {
+			blocks = append(blocks, summary)
+		}
+		return true, nil
+	}
+
+	switch {
+	case len(query.ProposerAddress) > 0:
+		err = s.scanIndex(ctx, proposerIndexPrefix, getProposerIndexKey(query.ProposerAddress, from), getProposerIndexKey(query.ProposerAddress, to), visit)
+	case query.MinTxs > 0 || query.MaxTxs != nil:
+		var heights []uint64
+		heights, err = s.txCountHeights(ctx, query, from, to)
+		for _, h := range heights {
+			if ok, visitErr := visit(h); visitErr != nil || !ok {
+				err = visitErr
+				break
+			}
+		}
+	default:
+		for h := from; h <= to; h++ {
+			if ok, visitErr := visit(h); visitErr != nil || !ok {
+				err = visitErr
+				break
+			}
+		}
+	}
+	if err != nil {
+		return nil, 0, err
+	}
+	return blocks, next, nil
+}
+
+func (q BlockQuery) matches(summary BlockSummary) bool {
+	if len(q.ProposerAddress) > 0 && !bytes.Equal(q.ProposerAddress, summary.ProposerAddress) {
+		return false
+	}
+	if !q.StartTime.IsZero() && summary.Time.Before(q.StartTime) {
+		return false
+	}
+	if !q.EndTime.IsZero() && !summary.Time.Before(q.EndTime) {
+		return false
+	}
+	if q.MinTxs == 0 && q.MaxTxs == nil {
+		return true
+	}
+	if !summary.txCountKnown {
+		return false
+	}
+	return summary.TxCount >= q.MinTxs && (q.MaxTxs == nil || summary.TxCount <= *q.MaxTxs)
+}
+
+// searchHeightAt returns the first height in [from, to] of a block produced at or after t, or
+// to+1 if there is none. It is read from the time index, unless t is outside the times of the
+// blocks at from and to.
+func (s *DefaultStore) searchHeightAt(ctx context.Context, from, to uint64, t time.Time) (uint64, error) {
+	if from > to {
+		return from, nil
+	}
+	if first, err := s.blockSummary(ctx, from); err == nil && !first.Time.Before(t) {
+		return from, nil
+	}
+	if last, err := s.blockSummary(ctx, to); err == nil && last.Time.Before(t) {
+		return to + 1, nil
+	}
+
+	found := to + 1
+	err := s.scanIndex(ctx, timeIndexPrefix, getTimeIndexKey(t, 0), "", func(h uint64) (bool, error) {
+		if h < from {
+			return true, nil
+		}
+		found = min(h, found)
+		return false, nil
+	})
+	return found, err
+}
+
+// txCountHeights returns in ascending order the heights in [from, to] of the blocks whose number
+// of transactions is in the range of the query, from the transaction count index. The index is
+// sorted by number of transactions, so with a limit only the limit+1 lowest heights are kept.
+func (s *DefaultStore) txCountHeights(ctx context.Context, query BlockQuery, from, to uint64) ([]uint64, error) {
+	hi := ""
+	if query.MaxTxs != nil {
+		hi = getTxCountIndexKey(*query.MaxTxs, math.MaxUint64)
+	}
+	lowest := &heightHeap{}
+	err := s.scanIndex(ctx, txCountIndexPrefix, getTxCountIndexKey(query.MinTxs, 0), hi, func(h uint64) (bool, error) {
+		if h < from || h > to {
+			return true, nil
+		}
+		heap.Push(lowest, h)
+		if query.Limit > 0 && lowest.Len() > query.Limit+1 {
+			heap.Pop(lowest)
+		}
+		return true, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	heights := []uint64(*lowest)
+	slices.Sort(heights)
+	return heights, nil
+}
+
+// heightHeap is a max-heap of heights.
+type heightHeap []uint64
+
+func (h heightHeap) Len() int           { return len(h) }
+func (h heightHeap) Less(i, j int) bool { return h[i] > h[j] }
+func (h heightHeap) Swap(i, j int)      { h[i], h[j] = h[j], h[i] }
+func (h *heightHeap) Push(x any)        { *h = append(*h, x.(uint64)) }
+func (h *heightHeap) Pop() any {
+	old := *h
+	x := old[len(old)-1]
+	*h = old[:len(old)-1]
+	return x
+}
+
+// scanIndex calls fn in key order with the heights of the index entries under the prefix, from
+// the key lo to the key hi included, or to the end of the index if hi is empty, until fn returns
+// false.
+func (s *DefaultStore) scanIndex(ctx context.Context, prefix, lo, hi string, fn func(height uint64) (bool, error)) error {
+	results, err := s.db.Query(ctx, dsq.Query{
+		Prefix:   GenerateKey([]string{prefix}),
+		KeysOnly: true,
+		Orders:   []dsq.Order{dsq.OrderByKey{}},
+	})
+	if err != nil {
+		return fmt.Errorf("failed to query %s index: %w", prefix, err)
+	}
+	defer results.Close()
+
+	for result := range results.Next() {
+		if result.Error != nil {
+			return fmt.Errorf("failed to read %s index: %w", prefix, result.Error)
+		}
+		if result.Key < lo {
+			continue
+		}
+		if hi != "" && result.Key > hi {
+			return nil
+		}
+		height, err := strconv.ParseUint(path.Base(result.Key), 10, 64)
+		if err != nil {
+			return fmt.Errorf("%w: invalid %s index key: %s", ErrCorrupted, prefix, result.Key)
+		}
+		if ok, err := fn(height); err != nil || !ok {
+			return err
+		}
+	}
+	return nil
+}
+
+// searchIndexKeys returns the keys of the entries of a block in the proposer, time and
+// transaction count indexes.
+func searchIndexKeys(summary BlockSummary) []string {
+	keys := []string{getTimeIndexKey(summary.Time, summary.Height)}
+	if len(summary.ProposerAddress) > 0 {
+		keys = append(keys, getProposerIndexKey(summary.ProposerAddress, summary.Height))
+	}
+	if summary.txCountKnown {
+		keys = append(keys, getTxCountIndexKey(summary.TxCount, summary.Height))
+	}
+	return keys
+}
+
+// indexBlocks writes the search index entries of the blocks saved before the search indexes
+// existed, once, recording the height indexed up to under SearchIndexHeightKey. Later blocks are
+// indexed in the batch saving them.
+func (s *DefaultStore) indexBlocks(ctx context.Context) error {
+	s.searchIndexMu.Lock()
+	defer s.searchIndexMu.Unlock()
+	if s.searchIndexed {
+		return nil
+	}
+	_, err := s.GetMetadata(ctx, SearchIndexHeightKey)
+	if err == nil {
+		s.searchIndexed = true
+		return nil
+	}
+	if !errors.Is(err, ds.ErrNotFound) {
+		return fmt.Errorf("failed to get search index height: %w", err)
+	}
+
+	height, err := s.Height(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to get current height: %w", err)
+	}
+	batch, err := s.db.Batch(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to create a new batch: %w", err)
+	}
+	for h := uint64(1); h <= height; h++ {
+		summary, err := s.blockSummary(ctx, h)
+		if errors.Is(err, ds.ErrNotFound) {
+			continue
+		}
+		if err != nil {
+			return err
+		}
+		for _, key := range searchIndexKeys(summary) {
+			if err := batch.Put(ctx, ds.NewKey(key), []byte{}); err != nil {
+				return fmt.Errorf("failed to put search index key in batch: %w", err)
+			}
+		}
+		if h%searchIndexBatchSize == 0 {
+			if err := batch.Commit(ctx); err != nil {
+				return fmt.Errorf("failed to commit batch: %w", err)
+			}
+			if batch, err = s.db.Batch(ctx); err != nil {
+				return fmt.Errorf("failed to create a new batch: %w", err)
+			}
+		}
+	}
+	if err := batch.Put(ctx, ds.NewKey(getMetaKey(SearchIndexHeightKey)), encodeHeight(height)); err != nil {
+		return fmt.Errorf("failed to put search index height in batch: %w", err)
+	}
+	if err := batch.Commit(ctx); err != nil {
+		return fmt.Errorf("failed to commit batch: %w", err)
+	}
+	s.searchIndexed = true
+	return nil
+}
+
+// blockSummary returns the metadata of the block at the given height from the block index, or
+// from the block itself if it was saved before the index existed.
+func (s *DefaultStore) blockSummary(ctx context.Context, height uint64) (BlockSummary, error) {
+	value, err := s.db.Get(ctx, ds.NewKey(getBlockIndexKey(height)))
+	if err == nil {
+		return decodeBlockIndex(height, value)
+	}
+	if !errors.Is(err, ds.ErrNotFound) {
+		return BlockSummary{}, fmt.Errorf("failed to get block index: %w", err)
+	}
+
+	header, err := s.GetHeader(ctx, height)
+	if err != nil {
+		return BlockSummary{}, err
+	}
+	summary := BlockSummary{
+		Height:          height,
+		Hash:            header.Hash(),
+		Time:            header.Time(),
+		ProposerAddress: header.ProposerAddress,
+	}
+	data, err := s.getData(ctx, height)
+	switch {
+	case errors.Is(err, ErrPruned):
+	case err != nil:
+		return BlockSummary{}, fmt.Errorf("failed to get data at height %d: %w", height, err)
+	default:
+		summary.TxCount, summary.txCountKnown = uint64(len(data.Txs)), true
+	}
+	return summary, nil
+}
+
+// newBlockSummary returns the metadata of a block.
+func newBlockSummary(header *types.SignedHeader, data *types.Data) BlockSummary {
+	return BlockSummary{
+		Height:          header.Height(),
+		Hash:            header.Hash(),
+		Time:            header.Time(),
+		ProposerAddress: header.ProposerAddress,
+		TxCount:         uint64(len(data.Txs)),
+		txCountKnown:    true,
+	}
+}
+
+// encodeBlockIndex returns the block index entry of a block.
+func encodeBlockIndex(header *types.SignedHeader, data *types.Data) []byte {
+	value := make([]byte, blockIndexHeaderLength, blockIndexHeaderLength+len(header.ProposerAddress))
+	binary.LittleEndian.PutUint64(value, uint64(len(data.Txs)))
+	binary.LittleEndian.PutUint64(value[8:], uint64(header.Time().UnixNano()))
+	copy(value[16:blockIndexHeaderLength], header.Hash())
+	return append(value, header.ProposerAddress...)
+}
+
+func decodeBlockIndex(height uint64, value []byte) (BlockSummary, error) {
+	if len(value) < blockIndexHeaderLength {
+		return BlockSummary{}, fmt.Errorf("%w: invalid block index length: %d", ErrCorrupted, len(value))
+	}
+	return BlockSummary{
+		Height:          height,
+		TxCount:         binary.LittleEndian.Uint64(value),
+		Time:            time.Unix(0, int64(binary.LittleEndian.Uint64(value[8:]))),
+		Hash:            types.Hash(bytes.Clone(value[16:blockIndexHeaderLength])),
+		ProposerAddress: bytes.Clone(value[blockIndexHeaderLength:]),
+		txCountKnown:    true,
+	}, nil
+}
@@ -0,0 +1,174 @@
+package store
+
+import (
+	"context"
+	"encoding/binary"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	ds "github.com/ipfs/go-datastore"
+	dsq "github.com/ipfs/go-datastore/query"
+	"github.com/stretchr/testify/require"
+
+	"github.com/evstack/ev-node/types"
+)
+
+func TestSearchBlocks(t *testing.T) {
+	t.Parallel()
+	require := require.New(t)
+
+	ctx := context.Background()
+	store := New(mustNewInMem()).(*DefaultStore)
+	start := time.Unix(1_700_000_000, 0)
+	proposers := [][]byte{[]byte("alice"), []byte("bob")}
+	txCounts := []int{0, 1, 2, 3, 0, 5}
+	for i, txs := range txCounts {
+		h := uint64(i + 1)
+		header, data := types.GetRandomBlock(h, txs, "test-search")
+		header.BaseHeader.Time = uint64(start.Add(time.Duration(i) * time.Minute).UnixNano())
+		header.ProposerAddress = proposers[i%2]
+		require.NoError(store.SaveBlockData(ctx, header, data, &header.Signature))
+		require.NoError(store.SetHeight(ctx, h))
+		require.NoError(store.UpdateState(ctx, types.State{ChainID: "test-search", LastBlockHeight: h}))
+	}
+	heights := func(blocks []BlockSummary) []uint64 {
+		var heights []uint64
+		for _, block := range blocks {
+			heights = append(heights, block.Height)
+		}
+		return heights
+	}
+	search := func(query BlockQuery) []uint64 {
+		blocks, next, err := store.SearchBlocks(ctx, query)
+		require.NoError(err)
+		require.Zero(next)
+		return heights(blocks)
+	}
+	maxTxs := func(n uint64) *uint64 { return &n }
+
+	require.Equal([]uint64{1, 2, 3, 4, 5, 6}, search(BlockQuery{}))
+	require.Equal([]uint64{2, 4, 6}, search(BlockQuery{ProposerAddress: []byte("bob")}))
+	require.Equal([]uint64{3, 4, 6}, search(BlockQuery{MinTxs: 2}))
+	require.Equal([]uint64{1, 5}, search(BlockQuery{MaxTxs: maxTxs(0)}))
+	require.Equal([]uint64{2, 3, 4}, search(BlockQuery{MinTxs: 1, MaxTxs: maxTxs(3)}))
+	require.Equal([]uint64{3, 4}, search(BlockQuery{StartTime: start.Add(90 * time.Second), EndTime: start.Add(4 * time.Minute)}))
+	require.Equal([]uint64{4}, search(BlockQuery{ProposerAddress: []byte("bob"), MinTxs: 1, FromHeight: 3, ToHeight: 5}))
+	require.Empty(search(BlockQuery{StartTime: start.Add(time.Hour)}))
+
+	blocks, next, err := store.SearchBlocks(ctx, BlockQuery{Limit: 2, FromHeight: 2})
+	require.NoError(err)
+	require.Equal([]uint64{2, 3}, heights(blocks))
+	require.Equal(uint64(4), next)
+	require.Equal(uint64(2), blocks[1].TxCount)
+	require.Equal([]byte("alice"), blocks[1].ProposerAddress)
+	require.Equal(start.Add(2*time.Minute).UnixNano(), blocks[1].Time.UnixNano())
+	header, err := store.GetHeader(ctx, 3)
+	require.NoError(err)
+	require.Equal(header.Hash(), blocks[1].Hash)
+
+	// blocks saved before the index existed are read in full, and indexed when pruned
+	for h := uint64(1); h <= 3; h++ {
+		require.NoError(store.db.Delete(ctx, ds.NewKey(getBlockIndexKey(h))))
+		require.NoError(store.SetMetadata(ctx, fmt.Sprintf("%s/%d/d", HeightToDAHeightKey, h), binary.LittleEndian.AppendUint64(nil, h)))
+	}
+	require.Equal([]uint64{3, 4, 6}, search(BlockQuery{MinTxs: 2}))
+	require.NoError(store.SetMetadata(ctx, DAIncludedHeightKey, binary.LittleEndian.AppendUint64(nil, 3)))
+	require.NoError(store.PruneBlockData(ctx, 3))
+	require.Equal([]uint64{3, 4, 6}, search(BlockQuery{MinTxs: 2}))
+
+	// rolled back blocks are not found, and their index entries are deleted
+	require.NoError(store.Rollback(ctx, 4))
+	require.Equal([]uint64{3, 4}, search(BlockQuery{MinTxs: 2}))
+	for _, key := range []string{getProposerIndexKey([]byte("bob"), 6), getTxCountIndexKey(5, 6), getTimeIndexKey(start.Add(5*time.Minute), 6)} {
+		has, err := store.db.Has(ctx, ds.NewKey(key))
+		require.NoError(err)
+		require.False(has, key)
+	}
+}
+
+func TestSearchBlocks_Indexes(t *testing.T) {
+	t.Parallel()
+	require := require.New(t)
+
+	ctx := context.Background()
+	db := mustNewInMem()
+	store := New(db).(*DefaultStore)
+	start := time.Unix(1_700_000_000, 0)
+	proposers := [][]byte{[]byte("alice"), []byte("bob"), []byte("carol")}
+	for i := range 30 {
+		h := uint64(i + 1)
+		header, data := types.GetRandomBlock(h, i%4, "test-search")
+		header.BaseHeader.Time = uint64(start.Add(time.Duration(i) * time.Minute).UnixNano())
+		header.ProposerAddress = proposers[i%3]
+		require.NoError(store.SaveBlockData(ctx, header, data, &header.Signature))
+		require.NoError(store.SetHeight(ctx, h))
+	}
+	search := func(store *DefaultStore, query BlockQuery) ([]uint64, uint64) {
+		blocks, next, err := store.SearchBlocks(ctx, query)
+		require.NoError(err)
+		var heights []uint64
+		for _, block := range blocks {
+			heights = append(heights, block.Height)
+		}
+		return heights, next
+	}
+
+	// the blocks of a proposer are read from the proposer index, continuing after the limit
+	heights, next := search(store, BlockQuery{ProposerAddress: []byte("carol"), Limit: 3})
+	require.Equal([]uint64{3, 6, 9}, heights)
+	require.Equal(uint64(12), next)
+	heights, next = search(store, BlockQuery{ProposerAddress: []byte("carol"), FromHeight: next, ToHeight: 20})
+	require.Equal([]uint64{12, 15, 18}, heights)
+	require.Zero(next)
+
+	// the transaction count index is read in height order
+	heights, next = search(store, BlockQuery{MinTxs: 2, MaxTxs: ptr(uint64(3)), Limit: 5})
+	require.Equal([]uint64{3, 4, 7, 8, 11}, heights)
+	require.Equal(uint64(12), next)
+	heights, _ = search(store, BlockQuery{MinTxs: 3, FromHeight: 20})
+	require.Equal([]uint64{20, 24, 28}, heights)
+
+	// the time range is resolved with the time index
+	heights, _ = search(store, BlockQuery{StartTime: start.Add(25*time.Minute + time.Second), EndTime: start.Add(28 * time.Minute)})
+	require.Equal([]uint64{27, 28}, heights)
+	heights, _ = search(store, BlockQuery{ProposerAddress: []byte("alice"), StartTime: start.Add(10 * time.Minute), EndTime: start.Add(time.Hour)})
+	require.Equal([]uint64{13, 16, 19, 22, 25, 28}, heights)
+
+	// blocks saved before the search indexes existed are indexed by the first search
+	results, err := db.Query(ctx, dsq.Query{KeysOnly: true})
+	require.NoError(err)
+	entries, err := results.Rest()
+	require.NoError(err)
+	for _, entry := range entries {
+		for _, prefix := range []string{proposerIndexPrefix, timeIndexPrefix, txCountIndexPrefix} {
+			if strings.HasPrefix(entry.Key, "/"+prefix+"/") {
+				require.NoError(db.Delete(ctx, ds.NewKey(entry.Key)))
+			}
+		}
+	}
+	require.NoError(db.Delete(ctx, ds.NewKey(getMetaKey(SearchIndexHeightKey))))
+	reopened := New(db).(*DefaultStore)
+	heights, _ = search(reopened, BlockQuery{ProposerAddress: []byte("bob"), MinTxs: 1, ToHeight: 12})
+	require.Equal([]uint64{2, 8, 11}, heights)
+	indexed, err := reopened.GetMetadata(ctx, SearchIndexHeightKey)
+	require.NoError(err)
+	require.Equal(encodeHeight(30), indexed)
+	has, err := db.Has(ctx, ds.NewKey(getTxCountIndexKey(0, 29)))
+	require.NoError(err)
+	require.True(has)
+
+	// pruned blocks are removed from the indexes
+	require.NoError(reopened.SetMetadata(ctx, DAIncludedHeightKey, binary.LittleEndian.AppendUint64(nil, 30)))
+	require.NoError(reopened.PruneBlock(ctx, 8))
+	heights, _ = search(reopened, BlockQuery{ProposerAddress: []byte("bob"), MinTxs: 1, ToHeight: 12})
+	require.Equal([]uint64{2, 11}, heights)
+	has, err = db.Has(ctx, ds.NewKey(getProposerIndexKey([]byte("bob"), 8)))
+	require.NoError(err)
+	require.False(has)
+}
+
+func ptr[T any](v T) *T {
+	return &v
+}
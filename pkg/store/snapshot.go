@@ -0,0 +1,249 @@
+package store
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+
+	ds "github.com/ipfs/go-datastore"
+	dsq "github.com/ipfs/go-datastore/query"
+)
+
+// snapshotMagic starts every snapshot, identifying the format and its version.
+var snapshotMagic = [8]byte{'E', 'V', 'S', 'N', 'A', 'P', 0, 1}
+
+const (
+	// snapshotChunkSize is the size above which the entries of a snapshot are cut into a new chunk.
+	snapshotChunkSize = 4 << 20
+	// maxSnapshotChunkSize bounds the size of the chunks read from a snapshot, to reject corrupted
+	// lengths before allocating them.
+	maxSnapshotChunkSize = 256 << 20
+)
+
+// ErrSnapshotCorrupted is returned when importing a snapshot which is truncated, or whose
+// checksums do not match its content.
+var ErrSnapshotCorrupted = errors.New("snapshot corrupted")
+
+// Snapshotter is implemented by stores which can export all their entries to a snapshot, and
+// import them back into an empty store, so that nodes can bootstrap from a snapshot instead of
+// syncing the whole chain.
+type Snapshotter interface {
+	// ExportSnapshot writes all the entries of the store to w.
+	ExportSnapshot(ctx context.Context, w io.Writer) (SnapshotInfo, error)
+	// ImportSnapshot reads a snapshot written by ExportSnapshot from r into the store, which must
+	// be empty. Every chunk is checked against its checksum before it is written.
+	ImportSnapshot(ctx context.Context, r io.Reader) (SnapshotInfo, error)
+}
+
+var _ Snapshotter = &DefaultStore{}
+
+// SnapshotInfo describes a snapshot.
+type SnapshotInfo struct {
+	// Height is the height of the store the snapshot was taken of
+	Height uint64
+	// Entries is the number of entries of the snapshot
+	Entries uint64
+	// Chunks is the number of chunks the entries are split in
+	Chunks uint64
+}
+
+// ExportSnapshot writes all the entries of the store to w, in order to import them in another
+// store with ImportSnapshot. The node should be stopped, or the snapshot may be taken in the
+// middle of the writes of a block.
+//
+// A snapshot starts with a magic and the height of the store, followed by chunks of entries. Each
+// chunk is its length, its entries, each of them a length prefixed key and value, and the SHA-256
+// checksum of its entries. An empty chunk ends the snapshot, followed by the number of entries.
+func (s *DefaultStore) ExportSnapshot(ctx context.Context, w io.Writer) (SnapshotInfo, error) {
+	height, err := s.Height(ctx)
+	if err != nil {
+		return SnapshotInfo{}, fmt.Errorf("failed to get height: %w", err)
+	}
+	info := SnapshotInfo{Height: height}
+
+	bw := bufio.NewWriter(w)
+	if _, err := bw.Write(snapshotMagic[:]); err != nil {
+		return info, fmt.Errorf("failed to write snapshot: %w", err)
+	}
+	if err := binary.Write(bw, binary.BigEndian, height); err != nil {
+		return info, fmt.Errorf("failed to write snapshot: %w", err)
+	}
+
+	results, err := s.db.Query(ctx, dsq.Query{})
+	if err != nil {
+		return info, fmt.Errorf("failed to query store: %w", err)
+	}
+	defer results.Close()
+
+	var chunk []byte
+	for result := range results.Next() {
+		if result.Error != nil {
+			return info, fmt.Errorf("failed to read store: %w", result.Error)
+		}
+		chunk = binary.AppendUvarint(chunk, uint64(len(result.Key)))
+		chunk = append(chunk, result.Key...)
+		chunk = binary.AppendUvarint(chunk, uint64(len(result.Value)))
+		chunk = append(chunk, result.Value...)
+		info.Entries++
+		if len(chunk) >= snapshotChunkSize {
+			if err := writeSnapshotChunk(bw, chunk); err != nil {
+				return info, err
+			}
+			info.Chunks++
+			chunk = chunk[:0]
+		}
+	}
+	if len(chunk) > 0 {
+		if err := writeSnapshotChunk(bw, chunk); err != nil {
+			return info, err
+		}
+		info.Chunks++
+	}
+
+	// the empty chunk ends the snapshot, so that a truncated one is detected
+	if err := writeSnapshotChunk(bw, nil); err != nil {
+		return info, err
+	}
+	if err := binary.Write(bw, binary.BigEndian, info.Entries); err != nil {
+		return info, fmt.Errorf("failed to write snapshot: %w", err)
+	}
+	if err := bw.Flush(); err != nil {
+		return info, fmt.Errorf("failed to write snapshot: %w", err)
+	}
+	return info, nil
+}
+
+// ImportSnapshot reads a snapshot written by ExportSnapshot from r into the store, which must be
+// empty. Every chunk is checked against its checksum before its entries are written, and the
+// height of the store is only written once the whole snapshot was read, so the store of a failed
+// import has no height and must be cleaned before importing again.
+func (s *DefaultStore) ImportSnapshot(ctx context.Context, r io.Reader) (SnapshotInfo, error) {
+	var info SnapshotInfo
+	if empty, err := s.isEmpty(ctx); err != nil {
+		return info, err
+	} else if !empty {
+		return info, errors.New("cannot import snapshot: store is not empty")
+	}
+
+	br := bufio.NewReader(r)
+	var magic [len(snapshotMagic)]byte
+	if _, err := io.ReadFull(br, magic[:]); err != nil || magic != snapshotMagic {
+		return info, fmt.Errorf("%w: not a snapshot", ErrSnapshotCorrupted)
+	}
+	if err := binary.Read(br, binary.BigEndian, &info.Height); err != nil {
+		return info, fmt.Errorf("%w: failed to read height: %w", ErrSnapshotCorrupted, err)
+	}
+
+	heightKey := ds.NewKey(getHeightKey())
+	var heightValue []byte
+	for {
+		chunk, err := readSnapshotChunk(br)
+		if err != nil {
+			return info, err
+		}
+		if len(chunk) == 0 {
+			break
+		}
+		batch, err := s.db.Batch(ctx)
+		if err != nil {
+			return info, fmt.Errorf("failed to create a new batch: %w", err)
+		}
+		for len(chunk) > 0 {
+			var key, value []byte
+			if key, chunk, err = readSnapshotField(chunk); err == nil {
+				value, chunk, err = readSnapshotField(chunk)
+			}
+			if err != nil {
+				return info, err
+			}
+			if k := ds.RawKey(string(key)); k.Equal(heightKey) {
+				heightValue = value
+			} else if err := batch.Put(ctx, k, value); err != nil {
+				return info, fmt.Errorf("failed to import entry %s: %w", k, err)
+			}
+			info.Entries++
+		}
+		if err := batch.Commit(ctx); err != nil {
+			return info, fmt.Errorf("failed to commit chunk %d: %w", info.Chunks, err)
+		}
+		info.Chunks++
+	}
+
+	var entries uint64
+	if err := binary.Read(br, binary.BigEndian, &entries); err != nil {
+		return info, fmt.Errorf("%w: failed to read number of entries: %w", ErrSnapshotCorrupted, err)
+	}
+	if entries != info.Entries {
+		return info, fmt.Errorf("%w: %d entries read, %d expected", ErrSnapshotCorrupted, info.Entries, entries)
+	}
+	if heightValue != nil {
+		if err := s.db.Put(ctx, heightKey, heightValue); err != nil {
+			return info, fmt.Errorf("failed to set height: %w", err)
+		}
+	}
+	return info, nil
+}
+
+// isEmpty returns whether the store has no entries.
+func (s *DefaultStore) isEmpty(ctx context.Context) (bool, error) {
+	results, err := s.db.Query(ctx, dsq.Query{KeysOnly: true, Limit: 1})
+	if err != nil {
+		return false, fmt.Errorf("failed to query store: %w", err)
+	}
+	entries, err := results.Rest()
+	if err != nil {
+		return false, fmt.Errorf("failed to read store: %w", err)
+	}
+	return len(entries) == 0, nil
+}
+
+// writeSnapshotChunk writes a chunk of entries followed by its checksum.
+func writeSnapshotChunk(w io.Writer, chunk []byte) error {
+	checksum := sha256.Sum256(chunk)
+	if err := binary.Write(w, binary.BigEndian, uint32(len(chunk))); err != nil {
+		return fmt.Errorf("failed to write snapshot: %w", err)
+	}
+	if _, err := w.Write(chunk); err != nil {
+		return fmt.Errorf("failed to write snapshot: %w", err)
+	}
+	if _, err := w.Write(checksum[:]); err != nil {
+		return fmt.Errorf("failed to write snapshot: %w", err)
+	}
+	return nil
+}
+
+// readSnapshotChunk reads a chunk of entries and checks it against its checksum.
+func readSnapshotChunk(r io.Reader) ([]byte, error) {
+	var size uint32
+	if err := binary.Read(r, binary.BigEndian, &size); err != nil {
+		return nil, fmt.Errorf("%w: failed to read chunk: %w", ErrSnapshotCorrupted, err)
+	}
+	if size > maxSnapshotChunkSize {
+		return nil, fmt.Errorf("%w: chunk of %d bytes exceeds the maximum of %d", ErrSnapshotCorrupted, size, maxSnapshotChunkSize)
+	}
+	chunk := make([]byte, int(size)+sha256.Size)
+	if _, err := io.ReadFull(r, chunk); err != nil {
+		return nil, fmt.Errorf("%w: failed to read chunk: %w", ErrSnapshotCorrupted, err)
+	}
+	chunk, checksum := chunk[:size], chunk[size:]
+	if expected := sha256.Sum256(chunk); !bytes.Equal(checksum, expected[:]) {
+		return nil, fmt.Errorf("%w: chunk checksum mismatch", ErrSnapshotCorrupted)
+	}
+	return chunk, nil
+}
+
+// readSnapshotField reads a length prefixed field of an entry from a chunk and returns the rest of
+// the chunk.
+func readSnapshotField(chunk []byte) ([]byte, []byte, error) {
+	size, n := binary.Uvarint(chunk)
+	if n <= 0 || size > uint64(len(chunk)-n) {
+		return nil, nil, fmt.Errorf("%w: malformed entry", ErrSnapshotCorrupted)
+	}
+	chunk = chunk[n:]
+	return chunk[:size], chunk[size:], nil
+}
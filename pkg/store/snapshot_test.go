@@ -0,0 +1,87 @@
+package store
+
+import (
+	"bytes"
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/evstack/ev-node/types"
+)
+
+func TestSnapshot(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	source := New(mustNewInMem()).(*DefaultStore)
+	for h := uint64(1); h <= 5; h++ {
+		header, data := types.GetRandomBlock(h, 3, "test-snapshot")
+		require.NoError(t, source.SaveBlockData(ctx, header, data, &header.Signature))
+		require.NoError(t, source.SetHeight(ctx, h))
+	}
+	require.NoError(t, source.UpdateState(ctx, types.State{ChainID: "test-snapshot", LastBlockHeight: 5}))
+	require.NoError(t, source.SetMetadata(ctx, DAIncludedHeightKey, encodeHeight(4)))
+
+	var snapshot bytes.Buffer
+	exported, err := source.ExportSnapshot(ctx, &snapshot)
+	require.NoError(t, err)
+	require.Equal(t, uint64(5), exported.Height)
+	require.Equal(t, uint64(1), exported.Chunks)
+	require.NotZero(t, exported.Entries)
+
+	t.Run("import", func(t *testing.T) {
+		t.Parallel()
+		target := New(mustNewInMem()).(*DefaultStore)
+		imported, err := target.ImportSnapshot(ctx, bytes.NewReader(snapshot.Bytes()))
+		require.NoError(t, err)
+		require.Equal(t, exported, imported)
+
+		height, err := target.Height(ctx)
+		require.NoError(t, err)
+		require.Equal(t, uint64(5), height)
+		for h := uint64(1); h <= 5; h++ {
+			expectedHeader, expectedData, err := source.GetBlockData(ctx, h)
+			require.NoError(t, err)
+			header, data, err := target.GetBlockData(ctx, h)
+			require.NoError(t, err)
+			require.Equal(t, expectedHeader.Hash(), header.Hash())
+			require.Equal(t, expectedData.Txs, data.Txs)
+		}
+		state, err := target.GetState(ctx)
+		require.NoError(t, err)
+		require.Equal(t, uint64(5), state.LastBlockHeight)
+		daIncludedHeight, err := target.GetMetadata(ctx, DAIncludedHeightKey)
+		require.NoError(t, err)
+		require.Equal(t, encodeHeight(4), daIncludedHeight)
+
+		// a store is only imported into when empty
+		_, err = target.ImportSnapshot(ctx, bytes.NewReader(snapshot.Bytes()))
+		require.ErrorContains(t, err, "not empty")
+	})
+
+	t.Run("corrupted", func(t *testing.T) {
+		t.Parallel()
+		corrupted := bytes.Clone(snapshot.Bytes())
+		corrupted[len(snapshotMagic)+8+4+10] ^= 0xff
+		target := New(mustNewInMem()).(*DefaultStore)
+		_, err := target.ImportSnapshot(ctx, bytes.NewReader(corrupted))
+		require.ErrorIs(t, err, ErrSnapshotCorrupted)
+		height, err := target.Height(ctx)
+		require.NoError(t, err)
+		require.Zero(t, height)
+	})
+
+	t.Run("truncated", func(t *testing.T) {
+		t.Parallel()
+		target := New(mustNewInMem()).(*DefaultStore)
+		_, err := target.ImportSnapshot(ctx, bytes.NewReader(snapshot.Bytes()[:snapshot.Len()-40]))
+		require.ErrorIs(t, err, ErrSnapshotCorrupted)
+		height, err := target.Height(ctx)
+		require.NoError(t, err)
+		require.Zero(t, height)
+
+		_, err = New(mustNewInMem()).(*DefaultStore).ImportSnapshot(ctx, bytes.NewReader([]byte("not a snapshot")))
+		require.ErrorIs(t, err, ErrSnapshotCorrupted)
+	})
+}
@@ -5,6 +5,7 @@ import (
 	"encoding/binary"
 	"errors"
 	"fmt"
+	"sync"
 
 	ds "github.com/ipfs/go-datastore"
 	"google.golang.org/protobuf/proto"
@@ -14,16 +15,31 @@ import (
 )
 
 // DefaultStore is a default store implementation.
+//
+// Transactions that recur across blocks, such as system transactions included in every block,
+// are stored once in content-addressed chunks referenced by the block data.
 type DefaultStore struct {
 	db ds.Batching
+
+	// chunkMu serializes the updates of chunk reference counts
+	chunkMu   sync.Mutex
+	recentTxs *recentTxs
+
+	// searchIndexMu serializes the indexing of the blocks saved before the search indexes existed
+	searchIndexMu sync.Mutex
+	searchIndexed bool
 }
 
 var _ Store = &DefaultStore{}
 
+// ErrCorrupted is returned when a stored value cannot be decoded.
+var ErrCorrupted = errors.New("store corrupted")
+
 // New returns new, default store.
 func New(ds ds.Batching) Store {
 	return &DefaultStore{
-		db: ds,
+		db:        ds,
+		recentTxs: newRecentTxs(recentTxsWindow),
 	}
 }
 
@@ -32,6 +48,20 @@ func (s *DefaultStore) Close() error {
 	return s.db.Close()
 }
 
+// DiskUsage returns the size of the underlying data storage on disk, 0 if it is not persistent.
+func (s *DefaultStore) DiskUsage(ctx context.Context) (uint64, error) {
+	return ds.DiskUsage(ctx, s.db)
+}
+
+// CollectGarbage reclaims the disk space of the data deleted from the underlying data storage,
+// if it is garbage collected.
+func (s *DefaultStore) CollectGarbage(ctx context.Context) error {
+	if gc, ok := s.db.(ds.GCFeature); ok {
+		return gc.CollectGarbage(ctx)
+	}
+	return nil
+}
+
 // SetHeight sets the height saved in the Store if it is higher than the existing height
 func (s *DefaultStore) SetHeight(ctx context.Context, height uint64) error {
 	currentHeight, err := s.Height(ctx)
@@ -68,40 +98,14 @@ func (s *DefaultStore) Height(ctx context.Context) (uint64, error) {
 // SaveBlockData adds block header and data to the store along with corresponding signature.
 // Stored height is updated if block height is greater than stored value.
 func (s *DefaultStore) SaveBlockData(ctx context.Context, header *types.SignedHeader, data *types.Data, signature *types.Signature) error {
-	hash := header.Hash()
-	height := header.Height()
-	signatureHash := *signature
-	headerBlob, err := header.MarshalBinary()
-	if err != nil {
-		return fmt.Errorf("failed to marshal Header to binary: %w", err)
-	}
-	dataBlob, err := data.MarshalBinary()
+	batch, err := s.Batch(ctx)
 	if err != nil {
-		return fmt.Errorf("failed to marshal Data to binary: %w", err)
-	}
-
-	batch, err := s.db.Batch(ctx)
-	if err != nil {
-		return fmt.Errorf("failed to create a new batch: %w", err)
-	}
-
-	if err := batch.Put(ctx, ds.NewKey(getHeaderKey(height)), headerBlob); err != nil {
-		return fmt.Errorf("failed to put header blob in batch: %w", err)
-	}
-	if err := batch.Put(ctx, ds.NewKey(getDataKey(height)), dataBlob); err != nil {
-		return fmt.Errorf("failed to put data blob in batch: %w", err)
-	}
-	if err := batch.Put(ctx, ds.NewKey(getSignatureKey(height)), signatureHash[:]); err != nil {
-		return fmt.Errorf("failed to put signature of block blob in batch: %w", err)
-	}
-	if err := batch.Put(ctx, ds.NewKey(getIndexKey(hash)), encodeHeight(height)); err != nil {
-		return fmt.Errorf("failed to put index key in batch: %w", err)
+		return err
 	}
-	if err := batch.Commit(ctx); err != nil {
-		return fmt.Errorf("failed to commit batch: %w", err)
+	if err := batch.SaveBlockData(ctx, header, data, signature); err != nil {
+		return err
 	}
-
-	return nil
+	return batch.Commit(ctx)
 }
 
 // GetBlockData returns block header and data at given height, or error if it's not found in Store.
@@ -110,16 +114,44 @@ func (s *DefaultStore) GetBlockData(ctx context.Context, height uint64) (*types.
 	if err != nil {
 		return nil, nil, err
 	}
-	dataBlob, err := s.db.Get(ctx, ds.NewKey(getDataKey(height)))
+	data, err := s.getData(ctx, height)
 	if err != nil {
-		return nil, nil, fmt.Errorf("failed to load block data: %w", err)
+		return nil, nil, err
 	}
+	return header, data, nil
+}
+
+// getData returns the block data at the given height, resolving its chunk references if any.
+func (s *DefaultStore) getData(ctx context.Context, height uint64) (*types.Data, error) {
 	data := new(types.Data)
-	err = data.UnmarshalBinary(dataBlob)
+	dataBlob, err := s.db.Get(ctx, ds.NewKey(getDataKey(height)))
+	if err == nil {
+		if err := data.UnmarshalBinary(dataBlob); err != nil {
+			return nil, fmt.Errorf("failed to unmarshal block data: %w: %w", ErrCorrupted, err)
+		}
+		return data, nil
+	}
+	if !errors.Is(err, ds.ErrNotFound) {
+		return nil, fmt.Errorf("failed to load block data: %w", err)
+	}
+
+	storedBlob, err := s.db.Get(ctx, ds.NewKey(getStoredDataKey(height)))
+	if errors.Is(err, ds.ErrNotFound) {
+		if pruned, _ := s.IsPruned(ctx, height); pruned {
+			return nil, fmt.Errorf("height %d: %w", height, ErrPruned)
+		}
+	}
 	if err != nil {
-		return nil, nil, fmt.Errorf("failed to unmarshal block data: %w", err)
+		return nil, fmt.Errorf("failed to load block data: %w", err)
 	}
-	return header, data, nil
+	pbData, err := s.decodeStoredData(ctx, storedBlob)
+	if err != nil {
+		return nil, err
+	}
+	if err := data.FromProto(pbData); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal block data: %w: %w", ErrCorrupted, err)
+	}
+	return data, nil
 }
 
 // GetBlockByHash returns block with given block header hash, or error if it's not found in Store.
@@ -156,12 +188,17 @@ func (s *DefaultStore) GetSignatureByHash(ctx context.Context, hash []byte) (*ty
 // GetHeader returns the header at the given height or error if it's not found in Store.
 func (s *DefaultStore) GetHeader(ctx context.Context, height uint64) (*types.SignedHeader, error) {
 	headerBlob, err := s.db.Get(ctx, ds.NewKey(getHeaderKey(height)))
+	if errors.Is(err, ds.ErrNotFound) {
+		if base, _ := PrunedBaseHeight(ctx, s); height <= base {
+			return nil, fmt.Errorf("height %d: %w", height, ErrPruned)
+		}
+	}
 	if err != nil {
 		return nil, fmt.Errorf("load block header: %w", err)
 	}
 	header := new(types.SignedHeader)
 	if err = header.UnmarshalBinary(headerBlob); err != nil {
-		return nil, fmt.Errorf("unmarshal block header: %w", err)
+		return nil, fmt.Errorf("unmarshal block header: %w: %w", ErrCorrupted, err)
 	}
 	return header, nil
 }
@@ -209,7 +246,7 @@ func (s *DefaultStore) GetState(ctx context.Context) (types.State, error) {
 	var pbState pb.State
 	err = proto.Unmarshal(blob, &pbState)
 	if err != nil {
-		return types.State{}, fmt.Errorf("failed to unmarshal state from protobuf: %w", err)
+		return types.State{}, fmt.Errorf("failed to unmarshal state from protobuf: %w: %w", ErrCorrupted, err)
 	}
 
 	var state types.State
@@ -230,7 +267,7 @@ func (s *DefaultStore) GetStateAtHeight(ctx context.Context, height uint64) (typ
 
 	var pbState pb.State
 	if err := proto.Unmarshal(blob, &pbState); err != nil {
-		return types.State{}, fmt.Errorf("failed to unmarshal state from protobuf at height %d: %w", height, err)
+		return types.State{}, fmt.Errorf("failed to unmarshal state from protobuf at height %d: %w: %w", height, ErrCorrupted, err)
 	}
 
 	var state types.State
@@ -288,6 +325,10 @@ func (s *DefaultStore) Rollback(ctx context.Context, height uint64) error {
 		}
 	}
 
+	s.chunkMu.Lock()
+	defer s.chunkMu.Unlock()
+	refs := newChunkRefs()
+
 	for currentHeight > height {
 		header, err := s.GetHeader(ctx, currentHeight)
 		if err != nil {
@@ -298,9 +339,26 @@ func (s *DefaultStore) Rollback(ctx context.Context, height uint64) error {
 			return fmt.Errorf("failed to delete header blob in batch: %w", err)
 		}
 
+		data, err := s.getData(ctx, currentHeight)
+		if err != nil {
+			return fmt.Errorf("failed to get data at height %d: %w", currentHeight, err)
+		}
+		if err := s.deleteTxIndex(ctx, batch, data, currentHeight); err != nil {
+			return err
+		}
+
 		if err := batch.Delete(ctx, ds.NewKey(getDataKey(currentHeight))); err != nil {
 			return fmt.Errorf("failed to delete data blob in batch: %w", err)
 		}
+		chunked, err := s.releaseStoredData(ctx, currentHeight, refs)
+		if err != nil {
+			return err
+		}
+		if chunked {
+			if err := batch.Delete(ctx, ds.NewKey(getStoredDataKey(currentHeight))); err != nil {
+				return fmt.Errorf("failed to delete data blob in batch: %w", err)
+			}
+		}
 
 		if err := batch.Delete(ctx, ds.NewKey(getSignatureKey(currentHeight))); err != nil {
 			return fmt.Errorf("failed to delete signature of block blob in batch: %w", err)
@@ -310,10 +368,22 @@ func (s *DefaultStore) Rollback(ctx context.Context, height uint64) error {
 		if err := batch.Delete(ctx, ds.NewKey(getIndexKey(hash))); err != nil {
 			return fmt.Errorf("failed to delete index key in batch: %w", err)
 		}
+		if err := batch.Delete(ctx, ds.NewKey(getBlockIndexKey(currentHeight))); err != nil {
+			return fmt.Errorf("failed to delete block index key in batch: %w", err)
+		}
+		for _, key := range searchIndexKeys(newBlockSummary(header, data)) {
+			if err := batch.Delete(ctx, ds.NewKey(key)); err != nil {
+				return fmt.Errorf("failed to delete search index key in batch: %w", err)
+			}
+		}
 
 		currentHeight--
 	}
 
+	if err := s.applyChunkRefs(ctx, batch, refs); err != nil {
+		return err
+	}
+
 	// set height -- using set height checks the current height
 	// so we cannot use that
 	heightBytes := encodeHeight(height)
@@ -350,6 +420,28 @@ func (s *DefaultStore) Rollback(ctx context.Context, height uint64) error {
 
 const heightLength = 8
 
+// deleteTxIndex deletes the index entries of the transactions of a block pointing to its height.
+// Entries of transactions included again in a later block are kept.
+func (s *DefaultStore) deleteTxIndex(ctx context.Context, batch ds.Batch, data *types.Data, height uint64) error {
+	for _, tx := range data.Txs {
+		key := ds.NewKey(getTxIndexKey(tx))
+		indexed, err := s.db.Get(ctx, key)
+		if errors.Is(err, ds.ErrNotFound) {
+			continue
+		}
+		if err != nil {
+			return fmt.Errorf("failed to get tx index key: %w", err)
+		}
+		if location, err := decodeTxLocation(indexed); err != nil || location.Height != height {
+			continue
+		}
+		if err := batch.Delete(ctx, key); err != nil {
+			return fmt.Errorf("failed to delete tx index key in batch: %w", err)
+		}
+	}
+	return nil
+}
+
 func encodeHeight(height uint64) []byte {
 	heightBytes := make([]byte, heightLength)
 	binary.LittleEndian.PutUint64(heightBytes, height)
@@ -358,7 +450,7 @@ func encodeHeight(height uint64) []byte {
 
 func decodeHeight(heightBytes []byte) (uint64, error) {
 	if len(heightBytes) != heightLength {
-		return 0, fmt.Errorf("invalid height length: %d (expected %d)", len(heightBytes), heightLength)
+		return 0, fmt.Errorf("%w: invalid height length: %d (expected %d)", ErrCorrupted, len(heightBytes), heightLength)
 	}
 	return binary.LittleEndian.Uint64(heightBytes), nil
 }
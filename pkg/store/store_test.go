@@ -2,6 +2,7 @@ package store
 
 import (
 	"context"
+	"crypto/sha256"
 	"encoding/binary"
 	"errors"
 	"fmt"
@@ -233,6 +234,30 @@ func TestRestart(t *testing.T) {
 	assert.Equal(expectedHeight, state2.LastBlockHeight)
 }
 
+// gcDatastore is a datastore counting its garbage collections.
+type gcDatastore struct {
+	ds.Batching
+	collections int
+}
+
+func (d *gcDatastore) CollectGarbage(context.Context) error {
+	d.collections++
+	return nil
+}
+
+func TestCollectGarbage(t *testing.T) {
+	t.Parallel()
+
+	kv, err := NewDefaultInMemoryKVStore()
+	require.NoError(t, err)
+	gc := &gcDatastore{Batching: kv}
+	require.NoError(t, New(gc).(ds.GCFeature).CollectGarbage(t.Context()))
+	assert.Equal(t, 1, gc.collections)
+
+	// datastores which are not garbage collected are left as is
+	require.NoError(t, New(ds.NewMapDatastore()).(ds.GCFeature).CollectGarbage(t.Context()))
+}
+
 func TestSetHeightError(t *testing.T) {
 	t.Parallel()
 	require := require.New(t)
@@ -1079,3 +1104,54 @@ func TestRollbackDAIncludedHeightGetMetadataError(t *testing.T) {
 	require.Contains(err.Error(), "failed to get DA included height")
 	require.Contains(err.Error(), "metadata retrieval failed")
 }
+
+func TestTxIndex(t *testing.T) {
+	t.Parallel()
+	require := require.New(t)
+
+	ctx := context.Background()
+	store := New(mustNewInMem())
+	chainID := "test-tx-index"
+
+	indexedHeight := func(tx []byte) (uint64, bool) {
+		hash := sha256.Sum256(tx)
+		location, err := GetTxLocation(ctx, store, hash[:])
+		if errors.Is(err, ds.ErrNotFound) {
+			return 0, false
+		}
+		require.NoError(err)
+		return location.Height, true
+	}
+
+	recurring := types.Tx("recurring")
+	var blocks []*types.Data
+	for h := uint64(1); h <= 3; h++ {
+		header, data := types.GetRandomBlock(h, 2, chainID)
+		if h != 2 {
+			data.Txs = append(data.Txs, recurring)
+		}
+		require.NoError(store.SaveBlockData(ctx, header, data, &header.Signature))
+		require.NoError(store.SetHeight(ctx, h))
+		require.NoError(store.UpdateState(ctx, types.State{ChainID: chainID, InitialHeight: 1, LastBlockHeight: h, LastBlockTime: header.Time()}))
+		blocks = append(blocks, data)
+	}
+
+	for i, data := range blocks {
+		height, ok := indexedHeight(data.Txs[0])
+		require.True(ok)
+		require.Equal(uint64(i+1), height)
+	}
+	// a recurring transaction is indexed at the latest block including it
+	height, ok := indexedHeight(recurring)
+	require.True(ok)
+	require.Equal(uint64(3), height)
+
+	// rolled back blocks are removed from the index
+	require.NoError(store.Rollback(ctx, 1))
+	_, ok = indexedHeight(blocks[2].Txs[0])
+	require.False(ok)
+	_, ok = indexedHeight(blocks[1].Txs[0])
+	require.False(ok)
+	_, ok = indexedHeight(blocks[0].Txs[0])
+	require.True(ok)
+}
@@ -0,0 +1,70 @@
+package store
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/binary"
+	"encoding/hex"
+	"fmt"
+
+	ds "github.com/ipfs/go-datastore"
+)
+
+// txLocationLength is the length of the tx index entries: the height of the block and the index
+// of the transaction in its data. Entries written before the index was stored are only a height.
+const txLocationLength = heightLength + 4
+
+// TxLocation locates a transaction in the chain.
+type TxLocation struct {
+	// Height is the height of the latest block including the transaction.
+	Height uint64
+	// Index is the index of the transaction in the data of the block.
+	Index uint32
+}
+
+func encodeTxLocation(location TxLocation) []byte {
+	bz := make([]byte, txLocationLength)
+	binary.LittleEndian.PutUint64(bz, location.Height)
+	binary.LittleEndian.PutUint32(bz[heightLength:], location.Index)
+	return bz
+}
+
+// decodeTxLocation decodes a tx index entry. The index of entries holding only a height is 0.
+func decodeTxLocation(bz []byte) (TxLocation, error) {
+	switch len(bz) {
+	case heightLength:
+		return TxLocation{Height: binary.LittleEndian.Uint64(bz)}, nil
+	case txLocationLength:
+		return TxLocation{Height: binary.LittleEndian.Uint64(bz), Index: binary.LittleEndian.Uint32(bz[heightLength:])}, nil
+	default:
+		return TxLocation{}, fmt.Errorf("%w: invalid tx index length: %d", ErrCorrupted, len(bz))
+	}
+}
+
+// GetTxLocation returns the location of the latest block including the transaction with the
+// given SHA-256 hash, from the tx index maintained as blocks are saved. It returns ds.ErrNotFound
+// if the transaction is not indexed, e.g. it was never included or its block was pruned.
+func GetTxLocation(ctx context.Context, s Store, txHash []byte) (TxLocation, error) {
+	bz, err := s.GetMetadata(ctx, fmt.Sprintf("%s/%s", TxIndexKey, hex.EncodeToString(txHash)))
+	if err != nil {
+		return TxLocation{}, err
+	}
+	location, err := decodeTxLocation(bz)
+	if err != nil || len(bz) == txLocationLength {
+		return location, err
+	}
+
+	// the index of transactions indexed before it was stored is found in the data of the block
+	_, data, err := s.GetBlockData(ctx, location.Height)
+	if err != nil {
+		return TxLocation{}, fmt.Errorf("failed to get block data at height %d: %w", location.Height, err)
+	}
+	for i, tx := range data.Txs {
+		if hash := sha256.Sum256(tx); bytes.Equal(hash[:], txHash) {
+			location.Index = uint32(i)
+			return location, nil
+		}
+	}
+	return TxLocation{}, fmt.Errorf("transaction %x not found at height %d: %w", txHash, location.Height, ds.ErrNotFound)
+}
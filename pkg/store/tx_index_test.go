@@ -0,0 +1,45 @@
+package store
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"testing"
+
+	ds "github.com/ipfs/go-datastore"
+	"github.com/stretchr/testify/require"
+
+	"github.com/evstack/ev-node/types"
+)
+
+func TestGetTxLocation(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	store := New(mustNewInMem())
+
+	header, data := types.GetRandomBlock(1, 3, "test-tx-location")
+	require.NoError(t, store.SaveBlockData(ctx, header, data, &header.Signature))
+
+	for i, tx := range data.Txs {
+		hash := sha256.Sum256(tx)
+		location, err := GetTxLocation(ctx, store, hash[:])
+		require.NoError(t, err)
+		require.Equal(t, TxLocation{Height: 1, Index: uint32(i)}, location)
+	}
+
+	unknown := sha256.Sum256([]byte("unknown"))
+	_, err := GetTxLocation(ctx, store, unknown[:])
+	require.ErrorIs(t, err, ds.ErrNotFound)
+
+	// entries written before the index was stored only hold the height
+	legacy := sha256.Sum256(data.Txs[2])
+	legacyKey := TxIndexKey + "/" + hex.EncodeToString(legacy[:])
+	require.NoError(t, store.SetMetadata(ctx, legacyKey, encodeHeight(1)))
+	location, err := GetTxLocation(ctx, store, legacy[:])
+	require.NoError(t, err)
+	require.Equal(t, TxLocation{Height: 1, Index: 2}, location)
+
+	require.NoError(t, store.SetMetadata(ctx, legacyKey, []byte{1, 2, 3}))
+	_, err = GetTxLocation(ctx, store, legacy[:])
+	require.ErrorIs(t, err, ErrCorrupted)
+}
@@ -46,6 +46,10 @@ type Store interface {
 	// GetMetadata returns values stored for given key with SetMetadata.
 	GetMetadata(ctx context.Context, key string) ([]byte, error)
 
+	// Batch returns a new batch of writes, e.g. of a block, its height and the state after it,
+	// applied atomically when committed.
+	Batch(ctx context.Context) (Batch, error)
+
 	// Rollback deletes x height from the ev-node store.
 	Rollback(ctx context.Context, height uint64) error
 
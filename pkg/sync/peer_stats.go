@@ -0,0 +1,189 @@
+package sync
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/libp2p/go-libp2p/core/host"
+	"github.com/libp2p/go-libp2p/core/network"
+	"github.com/libp2p/go-libp2p/core/peer"
+	"github.com/libp2p/go-libp2p/core/protocol"
+
+	"github.com/evstack/ev-node/pkg/p2p"
+)
+
+// responseBodyTag is the first byte of the header exchange responses carrying a header or data,
+// i.e. the tag of their body field. Responses without body report that the peer does not have
+// the requested range.
+const responseBodyTag = 0x0a
+
+// PeerSyncStats are the contributions of a peer to the sync of the node.
+type PeerSyncStats struct {
+	// Served is the number of headers or data served by the peer.
+	Served uint64
+	// BytesServed is the number of bytes of the responses of the peer.
+	BytesServed uint64
+	// Requests is the number of requests made to the peer.
+	Requests uint64
+	// FailedRequests is the number of requests to the peer which failed, e.g. because the peer
+	// could not be reached or did not respond in time.
+	FailedRequests uint64
+	// LastServed is the time the peer last served a header or data, zero if it never did.
+	LastServed time.Time
+}
+
+// FailureRate returns the fraction of the requests to the peer which failed.
+func (s PeerSyncStats) FailureRate() float64 {
+	if s.Requests == 0 {
+		return 0
+	}
+	return float64(s.FailedRequests) / float64(s.Requests)
+}
+
+// peerStatsTracker tracks the contributions of the peers to a sync service, by observing the
+// streams of the header exchange opened to them.
+type peerStatsTracker struct {
+	syncType syncType
+	metrics  *p2p.Metrics
+
+	mu    sync.Mutex
+	stats map[peer.ID]*PeerSyncStats
+}
+
+func newPeerStatsTracker(syncType syncType, metrics *p2p.Metrics) *peerStatsTracker {
+	if metrics == nil {
+		metrics = p2p.NopMetrics()
+	}
+	return &peerStatsTracker{syncType: syncType, metrics: metrics, stats: make(map[peer.ID]*PeerSyncStats)}
+}
+
+func (t *peerStatsTracker) get(id peer.ID) *PeerSyncStats {
+	s, ok := t.stats[id]
+	if !ok {
+		s = &PeerSyncStats{}
+		t.stats[id] = s
+	}
+	return s
+}
+
+func (t *peerStatsTracker) served(id peer.ID, bytes, items int) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	s := t.get(id)
+	s.BytesServed += uint64(bytes)
+	s.Served += uint64(items)
+	if items > 0 {
+		s.LastServed = time.Now()
+	}
+	labels := []string{"sync", string(t.syncType)}
+	t.metrics.SyncServedBytesTotal.With(labels...).Add(float64(bytes))
+	t.metrics.SyncServedTotal.With(labels...).Add(float64(items))
+}
+
+func (t *peerStatsTracker) requestDone(id peer.ID, failed bool) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	s := t.get(id)
+	s.Requests++
+	labels := []string{"sync", string(t.syncType)}
+	t.metrics.SyncRequestsTotal.With(labels...).Add(1)
+	if failed {
+		s.FailedRequests++
+		t.metrics.SyncFailedRequestsTotal.With(labels...).Add(1)
+	}
+}
+
+// snapshot returns a copy of the stats of every peer.
+func (t *peerStatsTracker) snapshot() map[peer.ID]PeerSyncStats {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	stats := make(map[peer.ID]PeerSyncStats, len(t.stats))
+	for id, s := range t.stats {
+		stats[id] = *s
+	}
+	return stats
+}
+
+// trackingHost is a host whose outbound streams report to a peerStatsTracker.
+type trackingHost struct {
+	host.Host
+	tracker *peerStatsTracker
+}
+
+// NewStream opens a stream to a peer and tracks it as a request. The header exchange closes
+// the streams of successful requests and resets the others.
+func (h *trackingHost) NewStream(ctx context.Context, id peer.ID, pids ...protocol.ID) (network.Stream, error) {
+	stream, err := h.Host.NewStream(ctx, id, pids...)
+	if err != nil {
+		h.tracker.requestDone(id, true)
+		return nil, err
+	}
+	return &trackedStream{Stream: stream, tracker: h.tracker}, nil
+}
+
+// trackedStream counts the bytes and responses read from a stream of the header exchange.
+type trackedStream struct {
+	network.Stream
+	tracker *peerStatsTracker
+	frames  frameCounter
+	done    sync.Once
+}
+
+func (s *trackedStream) Read(b []byte) (int, error) {
+	n, err := s.Stream.Read(b)
+	if n > 0 {
+		s.tracker.served(s.Conn().RemotePeer(), n, s.frames.feed(b[:n]))
+	}
+	return n, err
+}
+
+func (s *trackedStream) Close() error {
+	s.done.Do(func() { s.tracker.requestDone(s.Conn().RemotePeer(), false) })
+	return s.Stream.Close()
+}
+
+func (s *trackedStream) Reset() error {
+	s.done.Do(func() { s.tracker.requestDone(s.Conn().RemotePeer(), true) })
+	return s.Stream.Reset()
+}
+
+// frameCounter counts the responses carrying a header or data in a stream of length-prefixed
+// header exchange responses, fed in chunks of any size.
+type frameCounter struct {
+	// length and shift accumulate the varint length prefix of the next response
+	length uint64
+	shift  uint
+	// remaining is the number of bytes of the current response still to be read
+	remaining uint64
+	// first is set if the next byte is the first one of the current response
+	first bool
+}
+
+// feed consumes b and returns the number of responses with a body starting in it.
+func (c *frameCounter) feed(b []byte) int {
+	items := 0
+	for len(b) > 0 {
+		if c.remaining == 0 {
+			x := b[0]
+			b = b[1:]
+			c.length |= uint64(x&0x7f) << c.shift
+			if x&0x80 != 0 && c.shift < 63 {
+				c.shift += 7
+				continue
+			}
+			c.remaining, c.length, c.shift, c.first = c.length, 0, 0, true
+			continue
+		}
+		if c.first {
+			if b[0] == responseBodyTag {
+				items++
+			}
+			c.first = false
+		}
+		n := min(uint64(len(b)), c.remaining)
+		b = b[n:]
+		c.remaining -= n
+	}
+	return items
+}
@@ -0,0 +1,67 @@
+package sync
+
+import (
+	"encoding/binary"
+	"testing"
+
+	"github.com/libp2p/go-libp2p/core/peer"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// appendResponse appends a length-prefixed header exchange response to b, with a body of
+// bodyLen bytes or, if bodyLen is negative, a not found status.
+func appendResponse(b []byte, bodyLen int) []byte {
+	var msg []byte
+	if bodyLen < 0 {
+		msg = []byte{0x10, 0x01}
+	} else {
+		msg = binary.AppendUvarint([]byte{responseBodyTag}, uint64(bodyLen))
+		msg = append(msg, make([]byte, bodyLen)...)
+	}
+	b = binary.AppendUvarint(b, uint64(len(msg)))
+	return append(b, msg...)
+}
+
+func TestFrameCounter(t *testing.T) {
+	var stream []byte
+	stream = appendResponse(stream, 10)
+	stream = appendResponse(stream, 300) // two bytes length prefix
+	stream = appendResponse(stream, -1)
+	stream = appendResponse(stream, 0)
+
+	for _, chunkSize := range []int{1, 2, 7, 128, len(stream)} {
+		var counter frameCounter
+		items := 0
+		for b := stream; len(b) > 0; {
+			n := min(chunkSize, len(b))
+			items += counter.feed(b[:n])
+			b = b[n:]
+		}
+		assert.Equal(t, 3, items, "chunk size %d", chunkSize)
+		assert.Zero(t, counter.remaining, "chunk size %d", chunkSize)
+	}
+}
+
+func TestPeerStatsTracker(t *testing.T) {
+	tracker := newPeerStatsTracker(headerSync, nil)
+	alice, bob := peer.ID("alice"), peer.ID("bob")
+
+	tracker.served(alice, 100, 2)
+	tracker.requestDone(alice, false)
+	tracker.served(alice, 20, 0)
+	tracker.requestDone(alice, false)
+	tracker.requestDone(bob, true)
+
+	stats := tracker.snapshot()
+	require.Len(t, stats, 2)
+	assert.Equal(t, uint64(2), stats[alice].Served)
+	assert.Equal(t, uint64(120), stats[alice].BytesServed)
+	assert.Equal(t, uint64(2), stats[alice].Requests)
+	assert.False(t, stats[alice].LastServed.IsZero())
+	assert.Zero(t, stats[alice].FailureRate())
+	assert.Equal(t, uint64(1), stats[bob].FailedRequests)
+	assert.True(t, stats[bob].LastServed.IsZero())
+	assert.Equal(t, 1.0, stats[bob].FailureRate())
+	assert.Zero(t, PeerSyncStats{}.FailureRate())
+}
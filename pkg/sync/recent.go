@@ -0,0 +1,242 @@
+package sync
+
+import (
+	"bufio"
+	"context"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/celestiaorg/go-header"
+	pubsub "github.com/libp2p/go-libp2p-pubsub"
+	"github.com/libp2p/go-libp2p/core/event"
+	"github.com/libp2p/go-libp2p/core/network"
+	"github.com/libp2p/go-libp2p/core/peer"
+	"github.com/libp2p/go-libp2p/core/protocol"
+)
+
+const (
+	// recentPushTimeout bounds the push of the recent headers or data to a peer.
+	recentPushTimeout = 10 * time.Second
+	// maxRecentItems is the largest number of recent headers or data pushed to or accepted from a
+	// peer.
+	maxRecentItems = 64
+	// maxRecentItemSize is the largest size of a recent header or data accepted from a peer.
+	maxRecentItemSize = 8 << 20
+	// maxRecentBytes is the largest total size of the recent headers or data accepted from a peer
+	// in a push.
+	maxRecentBytes = 32 << 20
+)
+
+// recentPushes records the connections on which peers pushed their recent headers or data, which
+// is accepted once per connection.
+type recentPushes struct {
+	mu    sync.Mutex
+	conns map[string]network.Conn
+}
+
+// add records a push on conn, returning false if one was already received on it. The closed
+// connections are forgotten.
+func (p *recentPushes) add(conn network.Conn) bool {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if _, ok := p.conns[conn.ID()]; ok {
+		return false
+	}
+	if p.conns == nil {
+		p.conns = make(map[string]network.Conn)
+	}
+	for id, c := range p.conns {
+		if c.IsClosed() {
+			delete(p.conns, id)
+		}
+	}
+	p.conns[conn.ID()] = conn
+	return true
+}
+
+// recentProtocolID returns the ID of the protocol pushing the recent headers or data of a network.
+func recentProtocolID(network string) protocol.ID {
+	return protocol.ID(fmt.Sprintf("/%s/recent/v0.0.1", network))
+}
+
+// startRecentPush pushes the latest headers or data to the peers connecting to the node, so that
+// late joiners learn about the recent heights without waiting for the next gossip round, and
+// receives the ones pushed by peers. Nothing is pushed nor received if p2p.rebroadcast_recent is 0.
+func (syncService *SyncService[H]) startRecentPush(network string) error {
+	if syncService.conf.P2P.RebroadcastRecent <= 0 {
+		return nil
+	}
+	h := syncService.p2p.Host()
+	syncService.recentProtocol = recentProtocolID(network)
+	h.SetStreamHandler(syncService.recentProtocol, syncService.handleRecent)
+
+	// peers connected before starting their sync services announce the protocol in an update
+	sub, err := h.EventBus().Subscribe([]any{new(event.EvtPeerIdentificationCompleted), new(event.EvtPeerProtocolsUpdated)})
+	if err != nil {
+		return fmt.Errorf("failed to subscribe to peer events: %w", err)
+	}
+	syncService.peerEvents = sub
+	go func() {
+		for e := range sub.Out() {
+			switch evt := e.(type) {
+			case event.EvtPeerIdentificationCompleted:
+				if slices.Contains(evt.Protocols, syncService.recentProtocol) {
+					go syncService.pushRecent(evt.Peer)
+				}
+			case event.EvtPeerProtocolsUpdated:
+				if slices.Contains(evt.Added, syncService.recentProtocol) {
+					go syncService.pushRecent(evt.Peer)
+				}
+			}
+		}
+	}()
+	return nil
+}
+
+// stopRecentPush stops pushing the recent headers or data to the peers, and receiving them.
+func (syncService *SyncService[H]) stopRecentPush() error {
+	if syncService.recentProtocol == "" {
+		return nil
+	}
+	syncService.p2p.Host().RemoveStreamHandler(syncService.recentProtocol)
+	if syncService.peerEvents == nil {
+		return nil
+	}
+	return syncService.peerEvents.Close()
+}
+
+// pushRecent sends the latest headers or data of the store to a peer, as length-prefixed binary
+// messages in height order.
+func (syncService *SyncService[H]) pushRecent(id peer.ID) {
+	if !syncService.isInitialized() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), recentPushTimeout)
+	defer cancel()
+
+	head := syncService.store.Height()
+	count := uint64(min(syncService.conf.P2P.RebroadcastRecent, maxRecentItems))
+	from := max(head, count) - count + 1
+	var items [][]byte
+	for height := max(from, syncService.genesis.InitialHeight); height <= head; height++ {
+		item, err := syncService.store.GetByHeight(ctx, height)
+		if err != nil {
+			// e.g. pruned
+			continue
+		}
+		bin, err := item.MarshalBinary()
+		if err != nil {
+			syncService.logger.Error().Err(err).Uint64("height", height).Msg("failed to marshal recent item")
+			return
+		}
+		items = append(items, bin)
+	}
+	if len(items) == 0 {
+		return
+	}
+
+	stream, err := syncService.p2p.Host().NewStream(ctx, id, syncService.recentProtocol)
+	if err != nil {
+		syncService.logger.Debug().Err(err).Str("peer", id.String()).Msg("failed to open stream to push recent items")
+		return
+	}
+	defer stream.Close() //nolint:errcheck // stream is reset on failure
+	if deadline, ok := ctx.Deadline(); ok {
+		_ = stream.SetDeadline(deadline)
+	}
+
+	w := bufio.NewWriter(stream)
+	for _, bin := range items {
+		w.Write(binary.AppendUvarint(nil, uint64(len(bin)))) //nolint:errcheck // checked on flush
+		w.Write(bin)                                         //nolint:errcheck // checked on flush
+	}
+	if err := w.Flush(); err != nil {
+		_ = stream.Reset()
+		syncService.logger.Debug().Err(err).Str("peer", id.String()).Msg("failed to push recent items")
+		return
+	}
+	syncService.logger.Debug().Str("peer", id.String()).Int("count", len(items)).Uint64("head", head).Msg("pushed recent items")
+}
+
+// handleRecent receives the recent headers or data pushed by a peer, once per connection. They are
+// broadcast like the ones gossiped as they are read, so that the syncer validates each of them
+// before the next one is read and only the ones new to the node are gossiped further.
+func (syncService *SyncService[H]) handleRecent(stream network.Stream) {
+	defer stream.Close() //nolint:errcheck // nothing is written back
+	from := stream.Conn().RemotePeer()
+	if !syncService.recentPushes.add(stream.Conn()) {
+		syncService.logger.Debug().Str("peer", from.String()).Msg("recent items already pushed on connection")
+		_ = stream.Reset()
+		return
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), recentPushTimeout)
+	defer cancel()
+	_ = stream.SetReadDeadline(time.Now().Add(recentPushTimeout))
+
+	// a starting node first fetches the initial header or data from its peers, then starts the
+	// syncer validating the broadcast ones
+	ticker := time.NewTicker(100 * time.Millisecond)
+	defer ticker.Stop()
+	for !syncService.syncerStatus.isStarted() {
+		select {
+		case <-ctx.Done():
+			_ = stream.Reset()
+			return
+		case <-ticker.C:
+		}
+	}
+
+	err := readRecent(bufio.NewReader(stream), func(item H) error {
+		if err := syncService.sub.Broadcast(ctx, item); err != nil && !errors.Is(err, pubsub.ValidationError{Reason: pubsub.RejectValidationIgnored}) {
+			return fmt.Errorf("item at height %d rejected: %w", item.Height(), err)
+		}
+		return nil
+	})
+	if err != nil {
+		syncService.logger.Debug().Err(err).Str("peer", from.String()).Msg("invalid recent items pushed")
+		_ = stream.Reset()
+	}
+}
+
+// readRecent reads the recent headers or data pushed by a peer until the end of the stream,
+// passing each of them to handle as soon as it is decoded.
+func readRecent[H header.Header[H]](r *bufio.Reader, handle func(H) error) error {
+	var count int
+	var total uint64
+	for {
+		size, err := binary.ReadUvarint(r)
+		if errors.Is(err, io.EOF) {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		if size > maxRecentItemSize {
+			return fmt.Errorf("item of %d bytes exceeds the maximum of %d", size, maxRecentItemSize)
+		}
+		if count == maxRecentItems {
+			return fmt.Errorf("more than %d items", maxRecentItems)
+		}
+		if total += size; total > maxRecentBytes {
+			return fmt.Errorf("items exceed the maximum of %d bytes", maxRecentBytes)
+		}
+		bin := make([]byte, size)
+		if _, err := io.ReadFull(r, bin); err != nil {
+			return err
+		}
+		var zero H
+		item := zero.New()
+		if err := item.UnmarshalBinary(bin); err != nil {
+			return err
+		}
+		if err := handle(item); err != nil {
+			return err
+		}
+		count++
+	}
+}
@@ -13,8 +13,10 @@ import (
 	goheadersync "github.com/celestiaorg/go-header/sync"
 	ds "github.com/ipfs/go-datastore"
 	pubsub "github.com/libp2p/go-libp2p-pubsub"
+	"github.com/libp2p/go-libp2p/core/event"
 	"github.com/libp2p/go-libp2p/core/host"
 	"github.com/libp2p/go-libp2p/core/peer"
+	"github.com/libp2p/go-libp2p/core/protocol"
 	"github.com/libp2p/go-libp2p/p2p/net/conngater"
 	"github.com/multiformats/go-multiaddr"
 	"github.com/rs/zerolog"
@@ -51,6 +53,10 @@ type SyncService[H header.Header[H]] struct {
 	syncer            *goheadersync.Syncer[H]
 	syncerStatus      *SyncerStatus
 	topicSubscription header.Subscription[H]
+	peerStats         *peerStatsTracker
+	recentProtocol    protocol.ID
+	recentPushes      recentPushes
+	peerEvents        event.Subscription
 }
 
 // DataSyncService is the P2P Sync Service for blocks.
@@ -109,6 +115,7 @@ func newSyncService[H header.Header[H]](
 		syncType:     syncType,
 		logger:       logger,
 		syncerStatus: new(SyncerStatus),
+		peerStats:    newPeerStatsTracker(syncType, p2p.Metrics()),
 	}, nil
 }
 
@@ -117,6 +124,12 @@ func (syncService *SyncService[H]) Store() *goheaderstore.Store[H] {
 	return syncService.store
 }
 
+// PeerStats returns the contributions of each peer to the sync, i.e. the headers or data it
+// served in response to the requests of the SyncService and the failures of these requests.
+func (syncService *SyncService[H]) PeerStats() map[peer.ID]PeerSyncStats {
+	return syncService.peerStats.snapshot()
+}
+
 func (syncService *SyncService[H]) initStoreAndStartSyncer(ctx context.Context, initial H) error {
 	if initial.IsZero() {
 		return errors.New("failed to initialize the store and start syncer")
@@ -224,9 +237,13 @@ func (syncService *SyncService[H]) setupP2P(ctx context.Context) ([]peer.ID, err
 	if err := syncService.p2pServer.Start(ctx); err != nil {
 		return nil, fmt.Errorf("error while starting p2p server: %w", err)
 	}
+	if err := syncService.startRecentPush(networkID); err != nil {
+		return nil, err
+	}
 
 	peerIDs := syncService.getPeerIDs()
-	if syncService.ex, err = newP2PExchange[H](syncService.p2p.Host(), peerIDs, networkID, syncService.genesis.ChainID, syncService.p2p.ConnectionGater()); err != nil {
+	exchangeHost := &trackingHost{Host: syncService.p2p.Host(), tracker: syncService.peerStats}
+	if syncService.ex, err = newP2PExchange[H](exchangeHost, peerIDs, networkID, syncService.genesis.ChainID, syncService.p2p.ConnectionGater()); err != nil {
 		return nil, fmt.Errorf("error while creating exchange: %w", err)
 	}
 	if err := syncService.ex.Start(ctx); err != nil {
@@ -298,6 +315,7 @@ func (syncService *SyncService[H]) Stop(ctx context.Context) error {
 	// unsubscribe from topic first so that sub.Stop() does not fail
 	syncService.topicSubscription.Cancel()
 	err := errors.Join(
+		syncService.stopRecentPush(),
 		syncService.p2pServer.Stop(ctx),
 		syncService.ex.Stop(ctx),
 		syncService.sub.Stop(ctx),
@@ -368,6 +386,13 @@ func (syncService *SyncService[H]) getNetworkID(network string) string {
 	return network + "-" + string(syncService.syncType)
 }
 
+// TopicID returns the ID of the gossipsub topic of the SyncService. Headers and data are gossiped
+// on separate topics derived from the chain ID, so that light nodes, which only run the header
+// sync service, do not receive block data, and nodes of other chains are not reached.
+func (syncService *SyncService[H]) TopicID() string {
+	return goheaderp2p.PubsubTopicID(syncService.getChainID())
+}
+
 func (syncService *SyncService[H]) getChainID() string {
 	return syncService.genesis.ChainID + "-" + string(syncService.syncType)
 }
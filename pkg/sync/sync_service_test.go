@@ -1,8 +1,13 @@
 package sync
 
 import (
+	"bufio"
+	"bytes"
 	"context"
 	cryptoRand "crypto/rand"
+	"encoding/binary"
+	"errors"
+	"fmt"
 	"math/rand"
 	"path/filepath"
 	"testing"
@@ -134,3 +139,133 @@ func bytesN(r *rand.Rand, n int) []byte {
 	_, _ = r.Read(data)
 	return data
 }
+
+func TestGossipTopicsAreSeparateAndChainScoped(t *testing.T) {
+	mainKV := sync.MutexWrap(datastore.NewMapDatastore())
+	conf := config.DefaultConfig
+	conf.RootDir = t.TempDir()
+	nodeKey, err := key.LoadOrGenNodeKey(filepath.Dir(conf.ConfigPath()))
+	require.NoError(t, err)
+	logger := zerolog.Nop()
+
+	topics := func(chainID string) (string, string) {
+		p2pClient, err := p2p.NewClient(conf.P2P, nodeKey.PrivKey, mainKV, chainID, logger, p2p.NopMetrics())
+		require.NoError(t, err)
+		genesisDoc := genesispkg.Genesis{ChainID: chainID, InitialHeight: 1}
+		headerSync, err := NewHeaderSyncService(mainKV, conf, genesisDoc, p2pClient, logger)
+		require.NoError(t, err)
+		dataSync, err := NewDataSyncService(mainKV, conf, genesisDoc, p2pClient, logger)
+		require.NoError(t, err)
+		return headerSync.TopicID(), dataSync.TopicID()
+	}
+
+	headerTopic, dataTopic := topics("chain-a")
+	require.Equal(t, "/chain-a-headerSync/header-sub/v0.0.1", headerTopic)
+	require.Equal(t, "/chain-a-dataSync/header-sub/v0.0.1", dataTopic)
+
+	otherHeaderTopic, otherDataTopic := topics("chain-b")
+	require.NotEqual(t, headerTopic, otherHeaderTopic)
+	require.NotEqual(t, dataTopic, otherDataTopic)
+}
+
+func TestRecentHeadersPushedToNewPeers(t *testing.T) {
+	pk, _, err := crypto.GenerateEd25519Key(cryptoRand.Reader)
+	require.NoError(t, err)
+	noopSigner, err := noop.NewNoopSigner(pk)
+	require.NoError(t, err)
+	rnd := rand.New(rand.NewSource(1)) // nolint:gosec // test code only
+	mn := mocknet.New()
+	t.Cleanup(func() { _ = mn.Close() })
+	ctx, cancel := context.WithCancel(t.Context())
+	defer cancel()
+
+	genesisDoc := genesispkg.Genesis{
+		ChainID:            "test-chain-id",
+		GenesisDAStartTime: time.Now(),
+		InitialHeight:      1,
+		ProposerAddress:    []byte("test"),
+	}
+	conf := config.DefaultConfig
+	conf.P2P.RebroadcastRecent = 3
+
+	newService := func(peers string) (*HeaderSyncService, string) {
+		kv := sync.MutexWrap(datastore.NewMapDatastore())
+		h, err := mn.GenPeer()
+		require.NoError(t, err)
+		conf := conf
+		conf.P2P.Peers = peers
+		p2pClient, err := p2p.NewClientWithHost(conf.P2P, h.Peerstore().PrivKey(h.ID()), kv, genesisDoc.ChainID, zerolog.Nop(), p2p.NopMetrics(), h)
+		require.NoError(t, err)
+		require.NoError(t, p2pClient.Start(ctx))
+		t.Cleanup(func() { _ = p2pClient.Close() })
+		svc, err := NewHeaderSyncService(kv, conf, genesisDoc, p2pClient, zerolog.Nop())
+		require.NoError(t, err)
+		return svc, fmt.Sprintf("%s/p2p/%s", h.Addrs()[0], h.ID())
+	}
+
+	producer, producerAddr := newService("")
+	require.NoError(t, producer.Start(ctx))
+	t.Cleanup(func() { _ = producer.Stop(context.Background()) })
+	signedHeader, err := types.GetRandomSignedHeaderCustom(&types.HeaderConfig{
+		Height:   genesisDoc.InitialHeight,
+		DataHash: bytesN(rnd, 32),
+		AppHash:  bytesN(rnd, 32),
+		Signer:   noopSigner,
+	}, genesisDoc.ChainID)
+	require.NoError(t, err)
+	require.NoError(t, producer.WriteToStoreAndBroadcast(ctx, signedHeader))
+	for range 5 {
+		signedHeader = nextHeader(t, signedHeader, genesisDoc.ChainID, noopSigner)
+		require.NoError(t, producer.WriteToStoreAndBroadcast(ctx, signedHeader))
+	}
+
+	// the late joiner fetches the genesis header, then learns about the head from the push
+	// without any new header being gossiped
+	joiner, _ := newService(producerAddr)
+	require.NoError(t, mn.LinkAll())
+	require.NoError(t, mn.ConnectAllButSelf())
+	require.NoError(t, joiner.Start(ctx))
+	t.Cleanup(func() { _ = joiner.Stop(context.Background()) })
+	require.Eventually(t, func() bool {
+		return joiner.Store().Height() == signedHeader.Height()
+	}, 5*time.Second, 50*time.Millisecond)
+}
+
+func TestReadRecent(t *testing.T) {
+	frames := func(count int) []byte {
+		var buf []byte
+		for height := uint64(1); height <= uint64(count); height++ {
+			_, data := types.GetRandomBlock(height, 1, "test-chain")
+			bin, err := data.MarshalBinary()
+			require.NoError(t, err)
+			buf = binary.AppendUvarint(buf, uint64(len(bin)))
+			buf = append(buf, bin...)
+		}
+		return buf
+	}
+
+	// the items are handled as they are decoded
+	var heights []uint64
+	err := readRecent(bufio.NewReader(bytes.NewReader(frames(3))), func(data *types.Data) error {
+		heights = append(heights, data.Height())
+		return nil
+	})
+	require.NoError(t, err)
+	require.Equal(t, []uint64{1, 2, 3}, heights)
+
+	// reading stops at the first item rejected
+	heights = nil
+	err = readRecent(bufio.NewReader(bytes.NewReader(frames(3))), func(data *types.Data) error {
+		heights = append(heights, data.Height())
+		return errors.New("invalid")
+	})
+	require.Error(t, err)
+	require.Equal(t, []uint64{1}, heights)
+
+	// the number of items is bounded
+	err = readRecent(bufio.NewReader(bytes.NewReader(frames(maxRecentItems+1))), func(*types.Data) error { return nil })
+	require.ErrorContains(t, err, "more than")
+	oversized := binary.AppendUvarint(nil, maxRecentItemSize+1)
+	err = readRecent(bufio.NewReader(bytes.NewReader(oversized)), func(*types.Data) error { return nil })
+	require.ErrorContains(t, err, "exceeds the maximum")
+}
@@ -0,0 +1,359 @@
+package webhook
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/binary"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	ds "github.com/ipfs/go-datastore"
+	"github.com/rs/zerolog"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/evstack/ev-node/pkg/journal"
+	"github.com/evstack/ev-node/pkg/store"
+	pb "github.com/evstack/ev-node/types/pb/evnode/v1"
+)
+
+const (
+	// SignatureHeader is the HTTP header holding the HMAC-SHA256 of the request body,
+	// encoded as "sha256=<hex>", when a secret is configured.
+	SignatureHeader = "X-Evnode-Signature"
+
+	// maxBackoff is the maximum time to wait before retrying a failed delivery
+	maxBackoff = time.Minute
+	// requestTimeout is the timeout of a single delivery
+	requestTimeout = 10 * time.Second
+)
+
+// Execution statuses of a transaction.
+const (
+	TxStatusSuccess = "success"
+	TxStatusFailed  = "failed"
+)
+
+// TxResult locates a transaction included in a finalized block, along with its execution result
+// if the executor reports them, see execution.TxResultProvider. Status and Logs are omitted
+// otherwise, in which case consumers get the results from the execution layer.
+type TxResult struct {
+	// Hash is the lowercase hex encoded sha256 of the transaction
+	Hash string `json:"hash"`
+	// Index is the position of the transaction in the block
+	Index int `json:"index"`
+	// Status is TxStatusSuccess or TxStatusFailed, if known
+	Status string `json:"status,omitempty"`
+	// Logs are the logs emitted by the transaction, encoded as defined by the execution layer
+	Logs []string `json:"logs,omitempty"`
+}
+
+// BlockFinalized is the payload posted to the webhook for every block whose header and
+// data have been included on DA.
+type BlockFinalized struct {
+	Height         uint64     `json:"height"`
+	Hash           string     `json:"hash"`
+	Time           time.Time  `json:"time"`
+	AppHash        string     `json:"app_hash"`
+	HeaderDAHeight uint64     `json:"header_da_height"`
+	DataDAHeight   uint64     `json:"data_da_height"`
+	Txs            []TxResult `json:"txs"`
+}
+
+// Event is the payload posted to the event webhook for every event recorded in the journal of
+// the node.
+type Event struct {
+	Sequence   uint64            `json:"sequence"`
+	Time       time.Time         `json:"time"`
+	Type       string            `json:"type"`
+	Message    string            `json:"message"`
+	Attributes map[string]string `json:"attributes,omitempty"`
+}
+
+// errSkipped is returned for the items of a stream which are not delivered, e.g. events replaced
+// in the journal.
+var errSkipped = errors.New("item skipped")
+
+// Streams delivered to webhooks.
+const (
+	// StreamBlocks delivers a BlockFinalized payload for every DA included block.
+	StreamBlocks = "blocks"
+	// StreamEvents delivers an Event payload for every event recorded in the journal.
+	StreamEvents = "events"
+)
+
+// Notifier posts the items of a stream to a webhook, e.g. a BlockFinalized payload for every
+// DA included block.
+//
+// Items are delivered in order and at least once: a failed delivery is retried with exponential
+// backoff until it succeeds, and the last delivered item is persisted for every sink, i.e. stream
+// and URL, so that delivery resumes where it stopped after a restart. The items not delivered yet,
+// including those of a downtime of the node or of the webhook, are read back from the store, so
+// that they are replayed in order. When nothing has been delivered to a sink yet, delivery starts
+// after the latest item at startup.
+type Notifier struct {
+	url      string
+	secret   []byte
+	store    store.Store
+	client   *http.Client
+	interval time.Duration
+	logger   zerolog.Logger
+
+	stream string
+	// offsetKey is the metadata key of the last item delivered to the sink
+	offsetKey string
+	// head returns the latest item of the stream, ds.ErrNotFound if there is none yet
+	head func(ctx context.Context) (uint64, error)
+	// build returns the payload of an item, errSkipped if the item is not delivered
+	build func(ctx context.Context, item uint64) (any, error)
+}
+
+// NewNotifier creates a Notifier posting the DA included blocks to url, checking for newly DA
+// included blocks every interval. If secret is not empty, requests are signed with it, see
+// SignatureHeader.
+func NewNotifier(url, secret string, s store.Store, interval time.Duration, logger zerolog.Logger) *Notifier {
+	n := newNotifier(StreamBlocks, url, secret, s, interval, logger)
+	n.head = func(ctx context.Context) (uint64, error) {
+		return getHeight(ctx, s, store.DAIncludedHeightKey)
+	}
+	n.build = func(ctx context.Context, height uint64) (any, error) {
+		return BuildBlockFinalized(ctx, s, height)
+	}
+	return n
+}
+
+// NewEventNotifier creates a Notifier posting the events recorded in the journal of the node to
+// url, checking for new events every interval. Events replaced in the journal before they could
+// be delivered, once it is full, are skipped. If secret is not empty, requests are signed with it,
+// see SignatureHeader.
+func NewEventNotifier(url, secret string, s store.Store, interval time.Duration, logger zerolog.Logger) *Notifier {
+	j := journal.New(s)
+	n := newNotifier(StreamEvents, url, secret, s, interval, logger)
+	n.head = j.LastSequence
+	n.build = func(ctx context.Context, seq uint64) (any, error) {
+		event, err := j.Event(ctx, seq)
+		if errors.Is(err, ds.ErrNotFound) {
+			return nil, errSkipped
+		}
+		if err != nil {
+			return nil, err
+		}
+		return &Event{
+			Sequence:   event.Sequence,
+			Time:       event.Time.AsTime().UTC(),
+			Type:       event.Type,
+			Message:    event.Message,
+			Attributes: event.Attributes,
+		}, nil
+	}
+	return n
+}
+
+func newNotifier(stream, url, secret string, s store.Store, interval time.Duration, logger zerolog.Logger) *Notifier {
+	return &Notifier{
+		url:       url,
+		secret:    []byte(secret),
+		store:     s,
+		client:    &http.Client{Timeout: requestTimeout},
+		interval:  interval,
+		logger:    logger,
+		stream:    stream,
+		offsetKey: OffsetKey(stream, url),
+	}
+}
+
+// OffsetKey returns the metadata key of the last item of stream delivered to the webhook at url.
+func OffsetKey(stream, url string) string {
+	id := sha256.Sum256([]byte(url))
+	return fmt.Sprintf("%s/%s/%s", store.WebhookOffsetKey, stream, hex.EncodeToString(id[:8]))
+}
+
+// Run delivers the items of the stream until the context is canceled.
+func (n *Notifier) Run(ctx context.Context) {
+	delivered, err := n.deliveredOffset(ctx)
+	if err != nil {
+		n.logger.Error().Err(err).Str("stream", n.stream).Msg("failed to load last delivered offset, webhook disabled")
+		return
+	}
+	n.logger.Info().Str("url", n.url).Str("stream", n.stream).Uint64("from", delivered+1).Msg("starting webhook notifications")
+
+	ticker := time.NewTicker(n.interval)
+	defer ticker.Stop()
+
+	backoff := n.interval
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+
+		head, err := n.head(ctx)
+		if errors.Is(err, ds.ErrNotFound) {
+			continue
+		}
+		if err != nil {
+			n.logger.Error().Err(err).Str("stream", n.stream).Msg("failed to get latest item")
+			continue
+		}
+
+		for item := delivered + 1; item <= head; item++ {
+			err := n.deliver(ctx, item)
+			if errors.Is(err, errSkipped) {
+				n.logger.Warn().Str("stream", n.stream).Uint64("item", item).Msg("item no longer stored, skipping its webhook notification")
+				err = nil
+			}
+			if err != nil {
+				n.logger.Warn().Err(err).Str("stream", n.stream).Uint64("item", item).Dur("retryIn", backoff).Msg("failed to deliver webhook notification")
+				select {
+				case <-ctx.Done():
+					return
+				case <-time.After(backoff):
+				}
+				backoff = min(2*backoff, maxBackoff)
+				break
+			}
+			backoff = n.interval
+			delivered = item
+			if err := setHeight(ctx, n.store, n.offsetKey, delivered); err != nil {
+				n.logger.Error().Err(err).Str("stream", n.stream).Uint64("item", delivered).Msg("failed to persist last delivered offset")
+			}
+		}
+	}
+}
+
+// deliveredOffset returns the last item delivered to the sink, initializing it to the latest item
+// if nothing has been delivered yet.
+func (n *Notifier) deliveredOffset(ctx context.Context) (uint64, error) {
+	delivered, err := getHeight(ctx, n.store, n.offsetKey)
+	if err == nil {
+		return delivered, nil
+	}
+	if !errors.Is(err, ds.ErrNotFound) {
+		return 0, err
+	}
+
+	head, err := n.head(ctx)
+	if err != nil && !errors.Is(err, ds.ErrNotFound) {
+		return 0, err
+	}
+	return head, setHeight(ctx, n.store, n.offsetKey, head)
+}
+
+// deliver posts the notification of an item.
+func (n *Notifier) deliver(ctx context.Context, item uint64) error {
+	payload, err := n.build(ctx, item)
+	if err != nil {
+		return err
+	}
+	body, err := json.Marshal(payload)
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, n.url, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	if len(n.secret) > 0 {
+		req.Header.Set(SignatureHeader, Sign(n.secret, body))
+	}
+
+	resp, err := n.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return fmt.Errorf("webhook responded with status %d", resp.StatusCode)
+	}
+	return nil
+}
+
+// BuildBlockFinalized builds the notification of the block at height from the store.
+func BuildBlockFinalized(ctx context.Context, s store.Store, height uint64) (*BlockFinalized, error) {
+	header, data, err := s.GetBlockData(ctx, height)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get block at height %d: %w", height, err)
+	}
+
+	payload := &BlockFinalized{
+		Height:  height,
+		Hash:    hex.EncodeToString(header.Hash()),
+		Time:    header.Time().UTC(),
+		AppHash: hex.EncodeToString(header.AppHash),
+		Txs:     make([]TxResult, len(data.Txs)),
+	}
+	for i, tx := range data.Txs {
+		hash := sha256.Sum256(tx)
+		payload.Txs[i] = TxResult{Hash: hex.EncodeToString(hash[:]), Index: i}
+	}
+	if err := addTxResults(ctx, s, height, payload.Txs); err != nil {
+		return nil, err
+	}
+
+	if payload.HeaderDAHeight, err = getHeight(ctx, s, fmt.Sprintf("%s/%d/h", store.HeightToDAHeightKey, height)); err != nil && !errors.Is(err, ds.ErrNotFound) {
+		return nil, err
+	}
+	if payload.DataDAHeight, err = getHeight(ctx, s, fmt.Sprintf("%s/%d/d", store.HeightToDAHeightKey, height)); err != nil && !errors.Is(err, ds.ErrNotFound) {
+		return nil, err
+	}
+	return payload, nil
+}
+
+// addTxResults fills the execution results of the transactions of the block at height, if they
+// were stored for it.
+func addTxResults(ctx context.Context, s store.Store, height uint64, txs []TxResult) error {
+	bz, err := s.GetMetadata(ctx, fmt.Sprintf("%s/%d", store.TxResultsKey, height))
+	if errors.Is(err, ds.ErrNotFound) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	var results pb.TxResults
+	if err := proto.Unmarshal(bz, &results); err != nil {
+		return fmt.Errorf("failed to unmarshal transaction results of height %d: %w", height, err)
+	}
+	if len(results.Results) != len(txs) {
+		return fmt.Errorf("got %d transaction results for %d transactions at height %d", len(results.Results), len(txs), height)
+	}
+	for i, r := range results.Results {
+		txs[i].Status = TxStatusFailed
+		if r.Success {
+			txs[i].Status = TxStatusSuccess
+		}
+		txs[i].Logs = r.Logs
+	}
+	return nil
+}
+
+// Sign returns the value of SignatureHeader for the body.
+func Sign(secret, body []byte) string {
+	mac := hmac.New(sha256.New, secret)
+	mac.Write(body)
+	return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+}
+
+func getHeight(ctx context.Context, s store.Store, key string) (uint64, error) {
+	bz, err := s.GetMetadata(ctx, key)
+	if err != nil {
+		return 0, err
+	}
+	if len(bz) != 8 {
+		return 0, fmt.Errorf("invalid height stored under %s", key)
+	}
+	return binary.LittleEndian.Uint64(bz), nil
+}
+
+func setHeight(ctx context.Context, s store.Store, key string, height uint64) error {
+	bz := make([]byte, 8)
+	binary.LittleEndian.PutUint64(bz, height)
+	return s.SetMetadata(ctx, key, bz)
+}
@@ -0,0 +1,280 @@
+package webhook
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/evstack/ev-node/pkg/journal"
+	"github.com/evstack/ev-node/pkg/store"
+	"github.com/evstack/ev-node/types"
+	pb "github.com/evstack/ev-node/types/pb/evnode/v1"
+)
+
+// saveBlocks stores blocks 1..n with their DA heights and returns them.
+func saveBlocks(t *testing.T, s store.Store, n uint64) []*types.Data {
+	t.Helper()
+	ctx := context.Background()
+	var blocks []*types.Data
+	for height := uint64(1); height <= n; height++ {
+		header, data := types.GetRandomBlock(height, 2, "test-chain")
+		require.NoError(t, s.SaveBlockData(ctx, header, data, &header.Signature))
+		require.NoError(t, setHeight(ctx, s, fmt.Sprintf("%s/%d/h", store.HeightToDAHeightKey, height), 100+height))
+		require.NoError(t, setHeight(ctx, s, fmt.Sprintf("%s/%d/d", store.HeightToDAHeightKey, height), 200+height))
+		blocks = append(blocks, data)
+	}
+	return blocks
+}
+
+func newTestStore(t *testing.T) store.Store {
+	t.Helper()
+	kv, err := store.NewDefaultInMemoryKVStore()
+	require.NoError(t, err)
+	return store.New(kv)
+}
+
+func TestBuildBlockFinalized(t *testing.T) {
+	s := newTestStore(t)
+	blocks := saveBlocks(t, s, 1)
+
+	payload, err := BuildBlockFinalized(context.Background(), s, 1)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(1), payload.Height)
+	assert.Equal(t, uint64(101), payload.HeaderDAHeight)
+	assert.Equal(t, uint64(201), payload.DataDAHeight)
+	require.Len(t, payload.Txs, 2)
+	for i, tx := range blocks[0].Txs {
+		hash := sha256.Sum256(tx)
+		assert.Equal(t, TxResult{Hash: hex.EncodeToString(hash[:]), Index: i}, payload.Txs[i])
+	}
+
+	_, err = BuildBlockFinalized(context.Background(), s, 2)
+	require.Error(t, err)
+}
+
+func TestBuildBlockFinalizedTxResults(t *testing.T) {
+	ctx := context.Background()
+	s := newTestStore(t)
+	saveBlocks(t, s, 1)
+	key := fmt.Sprintf("%s/%d", store.TxResultsKey, 1)
+
+	bz, err := proto.Marshal(&pb.TxResults{Height: 1, Results: []*pb.TxResult{
+		{Success: true, Logs: []string{`{"topics":[]}`}},
+		{Success: false},
+	}})
+	require.NoError(t, err)
+	require.NoError(t, s.SetMetadata(ctx, key, bz))
+
+	payload, err := BuildBlockFinalized(ctx, s, 1)
+	require.NoError(t, err)
+	require.Len(t, payload.Txs, 2)
+	assert.Equal(t, TxStatusSuccess, payload.Txs[0].Status)
+	assert.Equal(t, []string{`{"topics":[]}`}, payload.Txs[0].Logs)
+	assert.Equal(t, TxStatusFailed, payload.Txs[1].Status)
+	assert.Empty(t, payload.Txs[1].Logs)
+
+	// results not matching the transactions of the block are rejected
+	bz, err = proto.Marshal(&pb.TxResults{Height: 1, Results: []*pb.TxResult{{Success: true}}})
+	require.NoError(t, err)
+	require.NoError(t, s.SetMetadata(ctx, key, bz))
+	_, err = BuildBlockFinalized(ctx, s, 1)
+	require.Error(t, err)
+}
+
+func TestNotifier(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	s := newTestStore(t)
+	saveBlocks(t, s, 4)
+	// block 1 was DA included before the webhook was registered
+	require.NoError(t, setHeight(ctx, s, store.DAIncludedHeightKey, 1))
+
+	secret := "secret"
+	var (
+		mu       sync.Mutex
+		heights  []uint64
+		failures = 1
+	)
+	received := make(chan struct{}, 10)
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, err := io.ReadAll(r.Body)
+		require.NoError(t, err)
+		assert.Equal(t, Sign([]byte(secret), body), r.Header.Get(SignatureHeader))
+
+		mu.Lock()
+		defer mu.Unlock()
+		if failures > 0 {
+			failures--
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		var payload BlockFinalized
+		require.NoError(t, json.Unmarshal(body, &payload))
+		heights = append(heights, payload.Height)
+		received <- struct{}{}
+	}))
+	defer srv.Close()
+
+	n := NewNotifier(srv.URL, secret, s, 10*time.Millisecond, zerolog.Nop())
+	go n.Run(ctx)
+	require.Eventually(t, func() bool {
+		delivered, err := getHeight(ctx, s, OffsetKey(StreamBlocks, srv.URL))
+		return err == nil && delivered == 1
+	}, time.Second, 10*time.Millisecond)
+
+	require.NoError(t, setHeight(ctx, s, store.DAIncludedHeightKey, 3))
+	for i := 0; i < 2; i++ {
+		select {
+		case <-received:
+		case <-time.After(2 * time.Second):
+			t.Fatal("expected webhook notification")
+		}
+	}
+
+	mu.Lock()
+	// the failed delivery of block 2 is retried, block 1 is not delivered
+	assert.Equal(t, []uint64{2, 3}, heights)
+	mu.Unlock()
+
+	require.Eventually(t, func() bool {
+		delivered, err := getHeight(ctx, s, OffsetKey(StreamBlocks, srv.URL))
+		return err == nil && delivered == 3
+	}, time.Second, 10*time.Millisecond)
+
+	// delivery resumes after the last delivered height
+	cancel()
+	ctx, cancel = context.WithCancel(context.Background())
+	defer cancel()
+	go NewNotifier(srv.URL, secret, s, 10*time.Millisecond, zerolog.Nop()).Run(ctx)
+	require.NoError(t, setHeight(ctx, s, store.DAIncludedHeightKey, 4))
+	select {
+	case <-received:
+	case <-time.After(2 * time.Second):
+		t.Fatal("expected webhook notification")
+	}
+	mu.Lock()
+	assert.Equal(t, []uint64{2, 3, 4}, heights)
+	mu.Unlock()
+}
+
+// recorder is a webhook recording the payloads it receives.
+type recorder struct {
+	*httptest.Server
+	mu       sync.Mutex
+	bodies   [][]byte
+	received chan struct{}
+}
+
+func newRecorder(t *testing.T) *recorder {
+	t.Helper()
+	r := &recorder{received: make(chan struct{}, 100)}
+	r.Server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		body, err := io.ReadAll(req.Body)
+		require.NoError(t, err)
+		r.mu.Lock()
+		r.bodies = append(r.bodies, body)
+		r.mu.Unlock()
+		r.received <- struct{}{}
+	}))
+	t.Cleanup(r.Close)
+	return r
+}
+
+// wait waits for n payloads and returns all the payloads received.
+func (r *recorder) wait(t *testing.T, n int) [][]byte {
+	t.Helper()
+	for i := 0; i < n; i++ {
+		select {
+		case <-r.received:
+		case <-time.After(2 * time.Second):
+			t.Fatal("expected webhook notification")
+		}
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.bodies
+}
+
+func TestNotifierOffsetsBySink(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	s := newTestStore(t)
+	saveBlocks(t, s, 3)
+	require.NoError(t, setHeight(ctx, s, store.DAIncludedHeightKey, 3))
+
+	// a sink resumes from its persisted offset
+	first := newRecorder(t)
+	require.NoError(t, setHeight(ctx, s, OffsetKey(StreamBlocks, first.URL), 1))
+	go NewNotifier(first.URL, "", s, 10*time.Millisecond, zerolog.Nop()).Run(ctx)
+	require.Len(t, first.wait(t, 2), 2)
+
+	// a new sink starts after the latest block, independently of the first one
+	second := newRecorder(t)
+	go NewNotifier(second.URL, "", s, 10*time.Millisecond, zerolog.Nop()).Run(ctx)
+	require.Eventually(t, func() bool {
+		delivered, err := getHeight(ctx, s, OffsetKey(StreamBlocks, second.URL))
+		return err == nil && delivered == 3
+	}, time.Second, 10*time.Millisecond)
+
+	header, data := types.GetRandomBlock(4, 1, "test-chain")
+	require.NoError(t, s.SaveBlockData(ctx, header, data, &header.Signature))
+	require.NoError(t, setHeight(ctx, s, store.DAIncludedHeightKey, 4))
+	var payload BlockFinalized
+	require.NoError(t, json.Unmarshal(second.wait(t, 1)[0], &payload))
+	assert.Equal(t, uint64(4), payload.Height)
+	require.Len(t, first.wait(t, 1), 3)
+}
+
+func TestEventNotifier(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	s := newTestStore(t)
+	j := journal.New(s)
+	// recorded before the webhook was registered
+	require.NoError(t, j.Record(ctx, journal.EventNodeStarted, "node started", nil))
+
+	r := newRecorder(t)
+	go NewEventNotifier(r.URL, "", s, 10*time.Millisecond, zerolog.Nop()).Run(ctx)
+	require.Eventually(t, func() bool {
+		delivered, err := getHeight(ctx, s, OffsetKey(StreamEvents, r.URL))
+		return err == nil && delivered == 1
+	}, time.Second, 10*time.Millisecond)
+
+	// events recorded while the notifier is down are replayed in order on restart
+	cancel()
+	require.NoError(t, j.Record(context.Background(), journal.EventPeerConnected, "peer connected", map[string]string{"peer": "a"}))
+	require.NoError(t, j.Record(context.Background(), journal.EventPeerDisconnected, "peer disconnected", map[string]string{"peer": "a"}))
+	ctx, cancel = context.WithCancel(context.Background())
+	defer cancel()
+	go NewEventNotifier(r.URL, "", s, 10*time.Millisecond, zerolog.Nop()).Run(ctx)
+
+	bodies := r.wait(t, 2)
+	require.Len(t, bodies, 2)
+	var events []Event
+	for _, body := range bodies {
+		var event Event
+		require.NoError(t, json.Unmarshal(body, &event))
+		events = append(events, event)
+	}
+	assert.Equal(t, uint64(2), events[0].Sequence)
+	assert.Equal(t, journal.EventPeerConnected, events[0].Type)
+	assert.Equal(t, map[string]string{"peer": "a"}, events[0].Attributes)
+	assert.Equal(t, uint64(3), events[1].Sequence)
+	assert.Equal(t, journal.EventPeerDisconnected, events[1].Type)
+}
@@ -1,15 +1,20 @@
 # Extract the latest Git tag as the version number
 VERSION := $(shell git describe --tags --abbrev=0)
 GITSHA := $(shell git rev-parse --short HEAD)
+# Who builds the binaries, reported by the node info RPC, e.g. the name of a CI job
+BUILDER ?= $(shell whoami)
 LDFLAGS := \
 	-X github.com/evstack/ev-node/pkg/cmd.Version=$(VERSION) \
-	-X github.com/evstack/ev-node/pkg/cmd.GitSHA=$(GITSHA)
+	-X github.com/evstack/ev-node/pkg/cmd.GitSHA=$(GITSHA) \
+	-X github.com/evstack/ev-node/pkg/buildinfo.Builder=$(BUILDER)
+# Builds are reproducible: paths of the build machine are not embedded in the binaries
+BUILDFLAGS := -trimpath
 
 ## build: build Testapp CLI
 build:
 	@echo "--> Building Testapp CLI"
 	@mkdir -p $(CURDIR)/build
-	@cd apps/testapp && go build -ldflags "$(LDFLAGS)" -o $(CURDIR)/build/testapp .
+	@cd apps/testapp && go build $(BUILDFLAGS) -ldflags "$(LDFLAGS)" -o $(CURDIR)/build/testapp .
 	@echo "--> Testapp CLI Built!"
 	@echo "    Check the version with: build/testapp version"
         @echo "    Check the binary with: $(CURDIR)/build/testapp"
@@ -18,7 +23,7 @@ build:
 ## install: Install Testapp CLI
 install:
 	@echo "--> Installing Testapp CLI"
-	@cd apps/testapp && go install -ldflags "$(LDFLAGS)" .
+	@cd apps/testapp && go install $(BUILDFLAGS) -ldflags "$(LDFLAGS)" .
 	@echo "--> Testapp CLI Installed!"
 	@echo "    Check the version with: testapp version"
 	@echo "    Check the binary with: which testapp"
@@ -28,18 +33,18 @@ build-all:
 	@echo "--> Building all ev-node binaries"
 	@mkdir -p $(CURDIR)/build
 	@echo "--> Building testapp"
-	@cd apps/testapp && go build -ldflags "$(LDFLAGS)" -o $(CURDIR)/build/testapp .
+	@cd apps/testapp && go build $(BUILDFLAGS) -ldflags "$(LDFLAGS)" -o $(CURDIR)/build/testapp .
 	@echo "--> Building evm-single"
-	@cd apps/evm/single && go build -ldflags "$(LDFLAGS)" -o $(CURDIR)/build/evm-single .
+	@cd apps/evm/single && go build $(BUILDFLAGS) -ldflags "$(LDFLAGS)" -o $(CURDIR)/build/evm-single .
 	@echo "--> Building local-da"
-	@cd da && go build -ldflags "$(LDFLAGS)" -o $(CURDIR)/build/local-da ./cmd/local-da
+	@cd da && go build $(BUILDFLAGS) -ldflags "$(LDFLAGS)" -o $(CURDIR)/build/local-da ./cmd/local-da
 	@echo "--> All ev-node binaries built!"
 
 ## build-testapp-bench:
 build-testapp-bench:
 	@echo "--> Building Testapp Bench"
 	@mkdir -p $(CURDIR)/build
-	@cd apps/testapp && go build -ldflags "$(LDFLAGS)" -o $(CURDIR)/build/testapp-bench ./kv/bench
+	@cd apps/testapp && go build $(BUILDFLAGS) -ldflags "$(LDFLAGS)" -o $(CURDIR)/build/testapp-bench ./kv/bench
 	@echo "    Check the binary with: $(CURDIR)/build/testapp-bench"
 .PHONY: build-testapp-bench
 
@@ -47,13 +52,13 @@ build-testapp-bench:
 build-evm-single:
 	@echo "--> Building EVM single"
 	@mkdir -p $(CURDIR)/build
-	@cd apps/evm/single && go build -ldflags "$(LDFLAGS)" -o $(CURDIR)/build/evm-single .
+	@cd apps/evm/single && go build $(BUILDFLAGS) -ldflags "$(LDFLAGS)" -o $(CURDIR)/build/evm-single .
         @echo "    Check the binary with: $(CURDIR)/build/evm-single"
 
 build-da:
 	@echo "--> Building local-da"
 	@mkdir -p $(CURDIR)/build
-	@cd da && go build -ldflags "$(LDFLAGS)" -o $(CURDIR)/build/local-da ./cmd/local-da
+	@cd da && go build $(BUILDFLAGS) -ldflags "$(LDFLAGS)" -o $(CURDIR)/build/local-da ./cmd/local-da
         @echo "    Check the binary with: $(CURDIR)/build/local-da"
 .PHONY: build-da
 
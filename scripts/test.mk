@@ -39,6 +39,16 @@ test-cover:
 	@go run -tags=cover scripts/test_cover.go
 .PHONY: test-cover
 
+## test-fuzz: Run each serialization fuzz target for FUZZ_TIME (default 30s)
+FUZZ_TIME ?= 30s
+test-fuzz:
+	@echo "--> Running fuzz tests"
+	@for target in $$(go test ./types -list '^Fuzz' | grep '^Fuzz'); do \
+		echo "--> $$target"; \
+		go test ./types -run '^$$' -fuzz "^$$target\$$" -fuzztime $(FUZZ_TIME) || exit 1; \
+	done
+.PHONY: test-fuzz
+
 ## test-evm: Running EVM tests
 test-evm:
 	@echo "--> Running EVM tests"
@@ -0,0 +1,97 @@
+package single
+
+import (
+	"context"
+	"time"
+
+	coresequencer "github.com/evstack/ev-node/core/sequencer"
+)
+
+// orderflowTimeout bounds the time the sequencer waits for the bundles of an orderflow source, so
+// that a slow source does not delay the batch.
+const orderflowTimeout = 500 * time.Millisecond
+
+// orderflowSource is an orderflow source of the sequencer with its quota.
+type orderflowSource struct {
+	source coresequencer.OrderflowSource
+	quota  coresequencer.OrderflowQuota
+}
+
+// AddOrderflowSource makes the sequencer pull bundles from source for every batch, up to quota,
+// and place them ahead of the transactions of its queue, in the order the sources were added.
+// Bundles are subject to the transaction policy as a whole: a bundle with a rejected transaction
+// is dropped. It must be called before the sequencer is used.
+func (c *Sequencer) AddOrderflowSource(source coresequencer.OrderflowSource, quota coresequencer.OrderflowQuota) {
+	c.orderflow = append(c.orderflow, orderflowSource{source: source, quota: quota})
+}
+
+// pullOrderflow returns the transactions of the bundles pulled from the orderflow sources, with
+// their attribution, totaling at most maxBytes bytes unless 0. A failing source, or one not
+// answering within orderflowTimeout, is skipped for the batch.
+func (c *Sequencer) pullOrderflow(ctx context.Context, maxBytes uint64) ([][]byte, []coresequencer.BundleInclusion) {
+	var (
+		txs        [][]byte
+		inclusions []coresequencer.BundleInclusion
+		totalBytes uint64
+	)
+	for _, of := range c.orderflow {
+		// the quota of the source is narrowed to the room left in the batch
+		quota := of.quota
+		if maxBytes > 0 {
+			if totalBytes >= maxBytes {
+				break
+			}
+			if remaining := maxBytes - totalBytes; quota.MaxBytes == 0 || quota.MaxBytes > remaining {
+				quota.MaxBytes = remaining
+			}
+		}
+
+		name := of.source.Name()
+		pullCtx, cancel := context.WithTimeout(ctx, orderflowTimeout)
+		bundles, err := of.source.Bundles(pullCtx, quota)
+		cancel()
+		if err != nil {
+			c.logger.Warn().Err(err).Str("source", name).Msg("failed to pull bundles from orderflow source")
+			continue
+		}
+
+		var numTxs int
+		var numBytes uint64
+		for _, bundle := range bundles {
+			if len(bundle.Transactions) == 0 {
+				continue
+			}
+			size := txsSize(bundle.Transactions)
+			if (quota.MaxTxs > 0 && numTxs+len(bundle.Transactions) > quota.MaxTxs) ||
+				(quota.MaxBytes > 0 && numBytes+size > quota.MaxBytes) {
+				c.logger.Debug().Str("source", name).Str("bundle", bundle.ID).Msg("dropping bundle over the quota of its source")
+				continue
+			}
+			if accepted := c.filterTxs(bundle.Transactions); len(accepted) != len(bundle.Transactions) {
+				c.logger.Debug().Str("source", name).Str("bundle", bundle.ID).Msg("dropping bundle with transactions rejected by the transaction policy")
+				continue
+			}
+
+			inclusions = append(inclusions, coresequencer.BundleInclusion{
+				Source:   name,
+				BundleID: bundle.ID,
+				Start:    len(txs),
+				Count:    len(bundle.Transactions),
+			})
+			txs = append(txs, bundle.Transactions...)
+			numTxs += len(bundle.Transactions)
+			numBytes += size
+		}
+		totalBytes += numBytes
+	}
+	return txs, inclusions
+}
+
+// txsSize returns the total size of transactions.
+func txsSize(txs [][]byte) uint64 {
+	var size uint64
+	for _, tx := range txs {
+		size += uint64(len(tx))
+	}
+	return size
+}
@@ -0,0 +1,106 @@
+package single
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+
+	coresequencer "github.com/evstack/ev-node/core/sequencer"
+)
+
+// maxOrderflowResponseBytes bounds the size of the responses of HTTP orderflow sources.
+const maxOrderflowResponseBytes = 32 << 20
+
+// httpBundle is a bundle in the responses of HTTP orderflow sources, whose transactions are base64
+// encoded.
+type httpBundle struct {
+	ID           string   `json:"id"`
+	Transactions [][]byte `json:"transactions"`
+}
+
+// httpOrderflowSource pulls bundles from an HTTP endpoint.
+type httpOrderflowSource struct {
+	name   string
+	url    string
+	client *http.Client
+}
+
+// NewHTTPOrderflowSource returns an orderflow source named name, pulling bundles from endpoint with
+// an HTTP GET passing the quota in the max_txs and max_bytes query parameters. The endpoint answers
+// with a JSON array of bundles, e.g. [{"id":"b1","transactions":["<base64>"]}]. A nil client is
+// http.DefaultClient: the sequencer bounds every pull with its own timeout.
+func NewHTTPOrderflowSource(name, endpoint string, client *http.Client) coresequencer.OrderflowSource {
+	if client == nil {
+		client = http.DefaultClient
+	}
+	return &httpOrderflowSource{name: name, url: endpoint, client: client}
+}
+
+// Name implements coresequencer.OrderflowSource.
+func (s *httpOrderflowSource) Name() string {
+	return s.name
+}
+
+// Bundles implements coresequencer.OrderflowSource.
+func (s *httpOrderflowSource) Bundles(ctx context.Context, quota coresequencer.OrderflowQuota) ([]coresequencer.Bundle, error) {
+	u, err := url.Parse(s.url)
+	if err != nil {
+		return nil, err
+	}
+	query := u.Query()
+	if quota.MaxTxs > 0 {
+		query.Set("max_txs", strconv.Itoa(quota.MaxTxs))
+	}
+	if quota.MaxBytes > 0 {
+		query.Set("max_bytes", strconv.FormatUint(quota.MaxBytes, 10))
+	}
+	u.RawQuery = query.Encode()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, u.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("unexpected status %s", resp.Status)
+	}
+
+	var bundles []httpBundle
+	if err := json.NewDecoder(io.LimitReader(resp.Body, maxOrderflowResponseBytes)).Decode(&bundles); err != nil {
+		return nil, fmt.Errorf("invalid bundles: %w", err)
+	}
+	res := make([]coresequencer.Bundle, 0, len(bundles))
+	for _, bundle := range bundles {
+		res = append(res, coresequencer.Bundle{ID: bundle.ID, Transactions: bundle.Transactions})
+	}
+	return res, nil
+}
+
+// AddHTTPOrderflowSources adds the HTTP orderflow sources of a comma-separated list of name=url
+// entries, e.g. the node.orderflow_sources configuration, without quota: their bundles are only
+// bounded by the size of the batches.
+func (c *Sequencer) AddHTTPOrderflowSources(list string) error {
+	for _, entry := range strings.Split(list, ",") {
+		if entry = strings.TrimSpace(entry); entry == "" {
+			continue
+		}
+		name, endpoint, ok := strings.Cut(entry, "=")
+		if !ok || name == "" {
+			return fmt.Errorf("invalid orderflow source %q: expected name=url", entry)
+		}
+		if u, err := url.Parse(endpoint); err != nil || (u.Scheme != "http" && u.Scheme != "https") {
+			return fmt.Errorf("invalid URL of orderflow source %q: %s", name, endpoint)
+		}
+		c.AddOrderflowSource(NewHTTPOrderflowSource(name, endpoint, nil), coresequencer.OrderflowQuota{})
+	}
+	return nil
+}
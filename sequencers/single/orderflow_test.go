@@ -0,0 +1,175 @@
+package single
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	ds "github.com/ipfs/go-datastore"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	coreda "github.com/evstack/ev-node/core/da"
+	coresequencer "github.com/evstack/ev-node/core/sequencer"
+)
+
+// testOrderflowSource returns fixed bundles, or an error.
+type testOrderflowSource struct {
+	name    string
+	bundles []coresequencer.Bundle
+	err     error
+	quota   coresequencer.OrderflowQuota
+}
+
+func (s *testOrderflowSource) Name() string {
+	return s.name
+}
+
+func (s *testOrderflowSource) Bundles(_ context.Context, quota coresequencer.OrderflowQuota) ([]coresequencer.Bundle, error) {
+	s.quota = quota
+	return s.bundles, s.err
+}
+
+func TestSequencer_GetNextBatch_Orderflow(t *testing.T) {
+	metrics, _ := NopMetrics()
+	dummyDA := coreda.NewDummyDA(100_000_000, 0, 0, 10*time.Second)
+	db := ds.NewMapDatastore()
+	defer db.Close()
+	ctx, cancel := context.WithTimeout(context.Background(), 3*time.Second)
+	defer cancel()
+	Id := []byte("test1")
+	seq, err := NewSequencer(ctx, zerolog.Nop(), db, dummyDA, Id, 10*time.Second, metrics, false)
+	require.NoError(t, err)
+	seq.SetTxFilter(testTxDecoder{}, TxPolicy{DeniedMethods: [][]byte{[]byte("bbbb")}})
+
+	private := &testOrderflowSource{name: "private", bundles: []coresequencer.Bundle{
+		{ID: "p1", Transactions: [][]byte{[]byte("aaaa01"), []byte("aaaa02")}},
+		// rejected by the transaction policy
+		{ID: "p2", Transactions: [][]byte{[]byte("aaaa03"), []byte("bbbb01")}},
+		// over the quota of the source
+		{ID: "p3", Transactions: [][]byte{[]byte("aaaa04"), []byte("aaaa05")}},
+		{ID: "p4", Transactions: [][]byte{[]byte("aaaa06")}},
+	}}
+	builder := &testOrderflowSource{name: "builder", bundles: []coresequencer.Bundle{
+		{ID: "b1", Transactions: [][]byte{[]byte("aaaa07")}},
+	}}
+	failing := &testOrderflowSource{name: "failing", err: errors.New("endpoint down")}
+	quota := coresequencer.OrderflowQuota{MaxTxs: 3}
+	seq.AddOrderflowSource(private, quota)
+	seq.AddOrderflowSource(failing, coresequencer.OrderflowQuota{})
+	seq.AddOrderflowSource(builder, coresequencer.OrderflowQuota{MaxBytes: 6})
+
+	_, err = seq.SubmitBatchTxs(ctx, coresequencer.SubmitBatchTxsRequest{
+		Id:    Id,
+		Batch: &coresequencer.Batch{Transactions: [][]byte{[]byte("aaaa08")}},
+	})
+	require.NoError(t, err)
+
+	res, err := seq.GetNextBatch(ctx, coresequencer.GetNextBatchRequest{Id: Id})
+	require.NoError(t, err)
+	assert.Equal(t, quota, private.quota)
+	// the bundles are ahead of the public queue, in the order of their sources
+	assert.Equal(t, [][]byte{
+		[]byte("aaaa01"), []byte("aaaa02"), []byte("aaaa06"), []byte("aaaa07"), []byte("aaaa08"),
+	}, res.Batch.Transactions)
+	assert.Equal(t, []coresequencer.BundleInclusion{
+		{Source: "private", BundleID: "p1", Start: 0, Count: 2},
+		{Source: "private", BundleID: "p4", Start: 2, Count: 1},
+		{Source: "builder", BundleID: "b1", Start: 3, Count: 1},
+	}, res.Orderflow)
+
+	// bundles are pulled even when the public queue is empty
+	res, err = seq.GetNextBatch(ctx, coresequencer.GetNextBatchRequest{Id: Id})
+	require.NoError(t, err)
+	assert.Len(t, res.Batch.Transactions, 4)
+}
+
+// slowOrderflowSource answers once its context is done.
+type slowOrderflowSource struct{}
+
+func (slowOrderflowSource) Name() string {
+	return "slow"
+}
+
+func (slowOrderflowSource) Bundles(ctx context.Context, _ coresequencer.OrderflowQuota) ([]coresequencer.Bundle, error) {
+	<-ctx.Done()
+	return []coresequencer.Bundle{{ID: "late", Transactions: [][]byte{[]byte("late")}}}, ctx.Err()
+}
+
+func TestSequencer_GetNextBatch_OrderflowMaxBytes(t *testing.T) {
+	metrics, _ := NopMetrics()
+	dummyDA := coreda.NewDummyDA(100_000_000, 0, 0, 10*time.Second)
+	db := ds.NewMapDatastore()
+	defer db.Close()
+	ctx, cancel := context.WithTimeout(context.Background(), 3*time.Second)
+	defer cancel()
+	Id := []byte("test1")
+	seq, err := NewSequencer(ctx, zerolog.Nop(), db, dummyDA, Id, 10*time.Second, metrics, false)
+	require.NoError(t, err)
+
+	first := &testOrderflowSource{name: "first", bundles: []coresequencer.Bundle{
+		{ID: "f1", Transactions: [][]byte{[]byte("aaaa01")}},
+		// over the room left in the batch
+		{ID: "f2", Transactions: [][]byte{[]byte("aaaa02"), []byte("aaaa03")}},
+	}}
+	second := &testOrderflowSource{name: "second", bundles: []coresequencer.Bundle{
+		{ID: "s1", Transactions: [][]byte{[]byte("aaaa04")}},
+	}}
+	seq.AddOrderflowSource(first, coresequencer.OrderflowQuota{})
+	seq.AddOrderflowSource(slowOrderflowSource{}, coresequencer.OrderflowQuota{})
+	seq.AddOrderflowSource(second, coresequencer.OrderflowQuota{MaxBytes: 100})
+
+	_, err = seq.SubmitBatchTxs(ctx, coresequencer.SubmitBatchTxsRequest{
+		Id:    Id,
+		Batch: &coresequencer.Batch{Transactions: [][]byte{[]byte("aaaa05")}},
+	})
+	require.NoError(t, err)
+
+	// the bundles fill the 14 bytes the queue leaves, and the slow source is skipped
+	res, err := seq.GetNextBatch(ctx, coresequencer.GetNextBatchRequest{Id: Id, MaxBytes: 20})
+	require.NoError(t, err)
+	assert.Equal(t, coresequencer.OrderflowQuota{MaxBytes: 14}, first.quota)
+	assert.Equal(t, coresequencer.OrderflowQuota{MaxBytes: 8}, second.quota)
+	assert.Equal(t, [][]byte{[]byte("aaaa01"), []byte("aaaa04"), []byte("aaaa05")}, res.Batch.Transactions)
+
+	// no bundles are pulled when the queue fills the batch
+	first.quota = coresequencer.OrderflowQuota{}
+	_, err = seq.SubmitBatchTxs(ctx, coresequencer.SubmitBatchTxsRequest{
+		Id:    Id,
+		Batch: &coresequencer.Batch{Transactions: [][]byte{[]byte("aaaa06")}},
+	})
+	require.NoError(t, err)
+	res, err = seq.GetNextBatch(ctx, coresequencer.GetNextBatchRequest{Id: Id, MaxBytes: 6})
+	require.NoError(t, err)
+	assert.Equal(t, [][]byte{[]byte("aaaa06")}, res.Batch.Transactions)
+	assert.Empty(t, res.Orderflow)
+	assert.Zero(t, first.quota)
+}
+
+func TestHTTPOrderflowSource(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "2", r.URL.Query().Get("max_txs"))
+		assert.Equal(t, "100", r.URL.Query().Get("max_bytes"))
+		_, _ = w.Write([]byte(`[{"id":"b1","transactions":["YWFhYTAx","YWFhYTAy"]}]`))
+	}))
+	defer srv.Close()
+
+	source := NewHTTPOrderflowSource("builder", srv.URL, nil)
+	assert.Equal(t, "builder", source.Name())
+	bundles, err := source.Bundles(context.Background(), coresequencer.OrderflowQuota{MaxTxs: 2, MaxBytes: 100})
+	require.NoError(t, err)
+	assert.Equal(t, []coresequencer.Bundle{
+		{ID: "b1", Transactions: [][]byte{[]byte("aaaa01"), []byte("aaaa02")}},
+	}, bundles)
+
+	seq := &Sequencer{}
+	require.NoError(t, seq.AddHTTPOrderflowSources(" builder="+srv.URL+",, private=https://orderflow.example.com "))
+	require.Len(t, seq.orderflow, 2)
+	assert.Equal(t, "private", seq.orderflow[1].source.Name())
+	assert.Error(t, seq.AddHTTPOrderflowSources("builder"))
+	assert.Error(t, seq.AddHTTPOrderflowSources("builder=ftp://example.com"))
+}
@@ -3,7 +3,6 @@ package single
 import (
 	"context"
 	"encoding/hex"
-	"errors"
 	"fmt"
 	"sync"
 
@@ -18,7 +17,7 @@ import (
 )
 
 // ErrQueueFull is returned when the batch queue has reached its maximum size
-var ErrQueueFull = errors.New("batch queue is full")
+var ErrQueueFull = fmt.Errorf("batch queue is full: %w", coresequencer.ErrQueueFull)
 
 func newPrefixKV(kvStore ds.Batching, prefix string) ds.Batching {
 	return ktds.Wrap(kvStore, ktds.PrefixTransform{Prefix: ds.NewKey(prefix)})
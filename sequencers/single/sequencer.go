@@ -11,6 +11,7 @@ import (
 	"github.com/rs/zerolog"
 
 	coreda "github.com/evstack/ev-node/core/da"
+	coreexecution "github.com/evstack/ev-node/core/execution"
 	coresequencer "github.com/evstack/ev-node/core/sequencer"
 )
 
@@ -35,6 +36,11 @@ type Sequencer struct {
 	queue *BatchQueue // single queue for immediate availability
 
 	metrics *Metrics
+
+	txDecoder coreexecution.TxDecoder
+	txPolicy  TxPolicy
+
+	orderflow []orderflowSource
 }
 
 // NewSequencer creates a new Single Sequencer
@@ -94,7 +100,12 @@ func (c *Sequencer) SubmitBatchTxs(ctx context.Context, req coresequencer.Submit
 		return &coresequencer.SubmitBatchTxsResponse{}, nil
 	}
 
-	batch := coresequencer.Batch{Transactions: req.Batch.Transactions}
+	txs := c.filterTxs(req.Batch.Transactions)
+	if len(txs) == 0 {
+		return &coresequencer.SubmitBatchTxsResponse{}, nil
+	}
+
+	batch := coresequencer.Batch{Transactions: txs}
 
 	err := c.queue.AddBatch(ctx, batch)
 	if err != nil {
@@ -121,10 +132,24 @@ func (c *Sequencer) GetNextBatch(ctx context.Context, req coresequencer.GetNextB
 	if err != nil {
 		return nil, err
 	}
+	// the bundles fill the room the transactions of the queue leave in the batch
+	var maxBytes uint64
+	if req.MaxBytes > 0 {
+		queued := txsSize(batch.Transactions)
+		if queued >= req.MaxBytes {
+			return &coresequencer.GetNextBatchResponse{Batch: batch, Timestamp: time.Now()}, nil
+		}
+		maxBytes = req.MaxBytes - queued
+	}
+	txs, inclusions := c.pullOrderflow(ctx, maxBytes)
+	if len(txs) > 0 {
+		batch = &coresequencer.Batch{Transactions: append(txs, batch.Transactions...)}
+	}
 
 	return &coresequencer.GetNextBatchResponse{
 		Batch:     batch,
 		Timestamp: time.Now(),
+		Orderflow: inclusions,
 	}, nil
 }
 
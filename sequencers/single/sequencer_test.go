@@ -685,6 +685,7 @@ func TestSequencer_DAFailureAndQueueThrottling_Integration(t *testing.T) {
 	resp, err := seq.SubmitBatchTxs(ctx, overflowReq)
 	require.Error(t, err, "Expected error when queue is full")
 	require.True(t, errors.Is(err, ErrQueueFull), "Expected ErrQueueFull, got %v", err)
+	require.True(t, errors.Is(err, coresequencer.ErrQueueFull), "Expected the core ErrQueueFull, got %v", err)
 	require.Nil(t, resp, "Expected nil response when queue is full")
 
 	t.Log("✅ Successfully demonstrated ErrQueueFull when queue reaches limit")
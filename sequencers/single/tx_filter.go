@@ -0,0 +1,84 @@
+package single
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"slices"
+
+	coreexecution "github.com/evstack/ev-node/core/execution"
+)
+
+// ErrTxRejected is returned by a TxPolicy for transactions which must not be batched.
+var ErrTxRejected = errors.New("transaction rejected by sequencer policy")
+
+// TxPolicy is a chain-specific policy applied by the sequencer to the decoded transactions it
+// receives, before batching them. The zero value accepts every transaction.
+type TxPolicy struct {
+	// AllowedMethods, if not empty, are the only methods which may be called.
+	AllowedMethods [][]byte
+	// DeniedMethods are methods which may not be called.
+	DeniedMethods [][]byte
+	// DenyContractCreation rejects the transactions creating a contract.
+	DenyContractCreation bool
+}
+
+// IsZero returns whether the policy is the zero value, accepting every transaction.
+func (p TxPolicy) IsZero() bool {
+	return len(p.AllowedMethods) == 0 && len(p.DeniedMethods) == 0 && !p.DenyContractCreation
+}
+
+// Check returns an error wrapping ErrTxRejected if the policy rejects the transaction.
+func (p TxPolicy) Check(tx coreexecution.DecodedTx) error {
+	if tx.IsContractCreation() {
+		if p.DenyContractCreation {
+			return fmt.Errorf("%w: contract creation", ErrTxRejected)
+		}
+		return nil
+	}
+	if tx.Method == nil {
+		return nil
+	}
+	if len(p.AllowedMethods) > 0 && !slices.ContainsFunc(p.AllowedMethods, func(m []byte) bool { return bytes.Equal(m, tx.Method) }) {
+		return fmt.Errorf("%w: method %x not allowed", ErrTxRejected, tx.Method)
+	}
+	if slices.ContainsFunc(p.DeniedMethods, func(m []byte) bool { return bytes.Equal(m, tx.Method) }) {
+		return fmt.Errorf("%w: method %x denied", ErrTxRejected, tx.Method)
+	}
+	return nil
+}
+
+// SetTxFilter makes the sequencer decode the submitted transactions with decoder and drop those
+// that the policy rejects before batching them. Transactions which cannot be decoded, e.g. of a
+// type unknown to the decoder, are left to the execution layer and batched.
+// It must be called before the sequencer is used.
+func (c *Sequencer) SetTxFilter(decoder coreexecution.TxDecoder, policy TxPolicy) {
+	c.txDecoder = decoder
+	c.txPolicy = policy
+}
+
+// filterTxs returns the transactions accepted by the transaction policy, if any.
+func (c *Sequencer) filterTxs(txs [][]byte) [][]byte {
+	if c.txDecoder == nil {
+		return txs
+	}
+
+	accepted := make([][]byte, 0, len(txs))
+	for _, tx := range txs {
+		decoded, err := c.txDecoder.DecodeTx(tx)
+		if err != nil {
+			c.logger.Debug().Err(err).Int("txSize", len(tx)).Msg("batching transaction which cannot be decoded")
+			accepted = append(accepted, tx)
+			continue
+		}
+		if err := c.txPolicy.Check(decoded); err != nil {
+			c.logger.Debug().Err(err).Int("txSize", len(tx)).Msg("dropping transaction")
+			continue
+		}
+		accepted = append(accepted, tx)
+	}
+	if dropped := len(txs) - len(accepted); dropped > 0 {
+		c.logger.Info().Int("dropped", dropped).Int("accepted", len(accepted)).Msg("dropped transactions rejected by the transaction policy")
+	}
+	return accepted
+}
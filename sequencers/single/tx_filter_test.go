@@ -0,0 +1,111 @@
+package single
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	ds "github.com/ipfs/go-datastore"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	coreda "github.com/evstack/ev-node/core/da"
+	coreexecution "github.com/evstack/ev-node/core/execution"
+	coresequencer "github.com/evstack/ev-node/core/sequencer"
+)
+
+// testTxDecoder decodes transactions made of a 4-byte method followed by the call arguments, or of
+// a single "c" byte for contract creations.
+type testTxDecoder struct{}
+
+func (testTxDecoder) DecodeTx(tx []byte) (coreexecution.DecodedTx, error) {
+	switch {
+	case string(tx) == "c":
+		return coreexecution.DecodedTx{}, nil
+	case len(tx) < 4:
+		return coreexecution.DecodedTx{}, errors.New("invalid transaction")
+	}
+	return coreexecution.DecodedTx{To: []byte("contract"), Method: tx[:4]}, nil
+}
+
+func TestTxPolicy_Check(t *testing.T) {
+	call := func(method string) coreexecution.DecodedTx {
+		return coreexecution.DecodedTx{To: []byte("contract"), Method: []byte(method)}
+	}
+	creation := coreexecution.DecodedTx{}
+	transfer := coreexecution.DecodedTx{To: []byte("account")}
+
+	testCases := []struct {
+		name     string
+		policy   TxPolicy
+		tx       coreexecution.DecodedTx
+		rejected bool
+	}{
+		{"zero policy accepts calls", TxPolicy{}, call("aaaa"), false},
+		{"zero policy accepts creations", TxPolicy{}, creation, false},
+		{"denied creation", TxPolicy{DenyContractCreation: true}, creation, true},
+		{"allowed method", TxPolicy{AllowedMethods: [][]byte{[]byte("aaaa")}}, call("aaaa"), false},
+		{"method not allowed", TxPolicy{AllowedMethods: [][]byte{[]byte("aaaa")}}, call("bbbb"), true},
+		{"denied method", TxPolicy{DeniedMethods: [][]byte{[]byte("bbbb")}}, call("bbbb"), true},
+		{"method not denied", TxPolicy{DeniedMethods: [][]byte{[]byte("bbbb")}}, call("aaaa"), false},
+		{"transfer with allow list", TxPolicy{AllowedMethods: [][]byte{[]byte("aaaa")}}, transfer, false},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.policy.Check(tc.tx)
+			if tc.rejected {
+				assert.ErrorIs(t, err, ErrTxRejected)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestTxPolicy_IsZero(t *testing.T) {
+	assert.True(t, TxPolicy{}.IsZero())
+	assert.False(t, TxPolicy{AllowedMethods: [][]byte{[]byte("aaaa")}}.IsZero())
+	assert.False(t, TxPolicy{DeniedMethods: [][]byte{[]byte("bbbb")}}.IsZero())
+	assert.False(t, TxPolicy{DenyContractCreation: true}.IsZero())
+}
+
+func TestSequencer_SubmitBatchTxs_TxFilter(t *testing.T) {
+	metrics, _ := NopMetrics()
+	dummyDA := coreda.NewDummyDA(100_000_000, 0, 0, 10*time.Second)
+	db := ds.NewMapDatastore()
+	defer db.Close()
+	ctx, cancel := context.WithTimeout(context.Background(), 3*time.Second)
+	defer cancel()
+	Id := []byte("test1")
+	seq, err := NewSequencer(ctx, zerolog.Nop(), db, dummyDA, Id, 10*time.Second, metrics, false)
+	require.NoError(t, err)
+	seq.SetTxFilter(testTxDecoder{}, TxPolicy{
+		DeniedMethods:        [][]byte{[]byte("bbbb")},
+		DenyContractCreation: true,
+	})
+
+	_, err = seq.SubmitBatchTxs(ctx, coresequencer.SubmitBatchTxsRequest{
+		Id: Id,
+		Batch: &coresequencer.Batch{Transactions: [][]byte{
+			[]byte("aaaa01"), []byte("bbbb01"), []byte("c"), []byte("x"), []byte("aaaa02"),
+		}},
+	})
+	require.NoError(t, err)
+
+	res, err := seq.GetNextBatch(ctx, coresequencer.GetNextBatchRequest{Id: Id})
+	require.NoError(t, err)
+	// transactions which cannot be decoded are left to the execution layer
+	assert.Equal(t, [][]byte{[]byte("aaaa01"), []byte("x"), []byte("aaaa02")}, res.Batch.Transactions)
+
+	// batches with only rejected transactions are not queued
+	_, err = seq.SubmitBatchTxs(ctx, coresequencer.SubmitBatchTxsRequest{
+		Id:    Id,
+		Batch: &coresequencer.Batch{Transactions: [][]byte{[]byte("bbbb02")}},
+	})
+	require.NoError(t, err)
+	res, err = seq.GetNextBatch(ctx, coresequencer.GetNextBatchRequest{Id: Id})
+	require.NoError(t, err)
+	assert.Empty(t, res.Batch.Transactions)
+}
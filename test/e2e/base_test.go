@@ -8,6 +8,7 @@ import (
 	"flag"
 	"fmt"
 	"net/http"
+	"os"
 	"path/filepath"
 	"testing"
 	"time"
@@ -41,7 +42,7 @@ func TestBasic(t *testing.T) {
 
 	// start local da
 	localDABinary := filepath.Join(filepath.Dir(binaryPath), "local-da")
-	sut.ExecCmd(localDABinary)
+	sut.ExecLocalDA(localDABinary)
 	// Wait a moment for the local DA to initialize
 	time.Sleep(500 * time.Millisecond)
 
@@ -80,8 +81,14 @@ func TestBasic(t *testing.T) {
 	)
 	require.NoError(t, err, "failed to init fullnode", output)
 
-	// Copy genesis file from aggregator to full node
-	MustCopyFile(t, filepath.Join(node1Home, "config", "genesis.json"), filepath.Join(node2Home, "config", "genesis.json"))
+	// Fetch the genesis of the aggregator, replacing the one generated by init
+	require.NoError(t, os.Remove(filepath.Join(node2Home, "config", "genesis.json")))
+	output, err = sut.RunCmd(binaryPath,
+		"fetch-genesis",
+		"--home="+node2Home,
+		"--node-rpc=127.0.0.1:7331",
+	)
+	require.NoError(t, err, "failed to fetch genesis", output)
 
 	// Start the full node
 	node2RPC := "127.0.0.1:7332"
@@ -138,7 +145,7 @@ func TestNodeRestartPersistence(t *testing.T) {
 
 	// Start local DA if needed
 	localDABinary := filepath.Join(filepath.Dir(binaryPath), "local-da")
-	sut.ExecCmd(localDABinary)
+	sut.ExecLocalDA(localDABinary)
 	time.Sleep(500 * time.Millisecond)
 
 	// Init node
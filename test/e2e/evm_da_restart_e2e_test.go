@@ -164,7 +164,7 @@ func TestEvmDARestartWithPendingBlocksE2E(t *testing.T) {
 		localDABinary = filepath.Join(filepath.Dir(evmSingleBinaryPath), "local-da")
 	}
 	// Use an extremely small max blob size (100 bytes) to guarantee StatusTooBig with our large batch
-	sut.ExecCmd(localDABinary, "-max-blob-size", "100")
+	sut.ExecLocalDA(localDABinary, "-max-blob-size", "100")
 	t.Log("✅ DA layer restarted")
 
 	// Wait for DA to be ready
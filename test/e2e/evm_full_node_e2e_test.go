@@ -36,6 +36,7 @@ import (
 
 	"github.com/evstack/ev-node/pkg/rpc/client"
 	"github.com/evstack/ev-node/pkg/store"
+	"github.com/evstack/ev-node/test/rollkittest"
 )
 
 // Note: evmSingleBinaryPath is declared in evm_sequencer_e2e_test.go to avoid duplicate declaration
@@ -206,6 +207,15 @@ func verifyStateRootsMatch(t *testing.T, sequencerURL, fullNodeURL string, block
 	t.Logf("✅ Block %d state roots match: %s (txs: %d)", blockHeight, seqStateRoot.Hex(), seqTxCount)
 }
 
+// ethStateRoot returns the state root of the EVM node at ethURL after a block, for
+// rollkittest.Node.StateRoot.
+func ethStateRoot(t *testing.T, ethURL string) func(ctx context.Context, height uint64) ([]byte, error) {
+	return func(ctx context.Context, height uint64) ([]byte, error) {
+		_, stateRoot, _, _, err := checkBlockInfoAt(t, ethURL, &height)
+		return stateRoot.Bytes(), err
+	}
+}
+
 // setupSequencerWithFullNode sets up both sequencer and full node with P2P connections.
 // This helper function handles the complex setup required for full node tests.
 //
@@ -399,6 +409,12 @@ func TestEvmSequencerWithFullNodeE2E(t *testing.T) {
 		verifyStateRootsMatch(t, SequencerEthURL, FullNodeEthURL, blockHeight)
 	}
 
+	// Compare the headers and data hashes of the nodes as well, at all heights
+	rollkittest.AssertStateConvergence(t, []rollkittest.Node{
+		{Name: "sequencer", Client: client.NewClient(RollkitRPCAddress), StateRoot: ethStateRoot(t, SequencerEthURL)},
+		{Name: "full node", Client: client.NewClient("http://127.0.0.1:" + FullNodeRPCPort), StateRoot: ethStateRoot(t, FullNodeEthURL)},
+	}, endHeight, rollkittest.WithSamples(int(endHeight)))
+
 	// Special focus on the transaction blocks
 	t.Log("Re-verifying state roots for all transaction blocks...")
 	for i, txBlockNumber := range txBlockNumbers {
@@ -542,11 +542,11 @@ func setupCommonEVMTest(t *testing.T, sut *SystemUnderTest, needsFullNode bool,
 
 	if len(daPort) > 0 && daPort[0] != "" {
 		// Start DA with specified port
-		sut.ExecCmd(localDABinary, "-port", daPort[0])
+		sut.ExecLocalDA(localDABinary, "-port", daPort[0])
 		t.Logf("Started local DA on port %s", daPort[0])
 	} else {
 		// Start DA with default port
-		sut.ExecCmd(localDABinary)
+		sut.ExecLocalDA(localDABinary)
 		t.Log("Started local DA")
 	}
 	time.Sleep(50 * time.Millisecond)
@@ -667,7 +667,7 @@ func restartDAAndSequencer(t *testing.T, sut *SystemUnderTest, sequencerHome, jw
 	if evmSingleBinaryPath != "evm-single" {
 		localDABinary = filepath.Join(filepath.Dir(evmSingleBinaryPath), "local-da")
 	}
-	sut.ExecCmd(localDABinary)
+	sut.ExecLocalDA(localDABinary)
 	t.Log("Restarted local DA")
 	time.Sleep(25 * time.Millisecond)
 
@@ -707,7 +707,7 @@ func restartDAAndSequencerLazy(t *testing.T, sut *SystemUnderTest, sequencerHome
 	if evmSingleBinaryPath != "evm-single" {
 		localDABinary = filepath.Join(filepath.Dir(evmSingleBinaryPath), "local-da")
 	}
-	sut.ExecCmd(localDABinary)
+	sut.ExecLocalDA(localDABinary)
 	t.Log("Restarted local DA")
 	time.Sleep(25 * time.Millisecond)
 
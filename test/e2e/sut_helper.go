@@ -8,10 +8,13 @@ import (
 	"io"
 	"iter"
 	"maps"
+	"math"
+	"math/rand/v2"
 	"os"
 	"os/exec"
 	"path/filepath"
 	"slices"
+	"strconv"
 	"strings"
 	"sync"
 	"syscall"
@@ -24,7 +27,6 @@ import (
 
 	"github.com/evstack/ev-node/pkg/p2p/key"
 	"github.com/evstack/ev-node/pkg/rpc/client"
-	pb "github.com/evstack/ev-node/types/pb/evnode/v1"
 )
 
 // WorkDir defines the default working directory for spawned processes.
@@ -87,6 +89,21 @@ func (s *SystemUnderTest) ExecCmd(cmd string, args ...string) {
 	s.awaitProcessCleanup(c)
 }
 
+// ExecLocalDA starts the local-da binary like ExecCmd. When $LOCAL_DA_SIM_PROFILE sets a
+// latency and failure simulation profile, the simulation seed is taken from $LOCAL_DA_SIM_SEED
+// or drawn at random, and logged in the test output so that a failing run can be replayed.
+func (s *SystemUnderTest) ExecLocalDA(cmd string, args ...string) {
+	if profile := os.Getenv("LOCAL_DA_SIM_PROFILE"); profile != "" && profile != "none" {
+		seed := os.Getenv("LOCAL_DA_SIM_SEED")
+		if seed == "" {
+			seed = strconv.FormatUint(rand.Uint64N(math.MaxUint64)+1, 10)
+		}
+		s.logf("local-da simulation profile %q with seed %s, replay with LOCAL_DA_SIM_PROFILE=%s LOCAL_DA_SIM_SEED=%s", profile, seed, profile, seed)
+		args = append(args, "-sim-profile", profile, "-sim-seed", seed)
+	}
+	s.ExecCmd(cmd, args...)
+}
+
 // AwaitNodeUp waits until a node is operational by validating it produces blocks.
 func (s *SystemUnderTest) AwaitNodeUp(t *testing.T, rpcAddr string, timeout time.Duration) {
 	t.Helper()
@@ -106,22 +123,11 @@ func (s *SystemUnderTest) AwaitNBlocks(t *testing.T, n uint64, rpcAddr string, t
 	t.Helper()
 	ctx, done := context.WithTimeout(context.Background(), timeout)
 	defer done()
-	var c *client.Client
-	require.EventuallyWithT(t, func(t *assert.CollectT) {
-		c = client.NewClient(rpcAddr)
-		require.NotNil(t, c)
-	}, timeout, 50*time.Millisecond, "client is not setup")
-	var baseState *pb.State
-	require.EventuallyWithT(t, func(t *assert.CollectT) {
-		s, err := c.GetState(ctx)
-		require.NoError(t, err)
-		baseState = s
-	}, timeout, 50*time.Millisecond, "client is not setup")
-	require.EventuallyWithT(t, func(t *assert.CollectT) {
-		s, err := c.GetState(ctx)
-		require.NoError(t, err)
-		assert.GreaterOrEqual(t, s.LastBlockHeight, baseState.LastBlockHeight+n)
-	}, timeout, 50*time.Millisecond, "client is not setup")
+	c := client.NewClient(rpcAddr)
+	base, err := c.WaitForHeight(ctx, 0)
+	require.NoError(t, err, "client is not setup")
+	_, err = c.WaitForHeight(ctx, base+n)
+	require.NoError(t, err)
 }
 
 func (s *SystemUnderTest) awaitProcessCleanup(cmd *exec.Cmd) {
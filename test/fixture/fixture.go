@@ -0,0 +1,133 @@
+// Package fixture dumps and loads complete node fixtures, i.e. the datastore, configuration and
+// genesis of a node, so that regression tests can start from interesting states, such as a node in
+// the middle of a sync, with a DA submission backlog or after a rollback, instead of replaying
+// block production.
+package fixture
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+
+	ds "github.com/ipfs/go-datastore"
+	dsq "github.com/ipfs/go-datastore/query"
+
+	"github.com/evstack/ev-node/pkg/config"
+	"github.com/evstack/ev-node/pkg/genesis"
+	"github.com/evstack/ev-node/pkg/store"
+)
+
+// Entry is a key-value pair of the datastore of a node.
+type Entry struct {
+	Key   string `json:"key"`
+	Value []byte `json:"value"`
+}
+
+// Fixture is a snapshot of a node.
+type Fixture struct {
+	// Name identifies the scenario captured by the fixture, e.g. "post-rollback".
+	Name string `json:"name"`
+	// Description details the state of the node.
+	Description string `json:"description,omitempty"`
+	// Config is the configuration of the node.
+	Config config.Config `json:"config"`
+	// Genesis is the genesis of the chain.
+	Genesis genesis.Genesis `json:"genesis"`
+	// Entries are all the entries of the datastore of the node, sorted by key.
+	Entries []Entry `json:"entries"`
+}
+
+// Dump captures a fixture of a node from its configuration, genesis and datastore. The datastore
+// must not be written to meanwhile, e.g. the node must be stopped.
+func Dump(ctx context.Context, name string, cfg config.Config, gen genesis.Genesis, kv ds.Datastore) (*Fixture, error) {
+	results, err := kv.Query(ctx, dsq.Query{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to query datastore: %w", err)
+	}
+	defer results.Close()
+
+	fixture := &Fixture{Name: name, Config: cfg, Genesis: gen}
+	for result := range results.Next() {
+		if result.Error != nil {
+			return nil, fmt.Errorf("failed to read datastore entry: %w", result.Error)
+		}
+		fixture.Entries = append(fixture.Entries, Entry{Key: result.Key, Value: result.Value})
+	}
+	sort.Slice(fixture.Entries, func(i, j int) bool { return fixture.Entries[i].Key < fixture.Entries[j].Key })
+	return fixture, nil
+}
+
+// Load reads a fixture saved with Save.
+func Load(path string) (*Fixture, error) {
+	bz, err := os.ReadFile(filepath.Clean(path))
+	if err != nil {
+		return nil, fmt.Errorf("failed to read fixture: %w", err)
+	}
+	var fixture Fixture
+	if err := json.Unmarshal(bz, &fixture); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal fixture: %w", err)
+	}
+	return &fixture, nil
+}
+
+// Save writes the fixture to the given path as JSON.
+func (f *Fixture) Save(path string) error {
+	bz, err := json.MarshalIndent(f, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to marshal fixture: %w", err)
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0o750); err != nil {
+		return fmt.Errorf("failed to create fixture directory: %w", err)
+	}
+	if err := os.WriteFile(filepath.Clean(path), bz, 0o600); err != nil {
+		return fmt.Errorf("failed to write fixture: %w", err)
+	}
+	return nil
+}
+
+// Restore writes the entries of the fixture to the given datastore, which should be empty.
+func (f *Fixture) Restore(ctx context.Context, kv ds.Batching) error {
+	batch, err := kv.Batch(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to create batch: %w", err)
+	}
+	for _, entry := range f.Entries {
+		if err := batch.Put(ctx, ds.NewKey(entry.Key), entry.Value); err != nil {
+			return fmt.Errorf("failed to restore entry %s: %w", entry.Key, err)
+		}
+	}
+	if err := batch.Commit(ctx); err != nil {
+		return fmt.Errorf("failed to commit restored entries: %w", err)
+	}
+	return nil
+}
+
+// Install sets up the home directory of a node in rootDir from the fixture: it writes the
+// configuration and the genesis, and restores the datastore named dbName, as opened by the node
+// with store.NewKVStore. It returns the configuration of the node, rooted in rootDir.
+func (f *Fixture) Install(ctx context.Context, rootDir, dbName string) (config.Config, error) {
+	cfg := f.Config
+	cfg.RootDir = rootDir
+	if err := cfg.SaveAsYaml(); err != nil {
+		return config.Config{}, fmt.Errorf("failed to save config: %w", err)
+	}
+	if err := f.Genesis.Save(genesis.GenesisPath(rootDir)); err != nil {
+		return config.Config{}, err
+	}
+
+	kv, err := store.NewKVStore(cfg.DBBackend, rootDir, cfg.DBPath, dbName)
+	if err != nil {
+		return config.Config{}, fmt.Errorf("failed to open datastore: %w", err)
+	}
+	if err := f.Restore(ctx, kv); err != nil {
+		_ = kv.Close()
+		return config.Config{}, err
+	}
+	if err := kv.Close(); err != nil {
+		return config.Config{}, fmt.Errorf("failed to close datastore: %w", err)
+	}
+	return cfg, nil
+}
@@ -0,0 +1,103 @@
+package fixture
+
+import (
+	"context"
+	"encoding/binary"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/evstack/ev-node/pkg/config"
+	"github.com/evstack/ev-node/pkg/genesis"
+	"github.com/evstack/ev-node/pkg/store"
+	"github.com/evstack/ev-node/types"
+)
+
+func TestFixtureRoundTrip(t *testing.T) {
+	ctx := context.Background()
+	kv, err := store.NewDefaultInMemoryKVStore()
+	require.NoError(t, err)
+	s := store.New(kv)
+
+	// 5 blocks of which 2 are DA included, rolled back to height 4
+	for height := uint64(1); height <= 5; height++ {
+		header, data := types.GetRandomBlock(height, 2, "test-chain")
+		require.NoError(t, s.SaveBlockData(ctx, header, data, &header.Signature))
+		require.NoError(t, s.SetHeight(ctx, height))
+		require.NoError(t, s.UpdateState(ctx, types.State{ChainID: "test-chain", InitialHeight: 1, LastBlockHeight: height}))
+	}
+	daIncludedHeight := make([]byte, 8)
+	binary.LittleEndian.PutUint64(daIncludedHeight, 2)
+	require.NoError(t, s.SetMetadata(ctx, store.DAIncludedHeightKey, daIncludedHeight))
+	require.NoError(t, s.Rollback(ctx, 4))
+	height, err := s.Height(ctx)
+	require.NoError(t, err)
+	require.Equal(t, uint64(4), height)
+
+	cfg := config.DefaultConfig
+	cfg.Node.Aggregator = true
+	cfg.RPC.Address = "127.0.0.1:7332"
+	gen := genesis.NewGenesis("test-chain", 1, time.Now().UTC().Truncate(time.Second), []byte("proposer"))
+
+	fixture, err := Dump(ctx, "post-rollback", cfg, gen, kv)
+	require.NoError(t, err)
+	require.NotEmpty(t, fixture.Entries)
+	for i := 1; i < len(fixture.Entries); i++ {
+		assert.Less(t, fixture.Entries[i-1].Key, fixture.Entries[i].Key)
+	}
+
+	path := filepath.Join(t.TempDir(), "fixtures", "post-rollback.json")
+	require.NoError(t, fixture.Save(path))
+	loaded, err := Load(path)
+	require.NoError(t, err)
+	assert.Equal(t, fixture, loaded)
+
+	restoredKV, err := store.NewDefaultInMemoryKVStore()
+	require.NoError(t, err)
+	require.NoError(t, loaded.Restore(ctx, restoredKV))
+	restored := store.New(restoredKV)
+	assertSameStore(t, s, restored)
+
+	rootDir := t.TempDir()
+	installedCfg, err := loaded.Install(ctx, rootDir, "testapp")
+	require.NoError(t, err)
+	assert.Equal(t, rootDir, installedCfg.RootDir)
+	assert.Equal(t, cfg.RPC.Address, installedCfg.RPC.Address)
+
+	installedGen, err := genesis.LoadGenesis(genesis.GenesisPath(rootDir))
+	require.NoError(t, err)
+	assert.Equal(t, gen.ChainID, installedGen.ChainID)
+
+	installedKV, err := store.NewDefaultKVStore(rootDir, installedCfg.DBPath, "testapp")
+	require.NoError(t, err)
+	defer installedKV.Close()
+	assertSameStore(t, s, store.New(installedKV))
+}
+
+func assertSameStore(t *testing.T, expected, actual store.Store) {
+	t.Helper()
+	ctx := context.Background()
+	height, err := expected.Height(ctx)
+	require.NoError(t, err)
+	actualHeight, err := actual.Height(ctx)
+	require.NoError(t, err)
+	require.Equal(t, height, actualHeight)
+
+	for h := uint64(1); h <= height; h++ {
+		header, data, err := expected.GetBlockData(ctx, h)
+		require.NoError(t, err)
+		actualHeader, actualData, err := actual.GetBlockData(ctx, h)
+		require.NoError(t, err)
+		assert.Equal(t, header.Hash(), actualHeader.Hash())
+		assert.Equal(t, data.Hash(), actualData.Hash())
+	}
+
+	daIncludedHeight, err := expected.GetMetadata(ctx, store.DAIncludedHeightKey)
+	require.NoError(t, err)
+	actualDAIncludedHeight, err := actual.GetMetadata(ctx, store.DAIncludedHeightKey)
+	require.NoError(t, err)
+	assert.Equal(t, daIncludedHeight, actualDAIncludedHeight)
+}
@@ -7,10 +7,335 @@ package mocks
 import (
 	"context"
 
+	"github.com/evstack/ev-node/pkg/store"
 	"github.com/evstack/ev-node/types"
 	mock "github.com/stretchr/testify/mock"
 )
 
+// NewMockBatch creates a new instance of MockBatch. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockBatch(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockBatch {
+	mock := &MockBatch{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockBatch is an autogenerated mock type for the Batch type
+type MockBatch struct {
+	mock.Mock
+}
+
+type MockBatch_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockBatch) EXPECT() *MockBatch_Expecter {
+	return &MockBatch_Expecter{mock: &_m.Mock}
+}
+
+// Commit provides a mock function for the type MockBatch
+func (_mock *MockBatch) Commit(ctx context.Context) error {
+	ret := _mock.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Commit")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context) error); ok {
+		r0 = returnFunc(ctx)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockBatch_Commit_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Commit'
+type MockBatch_Commit_Call struct {
+	*mock.Call
+}
+
+// Commit is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *MockBatch_Expecter) Commit(ctx interface{}) *MockBatch_Commit_Call {
+	return &MockBatch_Commit_Call{Call: _e.mock.On("Commit", ctx)}
+}
+
+func (_c *MockBatch_Commit_Call) Run(run func(ctx context.Context)) *MockBatch_Commit_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockBatch_Commit_Call) Return(err error) *MockBatch_Commit_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockBatch_Commit_Call) RunAndReturn(run func(ctx context.Context) error) *MockBatch_Commit_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SaveBlockData provides a mock function for the type MockBatch
+func (_mock *MockBatch) SaveBlockData(ctx context.Context, header *types.SignedHeader, data *types.Data, signature *types.Signature) error {
+	ret := _mock.Called(ctx, header, data, signature)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SaveBlockData")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *types.SignedHeader, *types.Data, *types.Signature) error); ok {
+		r0 = returnFunc(ctx, header, data, signature)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockBatch_SaveBlockData_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SaveBlockData'
+type MockBatch_SaveBlockData_Call struct {
+	*mock.Call
+}
+
+// SaveBlockData is a helper method to define mock.On call
+//   - ctx context.Context
+//   - header *types.SignedHeader
+//   - data *types.Data
+//   - signature *types.Signature
+func (_e *MockBatch_Expecter) SaveBlockData(ctx interface{}, header interface{}, data interface{}, signature interface{}) *MockBatch_SaveBlockData_Call {
+	return &MockBatch_SaveBlockData_Call{Call: _e.mock.On("SaveBlockData", ctx, header, data, signature)}
+}
+
+func (_c *MockBatch_SaveBlockData_Call) Run(run func(ctx context.Context, header *types.SignedHeader, data *types.Data, signature *types.Signature)) *MockBatch_SaveBlockData_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *types.SignedHeader
+		if args[1] != nil {
+			arg1 = args[1].(*types.SignedHeader)
+		}
+		var arg2 *types.Data
+		if args[2] != nil {
+			arg2 = args[2].(*types.Data)
+		}
+		var arg3 *types.Signature
+		if args[3] != nil {
+			arg3 = args[3].(*types.Signature)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+		)
+	})
+	return _c
+}
+
+func (_c *MockBatch_SaveBlockData_Call) Return(err error) *MockBatch_SaveBlockData_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockBatch_SaveBlockData_Call) RunAndReturn(run func(ctx context.Context, header *types.SignedHeader, data *types.Data, signature *types.Signature) error) *MockBatch_SaveBlockData_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SetHeight provides a mock function for the type MockBatch
+func (_mock *MockBatch) SetHeight(ctx context.Context, height uint64) error {
+	ret := _mock.Called(ctx, height)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SetHeight")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uint64) error); ok {
+		r0 = returnFunc(ctx, height)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockBatch_SetHeight_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetHeight'
+type MockBatch_SetHeight_Call struct {
+	*mock.Call
+}
+
+// SetHeight is a helper method to define mock.On call
+//   - ctx context.Context
+//   - height uint64
+func (_e *MockBatch_Expecter) SetHeight(ctx interface{}, height interface{}) *MockBatch_SetHeight_Call {
+	return &MockBatch_SetHeight_Call{Call: _e.mock.On("SetHeight", ctx, height)}
+}
+
+func (_c *MockBatch_SetHeight_Call) Run(run func(ctx context.Context, height uint64)) *MockBatch_SetHeight_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 uint64
+		if args[1] != nil {
+			arg1 = args[1].(uint64)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockBatch_SetHeight_Call) Return(err error) *MockBatch_SetHeight_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockBatch_SetHeight_Call) RunAndReturn(run func(ctx context.Context, height uint64) error) *MockBatch_SetHeight_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SetMetadata provides a mock function for the type MockBatch
+func (_mock *MockBatch) SetMetadata(ctx context.Context, key string, value []byte) error {
+	ret := _mock.Called(ctx, key, value)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SetMetadata")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, []byte) error); ok {
+		r0 = returnFunc(ctx, key, value)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockBatch_SetMetadata_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetMetadata'
+type MockBatch_SetMetadata_Call struct {
+	*mock.Call
+}
+
+// SetMetadata is a helper method to define mock.On call
+//   - ctx context.Context
+//   - key string
+//   - value []byte
+func (_e *MockBatch_Expecter) SetMetadata(ctx interface{}, key interface{}, value interface{}) *MockBatch_SetMetadata_Call {
+	return &MockBatch_SetMetadata_Call{Call: _e.mock.On("SetMetadata", ctx, key, value)}
+}
+
+func (_c *MockBatch_SetMetadata_Call) Run(run func(ctx context.Context, key string, value []byte)) *MockBatch_SetMetadata_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 []byte
+		if args[2] != nil {
+			arg2 = args[2].([]byte)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockBatch_SetMetadata_Call) Return(err error) *MockBatch_SetMetadata_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockBatch_SetMetadata_Call) RunAndReturn(run func(ctx context.Context, key string, value []byte) error) *MockBatch_SetMetadata_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateState provides a mock function for the type MockBatch
+func (_mock *MockBatch) UpdateState(ctx context.Context, state types.State) error {
+	ret := _mock.Called(ctx, state)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateState")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, types.State) error); ok {
+		r0 = returnFunc(ctx, state)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockBatch_UpdateState_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateState'
+type MockBatch_UpdateState_Call struct {
+	*mock.Call
+}
+
+// UpdateState is a helper method to define mock.On call
+//   - ctx context.Context
+//   - state types.State
+func (_e *MockBatch_Expecter) UpdateState(ctx interface{}, state interface{}) *MockBatch_UpdateState_Call {
+	return &MockBatch_UpdateState_Call{Call: _e.mock.On("UpdateState", ctx, state)}
+}
+
+func (_c *MockBatch_UpdateState_Call) Run(run func(ctx context.Context, state types.State)) *MockBatch_UpdateState_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 types.State
+		if args[1] != nil {
+			arg1 = args[1].(types.State)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockBatch_UpdateState_Call) Return(err error) *MockBatch_UpdateState_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockBatch_UpdateState_Call) RunAndReturn(run func(ctx context.Context, state types.State) error) *MockBatch_UpdateState_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // NewMockStore creates a new instance of MockStore. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewMockStore(t interface {
@@ -38,6 +363,68 @@ func (_m *MockStore) EXPECT() *MockStore_Expecter {
 	return &MockStore_Expecter{mock: &_m.Mock}
 }
 
+// Batch provides a mock function for the type MockStore
+func (_mock *MockStore) Batch(ctx context.Context) (store.Batch, error) {
+	ret := _mock.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Batch")
+	}
+
+	var r0 store.Batch
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context) (store.Batch, error)); ok {
+		return returnFunc(ctx)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context) store.Batch); ok {
+		r0 = returnFunc(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(store.Batch)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = returnFunc(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockStore_Batch_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Batch'
+type MockStore_Batch_Call struct {
+	*mock.Call
+}
+
+// Batch is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *MockStore_Expecter) Batch(ctx interface{}) *MockStore_Batch_Call {
+	return &MockStore_Batch_Call{Call: _e.mock.On("Batch", ctx)}
+}
+
+func (_c *MockStore_Batch_Call) Run(run func(ctx context.Context)) *MockStore_Batch_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockStore_Batch_Call) Return(batch store.Batch, err error) *MockStore_Batch_Call {
+	_c.Call.Return(batch, err)
+	return _c
+}
+
+func (_c *MockStore_Batch_Call) RunAndReturn(run func(ctx context.Context) (store.Batch, error)) *MockStore_Batch_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Close provides a mock function for the type MockStore
 func (_mock *MockStore) Close() error {
 	ret := _mock.Called()
@@ -0,0 +1,260 @@
+// Package rollkittest provides assertions for integration tests running several nodes of a chain,
+// e.g. a sequencer and full nodes.
+package rollkittest
+
+import (
+	"context"
+	"encoding/hex"
+	"fmt"
+	"sort"
+	"strings"
+	"testing"
+
+	"github.com/evstack/ev-node/pkg/rpc/client"
+	"github.com/evstack/ev-node/types"
+	pb "github.com/evstack/ev-node/types/pb/evnode/v1"
+)
+
+// defaultSamples is the number of heights sampled by default.
+const defaultSamples = 16
+
+// HeaderClient gets the headers of a node. It is implemented by the RPC client of a node.
+type HeaderClient interface {
+	GetHeader(ctx context.Context, height uint64) (*pb.GetHeaderResponse, error)
+}
+
+var _ HeaderClient = (*client.Client)(nil)
+
+// Node is a node whose state is compared.
+type Node struct {
+	// Name identifies the node in the report
+	Name   string
+	Client HeaderClient
+	// StateRoot, if set, returns the state root of the execution client of the node after the
+	// block at height, e.g. read from the Ethereum JSON-RPC of an EVM node. Otherwise only the
+	// app hashes of the headers, which commit to the state root before their block, are compared.
+	StateRoot func(ctx context.Context, height uint64) ([]byte, error)
+}
+
+// The fields compared at every sampled height.
+const (
+	FieldHeaderHash = "header_hash"
+	FieldDataHash   = "data_hash"
+	FieldAppHash    = "app_hash"
+	FieldStateRoot  = "state_root"
+)
+
+// Mismatch is a field which differs between nodes at a height.
+type Mismatch struct {
+	Height uint64
+	Field  string
+	// Values are the hex encoded values of the field, by node name
+	Values map[string]string
+}
+
+// NodeError is a failure to read the state of a node at a height.
+type NodeError struct {
+	Node   string
+	Height uint64
+	Err    error
+}
+
+// Report is the result of a comparison of the state of nodes.
+type Report struct {
+	// Heights are the heights compared, in increasing order
+	Heights []uint64
+	// Mismatches are the differences between the nodes, by increasing height
+	Mismatches []Mismatch
+	// FirstDivergence is the lowest height at which the nodes differ, found by bisecting the
+	// heights between the last sample which matches and the first which does not. It is zero if
+	// the nodes do not differ.
+	FirstDivergence uint64
+	// Errors are the failures to read the state of the nodes
+	Errors []NodeError
+}
+
+// Converged reports whether the nodes have the same state at all the heights compared.
+func (r *Report) Converged() bool {
+	return len(r.Mismatches) == 0 && len(r.Errors) == 0
+}
+
+// String describes the mismatches and errors of the report, one per line.
+func (r *Report) String() string {
+	if r.Converged() {
+		return fmt.Sprintf("nodes converged at heights %v", r.Heights)
+	}
+	var b strings.Builder
+	if r.FirstDivergence > 0 {
+		fmt.Fprintf(&b, "nodes diverged at height %d\n", r.FirstDivergence)
+	}
+	for _, m := range r.Mismatches {
+		names := make([]string, 0, len(m.Values))
+		for name := range m.Values {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+		fmt.Fprintf(&b, "height %d: %s differs:", m.Height, m.Field)
+		for _, name := range names {
+			fmt.Fprintf(&b, " %s=%s", name, m.Values[name])
+		}
+		b.WriteString("\n")
+	}
+	for _, e := range r.Errors {
+		fmt.Fprintf(&b, "height %d: %s: %v\n", e.Height, e.Node, e.Err)
+	}
+	return b.String()
+}
+
+// Option configures a comparison.
+type Option func(*options)
+
+type options struct {
+	samples int
+	heights []uint64
+}
+
+// WithSamples compares n heights, spread evenly from the first block to the height compared, 16
+// by default. The samples are the same for a given height, so that reruns compare the same blocks.
+func WithSamples(n int) Option {
+	return func(o *options) {
+		o.samples = n
+	}
+}
+
+// WithHeights also compares the heights, e.g. those of the blocks including the transactions of a
+// test.
+func WithHeights(heights ...uint64) Option {
+	return func(o *options) {
+		o.heights = append(o.heights, heights...)
+	}
+}
+
+// CheckStateConvergence compares the headers, data hashes and state roots of the nodes at heights
+// sampled up to height, which all the nodes must have applied.
+func CheckStateConvergence(ctx context.Context, nodes []Node, height uint64, opts ...Option) *Report {
+	o := options{samples: defaultSamples}
+	for _, opt := range opts {
+		opt(&o)
+	}
+
+	report := &Report{Heights: sampleHeights(height, o.samples, o.heights)}
+	lastMatch := uint64(0)
+	for _, h := range report.Heights {
+		mismatches, errs := compareAt(ctx, nodes, h)
+		report.Mismatches = append(report.Mismatches, mismatches...)
+		report.Errors = append(report.Errors, errs...)
+		if len(errs) > 0 {
+			continue
+		}
+		if len(mismatches) == 0 {
+			lastMatch = h
+		} else if report.FirstDivergence == 0 {
+			report.FirstDivergence = bisect(ctx, nodes, lastMatch, h)
+		}
+	}
+	return report
+}
+
+// AssertStateConvergence compares the state of the nodes like CheckStateConvergence, and marks the
+// test as failed with the report if the nodes differ.
+func AssertStateConvergence(t testing.TB, nodes []Node, height uint64, opts ...Option) *Report {
+	t.Helper()
+	report := CheckStateConvergence(context.Background(), nodes, height, opts...)
+	if !report.Converged() {
+		t.Errorf("nodes did not converge up to height %d:\n%s", height, report)
+	}
+	return report
+}
+
+// sampleHeights returns n heights spread evenly from 1 to height, and the extra heights up to
+// height, in increasing order.
+func sampleHeights(height uint64, n int, extra []uint64) []uint64 {
+	seen := make(map[uint64]bool)
+	var heights []uint64
+	add := func(h uint64) {
+		if h >= 1 && h <= height && !seen[h] {
+			seen[h] = true
+			heights = append(heights, h)
+		}
+	}
+	if n == 1 {
+		add(height)
+	}
+	for i := 0; n > 1 && i < n; i++ {
+		add(1 + (height-1)*uint64(i)/uint64(n-1))
+	}
+	for _, h := range extra {
+		add(h)
+	}
+	sort.Slice(heights, func(i, j int) bool { return heights[i] < heights[j] })
+	return heights
+}
+
+// bisect returns the lowest height in (match, diverged] at which the nodes differ, given that
+// they do not differ at match and differ at diverged.
+func bisect(ctx context.Context, nodes []Node, match, diverged uint64) uint64 {
+	for diverged-match > 1 {
+		mid := match + (diverged-match)/2
+		mismatches, errs := compareAt(ctx, nodes, mid)
+		switch {
+		case len(errs) > 0:
+			// the exact height cannot be found
+			return diverged
+		case len(mismatches) > 0:
+			diverged = mid
+		default:
+			match = mid
+		}
+	}
+	return diverged
+}
+
+// compareAt compares the state of the nodes at height.
+func compareAt(ctx context.Context, nodes []Node, height uint64) ([]Mismatch, []NodeError) {
+	values := map[string]map[string]string{}
+	set := func(field, node string, value []byte) {
+		if values[field] == nil {
+			values[field] = make(map[string]string)
+		}
+		values[field][node] = hex.EncodeToString(value)
+	}
+
+	var errs []NodeError
+	for _, node := range nodes {
+		resp, err := node.Client.GetHeader(ctx, height)
+		if err != nil {
+			errs = append(errs, NodeError{Node: node.Name, Height: height, Err: fmt.Errorf("failed to get header: %w", err)})
+			continue
+		}
+		var header types.Header
+		if err := header.FromProto(resp.Header.GetHeader()); err != nil {
+			errs = append(errs, NodeError{Node: node.Name, Height: height, Err: fmt.Errorf("invalid header: %w", err)})
+			continue
+		}
+		set(FieldHeaderHash, node.Name, header.Hash())
+		set(FieldDataHash, node.Name, header.DataHash)
+		set(FieldAppHash, node.Name, header.AppHash)
+
+		if node.StateRoot != nil {
+			stateRoot, err := node.StateRoot(ctx, height)
+			if err != nil {
+				errs = append(errs, NodeError{Node: node.Name, Height: height, Err: fmt.Errorf("failed to get state root: %w", err)})
+				continue
+			}
+			set(FieldStateRoot, node.Name, stateRoot)
+		}
+	}
+
+	var mismatches []Mismatch
+	for _, field := range []string{FieldHeaderHash, FieldDataHash, FieldAppHash, FieldStateRoot} {
+		byNode := values[field]
+		distinct := make(map[string]bool)
+		for _, value := range byNode {
+			distinct[value] = true
+		}
+		if len(distinct) > 1 {
+			mismatches = append(mismatches, Mismatch{Height: height, Field: field, Values: byNode})
+		}
+	}
+	return mismatches, errs
+}
@@ -0,0 +1,83 @@
+package rollkittest
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	pb "github.com/evstack/ev-node/types/pb/evnode/v1"
+)
+
+// chain is a node whose headers have a different app hash from divergeAt on, and which fails
+// above height.
+type chain struct {
+	height    uint64
+	divergeAt uint64
+}
+
+func (c *chain) GetHeader(_ context.Context, height uint64) (*pb.GetHeaderResponse, error) {
+	if height > c.height {
+		return nil, errors.New("header not found")
+	}
+	appHash := []byte("a")
+	if c.divergeAt > 0 && height >= c.divergeAt {
+		appHash = []byte("b")
+	}
+	return &pb.GetHeaderResponse{Header: &pb.SignedHeader{Header: &pb.Header{
+		Version:  &pb.Version{},
+		Height:   height,
+		AppHash:  appHash,
+		DataHash: []byte("data"),
+	}}}, nil
+}
+
+func TestSampleHeights(t *testing.T) {
+	assert.Equal(t, []uint64{1, 4, 7, 10}, sampleHeights(10, 4, nil))
+	assert.Equal(t, []uint64{1, 4, 5, 7, 10}, sampleHeights(10, 4, []uint64{5, 7, 11}))
+	assert.Equal(t, []uint64{1, 2, 3}, sampleHeights(3, 16, nil))
+	assert.Equal(t, []uint64{10}, sampleHeights(10, 1, nil))
+}
+
+func TestCheckStateConvergence(t *testing.T) {
+	ctx := context.Background()
+	nodes := []Node{
+		{Name: "sequencer", Client: &chain{height: 100}},
+		{Name: "full", Client: &chain{height: 100}},
+	}
+	report := CheckStateConvergence(ctx, nodes, 100)
+	require.True(t, report.Converged(), report.String())
+	require.Len(t, report.Heights, defaultSamples)
+
+	nodes[1].Client = &chain{height: 100, divergeAt: 42}
+	report = CheckStateConvergence(ctx, nodes, 100)
+	require.False(t, report.Converged())
+	assert.Equal(t, uint64(42), report.FirstDivergence)
+	require.NotEmpty(t, report.Mismatches)
+	first := report.Mismatches[0]
+	assert.Equal(t, FieldHeaderHash, first.Field)
+	assert.Equal(t, FieldAppHash, report.Mismatches[1].Field)
+	assert.Equal(t, map[string]string{"sequencer": "61", "full": "62"}, report.Mismatches[1].Values)
+	assert.Contains(t, report.String(), "nodes diverged at height 42")
+}
+
+func TestCheckStateConvergence_StateRootAndErrors(t *testing.T) {
+	ctx := context.Background()
+	stateRoot := func(root string) func(context.Context, uint64) ([]byte, error) {
+		return func(context.Context, uint64) ([]byte, error) { return []byte(root), nil }
+	}
+	nodes := []Node{
+		{Name: "sequencer", Client: &chain{height: 10}, StateRoot: stateRoot("x")},
+		{Name: "full", Client: &chain{height: 10}, StateRoot: stateRoot("y")},
+		{Name: "lagging", Client: &chain{height: 5}},
+	}
+	report := CheckStateConvergence(ctx, nodes, 10, WithSamples(2))
+	assert.Equal(t, []uint64{1, 10}, report.Heights)
+	require.Len(t, report.Mismatches, 2)
+	assert.Equal(t, FieldStateRoot, report.Mismatches[0].Field)
+	require.Len(t, report.Errors, 1)
+	assert.Equal(t, "lagging", report.Errors[0].Node)
+	assert.Equal(t, uint64(10), report.Errors[0].Height)
+}
@@ -0,0 +1,120 @@
+package types
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/binary"
+	"errors"
+	"fmt"
+)
+
+// blobPointerMagic prefixes the transactions pointing to a payload available outside of the DA
+// layer of the chain. Transactions of the execution clients are not expected to start with it.
+var blobPointerMagic = []byte{0xfe, 'e', 'v', 'p'}
+
+// blobPointerVersion is the version of the blob pointer layout.
+const blobPointerVersion = 1
+
+// MaxBlobPayloadSize is the maximum size of the payload of a blob pointer.
+const MaxBlobPayloadSize = 128 << 20
+
+// ErrNotBlobPointer is returned when parsing a transaction which is not a blob pointer.
+var ErrNotBlobPointer = errors.New("not a blob pointer")
+
+// BlobPointer is a transaction of a block committing to a payload available outside of the DA
+// layer of the chain, e.g. a large batch of transactions stored on an alternative DA layer or on
+// IPFS. Only the pointer is part of the block and of its data hash: the payload is resolved and
+// verified against the commitment before the block is executed, and its transactions are executed
+// in place of the pointer.
+type BlobPointer struct {
+	// Commitment is the SHA-256 hash of the payload.
+	Commitment [sha256.Size]byte
+	// Size is the size of the payload in bytes.
+	Size uint64
+	// URI locates the payload, e.g. https://... or ipfs://<cid>.
+	URI string
+}
+
+// NewBlobPointer returns the pointer committing to payload, available at uri.
+func NewBlobPointer(uri string, payload []byte) BlobPointer {
+	return BlobPointer{Commitment: sha256.Sum256(payload), Size: uint64(len(payload)), URI: uri}
+}
+
+// Tx encodes the pointer as a transaction. The layout is the magic prefix, the version, the
+// commitment, the size of the payload as a big-endian uint64 and the URI.
+func (p BlobPointer) Tx() Tx {
+	bz := make([]byte, 0, len(blobPointerMagic)+1+sha256.Size+8+len(p.URI))
+	bz = append(bz, blobPointerMagic...)
+	bz = append(bz, blobPointerVersion)
+	bz = append(bz, p.Commitment[:]...)
+	bz = binary.BigEndian.AppendUint64(bz, p.Size)
+	return append(bz, p.URI...)
+}
+
+// IsBlobPointer returns whether the transaction starts with the blob pointer prefix. Only
+// sequencers emit blob pointers: such transactions of the mempool must be dropped.
+func IsBlobPointer(tx Tx) bool {
+	return bytes.HasPrefix(tx, blobPointerMagic)
+}
+
+// ParseBlobPointer parses a transaction encoded by BlobPointer.Tx. It returns ErrNotBlobPointer if
+// the transaction is not a blob pointer.
+func ParseBlobPointer(tx Tx) (BlobPointer, error) {
+	if !IsBlobPointer(tx) {
+		return BlobPointer{}, ErrNotBlobPointer
+	}
+	bz := tx[len(blobPointerMagic):]
+	if len(bz) < 1+sha256.Size+8 {
+		return BlobPointer{}, errors.New("truncated blob pointer")
+	}
+	if bz[0] != blobPointerVersion {
+		return BlobPointer{}, fmt.Errorf("unsupported blob pointer version %d", bz[0])
+	}
+	var p BlobPointer
+	copy(p.Commitment[:], bz[1:])
+	p.Size = binary.BigEndian.Uint64(bz[1+sha256.Size:])
+	p.URI = string(bz[1+sha256.Size+8:])
+	if p.URI == "" {
+		return BlobPointer{}, errors.New("blob pointer without URI")
+	}
+	if p.Size > MaxBlobPayloadSize {
+		return BlobPointer{}, fmt.Errorf("blob pointer payload of %d bytes exceeds the maximum of %d bytes", p.Size, MaxBlobPayloadSize)
+	}
+	return p, nil
+}
+
+// Verify checks that payload matches the size and commitment of the pointer.
+func (p BlobPointer) Verify(payload []byte) error {
+	if uint64(len(payload)) != p.Size {
+		return fmt.Errorf("payload of %s has %d bytes, expected %d", p.URI, len(payload), p.Size)
+	}
+	if sha256.Sum256(payload) != p.Commitment {
+		return fmt.Errorf("payload of %s does not match its commitment %x", p.URI, p.Commitment)
+	}
+	return nil
+}
+
+// MarshalBlobPayload encodes transactions as the payload of a blob pointer: each transaction
+// prefixed with its uvarint length.
+func MarshalBlobPayload(txs Txs) []byte {
+	var bz []byte
+	for _, tx := range txs {
+		bz = binary.AppendUvarint(bz, uint64(len(tx)))
+		bz = append(bz, tx...)
+	}
+	return bz
+}
+
+// UnmarshalBlobPayload decodes the transactions of a payload encoded by MarshalBlobPayload.
+func UnmarshalBlobPayload(bz []byte) (Txs, error) {
+	var txs Txs
+	for len(bz) > 0 {
+		size, n := binary.Uvarint(bz)
+		if n <= 0 || size > uint64(len(bz)-n) {
+			return nil, errors.New("invalid transaction length in blob payload")
+		}
+		txs = append(txs, bz[n:n+int(size)])
+		bz = bz[n+int(size):]
+	}
+	return txs, nil
+}
@@ -0,0 +1,49 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBlobPointer(t *testing.T) {
+	txs := Txs{Tx("tx1"), Tx{}, Tx("tx3")}
+	payload := MarshalBlobPayload(txs)
+	decoded, err := UnmarshalBlobPayload(payload)
+	require.NoError(t, err)
+	assert.Equal(t, txs, decoded)
+	_, err = UnmarshalBlobPayload(payload[:len(payload)-1])
+	assert.Error(t, err)
+
+	pointer := NewBlobPointer("ipfs://bafybeigdyrzt", payload)
+	parsed, err := ParseBlobPointer(pointer.Tx())
+	require.NoError(t, err)
+	assert.Equal(t, pointer, parsed)
+	require.NoError(t, parsed.Verify(payload))
+	assert.Error(t, parsed.Verify(payload[:len(payload)-1]))
+	tampered := append([]byte{}, payload...)
+	tampered[0] ^= 0xff
+	assert.ErrorContains(t, parsed.Verify(tampered), "does not match its commitment")
+
+	// other transactions are not blob pointers
+	_, err = ParseBlobPointer(Tx("tx1"))
+	assert.ErrorIs(t, err, ErrNotBlobPointer)
+
+	assert.True(t, IsBlobPointer(pointer.Tx()))
+	assert.False(t, IsBlobPointer(Tx("tx1")))
+
+	bz := pointer.Tx()
+	oversized := pointer
+	oversized.Size = MaxBlobPayloadSize + 1
+	for name, corrupt := range map[string]Tx{
+		"oversized":     oversized.Tx(),
+		"truncated":     bz[:len(blobPointerMagic)+10],
+		"no uri":        bz[:len(bz)-len(pointer.URI)],
+		"wrong version": append(append(Tx{}, blobPointerMagic...), append([]byte{2}, bz[len(blobPointerMagic)+1:]...)...),
+	} {
+		_, err := ParseBlobPointer(corrupt)
+		assert.Error(t, err, name)
+		assert.NotErrorIs(t, err, ErrNotBlobPointer, name)
+	}
+}
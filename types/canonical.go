@@ -0,0 +1,255 @@
+package types
+
+import (
+	"bytes"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+
+	"github.com/libp2p/go-libp2p/core/crypto"
+	"google.golang.org/protobuf/encoding/protowire"
+)
+
+// Canonical encodings of headers.
+//
+// SignBytes is the byte encoding of a header that is hashed and signed by the proposer. It is the
+// protobuf wire encoding of the evnode.v1.Header message with the following rules, which make it
+// reproducible byte-for-byte by any protobuf implementation or by hand:
+//   - fields are written in ascending field number order
+//   - scalar and bytes fields with their default value (0, empty) are omitted
+//   - the version field (1) is always written, even if both versions are 0
+//   - varints use the minimal encoding, no unknown fields are written
+//
+// The canonical JSON representation is an object with lexicographically sorted keys and no
+// insignificant whitespace, in which uint64 values are decimal strings, byte strings are lowercase
+// hex without prefix and strings use the minimal JSON escaping. See docs/learn/specs/header-encoding.md.
+
+// Header proto field numbers, see proto/evnode/v1/evnode.proto.
+const (
+	headerFieldVersion         protowire.Number = 1
+	headerFieldHeight          protowire.Number = 2
+	headerFieldTime            protowire.Number = 3
+	headerFieldLastHeaderHash  protowire.Number = 4
+	headerFieldLastCommitHash  protowire.Number = 5
+	headerFieldDataHash        protowire.Number = 6
+	headerFieldConsensusHash   protowire.Number = 7
+	headerFieldAppHash         protowire.Number = 8
+	headerFieldLastResultsHash protowire.Number = 9
+	headerFieldProposerAddress protowire.Number = 10
+	headerFieldValidatorHash   protowire.Number = 11
+	headerFieldChainID         protowire.Number = 12
+
+	versionFieldBlock protowire.Number = 1
+	versionFieldApp   protowire.Number = 2
+)
+
+// SignBytes returns the canonical encoding of the header signed by the proposer.
+// It is identical to MarshalBinary, but does not depend on the protobuf runtime.
+func (h *Header) SignBytes() []byte {
+	var version []byte
+	version = appendVarintField(version, versionFieldBlock, h.Version.Block)
+	version = appendVarintField(version, versionFieldApp, h.Version.App)
+
+	var b []byte
+	b = protowire.AppendTag(b, headerFieldVersion, protowire.BytesType)
+	b = protowire.AppendBytes(b, version)
+	b = appendVarintField(b, headerFieldHeight, h.BaseHeader.Height)
+	b = appendVarintField(b, headerFieldTime, h.BaseHeader.Time)
+	b = appendBytesField(b, headerFieldLastHeaderHash, h.LastHeaderHash)
+	b = appendBytesField(b, headerFieldLastCommitHash, h.LastCommitHash)
+	b = appendBytesField(b, headerFieldDataHash, h.DataHash)
+	b = appendBytesField(b, headerFieldConsensusHash, h.ConsensusHash)
+	b = appendBytesField(b, headerFieldAppHash, h.AppHash)
+	b = appendBytesField(b, headerFieldLastResultsHash, h.LastResultsHash)
+	b = appendBytesField(b, headerFieldProposerAddress, h.ProposerAddress)
+	b = appendBytesField(b, headerFieldValidatorHash, h.ValidatorHash)
+	b = appendBytesField(b, headerFieldChainID, []byte(h.BaseHeader.ChainID))
+	return b
+}
+
+func appendVarintField(b []byte, num protowire.Number, v uint64) []byte {
+	if v == 0 {
+		return b
+	}
+	b = protowire.AppendTag(b, num, protowire.VarintType)
+	return protowire.AppendVarint(b, v)
+}
+
+func appendBytesField(b []byte, num protowire.Number, v []byte) []byte {
+	if len(v) == 0 {
+		return b
+	}
+	b = protowire.AppendTag(b, num, protowire.BytesType)
+	return protowire.AppendBytes(b, v)
+}
+
+// hexBytes is a byte string encoded as lowercase hex in canonical JSON.
+type hexBytes []byte
+
+func (h hexBytes) MarshalJSON() ([]byte, error) {
+	return json.Marshal(hex.EncodeToString(h))
+}
+
+func (h *hexBytes) UnmarshalJSON(data []byte) error {
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return err
+	}
+	bz, err := hex.DecodeString(s)
+	if err != nil {
+		return err
+	}
+	if len(bz) == 0 {
+		bz = nil
+	}
+	*h = bz
+	return nil
+}
+
+// canonicalVersion is the canonical JSON representation of Version.
+// Fields are declared in lexicographic order of their keys.
+type canonicalVersion struct {
+	App   uint64 `json:"app,string"`
+	Block uint64 `json:"block,string"`
+}
+
+// canonicalHeader is the canonical JSON representation of Header.
+// Fields are declared in lexicographic order of their keys.
+type canonicalHeader struct {
+	AppHash         hexBytes         `json:"app_hash"`
+	ChainID         string           `json:"chain_id"`
+	ConsensusHash   hexBytes         `json:"consensus_hash"`
+	DataHash        hexBytes         `json:"data_hash"`
+	Height          uint64           `json:"height,string"`
+	LastCommitHash  hexBytes         `json:"last_commit_hash"`
+	LastHeaderHash  hexBytes         `json:"last_header_hash"`
+	LastResultsHash hexBytes         `json:"last_results_hash"`
+	ProposerAddress hexBytes         `json:"proposer_address"`
+	Time            uint64           `json:"time,string"`
+	ValidatorHash   hexBytes         `json:"validator_hash"`
+	Version         canonicalVersion `json:"version"`
+}
+
+// canonicalSigner is the canonical JSON representation of Signer.
+// The public key is encoded with crypto.MarshalPublicKey, as in the protobuf representation.
+type canonicalSigner struct {
+	Address hexBytes `json:"address"`
+	PubKey  hexBytes `json:"pub_key"`
+}
+
+// canonicalSignedHeader is the canonical JSON representation of SignedHeader.
+type canonicalSignedHeader struct {
+	Header    canonicalHeader `json:"header"`
+	Signature hexBytes        `json:"signature"`
+	Signer    canonicalSigner `json:"signer"`
+}
+
+func (h *Header) toCanonical() canonicalHeader {
+	return canonicalHeader{
+		AppHash:         hexBytes(h.AppHash),
+		ChainID:         h.BaseHeader.ChainID,
+		ConsensusHash:   hexBytes(h.ConsensusHash),
+		DataHash:        hexBytes(h.DataHash),
+		Height:          h.BaseHeader.Height,
+		LastCommitHash:  hexBytes(h.LastCommitHash),
+		LastHeaderHash:  hexBytes(h.LastHeaderHash),
+		LastResultsHash: hexBytes(h.LastResultsHash),
+		ProposerAddress: hexBytes(h.ProposerAddress),
+		Time:            h.BaseHeader.Time,
+		ValidatorHash:   hexBytes(h.ValidatorHash),
+		Version:         canonicalVersion{App: h.Version.App, Block: h.Version.Block},
+	}
+}
+
+func (h *Header) fromCanonical(c canonicalHeader) {
+	*h = Header{
+		BaseHeader: BaseHeader{
+			Height:  c.Height,
+			Time:    c.Time,
+			ChainID: c.ChainID,
+		},
+		Version:         Version{Block: c.Version.Block, App: c.Version.App},
+		LastHeaderHash:  Hash(c.LastHeaderHash),
+		LastCommitHash:  Hash(c.LastCommitHash),
+		DataHash:        Hash(c.DataHash),
+		ConsensusHash:   Hash(c.ConsensusHash),
+		AppHash:         Hash(c.AppHash),
+		LastResultsHash: Hash(c.LastResultsHash),
+		ValidatorHash:   Hash(c.ValidatorHash),
+		ProposerAddress: []byte(c.ProposerAddress),
+	}
+}
+
+// MarshalCanonicalJSON returns the canonical JSON representation of the header.
+func (h *Header) MarshalCanonicalJSON() ([]byte, error) {
+	return marshalCanonicalJSON(h.toCanonical())
+}
+
+// UnmarshalCanonicalJSON decodes the canonical JSON representation of a header.
+func (h *Header) UnmarshalCanonicalJSON(data []byte) error {
+	var c canonicalHeader
+	if err := unmarshalCanonicalJSON(data, &c); err != nil {
+		return err
+	}
+	h.fromCanonical(c)
+	return nil
+}
+
+// MarshalCanonicalJSON returns the canonical JSON representation of the signed header.
+func (sh *SignedHeader) MarshalCanonicalJSON() ([]byte, error) {
+	c := canonicalSignedHeader{
+		Header:    sh.Header.toCanonical(),
+		Signature: hexBytes(sh.Signature),
+		Signer:    canonicalSigner{Address: hexBytes(sh.Signer.Address)},
+	}
+	if sh.Signer.PubKey != nil {
+		pubKey, err := crypto.MarshalPublicKey(sh.Signer.PubKey)
+		if err != nil {
+			return nil, err
+		}
+		c.Signer.PubKey = pubKey
+	}
+	return marshalCanonicalJSON(c)
+}
+
+// UnmarshalCanonicalJSON decodes the canonical JSON representation of a signed header.
+func (sh *SignedHeader) UnmarshalCanonicalJSON(data []byte) error {
+	var c canonicalSignedHeader
+	if err := unmarshalCanonicalJSON(data, &c); err != nil {
+		return err
+	}
+
+	var signer Signer
+	if len(c.Signer.PubKey) > 0 {
+		pubKey, err := crypto.UnmarshalPublicKey(c.Signer.PubKey)
+		if err != nil {
+			return fmt.Errorf("invalid signer public key: %w", err)
+		}
+		signer = Signer{PubKey: pubKey, Address: []byte(c.Signer.Address)}
+	}
+
+	sh.Header.fromCanonical(c.Header)
+	sh.Signature = Signature(c.Signature)
+	sh.Signer = signer
+	return nil
+}
+
+func marshalCanonicalJSON(v any) ([]byte, error) {
+	var buf bytes.Buffer
+	enc := json.NewEncoder(&buf)
+	enc.SetEscapeHTML(false)
+	if err := enc.Encode(v); err != nil {
+		return nil, err
+	}
+	// Encode terminates the value with a newline, which is not part of the canonical form
+	return bytes.TrimSuffix(buf.Bytes(), []byte("\n")), nil
+}
+
+func unmarshalCanonicalJSON(data []byte, v any) error {
+	dec := json.NewDecoder(bytes.NewReader(data))
+	dec.DisallowUnknownFields()
+	if err := dec.Decode(v); err != nil {
+		return fmt.Errorf("invalid canonical JSON: %w", err)
+	}
+	return nil
+}
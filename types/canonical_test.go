@@ -0,0 +1,204 @@
+package types
+
+import (
+	"bytes"
+	"crypto/ed25519"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"flag"
+	"math"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/libp2p/go-libp2p/core/crypto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var updateVectors = flag.Bool("update-vectors", false, "regenerate the header encoding test vectors")
+
+// headerVectorsPath holds the cross-language test vectors of the canonical header encodings.
+var headerVectorsPath = filepath.Join("testdata", "header_vectors.json")
+
+// headerVector is a single test vector of the canonical header encodings.
+type headerVector struct {
+	Name string `json:"name"`
+	// SignedHeaderJSON is the exact canonical JSON of the signed header
+	SignedHeaderJSON string `json:"signed_header_json"`
+	// SignBytes is the hex encoded canonical sign-bytes of the header
+	SignBytes string `json:"sign_bytes"`
+	// HeaderHash is the hex encoded sha256 of the sign-bytes
+	HeaderHash string `json:"header_hash"`
+}
+
+// vectorHeaders returns the headers the test vectors are generated from.
+func vectorHeaders() []struct {
+	name   string
+	header Header
+} {
+	fill := func(label string) Hash {
+		h := sha256.Sum256([]byte(label))
+		return h[:]
+	}
+	return []struct {
+		name   string
+		header Header
+	}{
+		{
+			name: "minimal",
+			header: Header{
+				BaseHeader: BaseHeader{Height: 1, ChainID: "evolve-1"},
+			},
+		},
+		{
+			name: "full",
+			header: Header{
+				BaseHeader:      BaseHeader{Height: 1234567, Time: 1700000000123456789, ChainID: "evolve-testnet"},
+				Version:         Version{Block: 11, App: 2},
+				LastHeaderHash:  fill("last_header_hash"),
+				LastCommitHash:  fill("last_commit_hash"),
+				DataHash:        fill("data_hash"),
+				ConsensusHash:   fill("consensus_hash"),
+				AppHash:         fill("app_hash"),
+				LastResultsHash: fill("last_results_hash"),
+				ValidatorHash:   fill("validator_hash"),
+			},
+		},
+		{
+			name: "max values",
+			header: Header{
+				BaseHeader: BaseHeader{Height: math.MaxUint64, Time: math.MaxUint64, ChainID: "max"},
+				Version:    Version{Block: math.MaxUint64, App: math.MaxUint64},
+				DataHash:   fill("data_hash"),
+			},
+		},
+		{
+			name: "escaped chain id",
+			header: Header{
+				BaseHeader: BaseHeader{Height: 42, Time: 1, ChainID: "chain \"ü\" <&>\n"},
+				AppHash:    fill("app_hash"),
+			},
+		},
+	}
+}
+
+// vectorSigner returns the deterministic ed25519 key used to sign the test vectors.
+func vectorSigner(t *testing.T) (crypto.PrivKey, Signer) {
+	t.Helper()
+	seed := make([]byte, ed25519.SeedSize)
+	for i := range seed {
+		seed[i] = byte(i)
+	}
+	privKey, err := crypto.UnmarshalEd25519PrivateKey(ed25519.NewKeyFromSeed(seed))
+	require.NoError(t, err)
+	signer, err := NewSigner(privKey.GetPublic())
+	require.NoError(t, err)
+	return privKey, signer
+}
+
+func generateHeaderVectors(t *testing.T) []headerVector {
+	t.Helper()
+	privKey, signer := vectorSigner(t)
+
+	var vectors []headerVector
+	for _, tc := range vectorHeaders() {
+		header := tc.header
+		header.ProposerAddress = signer.Address
+
+		signBytes := header.SignBytes()
+		signature, err := privKey.Sign(signBytes)
+		require.NoError(t, err)
+		sh := &SignedHeader{Header: header, Signature: signature, Signer: signer}
+		shJSON, err := sh.MarshalCanonicalJSON()
+		require.NoError(t, err)
+		hash := sha256.Sum256(signBytes)
+
+		vectors = append(vectors, headerVector{
+			Name:             tc.name,
+			SignedHeaderJSON: string(shJSON),
+			SignBytes:        hex.EncodeToString(signBytes),
+			HeaderHash:       hex.EncodeToString(hash[:]),
+		})
+	}
+	return vectors
+}
+
+// TestHeaderVectors checks the canonical encodings against the committed test vectors.
+// Run with -update-vectors to regenerate them after an intentional encoding change.
+func TestHeaderVectors(t *testing.T) {
+	if *updateVectors {
+		bz, err := json.MarshalIndent(generateHeaderVectors(t), "", "  ")
+		require.NoError(t, err)
+		require.NoError(t, os.WriteFile(headerVectorsPath, append(bz, '\n'), 0o600))
+	}
+
+	bz, err := os.ReadFile(headerVectorsPath)
+	require.NoError(t, err)
+	var vectors []headerVector
+	require.NoError(t, json.Unmarshal(bz, &vectors))
+	require.Equal(t, generateHeaderVectors(t), vectors, "test vectors are outdated, run with -update-vectors")
+
+	for _, v := range vectors {
+		t.Run(v.Name, func(t *testing.T) {
+			var sh SignedHeader
+			require.NoError(t, sh.UnmarshalCanonicalJSON([]byte(v.SignedHeaderJSON)))
+
+			shJSON, err := sh.MarshalCanonicalJSON()
+			require.NoError(t, err)
+			assert.Equal(t, v.SignedHeaderJSON, string(shJSON))
+
+			signBytes := sh.Header.SignBytes()
+			assert.Equal(t, v.SignBytes, hex.EncodeToString(signBytes))
+			marshaled, err := sh.Header.MarshalBinary()
+			require.NoError(t, err)
+			assert.Equal(t, marshaled, signBytes, "sign-bytes must equal the protobuf encoding")
+			assert.Equal(t, v.HeaderHash, hex.EncodeToString(sh.Header.Hash()))
+
+			require.NoError(t, sh.ValidateBasic())
+		})
+	}
+}
+
+// TestSignBytesMatchesProtobuf checks the hand-written sign-bytes encoder against the protobuf runtime.
+func TestSignBytesMatchesProtobuf(t *testing.T) {
+	headers := []Header{{}, {Version: Version{App: 1}}}
+	for i := 0; i < 100; i++ {
+		h := GetRandomHeader("chain", GetRandomBytes(32))
+		if i%2 == 0 {
+			h.LastCommitHash = nil
+			h.ValidatorHash = Hash{}
+		}
+		headers = append(headers, h)
+	}
+
+	for _, h := range headers {
+		marshaled, err := h.MarshalBinary()
+		require.NoError(t, err)
+		require.Equal(t, marshaled, h.SignBytes())
+	}
+}
+
+func TestCanonicalJSON(t *testing.T) {
+	header := Header{
+		BaseHeader: BaseHeader{Height: 3, Time: 4, ChainID: "a<b"},
+		Version:    Version{Block: 1},
+		AppHash:    []byte{0xAB, 0x01},
+	}
+
+	bz, err := header.MarshalCanonicalJSON()
+	require.NoError(t, err)
+	assert.Equal(t,
+		`{"app_hash":"ab01","chain_id":"a<b","consensus_hash":"","data_hash":"","height":"3","last_commit_hash":"","last_header_hash":"","last_results_hash":"","proposer_address":"","time":"4","validator_hash":"","version":{"app":"0","block":"1"}}`,
+		string(bz))
+
+	var decoded Header
+	require.NoError(t, decoded.UnmarshalCanonicalJSON(bz))
+	assert.True(t, bytes.Equal(header.SignBytes(), decoded.SignBytes()))
+
+	// unknown fields are rejected
+	require.Error(t, decoded.UnmarshalCanonicalJSON([]byte(`{"height":"1","extra":true}`)))
+	// numbers must be strings
+	require.Error(t, decoded.UnmarshalCanonicalJSON([]byte(`{"height":1}`)))
+}
@@ -0,0 +1,86 @@
+package types
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+)
+
+// blobEnvelopeMagic prefixes the DA blobs combining several parts, e.g. the header and data of
+// a height. Protobuf encoded headers and data start with a field tag, which never matches it.
+var blobEnvelopeMagic = []byte{0xfe, 'e', 'v', 'b'}
+
+// blobEnvelopeVersion is the version of the envelope layout.
+const blobEnvelopeVersion = 1
+
+// maxBlobEnvelopeParts is the maximum number of parts of an envelope.
+const maxBlobEnvelopeParts = 255
+
+// ErrNotBlobEnvelope is returned when unmarshalling a blob which is not an envelope.
+var ErrNotBlobEnvelope = errors.New("not a blob envelope")
+
+// MarshalBlobEnvelope combines parts in a single DA blob, so that they are submitted at once.
+// Parts can be empty. The layout is the magic prefix, the version, the number of parts, the end
+// offset of each part in the payload as a big-endian uint32 and the payload.
+func MarshalBlobEnvelope(parts ...[]byte) ([]byte, error) {
+	if len(parts) > maxBlobEnvelopeParts {
+		return nil, fmt.Errorf("too many parts for a blob envelope: %d", len(parts))
+	}
+	size := 0
+	for _, part := range parts {
+		size += len(part)
+	}
+	if uint64(size) > uint64(^uint32(0)) {
+		return nil, fmt.Errorf("blob envelope payload too large: %d bytes", size)
+	}
+
+	bz := make([]byte, 0, len(blobEnvelopeMagic)+2+4*len(parts)+size)
+	bz = append(bz, blobEnvelopeMagic...)
+	bz = append(bz, blobEnvelopeVersion, byte(len(parts)))
+	end := uint32(0)
+	for _, part := range parts {
+		end += uint32(len(part))
+		bz = binary.BigEndian.AppendUint32(bz, end)
+	}
+	for _, part := range parts {
+		bz = append(bz, part...)
+	}
+	return bz, nil
+}
+
+// UnmarshalBlobEnvelope returns the parts of a DA blob created by MarshalBlobEnvelope. It returns
+// ErrNotBlobEnvelope if the blob is not an envelope, e.g. a header or data submitted alone.
+func UnmarshalBlobEnvelope(bz []byte) ([][]byte, error) {
+	if !bytes.HasPrefix(bz, blobEnvelopeMagic) {
+		return nil, ErrNotBlobEnvelope
+	}
+	bz = bz[len(blobEnvelopeMagic):]
+	if len(bz) < 2 {
+		return nil, errors.New("truncated blob envelope")
+	}
+	if bz[0] != blobEnvelopeVersion {
+		return nil, fmt.Errorf("unsupported blob envelope version %d", bz[0])
+	}
+	n := int(bz[1])
+	bz = bz[2:]
+	if len(bz) < 4*n {
+		return nil, errors.New("truncated blob envelope offsets")
+	}
+	offsets, payload := bz[:4*n], bz[4*n:]
+
+	parts := make([][]byte, n)
+	start := uint32(0)
+	for i := range parts {
+		end := binary.BigEndian.Uint32(offsets[4*i:])
+		if end < start || uint64(end) > uint64(len(payload)) {
+			return nil, fmt.Errorf("invalid offset of blob envelope part %d", i)
+		}
+		parts[i] = payload[start:end]
+		start = end
+	}
+	if uint64(start) != uint64(len(payload)) {
+		return nil, errors.New("trailing bytes in blob envelope")
+	}
+	return parts, nil
+}
@@ -0,0 +1,39 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBlobEnvelope(t *testing.T) {
+	parts := [][]byte{[]byte("header"), {}, []byte("data")}
+	bz, err := MarshalBlobEnvelope(parts...)
+	require.NoError(t, err)
+
+	got, err := UnmarshalBlobEnvelope(bz)
+	require.NoError(t, err)
+	require.Len(t, got, 3)
+	for i := range parts {
+		assert.Equal(t, string(parts[i]), string(got[i]))
+	}
+
+	// headers and data submitted alone are not envelopes
+	header, _ := GetRandomBlock(1, 1, "test")
+	headerBz, err := header.MarshalBinary()
+	require.NoError(t, err)
+	_, err = UnmarshalBlobEnvelope(headerBz)
+	assert.ErrorIs(t, err, ErrNotBlobEnvelope)
+
+	for name, corrupt := range map[string][]byte{
+		"truncated":     bz[:len(bz)-1],
+		"trailing":      append(append([]byte{}, bz...), 0),
+		"no offsets":    bz[:6],
+		"wrong version": append(append([]byte{}, blobEnvelopeMagic...), 2, 0),
+	} {
+		_, err := UnmarshalBlobEnvelope(corrupt)
+		assert.Error(t, err, name)
+		assert.NotErrorIs(t, err, ErrNotBlobEnvelope, name)
+	}
+}
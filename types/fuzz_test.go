@@ -0,0 +1,258 @@
+package types
+
+import (
+	"bytes"
+	"testing"
+	"time"
+	"unicode/utf8"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+
+	pb "github.com/evstack/ev-node/types/pb/evnode/v1"
+)
+
+// Differential fuzz targets of the serialization of SignedHeader, Data and State.
+//
+// The Decode targets decode arbitrary bytes with both the protobuf runtime and the reference
+// codec of reference_codec_test.go, and require them to accept the same inputs, decode the same
+// values and re-encode them to the same bytes. The RoundTrip targets encode random values with
+// MarshalBinary, require the reference codec to decode the input values and the encoding to be
+// stable through UnmarshalBinary. Any difference could make nodes disagree on a header hash or
+// on the validity of a block. Run a target with e.g.:
+//
+//	go test ./types -run '^$' -fuzz '^FuzzSignedHeaderDecode$' -fuzztime 1m
+
+// checkDecode compares the decoding of data by the protobuf runtime into got with its decoding by
+// the reference codec into want.
+func checkDecode[M proto.Message](t *testing.T, data []byte, got, want M, refErr error, refEncode func(M) []byte) {
+	t.Helper()
+	err := proto.Unmarshal(data, got)
+	require.Equal(t, err == nil, refErr == nil, "acceptance differs: runtime error %v, reference error %v", err, refErr)
+	if err != nil {
+		return
+	}
+
+	discardUnknown(got.ProtoReflect())
+	require.True(t, proto.Equal(got, want), "decoded values differ:\nruntime:   %v\nreference: %v", got, want)
+	encoded, err := proto.MarshalOptions{Deterministic: true}.Marshal(got)
+	require.NoError(t, err)
+	refEncoded := refEncode(want)
+	require.True(t, bytes.Equal(refEncoded, encoded), "encodings differ:\nruntime:   %x\nreference: %x", encoded, refEncoded)
+}
+
+// addSignedHeaderSeeds adds encoded signed headers to the corpus of f.
+func addSignedHeaderSeeds(f *testing.F) {
+	f.Helper()
+	header, data := GetRandomBlock(1, 2, "fuzz-chain")
+	bz, err := header.MarshalBinary()
+	require.NoError(f, err)
+	f.Add(bz)
+	bz, err = header.Header.MarshalBinary()
+	require.NoError(f, err)
+	f.Add(bz)
+	bz, err = data.MarshalBinary()
+	require.NoError(f, err)
+	f.Add(bz)
+	f.Add([]byte{})
+	// a header with duplicated fields, an unknown field and a field of an unexpected wire type
+	f.Add([]byte{0x0a, 0x04, 0x10, 0x01, 0x10, 0x02, 0x0a, 0x02, 0x08, 0x03, 0x12, 0x01, 0xff, 0xa8, 0x06, 0x07})
+}
+
+func FuzzSignedHeaderDecode(f *testing.F) {
+	addSignedHeaderSeeds(f)
+	f.Fuzz(func(t *testing.T, data []byte) {
+		var want pb.SignedHeader
+		refErr := refDecodeSignedHeader(data, &want)
+		checkDecode(t, data, &pb.SignedHeader{}, &want, refErr, refEncodeSignedHeader)
+
+		// the encoding of a decoded header is stable, as its hash is computed over it
+		var sh SignedHeader
+		if err := sh.UnmarshalBinary(data); err != nil {
+			return
+		}
+		first, err := sh.MarshalBinary()
+		require.NoError(t, err)
+		var again SignedHeader
+		require.NoError(t, again.UnmarshalBinary(first))
+		second, err := again.MarshalBinary()
+		require.NoError(t, err)
+		require.Equal(t, first, second)
+		require.Equal(t, sh.Hash(), again.Hash())
+
+		// the canonical encoding, which is signed, matches the protobuf encoding
+		headerBz, err := sh.Header.MarshalBinary()
+		require.NoError(t, err)
+		require.Equal(t, headerBz, sh.Header.SignBytes())
+	})
+}
+
+func FuzzDataDecode(f *testing.F) {
+	addSignedHeaderSeeds(f)
+	f.Fuzz(func(t *testing.T, data []byte) {
+		var want pb.Data
+		refErr := refDecodeData(data, &want)
+		checkDecode(t, data, &pb.Data{}, &want, refErr, refEncodeData)
+
+		var d Data
+		if err := d.UnmarshalBinary(data); err != nil {
+			return
+		}
+		first, err := d.MarshalBinary()
+		require.NoError(t, err)
+		var again Data
+		require.NoError(t, again.UnmarshalBinary(first))
+		second, err := again.MarshalBinary()
+		require.NoError(t, err)
+		require.Equal(t, first, second)
+		require.Equal(t, d.DACommitment(), again.DACommitment())
+	})
+}
+
+func FuzzStateDecode(f *testing.F) {
+	state, err := (&State{
+		Version:         Version{Block: 11, App: 1},
+		ChainID:         "fuzz-chain",
+		InitialHeight:   1,
+		LastBlockHeight: 42,
+		LastBlockTime:   time.Unix(1_700_000_000, 123).UTC(),
+		DAHeight:        7,
+		LastResultsHash: GetRandomBytes(32),
+		AppHash:         GetRandomBytes(32),
+	}).ToProto()
+	require.NoError(f, err)
+	bz, err := proto.Marshal(state)
+	require.NoError(f, err)
+	f.Add(bz)
+	f.Add([]byte{})
+	// a timestamp with negative seconds and nanos
+	f.Add([]byte{0x2a, 0x0c, 0x08, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0x01, 0x10, 0x7f})
+
+	f.Fuzz(func(t *testing.T, data []byte) {
+		var want pb.State
+		refErr := refDecodeState(data, &want)
+		checkDecode(t, data, &pb.State{}, &want, refErr, refEncodeState)
+	})
+}
+
+func FuzzSignedHeaderRoundTrip(f *testing.F) {
+	f.Add(uint64(11), uint64(1), uint64(1), uint64(1_700_000_000_000_000_000), "fuzz-chain", []byte("last header"), []byte("data"), []byte("app"), []byte("proposer"), []byte("signature"))
+	f.Add(uint64(0), uint64(0), uint64(0), uint64(0), "", []byte{}, []byte(nil), []byte{}, []byte{}, []byte{})
+	f.Fuzz(func(t *testing.T, block, app, height, ts uint64, chainID string, lastHeaderHash, dataHash, appHash, proposer, signature []byte) {
+		sh := &SignedHeader{
+			Header: Header{
+				BaseHeader:      BaseHeader{Height: height, Time: ts, ChainID: chainID},
+				Version:         Version{Block: block, App: app},
+				LastHeaderHash:  lastHeaderHash,
+				DataHash:        dataHash,
+				AppHash:         appHash,
+				ProposerAddress: proposer,
+				ValidatorHash:   proposer,
+			},
+			Signature: signature,
+		}
+		bz, err := sh.MarshalBinary()
+		if err != nil {
+			// only strings which are not valid UTF-8 cannot be encoded
+			require.False(t, utf8.ValidString(chainID))
+			return
+		}
+
+		var decoded pb.SignedHeader
+		require.NoError(t, refDecodeSignedHeader(bz, &decoded))
+
+		require.Equal(t, block, decoded.Header.Version.Block)
+		require.Equal(t, app, decoded.Header.Version.App)
+		require.Equal(t, height, decoded.Header.Height)
+		require.Equal(t, ts, decoded.Header.Time)
+		require.Equal(t, chainID, decoded.Header.ChainId)
+		require.True(t, bytes.Equal(lastHeaderHash, decoded.Header.LastHeaderHash))
+		require.True(t, bytes.Equal(dataHash, decoded.Header.DataHash))
+		require.True(t, bytes.Equal(appHash, decoded.Header.AppHash))
+		require.True(t, bytes.Equal(proposer, decoded.Header.ProposerAddress))
+		require.True(t, bytes.Equal(proposer, decoded.Header.ValidatorHash))
+		require.True(t, bytes.Equal(signature, decoded.Signature))
+		require.Equal(t, bz, refEncodeSignedHeader(&decoded))
+
+		var again SignedHeader
+		require.NoError(t, again.UnmarshalBinary(bz))
+		require.Equal(t, sh.Hash(), again.Hash())
+		require.Equal(t, sh.Header.SignBytes(), again.Header.SignBytes())
+	})
+}
+
+func FuzzDataRoundTrip(f *testing.F) {
+	f.Add("fuzz-chain", uint64(1), uint64(1_700_000_000_000_000_000), []byte("last data"), []byte("tx1"), []byte("tx2"), true)
+	f.Add("", uint64(0), uint64(0), []byte{}, []byte{}, []byte(nil), false)
+	f.Fuzz(func(t *testing.T, chainID string, height, ts uint64, lastDataHash, tx1, tx2 []byte, withMetadata bool) {
+		d := &Data{Txs: Txs{tx1, tx2}}
+		if withMetadata {
+			d.Metadata = &Metadata{ChainID: chainID, Height: height, Time: ts, LastDataHash: lastDataHash}
+		}
+		bz, err := d.MarshalBinary()
+		if err != nil {
+			require.False(t, withMetadata && utf8.ValidString(chainID))
+			return
+		}
+
+		var decoded pb.Data
+		require.NoError(t, refDecodeData(bz, &decoded))
+		require.Len(t, decoded.Txs, 2)
+		require.True(t, bytes.Equal(tx1, decoded.Txs[0]))
+		require.True(t, bytes.Equal(tx2, decoded.Txs[1]))
+		require.Equal(t, withMetadata, decoded.Metadata != nil)
+		if withMetadata {
+			require.Equal(t, chainID, decoded.Metadata.ChainId)
+			require.Equal(t, height, decoded.Metadata.Height)
+			require.Equal(t, ts, decoded.Metadata.Time)
+			require.True(t, bytes.Equal(lastDataHash, decoded.Metadata.LastDataHash))
+		}
+		require.Equal(t, bz, refEncodeData(&decoded))
+
+		var again Data
+		require.NoError(t, again.UnmarshalBinary(bz))
+		require.Equal(t, d.DACommitment(), again.DACommitment())
+	})
+}
+
+func FuzzStateRoundTrip(f *testing.F) {
+	f.Add(uint64(11), uint64(1), "fuzz-chain", uint64(1), uint64(42), int64(1_700_000_000_123_456_789), uint64(7), []byte("results"), []byte("app"))
+	f.Add(uint64(0), uint64(0), "", uint64(0), uint64(0), int64(0), uint64(0), []byte{}, []byte(nil))
+	f.Fuzz(func(t *testing.T, block, app uint64, chainID string, initialHeight, lastHeight uint64, unixNano int64, daHeight uint64, resultsHash, appHash []byte) {
+		s := State{
+			Version:         Version{Block: block, App: app},
+			ChainID:         chainID,
+			InitialHeight:   initialHeight,
+			LastBlockHeight: lastHeight,
+			LastBlockTime:   time.Unix(0, unixNano).UTC(),
+			DAHeight:        daHeight,
+			LastResultsHash: resultsHash,
+			AppHash:         appHash,
+		}
+		sp, err := s.ToProto()
+		require.NoError(t, err)
+		bz, err := proto.Marshal(sp)
+		if err != nil {
+			require.False(t, utf8.ValidString(chainID))
+			return
+		}
+
+		var decoded pb.State
+		require.NoError(t, refDecodeState(bz, &decoded))
+		require.Equal(t, block, decoded.Version.Block)
+		require.Equal(t, app, decoded.Version.App)
+		require.Equal(t, chainID, decoded.ChainId)
+		require.Equal(t, initialHeight, decoded.InitialHeight)
+		require.Equal(t, lastHeight, decoded.LastBlockHeight)
+		require.True(t, s.LastBlockTime.Equal(decoded.LastBlockTime.AsTime()))
+		require.Equal(t, daHeight, decoded.DaHeight)
+		require.True(t, bytes.Equal(resultsHash, decoded.LastResultsHash))
+		require.True(t, bytes.Equal(appHash, decoded.AppHash))
+		require.Equal(t, bz, refEncodeState(&decoded))
+
+		var again State
+		require.NoError(t, again.FromProto(&decoded))
+		require.True(t, s.LastBlockTime.Equal(again.LastBlockTime))
+		require.True(t, bytes.Equal(s.AppHash, again.AppHash))
+	})
+}
@@ -0,0 +1,436 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.7
+// 	protoc        (unknown)
+// source: evnode/v1/admin.proto
+
+package v1
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	durationpb "google.golang.org/protobuf/types/known/durationpb"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// SetLogLevelRequest defines the request for changing the log level
+type SetLogLevelRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// One of trace, debug, info, warn, error, fatal, panic or disabled
+	Level         string `protobuf:"bytes,1,opt,name=level,proto3" json:"level,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetLogLevelRequest) Reset() {
+	*x = SetLogLevelRequest{}
+	mi := &file_evnode_v1_admin_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetLogLevelRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetLogLevelRequest) ProtoMessage() {}
+
+func (x *SetLogLevelRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_evnode_v1_admin_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetLogLevelRequest.ProtoReflect.Descriptor instead.
+func (*SetLogLevelRequest) Descriptor() ([]byte, []int) {
+	return file_evnode_v1_admin_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *SetLogLevelRequest) GetLevel() string {
+	if x != nil {
+		return x.Level
+	}
+	return ""
+}
+
+// SetLogLevelResponse defines the response for changing the log level
+type SetLogLevelResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Level before the change
+	PreviousLevel string `protobuf:"bytes,1,opt,name=previous_level,json=previousLevel,proto3" json:"previous_level,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetLogLevelResponse) Reset() {
+	*x = SetLogLevelResponse{}
+	mi := &file_evnode_v1_admin_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetLogLevelResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetLogLevelResponse) ProtoMessage() {}
+
+func (x *SetLogLevelResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_evnode_v1_admin_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetLogLevelResponse.ProtoReflect.Descriptor instead.
+func (*SetLogLevelResponse) Descriptor() ([]byte, []int) {
+	return file_evnode_v1_admin_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *SetLogLevelResponse) GetPreviousLevel() string {
+	if x != nil {
+		return x.PreviousLevel
+	}
+	return ""
+}
+
+// TriggerDASubmissionResponse defines the response for triggering a DA submission
+type TriggerDASubmissionResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Number of headers pending DA submission
+	PendingHeaders uint64 `protobuf:"varint,1,opt,name=pending_headers,json=pendingHeaders,proto3" json:"pending_headers,omitempty"`
+	// Number of data pending DA submission
+	PendingData   uint64 `protobuf:"varint,2,opt,name=pending_data,json=pendingData,proto3" json:"pending_data,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TriggerDASubmissionResponse) Reset() {
+	*x = TriggerDASubmissionResponse{}
+	mi := &file_evnode_v1_admin_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TriggerDASubmissionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TriggerDASubmissionResponse) ProtoMessage() {}
+
+func (x *TriggerDASubmissionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_evnode_v1_admin_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TriggerDASubmissionResponse.ProtoReflect.Descriptor instead.
+func (*TriggerDASubmissionResponse) Descriptor() ([]byte, []int) {
+	return file_evnode_v1_admin_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *TriggerDASubmissionResponse) GetPendingHeaders() uint64 {
+	if x != nil {
+		return x.PendingHeaders
+	}
+	return 0
+}
+
+func (x *TriggerDASubmissionResponse) GetPendingData() uint64 {
+	if x != nil {
+		return x.PendingData
+	}
+	return 0
+}
+
+// DisconnectPeerRequest defines the request for disconnecting a peer
+type DisconnectPeerRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// ID of the peer
+	PeerId string `protobuf:"bytes,1,opt,name=peer_id,json=peerId,proto3" json:"peer_id,omitempty"`
+	// Block the peer in the connection gater, which persists the ban across restarts
+	Ban           bool `protobuf:"varint,2,opt,name=ban,proto3" json:"ban,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DisconnectPeerRequest) Reset() {
+	*x = DisconnectPeerRequest{}
+	mi := &file_evnode_v1_admin_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DisconnectPeerRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DisconnectPeerRequest) ProtoMessage() {}
+
+func (x *DisconnectPeerRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_evnode_v1_admin_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DisconnectPeerRequest.ProtoReflect.Descriptor instead.
+func (*DisconnectPeerRequest) Descriptor() ([]byte, []int) {
+	return file_evnode_v1_admin_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *DisconnectPeerRequest) GetPeerId() string {
+	if x != nil {
+		return x.PeerId
+	}
+	return ""
+}
+
+func (x *DisconnectPeerRequest) GetBan() bool {
+	if x != nil {
+		return x.Ban
+	}
+	return false
+}
+
+// DrainRequest defines the request for draining the node
+type DrainRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Grace period of the in-flight requests. Defaults to the rpc.drain_timeout of the node,
+	// or 10 seconds if draining on shutdown is disabled.
+	Timeout       *durationpb.Duration `protobuf:"bytes,1,opt,name=timeout,proto3" json:"timeout,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DrainRequest) Reset() {
+	*x = DrainRequest{}
+	mi := &file_evnode_v1_admin_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DrainRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DrainRequest) ProtoMessage() {}
+
+func (x *DrainRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_evnode_v1_admin_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DrainRequest.ProtoReflect.Descriptor instead.
+func (*DrainRequest) Descriptor() ([]byte, []int) {
+	return file_evnode_v1_admin_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *DrainRequest) GetTimeout() *durationpb.Duration {
+	if x != nil {
+		return x.Timeout
+	}
+	return nil
+}
+
+// SetMetadataRequest defines the request for saving a value of application metadata
+type SetMetadataRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Namespace registered by the application
+	Namespace string `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	// Key within the namespace, a relative path such as checkpoints/1
+	Key           string `protobuf:"bytes,2,opt,name=key,proto3" json:"key,omitempty"`
+	Value         []byte `protobuf:"bytes,3,opt,name=value,proto3" json:"value,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetMetadataRequest) Reset() {
+	*x = SetMetadataRequest{}
+	mi := &file_evnode_v1_admin_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetMetadataRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetMetadataRequest) ProtoMessage() {}
+
+func (x *SetMetadataRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_evnode_v1_admin_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetMetadataRequest.ProtoReflect.Descriptor instead.
+func (*SetMetadataRequest) Descriptor() ([]byte, []int) {
+	return file_evnode_v1_admin_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *SetMetadataRequest) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
+func (x *SetMetadataRequest) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *SetMetadataRequest) GetValue() []byte {
+	if x != nil {
+		return x.Value
+	}
+	return nil
+}
+
+var File_evnode_v1_admin_proto protoreflect.FileDescriptor
+
+const file_evnode_v1_admin_proto_rawDesc = "" +
+	"\n" +
+	"\x15evnode/v1/admin.proto\x12\tevnode.v1\x1a\x1egoogle/protobuf/duration.proto\x1a\x1bgoogle/protobuf/empty.proto\"*\n" +
+	"\x12SetLogLevelRequest\x12\x14\n" +
+	"\x05level\x18\x01 \x01(\tR\x05level\"<\n" +
+	"\x13SetLogLevelResponse\x12%\n" +
+	"\x0eprevious_level\x18\x01 \x01(\tR\rpreviousLevel\"i\n" +
+	"\x1bTriggerDASubmissionResponse\x12'\n" +
+	"\x0fpending_headers\x18\x01 \x01(\x04R\x0ependingHeaders\x12!\n" +
+	"\fpending_data\x18\x02 \x01(\x04R\vpendingData\"B\n" +
+	"\x15DisconnectPeerRequest\x12\x17\n" +
+	"\apeer_id\x18\x01 \x01(\tR\x06peerId\x12\x10\n" +
+	"\x03ban\x18\x02 \x01(\bR\x03ban\"C\n" +
+	"\fDrainRequest\x123\n" +
+	"\atimeout\x18\x01 \x01(\v2\x19.google.protobuf.DurationR\atimeout\"Z\n" +
+	"\x12SetMetadataRequest\x12\x1c\n" +
+	"\tnamespace\x18\x01 \x01(\tR\tnamespace\x12\x10\n" +
+	"\x03key\x18\x02 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x03 \x01(\fR\x05value2\xfb\x03\n" +
+	"\fAdminService\x12:\n" +
+	"\bShutdown\x12\x16.google.protobuf.Empty\x1a\x16.google.protobuf.Empty\x12L\n" +
+	"\vSetLogLevel\x12\x1d.evnode.v1.SetLogLevelRequest\x1a\x1e.evnode.v1.SetLogLevelResponse\x12U\n" +
+	"\x13TriggerDASubmission\x12\x16.google.protobuf.Empty\x1a&.evnode.v1.TriggerDASubmissionResponse\x12J\n" +
+	"\x0eDisconnectPeer\x12 .evnode.v1.DisconnectPeerRequest\x1a\x16.google.protobuf.Empty\x12>\n" +
+	"\fCompactStore\x12\x16.google.protobuf.Empty\x1a\x16.google.protobuf.Empty\x128\n" +
+	"\x05Drain\x12\x17.evnode.v1.DrainRequest\x1a\x16.google.protobuf.Empty\x12D\n" +
+	"\vSetMetadata\x12\x1d.evnode.v1.SetMetadataRequest\x1a\x16.google.protobuf.EmptyB/Z-github.com/evstack/ev-node/types/pb/evnode/v1b\x06proto3"
+
+var (
+	file_evnode_v1_admin_proto_rawDescOnce sync.Once
+	file_evnode_v1_admin_proto_rawDescData []byte
+)
+
+func file_evnode_v1_admin_proto_rawDescGZIP() []byte {
+	file_evnode_v1_admin_proto_rawDescOnce.Do(func() {
+		file_evnode_v1_admin_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_evnode_v1_admin_proto_rawDesc), len(file_evnode_v1_admin_proto_rawDesc)))
+	})
+	return file_evnode_v1_admin_proto_rawDescData
+}
+
+var file_evnode_v1_admin_proto_msgTypes = make([]protoimpl.MessageInfo, 6)
+var file_evnode_v1_admin_proto_goTypes = []any{
+	(*SetLogLevelRequest)(nil),          // 0: evnode.v1.SetLogLevelRequest
+	(*SetLogLevelResponse)(nil),         // 1: evnode.v1.SetLogLevelResponse
+	(*TriggerDASubmissionResponse)(nil), // 2: evnode.v1.TriggerDASubmissionResponse
+	(*DisconnectPeerRequest)(nil),       // 3: evnode.v1.DisconnectPeerRequest
+	(*DrainRequest)(nil),                // 4: evnode.v1.DrainRequest
+	(*SetMetadataRequest)(nil),          // 5: evnode.v1.SetMetadataRequest
+	(*durationpb.Duration)(nil),         // 6: google.protobuf.Duration
+	(*emptypb.Empty)(nil),               // 7: google.protobuf.Empty
+}
+var file_evnode_v1_admin_proto_depIdxs = []int32{
+	6, // 0: evnode.v1.DrainRequest.timeout:type_name -> google.protobuf.Duration
+	7, // 1: evnode.v1.AdminService.Shutdown:input_type -> google.protobuf.Empty
+	0, // 2: evnode.v1.AdminService.SetLogLevel:input_type -> evnode.v1.SetLogLevelRequest
+	7, // 3: evnode.v1.AdminService.TriggerDASubmission:input_type -> google.protobuf.Empty
+	3, // 4: evnode.v1.AdminService.DisconnectPeer:input_type -> evnode.v1.DisconnectPeerRequest
+	7, // 5: evnode.v1.AdminService.CompactStore:input_type -> google.protobuf.Empty
+	4, // 6: evnode.v1.AdminService.Drain:input_type -> evnode.v1.DrainRequest
+	5, // 7: evnode.v1.AdminService.SetMetadata:input_type -> evnode.v1.SetMetadataRequest
+	7, // 8: evnode.v1.AdminService.Shutdown:output_type -> google.protobuf.Empty
+	1, // 9: evnode.v1.AdminService.SetLogLevel:output_type -> evnode.v1.SetLogLevelResponse
+	2, // 10: evnode.v1.AdminService.TriggerDASubmission:output_type -> evnode.v1.TriggerDASubmissionResponse
+	7, // 11: evnode.v1.AdminService.DisconnectPeer:output_type -> google.protobuf.Empty
+	7, // 12: evnode.v1.AdminService.CompactStore:output_type -> google.protobuf.Empty
+	7, // 13: evnode.v1.AdminService.Drain:output_type -> google.protobuf.Empty
+	7, // 14: evnode.v1.AdminService.SetMetadata:output_type -> google.protobuf.Empty
+	8, // [8:15] is the sub-list for method output_type
+	1, // [1:8] is the sub-list for method input_type
+	1, // [1:1] is the sub-list for extension type_name
+	1, // [1:1] is the sub-list for extension extendee
+	0, // [0:1] is the sub-list for field type_name
+}
+
+func init() { file_evnode_v1_admin_proto_init() }
+func file_evnode_v1_admin_proto_init() {
+	if File_evnode_v1_admin_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_evnode_v1_admin_proto_rawDesc), len(file_evnode_v1_admin_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   6,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_evnode_v1_admin_proto_goTypes,
+		DependencyIndexes: file_evnode_v1_admin_proto_depIdxs,
+		MessageInfos:      file_evnode_v1_admin_proto_msgTypes,
+	}.Build()
+	File_evnode_v1_admin_proto = out.File
+	file_evnode_v1_admin_proto_goTypes = nil
+	file_evnode_v1_admin_proto_depIdxs = nil
+}
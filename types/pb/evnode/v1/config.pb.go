@@ -10,6 +10,7 @@ import (
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
 	emptypb "google.golang.org/protobuf/types/known/emptypb"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
 	reflect "reflect"
 	sync "sync"
 	unsafe "unsafe"
@@ -75,16 +76,511 @@ func (x *GetNamespaceResponse) GetDataNamespace() string {
 	return ""
 }
 
+// ValidateConfigRequest defines the request for validating a configuration file
+type ValidateConfigRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Contents of the YAML configuration file
+	Config        []byte `protobuf:"bytes,1,opt,name=config,proto3" json:"config,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ValidateConfigRequest) Reset() {
+	*x = ValidateConfigRequest{}
+	mi := &file_evnode_v1_config_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ValidateConfigRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ValidateConfigRequest) ProtoMessage() {}
+
+func (x *ValidateConfigRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_evnode_v1_config_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ValidateConfigRequest.ProtoReflect.Descriptor instead.
+func (*ValidateConfigRequest) Descriptor() ([]byte, []int) {
+	return file_evnode_v1_config_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *ValidateConfigRequest) GetConfig() []byte {
+	if x != nil {
+		return x.Config
+	}
+	return nil
+}
+
+// ValidateConfigResponse defines the result of validating a configuration file
+type ValidateConfigResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Valid         bool                   `protobuf:"varint,1,opt,name=valid,proto3" json:"valid,omitempty"`
+	Errors        []string               `protobuf:"bytes,2,rep,name=errors,proto3" json:"errors,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ValidateConfigResponse) Reset() {
+	*x = ValidateConfigResponse{}
+	mi := &file_evnode_v1_config_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ValidateConfigResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ValidateConfigResponse) ProtoMessage() {}
+
+func (x *ValidateConfigResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_evnode_v1_config_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ValidateConfigResponse.ProtoReflect.Descriptor instead.
+func (*ValidateConfigResponse) Descriptor() ([]byte, []int) {
+	return file_evnode_v1_config_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *ValidateConfigResponse) GetValid() bool {
+	if x != nil {
+		return x.Valid
+	}
+	return false
+}
+
+func (x *ValidateConfigResponse) GetErrors() []string {
+	if x != nil {
+		return x.Errors
+	}
+	return nil
+}
+
+// GetNodeInfoResponse describes the software, chain and components of a node
+type GetNodeInfoResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Version of the node binary
+	Version string `protobuf:"bytes,1,opt,name=version,proto3" json:"version,omitempty"`
+	// Git commit the node binary was built from
+	GitCommit string `protobuf:"bytes,2,opt,name=git_commit,json=gitCommit,proto3" json:"git_commit,omitempty"`
+	ChainId   string `protobuf:"bytes,3,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	// Mode of the node: "aggregator", "full" or "light"
+	Mode string `protobuf:"bytes,4,opt,name=mode,proto3" json:"mode,omitempty"`
+	// Name of the execution client, e.g. "evm" or "grpc", "unknown" for execution clients not
+	// reporting one, and empty for light nodes
+	ExecutionClient string `protobuf:"bytes,5,opt,name=execution_client,json=executionClient,proto3" json:"execution_client,omitempty"`
+	// Name of the DA client, e.g. "jsonrpc", "unknown" for DA clients not reporting one, and
+	// empty for light nodes
+	DaBackend string `protobuf:"bytes,6,opt,name=da_backend,json=daBackend,proto3" json:"da_backend,omitempty"`
+	// Time the node was started
+	StartTime *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	// Provenance of the node binary
+	BuildInfo     *BuildInfo `protobuf:"bytes,8,opt,name=build_info,json=buildInfo,proto3" json:"build_info,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetNodeInfoResponse) Reset() {
+	*x = GetNodeInfoResponse{}
+	mi := &file_evnode_v1_config_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetNodeInfoResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetNodeInfoResponse) ProtoMessage() {}
+
+func (x *GetNodeInfoResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_evnode_v1_config_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetNodeInfoResponse.ProtoReflect.Descriptor instead.
+func (*GetNodeInfoResponse) Descriptor() ([]byte, []int) {
+	return file_evnode_v1_config_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *GetNodeInfoResponse) GetVersion() string {
+	if x != nil {
+		return x.Version
+	}
+	return ""
+}
+
+func (x *GetNodeInfoResponse) GetGitCommit() string {
+	if x != nil {
+		return x.GitCommit
+	}
+	return ""
+}
+
+func (x *GetNodeInfoResponse) GetChainId() string {
+	if x != nil {
+		return x.ChainId
+	}
+	return ""
+}
+
+func (x *GetNodeInfoResponse) GetMode() string {
+	if x != nil {
+		return x.Mode
+	}
+	return ""
+}
+
+func (x *GetNodeInfoResponse) GetExecutionClient() string {
+	if x != nil {
+		return x.ExecutionClient
+	}
+	return ""
+}
+
+func (x *GetNodeInfoResponse) GetDaBackend() string {
+	if x != nil {
+		return x.DaBackend
+	}
+	return ""
+}
+
+func (x *GetNodeInfoResponse) GetStartTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartTime
+	}
+	return nil
+}
+
+func (x *GetNodeInfoResponse) GetBuildInfo() *BuildInfo {
+	if x != nil {
+		return x.BuildInfo
+	}
+	return nil
+}
+
+// BuildInfo is the provenance of a node binary, as embedded by the Go toolchain
+type BuildInfo struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Version of the Go toolchain the binary was built with
+	GoVersion string `protobuf:"bytes,1,opt,name=go_version,json=goVersion,proto3" json:"go_version,omitempty"`
+	// Main module of the binary
+	Main *ModuleVersion `protobuf:"bytes,2,opt,name=main,proto3" json:"main,omitempty"`
+	// VCS revision and commit time of the sources, and whether they had uncommitted changes
+	VcsRevision string `protobuf:"bytes,3,opt,name=vcs_revision,json=vcsRevision,proto3" json:"vcs_revision,omitempty"`
+	VcsTime     string `protobuf:"bytes,4,opt,name=vcs_time,json=vcsTime,proto3" json:"vcs_time,omitempty"`
+	VcsModified bool   `protobuf:"varint,5,opt,name=vcs_modified,json=vcsModified,proto3" json:"vcs_modified,omitempty"`
+	// Who built the binary, e.g. a CI job, set at build time
+	Builder string `protobuf:"bytes,6,opt,name=builder,proto3" json:"builder,omitempty"`
+	// Build settings, e.g. GOOS, GOARCH, CGO_ENABLED and -trimpath
+	Settings map[string]string `protobuf:"bytes,7,rep,name=settings,proto3" json:"settings,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	// Module dependencies linked into the binary
+	Deps []*ModuleVersion `protobuf:"bytes,8,rep,name=deps,proto3" json:"deps,omitempty"`
+	// Hex encoded SHA-256 digest of the build inputs, equal for all binaries built from the same
+	// sources, dependencies, toolchain and settings
+	Digest        string `protobuf:"bytes,9,opt,name=digest,proto3" json:"digest,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BuildInfo) Reset() {
+	*x = BuildInfo{}
+	mi := &file_evnode_v1_config_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BuildInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BuildInfo) ProtoMessage() {}
+
+func (x *BuildInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_evnode_v1_config_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BuildInfo.ProtoReflect.Descriptor instead.
+func (*BuildInfo) Descriptor() ([]byte, []int) {
+	return file_evnode_v1_config_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *BuildInfo) GetGoVersion() string {
+	if x != nil {
+		return x.GoVersion
+	}
+	return ""
+}
+
+func (x *BuildInfo) GetMain() *ModuleVersion {
+	if x != nil {
+		return x.Main
+	}
+	return nil
+}
+
+func (x *BuildInfo) GetVcsRevision() string {
+	if x != nil {
+		return x.VcsRevision
+	}
+	return ""
+}
+
+func (x *BuildInfo) GetVcsTime() string {
+	if x != nil {
+		return x.VcsTime
+	}
+	return ""
+}
+
+func (x *BuildInfo) GetVcsModified() bool {
+	if x != nil {
+		return x.VcsModified
+	}
+	return false
+}
+
+func (x *BuildInfo) GetBuilder() string {
+	if x != nil {
+		return x.Builder
+	}
+	return ""
+}
+
+func (x *BuildInfo) GetSettings() map[string]string {
+	if x != nil {
+		return x.Settings
+	}
+	return nil
+}
+
+func (x *BuildInfo) GetDeps() []*ModuleVersion {
+	if x != nil {
+		return x.Deps
+	}
+	return nil
+}
+
+func (x *BuildInfo) GetDigest() string {
+	if x != nil {
+		return x.Digest
+	}
+	return ""
+}
+
+// ModuleVersion is a Go module linked into a binary
+type ModuleVersion struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	Path    string                 `protobuf:"bytes,1,opt,name=path,proto3" json:"path,omitempty"`
+	Version string                 `protobuf:"bytes,2,opt,name=version,proto3" json:"version,omitempty"`
+	// Checksum of the module, as in go.sum
+	Sum           string `protobuf:"bytes,3,opt,name=sum,proto3" json:"sum,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ModuleVersion) Reset() {
+	*x = ModuleVersion{}
+	mi := &file_evnode_v1_config_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ModuleVersion) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ModuleVersion) ProtoMessage() {}
+
+func (x *ModuleVersion) ProtoReflect() protoreflect.Message {
+	mi := &file_evnode_v1_config_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ModuleVersion.ProtoReflect.Descriptor instead.
+func (*ModuleVersion) Descriptor() ([]byte, []int) {
+	return file_evnode_v1_config_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *ModuleVersion) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+func (x *ModuleVersion) GetVersion() string {
+	if x != nil {
+		return x.Version
+	}
+	return ""
+}
+
+func (x *ModuleVersion) GetSum() string {
+	if x != nil {
+		return x.Sum
+	}
+	return ""
+}
+
+// GetGenesisResponse is the genesis document of the network of a node
+type GetGenesisResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// JSON genesis document, as loaded by the node
+	Genesis []byte `protobuf:"bytes,1,opt,name=genesis,proto3" json:"genesis,omitempty"`
+	ChainId string `protobuf:"bytes,2,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	// SHA-256 hash of the document
+	Hash          []byte `protobuf:"bytes,3,opt,name=hash,proto3" json:"hash,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetGenesisResponse) Reset() {
+	*x = GetGenesisResponse{}
+	mi := &file_evnode_v1_config_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetGenesisResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetGenesisResponse) ProtoMessage() {}
+
+func (x *GetGenesisResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_evnode_v1_config_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetGenesisResponse.ProtoReflect.Descriptor instead.
+func (*GetGenesisResponse) Descriptor() ([]byte, []int) {
+	return file_evnode_v1_config_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *GetGenesisResponse) GetGenesis() []byte {
+	if x != nil {
+		return x.Genesis
+	}
+	return nil
+}
+
+func (x *GetGenesisResponse) GetChainId() string {
+	if x != nil {
+		return x.ChainId
+	}
+	return ""
+}
+
+func (x *GetGenesisResponse) GetHash() []byte {
+	if x != nil {
+		return x.Hash
+	}
+	return nil
+}
+
 var File_evnode_v1_config_proto protoreflect.FileDescriptor
 
 const file_evnode_v1_config_proto_rawDesc = "" +
 	"\n" +
-	"\x16evnode/v1/config.proto\x12\tevnode.v1\x1a\x1bgoogle/protobuf/empty.proto\x1a\x16evnode/v1/evnode.proto\x1a\x15evnode/v1/state.proto\"h\n" +
+	"\x16evnode/v1/config.proto\x12\tevnode.v1\x1a\x1bgoogle/protobuf/empty.proto\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x16evnode/v1/evnode.proto\x1a\x15evnode/v1/state.proto\"h\n" +
 	"\x14GetNamespaceResponse\x12)\n" +
 	"\x10header_namespace\x18\x01 \x01(\tR\x0fheaderNamespace\x12%\n" +
-	"\x0edata_namespace\x18\x02 \x01(\tR\rdataNamespace2Z\n" +
-	"\rConfigService\x12I\n" +
-	"\fGetNamespace\x12\x16.google.protobuf.Empty\x1a\x1f.evnode.v1.GetNamespaceResponse\"\x00B/Z-github.com/evstack/ev-node/types/pb/evnode/v1b\x06proto3"
+	"\x0edata_namespace\x18\x02 \x01(\tR\rdataNamespace\"/\n" +
+	"\x15ValidateConfigRequest\x12\x16\n" +
+	"\x06config\x18\x01 \x01(\fR\x06config\"F\n" +
+	"\x16ValidateConfigResponse\x12\x14\n" +
+	"\x05valid\x18\x01 \x01(\bR\x05valid\x12\x16\n" +
+	"\x06errors\x18\x02 \x03(\tR\x06errors\"\xb7\x02\n" +
+	"\x13GetNodeInfoResponse\x12\x18\n" +
+	"\aversion\x18\x01 \x01(\tR\aversion\x12\x1d\n" +
+	"\n" +
+	"git_commit\x18\x02 \x01(\tR\tgitCommit\x12\x19\n" +
+	"\bchain_id\x18\x03 \x01(\tR\achainId\x12\x12\n" +
+	"\x04mode\x18\x04 \x01(\tR\x04mode\x12)\n" +
+	"\x10execution_client\x18\x05 \x01(\tR\x0fexecutionClient\x12\x1d\n" +
+	"\n" +
+	"da_backend\x18\x06 \x01(\tR\tdaBackend\x129\n" +
+	"\n" +
+	"start_time\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\tstartTime\x123\n" +
+	"\n" +
+	"build_info\x18\b \x01(\v2\x14.evnode.v1.BuildInfoR\tbuildInfo\"\x96\x03\n" +
+	"\tBuildInfo\x12\x1d\n" +
+	"\n" +
+	"go_version\x18\x01 \x01(\tR\tgoVersion\x12,\n" +
+	"\x04main\x18\x02 \x01(\v2\x18.evnode.v1.ModuleVersionR\x04main\x12!\n" +
+	"\fvcs_revision\x18\x03 \x01(\tR\vvcsRevision\x12\x19\n" +
+	"\bvcs_time\x18\x04 \x01(\tR\avcsTime\x12!\n" +
+	"\fvcs_modified\x18\x05 \x01(\bR\vvcsModified\x12\x18\n" +
+	"\abuilder\x18\x06 \x01(\tR\abuilder\x12>\n" +
+	"\bsettings\x18\a \x03(\v2\".evnode.v1.BuildInfo.SettingsEntryR\bsettings\x12,\n" +
+	"\x04deps\x18\b \x03(\v2\x18.evnode.v1.ModuleVersionR\x04deps\x12\x16\n" +
+	"\x06digest\x18\t \x01(\tR\x06digest\x1a;\n" +
+	"\rSettingsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"O\n" +
+	"\rModuleVersion\x12\x12\n" +
+	"\x04path\x18\x01 \x01(\tR\x04path\x12\x18\n" +
+	"\aversion\x18\x02 \x01(\tR\aversion\x12\x10\n" +
+	"\x03sum\x18\x03 \x01(\tR\x03sum\"]\n" +
+	"\x12GetGenesisResponse\x12\x18\n" +
+	"\agenesis\x18\x01 \x01(\fR\agenesis\x12\x19\n" +
+	"\bchain_id\x18\x02 \x01(\tR\achainId\x12\x12\n" +
+	"\x04hash\x18\x03 \x01(\fR\x04hash2\xcf\x02\n" +
+	"\rConfigService\x12L\n" +
+	"\fGetNamespace\x12\x16.google.protobuf.Empty\x1a\x1f.evnode.v1.GetNamespaceResponse\"\x03\x90\x02\x01\x12Z\n" +
+	"\x0eValidateConfig\x12 .evnode.v1.ValidateConfigRequest\x1a!.evnode.v1.ValidateConfigResponse\"\x03\x90\x02\x01\x12J\n" +
+	"\vGetNodeInfo\x12\x16.google.protobuf.Empty\x1a\x1e.evnode.v1.GetNodeInfoResponse\"\x03\x90\x02\x01\x12H\n" +
+	"\n" +
+	"GetGenesis\x12\x16.google.protobuf.Empty\x1a\x1d.evnode.v1.GetGenesisResponse\"\x03\x90\x02\x01B/Z-github.com/evstack/ev-node/types/pb/evnode/v1b\x06proto3"
 
 var (
 	file_evnode_v1_config_proto_rawDescOnce sync.Once
@@ -98,19 +594,38 @@ func file_evnode_v1_config_proto_rawDescGZIP() []byte {
 	return file_evnode_v1_config_proto_rawDescData
 }
 
-var file_evnode_v1_config_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
+var file_evnode_v1_config_proto_msgTypes = make([]protoimpl.MessageInfo, 8)
 var file_evnode_v1_config_proto_goTypes = []any{
-	(*GetNamespaceResponse)(nil), // 0: evnode.v1.GetNamespaceResponse
-	(*emptypb.Empty)(nil),        // 1: google.protobuf.Empty
+	(*GetNamespaceResponse)(nil),   // 0: evnode.v1.GetNamespaceResponse
+	(*ValidateConfigRequest)(nil),  // 1: evnode.v1.ValidateConfigRequest
+	(*ValidateConfigResponse)(nil), // 2: evnode.v1.ValidateConfigResponse
+	(*GetNodeInfoResponse)(nil),    // 3: evnode.v1.GetNodeInfoResponse
+	(*BuildInfo)(nil),              // 4: evnode.v1.BuildInfo
+	(*ModuleVersion)(nil),          // 5: evnode.v1.ModuleVersion
+	(*GetGenesisResponse)(nil),     // 6: evnode.v1.GetGenesisResponse
+	nil,                            // 7: evnode.v1.BuildInfo.SettingsEntry
+	(*timestamppb.Timestamp)(nil),  // 8: google.protobuf.Timestamp
+	(*emptypb.Empty)(nil),          // 9: google.protobuf.Empty
 }
 var file_evnode_v1_config_proto_depIdxs = []int32{
-	1, // 0: evnode.v1.ConfigService.GetNamespace:input_type -> google.protobuf.Empty
-	0, // 1: evnode.v1.ConfigService.GetNamespace:output_type -> evnode.v1.GetNamespaceResponse
-	1, // [1:2] is the sub-list for method output_type
-	0, // [0:1] is the sub-list for method input_type
-	0, // [0:0] is the sub-list for extension type_name
-	0, // [0:0] is the sub-list for extension extendee
-	0, // [0:0] is the sub-list for field type_name
+	8, // 0: evnode.v1.GetNodeInfoResponse.start_time:type_name -> google.protobuf.Timestamp
+	4, // 1: evnode.v1.GetNodeInfoResponse.build_info:type_name -> evnode.v1.BuildInfo
+	5, // 2: evnode.v1.BuildInfo.main:type_name -> evnode.v1.ModuleVersion
+	7, // 3: evnode.v1.BuildInfo.settings:type_name -> evnode.v1.BuildInfo.SettingsEntry
+	5, // 4: evnode.v1.BuildInfo.deps:type_name -> evnode.v1.ModuleVersion
+	9, // 5: evnode.v1.ConfigService.GetNamespace:input_type -> google.protobuf.Empty
+	1, // 6: evnode.v1.ConfigService.ValidateConfig:input_type -> evnode.v1.ValidateConfigRequest
+	9, // 7: evnode.v1.ConfigService.GetNodeInfo:input_type -> google.protobuf.Empty
+	9, // 8: evnode.v1.ConfigService.GetGenesis:input_type -> google.protobuf.Empty
+	0, // 9: evnode.v1.ConfigService.GetNamespace:output_type -> evnode.v1.GetNamespaceResponse
+	2, // 10: evnode.v1.ConfigService.ValidateConfig:output_type -> evnode.v1.ValidateConfigResponse
+	3, // 11: evnode.v1.ConfigService.GetNodeInfo:output_type -> evnode.v1.GetNodeInfoResponse
+	6, // 12: evnode.v1.ConfigService.GetGenesis:output_type -> evnode.v1.GetGenesisResponse
+	9, // [9:13] is the sub-list for method output_type
+	5, // [5:9] is the sub-list for method input_type
+	5, // [5:5] is the sub-list for extension type_name
+	5, // [5:5] is the sub-list for extension extendee
+	0, // [0:5] is the sub-list for field type_name
 }
 
 func init() { file_evnode_v1_config_proto_init() }
@@ -126,7 +641,7 @@ func file_evnode_v1_config_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_evnode_v1_config_proto_rawDesc), len(file_evnode_v1_config_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   1,
+			NumMessages:   8,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -0,0 +1,214 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.7
+// 	protoc        (unknown)
+// source: evnode/v1/errors.proto
+
+package v1
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// ErrorReason is the machine-readable reason of the failure of an RPC. It refines the code of the
+// error, so that clients can branch on it without parsing error messages.
+type ErrorReason int32
+
+const (
+	ErrorReason_ERROR_REASON_UNSPECIFIED ErrorReason = 0
+	// The requested block is not in the store of the node
+	ErrorReason_ERROR_REASON_BLOCK_NOT_FOUND ErrorReason = 1
+	// The data of the requested block was pruned from the store of the node
+	ErrorReason_ERROR_REASON_PRUNED ErrorReason = 2
+	// A value read from the store of the node cannot be decoded
+	ErrorReason_ERROR_REASON_STORE_CORRUPTED ErrorReason = 3
+	// The DA layer of the node cannot be reached
+	ErrorReason_ERROR_REASON_DA_UNAVAILABLE ErrorReason = 4
+	// The requested block exists on the network but the node has not synced it yet
+	ErrorReason_ERROR_REASON_SYNCING ErrorReason = 5
+	// The P2P network of the node is not available, e.g. it syncs from the DA layer only
+	ErrorReason_ERROR_REASON_P2P_UNAVAILABLE ErrorReason = 6
+)
+
+// Enum value maps for ErrorReason.
+var (
+	ErrorReason_name = map[int32]string{
+		0: "ERROR_REASON_UNSPECIFIED",
+		1: "ERROR_REASON_BLOCK_NOT_FOUND",
+		2: "ERROR_REASON_PRUNED",
+		3: "ERROR_REASON_STORE_CORRUPTED",
+		4: "ERROR_REASON_DA_UNAVAILABLE",
+		5: "ERROR_REASON_SYNCING",
+		6: "ERROR_REASON_P2P_UNAVAILABLE",
+	}
+	ErrorReason_value = map[string]int32{
+		"ERROR_REASON_UNSPECIFIED":     0,
+		"ERROR_REASON_BLOCK_NOT_FOUND": 1,
+		"ERROR_REASON_PRUNED":          2,
+		"ERROR_REASON_STORE_CORRUPTED": 3,
+		"ERROR_REASON_DA_UNAVAILABLE":  4,
+		"ERROR_REASON_SYNCING":         5,
+		"ERROR_REASON_P2P_UNAVAILABLE": 6,
+	}
+)
+
+func (x ErrorReason) Enum() *ErrorReason {
+	p := new(ErrorReason)
+	*p = x
+	return p
+}
+
+func (x ErrorReason) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ErrorReason) Descriptor() protoreflect.EnumDescriptor {
+	return file_evnode_v1_errors_proto_enumTypes[0].Descriptor()
+}
+
+func (ErrorReason) Type() protoreflect.EnumType {
+	return &file_evnode_v1_errors_proto_enumTypes[0]
+}
+
+func (x ErrorReason) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ErrorReason.Descriptor instead.
+func (ErrorReason) EnumDescriptor() ([]byte, []int) {
+	return file_evnode_v1_errors_proto_rawDescGZIP(), []int{0}
+}
+
+// ErrorDetail is attached to the errors of failed RPCs as a Connect error detail.
+type ErrorDetail struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	Reason ErrorReason            `protobuf:"varint,1,opt,name=reason,proto3,enum=evnode.v1.ErrorReason" json:"reason,omitempty"`
+	// Height of the block the failure relates to, 0 if none
+	Height        uint64 `protobuf:"varint,2,opt,name=height,proto3" json:"height,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ErrorDetail) Reset() {
+	*x = ErrorDetail{}
+	mi := &file_evnode_v1_errors_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ErrorDetail) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ErrorDetail) ProtoMessage() {}
+
+func (x *ErrorDetail) ProtoReflect() protoreflect.Message {
+	mi := &file_evnode_v1_errors_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ErrorDetail.ProtoReflect.Descriptor instead.
+func (*ErrorDetail) Descriptor() ([]byte, []int) {
+	return file_evnode_v1_errors_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *ErrorDetail) GetReason() ErrorReason {
+	if x != nil {
+		return x.Reason
+	}
+	return ErrorReason_ERROR_REASON_UNSPECIFIED
+}
+
+func (x *ErrorDetail) GetHeight() uint64 {
+	if x != nil {
+		return x.Height
+	}
+	return 0
+}
+
+var File_evnode_v1_errors_proto protoreflect.FileDescriptor
+
+const file_evnode_v1_errors_proto_rawDesc = "" +
+	"\n" +
+	"\x16evnode/v1/errors.proto\x12\tevnode.v1\"U\n" +
+	"\vErrorDetail\x12.\n" +
+	"\x06reason\x18\x01 \x01(\x0e2\x16.evnode.v1.ErrorReasonR\x06reason\x12\x16\n" +
+	"\x06height\x18\x02 \x01(\x04R\x06height*\xe5\x01\n" +
+	"\vErrorReason\x12\x1c\n" +
+	"\x18ERROR_REASON_UNSPECIFIED\x10\x00\x12 \n" +
+	"\x1cERROR_REASON_BLOCK_NOT_FOUND\x10\x01\x12\x17\n" +
+	"\x13ERROR_REASON_PRUNED\x10\x02\x12 \n" +
+	"\x1cERROR_REASON_STORE_CORRUPTED\x10\x03\x12\x1f\n" +
+	"\x1bERROR_REASON_DA_UNAVAILABLE\x10\x04\x12\x18\n" +
+	"\x14ERROR_REASON_SYNCING\x10\x05\x12 \n" +
+	"\x1cERROR_REASON_P2P_UNAVAILABLE\x10\x06B/Z-github.com/evstack/ev-node/types/pb/evnode/v1b\x06proto3"
+
+var (
+	file_evnode_v1_errors_proto_rawDescOnce sync.Once
+	file_evnode_v1_errors_proto_rawDescData []byte
+)
+
+func file_evnode_v1_errors_proto_rawDescGZIP() []byte {
+	file_evnode_v1_errors_proto_rawDescOnce.Do(func() {
+		file_evnode_v1_errors_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_evnode_v1_errors_proto_rawDesc), len(file_evnode_v1_errors_proto_rawDesc)))
+	})
+	return file_evnode_v1_errors_proto_rawDescData
+}
+
+var file_evnode_v1_errors_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_evnode_v1_errors_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
+var file_evnode_v1_errors_proto_goTypes = []any{
+	(ErrorReason)(0),    // 0: evnode.v1.ErrorReason
+	(*ErrorDetail)(nil), // 1: evnode.v1.ErrorDetail
+}
+var file_evnode_v1_errors_proto_depIdxs = []int32{
+	0, // 0: evnode.v1.ErrorDetail.reason:type_name -> evnode.v1.ErrorReason
+	1, // [1:1] is the sub-list for method output_type
+	1, // [1:1] is the sub-list for method input_type
+	1, // [1:1] is the sub-list for extension type_name
+	1, // [1:1] is the sub-list for extension extendee
+	0, // [0:1] is the sub-list for field type_name
+}
+
+func init() { file_evnode_v1_errors_proto_init() }
+func file_evnode_v1_errors_proto_init() {
+	if File_evnode_v1_errors_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_evnode_v1_errors_proto_rawDesc), len(file_evnode_v1_errors_proto_rawDesc)),
+			NumEnums:      1,
+			NumMessages:   1,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_evnode_v1_errors_proto_goTypes,
+		DependencyIndexes: file_evnode_v1_errors_proto_depIdxs,
+		EnumInfos:         file_evnode_v1_errors_proto_enumTypes,
+		MessageInfos:      file_evnode_v1_errors_proto_msgTypes,
+	}.Build()
+	File_evnode_v1_errors_proto = out.File
+	file_evnode_v1_errors_proto_goTypes = nil
+	file_evnode_v1_errors_proto_depIdxs = nil
+}
@@ -614,6 +614,146 @@ func (x *Vote) GetValidatorAddress() []byte {
 	return nil
 }
 
+// StoredData is the data of a block as persisted by the store when some of its transactions
+// recur across blocks: recurring transactions are replaced by the hash of a content-addressed
+// chunk, so that they are stored once.
+type StoredData struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Metadata      *Metadata              `protobuf:"bytes,1,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	Txs           []*StoredTx            `protobuf:"bytes,2,rep,name=txs,proto3" json:"txs,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StoredData) Reset() {
+	*x = StoredData{}
+	mi := &file_evnode_v1_evnode_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StoredData) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StoredData) ProtoMessage() {}
+
+func (x *StoredData) ProtoReflect() protoreflect.Message {
+	mi := &file_evnode_v1_evnode_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StoredData.ProtoReflect.Descriptor instead.
+func (*StoredData) Descriptor() ([]byte, []int) {
+	return file_evnode_v1_evnode_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *StoredData) GetMetadata() *Metadata {
+	if x != nil {
+		return x.Metadata
+	}
+	return nil
+}
+
+func (x *StoredData) GetTxs() []*StoredTx {
+	if x != nil {
+		return x.Txs
+	}
+	return nil
+}
+
+// StoredTx is a transaction of StoredData.
+type StoredTx struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Types that are valid to be assigned to Tx:
+	//
+	//	*StoredTx_Raw
+	//	*StoredTx_Chunk
+	Tx            isStoredTx_Tx `protobuf_oneof:"tx"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StoredTx) Reset() {
+	*x = StoredTx{}
+	mi := &file_evnode_v1_evnode_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StoredTx) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StoredTx) ProtoMessage() {}
+
+func (x *StoredTx) ProtoReflect() protoreflect.Message {
+	mi := &file_evnode_v1_evnode_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StoredTx.ProtoReflect.Descriptor instead.
+func (*StoredTx) Descriptor() ([]byte, []int) {
+	return file_evnode_v1_evnode_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *StoredTx) GetTx() isStoredTx_Tx {
+	if x != nil {
+		return x.Tx
+	}
+	return nil
+}
+
+func (x *StoredTx) GetRaw() []byte {
+	if x != nil {
+		if x, ok := x.Tx.(*StoredTx_Raw); ok {
+			return x.Raw
+		}
+	}
+	return nil
+}
+
+func (x *StoredTx) GetChunk() []byte {
+	if x != nil {
+		if x, ok := x.Tx.(*StoredTx_Chunk); ok {
+			return x.Chunk
+		}
+	}
+	return nil
+}
+
+type isStoredTx_Tx interface {
+	isStoredTx_Tx()
+}
+
+type StoredTx_Raw struct {
+	// Raw transaction
+	Raw []byte `protobuf:"bytes,1,opt,name=raw,proto3,oneof"`
+}
+
+type StoredTx_Chunk struct {
+	// SHA-256 hash of the chunk holding the transaction
+	Chunk []byte `protobuf:"bytes,2,opt,name=chunk,proto3,oneof"`
+}
+
+func (*StoredTx_Raw) isStoredTx_Tx() {}
+
+func (*StoredTx_Chunk) isStoredTx_Tx() {}
+
 var File_evnode_v1_evnode_proto protoreflect.FileDescriptor
 
 const file_evnode_v1_evnode_proto_rawDesc = "" +
@@ -661,7 +801,15 @@ const file_evnode_v1_evnode_proto_rawDesc = "" +
 	"\x06height\x18\x02 \x01(\x04R\x06height\x128\n" +
 	"\ttimestamp\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\ttimestamp\x12\"\n" +
 	"\rblock_id_hash\x18\x04 \x01(\fR\vblockIdHash\x12+\n" +
-	"\x11validator_address\x18\x05 \x01(\fR\x10validatorAddressB/Z-github.com/evstack/ev-node/types/pb/evnode/v1b\x06proto3"
+	"\x11validator_address\x18\x05 \x01(\fR\x10validatorAddress\"d\n" +
+	"\n" +
+	"StoredData\x12/\n" +
+	"\bmetadata\x18\x01 \x01(\v2\x13.evnode.v1.MetadataR\bmetadata\x12%\n" +
+	"\x03txs\x18\x02 \x03(\v2\x13.evnode.v1.StoredTxR\x03txs\"<\n" +
+	"\bStoredTx\x12\x12\n" +
+	"\x03raw\x18\x01 \x01(\fH\x00R\x03raw\x12\x16\n" +
+	"\x05chunk\x18\x02 \x01(\fH\x00R\x05chunkB\x04\n" +
+	"\x02txB/Z-github.com/evstack/ev-node/types/pb/evnode/v1b\x06proto3"
 
 var (
 	file_evnode_v1_evnode_proto_rawDescOnce sync.Once
@@ -675,7 +823,7 @@ func file_evnode_v1_evnode_proto_rawDescGZIP() []byte {
 	return file_evnode_v1_evnode_proto_rawDescData
 }
 
-var file_evnode_v1_evnode_proto_msgTypes = make([]protoimpl.MessageInfo, 8)
+var file_evnode_v1_evnode_proto_msgTypes = make([]protoimpl.MessageInfo, 10)
 var file_evnode_v1_evnode_proto_goTypes = []any{
 	(*Version)(nil),               // 0: evnode.v1.Version
 	(*Header)(nil),                // 1: evnode.v1.Header
@@ -685,21 +833,25 @@ var file_evnode_v1_evnode_proto_goTypes = []any{
 	(*Data)(nil),                  // 5: evnode.v1.Data
 	(*SignedData)(nil),            // 6: evnode.v1.SignedData
 	(*Vote)(nil),                  // 7: evnode.v1.Vote
-	(*timestamppb.Timestamp)(nil), // 8: google.protobuf.Timestamp
+	(*StoredData)(nil),            // 8: evnode.v1.StoredData
+	(*StoredTx)(nil),              // 9: evnode.v1.StoredTx
+	(*timestamppb.Timestamp)(nil), // 10: google.protobuf.Timestamp
 }
 var file_evnode_v1_evnode_proto_depIdxs = []int32{
-	0, // 0: evnode.v1.Header.version:type_name -> evnode.v1.Version
-	1, // 1: evnode.v1.SignedHeader.header:type_name -> evnode.v1.Header
-	3, // 2: evnode.v1.SignedHeader.signer:type_name -> evnode.v1.Signer
-	4, // 3: evnode.v1.Data.metadata:type_name -> evnode.v1.Metadata
-	5, // 4: evnode.v1.SignedData.data:type_name -> evnode.v1.Data
-	3, // 5: evnode.v1.SignedData.signer:type_name -> evnode.v1.Signer
-	8, // 6: evnode.v1.Vote.timestamp:type_name -> google.protobuf.Timestamp
-	7, // [7:7] is the sub-list for method output_type
-	7, // [7:7] is the sub-list for method input_type
-	7, // [7:7] is the sub-list for extension type_name
-	7, // [7:7] is the sub-list for extension extendee
-	0, // [0:7] is the sub-list for field type_name
+	0,  // 0: evnode.v1.Header.version:type_name -> evnode.v1.Version
+	1,  // 1: evnode.v1.SignedHeader.header:type_name -> evnode.v1.Header
+	3,  // 2: evnode.v1.SignedHeader.signer:type_name -> evnode.v1.Signer
+	4,  // 3: evnode.v1.Data.metadata:type_name -> evnode.v1.Metadata
+	5,  // 4: evnode.v1.SignedData.data:type_name -> evnode.v1.Data
+	3,  // 5: evnode.v1.SignedData.signer:type_name -> evnode.v1.Signer
+	10, // 6: evnode.v1.Vote.timestamp:type_name -> google.protobuf.Timestamp
+	4,  // 7: evnode.v1.StoredData.metadata:type_name -> evnode.v1.Metadata
+	9,  // 8: evnode.v1.StoredData.txs:type_name -> evnode.v1.StoredTx
+	9,  // [9:9] is the sub-list for method output_type
+	9,  // [9:9] is the sub-list for method input_type
+	9,  // [9:9] is the sub-list for extension type_name
+	9,  // [9:9] is the sub-list for extension extendee
+	0,  // [0:9] is the sub-list for field type_name
 }
 
 func init() { file_evnode_v1_evnode_proto_init() }
@@ -707,13 +859,17 @@ func file_evnode_v1_evnode_proto_init() {
 	if File_evnode_v1_evnode_proto != nil {
 		return
 	}
+	file_evnode_v1_evnode_proto_msgTypes[9].OneofWrappers = []any{
+		(*StoredTx_Raw)(nil),
+		(*StoredTx_Chunk)(nil),
+	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_evnode_v1_evnode_proto_rawDesc), len(file_evnode_v1_evnode_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   8,
+			NumMessages:   10,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
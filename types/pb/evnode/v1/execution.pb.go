@@ -435,6 +435,125 @@ func (*SetFinalResponse) Descriptor() ([]byte, []int) {
 	return file_evnode_v1_execution_proto_rawDescGZIP(), []int{7}
 }
 
+// SimulateTxsRequest contains transactions and block context for a simulated execution
+type SimulateTxsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Ordered list of transactions to execute
+	Txs [][]byte `protobuf:"bytes,1,rep,name=txs,proto3" json:"txs,omitempty"`
+	// Height of the block the transactions are executed in (must be > 0)
+	BlockHeight uint64 `protobuf:"varint,2,opt,name=block_height,json=blockHeight,proto3" json:"block_height,omitempty"`
+	// Block time in UTC
+	Timestamp *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	// State root the transactions are executed on top of
+	PrevStateRoot []byte `protobuf:"bytes,4,opt,name=prev_state_root,json=prevStateRoot,proto3" json:"prev_state_root,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SimulateTxsRequest) Reset() {
+	*x = SimulateTxsRequest{}
+	mi := &file_evnode_v1_execution_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SimulateTxsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SimulateTxsRequest) ProtoMessage() {}
+
+func (x *SimulateTxsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_evnode_v1_execution_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SimulateTxsRequest.ProtoReflect.Descriptor instead.
+func (*SimulateTxsRequest) Descriptor() ([]byte, []int) {
+	return file_evnode_v1_execution_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *SimulateTxsRequest) GetTxs() [][]byte {
+	if x != nil {
+		return x.Txs
+	}
+	return nil
+}
+
+func (x *SimulateTxsRequest) GetBlockHeight() uint64 {
+	if x != nil {
+		return x.BlockHeight
+	}
+	return 0
+}
+
+func (x *SimulateTxsRequest) GetTimestamp() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Timestamp
+	}
+	return nil
+}
+
+func (x *SimulateTxsRequest) GetPrevStateRoot() []byte {
+	if x != nil {
+		return x.PrevStateRoot
+	}
+	return nil
+}
+
+// SimulateTxsResponse contains the result of a simulated execution
+type SimulateTxsResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// State root after executing the transactions
+	StateRoot     []byte `protobuf:"bytes,1,opt,name=state_root,json=stateRoot,proto3" json:"state_root,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SimulateTxsResponse) Reset() {
+	*x = SimulateTxsResponse{}
+	mi := &file_evnode_v1_execution_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SimulateTxsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SimulateTxsResponse) ProtoMessage() {}
+
+func (x *SimulateTxsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_evnode_v1_execution_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SimulateTxsResponse.ProtoReflect.Descriptor instead.
+func (*SimulateTxsResponse) Descriptor() ([]byte, []int) {
+	return file_evnode_v1_execution_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *SimulateTxsResponse) GetStateRoot() []byte {
+	if x != nil {
+		return x.StateRoot
+	}
+	return nil
+}
+
 var File_evnode_v1_execution_proto protoreflect.FileDescriptor
 
 const file_evnode_v1_execution_proto_rawDesc = "" +
@@ -461,13 +580,22 @@ const file_evnode_v1_execution_proto_rawDesc = "" +
 	"\tmax_bytes\x18\x02 \x01(\x04R\bmaxBytes\"4\n" +
 	"\x0fSetFinalRequest\x12!\n" +
 	"\fblock_height\x18\x01 \x01(\x04R\vblockHeight\"\x12\n" +
-	"\x10SetFinalResponse2\xb0\x02\n" +
+	"\x10SetFinalResponse\"\xab\x01\n" +
+	"\x12SimulateTxsRequest\x12\x10\n" +
+	"\x03txs\x18\x01 \x03(\fR\x03txs\x12!\n" +
+	"\fblock_height\x18\x02 \x01(\x04R\vblockHeight\x128\n" +
+	"\ttimestamp\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\ttimestamp\x12&\n" +
+	"\x0fprev_state_root\x18\x04 \x01(\fR\rprevStateRoot\"4\n" +
+	"\x13SimulateTxsResponse\x12\x1d\n" +
+	"\n" +
+	"state_root\x18\x01 \x01(\fR\tstateRoot2\x80\x03\n" +
 	"\x0fExecutorService\x12H\n" +
 	"\tInitChain\x12\x1b.evnode.v1.InitChainRequest\x1a\x1c.evnode.v1.InitChainResponse\"\x00\x12?\n" +
 	"\x06GetTxs\x12\x18.evnode.v1.GetTxsRequest\x1a\x19.evnode.v1.GetTxsResponse\"\x00\x12K\n" +
 	"\n" +
 	"ExecuteTxs\x12\x1c.evnode.v1.ExecuteTxsRequest\x1a\x1d.evnode.v1.ExecuteTxsResponse\"\x00\x12E\n" +
-	"\bSetFinal\x12\x1a.evnode.v1.SetFinalRequest\x1a\x1b.evnode.v1.SetFinalResponse\"\x00B/Z-github.com/evstack/ev-node/types/pb/evnode/v1b\x06proto3"
+	"\bSetFinal\x12\x1a.evnode.v1.SetFinalRequest\x1a\x1b.evnode.v1.SetFinalResponse\"\x00\x12N\n" +
+	"\vSimulateTxs\x12\x1d.evnode.v1.SimulateTxsRequest\x1a\x1e.evnode.v1.SimulateTxsResponse\"\x00B/Z-github.com/evstack/ev-node/types/pb/evnode/v1b\x06proto3"
 
 var (
 	file_evnode_v1_execution_proto_rawDescOnce sync.Once
@@ -481,7 +609,7 @@ func file_evnode_v1_execution_proto_rawDescGZIP() []byte {
 	return file_evnode_v1_execution_proto_rawDescData
 }
 
-var file_evnode_v1_execution_proto_msgTypes = make([]protoimpl.MessageInfo, 8)
+var file_evnode_v1_execution_proto_msgTypes = make([]protoimpl.MessageInfo, 10)
 var file_evnode_v1_execution_proto_goTypes = []any{
 	(*InitChainRequest)(nil),      // 0: evnode.v1.InitChainRequest
 	(*InitChainResponse)(nil),     // 1: evnode.v1.InitChainResponse
@@ -491,24 +619,29 @@ var file_evnode_v1_execution_proto_goTypes = []any{
 	(*ExecuteTxsResponse)(nil),    // 5: evnode.v1.ExecuteTxsResponse
 	(*SetFinalRequest)(nil),       // 6: evnode.v1.SetFinalRequest
 	(*SetFinalResponse)(nil),      // 7: evnode.v1.SetFinalResponse
-	(*timestamppb.Timestamp)(nil), // 8: google.protobuf.Timestamp
+	(*SimulateTxsRequest)(nil),    // 8: evnode.v1.SimulateTxsRequest
+	(*SimulateTxsResponse)(nil),   // 9: evnode.v1.SimulateTxsResponse
+	(*timestamppb.Timestamp)(nil), // 10: google.protobuf.Timestamp
 }
 var file_evnode_v1_execution_proto_depIdxs = []int32{
-	8, // 0: evnode.v1.InitChainRequest.genesis_time:type_name -> google.protobuf.Timestamp
-	8, // 1: evnode.v1.ExecuteTxsRequest.timestamp:type_name -> google.protobuf.Timestamp
-	0, // 2: evnode.v1.ExecutorService.InitChain:input_type -> evnode.v1.InitChainRequest
-	2, // 3: evnode.v1.ExecutorService.GetTxs:input_type -> evnode.v1.GetTxsRequest
-	4, // 4: evnode.v1.ExecutorService.ExecuteTxs:input_type -> evnode.v1.ExecuteTxsRequest
-	6, // 5: evnode.v1.ExecutorService.SetFinal:input_type -> evnode.v1.SetFinalRequest
-	1, // 6: evnode.v1.ExecutorService.InitChain:output_type -> evnode.v1.InitChainResponse
-	3, // 7: evnode.v1.ExecutorService.GetTxs:output_type -> evnode.v1.GetTxsResponse
-	5, // 8: evnode.v1.ExecutorService.ExecuteTxs:output_type -> evnode.v1.ExecuteTxsResponse
-	7, // 9: evnode.v1.ExecutorService.SetFinal:output_type -> evnode.v1.SetFinalResponse
-	6, // [6:10] is the sub-list for method output_type
-	2, // [2:6] is the sub-list for method input_type
-	2, // [2:2] is the sub-list for extension type_name
-	2, // [2:2] is the sub-list for extension extendee
-	0, // [0:2] is the sub-list for field type_name
+	10, // 0: evnode.v1.InitChainRequest.genesis_time:type_name -> google.protobuf.Timestamp
+	10, // 1: evnode.v1.ExecuteTxsRequest.timestamp:type_name -> google.protobuf.Timestamp
+	10, // 2: evnode.v1.SimulateTxsRequest.timestamp:type_name -> google.protobuf.Timestamp
+	0,  // 3: evnode.v1.ExecutorService.InitChain:input_type -> evnode.v1.InitChainRequest
+	2,  // 4: evnode.v1.ExecutorService.GetTxs:input_type -> evnode.v1.GetTxsRequest
+	4,  // 5: evnode.v1.ExecutorService.ExecuteTxs:input_type -> evnode.v1.ExecuteTxsRequest
+	6,  // 6: evnode.v1.ExecutorService.SetFinal:input_type -> evnode.v1.SetFinalRequest
+	8,  // 7: evnode.v1.ExecutorService.SimulateTxs:input_type -> evnode.v1.SimulateTxsRequest
+	1,  // 8: evnode.v1.ExecutorService.InitChain:output_type -> evnode.v1.InitChainResponse
+	3,  // 9: evnode.v1.ExecutorService.GetTxs:output_type -> evnode.v1.GetTxsResponse
+	5,  // 10: evnode.v1.ExecutorService.ExecuteTxs:output_type -> evnode.v1.ExecuteTxsResponse
+	7,  // 11: evnode.v1.ExecutorService.SetFinal:output_type -> evnode.v1.SetFinalResponse
+	9,  // 12: evnode.v1.ExecutorService.SimulateTxs:output_type -> evnode.v1.SimulateTxsResponse
+	8,  // [8:13] is the sub-list for method output_type
+	3,  // [3:8] is the sub-list for method input_type
+	3,  // [3:3] is the sub-list for extension type_name
+	3,  // [3:3] is the sub-list for extension extendee
+	0,  // [0:3] is the sub-list for field type_name
 }
 
 func init() { file_evnode_v1_execution_proto_init() }
@@ -522,7 +655,7 @@ func file_evnode_v1_execution_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_evnode_v1_execution_proto_rawDesc), len(file_evnode_v1_execution_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   8,
+			NumMessages:   10,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
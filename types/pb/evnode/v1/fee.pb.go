@@ -0,0 +1,249 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.7
+// 	protoc        (unknown)
+// source: evnode/v1/fee.proto
+
+package v1
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// EstimateTxFeeRequest defines the request for estimating the fee of a transaction
+type EstimateTxFeeRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Raw transaction bytes as they would be submitted to the execution layer
+	Tx            []byte `protobuf:"bytes,1,opt,name=tx,proto3" json:"tx,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EstimateTxFeeRequest) Reset() {
+	*x = EstimateTxFeeRequest{}
+	mi := &file_evnode_v1_fee_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EstimateTxFeeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EstimateTxFeeRequest) ProtoMessage() {}
+
+func (x *EstimateTxFeeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_evnode_v1_fee_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EstimateTxFeeRequest.ProtoReflect.Descriptor instead.
+func (*EstimateTxFeeRequest) Descriptor() ([]byte, []int) {
+	return file_evnode_v1_fee_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *EstimateTxFeeRequest) GetTx() []byte {
+	if x != nil {
+		return x.Tx
+	}
+	return nil
+}
+
+// EstimateTxFeeResponse defines the estimated cost of a transaction
+type EstimateTxFeeResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Gas units the execution layer expects the transaction to consume
+	ExecutionGas uint64 `protobuf:"varint,1,opt,name=execution_gas,json=executionGas,proto3" json:"execution_gas,omitempty"`
+	// Execution gas price reported by the execution layer
+	ExecutionGasPrice float64 `protobuf:"fixed64,2,opt,name=execution_gas_price,json=executionGasPrice,proto3" json:"execution_gas_price,omitempty"`
+	// execution_gas * execution_gas_price
+	ExecutionFee float64 `protobuf:"fixed64,3,opt,name=execution_fee,json=executionFee,proto3" json:"execution_fee,omitempty"`
+	// Size of the raw transaction in bytes
+	TxSize uint64 `protobuf:"varint,4,opt,name=tx_size,json=txSize,proto3" json:"tx_size,omitempty"`
+	// Size of the compressed transaction in bytes, used to project the DA share
+	CompressedSize uint64 `protobuf:"varint,5,opt,name=compressed_size,json=compressedSize,proto3" json:"compressed_size,omitempty"`
+	// Current DA gas price per byte
+	DaGasPrice float64 `protobuf:"fixed64,6,opt,name=da_gas_price,json=daGasPrice,proto3" json:"da_gas_price,omitempty"`
+	// compressed_size * da_gas_price
+	DaFee float64 `protobuf:"fixed64,7,opt,name=da_fee,json=daFee,proto3" json:"da_fee,omitempty"`
+	// execution_fee + da_fee
+	TotalFee      float64 `protobuf:"fixed64,8,opt,name=total_fee,json=totalFee,proto3" json:"total_fee,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EstimateTxFeeResponse) Reset() {
+	*x = EstimateTxFeeResponse{}
+	mi := &file_evnode_v1_fee_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EstimateTxFeeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EstimateTxFeeResponse) ProtoMessage() {}
+
+func (x *EstimateTxFeeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_evnode_v1_fee_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EstimateTxFeeResponse.ProtoReflect.Descriptor instead.
+func (*EstimateTxFeeResponse) Descriptor() ([]byte, []int) {
+	return file_evnode_v1_fee_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *EstimateTxFeeResponse) GetExecutionGas() uint64 {
+	if x != nil {
+		return x.ExecutionGas
+	}
+	return 0
+}
+
+func (x *EstimateTxFeeResponse) GetExecutionGasPrice() float64 {
+	if x != nil {
+		return x.ExecutionGasPrice
+	}
+	return 0
+}
+
+func (x *EstimateTxFeeResponse) GetExecutionFee() float64 {
+	if x != nil {
+		return x.ExecutionFee
+	}
+	return 0
+}
+
+func (x *EstimateTxFeeResponse) GetTxSize() uint64 {
+	if x != nil {
+		return x.TxSize
+	}
+	return 0
+}
+
+func (x *EstimateTxFeeResponse) GetCompressedSize() uint64 {
+	if x != nil {
+		return x.CompressedSize
+	}
+	return 0
+}
+
+func (x *EstimateTxFeeResponse) GetDaGasPrice() float64 {
+	if x != nil {
+		return x.DaGasPrice
+	}
+	return 0
+}
+
+func (x *EstimateTxFeeResponse) GetDaFee() float64 {
+	if x != nil {
+		return x.DaFee
+	}
+	return 0
+}
+
+func (x *EstimateTxFeeResponse) GetTotalFee() float64 {
+	if x != nil {
+		return x.TotalFee
+	}
+	return 0
+}
+
+var File_evnode_v1_fee_proto protoreflect.FileDescriptor
+
+const file_evnode_v1_fee_proto_rawDesc = "" +
+	"\n" +
+	"\x13evnode/v1/fee.proto\x12\tevnode.v1\"&\n" +
+	"\x14EstimateTxFeeRequest\x12\x0e\n" +
+	"\x02tx\x18\x01 \x01(\fR\x02tx\"\xa9\x02\n" +
+	"\x15EstimateTxFeeResponse\x12#\n" +
+	"\rexecution_gas\x18\x01 \x01(\x04R\fexecutionGas\x12.\n" +
+	"\x13execution_gas_price\x18\x02 \x01(\x01R\x11executionGasPrice\x12#\n" +
+	"\rexecution_fee\x18\x03 \x01(\x01R\fexecutionFee\x12\x17\n" +
+	"\atx_size\x18\x04 \x01(\x04R\x06txSize\x12'\n" +
+	"\x0fcompressed_size\x18\x05 \x01(\x04R\x0ecompressedSize\x12 \n" +
+	"\fda_gas_price\x18\x06 \x01(\x01R\n" +
+	"daGasPrice\x12\x15\n" +
+	"\x06da_fee\x18\a \x01(\x01R\x05daFee\x12\x1b\n" +
+	"\ttotal_fee\x18\b \x01(\x01R\btotalFee2e\n" +
+	"\n" +
+	"FeeService\x12W\n" +
+	"\rEstimateTxFee\x12\x1f.evnode.v1.EstimateTxFeeRequest\x1a .evnode.v1.EstimateTxFeeResponse\"\x03\x90\x02\x01B/Z-github.com/evstack/ev-node/types/pb/evnode/v1b\x06proto3"
+
+var (
+	file_evnode_v1_fee_proto_rawDescOnce sync.Once
+	file_evnode_v1_fee_proto_rawDescData []byte
+)
+
+func file_evnode_v1_fee_proto_rawDescGZIP() []byte {
+	file_evnode_v1_fee_proto_rawDescOnce.Do(func() {
+		file_evnode_v1_fee_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_evnode_v1_fee_proto_rawDesc), len(file_evnode_v1_fee_proto_rawDesc)))
+	})
+	return file_evnode_v1_fee_proto_rawDescData
+}
+
+var file_evnode_v1_fee_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_evnode_v1_fee_proto_goTypes = []any{
+	(*EstimateTxFeeRequest)(nil),  // 0: evnode.v1.EstimateTxFeeRequest
+	(*EstimateTxFeeResponse)(nil), // 1: evnode.v1.EstimateTxFeeResponse
+}
+var file_evnode_v1_fee_proto_depIdxs = []int32{
+	0, // 0: evnode.v1.FeeService.EstimateTxFee:input_type -> evnode.v1.EstimateTxFeeRequest
+	1, // 1: evnode.v1.FeeService.EstimateTxFee:output_type -> evnode.v1.EstimateTxFeeResponse
+	1, // [1:2] is the sub-list for method output_type
+	0, // [0:1] is the sub-list for method input_type
+	0, // [0:0] is the sub-list for extension type_name
+	0, // [0:0] is the sub-list for extension extendee
+	0, // [0:0] is the sub-list for field type_name
+}
+
+func init() { file_evnode_v1_fee_proto_init() }
+func file_evnode_v1_fee_proto_init() {
+	if File_evnode_v1_fee_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_evnode_v1_fee_proto_rawDesc), len(file_evnode_v1_fee_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   2,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_evnode_v1_fee_proto_goTypes,
+		DependencyIndexes: file_evnode_v1_fee_proto_depIdxs,
+		MessageInfos:      file_evnode_v1_fee_proto_msgTypes,
+	}.Build()
+	File_evnode_v1_fee_proto = out.File
+	file_evnode_v1_fee_proto_goTypes = nil
+	file_evnode_v1_fee_proto_depIdxs = nil
+}
@@ -10,6 +10,7 @@ import (
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
 	emptypb "google.golang.org/protobuf/types/known/emptypb"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
 	reflect "reflect"
 	sync "sync"
 	unsafe "unsafe"
@@ -125,20 +126,430 @@ func (x *GetHealthResponse) GetStatus() HealthStatus {
 	return HealthStatus_UNKNOWN
 }
 
+// ReadinessCheck defines the result of a readiness check
+type ReadinessCheck struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Name of the check, e.g. store, p2p, da or signer
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// PASS if the check passed, otherwise WARN or FAIL depending on whether the check is critical
+	Status HealthStatus `protobuf:"varint,2,opt,name=status,proto3,enum=evnode.v1.HealthStatus" json:"status,omitempty"`
+	// Error of the failed check
+	Message       string `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReadinessCheck) Reset() {
+	*x = ReadinessCheck{}
+	mi := &file_evnode_v1_health_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReadinessCheck) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReadinessCheck) ProtoMessage() {}
+
+func (x *ReadinessCheck) ProtoReflect() protoreflect.Message {
+	mi := &file_evnode_v1_health_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReadinessCheck.ProtoReflect.Descriptor instead.
+func (*ReadinessCheck) Descriptor() ([]byte, []int) {
+	return file_evnode_v1_health_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *ReadinessCheck) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ReadinessCheck) GetStatus() HealthStatus {
+	if x != nil {
+		return x.Status
+	}
+	return HealthStatus_UNKNOWN
+}
+
+func (x *ReadinessCheck) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+// ReadyzResponse defines the response for retrieving the readiness of the node
+type ReadyzResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Overall readiness status
+	Status HealthStatus `protobuf:"varint,1,opt,name=status,proto3,enum=evnode.v1.HealthStatus" json:"status,omitempty"`
+	// Results of the readiness checks
+	Checks []*ReadinessCheck `protobuf:"bytes,2,rep,name=checks,proto3" json:"checks,omitempty"`
+	// Most recent error of every subsystem which reported one, for information only
+	Errors        []*SubsystemError `protobuf:"bytes,3,rep,name=errors,proto3" json:"errors,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReadyzResponse) Reset() {
+	*x = ReadyzResponse{}
+	mi := &file_evnode_v1_health_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReadyzResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReadyzResponse) ProtoMessage() {}
+
+func (x *ReadyzResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_evnode_v1_health_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReadyzResponse.ProtoReflect.Descriptor instead.
+func (*ReadyzResponse) Descriptor() ([]byte, []int) {
+	return file_evnode_v1_health_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *ReadyzResponse) GetStatus() HealthStatus {
+	if x != nil {
+		return x.Status
+	}
+	return HealthStatus_UNKNOWN
+}
+
+func (x *ReadyzResponse) GetChecks() []*ReadinessCheck {
+	if x != nil {
+		return x.Checks
+	}
+	return nil
+}
+
+func (x *ReadyzResponse) GetErrors() []*SubsystemError {
+	if x != nil {
+		return x.Errors
+	}
+	return nil
+}
+
+// Alert defines the state of an alert rule evaluated by the node
+type Alert struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Name of the rule
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// Human readable condition of the rule
+	Description string `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	// Whether the condition is currently met
+	Firing bool `protobuf:"varint,3,opt,name=firing,proto3" json:"firing,omitempty"`
+	// Current value of the condition
+	Message string `protobuf:"bytes,4,opt,name=message,proto3" json:"message,omitempty"`
+	// Time of the last transition between firing and resolved
+	Since         *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=since,proto3" json:"since,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Alert) Reset() {
+	*x = Alert{}
+	mi := &file_evnode_v1_health_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Alert) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Alert) ProtoMessage() {}
+
+func (x *Alert) ProtoReflect() protoreflect.Message {
+	mi := &file_evnode_v1_health_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Alert.ProtoReflect.Descriptor instead.
+func (*Alert) Descriptor() ([]byte, []int) {
+	return file_evnode_v1_health_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *Alert) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Alert) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *Alert) GetFiring() bool {
+	if x != nil {
+		return x.Firing
+	}
+	return false
+}
+
+func (x *Alert) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *Alert) GetSince() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Since
+	}
+	return nil
+}
+
+// GetAlertsResponse defines the response for retrieving the alert states
+type GetAlertsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Alerts        []*Alert               `protobuf:"bytes,1,rep,name=alerts,proto3" json:"alerts,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetAlertsResponse) Reset() {
+	*x = GetAlertsResponse{}
+	mi := &file_evnode_v1_health_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAlertsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAlertsResponse) ProtoMessage() {}
+
+func (x *GetAlertsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_evnode_v1_health_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAlertsResponse.ProtoReflect.Descriptor instead.
+func (*GetAlertsResponse) Descriptor() ([]byte, []int) {
+	return file_evnode_v1_health_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *GetAlertsResponse) GetAlerts() []*Alert {
+	if x != nil {
+		return x.Alerts
+	}
+	return nil
+}
+
+// SubsystemError defines the most recent error of a subsystem of the node
+type SubsystemError struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Name of the subsystem, e.g. BlockManager or HeaderSyncService
+	Subsystem string `protobuf:"bytes,1,opt,name=subsystem,proto3" json:"subsystem,omitempty"`
+	// Message of the most recent error
+	Message string `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	// Number of errors since the node started
+	Count uint64 `protobuf:"varint,3,opt,name=count,proto3" json:"count,omitempty"`
+	// Time of the first error since the node started
+	FirstSeen *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=first_seen,json=firstSeen,proto3" json:"first_seen,omitempty"`
+	// Time of the most recent error
+	LastSeen      *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=last_seen,json=lastSeen,proto3" json:"last_seen,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SubsystemError) Reset() {
+	*x = SubsystemError{}
+	mi := &file_evnode_v1_health_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SubsystemError) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubsystemError) ProtoMessage() {}
+
+func (x *SubsystemError) ProtoReflect() protoreflect.Message {
+	mi := &file_evnode_v1_health_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubsystemError.ProtoReflect.Descriptor instead.
+func (*SubsystemError) Descriptor() ([]byte, []int) {
+	return file_evnode_v1_health_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *SubsystemError) GetSubsystem() string {
+	if x != nil {
+		return x.Subsystem
+	}
+	return ""
+}
+
+func (x *SubsystemError) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *SubsystemError) GetCount() uint64 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+func (x *SubsystemError) GetFirstSeen() *timestamppb.Timestamp {
+	if x != nil {
+		return x.FirstSeen
+	}
+	return nil
+}
+
+func (x *SubsystemError) GetLastSeen() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastSeen
+	}
+	return nil
+}
+
+// GetErrorsResponse defines the response for retrieving the most recent errors of the subsystems
+type GetErrorsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Errors        []*SubsystemError      `protobuf:"bytes,1,rep,name=errors,proto3" json:"errors,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetErrorsResponse) Reset() {
+	*x = GetErrorsResponse{}
+	mi := &file_evnode_v1_health_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetErrorsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetErrorsResponse) ProtoMessage() {}
+
+func (x *GetErrorsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_evnode_v1_health_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetErrorsResponse.ProtoReflect.Descriptor instead.
+func (*GetErrorsResponse) Descriptor() ([]byte, []int) {
+	return file_evnode_v1_health_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *GetErrorsResponse) GetErrors() []*SubsystemError {
+	if x != nil {
+		return x.Errors
+	}
+	return nil
+}
+
 var File_evnode_v1_health_proto protoreflect.FileDescriptor
 
 const file_evnode_v1_health_proto_rawDesc = "" +
 	"\n" +
-	"\x16evnode/v1/health.proto\x12\tevnode.v1\x1a\x1bgoogle/protobuf/empty.proto\x1a\x16evnode/v1/evnode.proto\x1a\x15evnode/v1/state.proto\"D\n" +
+	"\x16evnode/v1/health.proto\x12\tevnode.v1\x1a\x1bgoogle/protobuf/empty.proto\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x16evnode/v1/evnode.proto\x1a\x15evnode/v1/state.proto\"D\n" +
 	"\x11GetHealthResponse\x12/\n" +
-	"\x06status\x18\x01 \x01(\x0e2\x17.evnode.v1.HealthStatusR\x06status*9\n" +
+	"\x06status\x18\x01 \x01(\x0e2\x17.evnode.v1.HealthStatusR\x06status\"o\n" +
+	"\x0eReadinessCheck\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12/\n" +
+	"\x06status\x18\x02 \x01(\x0e2\x17.evnode.v1.HealthStatusR\x06status\x12\x18\n" +
+	"\amessage\x18\x03 \x01(\tR\amessage\"\xa7\x01\n" +
+	"\x0eReadyzResponse\x12/\n" +
+	"\x06status\x18\x01 \x01(\x0e2\x17.evnode.v1.HealthStatusR\x06status\x121\n" +
+	"\x06checks\x18\x02 \x03(\v2\x19.evnode.v1.ReadinessCheckR\x06checks\x121\n" +
+	"\x06errors\x18\x03 \x03(\v2\x19.evnode.v1.SubsystemErrorR\x06errors\"\xa1\x01\n" +
+	"\x05Alert\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12 \n" +
+	"\vdescription\x18\x02 \x01(\tR\vdescription\x12\x16\n" +
+	"\x06firing\x18\x03 \x01(\bR\x06firing\x12\x18\n" +
+	"\amessage\x18\x04 \x01(\tR\amessage\x120\n" +
+	"\x05since\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\x05since\"=\n" +
+	"\x11GetAlertsResponse\x12(\n" +
+	"\x06alerts\x18\x01 \x03(\v2\x10.evnode.v1.AlertR\x06alerts\"\xd2\x01\n" +
+	"\x0eSubsystemError\x12\x1c\n" +
+	"\tsubsystem\x18\x01 \x01(\tR\tsubsystem\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12\x14\n" +
+	"\x05count\x18\x03 \x01(\x04R\x05count\x129\n" +
+	"\n" +
+	"first_seen\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\tfirstSeen\x127\n" +
+	"\tlast_seen\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\blastSeen\"F\n" +
+	"\x11GetErrorsResponse\x121\n" +
+	"\x06errors\x18\x01 \x03(\v2\x19.evnode.v1.SubsystemErrorR\x06errors*9\n" +
 	"\fHealthStatus\x12\v\n" +
 	"\aUNKNOWN\x10\x00\x12\b\n" +
 	"\x04PASS\x10\x01\x12\b\n" +
 	"\x04WARN\x10\x02\x12\b\n" +
-	"\x04FAIL\x10\x032P\n" +
-	"\rHealthService\x12?\n" +
-	"\x05Livez\x12\x16.google.protobuf.Empty\x1a\x1c.evnode.v1.GetHealthResponse\"\x00B/Z-github.com/evstack/ev-node/types/pb/evnode/v1b\x06proto3"
+	"\x04FAIL\x10\x032\xa5\x02\n" +
+	"\rHealthService\x12B\n" +
+	"\x05Livez\x12\x16.google.protobuf.Empty\x1a\x1c.evnode.v1.GetHealthResponse\"\x03\x90\x02\x01\x12@\n" +
+	"\x06Readyz\x12\x16.google.protobuf.Empty\x1a\x19.evnode.v1.ReadyzResponse\"\x03\x90\x02\x01\x12F\n" +
+	"\tGetAlerts\x12\x16.google.protobuf.Empty\x1a\x1c.evnode.v1.GetAlertsResponse\"\x03\x90\x02\x01\x12F\n" +
+	"\tGetErrors\x12\x16.google.protobuf.Empty\x1a\x1c.evnode.v1.GetErrorsResponse\"\x03\x90\x02\x01B/Z-github.com/evstack/ev-node/types/pb/evnode/v1b\x06proto3"
 
 var (
 	file_evnode_v1_health_proto_rawDescOnce sync.Once
@@ -153,21 +564,43 @@ func file_evnode_v1_health_proto_rawDescGZIP() []byte {
 }
 
 var file_evnode_v1_health_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_evnode_v1_health_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
+var file_evnode_v1_health_proto_msgTypes = make([]protoimpl.MessageInfo, 7)
 var file_evnode_v1_health_proto_goTypes = []any{
-	(HealthStatus)(0),         // 0: evnode.v1.HealthStatus
-	(*GetHealthResponse)(nil), // 1: evnode.v1.GetHealthResponse
-	(*emptypb.Empty)(nil),     // 2: google.protobuf.Empty
+	(HealthStatus)(0),             // 0: evnode.v1.HealthStatus
+	(*GetHealthResponse)(nil),     // 1: evnode.v1.GetHealthResponse
+	(*ReadinessCheck)(nil),        // 2: evnode.v1.ReadinessCheck
+	(*ReadyzResponse)(nil),        // 3: evnode.v1.ReadyzResponse
+	(*Alert)(nil),                 // 4: evnode.v1.Alert
+	(*GetAlertsResponse)(nil),     // 5: evnode.v1.GetAlertsResponse
+	(*SubsystemError)(nil),        // 6: evnode.v1.SubsystemError
+	(*GetErrorsResponse)(nil),     // 7: evnode.v1.GetErrorsResponse
+	(*timestamppb.Timestamp)(nil), // 8: google.protobuf.Timestamp
+	(*emptypb.Empty)(nil),         // 9: google.protobuf.Empty
 }
 var file_evnode_v1_health_proto_depIdxs = []int32{
-	0, // 0: evnode.v1.GetHealthResponse.status:type_name -> evnode.v1.HealthStatus
-	2, // 1: evnode.v1.HealthService.Livez:input_type -> google.protobuf.Empty
-	1, // 2: evnode.v1.HealthService.Livez:output_type -> evnode.v1.GetHealthResponse
-	2, // [2:3] is the sub-list for method output_type
-	1, // [1:2] is the sub-list for method input_type
-	1, // [1:1] is the sub-list for extension type_name
-	1, // [1:1] is the sub-list for extension extendee
-	0, // [0:1] is the sub-list for field type_name
+	0,  // 0: evnode.v1.GetHealthResponse.status:type_name -> evnode.v1.HealthStatus
+	0,  // 1: evnode.v1.ReadinessCheck.status:type_name -> evnode.v1.HealthStatus
+	0,  // 2: evnode.v1.ReadyzResponse.status:type_name -> evnode.v1.HealthStatus
+	2,  // 3: evnode.v1.ReadyzResponse.checks:type_name -> evnode.v1.ReadinessCheck
+	6,  // 4: evnode.v1.ReadyzResponse.errors:type_name -> evnode.v1.SubsystemError
+	8,  // 5: evnode.v1.Alert.since:type_name -> google.protobuf.Timestamp
+	4,  // 6: evnode.v1.GetAlertsResponse.alerts:type_name -> evnode.v1.Alert
+	8,  // 7: evnode.v1.SubsystemError.first_seen:type_name -> google.protobuf.Timestamp
+	8,  // 8: evnode.v1.SubsystemError.last_seen:type_name -> google.protobuf.Timestamp
+	6,  // 9: evnode.v1.GetErrorsResponse.errors:type_name -> evnode.v1.SubsystemError
+	9,  // 10: evnode.v1.HealthService.Livez:input_type -> google.protobuf.Empty
+	9,  // 11: evnode.v1.HealthService.Readyz:input_type -> google.protobuf.Empty
+	9,  // 12: evnode.v1.HealthService.GetAlerts:input_type -> google.protobuf.Empty
+	9,  // 13: evnode.v1.HealthService.GetErrors:input_type -> google.protobuf.Empty
+	1,  // 14: evnode.v1.HealthService.Livez:output_type -> evnode.v1.GetHealthResponse
+	3,  // 15: evnode.v1.HealthService.Readyz:output_type -> evnode.v1.ReadyzResponse
+	5,  // 16: evnode.v1.HealthService.GetAlerts:output_type -> evnode.v1.GetAlertsResponse
+	7,  // 17: evnode.v1.HealthService.GetErrors:output_type -> evnode.v1.GetErrorsResponse
+	14, // [14:18] is the sub-list for method output_type
+	10, // [10:14] is the sub-list for method input_type
+	10, // [10:10] is the sub-list for extension type_name
+	10, // [10:10] is the sub-list for extension extendee
+	0,  // [0:10] is the sub-list for field type_name
 }
 
 func init() { file_evnode_v1_health_proto_init() }
@@ -183,7 +616,7 @@ func file_evnode_v1_health_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_evnode_v1_health_proto_rawDesc), len(file_evnode_v1_health_proto_rawDesc)),
 			NumEnums:      1,
-			NumMessages:   1,
+			NumMessages:   7,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
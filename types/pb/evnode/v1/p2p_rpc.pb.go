@@ -9,7 +9,9 @@ package v1
 import (
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	durationpb "google.golang.org/protobuf/types/known/durationpb"
 	emptypb "google.golang.org/protobuf/types/known/emptypb"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
 	reflect "reflect"
 	sync "sync"
 	unsafe "unsafe"
@@ -22,18 +24,128 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+// PeerDirection is the direction of the connection to a peer
+type PeerDirection int32
+
+const (
+	// The direction is unknown or the peer is not connected
+	PeerDirection_PEER_DIRECTION_UNSPECIFIED PeerDirection = 0
+	// The peer connected to the node
+	PeerDirection_PEER_DIRECTION_INBOUND PeerDirection = 1
+	// The node connected to the peer
+	PeerDirection_PEER_DIRECTION_OUTBOUND PeerDirection = 2
+)
+
+// Enum value maps for PeerDirection.
+var (
+	PeerDirection_name = map[int32]string{
+		0: "PEER_DIRECTION_UNSPECIFIED",
+		1: "PEER_DIRECTION_INBOUND",
+		2: "PEER_DIRECTION_OUTBOUND",
+	}
+	PeerDirection_value = map[string]int32{
+		"PEER_DIRECTION_UNSPECIFIED": 0,
+		"PEER_DIRECTION_INBOUND":     1,
+		"PEER_DIRECTION_OUTBOUND":    2,
+	}
+)
+
+func (x PeerDirection) Enum() *PeerDirection {
+	p := new(PeerDirection)
+	*p = x
+	return p
+}
+
+func (x PeerDirection) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (PeerDirection) Descriptor() protoreflect.EnumDescriptor {
+	return file_evnode_v1_p2p_rpc_proto_enumTypes[0].Descriptor()
+}
+
+func (PeerDirection) Type() protoreflect.EnumType {
+	return &file_evnode_v1_p2p_rpc_proto_enumTypes[0]
+}
+
+func (x PeerDirection) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use PeerDirection.Descriptor instead.
+func (PeerDirection) EnumDescriptor() ([]byte, []int) {
+	return file_evnode_v1_p2p_rpc_proto_rawDescGZIP(), []int{0}
+}
+
+// GetPeerInfoRequest defines the request for retrieving peer information. Peers are ordered by ID.
+type GetPeerInfoRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Only return the peers connected in this direction, all peers if unspecified
+	Direction PeerDirection `protobuf:"varint,3,opt,name=direction,proto3,enum=evnode.v1.PeerDirection" json:"direction,omitempty"`
+	// The page of the peers, 100 by default and at most 1000
+	Page          *PageRequest `protobuf:"bytes,4,opt,name=page,proto3" json:"page,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetPeerInfoRequest) Reset() {
+	*x = GetPeerInfoRequest{}
+	mi := &file_evnode_v1_p2p_rpc_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPeerInfoRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPeerInfoRequest) ProtoMessage() {}
+
+func (x *GetPeerInfoRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_evnode_v1_p2p_rpc_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPeerInfoRequest.ProtoReflect.Descriptor instead.
+func (*GetPeerInfoRequest) Descriptor() ([]byte, []int) {
+	return file_evnode_v1_p2p_rpc_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *GetPeerInfoRequest) GetDirection() PeerDirection {
+	if x != nil {
+		return x.Direction
+	}
+	return PeerDirection_PEER_DIRECTION_UNSPECIFIED
+}
+
+func (x *GetPeerInfoRequest) GetPage() *PageRequest {
+	if x != nil {
+		return x.Page
+	}
+	return nil
+}
+
 // GetPeerInfoResponse defines the response for retrieving peer information
 type GetPeerInfoResponse struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// List of connected peers
-	Peers         []*PeerInfo `protobuf:"bytes,1,rep,name=peers,proto3" json:"peers,omitempty"`
+	Peers []*PeerInfo `protobuf:"bytes,1,rep,name=peers,proto3" json:"peers,omitempty"`
+	// The page returned, with the number of peers matching the request over all pages
+	Page          *PageResponse `protobuf:"bytes,4,opt,name=page,proto3" json:"page,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *GetPeerInfoResponse) Reset() {
 	*x = GetPeerInfoResponse{}
-	mi := &file_evnode_v1_p2p_rpc_proto_msgTypes[0]
+	mi := &file_evnode_v1_p2p_rpc_proto_msgTypes[1]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -45,7 +157,7 @@ func (x *GetPeerInfoResponse) String() string {
 func (*GetPeerInfoResponse) ProtoMessage() {}
 
 func (x *GetPeerInfoResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_evnode_v1_p2p_rpc_proto_msgTypes[0]
+	mi := &file_evnode_v1_p2p_rpc_proto_msgTypes[1]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -58,7 +170,7 @@ func (x *GetPeerInfoResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetPeerInfoResponse.ProtoReflect.Descriptor instead.
 func (*GetPeerInfoResponse) Descriptor() ([]byte, []int) {
-	return file_evnode_v1_p2p_rpc_proto_rawDescGZIP(), []int{0}
+	return file_evnode_v1_p2p_rpc_proto_rawDescGZIP(), []int{1}
 }
 
 func (x *GetPeerInfoResponse) GetPeers() []*PeerInfo {
@@ -68,6 +180,13 @@ func (x *GetPeerInfoResponse) GetPeers() []*PeerInfo {
 	return nil
 }
 
+func (x *GetPeerInfoResponse) GetPage() *PageResponse {
+	if x != nil {
+		return x.Page
+	}
+	return nil
+}
+
 // GetNetInfoResponse defines the response for retrieving network information
 type GetNetInfoResponse struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
@@ -79,7 +198,7 @@ type GetNetInfoResponse struct {
 
 func (x *GetNetInfoResponse) Reset() {
 	*x = GetNetInfoResponse{}
-	mi := &file_evnode_v1_p2p_rpc_proto_msgTypes[1]
+	mi := &file_evnode_v1_p2p_rpc_proto_msgTypes[2]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -91,7 +210,7 @@ func (x *GetNetInfoResponse) String() string {
 func (*GetNetInfoResponse) ProtoMessage() {}
 
 func (x *GetNetInfoResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_evnode_v1_p2p_rpc_proto_msgTypes[1]
+	mi := &file_evnode_v1_p2p_rpc_proto_msgTypes[2]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -104,7 +223,7 @@ func (x *GetNetInfoResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetNetInfoResponse.ProtoReflect.Descriptor instead.
 func (*GetNetInfoResponse) Descriptor() ([]byte, []int) {
-	return file_evnode_v1_p2p_rpc_proto_rawDescGZIP(), []int{1}
+	return file_evnode_v1_p2p_rpc_proto_rawDescGZIP(), []int{2}
 }
 
 func (x *GetNetInfoResponse) GetNetInfo() *NetInfo {
@@ -120,14 +239,23 @@ type PeerInfo struct {
 	// Peer ID
 	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 	// Peer address
-	Address       string `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	Address string `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+	// Direction of the connection to the peer, unspecified if not connected
+	Direction PeerDirection `protobuf:"varint,3,opt,name=direction,proto3,enum=evnode.v1.PeerDirection" json:"direction,omitempty"`
+	// Time since the connection to the peer was opened, unset if not connected
+	ConnectionAge *durationpb.Duration `protobuf:"bytes,4,opt,name=connection_age,json=connectionAge,proto3" json:"connection_age,omitempty"`
+	// Last time the peer was connected, unset if never connected or if it is not among the last
+	// 1024 disconnected peers
+	LastSeen *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=last_seen,json=lastSeen,proto3" json:"last_seen,omitempty"`
+	// Protocol version announced by the peer, empty if unknown
+	ProtocolVersion string `protobuf:"bytes,6,opt,name=protocol_version,json=protocolVersion,proto3" json:"protocol_version,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
 }
 
 func (x *PeerInfo) Reset() {
 	*x = PeerInfo{}
-	mi := &file_evnode_v1_p2p_rpc_proto_msgTypes[2]
+	mi := &file_evnode_v1_p2p_rpc_proto_msgTypes[3]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -139,7 +267,7 @@ func (x *PeerInfo) String() string {
 func (*PeerInfo) ProtoMessage() {}
 
 func (x *PeerInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_evnode_v1_p2p_rpc_proto_msgTypes[2]
+	mi := &file_evnode_v1_p2p_rpc_proto_msgTypes[3]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -152,7 +280,7 @@ func (x *PeerInfo) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PeerInfo.ProtoReflect.Descriptor instead.
 func (*PeerInfo) Descriptor() ([]byte, []int) {
-	return file_evnode_v1_p2p_rpc_proto_rawDescGZIP(), []int{2}
+	return file_evnode_v1_p2p_rpc_proto_rawDescGZIP(), []int{3}
 }
 
 func (x *PeerInfo) GetId() string {
@@ -169,6 +297,34 @@ func (x *PeerInfo) GetAddress() string {
 	return ""
 }
 
+func (x *PeerInfo) GetDirection() PeerDirection {
+	if x != nil {
+		return x.Direction
+	}
+	return PeerDirection_PEER_DIRECTION_UNSPECIFIED
+}
+
+func (x *PeerInfo) GetConnectionAge() *durationpb.Duration {
+	if x != nil {
+		return x.ConnectionAge
+	}
+	return nil
+}
+
+func (x *PeerInfo) GetLastSeen() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastSeen
+	}
+	return nil
+}
+
+func (x *PeerInfo) GetProtocolVersion() string {
+	if x != nil {
+		return x.ProtocolVersion
+	}
+	return ""
+}
+
 // NetInfo contains information about the network
 type NetInfo struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
@@ -184,7 +340,7 @@ type NetInfo struct {
 
 func (x *NetInfo) Reset() {
 	*x = NetInfo{}
-	mi := &file_evnode_v1_p2p_rpc_proto_msgTypes[3]
+	mi := &file_evnode_v1_p2p_rpc_proto_msgTypes[4]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -196,7 +352,7 @@ func (x *NetInfo) String() string {
 func (*NetInfo) ProtoMessage() {}
 
 func (x *NetInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_evnode_v1_p2p_rpc_proto_msgTypes[3]
+	mi := &file_evnode_v1_p2p_rpc_proto_msgTypes[4]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -209,7 +365,7 @@ func (x *NetInfo) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use NetInfo.ProtoReflect.Descriptor instead.
 func (*NetInfo) Descriptor() ([]byte, []int) {
-	return file_evnode_v1_p2p_rpc_proto_rawDescGZIP(), []int{3}
+	return file_evnode_v1_p2p_rpc_proto_rawDescGZIP(), []int{4}
 }
 
 func (x *NetInfo) GetId() string {
@@ -237,23 +393,36 @@ var File_evnode_v1_p2p_rpc_proto protoreflect.FileDescriptor
 
 const file_evnode_v1_p2p_rpc_proto_rawDesc = "" +
 	"\n" +
-	"\x17evnode/v1/p2p_rpc.proto\x12\tevnode.v1\x1a\x1bgoogle/protobuf/empty.proto\x1a\x16evnode/v1/evnode.proto\x1a\x15evnode/v1/state.proto\"@\n" +
+	"\x17evnode/v1/p2p_rpc.proto\x12\tevnode.v1\x1a\x1egoogle/protobuf/duration.proto\x1a\x1bgoogle/protobuf/empty.proto\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x16evnode/v1/evnode.proto\x1a\x1aevnode/v1/pagination.proto\x1a\x15evnode/v1/state.proto\"\x97\x01\n" +
+	"\x12GetPeerInfoRequest\x126\n" +
+	"\tdirection\x18\x03 \x01(\x0e2\x18.evnode.v1.PeerDirectionR\tdirection\x12*\n" +
+	"\x04page\x18\x04 \x01(\v2\x16.evnode.v1.PageRequestR\x04pageJ\x04\b\x01\x10\x02J\x04\b\x02\x10\x03R\x05limitR\n" +
+	"page_token\"\x91\x01\n" +
 	"\x13GetPeerInfoResponse\x12)\n" +
-	"\x05peers\x18\x01 \x03(\v2\x13.evnode.v1.PeerInfoR\x05peers\"C\n" +
+	"\x05peers\x18\x01 \x03(\v2\x13.evnode.v1.PeerInfoR\x05peers\x12+\n" +
+	"\x04page\x18\x04 \x01(\v2\x17.evnode.v1.PageResponseR\x04pageJ\x04\b\x02\x10\x03J\x04\b\x03\x10\x04R\x0fnext_page_tokenR\x05total\"C\n" +
 	"\x12GetNetInfoResponse\x12-\n" +
-	"\bnet_info\x18\x01 \x01(\v2\x12.evnode.v1.NetInfoR\anetInfo\"4\n" +
+	"\bnet_info\x18\x01 \x01(\v2\x12.evnode.v1.NetInfoR\anetInfo\"\x92\x02\n" +
 	"\bPeerInfo\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x18\n" +
-	"\aaddress\x18\x02 \x01(\tR\aaddress\"m\n" +
+	"\aaddress\x18\x02 \x01(\tR\aaddress\x126\n" +
+	"\tdirection\x18\x03 \x01(\x0e2\x18.evnode.v1.PeerDirectionR\tdirection\x12@\n" +
+	"\x0econnection_age\x18\x04 \x01(\v2\x19.google.protobuf.DurationR\rconnectionAge\x127\n" +
+	"\tlast_seen\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\blastSeen\x12)\n" +
+	"\x10protocol_version\x18\x06 \x01(\tR\x0fprotocolVersion\"m\n" +
 	"\aNetInfo\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12)\n" +
 	"\x10listen_addresses\x18\x02 \x03(\tR\x0flistenAddresses\x12'\n" +
-	"\x0fconnected_peers\x18\x03 \x03(\tR\x0econnectedPeers2\x9c\x01\n" +
+	"\x0fconnected_peers\x18\x03 \x03(\tR\x0econnectedPeers*h\n" +
+	"\rPeerDirection\x12\x1e\n" +
+	"\x1aPEER_DIRECTION_UNSPECIFIED\x10\x00\x12\x1a\n" +
+	"\x16PEER_DIRECTION_INBOUND\x10\x01\x12\x1b\n" +
+	"\x17PEER_DIRECTION_OUTBOUND\x10\x022\xa9\x01\n" +
 	"\n" +
-	"P2PService\x12G\n" +
-	"\vGetPeerInfo\x12\x16.google.protobuf.Empty\x1a\x1e.evnode.v1.GetPeerInfoResponse\"\x00\x12E\n" +
+	"P2PService\x12Q\n" +
+	"\vGetPeerInfo\x12\x1d.evnode.v1.GetPeerInfoRequest\x1a\x1e.evnode.v1.GetPeerInfoResponse\"\x03\x90\x02\x01\x12H\n" +
 	"\n" +
-	"GetNetInfo\x12\x16.google.protobuf.Empty\x1a\x1d.evnode.v1.GetNetInfoResponse\"\x00B/Z-github.com/evstack/ev-node/types/pb/evnode/v1b\x06proto3"
+	"GetNetInfo\x12\x16.google.protobuf.Empty\x1a\x1d.evnode.v1.GetNetInfoResponse\"\x03\x90\x02\x01B/Z-github.com/evstack/ev-node/types/pb/evnode/v1b\x06proto3"
 
 var (
 	file_evnode_v1_p2p_rpc_proto_rawDescOnce sync.Once
@@ -267,26 +436,39 @@ func file_evnode_v1_p2p_rpc_proto_rawDescGZIP() []byte {
 	return file_evnode_v1_p2p_rpc_proto_rawDescData
 }
 
-var file_evnode_v1_p2p_rpc_proto_msgTypes = make([]protoimpl.MessageInfo, 4)
+var file_evnode_v1_p2p_rpc_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_evnode_v1_p2p_rpc_proto_msgTypes = make([]protoimpl.MessageInfo, 5)
 var file_evnode_v1_p2p_rpc_proto_goTypes = []any{
-	(*GetPeerInfoResponse)(nil), // 0: evnode.v1.GetPeerInfoResponse
-	(*GetNetInfoResponse)(nil),  // 1: evnode.v1.GetNetInfoResponse
-	(*PeerInfo)(nil),            // 2: evnode.v1.PeerInfo
-	(*NetInfo)(nil),             // 3: evnode.v1.NetInfo
-	(*emptypb.Empty)(nil),       // 4: google.protobuf.Empty
+	(PeerDirection)(0),            // 0: evnode.v1.PeerDirection
+	(*GetPeerInfoRequest)(nil),    // 1: evnode.v1.GetPeerInfoRequest
+	(*GetPeerInfoResponse)(nil),   // 2: evnode.v1.GetPeerInfoResponse
+	(*GetNetInfoResponse)(nil),    // 3: evnode.v1.GetNetInfoResponse
+	(*PeerInfo)(nil),              // 4: evnode.v1.PeerInfo
+	(*NetInfo)(nil),               // 5: evnode.v1.NetInfo
+	(*PageRequest)(nil),           // 6: evnode.v1.PageRequest
+	(*PageResponse)(nil),          // 7: evnode.v1.PageResponse
+	(*durationpb.Duration)(nil),   // 8: google.protobuf.Duration
+	(*timestamppb.Timestamp)(nil), // 9: google.protobuf.Timestamp
+	(*emptypb.Empty)(nil),         // 10: google.protobuf.Empty
 }
 var file_evnode_v1_p2p_rpc_proto_depIdxs = []int32{
-	2, // 0: evnode.v1.GetPeerInfoResponse.peers:type_name -> evnode.v1.PeerInfo
-	3, // 1: evnode.v1.GetNetInfoResponse.net_info:type_name -> evnode.v1.NetInfo
-	4, // 2: evnode.v1.P2PService.GetPeerInfo:input_type -> google.protobuf.Empty
-	4, // 3: evnode.v1.P2PService.GetNetInfo:input_type -> google.protobuf.Empty
-	0, // 4: evnode.v1.P2PService.GetPeerInfo:output_type -> evnode.v1.GetPeerInfoResponse
-	1, // 5: evnode.v1.P2PService.GetNetInfo:output_type -> evnode.v1.GetNetInfoResponse
-	4, // [4:6] is the sub-list for method output_type
-	2, // [2:4] is the sub-list for method input_type
-	2, // [2:2] is the sub-list for extension type_name
-	2, // [2:2] is the sub-list for extension extendee
-	0, // [0:2] is the sub-list for field type_name
+	0,  // 0: evnode.v1.GetPeerInfoRequest.direction:type_name -> evnode.v1.PeerDirection
+	6,  // 1: evnode.v1.GetPeerInfoRequest.page:type_name -> evnode.v1.PageRequest
+	4,  // 2: evnode.v1.GetPeerInfoResponse.peers:type_name -> evnode.v1.PeerInfo
+	7,  // 3: evnode.v1.GetPeerInfoResponse.page:type_name -> evnode.v1.PageResponse
+	5,  // 4: evnode.v1.GetNetInfoResponse.net_info:type_name -> evnode.v1.NetInfo
+	0,  // 5: evnode.v1.PeerInfo.direction:type_name -> evnode.v1.PeerDirection
+	8,  // 6: evnode.v1.PeerInfo.connection_age:type_name -> google.protobuf.Duration
+	9,  // 7: evnode.v1.PeerInfo.last_seen:type_name -> google.protobuf.Timestamp
+	1,  // 8: evnode.v1.P2PService.GetPeerInfo:input_type -> evnode.v1.GetPeerInfoRequest
+	10, // 9: evnode.v1.P2PService.GetNetInfo:input_type -> google.protobuf.Empty
+	2,  // 10: evnode.v1.P2PService.GetPeerInfo:output_type -> evnode.v1.GetPeerInfoResponse
+	3,  // 11: evnode.v1.P2PService.GetNetInfo:output_type -> evnode.v1.GetNetInfoResponse
+	10, // [10:12] is the sub-list for method output_type
+	8,  // [8:10] is the sub-list for method input_type
+	8,  // [8:8] is the sub-list for extension type_name
+	8,  // [8:8] is the sub-list for extension extendee
+	0,  // [0:8] is the sub-list for field type_name
 }
 
 func init() { file_evnode_v1_p2p_rpc_proto_init() }
@@ -295,19 +477,21 @@ func file_evnode_v1_p2p_rpc_proto_init() {
 		return
 	}
 	file_evnode_v1_evnode_proto_init()
+	file_evnode_v1_pagination_proto_init()
 	file_evnode_v1_state_proto_init()
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_evnode_v1_p2p_rpc_proto_rawDesc), len(file_evnode_v1_p2p_rpc_proto_rawDesc)),
-			NumEnums:      0,
-			NumMessages:   4,
+			NumEnums:      1,
+			NumMessages:   5,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
 		GoTypes:           file_evnode_v1_p2p_rpc_proto_goTypes,
 		DependencyIndexes: file_evnode_v1_p2p_rpc_proto_depIdxs,
+		EnumInfos:         file_evnode_v1_p2p_rpc_proto_enumTypes,
 		MessageInfos:      file_evnode_v1_p2p_rpc_proto_msgTypes,
 	}.Build()
 	File_evnode_v1_p2p_rpc_proto = out.File
@@ -0,0 +1,198 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.7
+// 	protoc        (unknown)
+// source: evnode/v1/pagination.proto
+
+package v1
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// PageRequest selects a page of the items of a list RPC. The items are returned in a stable order,
+// and a page continues after the last item of the previous page.
+type PageRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The maximum number of items returned, a default of the RPC if 0
+	Limit uint32 `protobuf:"varint,1,opt,name=limit,proto3" json:"limit,omitempty"`
+	// The next_page_token of the previous page, empty for the first page. Tokens are opaque.
+	PageToken     string `protobuf:"bytes,2,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PageRequest) Reset() {
+	*x = PageRequest{}
+	mi := &file_evnode_v1_pagination_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PageRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PageRequest) ProtoMessage() {}
+
+func (x *PageRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_evnode_v1_pagination_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PageRequest.ProtoReflect.Descriptor instead.
+func (*PageRequest) Descriptor() ([]byte, []int) {
+	return file_evnode_v1_pagination_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *PageRequest) GetLimit() uint32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *PageRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+// PageResponse describes the page returned by a list RPC.
+type PageResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The page_token of the next page, empty for the last page
+	NextPageToken string `protobuf:"bytes,1,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	// The number of items matching the request over all pages, unset if the RPC can not count them
+	// without reading all of them
+	Total         *uint64 `protobuf:"varint,2,opt,name=total,proto3,oneof" json:"total,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PageResponse) Reset() {
+	*x = PageResponse{}
+	mi := &file_evnode_v1_pagination_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PageResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PageResponse) ProtoMessage() {}
+
+func (x *PageResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_evnode_v1_pagination_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PageResponse.ProtoReflect.Descriptor instead.
+func (*PageResponse) Descriptor() ([]byte, []int) {
+	return file_evnode_v1_pagination_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *PageResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+func (x *PageResponse) GetTotal() uint64 {
+	if x != nil && x.Total != nil {
+		return *x.Total
+	}
+	return 0
+}
+
+var File_evnode_v1_pagination_proto protoreflect.FileDescriptor
+
+const file_evnode_v1_pagination_proto_rawDesc = "" +
+	"\n" +
+	"\x1aevnode/v1/pagination.proto\x12\tevnode.v1\"B\n" +
+	"\vPageRequest\x12\x14\n" +
+	"\x05limit\x18\x01 \x01(\rR\x05limit\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x02 \x01(\tR\tpageToken\"[\n" +
+	"\fPageResponse\x12&\n" +
+	"\x0fnext_page_token\x18\x01 \x01(\tR\rnextPageToken\x12\x19\n" +
+	"\x05total\x18\x02 \x01(\x04H\x00R\x05total\x88\x01\x01B\b\n" +
+	"\x06_totalB/Z-github.com/evstack/ev-node/types/pb/evnode/v1b\x06proto3"
+
+var (
+	file_evnode_v1_pagination_proto_rawDescOnce sync.Once
+	file_evnode_v1_pagination_proto_rawDescData []byte
+)
+
+func file_evnode_v1_pagination_proto_rawDescGZIP() []byte {
+	file_evnode_v1_pagination_proto_rawDescOnce.Do(func() {
+		file_evnode_v1_pagination_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_evnode_v1_pagination_proto_rawDesc), len(file_evnode_v1_pagination_proto_rawDesc)))
+	})
+	return file_evnode_v1_pagination_proto_rawDescData
+}
+
+var file_evnode_v1_pagination_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_evnode_v1_pagination_proto_goTypes = []any{
+	(*PageRequest)(nil),  // 0: evnode.v1.PageRequest
+	(*PageResponse)(nil), // 1: evnode.v1.PageResponse
+}
+var file_evnode_v1_pagination_proto_depIdxs = []int32{
+	0, // [0:0] is the sub-list for method output_type
+	0, // [0:0] is the sub-list for method input_type
+	0, // [0:0] is the sub-list for extension type_name
+	0, // [0:0] is the sub-list for extension extendee
+	0, // [0:0] is the sub-list for field type_name
+}
+
+func init() { file_evnode_v1_pagination_proto_init() }
+func file_evnode_v1_pagination_proto_init() {
+	if File_evnode_v1_pagination_proto != nil {
+		return
+	}
+	file_evnode_v1_pagination_proto_msgTypes[1].OneofWrappers = []any{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_evnode_v1_pagination_proto_rawDesc), len(file_evnode_v1_pagination_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   2,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_evnode_v1_pagination_proto_goTypes,
+		DependencyIndexes: file_evnode_v1_pagination_proto_depIdxs,
+		MessageInfos:      file_evnode_v1_pagination_proto_msgTypes,
+	}.Build()
+	File_evnode_v1_pagination_proto = out.File
+	file_evnode_v1_pagination_proto_goTypes = nil
+	file_evnode_v1_pagination_proto_depIdxs = nil
+}
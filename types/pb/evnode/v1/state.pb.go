@@ -123,6 +123,694 @@ func (x *State) GetAppHash() []byte {
 	return nil
 }
 
+// StateChange is the new value of a single key of the execution state.
+type StateChange struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Key           []byte                 `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Value         []byte                 `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+	Deleted       bool                   `protobuf:"varint,3,opt,name=deleted,proto3" json:"deleted,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StateChange) Reset() {
+	*x = StateChange{}
+	mi := &file_evnode_v1_state_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StateChange) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StateChange) ProtoMessage() {}
+
+func (x *StateChange) ProtoReflect() protoreflect.Message {
+	mi := &file_evnode_v1_state_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StateChange.ProtoReflect.Descriptor instead.
+func (*StateChange) Descriptor() ([]byte, []int) {
+	return file_evnode_v1_state_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *StateChange) GetKey() []byte {
+	if x != nil {
+		return x.Key
+	}
+	return nil
+}
+
+func (x *StateChange) GetValue() []byte {
+	if x != nil {
+		return x.Value
+	}
+	return nil
+}
+
+func (x *StateChange) GetDeleted() bool {
+	if x != nil {
+		return x.Deleted
+	}
+	return false
+}
+
+// StateDiff contains the execution state changes made by the transactions of a block.
+type StateDiff struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Height        uint64                 `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	Changes       []*StateChange         `protobuf:"bytes,2,rep,name=changes,proto3" json:"changes,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StateDiff) Reset() {
+	*x = StateDiff{}
+	mi := &file_evnode_v1_state_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StateDiff) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StateDiff) ProtoMessage() {}
+
+func (x *StateDiff) ProtoReflect() protoreflect.Message {
+	mi := &file_evnode_v1_state_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StateDiff.ProtoReflect.Descriptor instead.
+func (*StateDiff) Descriptor() ([]byte, []int) {
+	return file_evnode_v1_state_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *StateDiff) GetHeight() uint64 {
+	if x != nil {
+		return x.Height
+	}
+	return 0
+}
+
+func (x *StateDiff) GetChanges() []*StateChange {
+	if x != nil {
+		return x.Changes
+	}
+	return nil
+}
+
+// TxResult is the execution result of a single transaction.
+type TxResult struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Logs          []string               `protobuf:"bytes,2,rep,name=logs,proto3" json:"logs,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TxResult) Reset() {
+	*x = TxResult{}
+	mi := &file_evnode_v1_state_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TxResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TxResult) ProtoMessage() {}
+
+func (x *TxResult) ProtoReflect() protoreflect.Message {
+	mi := &file_evnode_v1_state_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TxResult.ProtoReflect.Descriptor instead.
+func (*TxResult) Descriptor() ([]byte, []int) {
+	return file_evnode_v1_state_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *TxResult) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *TxResult) GetLogs() []string {
+	if x != nil {
+		return x.Logs
+	}
+	return nil
+}
+
+// TxResults contains the execution results of the transactions of a block, in the order of the
+// transactions.
+type TxResults struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Height        uint64                 `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	Results       []*TxResult            `protobuf:"bytes,2,rep,name=results,proto3" json:"results,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TxResults) Reset() {
+	*x = TxResults{}
+	mi := &file_evnode_v1_state_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TxResults) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TxResults) ProtoMessage() {}
+
+func (x *TxResults) ProtoReflect() protoreflect.Message {
+	mi := &file_evnode_v1_state_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TxResults.ProtoReflect.Descriptor instead.
+func (*TxResults) Descriptor() ([]byte, []int) {
+	return file_evnode_v1_state_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *TxResults) GetHeight() uint64 {
+	if x != nil {
+		return x.Height
+	}
+	return 0
+}
+
+func (x *TxResults) GetResults() []*TxResult {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+// SequencerFees accounts the sequencing fees collected by a block. Amounts are decimal integers
+// in the smallest unit of the execution layer (e.g. wei). The fees are node-local accounting: they
+// are read by the node from its own execution layer and are not part of the protocol, i.e. neither
+// committed to in the signed header or data nor checked by other nodes.
+type SequencerFees struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	Height uint64                 `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	// Account credited with the fees
+	Recipient []byte `protobuf:"bytes,2,opt,name=recipient,proto3" json:"recipient,omitempty"`
+	// Fees collected by the block
+	Collected string `protobuf:"bytes,3,opt,name=collected,proto3" json:"collected,omitempty"`
+	// Fees collected since the first accounted block
+	TotalCollected string `protobuf:"bytes,4,opt,name=total_collected,json=totalCollected,proto3" json:"total_collected,omitempty"`
+	// Balance of the recipient after the block
+	RecipientBalance string `protobuf:"bytes,5,opt,name=recipient_balance,json=recipientBalance,proto3" json:"recipient_balance,omitempty"`
+	// Whether the balance change of the recipient was compared to the collected fees. It is not
+	// for the first accounted block, nor when the recipient changes.
+	Reconciled bool `protobuf:"varint,6,opt,name=reconciled,proto3" json:"reconciled,omitempty"`
+	// Balance change of the recipient minus the collected fees, if reconciled. It is non-zero when
+	// the recipient is credited or debited by other means than fees, e.g. transfers.
+	Discrepancy   string `protobuf:"bytes,7,opt,name=discrepancy,proto3" json:"discrepancy,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SequencerFees) Reset() {
+	*x = SequencerFees{}
+	mi := &file_evnode_v1_state_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SequencerFees) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SequencerFees) ProtoMessage() {}
+
+func (x *SequencerFees) ProtoReflect() protoreflect.Message {
+	mi := &file_evnode_v1_state_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SequencerFees.ProtoReflect.Descriptor instead.
+func (*SequencerFees) Descriptor() ([]byte, []int) {
+	return file_evnode_v1_state_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *SequencerFees) GetHeight() uint64 {
+	if x != nil {
+		return x.Height
+	}
+	return 0
+}
+
+func (x *SequencerFees) GetRecipient() []byte {
+	if x != nil {
+		return x.Recipient
+	}
+	return nil
+}
+
+func (x *SequencerFees) GetCollected() string {
+	if x != nil {
+		return x.Collected
+	}
+	return ""
+}
+
+func (x *SequencerFees) GetTotalCollected() string {
+	if x != nil {
+		return x.TotalCollected
+	}
+	return ""
+}
+
+func (x *SequencerFees) GetRecipientBalance() string {
+	if x != nil {
+		return x.RecipientBalance
+	}
+	return ""
+}
+
+func (x *SequencerFees) GetReconciled() bool {
+	if x != nil {
+		return x.Reconciled
+	}
+	return false
+}
+
+func (x *SequencerFees) GetDiscrepancy() string {
+	if x != nil {
+		return x.Discrepancy
+	}
+	return ""
+}
+
+// OrderflowInclusion attributes consecutive transactions of a block to the bundle of an
+// orderflow source of the sequencer they were pulled from
+type OrderflowInclusion struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Name of the orderflow source
+	Source   string `protobuf:"bytes,1,opt,name=source,proto3" json:"source,omitempty"`
+	BundleId string `protobuf:"bytes,2,opt,name=bundle_id,json=bundleId,proto3" json:"bundle_id,omitempty"`
+	// Index of the first transaction of the bundle in the block
+	Start uint64 `protobuf:"varint,3,opt,name=start,proto3" json:"start,omitempty"`
+	// Number of transactions of the bundle
+	Count         uint64 `protobuf:"varint,4,opt,name=count,proto3" json:"count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *OrderflowInclusion) Reset() {
+	*x = OrderflowInclusion{}
+	mi := &file_evnode_v1_state_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OrderflowInclusion) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OrderflowInclusion) ProtoMessage() {}
+
+func (x *OrderflowInclusion) ProtoReflect() protoreflect.Message {
+	mi := &file_evnode_v1_state_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OrderflowInclusion.ProtoReflect.Descriptor instead.
+func (*OrderflowInclusion) Descriptor() ([]byte, []int) {
+	return file_evnode_v1_state_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *OrderflowInclusion) GetSource() string {
+	if x != nil {
+		return x.Source
+	}
+	return ""
+}
+
+func (x *OrderflowInclusion) GetBundleId() string {
+	if x != nil {
+		return x.BundleId
+	}
+	return ""
+}
+
+func (x *OrderflowInclusion) GetStart() uint64 {
+	if x != nil {
+		return x.Start
+	}
+	return 0
+}
+
+func (x *OrderflowInclusion) GetCount() uint64 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+// OrderflowAttribution lists the bundles of orderflow sources included by a block
+type OrderflowAttribution struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Height        uint64                 `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	Inclusions    []*OrderflowInclusion  `protobuf:"bytes,2,rep,name=inclusions,proto3" json:"inclusions,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *OrderflowAttribution) Reset() {
+	*x = OrderflowAttribution{}
+	mi := &file_evnode_v1_state_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OrderflowAttribution) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OrderflowAttribution) ProtoMessage() {}
+
+func (x *OrderflowAttribution) ProtoReflect() protoreflect.Message {
+	mi := &file_evnode_v1_state_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OrderflowAttribution.ProtoReflect.Descriptor instead.
+func (*OrderflowAttribution) Descriptor() ([]byte, []int) {
+	return file_evnode_v1_state_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *OrderflowAttribution) GetHeight() uint64 {
+	if x != nil {
+		return x.Height
+	}
+	return 0
+}
+
+func (x *OrderflowAttribution) GetInclusions() []*OrderflowInclusion {
+	if x != nil {
+		return x.Inclusions
+	}
+	return nil
+}
+
+// SystemCall is a protocol action requested by the execution layer, applied by the node at the
+// activation height
+type SystemCall struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Type of the action, e.g. "halt" or "set_block_time"
+	Type string `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`
+	// Height of the first block the action applies to
+	ActivationHeight uint64 `protobuf:"varint,2,opt,name=activation_height,json=activationHeight,proto3" json:"activation_height,omitempty"`
+	// Parameter of the action, as defined by its type
+	Value string `protobuf:"bytes,3,opt,name=value,proto3" json:"value,omitempty"`
+	// Height of the block which requested the action
+	RequestedHeight uint64 `protobuf:"varint,4,opt,name=requested_height,json=requestedHeight,proto3" json:"requested_height,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *SystemCall) Reset() {
+	*x = SystemCall{}
+	mi := &file_evnode_v1_state_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SystemCall) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SystemCall) ProtoMessage() {}
+
+func (x *SystemCall) ProtoReflect() protoreflect.Message {
+	mi := &file_evnode_v1_state_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SystemCall.ProtoReflect.Descriptor instead.
+func (*SystemCall) Descriptor() ([]byte, []int) {
+	return file_evnode_v1_state_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *SystemCall) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *SystemCall) GetActivationHeight() uint64 {
+	if x != nil {
+		return x.ActivationHeight
+	}
+	return 0
+}
+
+func (x *SystemCall) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
+func (x *SystemCall) GetRequestedHeight() uint64 {
+	if x != nil {
+		return x.RequestedHeight
+	}
+	return 0
+}
+
+// SystemCalls are the system calls scheduled at a height
+type SystemCalls struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Calls         []*SystemCall          `protobuf:"bytes,1,rep,name=calls,proto3" json:"calls,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SystemCalls) Reset() {
+	*x = SystemCalls{}
+	mi := &file_evnode_v1_state_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SystemCalls) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SystemCalls) ProtoMessage() {}
+
+func (x *SystemCalls) ProtoReflect() protoreflect.Message {
+	mi := &file_evnode_v1_state_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SystemCalls.ProtoReflect.Descriptor instead.
+func (*SystemCalls) Descriptor() ([]byte, []int) {
+	return file_evnode_v1_state_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *SystemCalls) GetCalls() []*SystemCall {
+	if x != nil {
+		return x.Calls
+	}
+	return nil
+}
+
+// ExecutionDivergence reports a block whose execution by a shadow replica led to another state
+// root than the one committed by the sequencer
+type ExecutionDivergence struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Height of the diverging block
+	Height uint64 `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	// State root after the block, committed by the sequencer in the header of the next block
+	ExpectedStateRoot []byte `protobuf:"bytes,2,opt,name=expected_state_root,json=expectedStateRoot,proto3" json:"expected_state_root,omitempty"`
+	// State root after the block, computed by the replica
+	StateRoot []byte `protobuf:"bytes,3,opt,name=state_root,json=stateRoot,proto3" json:"state_root,omitempty"`
+	// Number of transactions of the block
+	TxCount uint64 `protobuf:"varint,4,opt,name=tx_count,json=txCount,proto3" json:"tx_count,omitempty"`
+	// Whether the block was re-executed to pinpoint the diverging transaction
+	Reexecuted bool `protobuf:"varint,5,opt,name=reexecuted,proto3" json:"reexecuted,omitempty"`
+	// Whether all the re-executions of the block led to the state root computed by the replica
+	Reproducible bool `protobuf:"varint,6,opt,name=reproducible,proto3" json:"reproducible,omitempty"`
+	// Index in the block of the first transaction whose execution is not reproducible, -1 if none
+	DivergingTxIndex int64 `protobuf:"varint,7,opt,name=diverging_tx_index,json=divergingTxIndex,proto3" json:"diverging_tx_index,omitempty"`
+	// Hex encoded SHA-256 hash of the diverging transaction
+	DivergingTxHash string                 `protobuf:"bytes,8,opt,name=diverging_tx_hash,json=divergingTxHash,proto3" json:"diverging_tx_hash,omitempty"`
+	DetectedAt      *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=detected_at,json=detectedAt,proto3" json:"detected_at,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *ExecutionDivergence) Reset() {
+	*x = ExecutionDivergence{}
+	mi := &file_evnode_v1_state_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExecutionDivergence) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExecutionDivergence) ProtoMessage() {}
+
+func (x *ExecutionDivergence) ProtoReflect() protoreflect.Message {
+	mi := &file_evnode_v1_state_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExecutionDivergence.ProtoReflect.Descriptor instead.
+func (*ExecutionDivergence) Descriptor() ([]byte, []int) {
+	return file_evnode_v1_state_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *ExecutionDivergence) GetHeight() uint64 {
+	if x != nil {
+		return x.Height
+	}
+	return 0
+}
+
+func (x *ExecutionDivergence) GetExpectedStateRoot() []byte {
+	if x != nil {
+		return x.ExpectedStateRoot
+	}
+	return nil
+}
+
+func (x *ExecutionDivergence) GetStateRoot() []byte {
+	if x != nil {
+		return x.StateRoot
+	}
+	return nil
+}
+
+func (x *ExecutionDivergence) GetTxCount() uint64 {
+	if x != nil {
+		return x.TxCount
+	}
+	return 0
+}
+
+func (x *ExecutionDivergence) GetReexecuted() bool {
+	if x != nil {
+		return x.Reexecuted
+	}
+	return false
+}
+
+func (x *ExecutionDivergence) GetReproducible() bool {
+	if x != nil {
+		return x.Reproducible
+	}
+	return false
+}
+
+func (x *ExecutionDivergence) GetDivergingTxIndex() int64 {
+	if x != nil {
+		return x.DivergingTxIndex
+	}
+	return 0
+}
+
+func (x *ExecutionDivergence) GetDivergingTxHash() string {
+	if x != nil {
+		return x.DivergingTxHash
+	}
+	return ""
+}
+
+func (x *ExecutionDivergence) GetDetectedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DetectedAt
+	}
+	return nil
+}
+
 var File_evnode_v1_state_proto protoreflect.FileDescriptor
 
 const file_evnode_v1_state_proto_rawDesc = "" +
@@ -136,7 +824,62 @@ const file_evnode_v1_state_proto_rawDesc = "" +
 	"\x0flast_block_time\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\rlastBlockTime\x12\x1b\n" +
 	"\tda_height\x18\x06 \x01(\x04R\bdaHeight\x12*\n" +
 	"\x11last_results_hash\x18\a \x01(\fR\x0flastResultsHash\x12\x19\n" +
-	"\bapp_hash\x18\b \x01(\fR\aappHashB/Z-github.com/evstack/ev-node/types/pb/evnode/v1b\x06proto3"
+	"\bapp_hash\x18\b \x01(\fR\aappHash\"O\n" +
+	"\vStateChange\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\fR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\fR\x05value\x12\x18\n" +
+	"\adeleted\x18\x03 \x01(\bR\adeleted\"U\n" +
+	"\tStateDiff\x12\x16\n" +
+	"\x06height\x18\x01 \x01(\x04R\x06height\x120\n" +
+	"\achanges\x18\x02 \x03(\v2\x16.evnode.v1.StateChangeR\achanges\"8\n" +
+	"\bTxResult\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x12\n" +
+	"\x04logs\x18\x02 \x03(\tR\x04logs\"R\n" +
+	"\tTxResults\x12\x16\n" +
+	"\x06height\x18\x01 \x01(\x04R\x06height\x12-\n" +
+	"\aresults\x18\x02 \x03(\v2\x13.evnode.v1.TxResultR\aresults\"\xfb\x01\n" +
+	"\rSequencerFees\x12\x16\n" +
+	"\x06height\x18\x01 \x01(\x04R\x06height\x12\x1c\n" +
+	"\trecipient\x18\x02 \x01(\fR\trecipient\x12\x1c\n" +
+	"\tcollected\x18\x03 \x01(\tR\tcollected\x12'\n" +
+	"\x0ftotal_collected\x18\x04 \x01(\tR\x0etotalCollected\x12+\n" +
+	"\x11recipient_balance\x18\x05 \x01(\tR\x10recipientBalance\x12\x1e\n" +
+	"\n" +
+	"reconciled\x18\x06 \x01(\bR\n" +
+	"reconciled\x12 \n" +
+	"\vdiscrepancy\x18\a \x01(\tR\vdiscrepancy\"u\n" +
+	"\x12OrderflowInclusion\x12\x16\n" +
+	"\x06source\x18\x01 \x01(\tR\x06source\x12\x1b\n" +
+	"\tbundle_id\x18\x02 \x01(\tR\bbundleId\x12\x14\n" +
+	"\x05start\x18\x03 \x01(\x04R\x05start\x12\x14\n" +
+	"\x05count\x18\x04 \x01(\x04R\x05count\"m\n" +
+	"\x14OrderflowAttribution\x12\x16\n" +
+	"\x06height\x18\x01 \x01(\x04R\x06height\x12=\n" +
+	"\n" +
+	"inclusions\x18\x02 \x03(\v2\x1d.evnode.v1.OrderflowInclusionR\n" +
+	"inclusions\"\x8e\x01\n" +
+	"\n" +
+	"SystemCall\x12\x12\n" +
+	"\x04type\x18\x01 \x01(\tR\x04type\x12+\n" +
+	"\x11activation_height\x18\x02 \x01(\x04R\x10activationHeight\x12\x14\n" +
+	"\x05value\x18\x03 \x01(\tR\x05value\x12)\n" +
+	"\x10requested_height\x18\x04 \x01(\x04R\x0frequestedHeight\":\n" +
+	"\vSystemCalls\x12+\n" +
+	"\x05calls\x18\x01 \x03(\v2\x15.evnode.v1.SystemCallR\x05calls\"\xf2\x02\n" +
+	"\x13ExecutionDivergence\x12\x16\n" +
+	"\x06height\x18\x01 \x01(\x04R\x06height\x12.\n" +
+	"\x13expected_state_root\x18\x02 \x01(\fR\x11expectedStateRoot\x12\x1d\n" +
+	"\n" +
+	"state_root\x18\x03 \x01(\fR\tstateRoot\x12\x19\n" +
+	"\btx_count\x18\x04 \x01(\x04R\atxCount\x12\x1e\n" +
+	"\n" +
+	"reexecuted\x18\x05 \x01(\bR\n" +
+	"reexecuted\x12\"\n" +
+	"\freproducible\x18\x06 \x01(\bR\freproducible\x12,\n" +
+	"\x12diverging_tx_index\x18\a \x01(\x03R\x10divergingTxIndex\x12*\n" +
+	"\x11diverging_tx_hash\x18\b \x01(\tR\x0fdivergingTxHash\x12;\n" +
+	"\vdetected_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"detectedAtB/Z-github.com/evstack/ev-node/types/pb/evnode/v1b\x06proto3"
 
 var (
 	file_evnode_v1_state_proto_rawDescOnce sync.Once
@@ -150,20 +893,35 @@ func file_evnode_v1_state_proto_rawDescGZIP() []byte {
 	return file_evnode_v1_state_proto_rawDescData
 }
 
-var file_evnode_v1_state_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
+var file_evnode_v1_state_proto_msgTypes = make([]protoimpl.MessageInfo, 11)
 var file_evnode_v1_state_proto_goTypes = []any{
 	(*State)(nil),                 // 0: evnode.v1.State
-	(*Version)(nil),               // 1: evnode.v1.Version
-	(*timestamppb.Timestamp)(nil), // 2: google.protobuf.Timestamp
+	(*StateChange)(nil),           // 1: evnode.v1.StateChange
+	(*StateDiff)(nil),             // 2: evnode.v1.StateDiff
+	(*TxResult)(nil),              // 3: evnode.v1.TxResult
+	(*TxResults)(nil),             // 4: evnode.v1.TxResults
+	(*SequencerFees)(nil),         // 5: evnode.v1.SequencerFees
+	(*OrderflowInclusion)(nil),    // 6: evnode.v1.OrderflowInclusion
+	(*OrderflowAttribution)(nil),  // 7: evnode.v1.OrderflowAttribution
+	(*SystemCall)(nil),            // 8: evnode.v1.SystemCall
+	(*SystemCalls)(nil),           // 9: evnode.v1.SystemCalls
+	(*ExecutionDivergence)(nil),   // 10: evnode.v1.ExecutionDivergence
+	(*Version)(nil),               // 11: evnode.v1.Version
+	(*timestamppb.Timestamp)(nil), // 12: google.protobuf.Timestamp
 }
 var file_evnode_v1_state_proto_depIdxs = []int32{
-	1, // 0: evnode.v1.State.version:type_name -> evnode.v1.Version
-	2, // 1: evnode.v1.State.last_block_time:type_name -> google.protobuf.Timestamp
-	2, // [2:2] is the sub-list for method output_type
-	2, // [2:2] is the sub-list for method input_type
-	2, // [2:2] is the sub-list for extension type_name
-	2, // [2:2] is the sub-list for extension extendee
-	0, // [0:2] is the sub-list for field type_name
+	11, // 0: evnode.v1.State.version:type_name -> evnode.v1.Version
+	12, // 1: evnode.v1.State.last_block_time:type_name -> google.protobuf.Timestamp
+	1,  // 2: evnode.v1.StateDiff.changes:type_name -> evnode.v1.StateChange
+	3,  // 3: evnode.v1.TxResults.results:type_name -> evnode.v1.TxResult
+	6,  // 4: evnode.v1.OrderflowAttribution.inclusions:type_name -> evnode.v1.OrderflowInclusion
+	8,  // 5: evnode.v1.SystemCalls.calls:type_name -> evnode.v1.SystemCall
+	12, // 6: evnode.v1.ExecutionDivergence.detected_at:type_name -> google.protobuf.Timestamp
+	7,  // [7:7] is the sub-list for method output_type
+	7,  // [7:7] is the sub-list for method input_type
+	7,  // [7:7] is the sub-list for extension type_name
+	7,  // [7:7] is the sub-list for extension extendee
+	0,  // [0:7] is the sub-list for field type_name
 }
 
 func init() { file_evnode_v1_state_proto_init() }
@@ -178,7 +936,7 @@ func file_evnode_v1_state_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_evnode_v1_state_proto_rawDesc), len(file_evnode_v1_state_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   1,
+			NumMessages:   11,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
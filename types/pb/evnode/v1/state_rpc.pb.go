@@ -9,7 +9,9 @@ package v1
 import (
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	durationpb "google.golang.org/protobuf/types/known/durationpb"
 	emptypb "google.golang.org/protobuf/types/known/emptypb"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
 	reflect "reflect"
 	sync "sync"
 	unsafe "unsafe"
@@ -22,6 +24,60 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+// TxStatus is the status of a transaction known to the node
+type TxStatus int32
+
+const (
+	// The transaction is not known to the node
+	TxStatus_TX_STATUS_UNKNOWN TxStatus = 0
+	// The transaction was submitted to the sequencer and is not included in a block yet. Transactions
+	// not included within 10 minutes of their submission are likely dropped, and are UNKNOWN again
+	TxStatus_TX_STATUS_PENDING TxStatus = 1
+	// The transaction is included in a block
+	TxStatus_TX_STATUS_INCLUDED TxStatus = 2
+)
+
+// Enum value maps for TxStatus.
+var (
+	TxStatus_name = map[int32]string{
+		0: "TX_STATUS_UNKNOWN",
+		1: "TX_STATUS_PENDING",
+		2: "TX_STATUS_INCLUDED",
+	}
+	TxStatus_value = map[string]int32{
+		"TX_STATUS_UNKNOWN":  0,
+		"TX_STATUS_PENDING":  1,
+		"TX_STATUS_INCLUDED": 2,
+	}
+)
+
+func (x TxStatus) Enum() *TxStatus {
+	p := new(TxStatus)
+	*p = x
+	return p
+}
+
+func (x TxStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (TxStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_evnode_v1_state_rpc_proto_enumTypes[0].Descriptor()
+}
+
+func (TxStatus) Type() protoreflect.EnumType {
+	return &file_evnode_v1_state_rpc_proto_enumTypes[0]
+}
+
+func (x TxStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use TxStatus.Descriptor instead.
+func (TxStatus) EnumDescriptor() ([]byte, []int) {
+	return file_evnode_v1_state_rpc_proto_rawDescGZIP(), []int{0}
+}
+
 // Block contains all the components of a complete block
 type Block struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
@@ -221,28 +277,37 @@ func (x *GetBlockResponse) GetDataDaHeight() uint64 {
 	return 0
 }
 
-// GetStateResponse defines the response for retrieving the current state
-type GetStateResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	State         *State                 `protobuf:"bytes,1,opt,name=state,proto3" json:"state,omitempty"`
+// GetBlockStreamRequest defines the request for retrieving a block in chunks
+type GetBlockStreamRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The height or hash of the block to retrieve
+	//
+	// Types that are valid to be assigned to Identifier:
+	//
+	//	*GetBlockStreamRequest_Height
+	//	*GetBlockStreamRequest_Hash
+	Identifier isGetBlockStreamRequest_Identifier `protobuf_oneof:"identifier"`
+	// The maximum size in bytes of the transactions of a chunk, or 0 for the default of 1 MiB. A
+	// transaction larger than the maximum is sent alone in its chunk.
+	MaxChunkSize  uint64 `protobuf:"varint,3,opt,name=max_chunk_size,json=maxChunkSize,proto3" json:"max_chunk_size,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetStateResponse) Reset() {
-	*x = GetStateResponse{}
+func (x *GetBlockStreamRequest) Reset() {
+	*x = GetBlockStreamRequest{}
 	mi := &file_evnode_v1_state_rpc_proto_msgTypes[3]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetStateResponse) String() string {
+func (x *GetBlockStreamRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetStateResponse) ProtoMessage() {}
+func (*GetBlockStreamRequest) ProtoMessage() {}
 
-func (x *GetStateResponse) ProtoReflect() protoreflect.Message {
+func (x *GetBlockStreamRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_evnode_v1_state_rpc_proto_msgTypes[3]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -254,40 +319,91 @@ func (x *GetStateResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetStateResponse.ProtoReflect.Descriptor instead.
-func (*GetStateResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetBlockStreamRequest.ProtoReflect.Descriptor instead.
+func (*GetBlockStreamRequest) Descriptor() ([]byte, []int) {
 	return file_evnode_v1_state_rpc_proto_rawDescGZIP(), []int{3}
 }
 
-func (x *GetStateResponse) GetState() *State {
+func (x *GetBlockStreamRequest) GetIdentifier() isGetBlockStreamRequest_Identifier {
 	if x != nil {
-		return x.State
+		return x.Identifier
 	}
 	return nil
 }
 
-// GetMetadataRequest defines the request for retrieving metadata by key
-type GetMetadataRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Key           string                 `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+func (x *GetBlockStreamRequest) GetHeight() uint64 {
+	if x != nil {
+		if x, ok := x.Identifier.(*GetBlockStreamRequest_Height); ok {
+			return x.Height
+		}
+	}
+	return 0
+}
+
+func (x *GetBlockStreamRequest) GetHash() []byte {
+	if x != nil {
+		if x, ok := x.Identifier.(*GetBlockStreamRequest_Hash); ok {
+			return x.Hash
+		}
+	}
+	return nil
+}
+
+func (x *GetBlockStreamRequest) GetMaxChunkSize() uint64 {
+	if x != nil {
+		return x.MaxChunkSize
+	}
+	return 0
+}
+
+type isGetBlockStreamRequest_Identifier interface {
+	isGetBlockStreamRequest_Identifier()
+}
+
+type GetBlockStreamRequest_Height struct {
+	Height uint64 `protobuf:"varint,1,opt,name=height,proto3,oneof"`
+}
+
+type GetBlockStreamRequest_Hash struct {
+	Hash []byte `protobuf:"bytes,2,opt,name=hash,proto3,oneof"`
+}
+
+func (*GetBlockStreamRequest_Height) isGetBlockStreamRequest_Identifier() {}
+
+func (*GetBlockStreamRequest_Hash) isGetBlockStreamRequest_Identifier() {}
+
+// GetBlockStreamResponse is a chunk of a block. The first chunk carries the header and the
+// metadata of the block, and every chunk the next transactions of the block.
+type GetBlockStreamResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The signed header of the block, set in the first chunk only
+	Header *SignedHeader `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
+	// The metadata of the data of the block, set in the first chunk only
+	Metadata       *Metadata `protobuf:"bytes,2,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	HeaderDaHeight uint64    `protobuf:"varint,3,opt,name=header_da_height,json=headerDaHeight,proto3" json:"header_da_height,omitempty"`
+	DataDaHeight   uint64    `protobuf:"varint,4,opt,name=data_da_height,json=dataDaHeight,proto3" json:"data_da_height,omitempty"`
+	// The number of transactions of the block, set in the first chunk only
+	TxCount uint64 `protobuf:"varint,5,opt,name=tx_count,json=txCount,proto3" json:"tx_count,omitempty"`
+	// The next transactions of the block, in block order
+	Txs           [][]byte `protobuf:"bytes,6,rep,name=txs,proto3" json:"txs,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetMetadataRequest) Reset() {
-	*x = GetMetadataRequest{}
+func (x *GetBlockStreamResponse) Reset() {
+	*x = GetBlockStreamResponse{}
 	mi := &file_evnode_v1_state_rpc_proto_msgTypes[4]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetMetadataRequest) String() string {
+func (x *GetBlockStreamResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetMetadataRequest) ProtoMessage() {}
+func (*GetBlockStreamResponse) ProtoMessage() {}
 
-func (x *GetMetadataRequest) ProtoReflect() protoreflect.Message {
+func (x *GetBlockStreamResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_evnode_v1_state_rpc_proto_msgTypes[4]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -299,40 +415,76 @@ func (x *GetMetadataRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetMetadataRequest.ProtoReflect.Descriptor instead.
-func (*GetMetadataRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetBlockStreamResponse.ProtoReflect.Descriptor instead.
+func (*GetBlockStreamResponse) Descriptor() ([]byte, []int) {
 	return file_evnode_v1_state_rpc_proto_rawDescGZIP(), []int{4}
 }
 
-func (x *GetMetadataRequest) GetKey() string {
+func (x *GetBlockStreamResponse) GetHeader() *SignedHeader {
 	if x != nil {
-		return x.Key
+		return x.Header
 	}
-	return ""
+	return nil
 }
 
-// GetMetadataResponse defines the response for retrieving metadata
-type GetMetadataResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Value         []byte                 `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty"`
+func (x *GetBlockStreamResponse) GetMetadata() *Metadata {
+	if x != nil {
+		return x.Metadata
+	}
+	return nil
+}
+
+func (x *GetBlockStreamResponse) GetHeaderDaHeight() uint64 {
+	if x != nil {
+		return x.HeaderDaHeight
+	}
+	return 0
+}
+
+func (x *GetBlockStreamResponse) GetDataDaHeight() uint64 {
+	if x != nil {
+		return x.DataDaHeight
+	}
+	return 0
+}
+
+func (x *GetBlockStreamResponse) GetTxCount() uint64 {
+	if x != nil {
+		return x.TxCount
+	}
+	return 0
+}
+
+func (x *GetBlockStreamResponse) GetTxs() [][]byte {
+	if x != nil {
+		return x.Txs
+	}
+	return nil
+}
+
+// SubscribeBlocksRequest defines the request for subscribing to blocks
+type SubscribeBlocksRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The height of the first block streamed, or 0 to start with the next block
+	FromHeight    uint64 `protobuf:"varint,1,opt,name=from_height,json=fromHeight,proto3" json:"from_height,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetMetadataResponse) Reset() {
-	*x = GetMetadataResponse{}
+func (x *SubscribeBlocksRequest) Reset() {
+	*x = SubscribeBlocksRequest{}
 	mi := &file_evnode_v1_state_rpc_proto_msgTypes[5]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetMetadataResponse) String() string {
+func (x *SubscribeBlocksRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetMetadataResponse) ProtoMessage() {}
+func (*SubscribeBlocksRequest) ProtoMessage() {}
 
-func (x *GetMetadataResponse) ProtoReflect() protoreflect.Message {
+func (x *SubscribeBlocksRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_evnode_v1_state_rpc_proto_msgTypes[5]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -344,112 +496,2690 @@ func (x *GetMetadataResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetMetadataResponse.ProtoReflect.Descriptor instead.
-func (*GetMetadataResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use SubscribeBlocksRequest.ProtoReflect.Descriptor instead.
+func (*SubscribeBlocksRequest) Descriptor() ([]byte, []int) {
 	return file_evnode_v1_state_rpc_proto_rawDescGZIP(), []int{5}
 }
 
-func (x *GetMetadataResponse) GetValue() []byte {
+func (x *SubscribeBlocksRequest) GetFromHeight() uint64 {
 	if x != nil {
-		return x.Value
+		return x.FromHeight
+	}
+	return 0
+}
+
+// SubscribeBlocksResponse is a block streamed by SubscribeBlocks, in height order
+type SubscribeBlocksResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Block         *Block                 `protobuf:"bytes,1,opt,name=block,proto3" json:"block,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SubscribeBlocksResponse) Reset() {
+	*x = SubscribeBlocksResponse{}
+	mi := &file_evnode_v1_state_rpc_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SubscribeBlocksResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubscribeBlocksResponse) ProtoMessage() {}
+
+func (x *SubscribeBlocksResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_evnode_v1_state_rpc_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubscribeBlocksResponse.ProtoReflect.Descriptor instead.
+func (*SubscribeBlocksResponse) Descriptor() ([]byte, []int) {
+	return file_evnode_v1_state_rpc_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *SubscribeBlocksResponse) GetBlock() *Block {
+	if x != nil {
+		return x.Block
 	}
 	return nil
 }
 
-var File_evnode_v1_state_rpc_proto protoreflect.FileDescriptor
+// SubscribePreviewBlocksResponse is a preview block streamed by SubscribePreviewBlocks. The
+// signature and signer of its header are empty. Previews are untrusted: nodes only check the chain
+// ID and the proposer address claimed by their header, so any peer of the network can forge them.
+// Their content must not be relied upon until the block is received with its signed header.
+type SubscribePreviewBlocksResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Block         *Block                 `protobuf:"bytes,1,opt,name=block,proto3" json:"block,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
 
-const file_evnode_v1_state_rpc_proto_rawDesc = "" +
-	"\n" +
-	"\x19evnode/v1/state_rpc.proto\x12\tevnode.v1\x1a\x1bgoogle/protobuf/empty.proto\x1a\x16evnode/v1/evnode.proto\x1a\x15evnode/v1/state.proto\"]\n" +
-	"\x05Block\x12/\n" +
-	"\x06header\x18\x01 \x01(\v2\x17.evnode.v1.SignedHeaderR\x06header\x12#\n" +
-	"\x04data\x18\x02 \x01(\v2\x0f.evnode.v1.DataR\x04data\"O\n" +
-	"\x0fGetBlockRequest\x12\x18\n" +
-	"\x06height\x18\x01 \x01(\x04H\x00R\x06height\x12\x14\n" +
-	"\x04hash\x18\x02 \x01(\fH\x00R\x04hashB\f\n" +
-	"\n" +
-	"identifier\"\x8a\x01\n" +
-	"\x10GetBlockResponse\x12&\n" +
-	"\x05block\x18\x01 \x01(\v2\x10.evnode.v1.BlockR\x05block\x12(\n" +
-	"\x10header_da_height\x18\x02 \x01(\x04R\x0eheaderDaHeight\x12$\n" +
-	"\x0edata_da_height\x18\x03 \x01(\x04R\fdataDaHeight\":\n" +
-	"\x10GetStateResponse\x12&\n" +
-	"\x05state\x18\x01 \x01(\v2\x10.evnode.v1.StateR\x05state\"&\n" +
-	"\x12GetMetadataRequest\x12\x10\n" +
-	"\x03key\x18\x01 \x01(\tR\x03key\"+\n" +
-	"\x13GetMetadataResponse\x12\x14\n" +
-	"\x05value\x18\x01 \x01(\fR\x05value2\xe8\x01\n" +
-	"\fStoreService\x12E\n" +
-	"\bGetBlock\x12\x1a.evnode.v1.GetBlockRequest\x1a\x1b.evnode.v1.GetBlockResponse\"\x00\x12A\n" +
-	"\bGetState\x12\x16.google.protobuf.Empty\x1a\x1b.evnode.v1.GetStateResponse\"\x00\x12N\n" +
-	"\vGetMetadata\x12\x1d.evnode.v1.GetMetadataRequest\x1a\x1e.evnode.v1.GetMetadataResponse\"\x00B/Z-github.com/evstack/ev-node/types/pb/evnode/v1b\x06proto3"
+func (x *SubscribePreviewBlocksResponse) Reset() {
+	*x = SubscribePreviewBlocksResponse{}
+	mi := &file_evnode_v1_state_rpc_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
 
-var (
-	file_evnode_v1_state_rpc_proto_rawDescOnce sync.Once
-	file_evnode_v1_state_rpc_proto_rawDescData []byte
-)
+func (x *SubscribePreviewBlocksResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
 
-func file_evnode_v1_state_rpc_proto_rawDescGZIP() []byte {
-	file_evnode_v1_state_rpc_proto_rawDescOnce.Do(func() {
-		file_evnode_v1_state_rpc_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_evnode_v1_state_rpc_proto_rawDesc), len(file_evnode_v1_state_rpc_proto_rawDesc)))
-	})
-	return file_evnode_v1_state_rpc_proto_rawDescData
+func (*SubscribePreviewBlocksResponse) ProtoMessage() {}
+
+func (x *SubscribePreviewBlocksResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_evnode_v1_state_rpc_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
 }
 
-var file_evnode_v1_state_rpc_proto_msgTypes = make([]protoimpl.MessageInfo, 6)
-var file_evnode_v1_state_rpc_proto_goTypes = []any{
-	(*Block)(nil),               // 0: evnode.v1.Block
-	(*GetBlockRequest)(nil),     // 1: evnode.v1.GetBlockRequest
-	(*GetBlockResponse)(nil),    // 2: evnode.v1.GetBlockResponse
-	(*GetStateResponse)(nil),    // 3: evnode.v1.GetStateResponse
-	(*GetMetadataRequest)(nil),  // 4: evnode.v1.GetMetadataRequest
-	(*GetMetadataResponse)(nil), // 5: evnode.v1.GetMetadataResponse
-	(*SignedHeader)(nil),        // 6: evnode.v1.SignedHeader
-	(*Data)(nil),                // 7: evnode.v1.Data
-	(*State)(nil),               // 8: evnode.v1.State
-	(*emptypb.Empty)(nil),       // 9: google.protobuf.Empty
+// Deprecated: Use SubscribePreviewBlocksResponse.ProtoReflect.Descriptor instead.
+func (*SubscribePreviewBlocksResponse) Descriptor() ([]byte, []int) {
+	return file_evnode_v1_state_rpc_proto_rawDescGZIP(), []int{7}
 }
-var file_evnode_v1_state_rpc_proto_depIdxs = []int32{
-	6, // 0: evnode.v1.Block.header:type_name -> evnode.v1.SignedHeader
-	7, // 1: evnode.v1.Block.data:type_name -> evnode.v1.Data
-	0, // 2: evnode.v1.GetBlockResponse.block:type_name -> evnode.v1.Block
-	8, // 3: evnode.v1.GetStateResponse.state:type_name -> evnode.v1.State
-	1, // 4: evnode.v1.StoreService.GetBlock:input_type -> evnode.v1.GetBlockRequest
-	9, // 5: evnode.v1.StoreService.GetState:input_type -> google.protobuf.Empty
-	4, // 6: evnode.v1.StoreService.GetMetadata:input_type -> evnode.v1.GetMetadataRequest
-	2, // 7: evnode.v1.StoreService.GetBlock:output_type -> evnode.v1.GetBlockResponse
-	3, // 8: evnode.v1.StoreService.GetState:output_type -> evnode.v1.GetStateResponse
-	5, // 9: evnode.v1.StoreService.GetMetadata:output_type -> evnode.v1.GetMetadataResponse
-	7, // [7:10] is the sub-list for method output_type
-	4, // [4:7] is the sub-list for method input_type
-	4, // [4:4] is the sub-list for extension type_name
-	4, // [4:4] is the sub-list for extension extendee
-	0, // [0:4] is the sub-list for field type_name
+
+func (x *SubscribePreviewBlocksResponse) GetBlock() *Block {
+	if x != nil {
+		return x.Block
+	}
+	return nil
 }
 
-func init() { file_evnode_v1_state_rpc_proto_init() }
-func file_evnode_v1_state_rpc_proto_init() {
-	if File_evnode_v1_state_rpc_proto != nil {
-		return
+// GetHeaderRequest defines the request for retrieving a header
+type GetHeaderRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The height of the block, or 0 for the latest block
+	Height        uint64 `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetHeaderRequest) Reset() {
+	*x = GetHeaderRequest{}
+	mi := &file_evnode_v1_state_rpc_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetHeaderRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetHeaderRequest) ProtoMessage() {}
+
+func (x *GetHeaderRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_evnode_v1_state_rpc_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	file_evnode_v1_evnode_proto_init()
-	file_evnode_v1_state_proto_init()
-	file_evnode_v1_state_rpc_proto_msgTypes[1].OneofWrappers = []any{
-		(*GetBlockRequest_Height)(nil),
-		(*GetBlockRequest_Hash)(nil),
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetHeaderRequest.ProtoReflect.Descriptor instead.
+func (*GetHeaderRequest) Descriptor() ([]byte, []int) {
+	return file_evnode_v1_state_rpc_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *GetHeaderRequest) GetHeight() uint64 {
+	if x != nil {
+		return x.Height
+	}
+	return 0
+}
+
+// GetHeaderResponse defines the response for retrieving a header
+type GetHeaderResponse struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Header         *SignedHeader          `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
+	HeaderDaHeight uint64                 `protobuf:"varint,2,opt,name=header_da_height,json=headerDaHeight,proto3" json:"header_da_height,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *GetHeaderResponse) Reset() {
+	*x = GetHeaderResponse{}
+	mi := &file_evnode_v1_state_rpc_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetHeaderResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetHeaderResponse) ProtoMessage() {}
+
+func (x *GetHeaderResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_evnode_v1_state_rpc_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetHeaderResponse.ProtoReflect.Descriptor instead.
+func (*GetHeaderResponse) Descriptor() ([]byte, []int) {
+	return file_evnode_v1_state_rpc_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *GetHeaderResponse) GetHeader() *SignedHeader {
+	if x != nil {
+		return x.Header
+	}
+	return nil
+}
+
+func (x *GetHeaderResponse) GetHeaderDaHeight() uint64 {
+	if x != nil {
+		return x.HeaderDaHeight
+	}
+	return 0
+}
+
+// GetHeaderRangeRequest defines the request for retrieving the headers of a range of blocks
+type GetHeaderRangeRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The height of the first block of the range
+	FromHeight uint64 `protobuf:"varint,1,opt,name=from_height,json=fromHeight,proto3" json:"from_height,omitempty"`
+	// The height of the last block of the range, inclusive. Heights above the latest block are ignored.
+	ToHeight uint64 `protobuf:"varint,2,opt,name=to_height,json=toHeight,proto3" json:"to_height,omitempty"`
+	// The page of the range, at most 1000 headers. If unset, the whole range is returned and must
+	// not exceed 1000 headers.
+	Page          *PageRequest `protobuf:"bytes,3,opt,name=page,proto3" json:"page,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetHeaderRangeRequest) Reset() {
+	*x = GetHeaderRangeRequest{}
+	mi := &file_evnode_v1_state_rpc_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetHeaderRangeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetHeaderRangeRequest) ProtoMessage() {}
+
+func (x *GetHeaderRangeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_evnode_v1_state_rpc_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetHeaderRangeRequest.ProtoReflect.Descriptor instead.
+func (*GetHeaderRangeRequest) Descriptor() ([]byte, []int) {
+	return file_evnode_v1_state_rpc_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *GetHeaderRangeRequest) GetFromHeight() uint64 {
+	if x != nil {
+		return x.FromHeight
+	}
+	return 0
+}
+
+func (x *GetHeaderRangeRequest) GetToHeight() uint64 {
+	if x != nil {
+		return x.ToHeight
+	}
+	return 0
+}
+
+func (x *GetHeaderRangeRequest) GetPage() *PageRequest {
+	if x != nil {
+		return x.Page
+	}
+	return nil
+}
+
+// GetHeaderRangeResponse defines the response for retrieving the headers of a range of blocks
+type GetHeaderRangeResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The headers in ascending height order
+	Headers []*SignedHeader `protobuf:"bytes,1,rep,name=headers,proto3" json:"headers,omitempty"`
+	// The page returned, set if the request has a page
+	Page *PageResponse `protobuf:"bytes,2,opt,name=page,proto3" json:"page,omitempty"`
+	// The height up to which the blocks are included on DA, whose headers no longer change
+	DaIncludedHeight uint64 `protobuf:"varint,3,opt,name=da_included_height,json=daIncludedHeight,proto3" json:"da_included_height,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *GetHeaderRangeResponse) Reset() {
+	*x = GetHeaderRangeResponse{}
+	mi := &file_evnode_v1_state_rpc_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetHeaderRangeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetHeaderRangeResponse) ProtoMessage() {}
+
+func (x *GetHeaderRangeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_evnode_v1_state_rpc_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetHeaderRangeResponse.ProtoReflect.Descriptor instead.
+func (*GetHeaderRangeResponse) Descriptor() ([]byte, []int) {
+	return file_evnode_v1_state_rpc_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *GetHeaderRangeResponse) GetHeaders() []*SignedHeader {
+	if x != nil {
+		return x.Headers
+	}
+	return nil
+}
+
+func (x *GetHeaderRangeResponse) GetPage() *PageResponse {
+	if x != nil {
+		return x.Page
+	}
+	return nil
+}
+
+func (x *GetHeaderRangeResponse) GetDaIncludedHeight() uint64 {
+	if x != nil {
+		return x.DaIncludedHeight
+	}
+	return 0
+}
+
+// SearchBlocksRequest defines the request for searching blocks. Unset predicates match any block.
+type SearchBlocksRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The address of the proposer of the blocks
+	ProposerAddress []byte `protobuf:"bytes,1,opt,name=proposer_address,json=proposerAddress,proto3" json:"proposer_address,omitempty"`
+	// The minimum number of transactions of the blocks
+	MinTxCount uint64 `protobuf:"varint,2,opt,name=min_tx_count,json=minTxCount,proto3" json:"min_tx_count,omitempty"`
+	// The maximum number of transactions of the blocks
+	MaxTxCount *uint64 `protobuf:"varint,3,opt,name=max_tx_count,json=maxTxCount,proto3,oneof" json:"max_tx_count,omitempty"`
+	// The blocks produced at or after the time
+	StartTime *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	// The blocks produced before the time
+	EndTime *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`
+	// The first height searched
+	FromHeight uint64 `protobuf:"varint,6,opt,name=from_height,json=fromHeight,proto3" json:"from_height,omitempty"`
+	// The last height searched, or 0 for the latest block
+	ToHeight uint64 `protobuf:"varint,7,opt,name=to_height,json=toHeight,proto3" json:"to_height,omitempty"`
+	// The page of the search, 100 blocks by default and at most 1000. The page token overrides
+	// from_height.
+	Page          *PageRequest `protobuf:"bytes,9,opt,name=page,proto3" json:"page,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SearchBlocksRequest) Reset() {
+	*x = SearchBlocksRequest{}
+	mi := &file_evnode_v1_state_rpc_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchBlocksRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchBlocksRequest) ProtoMessage() {}
+
+func (x *SearchBlocksRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_evnode_v1_state_rpc_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchBlocksRequest.ProtoReflect.Descriptor instead.
+func (*SearchBlocksRequest) Descriptor() ([]byte, []int) {
+	return file_evnode_v1_state_rpc_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *SearchBlocksRequest) GetProposerAddress() []byte {
+	if x != nil {
+		return x.ProposerAddress
+	}
+	return nil
+}
+
+func (x *SearchBlocksRequest) GetMinTxCount() uint64 {
+	if x != nil {
+		return x.MinTxCount
+	}
+	return 0
+}
+
+func (x *SearchBlocksRequest) GetMaxTxCount() uint64 {
+	if x != nil && x.MaxTxCount != nil {
+		return *x.MaxTxCount
+	}
+	return 0
+}
+
+func (x *SearchBlocksRequest) GetStartTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartTime
+	}
+	return nil
+}
+
+func (x *SearchBlocksRequest) GetEndTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EndTime
+	}
+	return nil
+}
+
+func (x *SearchBlocksRequest) GetFromHeight() uint64 {
+	if x != nil {
+		return x.FromHeight
+	}
+	return 0
+}
+
+func (x *SearchBlocksRequest) GetToHeight() uint64 {
+	if x != nil {
+		return x.ToHeight
+	}
+	return 0
+}
+
+func (x *SearchBlocksRequest) GetPage() *PageRequest {
+	if x != nil {
+		return x.Page
+	}
+	return nil
+}
+
+// BlockSummary is the metadata of a block
+type BlockSummary struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Height          uint64                 `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	Hash            []byte                 `protobuf:"bytes,2,opt,name=hash,proto3" json:"hash,omitempty"`
+	Time            *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=time,proto3" json:"time,omitempty"`
+	ProposerAddress []byte                 `protobuf:"bytes,4,opt,name=proposer_address,json=proposerAddress,proto3" json:"proposer_address,omitempty"`
+	TxCount         uint64                 `protobuf:"varint,5,opt,name=tx_count,json=txCount,proto3" json:"tx_count,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *BlockSummary) Reset() {
+	*x = BlockSummary{}
+	mi := &file_evnode_v1_state_rpc_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BlockSummary) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BlockSummary) ProtoMessage() {}
+
+func (x *BlockSummary) ProtoReflect() protoreflect.Message {
+	mi := &file_evnode_v1_state_rpc_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BlockSummary.ProtoReflect.Descriptor instead.
+func (*BlockSummary) Descriptor() ([]byte, []int) {
+	return file_evnode_v1_state_rpc_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *BlockSummary) GetHeight() uint64 {
+	if x != nil {
+		return x.Height
+	}
+	return 0
+}
+
+func (x *BlockSummary) GetHash() []byte {
+	if x != nil {
+		return x.Hash
+	}
+	return nil
+}
+
+func (x *BlockSummary) GetTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Time
+	}
+	return nil
+}
+
+func (x *BlockSummary) GetProposerAddress() []byte {
+	if x != nil {
+		return x.ProposerAddress
+	}
+	return nil
+}
+
+func (x *BlockSummary) GetTxCount() uint64 {
+	if x != nil {
+		return x.TxCount
+	}
+	return 0
+}
+
+// SearchBlocksResponse defines the response for searching blocks
+type SearchBlocksResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The matching blocks in ascending height order
+	Blocks []*BlockSummary `protobuf:"bytes,1,rep,name=blocks,proto3" json:"blocks,omitempty"`
+	// The page returned, without next_page_token if the search is complete. The total is not
+	// counted.
+	Page          *PageResponse `protobuf:"bytes,3,opt,name=page,proto3" json:"page,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SearchBlocksResponse) Reset() {
+	*x = SearchBlocksResponse{}
+	mi := &file_evnode_v1_state_rpc_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchBlocksResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchBlocksResponse) ProtoMessage() {}
+
+func (x *SearchBlocksResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_evnode_v1_state_rpc_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchBlocksResponse.ProtoReflect.Descriptor instead.
+func (*SearchBlocksResponse) Descriptor() ([]byte, []int) {
+	return file_evnode_v1_state_rpc_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *SearchBlocksResponse) GetBlocks() []*BlockSummary {
+	if x != nil {
+		return x.Blocks
+	}
+	return nil
+}
+
+func (x *SearchBlocksResponse) GetPage() *PageResponse {
+	if x != nil {
+		return x.Page
+	}
+	return nil
+}
+
+// GetStateResponse defines the response for retrieving the current state
+type GetStateResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	State         *State                 `protobuf:"bytes,1,opt,name=state,proto3" json:"state,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetStateResponse) Reset() {
+	*x = GetStateResponse{}
+	mi := &file_evnode_v1_state_rpc_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetStateResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetStateResponse) ProtoMessage() {}
+
+func (x *GetStateResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_evnode_v1_state_rpc_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetStateResponse.ProtoReflect.Descriptor instead.
+func (*GetStateResponse) Descriptor() ([]byte, []int) {
+	return file_evnode_v1_state_rpc_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *GetStateResponse) GetState() *State {
+	if x != nil {
+		return x.State
+	}
+	return nil
+}
+
+// GetMetadataRequest defines the request for retrieving metadata by key
+type GetMetadataRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Key           string                 `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetMetadataRequest) Reset() {
+	*x = GetMetadataRequest{}
+	mi := &file_evnode_v1_state_rpc_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetMetadataRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetMetadataRequest) ProtoMessage() {}
+
+func (x *GetMetadataRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_evnode_v1_state_rpc_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetMetadataRequest.ProtoReflect.Descriptor instead.
+func (*GetMetadataRequest) Descriptor() ([]byte, []int) {
+	return file_evnode_v1_state_rpc_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *GetMetadataRequest) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+// GetMetadataResponse defines the response for retrieving metadata
+type GetMetadataResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Value         []byte                 `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetMetadataResponse) Reset() {
+	*x = GetMetadataResponse{}
+	mi := &file_evnode_v1_state_rpc_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetMetadataResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetMetadataResponse) ProtoMessage() {}
+
+func (x *GetMetadataResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_evnode_v1_state_rpc_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetMetadataResponse.ProtoReflect.Descriptor instead.
+func (*GetMetadataResponse) Descriptor() ([]byte, []int) {
+	return file_evnode_v1_state_rpc_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *GetMetadataResponse) GetValue() []byte {
+	if x != nil {
+		return x.Value
+	}
+	return nil
+}
+
+// GetMetadataBatchRequest defines the request for retrieving the metadata of several keys
+type GetMetadataBatchRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The keys to retrieve, at most 1000
+	Keys          []string `protobuf:"bytes,1,rep,name=keys,proto3" json:"keys,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetMetadataBatchRequest) Reset() {
+	*x = GetMetadataBatchRequest{}
+	mi := &file_evnode_v1_state_rpc_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetMetadataBatchRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetMetadataBatchRequest) ProtoMessage() {}
+
+func (x *GetMetadataBatchRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_evnode_v1_state_rpc_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetMetadataBatchRequest.ProtoReflect.Descriptor instead.
+func (*GetMetadataBatchRequest) Descriptor() ([]byte, []int) {
+	return file_evnode_v1_state_rpc_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *GetMetadataBatchRequest) GetKeys() []string {
+	if x != nil {
+		return x.Keys
+	}
+	return nil
+}
+
+// MetadataEntry is the value of a metadata key
+type MetadataEntry struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Key   string                 `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Value []byte                 `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+	// Whether the key is set, value being empty otherwise
+	Found         bool `protobuf:"varint,3,opt,name=found,proto3" json:"found,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MetadataEntry) Reset() {
+	*x = MetadataEntry{}
+	mi := &file_evnode_v1_state_rpc_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MetadataEntry) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MetadataEntry) ProtoMessage() {}
+
+func (x *MetadataEntry) ProtoReflect() protoreflect.Message {
+	mi := &file_evnode_v1_state_rpc_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MetadataEntry.ProtoReflect.Descriptor instead.
+func (*MetadataEntry) Descriptor() ([]byte, []int) {
+	return file_evnode_v1_state_rpc_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *MetadataEntry) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *MetadataEntry) GetValue() []byte {
+	if x != nil {
+		return x.Value
+	}
+	return nil
+}
+
+func (x *MetadataEntry) GetFound() bool {
+	if x != nil {
+		return x.Found
+	}
+	return false
+}
+
+// GetMetadataBatchResponse defines the response for retrieving the metadata of several keys
+type GetMetadataBatchResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The entries of the keys, in the order of the request
+	Entries       []*MetadataEntry `protobuf:"bytes,1,rep,name=entries,proto3" json:"entries,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetMetadataBatchResponse) Reset() {
+	*x = GetMetadataBatchResponse{}
+	mi := &file_evnode_v1_state_rpc_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetMetadataBatchResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetMetadataBatchResponse) ProtoMessage() {}
+
+func (x *GetMetadataBatchResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_evnode_v1_state_rpc_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetMetadataBatchResponse.ProtoReflect.Descriptor instead.
+func (*GetMetadataBatchResponse) Descriptor() ([]byte, []int) {
+	return file_evnode_v1_state_rpc_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *GetMetadataBatchResponse) GetEntries() []*MetadataEntry {
+	if x != nil {
+		return x.Entries
+	}
+	return nil
+}
+
+// GetStateDiffRequest defines the request for retrieving the state diff of a block
+type GetStateDiffRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Height        uint64                 `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetStateDiffRequest) Reset() {
+	*x = GetStateDiffRequest{}
+	mi := &file_evnode_v1_state_rpc_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetStateDiffRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetStateDiffRequest) ProtoMessage() {}
+
+func (x *GetStateDiffRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_evnode_v1_state_rpc_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetStateDiffRequest.ProtoReflect.Descriptor instead.
+func (*GetStateDiffRequest) Descriptor() ([]byte, []int) {
+	return file_evnode_v1_state_rpc_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *GetStateDiffRequest) GetHeight() uint64 {
+	if x != nil {
+		return x.Height
+	}
+	return 0
+}
+
+// GetStateDiffResponse defines the response for retrieving the state diff of a block
+type GetStateDiffResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Diff          *StateDiff             `protobuf:"bytes,1,opt,name=diff,proto3" json:"diff,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetStateDiffResponse) Reset() {
+	*x = GetStateDiffResponse{}
+	mi := &file_evnode_v1_state_rpc_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetStateDiffResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetStateDiffResponse) ProtoMessage() {}
+
+func (x *GetStateDiffResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_evnode_v1_state_rpc_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetStateDiffResponse.ProtoReflect.Descriptor instead.
+func (*GetStateDiffResponse) Descriptor() ([]byte, []int) {
+	return file_evnode_v1_state_rpc_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *GetStateDiffResponse) GetDiff() *StateDiff {
+	if x != nil {
+		return x.Diff
+	}
+	return nil
+}
+
+// GetSequencerFeesRequest defines the request for retrieving the sequencing fees of a block
+type GetSequencerFeesRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The height of the block, or 0 for the latest block
+	Height        uint64 `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetSequencerFeesRequest) Reset() {
+	*x = GetSequencerFeesRequest{}
+	mi := &file_evnode_v1_state_rpc_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetSequencerFeesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSequencerFeesRequest) ProtoMessage() {}
+
+func (x *GetSequencerFeesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_evnode_v1_state_rpc_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSequencerFeesRequest.ProtoReflect.Descriptor instead.
+func (*GetSequencerFeesRequest) Descriptor() ([]byte, []int) {
+	return file_evnode_v1_state_rpc_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *GetSequencerFeesRequest) GetHeight() uint64 {
+	if x != nil {
+		return x.Height
+	}
+	return 0
+}
+
+// GetSequencerFeesResponse defines the response for retrieving the sequencing fees of a block
+type GetSequencerFeesResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Fees  *SequencerFees         `protobuf:"bytes,1,opt,name=fees,proto3" json:"fees,omitempty"`
+	// Always true: the fees are accounted by the node from its own execution layer after the block
+	// is committed, are not covered by the signature of the block and cannot be verified by clients
+	Unverified    bool `protobuf:"varint,2,opt,name=unverified,proto3" json:"unverified,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetSequencerFeesResponse) Reset() {
+	*x = GetSequencerFeesResponse{}
+	mi := &file_evnode_v1_state_rpc_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetSequencerFeesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSequencerFeesResponse) ProtoMessage() {}
+
+func (x *GetSequencerFeesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_evnode_v1_state_rpc_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSequencerFeesResponse.ProtoReflect.Descriptor instead.
+func (*GetSequencerFeesResponse) Descriptor() ([]byte, []int) {
+	return file_evnode_v1_state_rpc_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *GetSequencerFeesResponse) GetFees() *SequencerFees {
+	if x != nil {
+		return x.Fees
+	}
+	return nil
+}
+
+func (x *GetSequencerFeesResponse) GetUnverified() bool {
+	if x != nil {
+		return x.Unverified
+	}
+	return false
+}
+
+// Event is a significant node event recorded in the event journal
+type Event struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Sequence      uint64                 `protobuf:"varint,1,opt,name=sequence,proto3" json:"sequence,omitempty"`
+	Time          *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=time,proto3" json:"time,omitempty"`
+	Type          string                 `protobuf:"bytes,3,opt,name=type,proto3" json:"type,omitempty"`
+	Message       string                 `protobuf:"bytes,4,opt,name=message,proto3" json:"message,omitempty"`
+	Attributes    map[string]string      `protobuf:"bytes,5,rep,name=attributes,proto3" json:"attributes,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Event) Reset() {
+	*x = Event{}
+	mi := &file_evnode_v1_state_rpc_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Event) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Event) ProtoMessage() {}
+
+func (x *Event) ProtoReflect() protoreflect.Message {
+	mi := &file_evnode_v1_state_rpc_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Event.ProtoReflect.Descriptor instead.
+func (*Event) Descriptor() ([]byte, []int) {
+	return file_evnode_v1_state_rpc_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *Event) GetSequence() uint64 {
+	if x != nil {
+		return x.Sequence
+	}
+	return 0
+}
+
+func (x *Event) GetTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Time
+	}
+	return nil
+}
+
+func (x *Event) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *Event) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *Event) GetAttributes() map[string]string {
+	if x != nil {
+		return x.Attributes
+	}
+	return nil
+}
+
+// GetEventsRequest defines the request for retrieving journal events
+type GetEventsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Only events recorded at or after from are returned, if set
+	From *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=from,proto3" json:"from,omitempty"`
+	// Only events recorded before to are returned, if set
+	To *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=to,proto3" json:"to,omitempty"`
+	// Only events of these types are returned, if not empty
+	Types []string `protobuf:"bytes,3,rep,name=types,proto3" json:"types,omitempty"`
+	// The page of the events, 100 by default and at most 1000. If unset, all the events are returned.
+	Page          *PageRequest `protobuf:"bytes,4,opt,name=page,proto3" json:"page,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetEventsRequest) Reset() {
+	*x = GetEventsRequest{}
+	mi := &file_evnode_v1_state_rpc_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetEventsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetEventsRequest) ProtoMessage() {}
+
+func (x *GetEventsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_evnode_v1_state_rpc_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetEventsRequest.ProtoReflect.Descriptor instead.
+func (*GetEventsRequest) Descriptor() ([]byte, []int) {
+	return file_evnode_v1_state_rpc_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *GetEventsRequest) GetFrom() *timestamppb.Timestamp {
+	if x != nil {
+		return x.From
+	}
+	return nil
+}
+
+func (x *GetEventsRequest) GetTo() *timestamppb.Timestamp {
+	if x != nil {
+		return x.To
+	}
+	return nil
+}
+
+func (x *GetEventsRequest) GetTypes() []string {
+	if x != nil {
+		return x.Types
+	}
+	return nil
+}
+
+func (x *GetEventsRequest) GetPage() *PageRequest {
+	if x != nil {
+		return x.Page
+	}
+	return nil
+}
+
+// GetEventsResponse defines the response for retrieving journal events
+type GetEventsResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The events in the order they were recorded
+	Events []*Event `protobuf:"bytes,1,rep,name=events,proto3" json:"events,omitempty"`
+	// The page returned, set if the request has a page
+	Page          *PageResponse `protobuf:"bytes,2,opt,name=page,proto3" json:"page,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetEventsResponse) Reset() {
+	*x = GetEventsResponse{}
+	mi := &file_evnode_v1_state_rpc_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetEventsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetEventsResponse) ProtoMessage() {}
+
+func (x *GetEventsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_evnode_v1_state_rpc_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetEventsResponse.ProtoReflect.Descriptor instead.
+func (*GetEventsResponse) Descriptor() ([]byte, []int) {
+	return file_evnode_v1_state_rpc_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *GetEventsResponse) GetEvents() []*Event {
+	if x != nil {
+		return x.Events
+	}
+	return nil
+}
+
+func (x *GetEventsResponse) GetPage() *PageResponse {
+	if x != nil {
+		return x.Page
+	}
+	return nil
+}
+
+// GetTxStatusRequest defines the request for retrieving the status of a transaction
+type GetTxStatusRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The SHA-256 hash of the raw transaction, or its execution layer hash (e.g. the keccak-256 hash
+	// of EVM transactions) if the executor of the node resolves them
+	TxHash []byte `protobuf:"bytes,1,opt,name=tx_hash,json=txHash,proto3" json:"tx_hash,omitempty"`
+	// If set, the response is delayed until the transaction is included in a block, for at most
+	// this duration, capped at one minute. The status is not INCLUDED if it was not included in time.
+	WaitForInclusion *durationpb.Duration `protobuf:"bytes,2,opt,name=wait_for_inclusion,json=waitForInclusion,proto3" json:"wait_for_inclusion,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *GetTxStatusRequest) Reset() {
+	*x = GetTxStatusRequest{}
+	mi := &file_evnode_v1_state_rpc_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTxStatusRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTxStatusRequest) ProtoMessage() {}
+
+func (x *GetTxStatusRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_evnode_v1_state_rpc_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTxStatusRequest.ProtoReflect.Descriptor instead.
+func (*GetTxStatusRequest) Descriptor() ([]byte, []int) {
+	return file_evnode_v1_state_rpc_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *GetTxStatusRequest) GetTxHash() []byte {
+	if x != nil {
+		return x.TxHash
+	}
+	return nil
+}
+
+func (x *GetTxStatusRequest) GetWaitForInclusion() *durationpb.Duration {
+	if x != nil {
+		return x.WaitForInclusion
+	}
+	return nil
+}
+
+// GetTxStatusResponse defines the response for retrieving the status of a transaction
+type GetTxStatusResponse struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	Status TxStatus               `protobuf:"varint,1,opt,name=status,proto3,enum=evnode.v1.TxStatus" json:"status,omitempty"`
+	// The height of the latest block including the transaction, if included
+	Height uint64 `protobuf:"varint,2,opt,name=height,proto3" json:"height,omitempty"`
+	// Whether the block including the transaction is included on DA
+	DaIncluded bool `protobuf:"varint,3,opt,name=da_included,json=daIncluded,proto3" json:"da_included,omitempty"`
+	// The DA height at which the data of the block was included, if known
+	DataDaHeight uint64 `protobuf:"varint,4,opt,name=data_da_height,json=dataDaHeight,proto3" json:"data_da_height,omitempty"`
+	// The index of the transaction in the data of the block, if has_index is set
+	Index uint32 `protobuf:"varint,5,opt,name=index,proto3" json:"index,omitempty"`
+	// Whether index is set, distinguishing the first transaction of a block from an unset index
+	HasIndex      bool `protobuf:"varint,6,opt,name=has_index,json=hasIndex,proto3" json:"has_index,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetTxStatusResponse) Reset() {
+	*x = GetTxStatusResponse{}
+	mi := &file_evnode_v1_state_rpc_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTxStatusResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTxStatusResponse) ProtoMessage() {}
+
+func (x *GetTxStatusResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_evnode_v1_state_rpc_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTxStatusResponse.ProtoReflect.Descriptor instead.
+func (*GetTxStatusResponse) Descriptor() ([]byte, []int) {
+	return file_evnode_v1_state_rpc_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *GetTxStatusResponse) GetStatus() TxStatus {
+	if x != nil {
+		return x.Status
+	}
+	return TxStatus_TX_STATUS_UNKNOWN
+}
+
+func (x *GetTxStatusResponse) GetHeight() uint64 {
+	if x != nil {
+		return x.Height
+	}
+	return 0
+}
+
+func (x *GetTxStatusResponse) GetDaIncluded() bool {
+	if x != nil {
+		return x.DaIncluded
+	}
+	return false
+}
+
+func (x *GetTxStatusResponse) GetDataDaHeight() uint64 {
+	if x != nil {
+		return x.DataDaHeight
+	}
+	return 0
+}
+
+func (x *GetTxStatusResponse) GetIndex() uint32 {
+	if x != nil {
+		return x.Index
+	}
+	return 0
+}
+
+func (x *GetTxStatusResponse) GetHasIndex() bool {
+	if x != nil {
+		return x.HasIndex
+	}
+	return false
+}
+
+// GetBlockByTxHashRequest defines the request for retrieving the block including a transaction
+type GetBlockByTxHashRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The SHA-256 hash of the raw transaction
+	TxHash        []byte `protobuf:"bytes,1,opt,name=tx_hash,json=txHash,proto3" json:"tx_hash,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetBlockByTxHashRequest) Reset() {
+	*x = GetBlockByTxHashRequest{}
+	mi := &file_evnode_v1_state_rpc_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetBlockByTxHashRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetBlockByTxHashRequest) ProtoMessage() {}
+
+func (x *GetBlockByTxHashRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_evnode_v1_state_rpc_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetBlockByTxHashRequest.ProtoReflect.Descriptor instead.
+func (*GetBlockByTxHashRequest) Descriptor() ([]byte, []int) {
+	return file_evnode_v1_state_rpc_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *GetBlockByTxHashRequest) GetTxHash() []byte {
+	if x != nil {
+		return x.TxHash
+	}
+	return nil
+}
+
+// GetBlockByTxHashResponse defines the response for retrieving the block including a transaction
+type GetBlockByTxHashResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The latest block including the transaction
+	Block          *Block `protobuf:"bytes,1,opt,name=block,proto3" json:"block,omitempty"`
+	HeaderDaHeight uint64 `protobuf:"varint,2,opt,name=header_da_height,json=headerDaHeight,proto3" json:"header_da_height,omitempty"`
+	DataDaHeight   uint64 `protobuf:"varint,3,opt,name=data_da_height,json=dataDaHeight,proto3" json:"data_da_height,omitempty"`
+	// The index of the transaction in the data of the block
+	Index         uint32 `protobuf:"varint,4,opt,name=index,proto3" json:"index,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetBlockByTxHashResponse) Reset() {
+	*x = GetBlockByTxHashResponse{}
+	mi := &file_evnode_v1_state_rpc_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetBlockByTxHashResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetBlockByTxHashResponse) ProtoMessage() {}
+
+func (x *GetBlockByTxHashResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_evnode_v1_state_rpc_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetBlockByTxHashResponse.ProtoReflect.Descriptor instead.
+func (*GetBlockByTxHashResponse) Descriptor() ([]byte, []int) {
+	return file_evnode_v1_state_rpc_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *GetBlockByTxHashResponse) GetBlock() *Block {
+	if x != nil {
+		return x.Block
+	}
+	return nil
+}
+
+func (x *GetBlockByTxHashResponse) GetHeaderDaHeight() uint64 {
+	if x != nil {
+		return x.HeaderDaHeight
+	}
+	return 0
+}
+
+func (x *GetBlockByTxHashResponse) GetDataDaHeight() uint64 {
+	if x != nil {
+		return x.DataDaHeight
+	}
+	return 0
+}
+
+func (x *GetBlockByTxHashResponse) GetIndex() uint32 {
+	if x != nil {
+		return x.Index
+	}
+	return 0
+}
+
+// GetTxProofRequest defines the request for retrieving the inclusion proof of a transaction
+type GetTxProofRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The SHA-256 hash of the raw transaction
+	TxHash        []byte `protobuf:"bytes,1,opt,name=tx_hash,json=txHash,proto3" json:"tx_hash,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetTxProofRequest) Reset() {
+	*x = GetTxProofRequest{}
+	mi := &file_evnode_v1_state_rpc_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTxProofRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTxProofRequest) ProtoMessage() {}
+
+func (x *GetTxProofRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_evnode_v1_state_rpc_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTxProofRequest.ProtoReflect.Descriptor instead.
+func (*GetTxProofRequest) Descriptor() ([]byte, []int) {
+	return file_evnode_v1_state_rpc_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *GetTxProofRequest) GetTxHash() []byte {
+	if x != nil {
+		return x.TxHash
+	}
+	return nil
+}
+
+// GetTxProofResponse defines the response for retrieving the inclusion proof of a transaction.
+// The transaction is included if the header is valid, the data hash of the header is the hash of
+// the transactions, and the transaction at the index has the requested hash.
+type GetTxProofResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The signed header of the latest block including the transaction
+	Header *SignedHeader `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
+	// All the transactions of the block, committed to by the data hash of the header
+	Txs [][]byte `protobuf:"bytes,2,rep,name=txs,proto3" json:"txs,omitempty"`
+	// The index of the transaction in txs
+	Index         uint32 `protobuf:"varint,3,opt,name=index,proto3" json:"index,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetTxProofResponse) Reset() {
+	*x = GetTxProofResponse{}
+	mi := &file_evnode_v1_state_rpc_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTxProofResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTxProofResponse) ProtoMessage() {}
+
+func (x *GetTxProofResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_evnode_v1_state_rpc_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTxProofResponse.ProtoReflect.Descriptor instead.
+func (*GetTxProofResponse) Descriptor() ([]byte, []int) {
+	return file_evnode_v1_state_rpc_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *GetTxProofResponse) GetHeader() *SignedHeader {
+	if x != nil {
+		return x.Header
+	}
+	return nil
+}
+
+func (x *GetTxProofResponse) GetTxs() [][]byte {
+	if x != nil {
+		return x.Txs
+	}
+	return nil
+}
+
+func (x *GetTxProofResponse) GetIndex() uint32 {
+	if x != nil {
+		return x.Index
+	}
+	return 0
+}
+
+// GetSyncStatusResponse defines the response for retrieving the sync progress of the node
+type GetSyncStatusResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The height of the latest block applied by the node
+	Height uint64 `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	// The height of the latest header known from the network, 0 if unknown
+	NetworkHeight uint64 `protobuf:"varint,2,opt,name=network_height,json=networkHeight,proto3" json:"network_height,omitempty"`
+	// The next DA height retrieved by the node
+	DaHeight uint64 `protobuf:"varint,3,opt,name=da_height,json=daHeight,proto3" json:"da_height,omitempty"`
+	// The height of the latest block whose header and data are included on DA
+	DaIncludedHeight uint64 `protobuf:"varint,4,opt,name=da_included_height,json=daIncludedHeight,proto3" json:"da_included_height,omitempty"`
+	// Whether the node is behind the network
+	Syncing bool `protobuf:"varint,5,opt,name=syncing,proto3" json:"syncing,omitempty"`
+	// The number of headers applied since the node started, by sync source
+	HeadersBySource map[string]uint64 `protobuf:"bytes,6,rep,name=headers_by_source,json=headersBySource,proto3" json:"headers_by_source,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"varint,2,opt,name=value"`
+	// The number of data applied since the node started, by sync source
+	DataBySource map[string]uint64 `protobuf:"bytes,7,rep,name=data_by_source,json=dataBySource,proto3" json:"data_by_source,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"varint,2,opt,name=value"`
+	// The height up to which the blocks were pruned, see node.pruning_strategy, 0 if none
+	PrunedBaseHeight uint64 `protobuf:"varint,8,opt,name=pruned_base_height,json=prunedBaseHeight,proto3" json:"pruned_base_height,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *GetSyncStatusResponse) Reset() {
+	*x = GetSyncStatusResponse{}
+	mi := &file_evnode_v1_state_rpc_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetSyncStatusResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSyncStatusResponse) ProtoMessage() {}
+
+func (x *GetSyncStatusResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_evnode_v1_state_rpc_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSyncStatusResponse.ProtoReflect.Descriptor instead.
+func (*GetSyncStatusResponse) Descriptor() ([]byte, []int) {
+	return file_evnode_v1_state_rpc_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *GetSyncStatusResponse) GetHeight() uint64 {
+	if x != nil {
+		return x.Height
+	}
+	return 0
+}
+
+func (x *GetSyncStatusResponse) GetNetworkHeight() uint64 {
+	if x != nil {
+		return x.NetworkHeight
+	}
+	return 0
+}
+
+func (x *GetSyncStatusResponse) GetDaHeight() uint64 {
+	if x != nil {
+		return x.DaHeight
+	}
+	return 0
+}
+
+func (x *GetSyncStatusResponse) GetDaIncludedHeight() uint64 {
+	if x != nil {
+		return x.DaIncludedHeight
+	}
+	return 0
+}
+
+func (x *GetSyncStatusResponse) GetSyncing() bool {
+	if x != nil {
+		return x.Syncing
+	}
+	return false
+}
+
+func (x *GetSyncStatusResponse) GetHeadersBySource() map[string]uint64 {
+	if x != nil {
+		return x.HeadersBySource
+	}
+	return nil
+}
+
+func (x *GetSyncStatusResponse) GetDataBySource() map[string]uint64 {
+	if x != nil {
+		return x.DataBySource
+	}
+	return nil
+}
+
+func (x *GetSyncStatusResponse) GetPrunedBaseHeight() uint64 {
+	if x != nil {
+		return x.PrunedBaseHeight
+	}
+	return 0
+}
+
+// GetDAInclusionProofRequest defines the request for retrieving the DA inclusion proof of a block
+type GetDAInclusionProofRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Height        uint64                 `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetDAInclusionProofRequest) Reset() {
+	*x = GetDAInclusionProofRequest{}
+	mi := &file_evnode_v1_state_rpc_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetDAInclusionProofRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetDAInclusionProofRequest) ProtoMessage() {}
+
+func (x *GetDAInclusionProofRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_evnode_v1_state_rpc_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetDAInclusionProofRequest.ProtoReflect.Descriptor instead.
+func (*GetDAInclusionProofRequest) Descriptor() ([]byte, []int) {
+	return file_evnode_v1_state_rpc_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *GetDAInclusionProofRequest) GetHeight() uint64 {
+	if x != nil {
+		return x.Height
+	}
+	return 0
+}
+
+// DABlobInclusion locates a blob on the DA layer and proves its inclusion
+type DABlobInclusion struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The DA height at which the blob was included
+	DaHeight uint64 `protobuf:"varint,1,opt,name=da_height,json=daHeight,proto3" json:"da_height,omitempty"`
+	// The namespace the blob was submitted to
+	Namespace string `protobuf:"bytes,2,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	// The ID of the blob on the DA layer
+	Id []byte `protobuf:"bytes,3,opt,name=id,proto3" json:"id,omitempty"`
+	// The commitment to the blob
+	Commitment []byte `protobuf:"bytes,4,opt,name=commitment,proto3" json:"commitment,omitempty"`
+	// The inclusion proof of the blob, in the format of the DA layer
+	Proof         []byte `protobuf:"bytes,5,opt,name=proof,proto3" json:"proof,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DABlobInclusion) Reset() {
+	*x = DABlobInclusion{}
+	mi := &file_evnode_v1_state_rpc_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DABlobInclusion) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DABlobInclusion) ProtoMessage() {}
+
+func (x *DABlobInclusion) ProtoReflect() protoreflect.Message {
+	mi := &file_evnode_v1_state_rpc_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DABlobInclusion.ProtoReflect.Descriptor instead.
+func (*DABlobInclusion) Descriptor() ([]byte, []int) {
+	return file_evnode_v1_state_rpc_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *DABlobInclusion) GetDaHeight() uint64 {
+	if x != nil {
+		return x.DaHeight
+	}
+	return 0
+}
+
+func (x *DABlobInclusion) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
+func (x *DABlobInclusion) GetId() []byte {
+	if x != nil {
+		return x.Id
+	}
+	return nil
+}
+
+func (x *DABlobInclusion) GetCommitment() []byte {
+	if x != nil {
+		return x.Commitment
+	}
+	return nil
+}
+
+func (x *DABlobInclusion) GetProof() []byte {
+	if x != nil {
+		return x.Proof
+	}
+	return nil
+}
+
+// GetDAInclusionProofResponse defines the response for retrieving the DA inclusion proof of a block
+type GetDAInclusionProofResponse struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	Height uint64                 `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	// The blob containing the header of the block
+	Header *DABlobInclusion `protobuf:"bytes,2,opt,name=header,proto3" json:"header,omitempty"`
+	// The blob containing the data of the block, unset for blocks without transactions, whose
+	// data is not submitted. Equal to header if they were submitted in a single blob.
+	Data          *DABlobInclusion `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetDAInclusionProofResponse) Reset() {
+	*x = GetDAInclusionProofResponse{}
+	mi := &file_evnode_v1_state_rpc_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetDAInclusionProofResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetDAInclusionProofResponse) ProtoMessage() {}
+
+func (x *GetDAInclusionProofResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_evnode_v1_state_rpc_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetDAInclusionProofResponse.ProtoReflect.Descriptor instead.
+func (*GetDAInclusionProofResponse) Descriptor() ([]byte, []int) {
+	return file_evnode_v1_state_rpc_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *GetDAInclusionProofResponse) GetHeight() uint64 {
+	if x != nil {
+		return x.Height
+	}
+	return 0
+}
+
+func (x *GetDAInclusionProofResponse) GetHeader() *DABlobInclusion {
+	if x != nil {
+		return x.Header
+	}
+	return nil
+}
+
+func (x *GetDAInclusionProofResponse) GetData() *DABlobInclusion {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+// GetDAInfoResponse describes the DA layer of the node, so that verifiers can check they use the
+// same DA coordinates as the node
+type GetDAInfoResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The name of the DA client of the node, e.g. "jsonrpc", "unknown" if it does not report one
+	Backend string `protobuf:"bytes,1,opt,name=backend,proto3" json:"backend,omitempty"`
+	// The ID of the DA network, empty if not reported by the DA layer
+	NetworkId string `protobuf:"bytes,2,opt,name=network_id,json=networkId,proto3" json:"network_id,omitempty"`
+	// The namespace the headers are submitted to, hex encoded as on the DA layer
+	HeaderNamespace string `protobuf:"bytes,3,opt,name=header_namespace,json=headerNamespace,proto3" json:"header_namespace,omitempty"`
+	// The namespace the data is submitted to, hex encoded as on the DA layer
+	DataNamespace string `protobuf:"bytes,4,opt,name=data_namespace,json=dataNamespace,proto3" json:"data_namespace,omitempty"`
+	// The maximum size of a blob in bytes, 0 if not reported by the DA layer
+	MaxBlobSize uint64 `protobuf:"varint,5,opt,name=max_blob_size,json=maxBlobSize,proto3" json:"max_blob_size,omitempty"`
+	// The next DA height retrieved by the node, 0 if the node does not sync from DA
+	DaHeight uint64 `protobuf:"varint,6,opt,name=da_height,json=daHeight,proto3" json:"da_height,omitempty"`
+	// The height of the latest block included on DA
+	DaIncludedHeight uint64 `protobuf:"varint,7,opt,name=da_included_height,json=daIncludedHeight,proto3" json:"da_included_height,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *GetDAInfoResponse) Reset() {
+	*x = GetDAInfoResponse{}
+	mi := &file_evnode_v1_state_rpc_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetDAInfoResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetDAInfoResponse) ProtoMessage() {}
+
+func (x *GetDAInfoResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_evnode_v1_state_rpc_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetDAInfoResponse.ProtoReflect.Descriptor instead.
+func (*GetDAInfoResponse) Descriptor() ([]byte, []int) {
+	return file_evnode_v1_state_rpc_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *GetDAInfoResponse) GetBackend() string {
+	if x != nil {
+		return x.Backend
+	}
+	return ""
+}
+
+func (x *GetDAInfoResponse) GetNetworkId() string {
+	if x != nil {
+		return x.NetworkId
+	}
+	return ""
+}
+
+func (x *GetDAInfoResponse) GetHeaderNamespace() string {
+	if x != nil {
+		return x.HeaderNamespace
+	}
+	return ""
+}
+
+func (x *GetDAInfoResponse) GetDataNamespace() string {
+	if x != nil {
+		return x.DataNamespace
+	}
+	return ""
+}
+
+func (x *GetDAInfoResponse) GetMaxBlobSize() uint64 {
+	if x != nil {
+		return x.MaxBlobSize
+	}
+	return 0
+}
+
+func (x *GetDAInfoResponse) GetDaHeight() uint64 {
+	if x != nil {
+		return x.DaHeight
+	}
+	return 0
+}
+
+func (x *GetDAInfoResponse) GetDaIncludedHeight() uint64 {
+	if x != nil {
+		return x.DaIncludedHeight
+	}
+	return 0
+}
+
+// GetExecutionConsistencyRequest defines the request for checking the consistency of the latest
+// heights with the execution layer
+type GetExecutionConsistencyRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The number of latest heights to check, 10 if 0
+	Count         uint32 `protobuf:"varint,1,opt,name=count,proto3" json:"count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetExecutionConsistencyRequest) Reset() {
+	*x = GetExecutionConsistencyRequest{}
+	mi := &file_evnode_v1_state_rpc_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetExecutionConsistencyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetExecutionConsistencyRequest) ProtoMessage() {}
+
+func (x *GetExecutionConsistencyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_evnode_v1_state_rpc_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetExecutionConsistencyRequest.ProtoReflect.Descriptor instead.
+func (*GetExecutionConsistencyRequest) Descriptor() ([]byte, []int) {
+	return file_evnode_v1_state_rpc_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *GetExecutionConsistencyRequest) GetCount() uint32 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+// ExecutionBlockMapping maps a height to the block of the execution layer built for it
+type ExecutionBlockMapping struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	Height uint64                 `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	// The number of the execution block
+	ExecutionNumber uint64 `protobuf:"varint,2,opt,name=execution_number,json=executionNumber,proto3" json:"execution_number,omitempty"`
+	// The hash of the execution block
+	ExecutionHash []byte `protobuf:"bytes,3,opt,name=execution_hash,json=executionHash,proto3" json:"execution_hash,omitempty"`
+	// The state root of the execution block
+	ExecutionStateRoot []byte `protobuf:"bytes,4,opt,name=execution_state_root,json=executionStateRoot,proto3" json:"execution_state_root,omitempty"`
+	// The state root after the height according to the store: the app hash of the next header,
+	// or of the state for the latest height
+	ExpectedStateRoot []byte `protobuf:"bytes,5,opt,name=expected_state_root,json=expectedStateRoot,proto3" json:"expected_state_root,omitempty"`
+	// Whether the execution block exists and its state root is the expected one
+	Consistent bool `protobuf:"varint,6,opt,name=consistent,proto3" json:"consistent,omitempty"`
+	// The error getting the execution block, if any
+	Error         string `protobuf:"bytes,7,opt,name=error,proto3" json:"error,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExecutionBlockMapping) Reset() {
+	*x = ExecutionBlockMapping{}
+	mi := &file_evnode_v1_state_rpc_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExecutionBlockMapping) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExecutionBlockMapping) ProtoMessage() {}
+
+func (x *ExecutionBlockMapping) ProtoReflect() protoreflect.Message {
+	mi := &file_evnode_v1_state_rpc_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExecutionBlockMapping.ProtoReflect.Descriptor instead.
+func (*ExecutionBlockMapping) Descriptor() ([]byte, []int) {
+	return file_evnode_v1_state_rpc_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *ExecutionBlockMapping) GetHeight() uint64 {
+	if x != nil {
+		return x.Height
+	}
+	return 0
+}
+
+func (x *ExecutionBlockMapping) GetExecutionNumber() uint64 {
+	if x != nil {
+		return x.ExecutionNumber
+	}
+	return 0
+}
+
+func (x *ExecutionBlockMapping) GetExecutionHash() []byte {
+	if x != nil {
+		return x.ExecutionHash
+	}
+	return nil
+}
+
+func (x *ExecutionBlockMapping) GetExecutionStateRoot() []byte {
+	if x != nil {
+		return x.ExecutionStateRoot
+	}
+	return nil
+}
+
+func (x *ExecutionBlockMapping) GetExpectedStateRoot() []byte {
+	if x != nil {
+		return x.ExpectedStateRoot
+	}
+	return nil
+}
+
+func (x *ExecutionBlockMapping) GetConsistent() bool {
+	if x != nil {
+		return x.Consistent
+	}
+	return false
+}
+
+func (x *ExecutionBlockMapping) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+// GetExecutionConsistencyResponse defines the response for checking the consistency of the latest
+// heights with the execution layer
+type GetExecutionConsistencyResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The height of the store
+	Height uint64 `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	// The number of the latest block of the execution layer
+	ExecutionHeight uint64 `protobuf:"varint,2,opt,name=execution_height,json=executionHeight,proto3" json:"execution_height,omitempty"`
+	// The execution height minus the height: positive if the execution layer is ahead of the
+	// store, negative if it is behind
+	Drift int64 `protobuf:"varint,3,opt,name=drift,proto3" json:"drift,omitempty"`
+	// Whether every checked height is consistent and there is no drift
+	Consistent bool `protobuf:"varint,4,opt,name=consistent,proto3" json:"consistent,omitempty"`
+	// The checked heights, from the latest
+	Blocks        []*ExecutionBlockMapping `protobuf:"bytes,5,rep,name=blocks,proto3" json:"blocks,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetExecutionConsistencyResponse) Reset() {
+	*x = GetExecutionConsistencyResponse{}
+	mi := &file_evnode_v1_state_rpc_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetExecutionConsistencyResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetExecutionConsistencyResponse) ProtoMessage() {}
+
+func (x *GetExecutionConsistencyResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_evnode_v1_state_rpc_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetExecutionConsistencyResponse.ProtoReflect.Descriptor instead.
+func (*GetExecutionConsistencyResponse) Descriptor() ([]byte, []int) {
+	return file_evnode_v1_state_rpc_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *GetExecutionConsistencyResponse) GetHeight() uint64 {
+	if x != nil {
+		return x.Height
+	}
+	return 0
+}
+
+func (x *GetExecutionConsistencyResponse) GetExecutionHeight() uint64 {
+	if x != nil {
+		return x.ExecutionHeight
+	}
+	return 0
+}
+
+func (x *GetExecutionConsistencyResponse) GetDrift() int64 {
+	if x != nil {
+		return x.Drift
+	}
+	return 0
+}
+
+func (x *GetExecutionConsistencyResponse) GetConsistent() bool {
+	if x != nil {
+		return x.Consistent
+	}
+	return false
+}
+
+func (x *GetExecutionConsistencyResponse) GetBlocks() []*ExecutionBlockMapping {
+	if x != nil {
+		return x.Blocks
+	}
+	return nil
+}
+
+var File_evnode_v1_state_rpc_proto protoreflect.FileDescriptor
+
+const file_evnode_v1_state_rpc_proto_rawDesc = "" +
+	"\n" +
+	"\x19evnode/v1/state_rpc.proto\x12\tevnode.v1\x1a\x1egoogle/protobuf/duration.proto\x1a\x1bgoogle/protobuf/empty.proto\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x16evnode/v1/evnode.proto\x1a\x1aevnode/v1/pagination.proto\x1a\x15evnode/v1/state.proto\"]\n" +
+	"\x05Block\x12/\n" +
+	"\x06header\x18\x01 \x01(\v2\x17.evnode.v1.SignedHeaderR\x06header\x12#\n" +
+	"\x04data\x18\x02 \x01(\v2\x0f.evnode.v1.DataR\x04data\"O\n" +
+	"\x0fGetBlockRequest\x12\x18\n" +
+	"\x06height\x18\x01 \x01(\x04H\x00R\x06height\x12\x14\n" +
+	"\x04hash\x18\x02 \x01(\fH\x00R\x04hashB\f\n" +
+	"\n" +
+	"identifier\"\x8a\x01\n" +
+	"\x10GetBlockResponse\x12&\n" +
+	"\x05block\x18\x01 \x01(\v2\x10.evnode.v1.BlockR\x05block\x12(\n" +
+	"\x10header_da_height\x18\x02 \x01(\x04R\x0eheaderDaHeight\x12$\n" +
+	"\x0edata_da_height\x18\x03 \x01(\x04R\fdataDaHeight\"{\n" +
+	"\x15GetBlockStreamRequest\x12\x18\n" +
+	"\x06height\x18\x01 \x01(\x04H\x00R\x06height\x12\x14\n" +
+	"\x04hash\x18\x02 \x01(\fH\x00R\x04hash\x12$\n" +
+	"\x0emax_chunk_size\x18\x03 \x01(\x04R\fmaxChunkSizeB\f\n" +
+	"\n" +
+	"identifier\"\xf7\x01\n" +
+	"\x16GetBlockStreamResponse\x12/\n" +
+	"\x06header\x18\x01 \x01(\v2\x17.evnode.v1.SignedHeaderR\x06header\x12/\n" +
+	"\bmetadata\x18\x02 \x01(\v2\x13.evnode.v1.MetadataR\bmetadata\x12(\n" +
+	"\x10header_da_height\x18\x03 \x01(\x04R\x0eheaderDaHeight\x12$\n" +
+	"\x0edata_da_height\x18\x04 \x01(\x04R\fdataDaHeight\x12\x19\n" +
+	"\btx_count\x18\x05 \x01(\x04R\atxCount\x12\x10\n" +
+	"\x03txs\x18\x06 \x03(\fR\x03txs\"9\n" +
+	"\x16SubscribeBlocksRequest\x12\x1f\n" +
+	"\vfrom_height\x18\x01 \x01(\x04R\n" +
+	"fromHeight\"A\n" +
+	"\x17SubscribeBlocksResponse\x12&\n" +
+	"\x05block\x18\x01 \x01(\v2\x10.evnode.v1.BlockR\x05block\"H\n" +
+	"\x1eSubscribePreviewBlocksResponse\x12&\n" +
+	"\x05block\x18\x01 \x01(\v2\x10.evnode.v1.BlockR\x05block\"*\n" +
+	"\x10GetHeaderRequest\x12\x16\n" +
+	"\x06height\x18\x01 \x01(\x04R\x06height\"\x84\x01\n" +
+	"\x11GetHeaderResponse\x12/\n" +
+	"\x06header\x18\x01 \x01(\v2\x17.evnode.v1.SignedHeaderR\x06header\x12(\n" +
+	"\x10header_da_height\x18\x02 \x01(\x04R\x0eheaderDaHeightJ\x04\b\x03\x10\x04R\x0esequencer_fees\"\x81\x01\n" +
+	"\x15GetHeaderRangeRequest\x12\x1f\n" +
+	"\vfrom_height\x18\x01 \x01(\x04R\n" +
+	"fromHeight\x12\x1b\n" +
+	"\tto_height\x18\x02 \x01(\x04R\btoHeight\x12*\n" +
+	"\x04page\x18\x03 \x01(\v2\x16.evnode.v1.PageRequestR\x04page\"\xa6\x01\n" +
+	"\x16GetHeaderRangeResponse\x121\n" +
+	"\aheaders\x18\x01 \x03(\v2\x17.evnode.v1.SignedHeaderR\aheaders\x12+\n" +
+	"\x04page\x18\x02 \x01(\v2\x17.evnode.v1.PageResponseR\x04page\x12,\n" +
+	"\x12da_included_height\x18\x03 \x01(\x04R\x10daIncludedHeight\"\x83\x03\n" +
+	"\x13SearchBlocksRequest\x12)\n" +
+	"\x10proposer_address\x18\x01 \x01(\fR\x0fproposerAddress\x12 \n" +
+	"\fmin_tx_count\x18\x02 \x01(\x04R\n" +
+	"minTxCount\x12%\n" +
+	"\fmax_tx_count\x18\x03 \x01(\x04H\x00R\n" +
+	"maxTxCount\x88\x01\x01\x129\n" +
+	"\n" +
+	"start_time\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\tstartTime\x125\n" +
+	"\bend_time\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\aendTime\x12\x1f\n" +
+	"\vfrom_height\x18\x06 \x01(\x04R\n" +
+	"fromHeight\x12\x1b\n" +
+	"\tto_height\x18\a \x01(\x04R\btoHeight\x12*\n" +
+	"\x04page\x18\t \x01(\v2\x16.evnode.v1.PageRequestR\x04pageB\x0f\n" +
+	"\r_max_tx_countJ\x04\b\b\x10\tR\x05limit\"\xb0\x01\n" +
+	"\fBlockSummary\x12\x16\n" +
+	"\x06height\x18\x01 \x01(\x04R\x06height\x12\x12\n" +
+	"\x04hash\x18\x02 \x01(\fR\x04hash\x12.\n" +
+	"\x04time\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\x04time\x12)\n" +
+	"\x10proposer_address\x18\x04 \x01(\fR\x0fproposerAddress\x12\x19\n" +
+	"\btx_count\x18\x05 \x01(\x04R\atxCount\"\x87\x01\n" +
+	"\x14SearchBlocksResponse\x12/\n" +
+	"\x06blocks\x18\x01 \x03(\v2\x17.evnode.v1.BlockSummaryR\x06blocks\x12+\n" +
+	"\x04page\x18\x03 \x01(\v2\x17.evnode.v1.PageResponseR\x04pageJ\x04\b\x02\x10\x03R\vnext_height\":\n" +
+	"\x10GetStateResponse\x12&\n" +
+	"\x05state\x18\x01 \x01(\v2\x10.evnode.v1.StateR\x05state\"&\n" +
+	"\x12GetMetadataRequest\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\"+\n" +
+	"\x13GetMetadataResponse\x12\x14\n" +
+	"\x05value\x18\x01 \x01(\fR\x05value\"-\n" +
+	"\x17GetMetadataBatchRequest\x12\x12\n" +
+	"\x04keys\x18\x01 \x03(\tR\x04keys\"M\n" +
+	"\rMetadataEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\fR\x05value\x12\x14\n" +
+	"\x05found\x18\x03 \x01(\bR\x05found\"N\n" +
+	"\x18GetMetadataBatchResponse\x122\n" +
+	"\aentries\x18\x01 \x03(\v2\x18.evnode.v1.MetadataEntryR\aentries\"-\n" +
+	"\x13GetStateDiffRequest\x12\x16\n" +
+	"\x06height\x18\x01 \x01(\x04R\x06height\"@\n" +
+	"\x14GetStateDiffResponse\x12(\n" +
+	"\x04diff\x18\x01 \x01(\v2\x14.evnode.v1.StateDiffR\x04diff\"1\n" +
+	"\x17GetSequencerFeesRequest\x12\x16\n" +
+	"\x06height\x18\x01 \x01(\x04R\x06height\"h\n" +
+	"\x18GetSequencerFeesResponse\x12,\n" +
+	"\x04fees\x18\x01 \x01(\v2\x18.evnode.v1.SequencerFeesR\x04fees\x12\x1e\n" +
+	"\n" +
+	"unverified\x18\x02 \x01(\bR\n" +
+	"unverified\"\x82\x02\n" +
+	"\x05Event\x12\x1a\n" +
+	"\bsequence\x18\x01 \x01(\x04R\bsequence\x12.\n" +
+	"\x04time\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\x04time\x12\x12\n" +
+	"\x04type\x18\x03 \x01(\tR\x04type\x12\x18\n" +
+	"\amessage\x18\x04 \x01(\tR\amessage\x12@\n" +
+	"\n" +
+	"attributes\x18\x05 \x03(\v2 .evnode.v1.Event.AttributesEntryR\n" +
+	"attributes\x1a=\n" +
+	"\x0fAttributesEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\xb0\x01\n" +
+	"\x10GetEventsRequest\x12.\n" +
+	"\x04from\x18\x01 \x01(\v2\x1a.google.protobuf.TimestampR\x04from\x12*\n" +
+	"\x02to\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\x02to\x12\x14\n" +
+	"\x05types\x18\x03 \x03(\tR\x05types\x12*\n" +
+	"\x04page\x18\x04 \x01(\v2\x16.evnode.v1.PageRequestR\x04page\"j\n" +
+	"\x11GetEventsResponse\x12(\n" +
+	"\x06events\x18\x01 \x03(\v2\x10.evnode.v1.EventR\x06events\x12+\n" +
+	"\x04page\x18\x02 \x01(\v2\x17.evnode.v1.PageResponseR\x04page\"v\n" +
+	"\x12GetTxStatusRequest\x12\x17\n" +
+	"\atx_hash\x18\x01 \x01(\fR\x06txHash\x12G\n" +
+	"\x12wait_for_inclusion\x18\x02 \x01(\v2\x19.google.protobuf.DurationR\x10waitForInclusion\"\xd4\x01\n" +
+	"\x13GetTxStatusResponse\x12+\n" +
+	"\x06status\x18\x01 \x01(\x0e2\x13.evnode.v1.TxStatusR\x06status\x12\x16\n" +
+	"\x06height\x18\x02 \x01(\x04R\x06height\x12\x1f\n" +
+	"\vda_included\x18\x03 \x01(\bR\n" +
+	"daIncluded\x12$\n" +
+	"\x0edata_da_height\x18\x04 \x01(\x04R\fdataDaHeight\x12\x14\n" +
+	"\x05index\x18\x05 \x01(\rR\x05index\x12\x1b\n" +
+	"\thas_index\x18\x06 \x01(\bR\bhasIndex\"2\n" +
+	"\x17GetBlockByTxHashRequest\x12\x17\n" +
+	"\atx_hash\x18\x01 \x01(\fR\x06txHash\"\xa8\x01\n" +
+	"\x18GetBlockByTxHashResponse\x12&\n" +
+	"\x05block\x18\x01 \x01(\v2\x10.evnode.v1.BlockR\x05block\x12(\n" +
+	"\x10header_da_height\x18\x02 \x01(\x04R\x0eheaderDaHeight\x12$\n" +
+	"\x0edata_da_height\x18\x03 \x01(\x04R\fdataDaHeight\x12\x14\n" +
+	"\x05index\x18\x04 \x01(\rR\x05index\",\n" +
+	"\x11GetTxProofRequest\x12\x17\n" +
+	"\atx_hash\x18\x01 \x01(\fR\x06txHash\"m\n" +
+	"\x12GetTxProofResponse\x12/\n" +
+	"\x06header\x18\x01 \x01(\v2\x17.evnode.v1.SignedHeaderR\x06header\x12\x10\n" +
+	"\x03txs\x18\x02 \x03(\fR\x03txs\x12\x14\n" +
+	"\x05index\x18\x03 \x01(\rR\x05index\"\xab\x04\n" +
+	"\x15GetSyncStatusResponse\x12\x16\n" +
+	"\x06height\x18\x01 \x01(\x04R\x06height\x12%\n" +
+	"\x0enetwork_height\x18\x02 \x01(\x04R\rnetworkHeight\x12\x1b\n" +
+	"\tda_height\x18\x03 \x01(\x04R\bdaHeight\x12,\n" +
+	"\x12da_included_height\x18\x04 \x01(\x04R\x10daIncludedHeight\x12\x18\n" +
+	"\asyncing\x18\x05 \x01(\bR\asyncing\x12a\n" +
+	"\x11headers_by_source\x18\x06 \x03(\v25.evnode.v1.GetSyncStatusResponse.HeadersBySourceEntryR\x0fheadersBySource\x12X\n" +
+	"\x0edata_by_source\x18\a \x03(\v22.evnode.v1.GetSyncStatusResponse.DataBySourceEntryR\fdataBySource\x12,\n" +
+	"\x12pruned_base_height\x18\b \x01(\x04R\x10prunedBaseHeight\x1aB\n" +
+	"\x14HeadersBySourceEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\x04R\x05value:\x028\x01\x1a?\n" +
+	"\x11DataBySourceEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\x04R\x05value:\x028\x01\"4\n" +
+	"\x1aGetDAInclusionProofRequest\x12\x16\n" +
+	"\x06height\x18\x01 \x01(\x04R\x06height\"\x92\x01\n" +
+	"\x0fDABlobInclusion\x12\x1b\n" +
+	"\tda_height\x18\x01 \x01(\x04R\bdaHeight\x12\x1c\n" +
+	"\tnamespace\x18\x02 \x01(\tR\tnamespace\x12\x0e\n" +
+	"\x02id\x18\x03 \x01(\fR\x02id\x12\x1e\n" +
+	"\n" +
+	"commitment\x18\x04 \x01(\fR\n" +
+	"commitment\x12\x14\n" +
+	"\x05proof\x18\x05 \x01(\fR\x05proof\"\x99\x01\n" +
+	"\x1bGetDAInclusionProofResponse\x12\x16\n" +
+	"\x06height\x18\x01 \x01(\x04R\x06height\x122\n" +
+	"\x06header\x18\x02 \x01(\v2\x1a.evnode.v1.DABlobInclusionR\x06header\x12.\n" +
+	"\x04data\x18\x03 \x01(\v2\x1a.evnode.v1.DABlobInclusionR\x04data\"\x8d\x02\n" +
+	"\x11GetDAInfoResponse\x12\x18\n" +
+	"\abackend\x18\x01 \x01(\tR\abackend\x12\x1d\n" +
+	"\n" +
+	"network_id\x18\x02 \x01(\tR\tnetworkId\x12)\n" +
+	"\x10header_namespace\x18\x03 \x01(\tR\x0fheaderNamespace\x12%\n" +
+	"\x0edata_namespace\x18\x04 \x01(\tR\rdataNamespace\x12\"\n" +
+	"\rmax_blob_size\x18\x05 \x01(\x04R\vmaxBlobSize\x12\x1b\n" +
+	"\tda_height\x18\x06 \x01(\x04R\bdaHeight\x12,\n" +
+	"\x12da_included_height\x18\a \x01(\x04R\x10daIncludedHeight\"6\n" +
+	"\x1eGetExecutionConsistencyRequest\x12\x14\n" +
+	"\x05count\x18\x01 \x01(\rR\x05count\"\x99\x02\n" +
+	"\x15ExecutionBlockMapping\x12\x16\n" +
+	"\x06height\x18\x01 \x01(\x04R\x06height\x12)\n" +
+	"\x10execution_number\x18\x02 \x01(\x04R\x0fexecutionNumber\x12%\n" +
+	"\x0eexecution_hash\x18\x03 \x01(\fR\rexecutionHash\x120\n" +
+	"\x14execution_state_root\x18\x04 \x01(\fR\x12executionStateRoot\x12.\n" +
+	"\x13expected_state_root\x18\x05 \x01(\fR\x11expectedStateRoot\x12\x1e\n" +
+	"\n" +
+	"consistent\x18\x06 \x01(\bR\n" +
+	"consistent\x12\x14\n" +
+	"\x05error\x18\a \x01(\tR\x05error\"\xd4\x01\n" +
+	"\x1fGetExecutionConsistencyResponse\x12\x16\n" +
+	"\x06height\x18\x01 \x01(\x04R\x06height\x12)\n" +
+	"\x10execution_height\x18\x02 \x01(\x04R\x0fexecutionHeight\x12\x14\n" +
+	"\x05drift\x18\x03 \x01(\x03R\x05drift\x12\x1e\n" +
+	"\n" +
+	"consistent\x18\x04 \x01(\bR\n" +
+	"consistent\x128\n" +
+	"\x06blocks\x18\x05 \x03(\v2 .evnode.v1.ExecutionBlockMappingR\x06blocks*P\n" +
+	"\bTxStatus\x12\x15\n" +
+	"\x11TX_STATUS_UNKNOWN\x10\x00\x12\x15\n" +
+	"\x11TX_STATUS_PENDING\x10\x01\x12\x16\n" +
+	"\x12TX_STATUS_INCLUDED\x10\x022\xf9\r\n" +
+	"\fStoreService\x12H\n" +
+	"\bGetBlock\x12\x1a.evnode.v1.GetBlockRequest\x1a\x1b.evnode.v1.GetBlockResponse\"\x03\x90\x02\x01\x12\\\n" +
+	"\x0eGetBlockStream\x12 .evnode.v1.GetBlockStreamRequest\x1a!.evnode.v1.GetBlockStreamResponse\"\x03\x90\x02\x010\x01\x12_\n" +
+	"\x0fSubscribeBlocks\x12!.evnode.v1.SubscribeBlocksRequest\x1a\".evnode.v1.SubscribeBlocksResponse\"\x03\x90\x02\x010\x01\x12b\n" +
+	"\x16SubscribePreviewBlocks\x12\x16.google.protobuf.Empty\x1a).evnode.v1.SubscribePreviewBlocksResponse\"\x03\x90\x02\x010\x01\x12K\n" +
+	"\tGetHeader\x12\x1b.evnode.v1.GetHeaderRequest\x1a\x1c.evnode.v1.GetHeaderResponse\"\x03\x90\x02\x01\x12Z\n" +
+	"\x0eGetHeaderRange\x12 .evnode.v1.GetHeaderRangeRequest\x1a!.evnode.v1.GetHeaderRangeResponse\"\x03\x90\x02\x01\x12T\n" +
+	"\fSearchBlocks\x12\x1e.evnode.v1.SearchBlocksRequest\x1a\x1f.evnode.v1.SearchBlocksResponse\"\x03\x90\x02\x01\x12D\n" +
+	"\bGetState\x12\x16.google.protobuf.Empty\x1a\x1b.evnode.v1.GetStateResponse\"\x03\x90\x02\x01\x12Q\n" +
+	"\vGetMetadata\x12\x1d.evnode.v1.GetMetadataRequest\x1a\x1e.evnode.v1.GetMetadataResponse\"\x03\x90\x02\x01\x12`\n" +
+	"\x10GetMetadataBatch\x12\".evnode.v1.GetMetadataBatchRequest\x1a#.evnode.v1.GetMetadataBatchResponse\"\x03\x90\x02\x01\x12T\n" +
+	"\fGetStateDiff\x12\x1e.evnode.v1.GetStateDiffRequest\x1a\x1f.evnode.v1.GetStateDiffResponse\"\x03\x90\x02\x01\x12`\n" +
+	"\x10GetSequencerFees\x12\".evnode.v1.GetSequencerFeesRequest\x1a#.evnode.v1.GetSequencerFeesResponse\"\x03\x90\x02\x01\x12K\n" +
+	"\tGetEvents\x12\x1b.evnode.v1.GetEventsRequest\x1a\x1c.evnode.v1.GetEventsResponse\"\x03\x90\x02\x01\x12Q\n" +
+	"\vGetTxStatus\x12\x1d.evnode.v1.GetTxStatusRequest\x1a\x1e.evnode.v1.GetTxStatusResponse\"\x03\x90\x02\x01\x12`\n" +
+	"\x10GetBlockByTxHash\x12\".evnode.v1.GetBlockByTxHashRequest\x1a#.evnode.v1.GetBlockByTxHashResponse\"\x03\x90\x02\x01\x12N\n" +
+	"\n" +
+	"GetTxProof\x12\x1c.evnode.v1.GetTxProofRequest\x1a\x1d.evnode.v1.GetTxProofResponse\"\x03\x90\x02\x01\x12N\n" +
+	"\rGetSyncStatus\x12\x16.google.protobuf.Empty\x1a .evnode.v1.GetSyncStatusResponse\"\x03\x90\x02\x01\x12i\n" +
+	"\x13GetDAInclusionProof\x12%.evnode.v1.GetDAInclusionProofRequest\x1a&.evnode.v1.GetDAInclusionProofResponse\"\x03\x90\x02\x01\x12F\n" +
+	"\tGetDAInfo\x12\x16.google.protobuf.Empty\x1a\x1c.evnode.v1.GetDAInfoResponse\"\x03\x90\x02\x01\x12u\n" +
+	"\x17GetExecutionConsistency\x12).evnode.v1.GetExecutionConsistencyRequest\x1a*.evnode.v1.GetExecutionConsistencyResponse\"\x03\x90\x02\x01B/Z-github.com/evstack/ev-node/types/pb/evnode/v1b\x06proto3"
+
+var (
+	file_evnode_v1_state_rpc_proto_rawDescOnce sync.Once
+	file_evnode_v1_state_rpc_proto_rawDescData []byte
+)
+
+func file_evnode_v1_state_rpc_proto_rawDescGZIP() []byte {
+	file_evnode_v1_state_rpc_proto_rawDescOnce.Do(func() {
+		file_evnode_v1_state_rpc_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_evnode_v1_state_rpc_proto_rawDesc), len(file_evnode_v1_state_rpc_proto_rawDesc)))
+	})
+	return file_evnode_v1_state_rpc_proto_rawDescData
+}
+
+var file_evnode_v1_state_rpc_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_evnode_v1_state_rpc_proto_msgTypes = make([]protoimpl.MessageInfo, 45)
+var file_evnode_v1_state_rpc_proto_goTypes = []any{
+	(TxStatus)(0),                           // 0: evnode.v1.TxStatus
+	(*Block)(nil),                           // 1: evnode.v1.Block
+	(*GetBlockRequest)(nil),                 // 2: evnode.v1.GetBlockRequest
+	(*GetBlockResponse)(nil),                // 3: evnode.v1.GetBlockResponse
+	(*GetBlockStreamRequest)(nil),           // 4: evnode.v1.GetBlockStreamRequest
+	(*GetBlockStreamResponse)(nil),          // 5: evnode.v1.GetBlockStreamResponse
+	(*SubscribeBlocksRequest)(nil),          // 6: evnode.v1.SubscribeBlocksRequest
+	(*SubscribeBlocksResponse)(nil),         // 7: evnode.v1.SubscribeBlocksResponse
+	(*SubscribePreviewBlocksResponse)(nil),  // 8: evnode.v1.SubscribePreviewBlocksResponse
+	(*GetHeaderRequest)(nil),                // 9: evnode.v1.GetHeaderRequest
+	(*GetHeaderResponse)(nil),               // 10: evnode.v1.GetHeaderResponse
+	(*GetHeaderRangeRequest)(nil),           // 11: evnode.v1.GetHeaderRangeRequest
+	(*GetHeaderRangeResponse)(nil),          // 12: evnode.v1.GetHeaderRangeResponse
+	(*SearchBlocksRequest)(nil),             // 13: evnode.v1.SearchBlocksRequest
+	(*BlockSummary)(nil),                    // 14: evnode.v1.BlockSummary
+	(*SearchBlocksResponse)(nil),            // 15: evnode.v1.SearchBlocksResponse
+	(*GetStateResponse)(nil),                // 16: evnode.v1.GetStateResponse
+	(*GetMetadataRequest)(nil),              // 17: evnode.v1.GetMetadataRequest
+	(*GetMetadataResponse)(nil),             // 18: evnode.v1.GetMetadataResponse
+	(*GetMetadataBatchRequest)(nil),         // 19: evnode.v1.GetMetadataBatchRequest
+	(*MetadataEntry)(nil),                   // 20: evnode.v1.MetadataEntry
+	(*GetMetadataBatchResponse)(nil),        // 21: evnode.v1.GetMetadataBatchResponse
+	(*GetStateDiffRequest)(nil),             // 22: evnode.v1.GetStateDiffRequest
+	(*GetStateDiffResponse)(nil),            // 23: evnode.v1.GetStateDiffResponse
+	(*GetSequencerFeesRequest)(nil),         // 24: evnode.v1.GetSequencerFeesRequest
+	(*GetSequencerFeesResponse)(nil),        // 25: evnode.v1.GetSequencerFeesResponse
+	(*Event)(nil),                           // 26: evnode.v1.Event
+	(*GetEventsRequest)(nil),                // 27: evnode.v1.GetEventsRequest
+	(*GetEventsResponse)(nil),               // 28: evnode.v1.GetEventsResponse
+	(*GetTxStatusRequest)(nil),              // 29: evnode.v1.GetTxStatusRequest
+	(*GetTxStatusResponse)(nil),             // 30: evnode.v1.GetTxStatusResponse
+	(*GetBlockByTxHashRequest)(nil),         // 31: evnode.v1.GetBlockByTxHashRequest
+	(*GetBlockByTxHashResponse)(nil),        // 32: evnode.v1.GetBlockByTxHashResponse
+	(*GetTxProofRequest)(nil),               // 33: evnode.v1.GetTxProofRequest
+	(*GetTxProofResponse)(nil),              // 34: evnode.v1.GetTxProofResponse
+	(*GetSyncStatusResponse)(nil),           // 35: evnode.v1.GetSyncStatusResponse
+	(*GetDAInclusionProofRequest)(nil),      // 36: evnode.v1.GetDAInclusionProofRequest
+	(*DABlobInclusion)(nil),                 // 37: evnode.v1.DABlobInclusion
+	(*GetDAInclusionProofResponse)(nil),     // 38: evnode.v1.GetDAInclusionProofResponse
+	(*GetDAInfoResponse)(nil),               // 39: evnode.v1.GetDAInfoResponse
+	(*GetExecutionConsistencyRequest)(nil),  // 40: evnode.v1.GetExecutionConsistencyRequest
+	(*ExecutionBlockMapping)(nil),           // 41: evnode.v1.ExecutionBlockMapping
+	(*GetExecutionConsistencyResponse)(nil), // 42: evnode.v1.GetExecutionConsistencyResponse
+	nil,                                     // 43: evnode.v1.Event.AttributesEntry
+	nil,                                     // 44: evnode.v1.GetSyncStatusResponse.HeadersBySourceEntry
+	nil,                                     // 45: evnode.v1.GetSyncStatusResponse.DataBySourceEntry
+	(*SignedHeader)(nil),                    // 46: evnode.v1.SignedHeader
+	(*Data)(nil),                            // 47: evnode.v1.Data
+	(*Metadata)(nil),                        // 48: evnode.v1.Metadata
+	(*PageRequest)(nil),                     // 49: evnode.v1.PageRequest
+	(*PageResponse)(nil),                    // 50: evnode.v1.PageResponse
+	(*timestamppb.Timestamp)(nil),           // 51: google.protobuf.Timestamp
+	(*State)(nil),                           // 52: evnode.v1.State
+	(*StateDiff)(nil),                       // 53: evnode.v1.StateDiff
+	(*SequencerFees)(nil),                   // 54: evnode.v1.SequencerFees
+	(*durationpb.Duration)(nil),             // 55: google.protobuf.Duration
+	(*emptypb.Empty)(nil),                   // 56: google.protobuf.Empty
+}
+var file_evnode_v1_state_rpc_proto_depIdxs = []int32{
+	46, // 0: evnode.v1.Block.header:type_name -> evnode.v1.SignedHeader
+	47, // 1: evnode.v1.Block.data:type_name -> evnode.v1.Data
+	1,  // 2: evnode.v1.GetBlockResponse.block:type_name -> evnode.v1.Block
+	46, // 3: evnode.v1.GetBlockStreamResponse.header:type_name -> evnode.v1.SignedHeader
+	48, // 4: evnode.v1.GetBlockStreamResponse.metadata:type_name -> evnode.v1.Metadata
+	1,  // 5: evnode.v1.SubscribeBlocksResponse.block:type_name -> evnode.v1.Block
+	1,  // 6: evnode.v1.SubscribePreviewBlocksResponse.block:type_name -> evnode.v1.Block
+	46, // 7: evnode.v1.GetHeaderResponse.header:type_name -> evnode.v1.SignedHeader
+	49, // 8: evnode.v1.GetHeaderRangeRequest.page:type_name -> evnode.v1.PageRequest
+	46, // 9: evnode.v1.GetHeaderRangeResponse.headers:type_name -> evnode.v1.SignedHeader
+	50, // 10: evnode.v1.GetHeaderRangeResponse.page:type_name -> evnode.v1.PageResponse
+	51, // 11: evnode.v1.SearchBlocksRequest.start_time:type_name -> google.protobuf.Timestamp
+	51, // 12: evnode.v1.SearchBlocksRequest.end_time:type_name -> google.protobuf.Timestamp
+	49, // 13: evnode.v1.SearchBlocksRequest.page:type_name -> evnode.v1.PageRequest
+	51, // 14: evnode.v1.BlockSummary.time:type_name -> google.protobuf.Timestamp
+	14, // 15: evnode.v1.SearchBlocksResponse.blocks:type_name -> evnode.v1.BlockSummary
+	50, // 16: evnode.v1.SearchBlocksResponse.page:type_name -> evnode.v1.PageResponse
+	52, // 17: evnode.v1.GetStateResponse.state:type_name -> evnode.v1.State
+	20, // 18: evnode.v1.GetMetadataBatchResponse.entries:type_name -> evnode.v1.MetadataEntry
+	53, // 19: evnode.v1.GetStateDiffResponse.diff:type_name -> evnode.v1.StateDiff
+	54, // 20: evnode.v1.GetSequencerFeesResponse.fees:type_name -> evnode.v1.SequencerFees
+	51, // 21: evnode.v1.Event.time:type_name -> google.protobuf.Timestamp
+	43, // 22: evnode.v1.Event.attributes:type_name -> evnode.v1.Event.AttributesEntry
+	51, // 23: evnode.v1.GetEventsRequest.from:type_name -> google.protobuf.Timestamp
+	51, // 24: evnode.v1.GetEventsRequest.to:type_name -> google.protobuf.Timestamp
+	49, // 25: evnode.v1.GetEventsRequest.page:type_name -> evnode.v1.PageRequest
+	26, // 26: evnode.v1.GetEventsResponse.events:type_name -> evnode.v1.Event
+	50, // 27: evnode.v1.GetEventsResponse.page:type_name -> evnode.v1.PageResponse
+	55, // 28: evnode.v1.GetTxStatusRequest.wait_for_inclusion:type_name -> google.protobuf.Duration
+	0,  // 29: evnode.v1.GetTxStatusResponse.status:type_name -> evnode.v1.TxStatus
+	1,  // 30: evnode.v1.GetBlockByTxHashResponse.block:type_name -> evnode.v1.Block
+	46, // 31: evnode.v1.GetTxProofResponse.header:type_name -> evnode.v1.SignedHeader
+	44, // 32: evnode.v1.GetSyncStatusResponse.headers_by_source:type_name -> evnode.v1.GetSyncStatusResponse.HeadersBySourceEntry
+	45, // 33: evnode.v1.GetSyncStatusResponse.data_by_source:type_name -> evnode.v1.GetSyncStatusResponse.DataBySourceEntry
+	37, // 34: evnode.v1.GetDAInclusionProofResponse.header:type_name -> evnode.v1.DABlobInclusion
+	37, // 35: evnode.v1.GetDAInclusionProofResponse.data:type_name -> evnode.v1.DABlobInclusion
+	41, // 36: evnode.v1.GetExecutionConsistencyResponse.blocks:type_name -> evnode.v1.ExecutionBlockMapping
+	2,  // 37: evnode.v1.StoreService.GetBlock:input_type -> evnode.v1.GetBlockRequest
+	4,  // 38: evnode.v1.StoreService.GetBlockStream:input_type -> evnode.v1.GetBlockStreamRequest
+	6,  // 39: evnode.v1.StoreService.SubscribeBlocks:input_type -> evnode.v1.SubscribeBlocksRequest
+	56, // 40: evnode.v1.StoreService.SubscribePreviewBlocks:input_type -> google.protobuf.Empty
+	9,  // 41: evnode.v1.StoreService.GetHeader:input_type -> evnode.v1.GetHeaderRequest
+	11, // 42: evnode.v1.StoreService.GetHeaderRange:input_type -> evnode.v1.GetHeaderRangeRequest
+	13, // 43: evnode.v1.StoreService.SearchBlocks:input_type -> evnode.v1.SearchBlocksRequest
+	56, // 44: evnode.v1.StoreService.GetState:input_type -> google.protobuf.Empty
+	17, // 45: evnode.v1.StoreService.GetMetadata:input_type -> evnode.v1.GetMetadataRequest
+	19, // 46: evnode.v1.StoreService.GetMetadataBatch:input_type -> evnode.v1.GetMetadataBatchRequest
+	22, // 47: evnode.v1.StoreService.GetStateDiff:input_type -> evnode.v1.GetStateDiffRequest
+	24, // 48: evnode.v1.StoreService.GetSequencerFees:input_type -> evnode.v1.GetSequencerFeesRequest
+	27, // 49: evnode.v1.StoreService.GetEvents:input_type -> evnode.v1.GetEventsRequest
+	29, // 50: evnode.v1.StoreService.GetTxStatus:input_type -> evnode.v1.GetTxStatusRequest
+	31, // 51: evnode.v1.StoreService.GetBlockByTxHash:input_type -> evnode.v1.GetBlockByTxHashRequest
+	33, // 52: evnode.v1.StoreService.GetTxProof:input_type -> evnode.v1.GetTxProofRequest
+	56, // 53: evnode.v1.StoreService.GetSyncStatus:input_type -> google.protobuf.Empty
+	36, // 54: evnode.v1.StoreService.GetDAInclusionProof:input_type -> evnode.v1.GetDAInclusionProofRequest
+	56, // 55: evnode.v1.StoreService.GetDAInfo:input_type -> google.protobuf.Empty
+	40, // 56: evnode.v1.StoreService.GetExecutionConsistency:input_type -> evnode.v1.GetExecutionConsistencyRequest
+	3,  // 57: evnode.v1.StoreService.GetBlock:output_type -> evnode.v1.GetBlockResponse
+	5,  // 58: evnode.v1.StoreService.GetBlockStream:output_type -> evnode.v1.GetBlockStreamResponse
+	7,  // 59: evnode.v1.StoreService.SubscribeBlocks:output_type -> evnode.v1.SubscribeBlocksResponse
+	8,  // 60: evnode.v1.StoreService.SubscribePreviewBlocks:output_type -> evnode.v1.SubscribePreviewBlocksResponse
+	10, // 61: evnode.v1.StoreService.GetHeader:output_type -> evnode.v1.GetHeaderResponse
+	12, // 62: evnode.v1.StoreService.GetHeaderRange:output_type -> evnode.v1.GetHeaderRangeResponse
+	15, // 63: evnode.v1.StoreService.SearchBlocks:output_type -> evnode.v1.SearchBlocksResponse
+	16, // 64: evnode.v1.StoreService.GetState:output_type -> evnode.v1.GetStateResponse
+	18, // 65: evnode.v1.StoreService.GetMetadata:output_type -> evnode.v1.GetMetadataResponse
+	21, // 66: evnode.v1.StoreService.GetMetadataBatch:output_type -> evnode.v1.GetMetadataBatchResponse
+	23, // 67: evnode.v1.StoreService.GetStateDiff:output_type -> evnode.v1.GetStateDiffResponse
+	25, // 68: evnode.v1.StoreService.GetSequencerFees:output_type -> evnode.v1.GetSequencerFeesResponse
+	28, // 69: evnode.v1.StoreService.GetEvents:output_type -> evnode.v1.GetEventsResponse
+	30, // 70: evnode.v1.StoreService.GetTxStatus:output_type -> evnode.v1.GetTxStatusResponse
+	32, // 71: evnode.v1.StoreService.GetBlockByTxHash:output_type -> evnode.v1.GetBlockByTxHashResponse
+	34, // 72: evnode.v1.StoreService.GetTxProof:output_type -> evnode.v1.GetTxProofResponse
+	35, // 73: evnode.v1.StoreService.GetSyncStatus:output_type -> evnode.v1.GetSyncStatusResponse
+	38, // 74: evnode.v1.StoreService.GetDAInclusionProof:output_type -> evnode.v1.GetDAInclusionProofResponse
+	39, // 75: evnode.v1.StoreService.GetDAInfo:output_type -> evnode.v1.GetDAInfoResponse
+	42, // 76: evnode.v1.StoreService.GetExecutionConsistency:output_type -> evnode.v1.GetExecutionConsistencyResponse
+	57, // [57:77] is the sub-list for method output_type
+	37, // [37:57] is the sub-list for method input_type
+	37, // [37:37] is the sub-list for extension type_name
+	37, // [37:37] is the sub-list for extension extendee
+	0,  // [0:37] is the sub-list for field type_name
+}
+
+func init() { file_evnode_v1_state_rpc_proto_init() }
+func file_evnode_v1_state_rpc_proto_init() {
+	if File_evnode_v1_state_rpc_proto != nil {
+		return
+	}
+	file_evnode_v1_evnode_proto_init()
+	file_evnode_v1_pagination_proto_init()
+	file_evnode_v1_state_proto_init()
+	file_evnode_v1_state_rpc_proto_msgTypes[1].OneofWrappers = []any{
+		(*GetBlockRequest_Height)(nil),
+		(*GetBlockRequest_Hash)(nil),
+	}
+	file_evnode_v1_state_rpc_proto_msgTypes[3].OneofWrappers = []any{
+		(*GetBlockStreamRequest_Height)(nil),
+		(*GetBlockStreamRequest_Hash)(nil),
 	}
+	file_evnode_v1_state_rpc_proto_msgTypes[12].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_evnode_v1_state_rpc_proto_rawDesc), len(file_evnode_v1_state_rpc_proto_rawDesc)),
-			NumEnums:      0,
-			NumMessages:   6,
+			NumEnums:      1,
+			NumMessages:   45,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
 		GoTypes:           file_evnode_v1_state_rpc_proto_goTypes,
 		DependencyIndexes: file_evnode_v1_state_rpc_proto_depIdxs,
+		EnumInfos:         file_evnode_v1_state_rpc_proto_enumTypes,
 		MessageInfos:      file_evnode_v1_state_rpc_proto_msgTypes,
 	}.Build()
 	File_evnode_v1_state_rpc_proto = out.File
@@ -0,0 +1,306 @@
+// Code generated by protoc-gen-connect-go. DO NOT EDIT.
+//
+// Source: evnode/v1/admin.proto
+
+package v1connect
+
+import (
+	connect "connectrpc.com/connect"
+	context "context"
+	errors "errors"
+	v1 "github.com/evstack/ev-node/types/pb/evnode/v1"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+	http "net/http"
+	strings "strings"
+)
+
+// This is a compile-time assertion to ensure that this generated file and the connect package are
+// compatible. If you get a compiler error that this constant is not defined, this code was
+// generated with a version of connect newer than the one compiled into your binary. You can fix the
+// problem by either regenerating this code with an older version of connect or updating the connect
+// version compiled into your binary.
+const _ = connect.IsAtLeastVersion1_13_0
+
+const (
+	// AdminServiceName is the fully-qualified name of the AdminService service.
+	AdminServiceName = "evnode.v1.AdminService"
+)
+
+// These constants are the fully-qualified names of the RPCs defined in this package. They're
+// exposed at runtime as Spec.Procedure and as the final two segments of the HTTP route.
+//
+// Note that these are different from the fully-qualified method names used by
+// google.golang.org/protobuf/reflect/protoreflect. To convert from these constants to
+// reflection-formatted method names, remove the leading slash and convert the remaining slash to a
+// period.
+const (
+	// AdminServiceShutdownProcedure is the fully-qualified name of the AdminService's Shutdown RPC.
+	AdminServiceShutdownProcedure = "/evnode.v1.AdminService/Shutdown"
+	// AdminServiceSetLogLevelProcedure is the fully-qualified name of the AdminService's SetLogLevel
+	// RPC.
+	AdminServiceSetLogLevelProcedure = "/evnode.v1.AdminService/SetLogLevel"
+	// AdminServiceTriggerDASubmissionProcedure is the fully-qualified name of the AdminService's
+	// TriggerDASubmission RPC.
+	AdminServiceTriggerDASubmissionProcedure = "/evnode.v1.AdminService/TriggerDASubmission"
+	// AdminServiceDisconnectPeerProcedure is the fully-qualified name of the AdminService's
+	// DisconnectPeer RPC.
+	AdminServiceDisconnectPeerProcedure = "/evnode.v1.AdminService/DisconnectPeer"
+	// AdminServiceCompactStoreProcedure is the fully-qualified name of the AdminService's CompactStore
+	// RPC.
+	AdminServiceCompactStoreProcedure = "/evnode.v1.AdminService/CompactStore"
+	// AdminServiceDrainProcedure is the fully-qualified name of the AdminService's Drain RPC.
+	AdminServiceDrainProcedure = "/evnode.v1.AdminService/Drain"
+	// AdminServiceSetMetadataProcedure is the fully-qualified name of the AdminService's SetMetadata
+	// RPC.
+	AdminServiceSetMetadataProcedure = "/evnode.v1.AdminService/SetMetadata"
+)
+
+// AdminServiceClient is a client for the evnode.v1.AdminService service.
+type AdminServiceClient interface {
+	// Shutdown stops the node gracefully, as on SIGTERM, after responding
+	Shutdown(context.Context, *connect.Request[emptypb.Empty]) (*connect.Response[emptypb.Empty], error)
+	// SetLogLevel changes the level of the node logs
+	SetLogLevel(context.Context, *connect.Request[v1.SetLogLevelRequest]) (*connect.Response[v1.SetLogLevelResponse], error)
+	// TriggerDASubmission submits the headers and data pending DA submission without waiting
+	// for the next DA block time. It fails on nodes which are not aggregators.
+	TriggerDASubmission(context.Context, *connect.Request[emptypb.Empty]) (*connect.Response[v1.TriggerDASubmissionResponse], error)
+	// DisconnectPeer closes the connections to a peer, optionally banning it from reconnecting
+	DisconnectPeer(context.Context, *connect.Request[v1.DisconnectPeerRequest]) (*connect.Response[emptypb.Empty], error)
+	// CompactStore reclaims the disk space of the data deleted from the store
+	CompactStore(context.Context, *connect.Request[emptypb.Empty]) (*connect.Response[emptypb.Empty], error)
+	// Drain stops the node gracefully after draining its RPC server, as on SIGTERM, after
+	// responding: readiness probes fail, new requests and streams are rejected, and in-flight
+	// requests are given the drain timeout to complete before the node stops
+	Drain(context.Context, *connect.Request[v1.DrainRequest]) (*connect.Response[emptypb.Empty], error)
+	// SetMetadata saves a value of application metadata, e.g. a checkpoint of the execution layer,
+	// under a namespace registered by the application. It is readable with GetMetadata at the key
+	// app/<namespace>/<key>.
+	SetMetadata(context.Context, *connect.Request[v1.SetMetadataRequest]) (*connect.Response[emptypb.Empty], error)
+}
+
+// NewAdminServiceClient constructs a client for the evnode.v1.AdminService service. By default, it
+// uses the Connect protocol with the binary Protobuf Codec, asks for gzipped responses, and sends
+// uncompressed requests. To use the gRPC or gRPC-Web protocols, supply the connect.WithGRPC() or
+// connect.WithGRPCWeb() options.
+//
+// The URL supplied here should be the base URL for the Connect or gRPC server (for example,
+// http://api.acme.com or https://acme.com/grpc).
+func NewAdminServiceClient(httpClient connect.HTTPClient, baseURL string, opts ...connect.ClientOption) AdminServiceClient {
+	baseURL = strings.TrimRight(baseURL, "/")
+	adminServiceMethods := v1.File_evnode_v1_admin_proto.Services().ByName("AdminService").Methods()
+	return &adminServiceClient{
+		shutdown: connect.NewClient[emptypb.Empty, emptypb.Empty](
+			httpClient,
+			baseURL+AdminServiceShutdownProcedure,
+			connect.WithSchema(adminServiceMethods.ByName("Shutdown")),
+			connect.WithClientOptions(opts...),
+		),
+		setLogLevel: connect.NewClient[v1.SetLogLevelRequest, v1.SetLogLevelResponse](
+			httpClient,
+			baseURL+AdminServiceSetLogLevelProcedure,
+			connect.WithSchema(adminServiceMethods.ByName("SetLogLevel")),
+			connect.WithClientOptions(opts...),
+		),
+		triggerDASubmission: connect.NewClient[emptypb.Empty, v1.TriggerDASubmissionResponse](
+			httpClient,
+			baseURL+AdminServiceTriggerDASubmissionProcedure,
+			connect.WithSchema(adminServiceMethods.ByName("TriggerDASubmission")),
+			connect.WithClientOptions(opts...),
+		),
+		disconnectPeer: connect.NewClient[v1.DisconnectPeerRequest, emptypb.Empty](
+			httpClient,
+			baseURL+AdminServiceDisconnectPeerProcedure,
+			connect.WithSchema(adminServiceMethods.ByName("DisconnectPeer")),
+			connect.WithClientOptions(opts...),
+		),
+		compactStore: connect.NewClient[emptypb.Empty, emptypb.Empty](
+			httpClient,
+			baseURL+AdminServiceCompactStoreProcedure,
+			connect.WithSchema(adminServiceMethods.ByName("CompactStore")),
+			connect.WithClientOptions(opts...),
+		),
+		drain: connect.NewClient[v1.DrainRequest, emptypb.Empty](
+			httpClient,
+			baseURL+AdminServiceDrainProcedure,
+			connect.WithSchema(adminServiceMethods.ByName("Drain")),
+			connect.WithClientOptions(opts...),
+		),
+		setMetadata: connect.NewClient[v1.SetMetadataRequest, emptypb.Empty](
+			httpClient,
+			baseURL+AdminServiceSetMetadataProcedure,
+			connect.WithSchema(adminServiceMethods.ByName("SetMetadata")),
+			connect.WithClientOptions(opts...),
+		),
+	}
+}
+
+// adminServiceClient implements AdminServiceClient.
+type adminServiceClient struct {
+	shutdown            *connect.Client[emptypb.Empty, emptypb.Empty]
+	setLogLevel         *connect.Client[v1.SetLogLevelRequest, v1.SetLogLevelResponse]
+	triggerDASubmission *connect.Client[emptypb.Empty, v1.TriggerDASubmissionResponse]
+	disconnectPeer      *connect.Client[v1.DisconnectPeerRequest, emptypb.Empty]
+	compactStore        *connect.Client[emptypb.Empty, emptypb.Empty]
+	drain               *connect.Client[v1.DrainRequest, emptypb.Empty]
+	setMetadata         *connect.Client[v1.SetMetadataRequest, emptypb.Empty]
+}
+
+// Shutdown calls evnode.v1.AdminService.Shutdown.
+func (c *adminServiceClient) Shutdown(ctx context.Context, req *connect.Request[emptypb.Empty]) (*connect.Response[emptypb.Empty], error) {
+	return c.shutdown.CallUnary(ctx, req)
+}
+
+// SetLogLevel calls evnode.v1.AdminService.SetLogLevel.
+func (c *adminServiceClient) SetLogLevel(ctx context.Context, req *connect.Request[v1.SetLogLevelRequest]) (*connect.Response[v1.SetLogLevelResponse], error) {
+	return c.setLogLevel.CallUnary(ctx, req)
+}
+
+// TriggerDASubmission calls evnode.v1.AdminService.TriggerDASubmission.
+func (c *adminServiceClient) TriggerDASubmission(ctx context.Context, req *connect.Request[emptypb.Empty]) (*connect.Response[v1.TriggerDASubmissionResponse], error) {
+	return c.triggerDASubmission.CallUnary(ctx, req)
+}
+
+// DisconnectPeer calls evnode.v1.AdminService.DisconnectPeer.
+func (c *adminServiceClient) DisconnectPeer(ctx context.Context, req *connect.Request[v1.DisconnectPeerRequest]) (*connect.Response[emptypb.Empty], error) {
+	return c.disconnectPeer.CallUnary(ctx, req)
+}
+
+// CompactStore calls evnode.v1.AdminService.CompactStore.
+func (c *adminServiceClient) CompactStore(ctx context.Context, req *connect.Request[emptypb.Empty]) (*connect.Response[emptypb.Empty], error) {
+	return c.compactStore.CallUnary(ctx, req)
+}
+
+// Drain calls evnode.v1.AdminService.Drain.
+func (c *adminServiceClient) Drain(ctx context.Context, req *connect.Request[v1.DrainRequest]) (*connect.Response[emptypb.Empty], error) {
+	return c.drain.CallUnary(ctx, req)
+}
+
+// SetMetadata calls evnode.v1.AdminService.SetMetadata.
+func (c *adminServiceClient) SetMetadata(ctx context.Context, req *connect.Request[v1.SetMetadataRequest]) (*connect.Response[emptypb.Empty], error) {
+	return c.setMetadata.CallUnary(ctx, req)
+}
+
+// AdminServiceHandler is an implementation of the evnode.v1.AdminService service.
+type AdminServiceHandler interface {
+	// Shutdown stops the node gracefully, as on SIGTERM, after responding
+	Shutdown(context.Context, *connect.Request[emptypb.Empty]) (*connect.Response[emptypb.Empty], error)
+	// SetLogLevel changes the level of the node logs
+	SetLogLevel(context.Context, *connect.Request[v1.SetLogLevelRequest]) (*connect.Response[v1.SetLogLevelResponse], error)
+	// TriggerDASubmission submits the headers and data pending DA submission without waiting
+	// for the next DA block time. It fails on nodes which are not aggregators.
+	TriggerDASubmission(context.Context, *connect.Request[emptypb.Empty]) (*connect.Response[v1.TriggerDASubmissionResponse], error)
+	// DisconnectPeer closes the connections to a peer, optionally banning it from reconnecting
+	DisconnectPeer(context.Context, *connect.Request[v1.DisconnectPeerRequest]) (*connect.Response[emptypb.Empty], error)
+	// CompactStore reclaims the disk space of the data deleted from the store
+	CompactStore(context.Context, *connect.Request[emptypb.Empty]) (*connect.Response[emptypb.Empty], error)
+	// Drain stops the node gracefully after draining its RPC server, as on SIGTERM, after
+	// responding: readiness probes fail, new requests and streams are rejected, and in-flight
+	// requests are given the drain timeout to complete before the node stops
+	Drain(context.Context, *connect.Request[v1.DrainRequest]) (*connect.Response[emptypb.Empty], error)
+	// SetMetadata saves a value of application metadata, e.g. a checkpoint of the execution layer,
+	// under a namespace registered by the application. It is readable with GetMetadata at the key
+	// app/<namespace>/<key>.
+	SetMetadata(context.Context, *connect.Request[v1.SetMetadataRequest]) (*connect.Response[emptypb.Empty], error)
+}
+
+// NewAdminServiceHandler builds an HTTP handler from the service implementation. It returns the
+// path on which to mount the handler and the handler itself.
+//
+// By default, handlers support the Connect, gRPC, and gRPC-Web protocols with the binary Protobuf
+// and JSON codecs. They also support gzip compression.
+func NewAdminServiceHandler(svc AdminServiceHandler, opts ...connect.HandlerOption) (string, http.Handler) {
+	adminServiceMethods := v1.File_evnode_v1_admin_proto.Services().ByName("AdminService").Methods()
+	adminServiceShutdownHandler := connect.NewUnaryHandler(
+		AdminServiceShutdownProcedure,
+		svc.Shutdown,
+		connect.WithSchema(adminServiceMethods.ByName("Shutdown")),
+		connect.WithHandlerOptions(opts...),
+	)
+	adminServiceSetLogLevelHandler := connect.NewUnaryHandler(
+		AdminServiceSetLogLevelProcedure,
+		svc.SetLogLevel,
+		connect.WithSchema(adminServiceMethods.ByName("SetLogLevel")),
+		connect.WithHandlerOptions(opts...),
+	)
+	adminServiceTriggerDASubmissionHandler := connect.NewUnaryHandler(
+		AdminServiceTriggerDASubmissionProcedure,
+		svc.TriggerDASubmission,
+		connect.WithSchema(adminServiceMethods.ByName("TriggerDASubmission")),
+		connect.WithHandlerOptions(opts...),
+	)
+	adminServiceDisconnectPeerHandler := connect.NewUnaryHandler(
+		AdminServiceDisconnectPeerProcedure,
+		svc.DisconnectPeer,
+		connect.WithSchema(adminServiceMethods.ByName("DisconnectPeer")),
+		connect.WithHandlerOptions(opts...),
+	)
+	adminServiceCompactStoreHandler := connect.NewUnaryHandler(
+		AdminServiceCompactStoreProcedure,
+		svc.CompactStore,
+		connect.WithSchema(adminServiceMethods.ByName("CompactStore")),
+		connect.WithHandlerOptions(opts...),
+	)
+	adminServiceDrainHandler := connect.NewUnaryHandler(
+		AdminServiceDrainProcedure,
+		svc.Drain,
+		connect.WithSchema(adminServiceMethods.ByName("Drain")),
+		connect.WithHandlerOptions(opts...),
+	)
+	adminServiceSetMetadataHandler := connect.NewUnaryHandler(
+		AdminServiceSetMetadataProcedure,
+		svc.SetMetadata,
+		connect.WithSchema(adminServiceMethods.ByName("SetMetadata")),
+		connect.WithHandlerOptions(opts...),
+	)
+	return "/evnode.v1.AdminService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case AdminServiceShutdownProcedure:
+			adminServiceShutdownHandler.ServeHTTP(w, r)
+		case AdminServiceSetLogLevelProcedure:
+			adminServiceSetLogLevelHandler.ServeHTTP(w, r)
+		case AdminServiceTriggerDASubmissionProcedure:
+			adminServiceTriggerDASubmissionHandler.ServeHTTP(w, r)
+		case AdminServiceDisconnectPeerProcedure:
+			adminServiceDisconnectPeerHandler.ServeHTTP(w, r)
+		case AdminServiceCompactStoreProcedure:
+			adminServiceCompactStoreHandler.ServeHTTP(w, r)
+		case AdminServiceDrainProcedure:
+			adminServiceDrainHandler.ServeHTTP(w, r)
+		case AdminServiceSetMetadataProcedure:
+			adminServiceSetMetadataHandler.ServeHTTP(w, r)
+		default:
+			http.NotFound(w, r)
+		}
+	})
+}
+
+// UnimplementedAdminServiceHandler returns CodeUnimplemented from all methods.
+type UnimplementedAdminServiceHandler struct{}
+
+func (UnimplementedAdminServiceHandler) Shutdown(context.Context, *connect.Request[emptypb.Empty]) (*connect.Response[emptypb.Empty], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("evnode.v1.AdminService.Shutdown is not implemented"))
+}
+
+func (UnimplementedAdminServiceHandler) SetLogLevel(context.Context, *connect.Request[v1.SetLogLevelRequest]) (*connect.Response[v1.SetLogLevelResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("evnode.v1.AdminService.SetLogLevel is not implemented"))
+}
+
+func (UnimplementedAdminServiceHandler) TriggerDASubmission(context.Context, *connect.Request[emptypb.Empty]) (*connect.Response[v1.TriggerDASubmissionResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("evnode.v1.AdminService.TriggerDASubmission is not implemented"))
+}
+
+func (UnimplementedAdminServiceHandler) DisconnectPeer(context.Context, *connect.Request[v1.DisconnectPeerRequest]) (*connect.Response[emptypb.Empty], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("evnode.v1.AdminService.DisconnectPeer is not implemented"))
+}
+
+func (UnimplementedAdminServiceHandler) CompactStore(context.Context, *connect.Request[emptypb.Empty]) (*connect.Response[emptypb.Empty], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("evnode.v1.AdminService.CompactStore is not implemented"))
+}
+
+func (UnimplementedAdminServiceHandler) Drain(context.Context, *connect.Request[v1.DrainRequest]) (*connect.Response[emptypb.Empty], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("evnode.v1.AdminService.Drain is not implemented"))
+}
+
+func (UnimplementedAdminServiceHandler) SetMetadata(context.Context, *connect.Request[v1.SetMetadataRequest]) (*connect.Response[emptypb.Empty], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("evnode.v1.AdminService.SetMetadata is not implemented"))
+}
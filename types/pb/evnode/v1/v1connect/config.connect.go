@@ -37,12 +37,27 @@ const (
 	// ConfigServiceGetNamespaceProcedure is the fully-qualified name of the ConfigService's
 	// GetNamespace RPC.
 	ConfigServiceGetNamespaceProcedure = "/evnode.v1.ConfigService/GetNamespace"
+	// ConfigServiceValidateConfigProcedure is the fully-qualified name of the ConfigService's
+	// ValidateConfig RPC.
+	ConfigServiceValidateConfigProcedure = "/evnode.v1.ConfigService/ValidateConfig"
+	// ConfigServiceGetNodeInfoProcedure is the fully-qualified name of the ConfigService's GetNodeInfo
+	// RPC.
+	ConfigServiceGetNodeInfoProcedure = "/evnode.v1.ConfigService/GetNodeInfo"
+	// ConfigServiceGetGenesisProcedure is the fully-qualified name of the ConfigService's GetGenesis
+	// RPC.
+	ConfigServiceGetGenesisProcedure = "/evnode.v1.ConfigService/GetGenesis"
 )
 
 // ConfigServiceClient is a client for the evnode.v1.ConfigService service.
 type ConfigServiceClient interface {
 	// GetNamespace returns the namespace for this network
 	GetNamespace(context.Context, *connect.Request[emptypb.Empty]) (*connect.Response[v1.GetNamespaceResponse], error)
+	// ValidateConfig checks a proposed YAML configuration file against the options supported by this node
+	ValidateConfig(context.Context, *connect.Request[v1.ValidateConfigRequest]) (*connect.Response[v1.ValidateConfigResponse], error)
+	// GetNodeInfo returns the version, chain and components of this node
+	GetNodeInfo(context.Context, *connect.Request[emptypb.Empty]) (*connect.Response[v1.GetNodeInfoResponse], error)
+	// GetGenesis returns the genesis document of the network of this node
+	GetGenesis(context.Context, *connect.Request[emptypb.Empty]) (*connect.Response[v1.GetGenesisResponse], error)
 }
 
 // NewConfigServiceClient constructs a client for the evnode.v1.ConfigService service. By default,
@@ -60,6 +75,28 @@ func NewConfigServiceClient(httpClient connect.HTTPClient, baseURL string, opts
 			httpClient,
 			baseURL+ConfigServiceGetNamespaceProcedure,
 			connect.WithSchema(configServiceMethods.ByName("GetNamespace")),
+			connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+			connect.WithClientOptions(opts...),
+		),
+		validateConfig: connect.NewClient[v1.ValidateConfigRequest, v1.ValidateConfigResponse](
+			httpClient,
+			baseURL+ConfigServiceValidateConfigProcedure,
+			connect.WithSchema(configServiceMethods.ByName("ValidateConfig")),
+			connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+			connect.WithClientOptions(opts...),
+		),
+		getNodeInfo: connect.NewClient[emptypb.Empty, v1.GetNodeInfoResponse](
+			httpClient,
+			baseURL+ConfigServiceGetNodeInfoProcedure,
+			connect.WithSchema(configServiceMethods.ByName("GetNodeInfo")),
+			connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+			connect.WithClientOptions(opts...),
+		),
+		getGenesis: connect.NewClient[emptypb.Empty, v1.GetGenesisResponse](
+			httpClient,
+			baseURL+ConfigServiceGetGenesisProcedure,
+			connect.WithSchema(configServiceMethods.ByName("GetGenesis")),
+			connect.WithIdempotency(connect.IdempotencyNoSideEffects),
 			connect.WithClientOptions(opts...),
 		),
 	}
@@ -67,7 +104,10 @@ func NewConfigServiceClient(httpClient connect.HTTPClient, baseURL string, opts
 
 // configServiceClient implements ConfigServiceClient.
 type configServiceClient struct {
-	getNamespace *connect.Client[emptypb.Empty, v1.GetNamespaceResponse]
+	getNamespace   *connect.Client[emptypb.Empty, v1.GetNamespaceResponse]
+	validateConfig *connect.Client[v1.ValidateConfigRequest, v1.ValidateConfigResponse]
+	getNodeInfo    *connect.Client[emptypb.Empty, v1.GetNodeInfoResponse]
+	getGenesis     *connect.Client[emptypb.Empty, v1.GetGenesisResponse]
 }
 
 // GetNamespace calls evnode.v1.ConfigService.GetNamespace.
@@ -75,10 +115,31 @@ func (c *configServiceClient) GetNamespace(ctx context.Context, req *connect.Req
 	return c.getNamespace.CallUnary(ctx, req)
 }
 
+// ValidateConfig calls evnode.v1.ConfigService.ValidateConfig.
+func (c *configServiceClient) ValidateConfig(ctx context.Context, req *connect.Request[v1.ValidateConfigRequest]) (*connect.Response[v1.ValidateConfigResponse], error) {
+	return c.validateConfig.CallUnary(ctx, req)
+}
+
+// GetNodeInfo calls evnode.v1.ConfigService.GetNodeInfo.
+func (c *configServiceClient) GetNodeInfo(ctx context.Context, req *connect.Request[emptypb.Empty]) (*connect.Response[v1.GetNodeInfoResponse], error) {
+	return c.getNodeInfo.CallUnary(ctx, req)
+}
+
+// GetGenesis calls evnode.v1.ConfigService.GetGenesis.
+func (c *configServiceClient) GetGenesis(ctx context.Context, req *connect.Request[emptypb.Empty]) (*connect.Response[v1.GetGenesisResponse], error) {
+	return c.getGenesis.CallUnary(ctx, req)
+}
+
 // ConfigServiceHandler is an implementation of the evnode.v1.ConfigService service.
 type ConfigServiceHandler interface {
 	// GetNamespace returns the namespace for this network
 	GetNamespace(context.Context, *connect.Request[emptypb.Empty]) (*connect.Response[v1.GetNamespaceResponse], error)
+	// ValidateConfig checks a proposed YAML configuration file against the options supported by this node
+	ValidateConfig(context.Context, *connect.Request[v1.ValidateConfigRequest]) (*connect.Response[v1.ValidateConfigResponse], error)
+	// GetNodeInfo returns the version, chain and components of this node
+	GetNodeInfo(context.Context, *connect.Request[emptypb.Empty]) (*connect.Response[v1.GetNodeInfoResponse], error)
+	// GetGenesis returns the genesis document of the network of this node
+	GetGenesis(context.Context, *connect.Request[emptypb.Empty]) (*connect.Response[v1.GetGenesisResponse], error)
 }
 
 // NewConfigServiceHandler builds an HTTP handler from the service implementation. It returns the
@@ -92,12 +153,40 @@ func NewConfigServiceHandler(svc ConfigServiceHandler, opts ...connect.HandlerOp
 		ConfigServiceGetNamespaceProcedure,
 		svc.GetNamespace,
 		connect.WithSchema(configServiceMethods.ByName("GetNamespace")),
+		connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+		connect.WithHandlerOptions(opts...),
+	)
+	configServiceValidateConfigHandler := connect.NewUnaryHandler(
+		ConfigServiceValidateConfigProcedure,
+		svc.ValidateConfig,
+		connect.WithSchema(configServiceMethods.ByName("ValidateConfig")),
+		connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+		connect.WithHandlerOptions(opts...),
+	)
+	configServiceGetNodeInfoHandler := connect.NewUnaryHandler(
+		ConfigServiceGetNodeInfoProcedure,
+		svc.GetNodeInfo,
+		connect.WithSchema(configServiceMethods.ByName("GetNodeInfo")),
+		connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+		connect.WithHandlerOptions(opts...),
+	)
+	configServiceGetGenesisHandler := connect.NewUnaryHandler(
+		ConfigServiceGetGenesisProcedure,
+		svc.GetGenesis,
+		connect.WithSchema(configServiceMethods.ByName("GetGenesis")),
+		connect.WithIdempotency(connect.IdempotencyNoSideEffects),
 		connect.WithHandlerOptions(opts...),
 	)
 	return "/evnode.v1.ConfigService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		switch r.URL.Path {
 		case ConfigServiceGetNamespaceProcedure:
 			configServiceGetNamespaceHandler.ServeHTTP(w, r)
+		case ConfigServiceValidateConfigProcedure:
+			configServiceValidateConfigHandler.ServeHTTP(w, r)
+		case ConfigServiceGetNodeInfoProcedure:
+			configServiceGetNodeInfoHandler.ServeHTTP(w, r)
+		case ConfigServiceGetGenesisProcedure:
+			configServiceGetGenesisHandler.ServeHTTP(w, r)
 		default:
 			http.NotFound(w, r)
 		}
@@ -110,3 +199,15 @@ type UnimplementedConfigServiceHandler struct{}
 func (UnimplementedConfigServiceHandler) GetNamespace(context.Context, *connect.Request[emptypb.Empty]) (*connect.Response[v1.GetNamespaceResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("evnode.v1.ConfigService.GetNamespace is not implemented"))
 }
+
+func (UnimplementedConfigServiceHandler) ValidateConfig(context.Context, *connect.Request[v1.ValidateConfigRequest]) (*connect.Response[v1.ValidateConfigResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("evnode.v1.ConfigService.ValidateConfig is not implemented"))
+}
+
+func (UnimplementedConfigServiceHandler) GetNodeInfo(context.Context, *connect.Request[emptypb.Empty]) (*connect.Response[v1.GetNodeInfoResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("evnode.v1.ConfigService.GetNodeInfo is not implemented"))
+}
+
+func (UnimplementedConfigServiceHandler) GetGenesis(context.Context, *connect.Request[emptypb.Empty]) (*connect.Response[v1.GetGenesisResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("evnode.v1.ConfigService.GetGenesis is not implemented"))
+}
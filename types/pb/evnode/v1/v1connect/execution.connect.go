@@ -44,6 +44,9 @@ const (
 	// ExecutorServiceSetFinalProcedure is the fully-qualified name of the ExecutorService's SetFinal
 	// RPC.
 	ExecutorServiceSetFinalProcedure = "/evnode.v1.ExecutorService/SetFinal"
+	// ExecutorServiceSimulateTxsProcedure is the fully-qualified name of the ExecutorService's
+	// SimulateTxs RPC.
+	ExecutorServiceSimulateTxsProcedure = "/evnode.v1.ExecutorService/SimulateTxs"
 )
 
 // ExecutorServiceClient is a client for the evnode.v1.ExecutorService service.
@@ -56,6 +59,9 @@ type ExecutorServiceClient interface {
 	ExecuteTxs(context.Context, *connect.Request[v1.ExecuteTxsRequest]) (*connect.Response[v1.ExecuteTxsResponse], error)
 	// SetFinal marks a block as finalized at the specified height
 	SetFinal(context.Context, *connect.Request[v1.SetFinalRequest]) (*connect.Response[v1.SetFinalResponse], error)
+	// SimulateTxs executes transactions as ExecuteTxs would and discards the result, for executors
+	// able to simulate transactions. Others fail with the UNIMPLEMENTED code.
+	SimulateTxs(context.Context, *connect.Request[v1.SimulateTxsRequest]) (*connect.Response[v1.SimulateTxsResponse], error)
 }
 
 // NewExecutorServiceClient constructs a client for the evnode.v1.ExecutorService service. By
@@ -93,15 +99,22 @@ func NewExecutorServiceClient(httpClient connect.HTTPClient, baseURL string, opt
 			connect.WithSchema(executorServiceMethods.ByName("SetFinal")),
 			connect.WithClientOptions(opts...),
 		),
+		simulateTxs: connect.NewClient[v1.SimulateTxsRequest, v1.SimulateTxsResponse](
+			httpClient,
+			baseURL+ExecutorServiceSimulateTxsProcedure,
+			connect.WithSchema(executorServiceMethods.ByName("SimulateTxs")),
+			connect.WithClientOptions(opts...),
+		),
 	}
 }
 
 // executorServiceClient implements ExecutorServiceClient.
 type executorServiceClient struct {
-	initChain  *connect.Client[v1.InitChainRequest, v1.InitChainResponse]
-	getTxs     *connect.Client[v1.GetTxsRequest, v1.GetTxsResponse]
-	executeTxs *connect.Client[v1.ExecuteTxsRequest, v1.ExecuteTxsResponse]
-	setFinal   *connect.Client[v1.SetFinalRequest, v1.SetFinalResponse]
+	initChain   *connect.Client[v1.InitChainRequest, v1.InitChainResponse]
+	getTxs      *connect.Client[v1.GetTxsRequest, v1.GetTxsResponse]
+	executeTxs  *connect.Client[v1.ExecuteTxsRequest, v1.ExecuteTxsResponse]
+	setFinal    *connect.Client[v1.SetFinalRequest, v1.SetFinalResponse]
+	simulateTxs *connect.Client[v1.SimulateTxsRequest, v1.SimulateTxsResponse]
 }
 
 // InitChain calls evnode.v1.ExecutorService.InitChain.
@@ -124,6 +137,11 @@ func (c *executorServiceClient) SetFinal(ctx context.Context, req *connect.Reque
 	return c.setFinal.CallUnary(ctx, req)
 }
 
+// SimulateTxs calls evnode.v1.ExecutorService.SimulateTxs.
+func (c *executorServiceClient) SimulateTxs(ctx context.Context, req *connect.Request[v1.SimulateTxsRequest]) (*connect.Response[v1.SimulateTxsResponse], error) {
+	return c.simulateTxs.CallUnary(ctx, req)
+}
+
 // ExecutorServiceHandler is an implementation of the evnode.v1.ExecutorService service.
 type ExecutorServiceHandler interface {
 	// InitChain initializes a new blockchain instance with genesis parameters
@@ -134,6 +152,9 @@ type ExecutorServiceHandler interface {
 	ExecuteTxs(context.Context, *connect.Request[v1.ExecuteTxsRequest]) (*connect.Response[v1.ExecuteTxsResponse], error)
 	// SetFinal marks a block as finalized at the specified height
 	SetFinal(context.Context, *connect.Request[v1.SetFinalRequest]) (*connect.Response[v1.SetFinalResponse], error)
+	// SimulateTxs executes transactions as ExecuteTxs would and discards the result, for executors
+	// able to simulate transactions. Others fail with the UNIMPLEMENTED code.
+	SimulateTxs(context.Context, *connect.Request[v1.SimulateTxsRequest]) (*connect.Response[v1.SimulateTxsResponse], error)
 }
 
 // NewExecutorServiceHandler builds an HTTP handler from the service implementation. It returns the
@@ -167,6 +188,12 @@ func NewExecutorServiceHandler(svc ExecutorServiceHandler, opts ...connect.Handl
 		connect.WithSchema(executorServiceMethods.ByName("SetFinal")),
 		connect.WithHandlerOptions(opts...),
 	)
+	executorServiceSimulateTxsHandler := connect.NewUnaryHandler(
+		ExecutorServiceSimulateTxsProcedure,
+		svc.SimulateTxs,
+		connect.WithSchema(executorServiceMethods.ByName("SimulateTxs")),
+		connect.WithHandlerOptions(opts...),
+	)
 	return "/evnode.v1.ExecutorService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		switch r.URL.Path {
 		case ExecutorServiceInitChainProcedure:
@@ -177,6 +204,8 @@ func NewExecutorServiceHandler(svc ExecutorServiceHandler, opts ...connect.Handl
 			executorServiceExecuteTxsHandler.ServeHTTP(w, r)
 		case ExecutorServiceSetFinalProcedure:
 			executorServiceSetFinalHandler.ServeHTTP(w, r)
+		case ExecutorServiceSimulateTxsProcedure:
+			executorServiceSimulateTxsHandler.ServeHTTP(w, r)
 		default:
 			http.NotFound(w, r)
 		}
@@ -201,3 +230,7 @@ func (UnimplementedExecutorServiceHandler) ExecuteTxs(context.Context, *connect.
 func (UnimplementedExecutorServiceHandler) SetFinal(context.Context, *connect.Request[v1.SetFinalRequest]) (*connect.Response[v1.SetFinalResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("evnode.v1.ExecutorService.SetFinal is not implemented"))
 }
+
+func (UnimplementedExecutorServiceHandler) SimulateTxs(context.Context, *connect.Request[v1.SimulateTxsRequest]) (*connect.Response[v1.SimulateTxsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("evnode.v1.ExecutorService.SimulateTxs is not implemented"))
+}
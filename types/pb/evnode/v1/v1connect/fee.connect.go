@@ -0,0 +1,113 @@
+// Code generated by protoc-gen-connect-go. DO NOT EDIT.
+//
+// Source: evnode/v1/fee.proto
+
+package v1connect
+
+import (
+	connect "connectrpc.com/connect"
+	context "context"
+	errors "errors"
+	v1 "github.com/evstack/ev-node/types/pb/evnode/v1"
+	http "net/http"
+	strings "strings"
+)
+
+// This is a compile-time assertion to ensure that this generated file and the connect package are
+// compatible. If you get a compiler error that this constant is not defined, this code was
+// generated with a version of connect newer than the one compiled into your binary. You can fix the
+// problem by either regenerating this code with an older version of connect or updating the connect
+// version compiled into your binary.
+const _ = connect.IsAtLeastVersion1_13_0
+
+const (
+	// FeeServiceName is the fully-qualified name of the FeeService service.
+	FeeServiceName = "evnode.v1.FeeService"
+)
+
+// These constants are the fully-qualified names of the RPCs defined in this package. They're
+// exposed at runtime as Spec.Procedure and as the final two segments of the HTTP route.
+//
+// Note that these are different from the fully-qualified method names used by
+// google.golang.org/protobuf/reflect/protoreflect. To convert from these constants to
+// reflection-formatted method names, remove the leading slash and convert the remaining slash to a
+// period.
+const (
+	// FeeServiceEstimateTxFeeProcedure is the fully-qualified name of the FeeService's EstimateTxFee
+	// RPC.
+	FeeServiceEstimateTxFeeProcedure = "/evnode.v1.FeeService/EstimateTxFee"
+)
+
+// FeeServiceClient is a client for the evnode.v1.FeeService service.
+type FeeServiceClient interface {
+	// EstimateTxFee returns the execution and DA cost components of a raw transaction
+	EstimateTxFee(context.Context, *connect.Request[v1.EstimateTxFeeRequest]) (*connect.Response[v1.EstimateTxFeeResponse], error)
+}
+
+// NewFeeServiceClient constructs a client for the evnode.v1.FeeService service. By default, it uses
+// the Connect protocol with the binary Protobuf Codec, asks for gzipped responses, and sends
+// uncompressed requests. To use the gRPC or gRPC-Web protocols, supply the connect.WithGRPC() or
+// connect.WithGRPCWeb() options.
+//
+// The URL supplied here should be the base URL for the Connect or gRPC server (for example,
+// http://api.acme.com or https://acme.com/grpc).
+func NewFeeServiceClient(httpClient connect.HTTPClient, baseURL string, opts ...connect.ClientOption) FeeServiceClient {
+	baseURL = strings.TrimRight(baseURL, "/")
+	feeServiceMethods := v1.File_evnode_v1_fee_proto.Services().ByName("FeeService").Methods()
+	return &feeServiceClient{
+		estimateTxFee: connect.NewClient[v1.EstimateTxFeeRequest, v1.EstimateTxFeeResponse](
+			httpClient,
+			baseURL+FeeServiceEstimateTxFeeProcedure,
+			connect.WithSchema(feeServiceMethods.ByName("EstimateTxFee")),
+			connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+			connect.WithClientOptions(opts...),
+		),
+	}
+}
+
+// feeServiceClient implements FeeServiceClient.
+type feeServiceClient struct {
+	estimateTxFee *connect.Client[v1.EstimateTxFeeRequest, v1.EstimateTxFeeResponse]
+}
+
+// EstimateTxFee calls evnode.v1.FeeService.EstimateTxFee.
+func (c *feeServiceClient) EstimateTxFee(ctx context.Context, req *connect.Request[v1.EstimateTxFeeRequest]) (*connect.Response[v1.EstimateTxFeeResponse], error) {
+	return c.estimateTxFee.CallUnary(ctx, req)
+}
+
+// FeeServiceHandler is an implementation of the evnode.v1.FeeService service.
+type FeeServiceHandler interface {
+	// EstimateTxFee returns the execution and DA cost components of a raw transaction
+	EstimateTxFee(context.Context, *connect.Request[v1.EstimateTxFeeRequest]) (*connect.Response[v1.EstimateTxFeeResponse], error)
+}
+
+// NewFeeServiceHandler builds an HTTP handler from the service implementation. It returns the path
+// on which to mount the handler and the handler itself.
+//
+// By default, handlers support the Connect, gRPC, and gRPC-Web protocols with the binary Protobuf
+// and JSON codecs. They also support gzip compression.
+func NewFeeServiceHandler(svc FeeServiceHandler, opts ...connect.HandlerOption) (string, http.Handler) {
+	feeServiceMethods := v1.File_evnode_v1_fee_proto.Services().ByName("FeeService").Methods()
+	feeServiceEstimateTxFeeHandler := connect.NewUnaryHandler(
+		FeeServiceEstimateTxFeeProcedure,
+		svc.EstimateTxFee,
+		connect.WithSchema(feeServiceMethods.ByName("EstimateTxFee")),
+		connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+		connect.WithHandlerOptions(opts...),
+	)
+	return "/evnode.v1.FeeService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case FeeServiceEstimateTxFeeProcedure:
+			feeServiceEstimateTxFeeHandler.ServeHTTP(w, r)
+		default:
+			http.NotFound(w, r)
+		}
+	})
+}
+
+// UnimplementedFeeServiceHandler returns CodeUnimplemented from all methods.
+type UnimplementedFeeServiceHandler struct{}
+
+func (UnimplementedFeeServiceHandler) EstimateTxFee(context.Context, *connect.Request[v1.EstimateTxFeeRequest]) (*connect.Response[v1.EstimateTxFeeResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("evnode.v1.FeeService.EstimateTxFee is not implemented"))
+}
@@ -36,12 +36,25 @@ const (
 const (
 	// HealthServiceLivezProcedure is the fully-qualified name of the HealthService's Livez RPC.
 	HealthServiceLivezProcedure = "/evnode.v1.HealthService/Livez"
+	// HealthServiceReadyzProcedure is the fully-qualified name of the HealthService's Readyz RPC.
+	HealthServiceReadyzProcedure = "/evnode.v1.HealthService/Readyz"
+	// HealthServiceGetAlertsProcedure is the fully-qualified name of the HealthService's GetAlerts RPC.
+	HealthServiceGetAlertsProcedure = "/evnode.v1.HealthService/GetAlerts"
+	// HealthServiceGetErrorsProcedure is the fully-qualified name of the HealthService's GetErrors RPC.
+	HealthServiceGetErrorsProcedure = "/evnode.v1.HealthService/GetErrors"
 )
 
 // HealthServiceClient is a client for the evnode.v1.HealthService service.
 type HealthServiceClient interface {
 	// Livez returns the health status of the node
 	Livez(context.Context, *connect.Request[emptypb.Empty]) (*connect.Response[v1.GetHealthResponse], error)
+	// Readyz returns whether the node is ready to serve, with the result of every readiness check.
+	// The status is WARN if a non-critical check fails and FAIL if a critical check fails.
+	Readyz(context.Context, *connect.Request[emptypb.Empty]) (*connect.Response[v1.ReadyzResponse], error)
+	// GetAlerts returns the current state of the alert rules evaluated by the node
+	GetAlerts(context.Context, *connect.Request[emptypb.Empty]) (*connect.Response[v1.GetAlertsResponse], error)
+	// GetErrors returns the most recent error of every subsystem of the node which reported one
+	GetErrors(context.Context, *connect.Request[emptypb.Empty]) (*connect.Response[v1.GetErrorsResponse], error)
 }
 
 // NewHealthServiceClient constructs a client for the evnode.v1.HealthService service. By default,
@@ -59,6 +72,28 @@ func NewHealthServiceClient(httpClient connect.HTTPClient, baseURL string, opts
 			httpClient,
 			baseURL+HealthServiceLivezProcedure,
 			connect.WithSchema(healthServiceMethods.ByName("Livez")),
+			connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+			connect.WithClientOptions(opts...),
+		),
+		readyz: connect.NewClient[emptypb.Empty, v1.ReadyzResponse](
+			httpClient,
+			baseURL+HealthServiceReadyzProcedure,
+			connect.WithSchema(healthServiceMethods.ByName("Readyz")),
+			connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+			connect.WithClientOptions(opts...),
+		),
+		getAlerts: connect.NewClient[emptypb.Empty, v1.GetAlertsResponse](
+			httpClient,
+			baseURL+HealthServiceGetAlertsProcedure,
+			connect.WithSchema(healthServiceMethods.ByName("GetAlerts")),
+			connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+			connect.WithClientOptions(opts...),
+		),
+		getErrors: connect.NewClient[emptypb.Empty, v1.GetErrorsResponse](
+			httpClient,
+			baseURL+HealthServiceGetErrorsProcedure,
+			connect.WithSchema(healthServiceMethods.ByName("GetErrors")),
+			connect.WithIdempotency(connect.IdempotencyNoSideEffects),
 			connect.WithClientOptions(opts...),
 		),
 	}
@@ -66,7 +101,10 @@ func NewHealthServiceClient(httpClient connect.HTTPClient, baseURL string, opts
 
 // healthServiceClient implements HealthServiceClient.
 type healthServiceClient struct {
-	livez *connect.Client[emptypb.Empty, v1.GetHealthResponse]
+	livez     *connect.Client[emptypb.Empty, v1.GetHealthResponse]
+	readyz    *connect.Client[emptypb.Empty, v1.ReadyzResponse]
+	getAlerts *connect.Client[emptypb.Empty, v1.GetAlertsResponse]
+	getErrors *connect.Client[emptypb.Empty, v1.GetErrorsResponse]
 }
 
 // Livez calls evnode.v1.HealthService.Livez.
@@ -74,10 +112,32 @@ func (c *healthServiceClient) Livez(ctx context.Context, req *connect.Request[em
 	return c.livez.CallUnary(ctx, req)
 }
 
+// Readyz calls evnode.v1.HealthService.Readyz.
+func (c *healthServiceClient) Readyz(ctx context.Context, req *connect.Request[emptypb.Empty]) (*connect.Response[v1.ReadyzResponse], error) {
+	return c.readyz.CallUnary(ctx, req)
+}
+
+// GetAlerts calls evnode.v1.HealthService.GetAlerts.
+func (c *healthServiceClient) GetAlerts(ctx context.Context, req *connect.Request[emptypb.Empty]) (*connect.Response[v1.GetAlertsResponse], error) {
+	return c.getAlerts.CallUnary(ctx, req)
+}
+
+// GetErrors calls evnode.v1.HealthService.GetErrors.
+func (c *healthServiceClient) GetErrors(ctx context.Context, req *connect.Request[emptypb.Empty]) (*connect.Response[v1.GetErrorsResponse], error) {
+	return c.getErrors.CallUnary(ctx, req)
+}
+
 // HealthServiceHandler is an implementation of the evnode.v1.HealthService service.
 type HealthServiceHandler interface {
 	// Livez returns the health status of the node
 	Livez(context.Context, *connect.Request[emptypb.Empty]) (*connect.Response[v1.GetHealthResponse], error)
+	// Readyz returns whether the node is ready to serve, with the result of every readiness check.
+	// The status is WARN if a non-critical check fails and FAIL if a critical check fails.
+	Readyz(context.Context, *connect.Request[emptypb.Empty]) (*connect.Response[v1.ReadyzResponse], error)
+	// GetAlerts returns the current state of the alert rules evaluated by the node
+	GetAlerts(context.Context, *connect.Request[emptypb.Empty]) (*connect.Response[v1.GetAlertsResponse], error)
+	// GetErrors returns the most recent error of every subsystem of the node which reported one
+	GetErrors(context.Context, *connect.Request[emptypb.Empty]) (*connect.Response[v1.GetErrorsResponse], error)
 }
 
 // NewHealthServiceHandler builds an HTTP handler from the service implementation. It returns the
@@ -91,12 +151,40 @@ func NewHealthServiceHandler(svc HealthServiceHandler, opts ...connect.HandlerOp
 		HealthServiceLivezProcedure,
 		svc.Livez,
 		connect.WithSchema(healthServiceMethods.ByName("Livez")),
+		connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+		connect.WithHandlerOptions(opts...),
+	)
+	healthServiceReadyzHandler := connect.NewUnaryHandler(
+		HealthServiceReadyzProcedure,
+		svc.Readyz,
+		connect.WithSchema(healthServiceMethods.ByName("Readyz")),
+		connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+		connect.WithHandlerOptions(opts...),
+	)
+	healthServiceGetAlertsHandler := connect.NewUnaryHandler(
+		HealthServiceGetAlertsProcedure,
+		svc.GetAlerts,
+		connect.WithSchema(healthServiceMethods.ByName("GetAlerts")),
+		connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+		connect.WithHandlerOptions(opts...),
+	)
+	healthServiceGetErrorsHandler := connect.NewUnaryHandler(
+		HealthServiceGetErrorsProcedure,
+		svc.GetErrors,
+		connect.WithSchema(healthServiceMethods.ByName("GetErrors")),
+		connect.WithIdempotency(connect.IdempotencyNoSideEffects),
 		connect.WithHandlerOptions(opts...),
 	)
 	return "/evnode.v1.HealthService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		switch r.URL.Path {
 		case HealthServiceLivezProcedure:
 			healthServiceLivezHandler.ServeHTTP(w, r)
+		case HealthServiceReadyzProcedure:
+			healthServiceReadyzHandler.ServeHTTP(w, r)
+		case HealthServiceGetAlertsProcedure:
+			healthServiceGetAlertsHandler.ServeHTTP(w, r)
+		case HealthServiceGetErrorsProcedure:
+			healthServiceGetErrorsHandler.ServeHTTP(w, r)
 		default:
 			http.NotFound(w, r)
 		}
@@ -109,3 +197,15 @@ type UnimplementedHealthServiceHandler struct{}
 func (UnimplementedHealthServiceHandler) Livez(context.Context, *connect.Request[emptypb.Empty]) (*connect.Response[v1.GetHealthResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("evnode.v1.HealthService.Livez is not implemented"))
 }
+
+func (UnimplementedHealthServiceHandler) Readyz(context.Context, *connect.Request[emptypb.Empty]) (*connect.Response[v1.ReadyzResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("evnode.v1.HealthService.Readyz is not implemented"))
+}
+
+func (UnimplementedHealthServiceHandler) GetAlerts(context.Context, *connect.Request[emptypb.Empty]) (*connect.Response[v1.GetAlertsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("evnode.v1.HealthService.GetAlerts is not implemented"))
+}
+
+func (UnimplementedHealthServiceHandler) GetErrors(context.Context, *connect.Request[emptypb.Empty]) (*connect.Response[v1.GetErrorsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("evnode.v1.HealthService.GetErrors is not implemented"))
+}
@@ -42,8 +42,8 @@ const (
 
 // P2PServiceClient is a client for the evnode.v1.P2PService service.
 type P2PServiceClient interface {
-	// GetPeerInfo returns information about the connected peers
-	GetPeerInfo(context.Context, *connect.Request[emptypb.Empty]) (*connect.Response[v1.GetPeerInfoResponse], error)
+	// GetPeerInfo returns information about the connected peers, a page at a time
+	GetPeerInfo(context.Context, *connect.Request[v1.GetPeerInfoRequest]) (*connect.Response[v1.GetPeerInfoResponse], error)
 	// GetNetInfo returns network information
 	GetNetInfo(context.Context, *connect.Request[emptypb.Empty]) (*connect.Response[v1.GetNetInfoResponse], error)
 }
@@ -59,16 +59,18 @@ func NewP2PServiceClient(httpClient connect.HTTPClient, baseURL string, opts ...
 	baseURL = strings.TrimRight(baseURL, "/")
 	p2PServiceMethods := v1.File_evnode_v1_p2p_rpc_proto.Services().ByName("P2PService").Methods()
 	return &p2PServiceClient{
-		getPeerInfo: connect.NewClient[emptypb.Empty, v1.GetPeerInfoResponse](
+		getPeerInfo: connect.NewClient[v1.GetPeerInfoRequest, v1.GetPeerInfoResponse](
 			httpClient,
 			baseURL+P2PServiceGetPeerInfoProcedure,
 			connect.WithSchema(p2PServiceMethods.ByName("GetPeerInfo")),
+			connect.WithIdempotency(connect.IdempotencyNoSideEffects),
 			connect.WithClientOptions(opts...),
 		),
 		getNetInfo: connect.NewClient[emptypb.Empty, v1.GetNetInfoResponse](
 			httpClient,
 			baseURL+P2PServiceGetNetInfoProcedure,
 			connect.WithSchema(p2PServiceMethods.ByName("GetNetInfo")),
+			connect.WithIdempotency(connect.IdempotencyNoSideEffects),
 			connect.WithClientOptions(opts...),
 		),
 	}
@@ -76,12 +78,12 @@ func NewP2PServiceClient(httpClient connect.HTTPClient, baseURL string, opts ...
 
 // p2PServiceClient implements P2PServiceClient.
 type p2PServiceClient struct {
-	getPeerInfo *connect.Client[emptypb.Empty, v1.GetPeerInfoResponse]
+	getPeerInfo *connect.Client[v1.GetPeerInfoRequest, v1.GetPeerInfoResponse]
 	getNetInfo  *connect.Client[emptypb.Empty, v1.GetNetInfoResponse]
 }
 
 // GetPeerInfo calls evnode.v1.P2PService.GetPeerInfo.
-func (c *p2PServiceClient) GetPeerInfo(ctx context.Context, req *connect.Request[emptypb.Empty]) (*connect.Response[v1.GetPeerInfoResponse], error) {
+func (c *p2PServiceClient) GetPeerInfo(ctx context.Context, req *connect.Request[v1.GetPeerInfoRequest]) (*connect.Response[v1.GetPeerInfoResponse], error) {
 	return c.getPeerInfo.CallUnary(ctx, req)
 }
 
@@ -92,8 +94,8 @@ func (c *p2PServiceClient) GetNetInfo(ctx context.Context, req *connect.Request[
 
 // P2PServiceHandler is an implementation of the evnode.v1.P2PService service.
 type P2PServiceHandler interface {
-	// GetPeerInfo returns information about the connected peers
-	GetPeerInfo(context.Context, *connect.Request[emptypb.Empty]) (*connect.Response[v1.GetPeerInfoResponse], error)
+	// GetPeerInfo returns information about the connected peers, a page at a time
+	GetPeerInfo(context.Context, *connect.Request[v1.GetPeerInfoRequest]) (*connect.Response[v1.GetPeerInfoResponse], error)
 	// GetNetInfo returns network information
 	GetNetInfo(context.Context, *connect.Request[emptypb.Empty]) (*connect.Response[v1.GetNetInfoResponse], error)
 }
@@ -109,12 +111,14 @@ func NewP2PServiceHandler(svc P2PServiceHandler, opts ...connect.HandlerOption)
 		P2PServiceGetPeerInfoProcedure,
 		svc.GetPeerInfo,
 		connect.WithSchema(p2PServiceMethods.ByName("GetPeerInfo")),
+		connect.WithIdempotency(connect.IdempotencyNoSideEffects),
 		connect.WithHandlerOptions(opts...),
 	)
 	p2PServiceGetNetInfoHandler := connect.NewUnaryHandler(
 		P2PServiceGetNetInfoProcedure,
 		svc.GetNetInfo,
 		connect.WithSchema(p2PServiceMethods.ByName("GetNetInfo")),
+		connect.WithIdempotency(connect.IdempotencyNoSideEffects),
 		connect.WithHandlerOptions(opts...),
 	)
 	return "/evnode.v1.P2PService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
@@ -132,7 +136,7 @@ func NewP2PServiceHandler(svc P2PServiceHandler, opts ...connect.HandlerOption)
 // UnimplementedP2PServiceHandler returns CodeUnimplemented from all methods.
 type UnimplementedP2PServiceHandler struct{}
 
-func (UnimplementedP2PServiceHandler) GetPeerInfo(context.Context, *connect.Request[emptypb.Empty]) (*connect.Response[v1.GetPeerInfoResponse], error) {
+func (UnimplementedP2PServiceHandler) GetPeerInfo(context.Context, *connect.Request[v1.GetPeerInfoRequest]) (*connect.Response[v1.GetPeerInfoResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("evnode.v1.P2PService.GetPeerInfo is not implemented"))
 }
 
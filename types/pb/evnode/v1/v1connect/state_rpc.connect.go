@@ -36,21 +36,113 @@ const (
 const (
 	// StoreServiceGetBlockProcedure is the fully-qualified name of the StoreService's GetBlock RPC.
 	StoreServiceGetBlockProcedure = "/evnode.v1.StoreService/GetBlock"
+	// StoreServiceGetBlockStreamProcedure is the fully-qualified name of the StoreService's
+	// GetBlockStream RPC.
+	StoreServiceGetBlockStreamProcedure = "/evnode.v1.StoreService/GetBlockStream"
+	// StoreServiceSubscribeBlocksProcedure is the fully-qualified name of the StoreService's
+	// SubscribeBlocks RPC.
+	StoreServiceSubscribeBlocksProcedure = "/evnode.v1.StoreService/SubscribeBlocks"
+	// StoreServiceSubscribePreviewBlocksProcedure is the fully-qualified name of the StoreService's
+	// SubscribePreviewBlocks RPC.
+	StoreServiceSubscribePreviewBlocksProcedure = "/evnode.v1.StoreService/SubscribePreviewBlocks"
+	// StoreServiceGetHeaderProcedure is the fully-qualified name of the StoreService's GetHeader RPC.
+	StoreServiceGetHeaderProcedure = "/evnode.v1.StoreService/GetHeader"
+	// StoreServiceGetHeaderRangeProcedure is the fully-qualified name of the StoreService's
+	// GetHeaderRange RPC.
+	StoreServiceGetHeaderRangeProcedure = "/evnode.v1.StoreService/GetHeaderRange"
+	// StoreServiceSearchBlocksProcedure is the fully-qualified name of the StoreService's SearchBlocks
+	// RPC.
+	StoreServiceSearchBlocksProcedure = "/evnode.v1.StoreService/SearchBlocks"
 	// StoreServiceGetStateProcedure is the fully-qualified name of the StoreService's GetState RPC.
 	StoreServiceGetStateProcedure = "/evnode.v1.StoreService/GetState"
 	// StoreServiceGetMetadataProcedure is the fully-qualified name of the StoreService's GetMetadata
 	// RPC.
 	StoreServiceGetMetadataProcedure = "/evnode.v1.StoreService/GetMetadata"
+	// StoreServiceGetMetadataBatchProcedure is the fully-qualified name of the StoreService's
+	// GetMetadataBatch RPC.
+	StoreServiceGetMetadataBatchProcedure = "/evnode.v1.StoreService/GetMetadataBatch"
+	// StoreServiceGetStateDiffProcedure is the fully-qualified name of the StoreService's GetStateDiff
+	// RPC.
+	StoreServiceGetStateDiffProcedure = "/evnode.v1.StoreService/GetStateDiff"
+	// StoreServiceGetSequencerFeesProcedure is the fully-qualified name of the StoreService's
+	// GetSequencerFees RPC.
+	StoreServiceGetSequencerFeesProcedure = "/evnode.v1.StoreService/GetSequencerFees"
+	// StoreServiceGetEventsProcedure is the fully-qualified name of the StoreService's GetEvents RPC.
+	StoreServiceGetEventsProcedure = "/evnode.v1.StoreService/GetEvents"
+	// StoreServiceGetTxStatusProcedure is the fully-qualified name of the StoreService's GetTxStatus
+	// RPC.
+	StoreServiceGetTxStatusProcedure = "/evnode.v1.StoreService/GetTxStatus"
+	// StoreServiceGetBlockByTxHashProcedure is the fully-qualified name of the StoreService's
+	// GetBlockByTxHash RPC.
+	StoreServiceGetBlockByTxHashProcedure = "/evnode.v1.StoreService/GetBlockByTxHash"
+	// StoreServiceGetTxProofProcedure is the fully-qualified name of the StoreService's GetTxProof RPC.
+	StoreServiceGetTxProofProcedure = "/evnode.v1.StoreService/GetTxProof"
+	// StoreServiceGetSyncStatusProcedure is the fully-qualified name of the StoreService's
+	// GetSyncStatus RPC.
+	StoreServiceGetSyncStatusProcedure = "/evnode.v1.StoreService/GetSyncStatus"
+	// StoreServiceGetDAInclusionProofProcedure is the fully-qualified name of the StoreService's
+	// GetDAInclusionProof RPC.
+	StoreServiceGetDAInclusionProofProcedure = "/evnode.v1.StoreService/GetDAInclusionProof"
+	// StoreServiceGetDAInfoProcedure is the fully-qualified name of the StoreService's GetDAInfo RPC.
+	StoreServiceGetDAInfoProcedure = "/evnode.v1.StoreService/GetDAInfo"
+	// StoreServiceGetExecutionConsistencyProcedure is the fully-qualified name of the StoreService's
+	// GetExecutionConsistency RPC.
+	StoreServiceGetExecutionConsistencyProcedure = "/evnode.v1.StoreService/GetExecutionConsistency"
 )
 
 // StoreServiceClient is a client for the evnode.v1.StoreService service.
 type StoreServiceClient interface {
 	// GetBlock returns a block by height or hash
 	GetBlock(context.Context, *connect.Request[v1.GetBlockRequest]) (*connect.Response[v1.GetBlockResponse], error)
+	// GetBlockStream returns a block by height or hash in chunks, for blocks too large to be
+	// retrieved in a single response
+	GetBlockStream(context.Context, *connect.Request[v1.GetBlockStreamRequest]) (*connect.ServerStreamForClient[v1.GetBlockStreamResponse], error)
+	// SubscribeBlocks streams the blocks from a height, then the new blocks as they are produced or
+	// synced by the node
+	SubscribeBlocks(context.Context, *connect.Request[v1.SubscribeBlocksRequest]) (*connect.ServerStreamForClient[v1.SubscribeBlocksResponse], error)
+	// SubscribePreviewBlocks streams the unsigned preview blocks gossiped by the aggregator as soon
+	// as it executes them, ahead of their signed header. Previews are untrusted and may never
+	// become blocks.
+	SubscribePreviewBlocks(context.Context, *connect.Request[emptypb.Empty]) (*connect.ServerStreamForClient[v1.SubscribePreviewBlocksResponse], error)
+	// GetHeader returns the signed header of a block by height, without the block data
+	GetHeader(context.Context, *connect.Request[v1.GetHeaderRequest]) (*connect.Response[v1.GetHeaderResponse], error)
+	// GetHeaderRange returns the signed headers of a range of blocks, without the block data
+	GetHeaderRange(context.Context, *connect.Request[v1.GetHeaderRangeRequest]) (*connect.Response[v1.GetHeaderRangeResponse], error)
+	// SearchBlocks returns the blocks matching predicates on their metadata, looked up in the
+	// proposer, time and transaction count indexes of the store. The search continues with the
+	// next_page_token of the response
+	SearchBlocks(context.Context, *connect.Request[v1.SearchBlocksRequest]) (*connect.Response[v1.SearchBlocksResponse], error)
 	// GetState returns the current state
 	GetState(context.Context, *connect.Request[emptypb.Empty]) (*connect.Response[v1.GetStateResponse], error)
 	// GetMetadata returns metadata for a specific key
 	GetMetadata(context.Context, *connect.Request[v1.GetMetadataRequest]) (*connect.Response[v1.GetMetadataResponse], error)
+	// GetMetadataBatch returns the metadata of several keys at once
+	GetMetadataBatch(context.Context, *connect.Request[v1.GetMetadataBatchRequest]) (*connect.Response[v1.GetMetadataBatchResponse], error)
+	// GetStateDiff returns the execution state changes made by the block at a height
+	GetStateDiff(context.Context, *connect.Request[v1.GetStateDiffRequest]) (*connect.Response[v1.GetStateDiffResponse], error)
+	// GetSequencerFees returns the sequencing fees collected by the block at a height
+	GetSequencerFees(context.Context, *connect.Request[v1.GetSequencerFeesRequest]) (*connect.Response[v1.GetSequencerFeesResponse], error)
+	// GetEvents returns the node events recorded in the event journal
+	GetEvents(context.Context, *connect.Request[v1.GetEventsRequest]) (*connect.Response[v1.GetEventsResponse], error)
+	// GetTxStatus returns whether a transaction is pending in the sequencer or included in a block
+	GetTxStatus(context.Context, *connect.Request[v1.GetTxStatusRequest]) (*connect.Response[v1.GetTxStatusResponse], error)
+	// GetBlockByTxHash returns the latest block including a transaction, by the hash of the
+	// transaction
+	GetBlockByTxHash(context.Context, *connect.Request[v1.GetBlockByTxHashRequest]) (*connect.Response[v1.GetBlockByTxHashResponse], error)
+	// GetTxProof returns the proof that a transaction is included in a block: the signed header of
+	// the block and the transactions its data hash commits to
+	GetTxProof(context.Context, *connect.Request[v1.GetTxProofRequest]) (*connect.Response[v1.GetTxProofResponse], error)
+	// GetSyncStatus returns the progress of the node syncing the chain
+	GetSyncStatus(context.Context, *connect.Request[emptypb.Empty]) (*connect.Response[v1.GetSyncStatusResponse], error)
+	// GetDAInclusionProof returns the DA blobs containing the header and data of a block, with
+	// their commitments and inclusion proofs
+	GetDAInclusionProof(context.Context, *connect.Request[v1.GetDAInclusionProofRequest]) (*connect.Response[v1.GetDAInclusionProofResponse], error)
+	// GetDAInfo returns the DA layer of the node: its backend, network, namespaces, limits and the
+	// DA heights the node has reached
+	GetDAInfo(context.Context, *connect.Request[emptypb.Empty]) (*connect.Response[v1.GetDAInfoResponse], error)
+	// GetExecutionConsistency returns the execution blocks of the latest heights and whether they
+	// are consistent with the store of the node
+	GetExecutionConsistency(context.Context, *connect.Request[v1.GetExecutionConsistencyRequest]) (*connect.Response[v1.GetExecutionConsistencyResponse], error)
 }
 
 // NewStoreServiceClient constructs a client for the evnode.v1.StoreService service. By default, it
@@ -68,18 +160,140 @@ func NewStoreServiceClient(httpClient connect.HTTPClient, baseURL string, opts .
 			httpClient,
 			baseURL+StoreServiceGetBlockProcedure,
 			connect.WithSchema(storeServiceMethods.ByName("GetBlock")),
+			connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+			connect.WithClientOptions(opts...),
+		),
+		getBlockStream: connect.NewClient[v1.GetBlockStreamRequest, v1.GetBlockStreamResponse](
+			httpClient,
+			baseURL+StoreServiceGetBlockStreamProcedure,
+			connect.WithSchema(storeServiceMethods.ByName("GetBlockStream")),
+			connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+			connect.WithClientOptions(opts...),
+		),
+		subscribeBlocks: connect.NewClient[v1.SubscribeBlocksRequest, v1.SubscribeBlocksResponse](
+			httpClient,
+			baseURL+StoreServiceSubscribeBlocksProcedure,
+			connect.WithSchema(storeServiceMethods.ByName("SubscribeBlocks")),
+			connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+			connect.WithClientOptions(opts...),
+		),
+		subscribePreviewBlocks: connect.NewClient[emptypb.Empty, v1.SubscribePreviewBlocksResponse](
+			httpClient,
+			baseURL+StoreServiceSubscribePreviewBlocksProcedure,
+			connect.WithSchema(storeServiceMethods.ByName("SubscribePreviewBlocks")),
+			connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+			connect.WithClientOptions(opts...),
+		),
+		getHeader: connect.NewClient[v1.GetHeaderRequest, v1.GetHeaderResponse](
+			httpClient,
+			baseURL+StoreServiceGetHeaderProcedure,
+			connect.WithSchema(storeServiceMethods.ByName("GetHeader")),
+			connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+			connect.WithClientOptions(opts...),
+		),
+		getHeaderRange: connect.NewClient[v1.GetHeaderRangeRequest, v1.GetHeaderRangeResponse](
+			httpClient,
+			baseURL+StoreServiceGetHeaderRangeProcedure,
+			connect.WithSchema(storeServiceMethods.ByName("GetHeaderRange")),
+			connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+			connect.WithClientOptions(opts...),
+		),
+		searchBlocks: connect.NewClient[v1.SearchBlocksRequest, v1.SearchBlocksResponse](
+			httpClient,
+			baseURL+StoreServiceSearchBlocksProcedure,
+			connect.WithSchema(storeServiceMethods.ByName("SearchBlocks")),
+			connect.WithIdempotency(connect.IdempotencyNoSideEffects),
 			connect.WithClientOptions(opts...),
 		),
 		getState: connect.NewClient[emptypb.Empty, v1.GetStateResponse](
 			httpClient,
 			baseURL+StoreServiceGetStateProcedure,
 			connect.WithSchema(storeServiceMethods.ByName("GetState")),
+			connect.WithIdempotency(connect.IdempotencyNoSideEffects),
 			connect.WithClientOptions(opts...),
 		),
 		getMetadata: connect.NewClient[v1.GetMetadataRequest, v1.GetMetadataResponse](
 			httpClient,
 			baseURL+StoreServiceGetMetadataProcedure,
 			connect.WithSchema(storeServiceMethods.ByName("GetMetadata")),
+			connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+			connect.WithClientOptions(opts...),
+		),
+		getMetadataBatch: connect.NewClient[v1.GetMetadataBatchRequest, v1.GetMetadataBatchResponse](
+			httpClient,
+			baseURL+StoreServiceGetMetadataBatchProcedure,
+			connect.WithSchema(storeServiceMethods.ByName("GetMetadataBatch")),
+			connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+			connect.WithClientOptions(opts...),
+		),
+		getStateDiff: connect.NewClient[v1.GetStateDiffRequest, v1.GetStateDiffResponse](
+			httpClient,
+			baseURL+StoreServiceGetStateDiffProcedure,
+			connect.WithSchema(storeServiceMethods.ByName("GetStateDiff")),
+			connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+			connect.WithClientOptions(opts...),
+		),
+		getSequencerFees: connect.NewClient[v1.GetSequencerFeesRequest, v1.GetSequencerFeesResponse](
+			httpClient,
+			baseURL+StoreServiceGetSequencerFeesProcedure,
+			connect.WithSchema(storeServiceMethods.ByName("GetSequencerFees")),
+			connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+			connect.WithClientOptions(opts...),
+		),
+		getEvents: connect.NewClient[v1.GetEventsRequest, v1.GetEventsResponse](
+			httpClient,
+			baseURL+StoreServiceGetEventsProcedure,
+			connect.WithSchema(storeServiceMethods.ByName("GetEvents")),
+			connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+			connect.WithClientOptions(opts...),
+		),
+		getTxStatus: connect.NewClient[v1.GetTxStatusRequest, v1.GetTxStatusResponse](
+			httpClient,
+			baseURL+StoreServiceGetTxStatusProcedure,
+			connect.WithSchema(storeServiceMethods.ByName("GetTxStatus")),
+			connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+			connect.WithClientOptions(opts...),
+		),
+		getBlockByTxHash: connect.NewClient[v1.GetBlockByTxHashRequest, v1.GetBlockByTxHashResponse](
+			httpClient,
+			baseURL+StoreServiceGetBlockByTxHashProcedure,
+			connect.WithSchema(storeServiceMethods.ByName("GetBlockByTxHash")),
+			connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+			connect.WithClientOptions(opts...),
+		),
+		getTxProof: connect.NewClient[v1.GetTxProofRequest, v1.GetTxProofResponse](
+			httpClient,
+			baseURL+StoreServiceGetTxProofProcedure,
+			connect.WithSchema(storeServiceMethods.ByName("GetTxProof")),
+			connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+			connect.WithClientOptions(opts...),
+		),
+		getSyncStatus: connect.NewClient[emptypb.Empty, v1.GetSyncStatusResponse](
+			httpClient,
+			baseURL+StoreServiceGetSyncStatusProcedure,
+			connect.WithSchema(storeServiceMethods.ByName("GetSyncStatus")),
+			connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+			connect.WithClientOptions(opts...),
+		),
+		getDAInclusionProof: connect.NewClient[v1.GetDAInclusionProofRequest, v1.GetDAInclusionProofResponse](
+			httpClient,
+			baseURL+StoreServiceGetDAInclusionProofProcedure,
+			connect.WithSchema(storeServiceMethods.ByName("GetDAInclusionProof")),
+			connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+			connect.WithClientOptions(opts...),
+		),
+		getDAInfo: connect.NewClient[emptypb.Empty, v1.GetDAInfoResponse](
+			httpClient,
+			baseURL+StoreServiceGetDAInfoProcedure,
+			connect.WithSchema(storeServiceMethods.ByName("GetDAInfo")),
+			connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+			connect.WithClientOptions(opts...),
+		),
+		getExecutionConsistency: connect.NewClient[v1.GetExecutionConsistencyRequest, v1.GetExecutionConsistencyResponse](
+			httpClient,
+			baseURL+StoreServiceGetExecutionConsistencyProcedure,
+			connect.WithSchema(storeServiceMethods.ByName("GetExecutionConsistency")),
+			connect.WithIdempotency(connect.IdempotencyNoSideEffects),
 			connect.WithClientOptions(opts...),
 		),
 	}
@@ -87,9 +301,26 @@ func NewStoreServiceClient(httpClient connect.HTTPClient, baseURL string, opts .
 
 // storeServiceClient implements StoreServiceClient.
 type storeServiceClient struct {
-	getBlock    *connect.Client[v1.GetBlockRequest, v1.GetBlockResponse]
-	getState    *connect.Client[emptypb.Empty, v1.GetStateResponse]
-	getMetadata *connect.Client[v1.GetMetadataRequest, v1.GetMetadataResponse]
+	getBlock                *connect.Client[v1.GetBlockRequest, v1.GetBlockResponse]
+	getBlockStream          *connect.Client[v1.GetBlockStreamRequest, v1.GetBlockStreamResponse]
+	subscribeBlocks         *connect.Client[v1.SubscribeBlocksRequest, v1.SubscribeBlocksResponse]
+	subscribePreviewBlocks  *connect.Client[emptypb.Empty, v1.SubscribePreviewBlocksResponse]
+	getHeader               *connect.Client[v1.GetHeaderRequest, v1.GetHeaderResponse]
+	getHeaderRange          *connect.Client[v1.GetHeaderRangeRequest, v1.GetHeaderRangeResponse]
+	searchBlocks            *connect.Client[v1.SearchBlocksRequest, v1.SearchBlocksResponse]
+	getState                *connect.Client[emptypb.Empty, v1.GetStateResponse]
+	getMetadata             *connect.Client[v1.GetMetadataRequest, v1.GetMetadataResponse]
+	getMetadataBatch        *connect.Client[v1.GetMetadataBatchRequest, v1.GetMetadataBatchResponse]
+	getStateDiff            *connect.Client[v1.GetStateDiffRequest, v1.GetStateDiffResponse]
+	getSequencerFees        *connect.Client[v1.GetSequencerFeesRequest, v1.GetSequencerFeesResponse]
+	getEvents               *connect.Client[v1.GetEventsRequest, v1.GetEventsResponse]
+	getTxStatus             *connect.Client[v1.GetTxStatusRequest, v1.GetTxStatusResponse]
+	getBlockByTxHash        *connect.Client[v1.GetBlockByTxHashRequest, v1.GetBlockByTxHashResponse]
+	getTxProof              *connect.Client[v1.GetTxProofRequest, v1.GetTxProofResponse]
+	getSyncStatus           *connect.Client[emptypb.Empty, v1.GetSyncStatusResponse]
+	getDAInclusionProof     *connect.Client[v1.GetDAInclusionProofRequest, v1.GetDAInclusionProofResponse]
+	getDAInfo               *connect.Client[emptypb.Empty, v1.GetDAInfoResponse]
+	getExecutionConsistency *connect.Client[v1.GetExecutionConsistencyRequest, v1.GetExecutionConsistencyResponse]
 }
 
 // GetBlock calls evnode.v1.StoreService.GetBlock.
@@ -97,6 +328,36 @@ func (c *storeServiceClient) GetBlock(ctx context.Context, req *connect.Request[
 	return c.getBlock.CallUnary(ctx, req)
 }
 
+// GetBlockStream calls evnode.v1.StoreService.GetBlockStream.
+func (c *storeServiceClient) GetBlockStream(ctx context.Context, req *connect.Request[v1.GetBlockStreamRequest]) (*connect.ServerStreamForClient[v1.GetBlockStreamResponse], error) {
+	return c.getBlockStream.CallServerStream(ctx, req)
+}
+
+// SubscribeBlocks calls evnode.v1.StoreService.SubscribeBlocks.
+func (c *storeServiceClient) SubscribeBlocks(ctx context.Context, req *connect.Request[v1.SubscribeBlocksRequest]) (*connect.ServerStreamForClient[v1.SubscribeBlocksResponse], error) {
+	return c.subscribeBlocks.CallServerStream(ctx, req)
+}
+
+// SubscribePreviewBlocks calls evnode.v1.StoreService.SubscribePreviewBlocks.
+func (c *storeServiceClient) SubscribePreviewBlocks(ctx context.Context, req *connect.Request[emptypb.Empty]) (*connect.ServerStreamForClient[v1.SubscribePreviewBlocksResponse], error) {
+	return c.subscribePreviewBlocks.CallServerStream(ctx, req)
+}
+
+// GetHeader calls evnode.v1.StoreService.GetHeader.
+func (c *storeServiceClient) GetHeader(ctx context.Context, req *connect.Request[v1.GetHeaderRequest]) (*connect.Response[v1.GetHeaderResponse], error) {
+	return c.getHeader.CallUnary(ctx, req)
+}
+
+// GetHeaderRange calls evnode.v1.StoreService.GetHeaderRange.
+func (c *storeServiceClient) GetHeaderRange(ctx context.Context, req *connect.Request[v1.GetHeaderRangeRequest]) (*connect.Response[v1.GetHeaderRangeResponse], error) {
+	return c.getHeaderRange.CallUnary(ctx, req)
+}
+
+// SearchBlocks calls evnode.v1.StoreService.SearchBlocks.
+func (c *storeServiceClient) SearchBlocks(ctx context.Context, req *connect.Request[v1.SearchBlocksRequest]) (*connect.Response[v1.SearchBlocksResponse], error) {
+	return c.searchBlocks.CallUnary(ctx, req)
+}
+
 // GetState calls evnode.v1.StoreService.GetState.
 func (c *storeServiceClient) GetState(ctx context.Context, req *connect.Request[emptypb.Empty]) (*connect.Response[v1.GetStateResponse], error) {
 	return c.getState.CallUnary(ctx, req)
@@ -107,14 +368,114 @@ func (c *storeServiceClient) GetMetadata(ctx context.Context, req *connect.Reque
 	return c.getMetadata.CallUnary(ctx, req)
 }
 
+// GetMetadataBatch calls evnode.v1.StoreService.GetMetadataBatch.
+func (c *storeServiceClient) GetMetadataBatch(ctx context.Context, req *connect.Request[v1.GetMetadataBatchRequest]) (*connect.Response[v1.GetMetadataBatchResponse], error) {
+	return c.getMetadataBatch.CallUnary(ctx, req)
+}
+
+// GetStateDiff calls evnode.v1.StoreService.GetStateDiff.
+func (c *storeServiceClient) GetStateDiff(ctx context.Context, req *connect.Request[v1.GetStateDiffRequest]) (*connect.Response[v1.GetStateDiffResponse], error) {
+	return c.getStateDiff.CallUnary(ctx, req)
+}
+
+// GetSequencerFees calls evnode.v1.StoreService.GetSequencerFees.
+func (c *storeServiceClient) GetSequencerFees(ctx context.Context, req *connect.Request[v1.GetSequencerFeesRequest]) (*connect.Response[v1.GetSequencerFeesResponse], error) {
+	return c.getSequencerFees.CallUnary(ctx, req)
+}
+
+// GetEvents calls evnode.v1.StoreService.GetEvents.
+func (c *storeServiceClient) GetEvents(ctx context.Context, req *connect.Request[v1.GetEventsRequest]) (*connect.Response[v1.GetEventsResponse], error) {
+	return c.getEvents.CallUnary(ctx, req)
+}
+
+// GetTxStatus calls evnode.v1.StoreService.GetTxStatus.
+func (c *storeServiceClient) GetTxStatus(ctx context.Context, req *connect.Request[v1.GetTxStatusRequest]) (*connect.Response[v1.GetTxStatusResponse], error) {
+	return c.getTxStatus.CallUnary(ctx, req)
+}
+
+// GetBlockByTxHash calls evnode.v1.StoreService.GetBlockByTxHash.
+func (c *storeServiceClient) GetBlockByTxHash(ctx context.Context, req *connect.Request[v1.GetBlockByTxHashRequest]) (*connect.Response[v1.GetBlockByTxHashResponse], error) {
+	return c.getBlockByTxHash.CallUnary(ctx, req)
+}
+
+// GetTxProof calls evnode.v1.StoreService.GetTxProof.
+func (c *storeServiceClient) GetTxProof(ctx context.Context, req *connect.Request[v1.GetTxProofRequest]) (*connect.Response[v1.GetTxProofResponse], error) {
+	return c.getTxProof.CallUnary(ctx, req)
+}
+
+// GetSyncStatus calls evnode.v1.StoreService.GetSyncStatus.
+func (c *storeServiceClient) GetSyncStatus(ctx context.Context, req *connect.Request[emptypb.Empty]) (*connect.Response[v1.GetSyncStatusResponse], error) {
+	return c.getSyncStatus.CallUnary(ctx, req)
+}
+
+// GetDAInclusionProof calls evnode.v1.StoreService.GetDAInclusionProof.
+func (c *storeServiceClient) GetDAInclusionProof(ctx context.Context, req *connect.Request[v1.GetDAInclusionProofRequest]) (*connect.Response[v1.GetDAInclusionProofResponse], error) {
+	return c.getDAInclusionProof.CallUnary(ctx, req)
+}
+
+// GetDAInfo calls evnode.v1.StoreService.GetDAInfo.
+func (c *storeServiceClient) GetDAInfo(ctx context.Context, req *connect.Request[emptypb.Empty]) (*connect.Response[v1.GetDAInfoResponse], error) {
+	return c.getDAInfo.CallUnary(ctx, req)
+}
+
+// GetExecutionConsistency calls evnode.v1.StoreService.GetExecutionConsistency.
+func (c *storeServiceClient) GetExecutionConsistency(ctx context.Context, req *connect.Request[v1.GetExecutionConsistencyRequest]) (*connect.Response[v1.GetExecutionConsistencyResponse], error) {
+	return c.getExecutionConsistency.CallUnary(ctx, req)
+}
+
 // StoreServiceHandler is an implementation of the evnode.v1.StoreService service.
 type StoreServiceHandler interface {
 	// GetBlock returns a block by height or hash
 	GetBlock(context.Context, *connect.Request[v1.GetBlockRequest]) (*connect.Response[v1.GetBlockResponse], error)
+	// GetBlockStream returns a block by height or hash in chunks, for blocks too large to be
+	// retrieved in a single response
+	GetBlockStream(context.Context, *connect.Request[v1.GetBlockStreamRequest], *connect.ServerStream[v1.GetBlockStreamResponse]) error
+	// SubscribeBlocks streams the blocks from a height, then the new blocks as they are produced or
+	// synced by the node
+	SubscribeBlocks(context.Context, *connect.Request[v1.SubscribeBlocksRequest], *connect.ServerStream[v1.SubscribeBlocksResponse]) error
+	// SubscribePreviewBlocks streams the unsigned preview blocks gossiped by the aggregator as soon
+	// as it executes them, ahead of their signed header. Previews are untrusted and may never
+	// become blocks.
+	SubscribePreviewBlocks(context.Context, *connect.Request[emptypb.Empty], *connect.ServerStream[v1.SubscribePreviewBlocksResponse]) error
+	// GetHeader returns the signed header of a block by height, without the block data
+	GetHeader(context.Context, *connect.Request[v1.GetHeaderRequest]) (*connect.Response[v1.GetHeaderResponse], error)
+	// GetHeaderRange returns the signed headers of a range of blocks, without the block data
+	GetHeaderRange(context.Context, *connect.Request[v1.GetHeaderRangeRequest]) (*connect.Response[v1.GetHeaderRangeResponse], error)
+	// SearchBlocks returns the blocks matching predicates on their metadata, looked up in the
+	// proposer, time and transaction count indexes of the store. The search continues with the
+	// next_page_token of the response
+	SearchBlocks(context.Context, *connect.Request[v1.SearchBlocksRequest]) (*connect.Response[v1.SearchBlocksResponse], error)
 	// GetState returns the current state
 	GetState(context.Context, *connect.Request[emptypb.Empty]) (*connect.Response[v1.GetStateResponse], error)
 	// GetMetadata returns metadata for a specific key
 	GetMetadata(context.Context, *connect.Request[v1.GetMetadataRequest]) (*connect.Response[v1.GetMetadataResponse], error)
+	// GetMetadataBatch returns the metadata of several keys at once
+	GetMetadataBatch(context.Context, *connect.Request[v1.GetMetadataBatchRequest]) (*connect.Response[v1.GetMetadataBatchResponse], error)
+	// GetStateDiff returns the execution state changes made by the block at a height
+	GetStateDiff(context.Context, *connect.Request[v1.GetStateDiffRequest]) (*connect.Response[v1.GetStateDiffResponse], error)
+	// GetSequencerFees returns the sequencing fees collected by the block at a height
+	GetSequencerFees(context.Context, *connect.Request[v1.GetSequencerFeesRequest]) (*connect.Response[v1.GetSequencerFeesResponse], error)
+	// GetEvents returns the node events recorded in the event journal
+	GetEvents(context.Context, *connect.Request[v1.GetEventsRequest]) (*connect.Response[v1.GetEventsResponse], error)
+	// GetTxStatus returns whether a transaction is pending in the sequencer or included in a block
+	GetTxStatus(context.Context, *connect.Request[v1.GetTxStatusRequest]) (*connect.Response[v1.GetTxStatusResponse], error)
+	// GetBlockByTxHash returns the latest block including a transaction, by the hash of the
+	// transaction
+	GetBlockByTxHash(context.Context, *connect.Request[v1.GetBlockByTxHashRequest]) (*connect.Response[v1.GetBlockByTxHashResponse], error)
+	// GetTxProof returns the proof that a transaction is included in a block: the signed header of
+	// the block and the transactions its data hash commits to
+	GetTxProof(context.Context, *connect.Request[v1.GetTxProofRequest]) (*connect.Response[v1.GetTxProofResponse], error)
+	// GetSyncStatus returns the progress of the node syncing the chain
+	GetSyncStatus(context.Context, *connect.Request[emptypb.Empty]) (*connect.Response[v1.GetSyncStatusResponse], error)
+	// GetDAInclusionProof returns the DA blobs containing the header and data of a block, with
+	// their commitments and inclusion proofs
+	GetDAInclusionProof(context.Context, *connect.Request[v1.GetDAInclusionProofRequest]) (*connect.Response[v1.GetDAInclusionProofResponse], error)
+	// GetDAInfo returns the DA layer of the node: its backend, network, namespaces, limits and the
+	// DA heights the node has reached
+	GetDAInfo(context.Context, *connect.Request[emptypb.Empty]) (*connect.Response[v1.GetDAInfoResponse], error)
+	// GetExecutionConsistency returns the execution blocks of the latest heights and whether they
+	// are consistent with the store of the node
+	GetExecutionConsistency(context.Context, *connect.Request[v1.GetExecutionConsistencyRequest]) (*connect.Response[v1.GetExecutionConsistencyResponse], error)
 }
 
 // NewStoreServiceHandler builds an HTTP handler from the service implementation. It returns the
@@ -128,28 +489,184 @@ func NewStoreServiceHandler(svc StoreServiceHandler, opts ...connect.HandlerOpti
 		StoreServiceGetBlockProcedure,
 		svc.GetBlock,
 		connect.WithSchema(storeServiceMethods.ByName("GetBlock")),
+		connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+		connect.WithHandlerOptions(opts...),
+	)
+	storeServiceGetBlockStreamHandler := connect.NewServerStreamHandler(
+		StoreServiceGetBlockStreamProcedure,
+		svc.GetBlockStream,
+		connect.WithSchema(storeServiceMethods.ByName("GetBlockStream")),
+		connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+		connect.WithHandlerOptions(opts...),
+	)
+	storeServiceSubscribeBlocksHandler := connect.NewServerStreamHandler(
+		StoreServiceSubscribeBlocksProcedure,
+		svc.SubscribeBlocks,
+		connect.WithSchema(storeServiceMethods.ByName("SubscribeBlocks")),
+		connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+		connect.WithHandlerOptions(opts...),
+	)
+	storeServiceSubscribePreviewBlocksHandler := connect.NewServerStreamHandler(
+		StoreServiceSubscribePreviewBlocksProcedure,
+		svc.SubscribePreviewBlocks,
+		connect.WithSchema(storeServiceMethods.ByName("SubscribePreviewBlocks")),
+		connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+		connect.WithHandlerOptions(opts...),
+	)
+	storeServiceGetHeaderHandler := connect.NewUnaryHandler(
+		StoreServiceGetHeaderProcedure,
+		svc.GetHeader,
+		connect.WithSchema(storeServiceMethods.ByName("GetHeader")),
+		connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+		connect.WithHandlerOptions(opts...),
+	)
+	storeServiceGetHeaderRangeHandler := connect.NewUnaryHandler(
+		StoreServiceGetHeaderRangeProcedure,
+		svc.GetHeaderRange,
+		connect.WithSchema(storeServiceMethods.ByName("GetHeaderRange")),
+		connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+		connect.WithHandlerOptions(opts...),
+	)
+	storeServiceSearchBlocksHandler := connect.NewUnaryHandler(
+		StoreServiceSearchBlocksProcedure,
+		svc.SearchBlocks,
+		connect.WithSchema(storeServiceMethods.ByName("SearchBlocks")),
+		connect.WithIdempotency(connect.IdempotencyNoSideEffects),
 		connect.WithHandlerOptions(opts...),
 	)
 	storeServiceGetStateHandler := connect.NewUnaryHandler(
 		StoreServiceGetStateProcedure,
 		svc.GetState,
 		connect.WithSchema(storeServiceMethods.ByName("GetState")),
+		connect.WithIdempotency(connect.IdempotencyNoSideEffects),
 		connect.WithHandlerOptions(opts...),
 	)
 	storeServiceGetMetadataHandler := connect.NewUnaryHandler(
 		StoreServiceGetMetadataProcedure,
 		svc.GetMetadata,
 		connect.WithSchema(storeServiceMethods.ByName("GetMetadata")),
+		connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+		connect.WithHandlerOptions(opts...),
+	)
+	storeServiceGetMetadataBatchHandler := connect.NewUnaryHandler(
+		StoreServiceGetMetadataBatchProcedure,
+		svc.GetMetadataBatch,
+		connect.WithSchema(storeServiceMethods.ByName("GetMetadataBatch")),
+		connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+		connect.WithHandlerOptions(opts...),
+	)
+	storeServiceGetStateDiffHandler := connect.NewUnaryHandler(
+		StoreServiceGetStateDiffProcedure,
+		svc.GetStateDiff,
+		connect.WithSchema(storeServiceMethods.ByName("GetStateDiff")),
+		connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+		connect.WithHandlerOptions(opts...),
+	)
+	storeServiceGetSequencerFeesHandler := connect.NewUnaryHandler(
+		StoreServiceGetSequencerFeesProcedure,
+		svc.GetSequencerFees,
+		connect.WithSchema(storeServiceMethods.ByName("GetSequencerFees")),
+		connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+		connect.WithHandlerOptions(opts...),
+	)
+	storeServiceGetEventsHandler := connect.NewUnaryHandler(
+		StoreServiceGetEventsProcedure,
+		svc.GetEvents,
+		connect.WithSchema(storeServiceMethods.ByName("GetEvents")),
+		connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+		connect.WithHandlerOptions(opts...),
+	)
+	storeServiceGetTxStatusHandler := connect.NewUnaryHandler(
+		StoreServiceGetTxStatusProcedure,
+		svc.GetTxStatus,
+		connect.WithSchema(storeServiceMethods.ByName("GetTxStatus")),
+		connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+		connect.WithHandlerOptions(opts...),
+	)
+	storeServiceGetBlockByTxHashHandler := connect.NewUnaryHandler(
+		StoreServiceGetBlockByTxHashProcedure,
+		svc.GetBlockByTxHash,
+		connect.WithSchema(storeServiceMethods.ByName("GetBlockByTxHash")),
+		connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+		connect.WithHandlerOptions(opts...),
+	)
+	storeServiceGetTxProofHandler := connect.NewUnaryHandler(
+		StoreServiceGetTxProofProcedure,
+		svc.GetTxProof,
+		connect.WithSchema(storeServiceMethods.ByName("GetTxProof")),
+		connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+		connect.WithHandlerOptions(opts...),
+	)
+	storeServiceGetSyncStatusHandler := connect.NewUnaryHandler(
+		StoreServiceGetSyncStatusProcedure,
+		svc.GetSyncStatus,
+		connect.WithSchema(storeServiceMethods.ByName("GetSyncStatus")),
+		connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+		connect.WithHandlerOptions(opts...),
+	)
+	storeServiceGetDAInclusionProofHandler := connect.NewUnaryHandler(
+		StoreServiceGetDAInclusionProofProcedure,
+		svc.GetDAInclusionProof,
+		connect.WithSchema(storeServiceMethods.ByName("GetDAInclusionProof")),
+		connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+		connect.WithHandlerOptions(opts...),
+	)
+	storeServiceGetDAInfoHandler := connect.NewUnaryHandler(
+		StoreServiceGetDAInfoProcedure,
+		svc.GetDAInfo,
+		connect.WithSchema(storeServiceMethods.ByName("GetDAInfo")),
+		connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+		connect.WithHandlerOptions(opts...),
+	)
+	storeServiceGetExecutionConsistencyHandler := connect.NewUnaryHandler(
+		StoreServiceGetExecutionConsistencyProcedure,
+		svc.GetExecutionConsistency,
+		connect.WithSchema(storeServiceMethods.ByName("GetExecutionConsistency")),
+		connect.WithIdempotency(connect.IdempotencyNoSideEffects),
 		connect.WithHandlerOptions(opts...),
 	)
 	return "/evnode.v1.StoreService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		switch r.URL.Path {
 		case StoreServiceGetBlockProcedure:
 			storeServiceGetBlockHandler.ServeHTTP(w, r)
+		case StoreServiceGetBlockStreamProcedure:
+			storeServiceGetBlockStreamHandler.ServeHTTP(w, r)
+		case StoreServiceSubscribeBlocksProcedure:
+			storeServiceSubscribeBlocksHandler.ServeHTTP(w, r)
+		case StoreServiceSubscribePreviewBlocksProcedure:
+			storeServiceSubscribePreviewBlocksHandler.ServeHTTP(w, r)
+		case StoreServiceGetHeaderProcedure:
+			storeServiceGetHeaderHandler.ServeHTTP(w, r)
+		case StoreServiceGetHeaderRangeProcedure:
+			storeServiceGetHeaderRangeHandler.ServeHTTP(w, r)
+		case StoreServiceSearchBlocksProcedure:
+			storeServiceSearchBlocksHandler.ServeHTTP(w, r)
 		case StoreServiceGetStateProcedure:
 			storeServiceGetStateHandler.ServeHTTP(w, r)
 		case StoreServiceGetMetadataProcedure:
 			storeServiceGetMetadataHandler.ServeHTTP(w, r)
+		case StoreServiceGetMetadataBatchProcedure:
+			storeServiceGetMetadataBatchHandler.ServeHTTP(w, r)
+		case StoreServiceGetStateDiffProcedure:
+			storeServiceGetStateDiffHandler.ServeHTTP(w, r)
+		case StoreServiceGetSequencerFeesProcedure:
+			storeServiceGetSequencerFeesHandler.ServeHTTP(w, r)
+		case StoreServiceGetEventsProcedure:
+			storeServiceGetEventsHandler.ServeHTTP(w, r)
+		case StoreServiceGetTxStatusProcedure:
+			storeServiceGetTxStatusHandler.ServeHTTP(w, r)
+		case StoreServiceGetBlockByTxHashProcedure:
+			storeServiceGetBlockByTxHashHandler.ServeHTTP(w, r)
+		case StoreServiceGetTxProofProcedure:
+			storeServiceGetTxProofHandler.ServeHTTP(w, r)
+		case StoreServiceGetSyncStatusProcedure:
+			storeServiceGetSyncStatusHandler.ServeHTTP(w, r)
+		case StoreServiceGetDAInclusionProofProcedure:
+			storeServiceGetDAInclusionProofHandler.ServeHTTP(w, r)
+		case StoreServiceGetDAInfoProcedure:
+			storeServiceGetDAInfoHandler.ServeHTTP(w, r)
+		case StoreServiceGetExecutionConsistencyProcedure:
+			storeServiceGetExecutionConsistencyHandler.ServeHTTP(w, r)
 		default:
 			http.NotFound(w, r)
 		}
@@ -163,6 +680,30 @@ func (UnimplementedStoreServiceHandler) GetBlock(context.Context, *connect.Reque
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("evnode.v1.StoreService.GetBlock is not implemented"))
 }
 
+func (UnimplementedStoreServiceHandler) GetBlockStream(context.Context, *connect.Request[v1.GetBlockStreamRequest], *connect.ServerStream[v1.GetBlockStreamResponse]) error {
+	return connect.NewError(connect.CodeUnimplemented, errors.New("evnode.v1.StoreService.GetBlockStream is not implemented"))
+}
+
+func (UnimplementedStoreServiceHandler) SubscribeBlocks(context.Context, *connect.Request[v1.SubscribeBlocksRequest], *connect.ServerStream[v1.SubscribeBlocksResponse]) error {
+	return connect.NewError(connect.CodeUnimplemented, errors.New("evnode.v1.StoreService.SubscribeBlocks is not implemented"))
+}
+
+func (UnimplementedStoreServiceHandler) SubscribePreviewBlocks(context.Context, *connect.Request[emptypb.Empty], *connect.ServerStream[v1.SubscribePreviewBlocksResponse]) error {
+	return connect.NewError(connect.CodeUnimplemented, errors.New("evnode.v1.StoreService.SubscribePreviewBlocks is not implemented"))
+}
+
+func (UnimplementedStoreServiceHandler) GetHeader(context.Context, *connect.Request[v1.GetHeaderRequest]) (*connect.Response[v1.GetHeaderResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("evnode.v1.StoreService.GetHeader is not implemented"))
+}
+
+func (UnimplementedStoreServiceHandler) GetHeaderRange(context.Context, *connect.Request[v1.GetHeaderRangeRequest]) (*connect.Response[v1.GetHeaderRangeResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("evnode.v1.StoreService.GetHeaderRange is not implemented"))
+}
+
+func (UnimplementedStoreServiceHandler) SearchBlocks(context.Context, *connect.Request[v1.SearchBlocksRequest]) (*connect.Response[v1.SearchBlocksResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("evnode.v1.StoreService.SearchBlocks is not implemented"))
+}
+
 func (UnimplementedStoreServiceHandler) GetState(context.Context, *connect.Request[emptypb.Empty]) (*connect.Response[v1.GetStateResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("evnode.v1.StoreService.GetState is not implemented"))
 }
@@ -170,3 +711,47 @@ func (UnimplementedStoreServiceHandler) GetState(context.Context, *connect.Reque
 func (UnimplementedStoreServiceHandler) GetMetadata(context.Context, *connect.Request[v1.GetMetadataRequest]) (*connect.Response[v1.GetMetadataResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("evnode.v1.StoreService.GetMetadata is not implemented"))
 }
+
+func (UnimplementedStoreServiceHandler) GetMetadataBatch(context.Context, *connect.Request[v1.GetMetadataBatchRequest]) (*connect.Response[v1.GetMetadataBatchResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("evnode.v1.StoreService.GetMetadataBatch is not implemented"))
+}
+
+func (UnimplementedStoreServiceHandler) GetStateDiff(context.Context, *connect.Request[v1.GetStateDiffRequest]) (*connect.Response[v1.GetStateDiffResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("evnode.v1.StoreService.GetStateDiff is not implemented"))
+}
+
+func (UnimplementedStoreServiceHandler) GetSequencerFees(context.Context, *connect.Request[v1.GetSequencerFeesRequest]) (*connect.Response[v1.GetSequencerFeesResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("evnode.v1.StoreService.GetSequencerFees is not implemented"))
+}
+
+func (UnimplementedStoreServiceHandler) GetEvents(context.Context, *connect.Request[v1.GetEventsRequest]) (*connect.Response[v1.GetEventsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("evnode.v1.StoreService.GetEvents is not implemented"))
+}
+
+func (UnimplementedStoreServiceHandler) GetTxStatus(context.Context, *connect.Request[v1.GetTxStatusRequest]) (*connect.Response[v1.GetTxStatusResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("evnode.v1.StoreService.GetTxStatus is not implemented"))
+}
+
+func (UnimplementedStoreServiceHandler) GetBlockByTxHash(context.Context, *connect.Request[v1.GetBlockByTxHashRequest]) (*connect.Response[v1.GetBlockByTxHashResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("evnode.v1.StoreService.GetBlockByTxHash is not implemented"))
+}
+
+func (UnimplementedStoreServiceHandler) GetTxProof(context.Context, *connect.Request[v1.GetTxProofRequest]) (*connect.Response[v1.GetTxProofResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("evnode.v1.StoreService.GetTxProof is not implemented"))
+}
+
+func (UnimplementedStoreServiceHandler) GetSyncStatus(context.Context, *connect.Request[emptypb.Empty]) (*connect.Response[v1.GetSyncStatusResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("evnode.v1.StoreService.GetSyncStatus is not implemented"))
+}
+
+func (UnimplementedStoreServiceHandler) GetDAInclusionProof(context.Context, *connect.Request[v1.GetDAInclusionProofRequest]) (*connect.Response[v1.GetDAInclusionProofResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("evnode.v1.StoreService.GetDAInclusionProof is not implemented"))
+}
+
+func (UnimplementedStoreServiceHandler) GetDAInfo(context.Context, *connect.Request[emptypb.Empty]) (*connect.Response[v1.GetDAInfoResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("evnode.v1.StoreService.GetDAInfo is not implemented"))
+}
+
+func (UnimplementedStoreServiceHandler) GetExecutionConsistency(context.Context, *connect.Request[v1.GetExecutionConsistencyRequest]) (*connect.Response[v1.GetExecutionConsistencyResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("evnode.v1.StoreService.GetExecutionConsistency is not implemented"))
+}
@@ -0,0 +1,399 @@
+package types
+
+import (
+	"errors"
+	"unicode/utf8"
+
+	"google.golang.org/protobuf/encoding/protowire"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	pb "github.com/evstack/ev-node/types/pb/evnode/v1"
+)
+
+// Reference protobuf codec of SignedHeader, Data and State.
+//
+// It implements the proto3 wire format rules the nodes rely on by hand, with no generated code
+// and no protobuf runtime, so that the fuzz targets can compare it with the protobuf runtime:
+//   - fields are decoded in any order, the last value of a scalar field wins, repeated fields
+//     accumulate and repeated occurrences of a message field are merged
+//   - unknown fields, and known fields with an unexpected wire type, are skipped
+//   - strings must be valid UTF-8 and field numbers at most 2^29-1
+//   - fields are encoded in ascending field number order, scalar fields with their default value
+//     are omitted, and message fields are written if set, even if empty
+
+var (
+	errRefInvalidUTF8 = errors.New("string field contains invalid UTF-8")
+	errRefFieldNumber = errors.New("invalid field number")
+)
+
+// refFields calls fn with the number, type and raw value of every field of the message b.
+func refFields(b []byte, fn func(num protowire.Number, typ protowire.Type, value []byte) error) error {
+	for len(b) > 0 {
+		num, typ, n := protowire.ConsumeTag(b)
+		if n < 0 {
+			return protowire.ParseError(n)
+		}
+		// protowire accepts the larger field numbers of message sets
+		if num > protowire.MaxValidNumber {
+			return errRefFieldNumber
+		}
+		b = b[n:]
+		n = protowire.ConsumeFieldValue(num, typ, b)
+		if n < 0 {
+			return protowire.ParseError(n)
+		}
+		if err := fn(num, typ, b[:n]); err != nil {
+			return err
+		}
+		b = b[n:]
+	}
+	return nil
+}
+
+// refVarint decodes a varint field value, returning false if the field is not a varint.
+func refVarint(typ protowire.Type, value []byte) (uint64, bool) {
+	if typ != protowire.VarintType {
+		return 0, false
+	}
+	v, _ := protowire.ConsumeVarint(value)
+	return v, true
+}
+
+// refBytes decodes a length-delimited field value, returning false if the field is not length-delimited.
+func refBytes(typ protowire.Type, value []byte) ([]byte, bool) {
+	if typ != protowire.BytesType {
+		return nil, false
+	}
+	v, _ := protowire.ConsumeBytes(value)
+	return append([]byte{}, v...), true
+}
+
+// refString decodes a string field value.
+func refString(typ protowire.Type, value []byte, s *string) error {
+	v, ok := refBytes(typ, value)
+	if !ok {
+		return nil
+	}
+	if !utf8.Valid(v) {
+		return errRefInvalidUTF8
+	}
+	*s = string(v)
+	return nil
+}
+
+func refDecodeVersion(b []byte, v *pb.Version) error {
+	return refFields(b, func(num protowire.Number, typ protowire.Type, value []byte) error {
+		switch num {
+		case 1:
+			if x, ok := refVarint(typ, value); ok {
+				v.Block = x
+			}
+		case 2:
+			if x, ok := refVarint(typ, value); ok {
+				v.App = x
+			}
+		}
+		return nil
+	})
+}
+
+func refDecodeHeader(b []byte, h *pb.Header) error {
+	return refFields(b, func(num protowire.Number, typ protowire.Type, value []byte) error {
+		var field *[]byte
+		switch num {
+		case 1:
+			if x, ok := refBytes(typ, value); ok {
+				if h.Version == nil {
+					h.Version = &pb.Version{}
+				}
+				return refDecodeVersion(x, h.Version)
+			}
+		case 2:
+			if x, ok := refVarint(typ, value); ok {
+				h.Height = x
+			}
+		case 3:
+			if x, ok := refVarint(typ, value); ok {
+				h.Time = x
+			}
+		case 4:
+			field = &h.LastHeaderHash
+		case 5:
+			field = &h.LastCommitHash
+		case 6:
+			field = &h.DataHash
+		case 7:
+			field = &h.ConsensusHash
+		case 8:
+			field = &h.AppHash
+		case 9:
+			field = &h.LastResultsHash
+		case 10:
+			field = &h.ProposerAddress
+		case 11:
+			field = &h.ValidatorHash
+		case 12:
+			return refString(typ, value, &h.ChainId)
+		}
+		if field != nil {
+			if x, ok := refBytes(typ, value); ok {
+				*field = x
+			}
+		}
+		return nil
+	})
+}
+
+func refDecodeSigner(b []byte, s *pb.Signer) error {
+	return refFields(b, func(num protowire.Number, typ protowire.Type, value []byte) error {
+		switch num {
+		case 1:
+			if x, ok := refBytes(typ, value); ok {
+				s.Address = x
+			}
+		case 2:
+			if x, ok := refBytes(typ, value); ok {
+				s.PubKey = x
+			}
+		}
+		return nil
+	})
+}
+
+func refDecodeSignedHeader(b []byte, sh *pb.SignedHeader) error {
+	return refFields(b, func(num protowire.Number, typ protowire.Type, value []byte) error {
+		switch num {
+		case 1:
+			if x, ok := refBytes(typ, value); ok {
+				if sh.Header == nil {
+					sh.Header = &pb.Header{}
+				}
+				return refDecodeHeader(x, sh.Header)
+			}
+		case 2:
+			if x, ok := refBytes(typ, value); ok {
+				sh.Signature = x
+			}
+		case 3:
+			if x, ok := refBytes(typ, value); ok {
+				if sh.Signer == nil {
+					sh.Signer = &pb.Signer{}
+				}
+				return refDecodeSigner(x, sh.Signer)
+			}
+		}
+		return nil
+	})
+}
+
+func refDecodeMetadata(b []byte, m *pb.Metadata) error {
+	return refFields(b, func(num protowire.Number, typ protowire.Type, value []byte) error {
+		switch num {
+		case 1:
+			return refString(typ, value, &m.ChainId)
+		case 2:
+			if x, ok := refVarint(typ, value); ok {
+				m.Height = x
+			}
+		case 3:
+			if x, ok := refVarint(typ, value); ok {
+				m.Time = x
+			}
+		case 4:
+			if x, ok := refBytes(typ, value); ok {
+				m.LastDataHash = x
+			}
+		}
+		return nil
+	})
+}
+
+func refDecodeData(b []byte, d *pb.Data) error {
+	return refFields(b, func(num protowire.Number, typ protowire.Type, value []byte) error {
+		switch num {
+		case 1:
+			if x, ok := refBytes(typ, value); ok {
+				if d.Metadata == nil {
+					d.Metadata = &pb.Metadata{}
+				}
+				return refDecodeMetadata(x, d.Metadata)
+			}
+		case 2:
+			if x, ok := refBytes(typ, value); ok {
+				d.Txs = append(d.Txs, x)
+			}
+		}
+		return nil
+	})
+}
+
+func refDecodeTimestamp(b []byte, ts *timestamppb.Timestamp) error {
+	return refFields(b, func(num protowire.Number, typ protowire.Type, value []byte) error {
+		switch num {
+		case 1:
+			if x, ok := refVarint(typ, value); ok {
+				ts.Seconds = int64(x) //nolint:gosec // int64 fields are encoded as their two's complement
+			}
+		case 2:
+			if x, ok := refVarint(typ, value); ok {
+				ts.Nanos = int32(x) //nolint:gosec // int32 fields are truncated to their lower 32 bits
+			}
+		}
+		return nil
+	})
+}
+
+func refDecodeState(b []byte, s *pb.State) error {
+	return refFields(b, func(num protowire.Number, typ protowire.Type, value []byte) error {
+		switch num {
+		case 1:
+			if x, ok := refBytes(typ, value); ok {
+				if s.Version == nil {
+					s.Version = &pb.Version{}
+				}
+				return refDecodeVersion(x, s.Version)
+			}
+		case 2:
+			return refString(typ, value, &s.ChainId)
+		case 3:
+			if x, ok := refVarint(typ, value); ok {
+				s.InitialHeight = x
+			}
+		case 4:
+			if x, ok := refVarint(typ, value); ok {
+				s.LastBlockHeight = x
+			}
+		case 5:
+			if x, ok := refBytes(typ, value); ok {
+				if s.LastBlockTime == nil {
+					s.LastBlockTime = &timestamppb.Timestamp{}
+				}
+				return refDecodeTimestamp(x, s.LastBlockTime)
+			}
+		case 6:
+			if x, ok := refVarint(typ, value); ok {
+				s.DaHeight = x
+			}
+		case 7:
+			if x, ok := refBytes(typ, value); ok {
+				s.LastResultsHash = x
+			}
+		case 8:
+			if x, ok := refBytes(typ, value); ok {
+				s.AppHash = x
+			}
+		}
+		return nil
+	})
+}
+
+func refAppendVarint(b []byte, num protowire.Number, v uint64) []byte {
+	if v == 0 {
+		return b
+	}
+	b = protowire.AppendTag(b, num, protowire.VarintType)
+	return protowire.AppendVarint(b, v)
+}
+
+func refAppendBytes(b []byte, num protowire.Number, v []byte) []byte {
+	if len(v) == 0 {
+		return b
+	}
+	b = protowire.AppendTag(b, num, protowire.BytesType)
+	return protowire.AppendBytes(b, v)
+}
+
+func refAppendMessage(b []byte, num protowire.Number, v []byte) []byte {
+	b = protowire.AppendTag(b, num, protowire.BytesType)
+	return protowire.AppendBytes(b, v)
+}
+
+func refEncodeVersion(v *pb.Version) []byte {
+	var b []byte
+	b = refAppendVarint(b, 1, v.Block)
+	b = refAppendVarint(b, 2, v.App)
+	return b
+}
+
+func refEncodeHeader(h *pb.Header) []byte {
+	var b []byte
+	if h.Version != nil {
+		b = refAppendMessage(b, 1, refEncodeVersion(h.Version))
+	}
+	b = refAppendVarint(b, 2, h.Height)
+	b = refAppendVarint(b, 3, h.Time)
+	b = refAppendBytes(b, 4, h.LastHeaderHash)
+	b = refAppendBytes(b, 5, h.LastCommitHash)
+	b = refAppendBytes(b, 6, h.DataHash)
+	b = refAppendBytes(b, 7, h.ConsensusHash)
+	b = refAppendBytes(b, 8, h.AppHash)
+	b = refAppendBytes(b, 9, h.LastResultsHash)
+	b = refAppendBytes(b, 10, h.ProposerAddress)
+	b = refAppendBytes(b, 11, h.ValidatorHash)
+	b = refAppendBytes(b, 12, []byte(h.ChainId))
+	return b
+}
+
+func refEncodeSignedHeader(sh *pb.SignedHeader) []byte {
+	var b []byte
+	if sh.Header != nil {
+		b = refAppendMessage(b, 1, refEncodeHeader(sh.Header))
+	}
+	b = refAppendBytes(b, 2, sh.Signature)
+	if sh.Signer != nil {
+		var signer []byte
+		signer = refAppendBytes(signer, 1, sh.Signer.Address)
+		signer = refAppendBytes(signer, 2, sh.Signer.PubKey)
+		b = refAppendMessage(b, 3, signer)
+	}
+	return b
+}
+
+func refEncodeData(d *pb.Data) []byte {
+	var b []byte
+	if d.Metadata != nil {
+		var metadata []byte
+		metadata = refAppendBytes(metadata, 1, []byte(d.Metadata.ChainId))
+		metadata = refAppendVarint(metadata, 2, d.Metadata.Height)
+		metadata = refAppendVarint(metadata, 3, d.Metadata.Time)
+		metadata = refAppendBytes(metadata, 4, d.Metadata.LastDataHash)
+		b = refAppendMessage(b, 1, metadata)
+	}
+	for _, tx := range d.Txs {
+		b = refAppendMessage(b, 2, tx)
+	}
+	return b
+}
+
+func refEncodeState(s *pb.State) []byte {
+	var b []byte
+	if s.Version != nil {
+		b = refAppendMessage(b, 1, refEncodeVersion(s.Version))
+	}
+	b = refAppendBytes(b, 2, []byte(s.ChainId))
+	b = refAppendVarint(b, 3, s.InitialHeight)
+	b = refAppendVarint(b, 4, s.LastBlockHeight)
+	if s.LastBlockTime != nil {
+		var ts []byte
+		ts = refAppendVarint(ts, 1, uint64(s.LastBlockTime.Seconds)) //nolint:gosec // two's complement encoding
+		ts = refAppendVarint(ts, 2, uint64(s.LastBlockTime.Nanos))   //nolint:gosec // sign-extended encoding
+		b = refAppendMessage(b, 5, ts)
+	}
+	b = refAppendVarint(b, 6, s.DaHeight)
+	b = refAppendBytes(b, 7, s.LastResultsHash)
+	b = refAppendBytes(b, 8, s.AppHash)
+	return b
+}
+
+// discardUnknown removes the unknown fields of m and of its message fields, which the
+// reference codec does not retain.
+func discardUnknown(m protoreflect.Message) {
+	m.SetUnknown(nil)
+	m.Range(func(fd protoreflect.FieldDescriptor, v protoreflect.Value) bool {
+		if fd.Message() != nil && !fd.IsList() && !fd.IsMap() {
+			discardUnknown(v.Message())
+		}
+		return true
+	})
+}
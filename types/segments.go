@@ -0,0 +1,249 @@
+package types
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/binary"
+	"errors"
+	"fmt"
+
+	"google.golang.org/protobuf/proto"
+
+	pb "github.com/evstack/ev-node/types/pb/evnode/v1"
+)
+
+// segmentedDataMagic prefixes the DA blobs of signed data laid out in segments. Protobuf encoded
+// signed data and blob envelopes never start with it.
+var segmentedDataMagic = []byte{0xfe, 'e', 'v', 's'}
+
+// segmentedDataVersion is the version of the segmented data layout.
+const segmentedDataVersion = 1
+
+// segmentEntryLength is the length of an entry of the segment index: the index of the first
+// transaction of the segment, the number of its transactions, its length and its SHA-256 hash.
+const segmentEntryLength = 4 + 4 + 4 + sha256.Size
+
+// ErrNotSegmentedData is returned when parsing a blob which is not laid out in segments.
+var ErrNotSegmentedData = errors.New("not segmented data")
+
+// DataSegment is an entry of the index of a segmented data blob.
+type DataSegment struct {
+	// FirstTx is the index of the first transaction of the segment in the block.
+	FirstTx uint32
+	// TxCount is the number of transactions of the segment.
+	TxCount uint32
+	// Offset is the offset of the segment in the blob, a multiple of the segment size from the
+	// start of the segments.
+	Offset uint64
+	// Length is the length of the segment.
+	Length uint32
+	// Hash is the SHA-256 hash of the segment.
+	Hash [sha256.Size]byte
+}
+
+// DataSegmentIndex is the head of a segmented data blob: the signed data without its
+// transactions, and the index of the segments holding them.
+type DataSegmentIndex struct {
+	// SignedData is the signed data of the blob, without transactions.
+	SignedData *SignedData
+	// SegmentSize is the size the segments are aligned to.
+	SegmentSize uint32
+	// Segments are the segments of the blob, in transaction order.
+	Segments []DataSegment
+}
+
+// MarshalSegmented encodes SignedData for DA in segments of segmentSize bytes, so that DA layers
+// and light clients can sample or fetch a range of transactions without the whole blob.
+//
+// The layout is the magic prefix, the version, the segment size and the length of the head as
+// big-endian uint32, the head, i.e. the protobuf encoded signed data without transactions, the
+// number of segments as a big-endian uint32 and an entry per segment with the index of its first
+// transaction, its number of transactions, its length and its SHA-256 hash, followed by the
+// segments. Each segment holds whole transactions prefixed with their uvarint length and starts
+// at a multiple of the segment size from the first segment, the gap being zero padded. A
+// transaction larger than the segment size is alone in its segment.
+func (sd *SignedData) MarshalSegmented(segmentSize uint32) ([]byte, error) {
+	if segmentSize == 0 {
+		return nil, errors.New("segment size must be positive")
+	}
+	head, err := sd.ToProto()
+	if err != nil {
+		return nil, err
+	}
+	head.Data.Txs = nil
+	headBz, err := proto.Marshal(head)
+	if err != nil {
+		return nil, err
+	}
+
+	var segments [][]byte
+	var entries []DataSegment
+	for i, tx := range sd.Txs {
+		encoded := binary.AppendUvarint(nil, uint64(len(tx)))
+		encoded = append(encoded, tx...)
+		last := len(segments) - 1
+		if last < 0 || len(segments[last])+len(encoded) > int(segmentSize) {
+			segments = append(segments, nil)
+			entries = append(entries, DataSegment{FirstTx: uint32(i)})
+			last++
+		}
+		segments[last] = append(segments[last], encoded...)
+		entries[last].TxCount++
+	}
+
+	bz := append([]byte{}, segmentedDataMagic...)
+	bz = append(bz, segmentedDataVersion)
+	bz = binary.BigEndian.AppendUint32(bz, segmentSize)
+	bz = binary.BigEndian.AppendUint32(bz, uint32(len(headBz)))
+	bz = append(bz, headBz...)
+	bz = binary.BigEndian.AppendUint32(bz, uint32(len(segments)))
+	for i, segment := range segments {
+		if uint64(len(segment)) > uint64(^uint32(0)) {
+			return nil, fmt.Errorf("segment %d too large: %d bytes", i, len(segment))
+		}
+		hash := sha256.Sum256(segment)
+		bz = binary.BigEndian.AppendUint32(bz, entries[i].FirstTx)
+		bz = binary.BigEndian.AppendUint32(bz, entries[i].TxCount)
+		bz = binary.BigEndian.AppendUint32(bz, uint32(len(segment)))
+		bz = append(bz, hash[:]...)
+	}
+	start := len(bz)
+	for _, segment := range segments {
+		if pad := (len(bz) - start) % int(segmentSize); pad != 0 {
+			bz = append(bz, make([]byte, int(segmentSize)-pad)...)
+		}
+		bz = append(bz, segment...)
+	}
+	return bz, nil
+}
+
+// ParseDataSegmentIndex parses the head of a segmented data blob. bz may be a prefix of the blob
+// long enough to hold the head and the index, so that the segments can then be fetched alone.
+// It returns ErrNotSegmentedData if the blob is not laid out in segments.
+func ParseDataSegmentIndex(bz []byte) (*DataSegmentIndex, error) {
+	index, _, err := parseDataSegmentIndex(bz)
+	return index, err
+}
+
+func parseDataSegmentIndex(bz []byte) (*DataSegmentIndex, int, error) {
+	if !bytes.HasPrefix(bz, segmentedDataMagic) {
+		return nil, 0, ErrNotSegmentedData
+	}
+	pos := len(segmentedDataMagic)
+	if len(bz) < pos+9 {
+		return nil, 0, errors.New("truncated segmented data")
+	}
+	if bz[pos] != segmentedDataVersion {
+		return nil, 0, fmt.Errorf("unsupported segmented data version %d", bz[pos])
+	}
+	segmentSize := binary.BigEndian.Uint32(bz[pos+1:])
+	headLen := binary.BigEndian.Uint32(bz[pos+5:])
+	pos += 9
+	if segmentSize == 0 {
+		return nil, 0, errors.New("invalid segment size 0")
+	}
+	if uint64(len(bz)) < uint64(pos)+uint64(headLen)+4 {
+		return nil, 0, errors.New("truncated segmented data head")
+	}
+	var head pb.SignedData
+	if err := proto.Unmarshal(bz[pos:pos+int(headLen)], &head); err != nil {
+		return nil, 0, fmt.Errorf("invalid segmented data head: %w", err)
+	}
+	var signedData SignedData
+	if err := signedData.FromProto(&head); err != nil {
+		return nil, 0, fmt.Errorf("invalid segmented data head: %w", err)
+	}
+	pos += int(headLen)
+
+	n := binary.BigEndian.Uint32(bz[pos:])
+	pos += 4
+	if uint64(len(bz)-pos) < uint64(n)*segmentEntryLength {
+		return nil, 0, errors.New("truncated segment index")
+	}
+	index := &DataSegmentIndex{SignedData: &signedData, SegmentSize: segmentSize, Segments: make([]DataSegment, n)}
+	start := uint64(pos) + uint64(n)*segmentEntryLength
+	offset, nextTx := start, uint32(0)
+	for i := range index.Segments {
+		entry := bz[pos+i*segmentEntryLength:]
+		segment := DataSegment{
+			FirstTx: binary.BigEndian.Uint32(entry),
+			TxCount: binary.BigEndian.Uint32(entry[4:]),
+			Offset:  offset,
+			Length:  binary.BigEndian.Uint32(entry[8:]),
+		}
+		copy(segment.Hash[:], entry[12:segmentEntryLength])
+		if segment.FirstTx != nextTx || segment.TxCount == 0 {
+			return nil, 0, fmt.Errorf("invalid transactions of segment %d", i)
+		}
+		index.Segments[i] = segment
+		nextTx += segment.TxCount
+		// the next segment starts at the next multiple of the segment size
+		slots := (uint64(segment.Length) + uint64(segmentSize) - 1) / uint64(segmentSize)
+		offset += slots * uint64(segmentSize)
+	}
+	return index, int(start), nil
+}
+
+// TxCount returns the number of transactions of the blob.
+func (idx *DataSegmentIndex) TxCount() uint32 {
+	if len(idx.Segments) == 0 {
+		return 0
+	}
+	last := idx.Segments[len(idx.Segments)-1]
+	return last.FirstTx + last.TxCount
+}
+
+// DecodeSegment returns the transactions of the i-th segment, after checking the segment against
+// its hash in the index.
+func (idx *DataSegmentIndex) DecodeSegment(i int, segment []byte) (Txs, error) {
+	if i < 0 || i >= len(idx.Segments) {
+		return nil, fmt.Errorf("segment %d out of range", i)
+	}
+	entry := idx.Segments[i]
+	if len(segment) != int(entry.Length) || sha256.Sum256(segment) != entry.Hash {
+		return nil, fmt.Errorf("segment %d does not match its hash", i)
+	}
+	txs := make(Txs, 0, entry.TxCount)
+	for len(segment) > 0 {
+		size, n := binary.Uvarint(segment)
+		if n <= 0 || size > uint64(len(segment)-n) {
+			return nil, fmt.Errorf("invalid transaction length in segment %d", i)
+		}
+		txs = append(txs, segment[n:n+int(size)])
+		segment = segment[n+int(size):]
+	}
+	if len(txs) != int(entry.TxCount) {
+		return nil, fmt.Errorf("segment %d has %d transactions, expected %d", i, len(txs), entry.TxCount)
+	}
+	return txs, nil
+}
+
+// unmarshalSegmented decodes a segmented data blob into sd.
+func (sd *SignedData) unmarshalSegmented(bz []byte) error {
+	index, start, err := parseDataSegmentIndex(bz)
+	if err != nil {
+		return err
+	}
+	txs := make(Txs, 0, index.TxCount())
+	end := uint64(len(bz))
+	for i, segment := range index.Segments {
+		if segment.Offset+uint64(segment.Length) > end {
+			return fmt.Errorf("truncated segment %d", i)
+		}
+		segmentTxs, err := index.DecodeSegment(i, bz[segment.Offset:segment.Offset+uint64(segment.Length)])
+		if err != nil {
+			return err
+		}
+		txs = append(txs, segmentTxs...)
+	}
+	last := uint64(start)
+	if n := len(index.Segments); n > 0 {
+		last = index.Segments[n-1].Offset + uint64(index.Segments[n-1].Length)
+	}
+	if last != end {
+		return errors.New("trailing bytes in segmented data")
+	}
+	*sd = *index.SignedData
+	sd.Txs = txs
+	return nil
+}
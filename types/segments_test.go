@@ -0,0 +1,68 @@
+package types
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSegmentedData(t *testing.T) {
+	_, data := GetRandomBlock(7, 0, "test")
+	data.Txs = Txs{
+		bytes.Repeat([]byte{1}, 40),
+		bytes.Repeat([]byte{2}, 40),
+		bytes.Repeat([]byte{3}, 300),
+		bytes.Repeat([]byte{4}, 10),
+	}
+	signedData := &SignedData{Data: *data, Signature: []byte("signature")}
+	bz, err := signedData.MarshalSegmented(100)
+	require.NoError(t, err)
+
+	var decoded SignedData
+	require.NoError(t, decoded.UnmarshalBinary(bz))
+	assert.Equal(t, data.Height(), decoded.Height())
+	assert.Equal(t, Signature("signature"), decoded.Signature)
+	assert.Equal(t, data.DACommitment(), decoded.DACommitment())
+
+	// the index is parsed from a prefix of the blob, and segments are fetched alone
+	index, err := ParseDataSegmentIndex(bz[:bytes.Index(bz, data.Txs[0])])
+	require.NoError(t, err)
+	assert.Equal(t, uint64(7), index.SignedData.Height())
+	assert.Empty(t, index.SignedData.Txs)
+	assert.Equal(t, uint32(4), index.TxCount())
+	require.Len(t, index.Segments, 3)
+	// the transaction larger than the segment size is alone in its segment
+	assert.Equal(t, []uint32{0, 2, 3}, []uint32{index.Segments[0].FirstTx, index.Segments[1].FirstTx, index.Segments[2].FirstTx})
+	first := index.Segments[0].Offset
+	for _, segment := range index.Segments {
+		assert.Zero(t, (segment.Offset-first)%100)
+	}
+	segment := index.Segments[1]
+	txs, err := index.DecodeSegment(1, bz[segment.Offset:segment.Offset+uint64(segment.Length)])
+	require.NoError(t, err)
+	assert.Equal(t, Txs{data.Txs[2]}, txs)
+
+	// segments are checked against the index
+	tampered := append([]byte{}, bz...)
+	tampered[segment.Offset+10] ^= 1
+	_, err = index.DecodeSegment(1, tampered[segment.Offset:segment.Offset+uint64(segment.Length)])
+	assert.Error(t, err)
+	assert.Error(t, decoded.UnmarshalBinary(tampered))
+	assert.Error(t, decoded.UnmarshalBinary(bz[:len(bz)-1]))
+	assert.Error(t, decoded.UnmarshalBinary(append(append([]byte{}, bz...), 0)))
+
+	// data encoded as a single protobuf message is not segmented
+	legacy, err := signedData.MarshalBinary()
+	require.NoError(t, err)
+	_, err = ParseDataSegmentIndex(legacy)
+	assert.ErrorIs(t, err, ErrNotSegmentedData)
+
+	// data without transactions has no segments
+	empty := &SignedData{Data: Data{Metadata: data.Metadata}}
+	bz, err = empty.MarshalSegmented(100)
+	require.NoError(t, err)
+	require.NoError(t, decoded.UnmarshalBinary(bz))
+	assert.Empty(t, decoded.Txs)
+}
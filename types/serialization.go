@@ -1,6 +1,7 @@
 package types
 
 import (
+	"bytes"
 	"errors"
 	"time"
 
@@ -413,8 +414,12 @@ func (sd *SignedData) MarshalBinary() ([]byte, error) {
 	return proto.Marshal(p)
 }
 
-// UnmarshalBinary decodes binary form of SignedData into object.
+// UnmarshalBinary decodes binary form of SignedData into object. Data laid out in segments by
+// MarshalSegmented is decoded as well.
 func (sd *SignedData) UnmarshalBinary(data []byte) error {
+	if bytes.HasPrefix(data, segmentedDataMagic) {
+		return sd.unmarshalSegmented(data)
+	}
 	var pData pb.SignedData
 	err := proto.Unmarshal(data, &pData)
 	if err != nil {
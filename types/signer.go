@@ -10,8 +10,9 @@ import (
 type SignaturePayloadProvider func(header *Header) ([]byte, error)
 
 // DefaultSignaturePayloadProvider is the default implementation of SignaturePayloadProvider.
+// It signs the canonical sign-bytes of the header, see Header.SignBytes.
 func DefaultSignaturePayloadProvider(header *Header) ([]byte, error) {
-	return header.MarshalBinary()
+	return header.SignBytes(), nil
 }
 
 // Signer is a type that can verify messages.
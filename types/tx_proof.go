@@ -0,0 +1,51 @@
+package types
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"fmt"
+)
+
+// TxProof proves that a transaction is included in a block: the data hash of the header of the
+// block commits to the transactions of the proof, the proven one being at the index.
+//
+// The data hash is the hash of the transactions as a whole rather than a Merkle root, so the proof
+// holds all the transactions of the block.
+type TxProof struct {
+	Txs   Txs
+	Index uint32
+}
+
+// NewTxProof returns the proof of the transaction at index in data.
+func NewTxProof(data *Data, index uint32) (*TxProof, error) {
+	if int(index) >= len(data.Txs) {
+		return nil, fmt.Errorf("transaction index %d out of range, the block has %d transactions", index, len(data.Txs))
+	}
+	return &TxProof{Txs: data.Txs, Index: index}, nil
+}
+
+// Tx returns the proven transaction.
+func (p *TxProof) Tx() Tx {
+	if int(p.Index) >= len(p.Txs) {
+		return nil
+	}
+	return p.Txs[p.Index]
+}
+
+// Verify checks that the transactions of the proof match dataHash, the data hash of the header of
+// a block, and that the proven transaction has the SHA-256 hash txHash. The header itself must be
+// verified by the caller, e.g. its signature by the proposer.
+func (p *TxProof) Verify(dataHash Hash, txHash []byte) error {
+	tx := p.Tx()
+	if tx == nil {
+		return fmt.Errorf("transaction index %d out of range, the proof has %d transactions", p.Index, len(p.Txs))
+	}
+	if hash := sha256.Sum256(tx); !bytes.Equal(hash[:], txHash) {
+		return fmt.Errorf("transaction at index %d has hash %x, expected %x", p.Index, hash, txHash)
+	}
+	data := Data{Txs: p.Txs}
+	if commitment := data.DACommitment(); !bytes.Equal(commitment, dataHash) {
+		return fmt.Errorf("transactions of the proof do not match the data hash %x", dataHash)
+	}
+	return nil
+}
@@ -0,0 +1,31 @@
+package types
+
+import (
+	"crypto/sha256"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTxProof(t *testing.T) {
+	header, data := GetRandomBlock(1, 3, "test-tx-proof")
+	hash := sha256.Sum256(data.Txs[1])
+
+	proof, err := NewTxProof(data, 1)
+	require.NoError(t, err)
+	assert.Equal(t, data.Txs[1], proof.Tx())
+	require.NoError(t, proof.Verify(header.DataHash, hash[:]))
+
+	other := sha256.Sum256(data.Txs[0])
+	assert.Error(t, proof.Verify(header.DataHash, other[:]))
+	assert.Error(t, proof.Verify(Hash(make([]byte, 32)), hash[:]))
+
+	// a proof omitting transactions of the block does not match its data hash
+	partial := &TxProof{Txs: data.Txs[1:], Index: 0}
+	assert.Error(t, partial.Verify(header.DataHash, hash[:]))
+
+	_, err = NewTxProof(data, 3)
+	assert.Error(t, err)
+	assert.Error(t, (&TxProof{Txs: data.Txs, Index: 3}).Verify(header.DataHash, hash[:]))
+}